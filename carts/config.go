@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"go-micro.dev/v5/logger"
+)
+
+// envDuration reads a duration (e.g. "10m") from the environment, falling back to def
+func envDuration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		logger.Warnf("Invalid duration %q for %s, using default %s", v, key, def)
+		return def
+	}
+	return d
+}
+
+// envInt reads a positive integer from the environment, falling back to def
+func envInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		logger.Warnf("Invalid integer %q for %s, using default %d", v, key, def)
+		return def
+	}
+	return n
+}
+
+// envBool reads a boolean (e.g. "true", "0") from the environment, falling back to def
+func envBool(key string, def bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		logger.Warnf("Invalid boolean %q for %s, using default %v", v, key, def)
+		return def
+	}
+	return b
+}
+
+// serviceMetadata is advertised with the service in the registry. Region, set in
+// multi-region deployments, tells the instances of each region apart.
+func serviceMetadata(region string) map[string]string {
+	md := map[string]string{
+		"StartTime": time.Now().String(),
+	}
+	if region != "" {
+		md["Region"] = region
+	}
+	return md
+}
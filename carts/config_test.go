@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+func TestServiceMetadataAdvertisesRegion(t *testing.T) {
+	md := serviceMetadata("eu-west")
+	if md["Region"] != "eu-west" {
+		t.Fatalf("expected Region eu-west, got %q", md["Region"])
+	}
+	if md["StartTime"] == "" {
+		t.Fatal("expected StartTime to be advertised")
+	}
+
+	if _, ok := serviceMetadata("")["Region"]; ok {
+		t.Fatal("expected no Region without one configured")
+	}
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"carts/ent/cart"
+	"carts/ent/coupon"
 	"fmt"
 	"strings"
 	"time"
@@ -30,11 +31,16 @@ type Cart struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// soft delete timestamp
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Set when the cart was checked out into an order
+	CheckedOutAt *time.Time `json:"checked_out_at,omitempty"`
+	// Region of the service that created the cart; unset outside multi-region deployments
+	Region *string `json:"region,omitempty"`
 	// Optimistic lock version
 	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CartQuery when eager-loading is set.
 	Edges        CartEdges `json:"edges"`
+	coupon_carts *uuid.UUID
 	selectValues sql.SelectValues
 }
 
@@ -42,9 +48,13 @@ type Cart struct {
 type CartEdges struct {
 	// CartItems holds the value of the cart_items edge.
 	CartItems []*CartItem `json:"cart_items,omitempty"`
+	// Snapshots holds the value of the snapshots edge.
+	Snapshots []*CartSnapshot `json:"snapshots,omitempty"`
+	// Coupon holds the value of the coupon edge.
+	Coupon *Coupon `json:"coupon,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // CartItemsOrErr returns the CartItems value or an error if the edge
@@ -56,6 +66,26 @@ func (e CartEdges) CartItemsOrErr() ([]*CartItem, error) {
 	return nil, &NotLoadedError{edge: "cart_items"}
 }
 
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e CartEdges) SnapshotsOrErr() ([]*CartSnapshot, error) {
+	if e.loadedTypes[1] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// CouponOrErr returns the Coupon value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartEdges) CouponOrErr() (*Coupon, error) {
+	if e.Coupon != nil {
+		return e.Coupon, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: coupon.Label}
+	}
+	return nil, &NotLoadedError{edge: "coupon"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Cart) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -63,10 +93,14 @@ func (*Cart) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case cart.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case cart.FieldExpiresAt, cart.FieldLastActivityAt, cart.FieldCreatedAt, cart.FieldUpdatedAt, cart.FieldDeletedAt:
+		case cart.FieldRegion:
+			values[i] = new(sql.NullString)
+		case cart.FieldExpiresAt, cart.FieldLastActivityAt, cart.FieldCreatedAt, cart.FieldUpdatedAt, cart.FieldDeletedAt, cart.FieldCheckedOutAt:
 			values[i] = new(sql.NullTime)
 		case cart.FieldID, cart.FieldUserID:
 			values[i] = new(uuid.UUID)
+		case cart.ForeignKeys[0]: // coupon_carts
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -125,12 +159,33 @@ func (c *Cart) assignValues(columns []string, values []any) error {
 				c.DeletedAt = new(time.Time)
 				*c.DeletedAt = value.Time
 			}
+		case cart.FieldCheckedOutAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_out_at", values[i])
+			} else if value.Valid {
+				c.CheckedOutAt = new(time.Time)
+				*c.CheckedOutAt = value.Time
+			}
+		case cart.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				c.Region = new(string)
+				*c.Region = value.String
+			}
 		case cart.FieldVersion:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field version", values[i])
 			} else if value.Valid {
 				c.Version = int(value.Int64)
 			}
+		case cart.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_carts", values[i])
+			} else if value.Valid {
+				c.coupon_carts = new(uuid.UUID)
+				*c.coupon_carts = *value.S.(*uuid.UUID)
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -149,6 +204,16 @@ func (c *Cart) QueryCartItems() *CartItemQuery {
 	return NewCartClient(c.config).QueryCartItems(c)
 }
 
+// QuerySnapshots queries the "snapshots" edge of the Cart entity.
+func (c *Cart) QuerySnapshots() *CartSnapshotQuery {
+	return NewCartClient(c.config).QuerySnapshots(c)
+}
+
+// QueryCoupon queries the "coupon" edge of the Cart entity.
+func (c *Cart) QueryCoupon() *CouponQuery {
+	return NewCartClient(c.config).QueryCoupon(c)
+}
+
 // Update returns a builder for updating this Cart.
 // Note that you need to call Cart.Unwrap() before calling this method if this Cart
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -192,6 +257,16 @@ func (c *Cart) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := c.CheckedOutAt; v != nil {
+		builder.WriteString("checked_out_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.Region; v != nil {
+		builder.WriteString("region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("version=")
 	builder.WriteString(fmt.Sprintf("%v", c.Version))
 	builder.WriteByte(')')
@@ -27,10 +27,18 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldCheckedOutAt holds the string denoting the checked_out_at field in the database.
+	FieldCheckedOutAt = "checked_out_at"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
 	// EdgeCartItems holds the string denoting the cart_items edge name in mutations.
 	EdgeCartItems = "cart_items"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// EdgeCoupon holds the string denoting the coupon edge name in mutations.
+	EdgeCoupon = "coupon"
 	// Table holds the table name of the cart in the database.
 	Table = "carts"
 	// CartItemsTable is the table that holds the cart_items relation/edge.
@@ -40,6 +48,20 @@ const (
 	CartItemsInverseTable = "cart_items"
 	// CartItemsColumn is the table column denoting the cart_items relation/edge.
 	CartItemsColumn = "cart_cart_items"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "cart_snapshots"
+	// SnapshotsInverseTable is the table name for the CartSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "cartsnapshot" package.
+	SnapshotsInverseTable = "cart_snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "cart_snapshots"
+	// CouponTable is the table that holds the coupon relation/edge.
+	CouponTable = "carts"
+	// CouponInverseTable is the table name for the Coupon entity.
+	// It exists in this package in order to avoid circular dependency with the "coupon" package.
+	CouponInverseTable = "coupons"
+	// CouponColumn is the table column denoting the coupon relation/edge.
+	CouponColumn = "coupon_carts"
 )
 
 // Columns holds all SQL columns for cart fields.
@@ -51,9 +73,17 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldCheckedOutAt,
+	FieldRegion,
 	FieldVersion,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "carts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"coupon_carts",
+}
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -61,6 +91,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -121,6 +156,16 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByCheckedOutAt orders the results by the checked_out_at field.
+func ByCheckedOutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedOutAt, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
 // ByVersion orders the results by the version field.
 func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
@@ -139,6 +184,27 @@ func ByCartItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCartItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCouponField orders the results by coupon field.
+func ByCouponField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCouponStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCartItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -146,3 +212,17 @@ func newCartItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CartItemsTable, CartItemsColumn),
 	)
 }
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
+func newCouponStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CouponInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CouponTable, CouponColumn),
+	)
+}
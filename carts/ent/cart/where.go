@@ -86,6 +86,16 @@ func DeletedAt(v time.Time) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// CheckedOutAt applies equality check predicate on the "checked_out_at" field. It's identical to CheckedOutAtEQ.
+func CheckedOutAt(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldCheckedOutAt, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldRegion, v))
+}
+
 // Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
 func Version(v int) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldVersion, v))
@@ -341,6 +351,131 @@ func DeletedAtNotNil() predicate.Cart {
 	return predicate.Cart(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// CheckedOutAtEQ applies the EQ predicate on the "checked_out_at" field.
+func CheckedOutAtEQ(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtNEQ applies the NEQ predicate on the "checked_out_at" field.
+func CheckedOutAtNEQ(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldNEQ(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtIn applies the In predicate on the "checked_out_at" field.
+func CheckedOutAtIn(vs ...time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldIn(FieldCheckedOutAt, vs...))
+}
+
+// CheckedOutAtNotIn applies the NotIn predicate on the "checked_out_at" field.
+func CheckedOutAtNotIn(vs ...time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldNotIn(FieldCheckedOutAt, vs...))
+}
+
+// CheckedOutAtGT applies the GT predicate on the "checked_out_at" field.
+func CheckedOutAtGT(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldGT(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtGTE applies the GTE predicate on the "checked_out_at" field.
+func CheckedOutAtGTE(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldGTE(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtLT applies the LT predicate on the "checked_out_at" field.
+func CheckedOutAtLT(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldLT(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtLTE applies the LTE predicate on the "checked_out_at" field.
+func CheckedOutAtLTE(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldLTE(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtIsNil applies the IsNil predicate on the "checked_out_at" field.
+func CheckedOutAtIsNil() predicate.Cart {
+	return predicate.Cart(sql.FieldIsNull(FieldCheckedOutAt))
+}
+
+// CheckedOutAtNotNil applies the NotNil predicate on the "checked_out_at" field.
+func CheckedOutAtNotNil() predicate.Cart {
+	return predicate.Cart(sql.FieldNotNull(FieldCheckedOutAt))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.Cart {
+	return predicate.Cart(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.Cart {
+	return predicate.Cart(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContainsFold(FieldRegion, v))
+}
+
 // VersionEQ applies the EQ predicate on the "version" field.
 func VersionEQ(v int) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldVersion, v))
@@ -404,6 +539,52 @@ func HasCartItemsWith(preds ...predicate.CartItem) predicate.Cart {
 	})
 }
 
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.Cart {
+	return predicate.Cart(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.CartSnapshot) predicate.Cart {
+	return predicate.Cart(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCoupon applies the HasEdge predicate on the "coupon" edge.
+func HasCoupon() predicate.Cart {
+	return predicate.Cart(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CouponTable, CouponColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCouponWith applies the HasEdge predicate on the "coupon" edge with a given conditions (other predicates).
+func HasCouponWith(preds ...predicate.Coupon) predicate.Cart {
+	return predicate.Cart(func(s *sql.Selector) {
+		step := newCouponStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Cart) predicate.Cart {
 	return predicate.Cart(sql.AndPredicates(predicates...))
@@ -5,6 +5,8 @@ package ent
 import (
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
 	"context"
 	"errors"
 	"fmt"
@@ -98,6 +100,34 @@ func (cc *CartCreate) SetNillableDeletedAt(t *time.Time) *CartCreate {
 	return cc
 }
 
+// SetCheckedOutAt sets the "checked_out_at" field.
+func (cc *CartCreate) SetCheckedOutAt(t time.Time) *CartCreate {
+	cc.mutation.SetCheckedOutAt(t)
+	return cc
+}
+
+// SetNillableCheckedOutAt sets the "checked_out_at" field if the given value is not nil.
+func (cc *CartCreate) SetNillableCheckedOutAt(t *time.Time) *CartCreate {
+	if t != nil {
+		cc.SetCheckedOutAt(*t)
+	}
+	return cc
+}
+
+// SetRegion sets the "region" field.
+func (cc *CartCreate) SetRegion(s string) *CartCreate {
+	cc.mutation.SetRegion(s)
+	return cc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (cc *CartCreate) SetNillableRegion(s *string) *CartCreate {
+	if s != nil {
+		cc.SetRegion(*s)
+	}
+	return cc
+}
+
 // SetVersion sets the "version" field.
 func (cc *CartCreate) SetVersion(i int) *CartCreate {
 	cc.mutation.SetVersion(i)
@@ -141,6 +171,40 @@ func (cc *CartCreate) AddCartItems(c ...*CartItem) *CartCreate {
 	return cc.AddCartItemIDs(ids...)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the CartSnapshot entity by IDs.
+func (cc *CartCreate) AddSnapshotIDs(ids ...uuid.UUID) *CartCreate {
+	cc.mutation.AddSnapshotIDs(ids...)
+	return cc
+}
+
+// AddSnapshots adds the "snapshots" edges to the CartSnapshot entity.
+func (cc *CartCreate) AddSnapshots(c ...*CartSnapshot) *CartCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddSnapshotIDs(ids...)
+}
+
+// SetCouponID sets the "coupon" edge to the Coupon entity by ID.
+func (cc *CartCreate) SetCouponID(id uuid.UUID) *CartCreate {
+	cc.mutation.SetCouponID(id)
+	return cc
+}
+
+// SetNillableCouponID sets the "coupon" edge to the Coupon entity by ID if the given value is not nil.
+func (cc *CartCreate) SetNillableCouponID(id *uuid.UUID) *CartCreate {
+	if id != nil {
+		cc = cc.SetCouponID(*id)
+	}
+	return cc
+}
+
+// SetCoupon sets the "coupon" edge to the Coupon entity.
+func (cc *CartCreate) SetCoupon(c *Coupon) *CartCreate {
+	return cc.SetCouponID(c.ID)
+}
+
 // Mutation returns the CartMutation object of the builder.
 func (cc *CartCreate) Mutation() *CartMutation {
 	return cc.mutation
@@ -281,6 +345,14 @@ func (cc *CartCreate) createSpec() (*Cart, *sqlgraph.CreateSpec) {
 		_spec.SetField(cart.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := cc.mutation.CheckedOutAt(); ok {
+		_spec.SetField(cart.FieldCheckedOutAt, field.TypeTime, value)
+		_node.CheckedOutAt = &value
+	}
+	if value, ok := cc.mutation.Region(); ok {
+		_spec.SetField(cart.FieldRegion, field.TypeString, value)
+		_node.Region = &value
+	}
 	if value, ok := cc.mutation.Version(); ok {
 		_spec.SetField(cart.FieldVersion, field.TypeInt, value)
 		_node.Version = value
@@ -301,6 +373,39 @@ func (cc *CartCreate) createSpec() (*Cart, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.CouponIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cart.CouponTable,
+			Columns: []string{cart.CouponColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.coupon_carts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
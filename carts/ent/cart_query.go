@@ -5,6 +5,8 @@ package ent
 import (
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
 	"carts/ent/predicate"
 	"context"
 	"database/sql/driver"
@@ -26,6 +28,9 @@ type CartQuery struct {
 	inters        []Interceptor
 	predicates    []predicate.Cart
 	withCartItems *CartItemQuery
+	withSnapshots *CartSnapshotQuery
+	withCoupon    *CouponQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +89,50 @@ func (cq *CartQuery) QueryCartItems() *CartItemQuery {
 	return query
 }
 
+// QuerySnapshots chains the current query on the "snapshots" edge.
+func (cq *CartQuery) QuerySnapshots() *CartSnapshotQuery {
+	query := (&CartSnapshotClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, selector),
+			sqlgraph.To(cartsnapshot.Table, cartsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cart.SnapshotsTable, cart.SnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCoupon chains the current query on the "coupon" edge.
+func (cq *CartQuery) QueryCoupon() *CouponQuery {
+	query := (&CouponClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, selector),
+			sqlgraph.To(coupon.Table, coupon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cart.CouponTable, cart.CouponColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Cart entity from the query.
 // Returns a *NotFoundError when no Cart was found.
 func (cq *CartQuery) First(ctx context.Context) (*Cart, error) {
@@ -277,6 +326,8 @@ func (cq *CartQuery) Clone() *CartQuery {
 		inters:        append([]Interceptor{}, cq.inters...),
 		predicates:    append([]predicate.Cart{}, cq.predicates...),
 		withCartItems: cq.withCartItems.Clone(),
+		withSnapshots: cq.withSnapshots.Clone(),
+		withCoupon:    cq.withCoupon.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -294,6 +345,28 @@ func (cq *CartQuery) WithCartItems(opts ...func(*CartItemQuery)) *CartQuery {
 	return cq
 }
 
+// WithSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CartQuery) WithSnapshots(opts ...func(*CartSnapshotQuery)) *CartQuery {
+	query := (&CartSnapshotClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withSnapshots = query
+	return cq
+}
+
+// WithCoupon tells the query-builder to eager-load the nodes that are connected to
+// the "coupon" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CartQuery) WithCoupon(opts ...func(*CouponQuery)) *CartQuery {
+	query := (&CouponClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withCoupon = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,11 +444,20 @@ func (cq *CartQuery) prepareQuery(ctx context.Context) error {
 func (cq *CartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cart, error) {
 	var (
 		nodes       = []*Cart{}
+		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			cq.withCartItems != nil,
+			cq.withSnapshots != nil,
+			cq.withCoupon != nil,
 		}
 	)
+	if cq.withCoupon != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, cart.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Cart).scanValues(nil, columns)
 	}
@@ -401,6 +483,19 @@ func (cq *CartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cart, e
 			return nil, err
 		}
 	}
+	if query := cq.withSnapshots; query != nil {
+		if err := cq.loadSnapshots(ctx, query, nodes,
+			func(n *Cart) { n.Edges.Snapshots = []*CartSnapshot{} },
+			func(n *Cart, e *CartSnapshot) { n.Edges.Snapshots = append(n.Edges.Snapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withCoupon; query != nil {
+		if err := cq.loadCoupon(ctx, query, nodes, nil,
+			func(n *Cart, e *Coupon) { n.Edges.Coupon = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +530,69 @@ func (cq *CartQuery) loadCartItems(ctx context.Context, query *CartItemQuery, no
 	}
 	return nil
 }
+func (cq *CartQuery) loadSnapshots(ctx context.Context, query *CartSnapshotQuery, nodes []*Cart, init func(*Cart), assign func(*Cart, *CartSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Cart)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.CartSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(cart.SnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.cart_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "cart_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "cart_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *CartQuery) loadCoupon(ctx context.Context, query *CouponQuery, nodes []*Cart, init func(*Cart), assign func(*Cart, *Coupon)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Cart)
+	for i := range nodes {
+		if nodes[i].coupon_carts == nil {
+			continue
+		}
+		fk := *nodes[i].coupon_carts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coupon.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "coupon_carts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (cq *CartQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
@@ -5,6 +5,8 @@ package ent
 import (
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
 	"carts/ent/predicate"
 	"context"
 	"errors"
@@ -90,6 +92,26 @@ func (cu *CartUpdate) ClearDeletedAt() *CartUpdate {
 	return cu
 }
 
+// SetCheckedOutAt sets the "checked_out_at" field.
+func (cu *CartUpdate) SetCheckedOutAt(t time.Time) *CartUpdate {
+	cu.mutation.SetCheckedOutAt(t)
+	return cu
+}
+
+// SetNillableCheckedOutAt sets the "checked_out_at" field if the given value is not nil.
+func (cu *CartUpdate) SetNillableCheckedOutAt(t *time.Time) *CartUpdate {
+	if t != nil {
+		cu.SetCheckedOutAt(*t)
+	}
+	return cu
+}
+
+// ClearCheckedOutAt clears the value of the "checked_out_at" field.
+func (cu *CartUpdate) ClearCheckedOutAt() *CartUpdate {
+	cu.mutation.ClearCheckedOutAt()
+	return cu
+}
+
 // SetVersion sets the "version" field.
 func (cu *CartUpdate) SetVersion(i int) *CartUpdate {
 	cu.mutation.ResetVersion()
@@ -126,6 +148,40 @@ func (cu *CartUpdate) AddCartItems(c ...*CartItem) *CartUpdate {
 	return cu.AddCartItemIDs(ids...)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the CartSnapshot entity by IDs.
+func (cu *CartUpdate) AddSnapshotIDs(ids ...uuid.UUID) *CartUpdate {
+	cu.mutation.AddSnapshotIDs(ids...)
+	return cu
+}
+
+// AddSnapshots adds the "snapshots" edges to the CartSnapshot entity.
+func (cu *CartUpdate) AddSnapshots(c ...*CartSnapshot) *CartUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddSnapshotIDs(ids...)
+}
+
+// SetCouponID sets the "coupon" edge to the Coupon entity by ID.
+func (cu *CartUpdate) SetCouponID(id uuid.UUID) *CartUpdate {
+	cu.mutation.SetCouponID(id)
+	return cu
+}
+
+// SetNillableCouponID sets the "coupon" edge to the Coupon entity by ID if the given value is not nil.
+func (cu *CartUpdate) SetNillableCouponID(id *uuid.UUID) *CartUpdate {
+	if id != nil {
+		cu = cu.SetCouponID(*id)
+	}
+	return cu
+}
+
+// SetCoupon sets the "coupon" edge to the Coupon entity.
+func (cu *CartUpdate) SetCoupon(c *Coupon) *CartUpdate {
+	return cu.SetCouponID(c.ID)
+}
+
 // Mutation returns the CartMutation object of the builder.
 func (cu *CartUpdate) Mutation() *CartMutation {
 	return cu.mutation
@@ -152,6 +208,33 @@ func (cu *CartUpdate) RemoveCartItems(c ...*CartItem) *CartUpdate {
 	return cu.RemoveCartItemIDs(ids...)
 }
 
+// ClearSnapshots clears all "snapshots" edges to the CartSnapshot entity.
+func (cu *CartUpdate) ClearSnapshots() *CartUpdate {
+	cu.mutation.ClearSnapshots()
+	return cu
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to CartSnapshot entities by IDs.
+func (cu *CartUpdate) RemoveSnapshotIDs(ids ...uuid.UUID) *CartUpdate {
+	cu.mutation.RemoveSnapshotIDs(ids...)
+	return cu
+}
+
+// RemoveSnapshots removes "snapshots" edges to CartSnapshot entities.
+func (cu *CartUpdate) RemoveSnapshots(c ...*CartSnapshot) *CartUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveSnapshotIDs(ids...)
+}
+
+// ClearCoupon clears the "coupon" edge to the Coupon entity.
+func (cu *CartUpdate) ClearCoupon() *CartUpdate {
+	cu.mutation.ClearCoupon()
+	return cu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CartUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -219,6 +302,15 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.DeletedAtCleared() {
 		_spec.ClearField(cart.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := cu.mutation.CheckedOutAt(); ok {
+		_spec.SetField(cart.FieldCheckedOutAt, field.TypeTime, value)
+	}
+	if cu.mutation.CheckedOutAtCleared() {
+		_spec.ClearField(cart.FieldCheckedOutAt, field.TypeTime)
+	}
+	if cu.mutation.RegionCleared() {
+		_spec.ClearField(cart.FieldRegion, field.TypeString)
+	}
 	if value, ok := cu.mutation.Version(); ok {
 		_spec.SetField(cart.FieldVersion, field.TypeInt, value)
 	}
@@ -270,6 +362,80 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !cu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.CouponCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cart.CouponTable,
+			Columns: []string{cart.CouponColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CouponIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cart.CouponTable,
+			Columns: []string{cart.CouponColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{cart.Label}
@@ -350,6 +516,26 @@ func (cuo *CartUpdateOne) ClearDeletedAt() *CartUpdateOne {
 	return cuo
 }
 
+// SetCheckedOutAt sets the "checked_out_at" field.
+func (cuo *CartUpdateOne) SetCheckedOutAt(t time.Time) *CartUpdateOne {
+	cuo.mutation.SetCheckedOutAt(t)
+	return cuo
+}
+
+// SetNillableCheckedOutAt sets the "checked_out_at" field if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableCheckedOutAt(t *time.Time) *CartUpdateOne {
+	if t != nil {
+		cuo.SetCheckedOutAt(*t)
+	}
+	return cuo
+}
+
+// ClearCheckedOutAt clears the value of the "checked_out_at" field.
+func (cuo *CartUpdateOne) ClearCheckedOutAt() *CartUpdateOne {
+	cuo.mutation.ClearCheckedOutAt()
+	return cuo
+}
+
 // SetVersion sets the "version" field.
 func (cuo *CartUpdateOne) SetVersion(i int) *CartUpdateOne {
 	cuo.mutation.ResetVersion()
@@ -386,6 +572,40 @@ func (cuo *CartUpdateOne) AddCartItems(c ...*CartItem) *CartUpdateOne {
 	return cuo.AddCartItemIDs(ids...)
 }
 
+// AddSnapshotIDs adds the "snapshots" edge to the CartSnapshot entity by IDs.
+func (cuo *CartUpdateOne) AddSnapshotIDs(ids ...uuid.UUID) *CartUpdateOne {
+	cuo.mutation.AddSnapshotIDs(ids...)
+	return cuo
+}
+
+// AddSnapshots adds the "snapshots" edges to the CartSnapshot entity.
+func (cuo *CartUpdateOne) AddSnapshots(c ...*CartSnapshot) *CartUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddSnapshotIDs(ids...)
+}
+
+// SetCouponID sets the "coupon" edge to the Coupon entity by ID.
+func (cuo *CartUpdateOne) SetCouponID(id uuid.UUID) *CartUpdateOne {
+	cuo.mutation.SetCouponID(id)
+	return cuo
+}
+
+// SetNillableCouponID sets the "coupon" edge to the Coupon entity by ID if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableCouponID(id *uuid.UUID) *CartUpdateOne {
+	if id != nil {
+		cuo = cuo.SetCouponID(*id)
+	}
+	return cuo
+}
+
+// SetCoupon sets the "coupon" edge to the Coupon entity.
+func (cuo *CartUpdateOne) SetCoupon(c *Coupon) *CartUpdateOne {
+	return cuo.SetCouponID(c.ID)
+}
+
 // Mutation returns the CartMutation object of the builder.
 func (cuo *CartUpdateOne) Mutation() *CartMutation {
 	return cuo.mutation
@@ -412,6 +632,33 @@ func (cuo *CartUpdateOne) RemoveCartItems(c ...*CartItem) *CartUpdateOne {
 	return cuo.RemoveCartItemIDs(ids...)
 }
 
+// ClearSnapshots clears all "snapshots" edges to the CartSnapshot entity.
+func (cuo *CartUpdateOne) ClearSnapshots() *CartUpdateOne {
+	cuo.mutation.ClearSnapshots()
+	return cuo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to CartSnapshot entities by IDs.
+func (cuo *CartUpdateOne) RemoveSnapshotIDs(ids ...uuid.UUID) *CartUpdateOne {
+	cuo.mutation.RemoveSnapshotIDs(ids...)
+	return cuo
+}
+
+// RemoveSnapshots removes "snapshots" edges to CartSnapshot entities.
+func (cuo *CartUpdateOne) RemoveSnapshots(c ...*CartSnapshot) *CartUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveSnapshotIDs(ids...)
+}
+
+// ClearCoupon clears the "coupon" edge to the Coupon entity.
+func (cuo *CartUpdateOne) ClearCoupon() *CartUpdateOne {
+	cuo.mutation.ClearCoupon()
+	return cuo
+}
+
 // Where appends a list predicates to the CartUpdate builder.
 func (cuo *CartUpdateOne) Where(ps ...predicate.Cart) *CartUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -509,6 +756,15 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 	if cuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(cart.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := cuo.mutation.CheckedOutAt(); ok {
+		_spec.SetField(cart.FieldCheckedOutAt, field.TypeTime, value)
+	}
+	if cuo.mutation.CheckedOutAtCleared() {
+		_spec.ClearField(cart.FieldCheckedOutAt, field.TypeTime)
+	}
+	if cuo.mutation.RegionCleared() {
+		_spec.ClearField(cart.FieldRegion, field.TypeString)
+	}
 	if value, ok := cuo.mutation.Version(); ok {
 		_spec.SetField(cart.FieldVersion, field.TypeInt, value)
 	}
@@ -560,6 +816,80 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !cuo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cart.SnapshotsTable,
+			Columns: []string{cart.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.CouponCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cart.CouponTable,
+			Columns: []string{cart.CouponColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CouponIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cart.CouponTable,
+			Columns: []string{cart.CouponColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Cart{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
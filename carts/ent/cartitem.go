@@ -21,6 +21,8 @@ type CartItem struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Reference to the product
 	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// Product name snapshot taken when the item was added
+	ProductName string `json:"product_name,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -31,7 +33,6 @@ type CartItem struct {
 	// The values are being populated by the CartItemQuery when eager-loading is set.
 	Edges           CartItemEdges `json:"edges"`
 	cart_cart_items *uuid.UUID
-	cart_item_cart  *uuid.UUID
 	selectValues    sql.SelectValues
 }
 
@@ -62,14 +63,14 @@ func (*CartItem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case cartitem.FieldQuantity:
 			values[i] = new(sql.NullInt64)
+		case cartitem.FieldProductName:
+			values[i] = new(sql.NullString)
 		case cartitem.FieldCreatedAt, cartitem.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case cartitem.FieldID, cartitem.FieldProductID:
 			values[i] = new(uuid.UUID)
 		case cartitem.ForeignKeys[0]: // cart_cart_items
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case cartitem.ForeignKeys[1]: // cart_item_cart
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -97,6 +98,12 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ci.ProductID = *value
 			}
+		case cartitem.FieldProductName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name", values[i])
+			} else if value.Valid {
+				ci.ProductName = value.String
+			}
 		case cartitem.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
@@ -122,13 +129,6 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 				ci.cart_cart_items = new(uuid.UUID)
 				*ci.cart_cart_items = *value.S.(*uuid.UUID)
 			}
-		case cartitem.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field cart_item_cart", values[i])
-			} else if value.Valid {
-				ci.cart_item_cart = new(uuid.UUID)
-				*ci.cart_item_cart = *value.S.(*uuid.UUID)
-			}
 		default:
 			ci.selectValues.Set(columns[i], values[i])
 		}
@@ -173,6 +173,9 @@ func (ci *CartItem) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", ci.ProductID))
 	builder.WriteString(", ")
+	builder.WriteString("product_name=")
+	builder.WriteString(ci.ProductName)
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", ci.Quantity))
 	builder.WriteString(", ")
@@ -17,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldProductID holds the string denoting the product_id field in the database.
 	FieldProductID = "product_id"
+	// FieldProductName holds the string denoting the product_name field in the database.
+	FieldProductName = "product_name"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -33,13 +35,14 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "cart" package.
 	CartInverseTable = "carts"
 	// CartColumn is the table column denoting the cart relation/edge.
-	CartColumn = "cart_item_cart"
+	CartColumn = "cart_cart_items"
 )
 
 // Columns holds all SQL columns for cartitem fields.
 var Columns = []string{
 	FieldID,
 	FieldProductID,
+	FieldProductName,
 	FieldQuantity,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -49,7 +52,6 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"cart_cart_items",
-	"cart_item_cart",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,6 +95,11 @@ func ByProductID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProductID, opts...).ToFunc()
 }
 
+// ByProductName orders the results by the product_name field.
+func ByProductName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductName, opts...).ToFunc()
+}
+
 // ByQuantity orders the results by the quantity field.
 func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
@@ -118,6 +125,6 @@ func newCartStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(CartInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, false, CartTable, CartColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, CartTable, CartColumn),
 	)
 }
@@ -61,6 +61,11 @@ func ProductID(v uuid.UUID) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldProductID, v))
 }
 
+// ProductName applies equality check predicate on the "product_name" field. It's identical to ProductNameEQ.
+func ProductName(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldProductName, v))
+}
+
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
 func Quantity(v int) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldQuantity, v))
@@ -116,6 +121,81 @@ func ProductIDLTE(v uuid.UUID) predicate.CartItem {
 	return predicate.CartItem(sql.FieldLTE(FieldProductID, v))
 }
 
+// ProductNameEQ applies the EQ predicate on the "product_name" field.
+func ProductNameEQ(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// ProductNameNEQ applies the NEQ predicate on the "product_name" field.
+func ProductNameNEQ(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldProductName, v))
+}
+
+// ProductNameIn applies the In predicate on the "product_name" field.
+func ProductNameIn(vs ...string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldProductName, vs...))
+}
+
+// ProductNameNotIn applies the NotIn predicate on the "product_name" field.
+func ProductNameNotIn(vs ...string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldProductName, vs...))
+}
+
+// ProductNameGT applies the GT predicate on the "product_name" field.
+func ProductNameGT(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGT(FieldProductName, v))
+}
+
+// ProductNameGTE applies the GTE predicate on the "product_name" field.
+func ProductNameGTE(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGTE(FieldProductName, v))
+}
+
+// ProductNameLT applies the LT predicate on the "product_name" field.
+func ProductNameLT(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLT(FieldProductName, v))
+}
+
+// ProductNameLTE applies the LTE predicate on the "product_name" field.
+func ProductNameLTE(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLTE(FieldProductName, v))
+}
+
+// ProductNameContains applies the Contains predicate on the "product_name" field.
+func ProductNameContains(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldContains(FieldProductName, v))
+}
+
+// ProductNameHasPrefix applies the HasPrefix predicate on the "product_name" field.
+func ProductNameHasPrefix(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldHasPrefix(FieldProductName, v))
+}
+
+// ProductNameHasSuffix applies the HasSuffix predicate on the "product_name" field.
+func ProductNameHasSuffix(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldHasSuffix(FieldProductName, v))
+}
+
+// ProductNameIsNil applies the IsNil predicate on the "product_name" field.
+func ProductNameIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldProductName))
+}
+
+// ProductNameNotNil applies the NotNil predicate on the "product_name" field.
+func ProductNameNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldProductName))
+}
+
+// ProductNameEqualFold applies the EqualFold predicate on the "product_name" field.
+func ProductNameEqualFold(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEqualFold(FieldProductName, v))
+}
+
+// ProductNameContainsFold applies the ContainsFold predicate on the "product_name" field.
+func ProductNameContainsFold(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldContainsFold(FieldProductName, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldQuantity, v))
@@ -241,7 +321,7 @@ func HasCart() predicate.CartItem {
 	return predicate.CartItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, CartTable, CartColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, CartTable, CartColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -28,6 +28,20 @@ func (cic *CartItemCreate) SetProductID(u uuid.UUID) *CartItemCreate {
 	return cic
 }
 
+// SetProductName sets the "product_name" field.
+func (cic *CartItemCreate) SetProductName(s string) *CartItemCreate {
+	cic.mutation.SetProductName(s)
+	return cic
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (cic *CartItemCreate) SetNillableProductName(s *string) *CartItemCreate {
+	if s != nil {
+		cic.SetProductName(*s)
+	}
+	return cic
+}
+
 // SetQuantity sets the "quantity" field.
 func (cic *CartItemCreate) SetQuantity(i int) *CartItemCreate {
 	cic.mutation.SetQuantity(i)
@@ -197,6 +211,10 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(cartitem.FieldProductID, field.TypeUUID, value)
 		_node.ProductID = value
 	}
+	if value, ok := cic.mutation.ProductName(); ok {
+		_spec.SetField(cartitem.FieldProductName, field.TypeString, value)
+		_node.ProductName = value
+	}
 	if value, ok := cic.mutation.Quantity(); ok {
 		_spec.SetField(cartitem.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
@@ -212,7 +230,7 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 	if nodes := cic.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -223,7 +241,7 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.cart_item_cart = &nodes[0]
+		_node.cart_cart_items = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -76,7 +76,7 @@ func (ciq *CartItemQuery) QueryCart() *CartQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(cartitem.Table, cartitem.FieldID, selector),
 			sqlgraph.To(cart.Table, cart.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.CartTable, cartitem.CartColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.CartTable, cartitem.CartColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
 		return fromU, nil
@@ -414,10 +414,10 @@ func (ciq *CartItemQuery) loadCart(ctx context.Context, query *CartQuery, nodes
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*CartItem)
 	for i := range nodes {
-		if nodes[i].cart_item_cart == nil {
+		if nodes[i].cart_cart_items == nil {
 			continue
 		}
-		fk := *nodes[i].cart_item_cart
+		fk := *nodes[i].cart_cart_items
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -434,7 +434,7 @@ func (ciq *CartItemQuery) loadCart(ctx context.Context, query *CartQuery, nodes
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "cart_item_cart" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "cart_cart_items" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
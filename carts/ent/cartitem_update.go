@@ -44,6 +44,26 @@ func (ciu *CartItemUpdate) SetNillableProductID(u *uuid.UUID) *CartItemUpdate {
 	return ciu
 }
 
+// SetProductName sets the "product_name" field.
+func (ciu *CartItemUpdate) SetProductName(s string) *CartItemUpdate {
+	ciu.mutation.SetProductName(s)
+	return ciu
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillableProductName(s *string) *CartItemUpdate {
+	if s != nil {
+		ciu.SetProductName(*s)
+	}
+	return ciu
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (ciu *CartItemUpdate) ClearProductName() *CartItemUpdate {
+	ciu.mutation.ClearProductName()
+	return ciu
+}
+
 // SetQuantity sets the "quantity" field.
 func (ciu *CartItemUpdate) SetQuantity(i int) *CartItemUpdate {
 	ciu.mutation.ResetQuantity()
@@ -157,6 +177,12 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ciu.mutation.ProductID(); ok {
 		_spec.SetField(cartitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := ciu.mutation.ProductName(); ok {
+		_spec.SetField(cartitem.FieldProductName, field.TypeString, value)
+	}
+	if ciu.mutation.ProductNameCleared() {
+		_spec.ClearField(cartitem.FieldProductName, field.TypeString)
+	}
 	if value, ok := ciu.mutation.Quantity(); ok {
 		_spec.SetField(cartitem.FieldQuantity, field.TypeInt, value)
 	}
@@ -169,7 +195,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ciu.mutation.CartCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -182,7 +208,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := ciu.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -229,6 +255,26 @@ func (ciuo *CartItemUpdateOne) SetNillableProductID(u *uuid.UUID) *CartItemUpdat
 	return ciuo
 }
 
+// SetProductName sets the "product_name" field.
+func (ciuo *CartItemUpdateOne) SetProductName(s string) *CartItemUpdateOne {
+	ciuo.mutation.SetProductName(s)
+	return ciuo
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillableProductName(s *string) *CartItemUpdateOne {
+	if s != nil {
+		ciuo.SetProductName(*s)
+	}
+	return ciuo
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (ciuo *CartItemUpdateOne) ClearProductName() *CartItemUpdateOne {
+	ciuo.mutation.ClearProductName()
+	return ciuo
+}
+
 // SetQuantity sets the "quantity" field.
 func (ciuo *CartItemUpdateOne) SetQuantity(i int) *CartItemUpdateOne {
 	ciuo.mutation.ResetQuantity()
@@ -372,6 +418,12 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if value, ok := ciuo.mutation.ProductID(); ok {
 		_spec.SetField(cartitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := ciuo.mutation.ProductName(); ok {
+		_spec.SetField(cartitem.FieldProductName, field.TypeString, value)
+	}
+	if ciuo.mutation.ProductNameCleared() {
+		_spec.ClearField(cartitem.FieldProductName, field.TypeString)
+	}
 	if value, ok := ciuo.mutation.Quantity(); ok {
 		_spec.SetField(cartitem.FieldQuantity, field.TypeInt, value)
 	}
@@ -384,7 +436,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if ciuo.mutation.CartCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -397,7 +449,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if nodes := ciuo.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cart"
+	"carts/ent/cartsnapshot"
+	"carts/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CartSnapshot is the model entity for the CartSnapshot schema.
+type CartSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Cart version the items belong to
+	Version int `json:"version,omitempty"`
+	// Items holds the value of the "items" field.
+	Items []schema.CartSnapshotItem `json:"items,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CartSnapshotQuery when eager-loading is set.
+	Edges          CartSnapshotEdges `json:"edges"`
+	cart_snapshots *uuid.UUID
+	selectValues   sql.SelectValues
+}
+
+// CartSnapshotEdges holds the relations/edges for other nodes in the graph.
+type CartSnapshotEdges struct {
+	// Cart holds the value of the cart edge.
+	Cart *Cart `json:"cart,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CartOrErr returns the Cart value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartSnapshotEdges) CartOrErr() (*Cart, error) {
+	if e.Cart != nil {
+		return e.Cart, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: cart.Label}
+	}
+	return nil, &NotLoadedError{edge: "cart"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CartSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cartsnapshot.FieldItems:
+			values[i] = new([]byte)
+		case cartsnapshot.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case cartsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case cartsnapshot.FieldID:
+			values[i] = new(uuid.UUID)
+		case cartsnapshot.ForeignKeys[0]: // cart_snapshots
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CartSnapshot fields.
+func (cs *CartSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cartsnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case cartsnapshot.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				cs.Version = int(value.Int64)
+			}
+		case cartsnapshot.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cs.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case cartsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		case cartsnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field cart_snapshots", values[i])
+			} else if value.Valid {
+				cs.cart_snapshots = new(uuid.UUID)
+				*cs.cart_snapshots = *value.S.(*uuid.UUID)
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CartSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (cs *CartSnapshot) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// QueryCart queries the "cart" edge of the CartSnapshot entity.
+func (cs *CartSnapshot) QueryCart() *CartQuery {
+	return NewCartSnapshotClient(cs.config).QueryCart(cs)
+}
+
+// Update returns a builder for updating this CartSnapshot.
+// Note that you need to call CartSnapshot.Unwrap() before calling this method if this CartSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *CartSnapshot) Update() *CartSnapshotUpdateOne {
+	return NewCartSnapshotClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the CartSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *CartSnapshot) Unwrap() *CartSnapshot {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CartSnapshot is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *CartSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("CartSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Version))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Items))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CartSnapshots is a parsable slice of CartSnapshot.
+type CartSnapshots []*CartSnapshot
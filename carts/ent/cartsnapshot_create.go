@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cart"
+	"carts/ent/cartsnapshot"
+	"carts/ent/schema"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CartSnapshotCreate is the builder for creating a CartSnapshot entity.
+type CartSnapshotCreate struct {
+	config
+	mutation *CartSnapshotMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (csc *CartSnapshotCreate) SetVersion(i int) *CartSnapshotCreate {
+	csc.mutation.SetVersion(i)
+	return csc
+}
+
+// SetItems sets the "items" field.
+func (csc *CartSnapshotCreate) SetItems(ssi []schema.CartSnapshotItem) *CartSnapshotCreate {
+	csc.mutation.SetItems(ssi)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *CartSnapshotCreate) SetCreatedAt(t time.Time) *CartSnapshotCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *CartSnapshotCreate) SetNillableCreatedAt(t *time.Time) *CartSnapshotCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *CartSnapshotCreate) SetID(u uuid.UUID) *CartSnapshotCreate {
+	csc.mutation.SetID(u)
+	return csc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (csc *CartSnapshotCreate) SetNillableID(u *uuid.UUID) *CartSnapshotCreate {
+	if u != nil {
+		csc.SetID(*u)
+	}
+	return csc
+}
+
+// SetCartID sets the "cart" edge to the Cart entity by ID.
+func (csc *CartSnapshotCreate) SetCartID(id uuid.UUID) *CartSnapshotCreate {
+	csc.mutation.SetCartID(id)
+	return csc
+}
+
+// SetCart sets the "cart" edge to the Cart entity.
+func (csc *CartSnapshotCreate) SetCart(c *Cart) *CartSnapshotCreate {
+	return csc.SetCartID(c.ID)
+}
+
+// Mutation returns the CartSnapshotMutation object of the builder.
+func (csc *CartSnapshotCreate) Mutation() *CartSnapshotMutation {
+	return csc.mutation
+}
+
+// Save creates the CartSnapshot in the database.
+func (csc *CartSnapshotCreate) Save(ctx context.Context) (*CartSnapshot, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *CartSnapshotCreate) SaveX(ctx context.Context) *CartSnapshot {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *CartSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *CartSnapshotCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *CartSnapshotCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := cartsnapshot.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := csc.mutation.ID(); !ok {
+		v := cartsnapshot.DefaultID()
+		csc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *CartSnapshotCreate) check() error {
+	if _, ok := csc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "CartSnapshot.version"`)}
+	}
+	if _, ok := csc.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "CartSnapshot.items"`)}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CartSnapshot.created_at"`)}
+	}
+	if len(csc.mutation.CartIDs()) == 0 {
+		return &ValidationError{Name: "cart", err: errors.New(`ent: missing required edge "CartSnapshot.cart"`)}
+	}
+	return nil
+}
+
+func (csc *CartSnapshotCreate) sqlSave(ctx context.Context) (*CartSnapshot, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *CartSnapshotCreate) createSpec() (*CartSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CartSnapshot{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(cartsnapshot.Table, sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID))
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.Version(); ok {
+		_spec.SetField(cartsnapshot.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := csc.mutation.Items(); ok {
+		_spec.SetField(cartsnapshot.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(cartsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := csc.mutation.CartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartsnapshot.CartTable,
+			Columns: []string{cartsnapshot.CartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.cart_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CartSnapshotCreateBulk is the builder for creating many CartSnapshot entities in bulk.
+type CartSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*CartSnapshotCreate
+}
+
+// Save creates the CartSnapshot entities in the database.
+func (cscb *CartSnapshotCreateBulk) Save(ctx context.Context) ([]*CartSnapshot, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*CartSnapshot, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CartSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *CartSnapshotCreateBulk) SaveX(ctx context.Context) []*CartSnapshot {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *CartSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *CartSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
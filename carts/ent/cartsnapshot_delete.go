@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cartsnapshot"
+	"carts/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CartSnapshotDelete is the builder for deleting a CartSnapshot entity.
+type CartSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *CartSnapshotMutation
+}
+
+// Where appends a list predicates to the CartSnapshotDelete builder.
+func (csd *CartSnapshotDelete) Where(ps ...predicate.CartSnapshot) *CartSnapshotDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *CartSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *CartSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *CartSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cartsnapshot.Table, sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// CartSnapshotDeleteOne is the builder for deleting a single CartSnapshot entity.
+type CartSnapshotDeleteOne struct {
+	csd *CartSnapshotDelete
+}
+
+// Where appends a list predicates to the CartSnapshotDelete builder.
+func (csdo *CartSnapshotDeleteOne) Where(ps ...predicate.CartSnapshot) *CartSnapshotDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *CartSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cartsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *CartSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cartsnapshot"
+	"carts/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CartSnapshotUpdate is the builder for updating CartSnapshot entities.
+type CartSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CartSnapshotMutation
+}
+
+// Where appends a list predicates to the CartSnapshotUpdate builder.
+func (csu *CartSnapshotUpdate) Where(ps ...predicate.CartSnapshot) *CartSnapshotUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// Mutation returns the CartSnapshotMutation object of the builder.
+func (csu *CartSnapshotUpdate) Mutation() *CartSnapshotMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *CartSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *CartSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *CartSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *CartSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *CartSnapshotUpdate) check() error {
+	if csu.mutation.CartCleared() && len(csu.mutation.CartIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartSnapshot.cart"`)
+	}
+	return nil
+}
+
+func (csu *CartSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := csu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartsnapshot.Table, cartsnapshot.Columns, sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// CartSnapshotUpdateOne is the builder for updating a single CartSnapshot entity.
+type CartSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CartSnapshotMutation
+}
+
+// Mutation returns the CartSnapshotMutation object of the builder.
+func (csuo *CartSnapshotUpdateOne) Mutation() *CartSnapshotMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the CartSnapshotUpdate builder.
+func (csuo *CartSnapshotUpdateOne) Where(ps ...predicate.CartSnapshot) *CartSnapshotUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *CartSnapshotUpdateOne) Select(field string, fields ...string) *CartSnapshotUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated CartSnapshot entity.
+func (csuo *CartSnapshotUpdateOne) Save(ctx context.Context) (*CartSnapshot, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *CartSnapshotUpdateOne) SaveX(ctx context.Context) *CartSnapshot {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *CartSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *CartSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *CartSnapshotUpdateOne) check() error {
+	if csuo.mutation.CartCleared() && len(csuo.mutation.CartIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartSnapshot.cart"`)
+	}
+	return nil
+}
+
+func (csuo *CartSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *CartSnapshot, err error) {
+	if err := csuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartsnapshot.Table, cartsnapshot.Columns, sqlgraph.NewFieldSpec(cartsnapshot.FieldID, field.TypeUUID))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CartSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cartsnapshot.FieldID)
+		for _, f := range fields {
+			if !cartsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cartsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &CartSnapshot{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
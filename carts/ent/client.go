@@ -13,6 +13,9 @@ import (
 
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
+	"carts/ent/wishlistitem"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -30,6 +33,12 @@ type Client struct {
 	Cart *CartClient
 	// CartItem is the client for interacting with the CartItem builders.
 	CartItem *CartItemClient
+	// CartSnapshot is the client for interacting with the CartSnapshot builders.
+	CartSnapshot *CartSnapshotClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -43,6 +52,9 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Cart = NewCartClient(c.config)
 	c.CartItem = NewCartItemClient(c.config)
+	c.CartSnapshot = NewCartSnapshotClient(c.config)
+	c.Coupon = NewCouponClient(c.config)
+	c.WishlistItem = NewWishlistItemClient(c.config)
 }
 
 type (
@@ -133,10 +145,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Cart:     NewCartClient(cfg),
-		CartItem: NewCartItemClient(cfg),
+		ctx:          ctx,
+		config:       cfg,
+		Cart:         NewCartClient(cfg),
+		CartItem:     NewCartItemClient(cfg),
+		CartSnapshot: NewCartSnapshotClient(cfg),
+		Coupon:       NewCouponClient(cfg),
+		WishlistItem: NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -154,10 +169,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Cart:     NewCartClient(cfg),
-		CartItem: NewCartItemClient(cfg),
+		ctx:          ctx,
+		config:       cfg,
+		Cart:         NewCartClient(cfg),
+		CartItem:     NewCartItemClient(cfg),
+		CartSnapshot: NewCartSnapshotClient(cfg),
+		Coupon:       NewCouponClient(cfg),
+		WishlistItem: NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -188,6 +206,9 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.Cart.Use(hooks...)
 	c.CartItem.Use(hooks...)
+	c.CartSnapshot.Use(hooks...)
+	c.Coupon.Use(hooks...)
+	c.WishlistItem.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
@@ -195,6 +216,9 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Cart.Intercept(interceptors...)
 	c.CartItem.Intercept(interceptors...)
+	c.CartSnapshot.Intercept(interceptors...)
+	c.Coupon.Intercept(interceptors...)
+	c.WishlistItem.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -204,6 +228,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Cart.mutate(ctx, m)
 	case *CartItemMutation:
 		return c.CartItem.mutate(ctx, m)
+	case *CartSnapshotMutation:
+		return c.CartSnapshot.mutate(ctx, m)
+	case *CouponMutation:
+		return c.Coupon.mutate(ctx, m)
+	case *WishlistItemMutation:
+		return c.WishlistItem.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -333,6 +363,38 @@ func (c *CartClient) QueryCartItems(ca *Cart) *CartItemQuery {
 	return query
 }
 
+// QuerySnapshots queries the snapshots edge of a Cart.
+func (c *CartClient) QuerySnapshots(ca *Cart) *CartSnapshotQuery {
+	query := (&CartSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, id),
+			sqlgraph.To(cartsnapshot.Table, cartsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cart.SnapshotsTable, cart.SnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCoupon queries the coupon edge of a Cart.
+func (c *CartClient) QueryCoupon(ca *Cart) *CouponQuery {
+	query := (&CouponClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, id),
+			sqlgraph.To(coupon.Table, coupon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cart.CouponTable, cart.CouponColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CartClient) Hooks() []Hook {
 	return c.hooks.Cart
@@ -474,7 +536,7 @@ func (c *CartItemClient) QueryCart(ci *CartItem) *CartQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(cartitem.Table, cartitem.FieldID, id),
 			sqlgraph.To(cart.Table, cart.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.CartTable, cartitem.CartColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.CartTable, cartitem.CartColumn),
 		)
 		fromV = sqlgraph.Neighbors(ci.driver.Dialect(), step)
 		return fromV, nil
@@ -507,12 +569,443 @@ func (c *CartItemClient) mutate(ctx context.Context, m *CartItemMutation) (Value
 	}
 }
 
+// CartSnapshotClient is a client for the CartSnapshot schema.
+type CartSnapshotClient struct {
+	config
+}
+
+// NewCartSnapshotClient returns a client for the CartSnapshot from the given config.
+func NewCartSnapshotClient(c config) *CartSnapshotClient {
+	return &CartSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cartsnapshot.Hooks(f(g(h())))`.
+func (c *CartSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.CartSnapshot = append(c.hooks.CartSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cartsnapshot.Intercept(f(g(h())))`.
+func (c *CartSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CartSnapshot = append(c.inters.CartSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a CartSnapshot entity.
+func (c *CartSnapshotClient) Create() *CartSnapshotCreate {
+	mutation := newCartSnapshotMutation(c.config, OpCreate)
+	return &CartSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CartSnapshot entities.
+func (c *CartSnapshotClient) CreateBulk(builders ...*CartSnapshotCreate) *CartSnapshotCreateBulk {
+	return &CartSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CartSnapshotClient) MapCreateBulk(slice any, setFunc func(*CartSnapshotCreate, int)) *CartSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CartSnapshotCreateBulk{err: fmt.Errorf("calling to CartSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CartSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CartSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CartSnapshot.
+func (c *CartSnapshotClient) Update() *CartSnapshotUpdate {
+	mutation := newCartSnapshotMutation(c.config, OpUpdate)
+	return &CartSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CartSnapshotClient) UpdateOne(cs *CartSnapshot) *CartSnapshotUpdateOne {
+	mutation := newCartSnapshotMutation(c.config, OpUpdateOne, withCartSnapshot(cs))
+	return &CartSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CartSnapshotClient) UpdateOneID(id uuid.UUID) *CartSnapshotUpdateOne {
+	mutation := newCartSnapshotMutation(c.config, OpUpdateOne, withCartSnapshotID(id))
+	return &CartSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CartSnapshot.
+func (c *CartSnapshotClient) Delete() *CartSnapshotDelete {
+	mutation := newCartSnapshotMutation(c.config, OpDelete)
+	return &CartSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CartSnapshotClient) DeleteOne(cs *CartSnapshot) *CartSnapshotDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CartSnapshotClient) DeleteOneID(id uuid.UUID) *CartSnapshotDeleteOne {
+	builder := c.Delete().Where(cartsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CartSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for CartSnapshot.
+func (c *CartSnapshotClient) Query() *CartSnapshotQuery {
+	return &CartSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCartSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CartSnapshot entity by its id.
+func (c *CartSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*CartSnapshot, error) {
+	return c.Query().Where(cartsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CartSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *CartSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCart queries the cart edge of a CartSnapshot.
+func (c *CartSnapshotClient) QueryCart(cs *CartSnapshot) *CartQuery {
+	query := (&CartClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cartsnapshot.Table, cartsnapshot.FieldID, id),
+			sqlgraph.To(cart.Table, cart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cartsnapshot.CartTable, cartsnapshot.CartColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CartSnapshotClient) Hooks() []Hook {
+	return c.hooks.CartSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *CartSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.CartSnapshot
+}
+
+func (c *CartSnapshotClient) mutate(ctx context.Context, m *CartSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CartSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CartSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CartSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CartSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CartSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// CouponClient is a client for the Coupon schema.
+type CouponClient struct {
+	config
+}
+
+// NewCouponClient returns a client for the Coupon from the given config.
+func NewCouponClient(c config) *CouponClient {
+	return &CouponClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coupon.Hooks(f(g(h())))`.
+func (c *CouponClient) Use(hooks ...Hook) {
+	c.hooks.Coupon = append(c.hooks.Coupon, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coupon.Intercept(f(g(h())))`.
+func (c *CouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Coupon = append(c.inters.Coupon, interceptors...)
+}
+
+// Create returns a builder for creating a Coupon entity.
+func (c *CouponClient) Create() *CouponCreate {
+	mutation := newCouponMutation(c.config, OpCreate)
+	return &CouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Coupon entities.
+func (c *CouponClient) CreateBulk(builders ...*CouponCreate) *CouponCreateBulk {
+	return &CouponCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CouponClient) MapCreateBulk(slice any, setFunc func(*CouponCreate, int)) *CouponCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CouponCreateBulk{err: fmt.Errorf("calling to CouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CouponCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CouponCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Coupon.
+func (c *CouponClient) Update() *CouponUpdate {
+	mutation := newCouponMutation(c.config, OpUpdate)
+	return &CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CouponClient) UpdateOne(co *Coupon) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCoupon(co))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CouponClient) UpdateOneID(id uuid.UUID) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCouponID(id))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Coupon.
+func (c *CouponClient) Delete() *CouponDelete {
+	mutation := newCouponMutation(c.config, OpDelete)
+	return &CouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CouponClient) DeleteOne(co *Coupon) *CouponDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CouponClient) DeleteOneID(id uuid.UUID) *CouponDeleteOne {
+	builder := c.Delete().Where(coupon.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CouponDeleteOne{builder}
+}
+
+// Query returns a query builder for Coupon.
+func (c *CouponClient) Query() *CouponQuery {
+	return &CouponQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoupon},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Coupon entity by its id.
+func (c *CouponClient) Get(ctx context.Context, id uuid.UUID) (*Coupon, error) {
+	return c.Query().Where(coupon.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CouponClient) GetX(ctx context.Context, id uuid.UUID) *Coupon {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCarts queries the carts edge of a Coupon.
+func (c *CouponClient) QueryCarts(co *Coupon) *CartQuery {
+	query := (&CartClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coupon.Table, coupon.FieldID, id),
+			sqlgraph.To(cart.Table, cart.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coupon.CartsTable, coupon.CartsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CouponClient) Hooks() []Hook {
+	return c.hooks.Coupon
+}
+
+// Interceptors returns the client interceptors.
+func (c *CouponClient) Interceptors() []Interceptor {
+	return c.inters.Coupon
+}
+
+func (c *CouponClient) mutate(ctx context.Context, m *CouponMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Coupon mutation op: %q", m.Op())
+	}
+}
+
+// WishlistItemClient is a client for the WishlistItem schema.
+type WishlistItemClient struct {
+	config
+}
+
+// NewWishlistItemClient returns a client for the WishlistItem from the given config.
+func NewWishlistItemClient(c config) *WishlistItemClient {
+	return &WishlistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wishlistitem.Hooks(f(g(h())))`.
+func (c *WishlistItemClient) Use(hooks ...Hook) {
+	c.hooks.WishlistItem = append(c.hooks.WishlistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wishlistitem.Intercept(f(g(h())))`.
+func (c *WishlistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WishlistItem = append(c.inters.WishlistItem, interceptors...)
+}
+
+// Create returns a builder for creating a WishlistItem entity.
+func (c *WishlistItemClient) Create() *WishlistItemCreate {
+	mutation := newWishlistItemMutation(c.config, OpCreate)
+	return &WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WishlistItem entities.
+func (c *WishlistItemClient) CreateBulk(builders ...*WishlistItemCreate) *WishlistItemCreateBulk {
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WishlistItemClient) MapCreateBulk(slice any, setFunc func(*WishlistItemCreate, int)) *WishlistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WishlistItemCreateBulk{err: fmt.Errorf("calling to WishlistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WishlistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WishlistItem.
+func (c *WishlistItemClient) Update() *WishlistItemUpdate {
+	mutation := newWishlistItemMutation(c.config, OpUpdate)
+	return &WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WishlistItemClient) UpdateOne(wi *WishlistItem) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItem(wi))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WishlistItemClient) UpdateOneID(id uuid.UUID) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItemID(id))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WishlistItem.
+func (c *WishlistItemClient) Delete() *WishlistItemDelete {
+	mutation := newWishlistItemMutation(c.config, OpDelete)
+	return &WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WishlistItemClient) DeleteOne(wi *WishlistItem) *WishlistItemDeleteOne {
+	return c.DeleteOneID(wi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WishlistItemClient) DeleteOneID(id uuid.UUID) *WishlistItemDeleteOne {
+	builder := c.Delete().Where(wishlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WishlistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for WishlistItem.
+func (c *WishlistItemClient) Query() *WishlistItemQuery {
+	return &WishlistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWishlistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WishlistItem entity by its id.
+func (c *WishlistItemClient) Get(ctx context.Context, id uuid.UUID) (*WishlistItem, error) {
+	return c.Query().Where(wishlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WishlistItemClient) GetX(ctx context.Context, id uuid.UUID) *WishlistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WishlistItemClient) Hooks() []Hook {
+	return c.hooks.WishlistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *WishlistItemClient) Interceptors() []Interceptor {
+	return c.inters.WishlistItem
+}
+
+func (c *WishlistItemClient) mutate(ctx context.Context, m *WishlistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WishlistItem mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Cart, CartItem []ent.Hook
+		Cart, CartItem, CartSnapshot, Coupon, WishlistItem []ent.Hook
 	}
 	inters struct {
-		Cart, CartItem []ent.Interceptor
+		Cart, CartItem, CartSnapshot, Coupon, WishlistItem []ent.Interceptor
 	}
 )
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/coupon"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Coupon is the model entity for the Coupon schema.
+type Coupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Code customers enter, stored upper-case
+	Code string `json:"code,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType coupon.DiscountType `json:"discount_type,omitempty"`
+	// Percentage off (1-100) for percent coupons, minor units off the cart's currency for fixed ones
+	Amount int64 `json:"amount,omitempty"`
+	// Coupon cannot be applied or checked out after this time; unset never expires
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Checkouts the coupon may be used for; unset is unlimited
+	MaxUses *int `json:"max_uses,omitempty"`
+	// Checkouts the coupon has been used for
+	UsedCount int `json:"used_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CouponQuery when eager-loading is set.
+	Edges        CouponEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CouponEdges holds the relations/edges for other nodes in the graph.
+type CouponEdges struct {
+	// Carts holds the value of the carts edge.
+	Carts []*Cart `json:"carts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CartsOrErr returns the Carts value or an error if the edge
+// was not loaded in eager-loading.
+func (e CouponEdges) CartsOrErr() ([]*Cart, error) {
+	if e.loadedTypes[0] {
+		return e.Carts, nil
+	}
+	return nil, &NotLoadedError{edge: "carts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Coupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldAmount, coupon.FieldMaxUses, coupon.FieldUsedCount:
+			values[i] = new(sql.NullInt64)
+		case coupon.FieldCode, coupon.FieldDiscountType:
+			values[i] = new(sql.NullString)
+		case coupon.FieldExpiresAt, coupon.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case coupon.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Coupon fields.
+func (c *Coupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case coupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				c.Code = value.String
+			}
+		case coupon.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				c.DiscountType = coupon.DiscountType(value.String)
+			}
+		case coupon.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				c.Amount = value.Int64
+			}
+		case coupon.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
+		case coupon.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				c.MaxUses = new(int)
+				*c.MaxUses = int(value.Int64)
+			}
+		case coupon.FieldUsedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_count", values[i])
+			} else if value.Valid {
+				c.UsedCount = int(value.Int64)
+			}
+		case coupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// This includes values selected through modifiers, order, etc.
+func (c *Coupon) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryCarts queries the "carts" edge of the Coupon entity.
+func (c *Coupon) QueryCarts() *CartQuery {
+	return NewCouponClient(c.config).QueryCarts(c)
+}
+
+// Update returns a builder for updating this Coupon.
+// Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Coupon) Update() *CouponUpdateOne {
+	return NewCouponClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Coupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Coupon) Unwrap() *Coupon {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Coupon is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Coupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Coupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("code=")
+	builder.WriteString(c.Code)
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(fmt.Sprintf("%v", c.DiscountType))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", c.Amount))
+	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.MaxUses; v != nil {
+		builder.WriteString("max_uses=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("used_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.UsedCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Coupons is a parsable slice of Coupon.
+type Coupons []*Coupon
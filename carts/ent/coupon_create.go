@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cart"
+	"carts/ent/coupon"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CouponCreate is the builder for creating a Coupon entity.
+type CouponCreate struct {
+	config
+	mutation *CouponMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (cc *CouponCreate) SetCode(s string) *CouponCreate {
+	cc.mutation.SetCode(s)
+	return cc
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (cc *CouponCreate) SetDiscountType(ct coupon.DiscountType) *CouponCreate {
+	cc.mutation.SetDiscountType(ct)
+	return cc
+}
+
+// SetAmount sets the "amount" field.
+func (cc *CouponCreate) SetAmount(i int64) *CouponCreate {
+	cc.mutation.SetAmount(i)
+	return cc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cc *CouponCreate) SetExpiresAt(t time.Time) *CouponCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableExpiresAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cc *CouponCreate) SetMaxUses(i int) *CouponCreate {
+	cc.mutation.SetMaxUses(i)
+	return cc
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableMaxUses(i *int) *CouponCreate {
+	if i != nil {
+		cc.SetMaxUses(*i)
+	}
+	return cc
+}
+
+// SetUsedCount sets the "used_count" field.
+func (cc *CouponCreate) SetUsedCount(i int) *CouponCreate {
+	cc.mutation.SetUsedCount(i)
+	return cc
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableUsedCount(i *int) *CouponCreate {
+	if i != nil {
+		cc.SetUsedCount(*i)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CouponCreate) SetCreatedAt(t time.Time) *CouponCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableCreatedAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CouponCreate) SetID(u uuid.UUID) *CouponCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableID(u *uuid.UUID) *CouponCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (cc *CouponCreate) AddCartIDs(ids ...uuid.UUID) *CouponCreate {
+	cc.mutation.AddCartIDs(ids...)
+	return cc
+}
+
+// AddCarts adds the "carts" edges to the Cart entity.
+func (cc *CouponCreate) AddCarts(c ...*Cart) *CouponCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddCartIDs(ids...)
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cc *CouponCreate) Mutation() *CouponMutation {
+	return cc.mutation
+}
+
+// Save creates the Coupon in the database.
+func (cc *CouponCreate) Save(ctx context.Context) (*Coupon, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CouponCreate) SaveX(ctx context.Context) *Coupon {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CouponCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CouponCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CouponCreate) defaults() {
+	if _, ok := cc.mutation.UsedCount(); !ok {
+		v := coupon.DefaultUsedCount
+		cc.mutation.SetUsedCount(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := coupon.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := coupon.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CouponCreate) check() error {
+	if _, ok := cc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Coupon.code"`)}
+	}
+	if v, ok := cc.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discount_type", err: errors.New(`ent: missing required field "Coupon.discount_type"`)}
+	}
+	if v, ok := cc.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Coupon.amount"`)}
+	}
+	if v, ok := cc.mutation.Amount(); ok {
+		if err := coupon.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Coupon.amount": %w`, err)}
+		}
+	}
+	if v, ok := cc.mutation.MaxUses(); ok {
+		if err := coupon.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Coupon.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.UsedCount(); !ok {
+		return &ValidationError{Name: "used_count", err: errors.New(`ent: missing required field "Coupon.used_count"`)}
+	}
+	if v, ok := cc.mutation.UsedCount(); ok {
+		if err := coupon.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Coupon.used_count": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Coupon.created_at"`)}
+	}
+	return nil
+}
+
+func (cc *CouponCreate) sqlSave(ctx context.Context) (*Coupon, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CouponCreate) createSpec() (*Coupon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Coupon{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(coupon.Table, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := cc.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+		_node.DiscountType = value
+	}
+	if value, ok := cc.mutation.Amount(); ok {
+		_spec.SetField(coupon.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := cc.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := cc.mutation.UsedCount(); ok {
+		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+		_node.UsedCount = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.CartsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CouponCreateBulk is the builder for creating many Coupon entities in bulk.
+type CouponCreateBulk struct {
+	config
+	err      error
+	builders []*CouponCreate
+}
+
+// Save creates the Coupon entities in the database.
+func (ccb *CouponCreateBulk) Save(ctx context.Context) ([]*Coupon, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Coupon, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CouponCreateBulk) SaveX(ctx context.Context) []*Coupon {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CouponCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CouponCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/cart"
+	"carts/ent/coupon"
+	"carts/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CouponUpdate is the builder for updating Coupon entities.
+type CouponUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cu *CouponUpdate) Where(ps ...predicate.Coupon) *CouponUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CouponUpdate) SetExpiresAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableExpiresAt(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CouponUpdate) ClearExpiresAt() *CouponUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cu *CouponUpdate) SetMaxUses(i int) *CouponUpdate {
+	cu.mutation.ResetMaxUses()
+	cu.mutation.SetMaxUses(i)
+	return cu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableMaxUses(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetMaxUses(*i)
+	}
+	return cu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cu *CouponUpdate) AddMaxUses(i int) *CouponUpdate {
+	cu.mutation.AddMaxUses(i)
+	return cu
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cu *CouponUpdate) ClearMaxUses() *CouponUpdate {
+	cu.mutation.ClearMaxUses()
+	return cu
+}
+
+// SetUsedCount sets the "used_count" field.
+func (cu *CouponUpdate) SetUsedCount(i int) *CouponUpdate {
+	cu.mutation.ResetUsedCount()
+	cu.mutation.SetUsedCount(i)
+	return cu
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableUsedCount(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetUsedCount(*i)
+	}
+	return cu
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (cu *CouponUpdate) AddUsedCount(i int) *CouponUpdate {
+	cu.mutation.AddUsedCount(i)
+	return cu
+}
+
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (cu *CouponUpdate) AddCartIDs(ids ...uuid.UUID) *CouponUpdate {
+	cu.mutation.AddCartIDs(ids...)
+	return cu
+}
+
+// AddCarts adds the "carts" edges to the Cart entity.
+func (cu *CouponUpdate) AddCarts(c ...*Cart) *CouponUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddCartIDs(ids...)
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cu *CouponUpdate) Mutation() *CouponMutation {
+	return cu.mutation
+}
+
+// ClearCarts clears all "carts" edges to the Cart entity.
+func (cu *CouponUpdate) ClearCarts() *CouponUpdate {
+	cu.mutation.ClearCarts()
+	return cu
+}
+
+// RemoveCartIDs removes the "carts" edge to Cart entities by IDs.
+func (cu *CouponUpdate) RemoveCartIDs(ids ...uuid.UUID) *CouponUpdate {
+	cu.mutation.RemoveCartIDs(ids...)
+	return cu
+}
+
+// RemoveCarts removes "carts" edges to Cart entities.
+func (cu *CouponUpdate) RemoveCarts(c ...*Cart) *CouponUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveCartIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CouponUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CouponUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CouponUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CouponUpdate) check() error {
+	if v, ok := cu.mutation.MaxUses(); ok {
+		if err := coupon.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Coupon.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.UsedCount(); ok {
+		if err := coupon.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Coupon.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if cu.mutation.MaxUsesCleared() {
+		_spec.ClearField(coupon.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := cu.mutation.UsedCount(); ok {
+		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedUsedCount(); ok {
+		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if cu.mutation.CartsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedCartsIDs(); len(nodes) > 0 && !cu.mutation.CartsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CartsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CouponUpdateOne is the builder for updating a single Coupon entity.
+type CouponUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CouponUpdateOne) SetExpiresAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableExpiresAt(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CouponUpdateOne) ClearExpiresAt() *CouponUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cuo *CouponUpdateOne) SetMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.ResetMaxUses()
+	cuo.mutation.SetMaxUses(i)
+	return cuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableMaxUses(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetMaxUses(*i)
+	}
+	return cuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cuo *CouponUpdateOne) AddMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.AddMaxUses(i)
+	return cuo
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cuo *CouponUpdateOne) ClearMaxUses() *CouponUpdateOne {
+	cuo.mutation.ClearMaxUses()
+	return cuo
+}
+
+// SetUsedCount sets the "used_count" field.
+func (cuo *CouponUpdateOne) SetUsedCount(i int) *CouponUpdateOne {
+	cuo.mutation.ResetUsedCount()
+	cuo.mutation.SetUsedCount(i)
+	return cuo
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableUsedCount(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetUsedCount(*i)
+	}
+	return cuo
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (cuo *CouponUpdateOne) AddUsedCount(i int) *CouponUpdateOne {
+	cuo.mutation.AddUsedCount(i)
+	return cuo
+}
+
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (cuo *CouponUpdateOne) AddCartIDs(ids ...uuid.UUID) *CouponUpdateOne {
+	cuo.mutation.AddCartIDs(ids...)
+	return cuo
+}
+
+// AddCarts adds the "carts" edges to the Cart entity.
+func (cuo *CouponUpdateOne) AddCarts(c ...*Cart) *CouponUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddCartIDs(ids...)
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cuo *CouponUpdateOne) Mutation() *CouponMutation {
+	return cuo.mutation
+}
+
+// ClearCarts clears all "carts" edges to the Cart entity.
+func (cuo *CouponUpdateOne) ClearCarts() *CouponUpdateOne {
+	cuo.mutation.ClearCarts()
+	return cuo
+}
+
+// RemoveCartIDs removes the "carts" edge to Cart entities by IDs.
+func (cuo *CouponUpdateOne) RemoveCartIDs(ids ...uuid.UUID) *CouponUpdateOne {
+	cuo.mutation.RemoveCartIDs(ids...)
+	return cuo
+}
+
+// RemoveCarts removes "carts" edges to Cart entities.
+func (cuo *CouponUpdateOne) RemoveCarts(c ...*Cart) *CouponUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveCartIDs(ids...)
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cuo *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CouponUpdateOne) Select(field string, fields ...string) *CouponUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Coupon entity.
+func (cuo *CouponUpdateOne) Save(ctx context.Context) (*Coupon, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CouponUpdateOne) SaveX(ctx context.Context) *Coupon {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CouponUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CouponUpdateOne) check() error {
+	if v, ok := cuo.mutation.MaxUses(); ok {
+		if err := coupon.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Coupon.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.UsedCount(); ok {
+		if err := coupon.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Coupon.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Coupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupon.FieldID)
+		for _, f := range fields {
+			if !coupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if cuo.mutation.MaxUsesCleared() {
+		_spec.ClearField(coupon.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.UsedCount(); ok {
+		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedUsedCount(); ok {
+		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if cuo.mutation.CartsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedCartsIDs(); len(nodes) > 0 && !cuo.mutation.CartsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CartsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coupon.CartsTable,
+			Columns: []string{coupon.CartsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Coupon{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -5,6 +5,9 @@ package ent
 import (
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
+	"carts/ent/wishlistitem"
 	"context"
 	"errors"
 	"fmt"
@@ -74,8 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			cart.Table:     cart.ValidColumn,
-			cartitem.Table: cartitem.ValidColumn,
+			cart.Table:         cart.ValidColumn,
+			cartitem.Table:     cartitem.ValidColumn,
+			cartsnapshot.Table: cartsnapshot.ValidColumn,
+			coupon.Table:       coupon.ValidColumn,
+			wishlistitem.Table: wishlistitem.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
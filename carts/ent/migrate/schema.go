@@ -18,23 +18,34 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "checked_out_at", Type: field.TypeTime, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
 		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "coupon_carts", Type: field.TypeUUID, Nullable: true},
 	}
 	// CartsTable holds the schema information for the "carts" table.
 	CartsTable = &schema.Table{
 		Name:       "carts",
 		Columns:    CartsColumns,
 		PrimaryKey: []*schema.Column{CartsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "carts_coupons_carts",
+				Columns:    []*schema.Column{CartsColumns[10]},
+				RefColumns: []*schema.Column{CouponsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// CartItemsColumns holds the columns for the "cart_items" table.
 	CartItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "product_name", Type: field.TypeString, Nullable: true},
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "cart_cart_items", Type: field.TypeUUID, Nullable: true},
-		{Name: "cart_item_cart", Type: field.TypeUUID},
+		{Name: "cart_cart_items", Type: field.TypeUUID},
 	}
 	// CartItemsTable holds the schema information for the "cart_items" table.
 	CartItemsTable = &schema.Table{
@@ -44,15 +55,76 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "cart_items_carts_cart_items",
-				Columns:    []*schema.Column{CartItemsColumns[5]},
+				Columns:    []*schema.Column{CartItemsColumns[6]},
 				RefColumns: []*schema.Column{CartsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+		},
+	}
+	// CartSnapshotsColumns holds the columns for the "cart_snapshots" table.
+	CartSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "items", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "cart_snapshots", Type: field.TypeUUID},
+	}
+	// CartSnapshotsTable holds the schema information for the "cart_snapshots" table.
+	CartSnapshotsTable = &schema.Table{
+		Name:       "cart_snapshots",
+		Columns:    CartSnapshotsColumns,
+		PrimaryKey: []*schema.Column{CartSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "cart_items_carts_cart",
-				Columns:    []*schema.Column{CartItemsColumns[6]},
+				Symbol:     "cart_snapshots_carts_snapshots",
+				Columns:    []*schema.Column{CartSnapshotsColumns[4]},
 				RefColumns: []*schema.Column{CartsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cartsnapshot_version_cart_snapshots",
+				Unique:  false,
+				Columns: []*schema.Column{CartSnapshotsColumns[1], CartSnapshotsColumns[4]},
+			},
+		},
+	}
+	// CouponsColumns holds the columns for the "coupons" table.
+	CouponsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "discount_type", Type: field.TypeEnum, Enums: []string{"percent", "fixed"}},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CouponsTable holds the schema information for the "coupons" table.
+	CouponsTable = &schema.Table{
+		Name:       "coupons",
+		Columns:    CouponsColumns,
+		PrimaryKey: []*schema.Column{CouponsColumns[0]},
+	}
+	// WishlistItemsColumns holds the columns for the "wishlist_items" table.
+	WishlistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "product_name", Type: field.TypeString, Nullable: true},
+		{Name: "added_at", Type: field.TypeTime},
+	}
+	// WishlistItemsTable holds the schema information for the "wishlist_items" table.
+	WishlistItemsTable = &schema.Table{
+		Name:       "wishlist_items",
+		Columns:    WishlistItemsColumns,
+		PrimaryKey: []*schema.Column{WishlistItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlistitem_user_id_product_id",
+				Unique:  true,
+				Columns: []*schema.Column{WishlistItemsColumns[1], WishlistItemsColumns[2]},
 			},
 		},
 	}
@@ -60,16 +132,29 @@ var (
 	Tables = []*schema.Table{
 		CartsTable,
 		CartItemsTable,
+		CartSnapshotsTable,
+		CouponsTable,
+		WishlistItemsTable,
 	}
 )
 
 func init() {
+	CartsTable.ForeignKeys[0].RefTable = CouponsTable
 	CartsTable.Annotation = &entsql.Annotation{
 		Table: "carts",
 	}
 	CartItemsTable.ForeignKeys[0].RefTable = CartsTable
-	CartItemsTable.ForeignKeys[1].RefTable = CartsTable
 	CartItemsTable.Annotation = &entsql.Annotation{
 		Table: "cart_items",
 	}
+	CartSnapshotsTable.ForeignKeys[0].RefTable = CartsTable
+	CartSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "cart_snapshots",
+	}
+	CouponsTable.Annotation = &entsql.Annotation{
+		Table: "coupons",
+	}
+	WishlistItemsTable.Annotation = &entsql.Annotation{
+		Table: "wishlist_items",
+	}
 }
@@ -11,3 +11,12 @@ type Cart func(*sql.Selector)
 
 // CartItem is the predicate function for cartitem builders.
 type CartItem func(*sql.Selector)
+
+// CartSnapshot is the predicate function for cartsnapshot builders.
+type CartSnapshot func(*sql.Selector)
+
+// Coupon is the predicate function for coupon builders.
+type Coupon func(*sql.Selector)
+
+// WishlistItem is the predicate function for wishlistitem builders.
+type WishlistItem func(*sql.Selector)
@@ -5,7 +5,10 @@ package ent
 import (
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	"carts/ent/coupon"
 	"carts/ent/schema"
+	"carts/ent/wishlistitem"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,7 +41,7 @@ func init() {
 	// cart.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	cart.UpdateDefaultUpdatedAt = cartDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// cartDescVersion is the schema descriptor for version field.
-	cartDescVersion := cartFields[7].Descriptor()
+	cartDescVersion := cartFields[9].Descriptor()
 	// cart.DefaultVersion holds the default value on creation for the version field.
 	cart.DefaultVersion = cartDescVersion.Default.(int)
 	// cartDescID is the schema descriptor for id field.
@@ -48,15 +51,15 @@ func init() {
 	cartitemFields := schema.CartItem{}.Fields()
 	_ = cartitemFields
 	// cartitemDescQuantity is the schema descriptor for quantity field.
-	cartitemDescQuantity := cartitemFields[2].Descriptor()
+	cartitemDescQuantity := cartitemFields[3].Descriptor()
 	// cartitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	cartitem.QuantityValidator = cartitemDescQuantity.Validators[0].(func(int) error)
 	// cartitemDescCreatedAt is the schema descriptor for created_at field.
-	cartitemDescCreatedAt := cartitemFields[3].Descriptor()
+	cartitemDescCreatedAt := cartitemFields[4].Descriptor()
 	// cartitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	cartitem.DefaultCreatedAt = cartitemDescCreatedAt.Default.(func() time.Time)
 	// cartitemDescUpdatedAt is the schema descriptor for updated_at field.
-	cartitemDescUpdatedAt := cartitemFields[4].Descriptor()
+	cartitemDescUpdatedAt := cartitemFields[5].Descriptor()
 	// cartitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	cartitem.DefaultUpdatedAt = cartitemDescUpdatedAt.Default.(func() time.Time)
 	// cartitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -65,4 +68,52 @@ func init() {
 	cartitemDescID := cartitemFields[0].Descriptor()
 	// cartitem.DefaultID holds the default value on creation for the id field.
 	cartitem.DefaultID = cartitemDescID.Default.(func() uuid.UUID)
+	cartsnapshotFields := schema.CartSnapshot{}.Fields()
+	_ = cartsnapshotFields
+	// cartsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	cartsnapshotDescCreatedAt := cartsnapshotFields[3].Descriptor()
+	// cartsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cartsnapshot.DefaultCreatedAt = cartsnapshotDescCreatedAt.Default.(func() time.Time)
+	// cartsnapshotDescID is the schema descriptor for id field.
+	cartsnapshotDescID := cartsnapshotFields[0].Descriptor()
+	// cartsnapshot.DefaultID holds the default value on creation for the id field.
+	cartsnapshot.DefaultID = cartsnapshotDescID.Default.(func() uuid.UUID)
+	couponFields := schema.Coupon{}.Fields()
+	_ = couponFields
+	// couponDescCode is the schema descriptor for code field.
+	couponDescCode := couponFields[1].Descriptor()
+	// coupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	coupon.CodeValidator = couponDescCode.Validators[0].(func(string) error)
+	// couponDescAmount is the schema descriptor for amount field.
+	couponDescAmount := couponFields[3].Descriptor()
+	// coupon.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	coupon.AmountValidator = couponDescAmount.Validators[0].(func(int64) error)
+	// couponDescMaxUses is the schema descriptor for max_uses field.
+	couponDescMaxUses := couponFields[5].Descriptor()
+	// coupon.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	coupon.MaxUsesValidator = couponDescMaxUses.Validators[0].(func(int) error)
+	// couponDescUsedCount is the schema descriptor for used_count field.
+	couponDescUsedCount := couponFields[6].Descriptor()
+	// coupon.DefaultUsedCount holds the default value on creation for the used_count field.
+	coupon.DefaultUsedCount = couponDescUsedCount.Default.(int)
+	// coupon.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	coupon.UsedCountValidator = couponDescUsedCount.Validators[0].(func(int) error)
+	// couponDescCreatedAt is the schema descriptor for created_at field.
+	couponDescCreatedAt := couponFields[7].Descriptor()
+	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupon.DefaultCreatedAt = couponDescCreatedAt.Default.(func() time.Time)
+	// couponDescID is the schema descriptor for id field.
+	couponDescID := couponFields[0].Descriptor()
+	// coupon.DefaultID holds the default value on creation for the id field.
+	coupon.DefaultID = couponDescID.Default.(func() uuid.UUID)
+	wishlistitemFields := schema.WishlistItem{}.Fields()
+	_ = wishlistitemFields
+	// wishlistitemDescAddedAt is the schema descriptor for added_at field.
+	wishlistitemDescAddedAt := wishlistitemFields[4].Descriptor()
+	// wishlistitem.DefaultAddedAt holds the default value on creation for the added_at field.
+	wishlistitem.DefaultAddedAt = wishlistitemDescAddedAt.Default.(func() time.Time)
+	// wishlistitemDescID is the schema descriptor for id field.
+	wishlistitemDescID := wishlistitemFields[0].Descriptor()
+	// wishlistitem.DefaultID holds the default value on creation for the id field.
+	wishlistitem.DefaultID = wishlistitemDescID.Default.(func() uuid.UUID)
 }
@@ -28,6 +28,8 @@ func (Cart) Fields() []ent.Field {
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional().Nillable().Comment("soft delete timestamp"),
+		field.Time("checked_out_at").Optional().Nillable().Comment("Set when the cart was checked out into an order"),
+		field.String("region").Optional().Nillable().Immutable().Comment("Region of the service that created the cart; unset outside multi-region deployments"),
 		field.Int("version").Default(1).Comment("Optimistic lock version"),
 	}
 }
@@ -38,6 +40,13 @@ func (Cart) Edges() []ent.Edge {
 		// A cart has many cart items
 		edge.To("cart_items", CartItem.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// A cart keeps snapshots of its past contents
+		edge.To("snapshots", CartSnapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// A cart has at most one coupon applied
+		edge.From("coupon", Coupon.Type).
+			Ref("carts").
+			Unique(),
 	}
 }
 
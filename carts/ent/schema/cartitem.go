@@ -21,6 +21,7 @@ func (CartItem) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.UUID("product_id", uuid.UUID{}).Comment("Reference to the product"),
+		field.String("product_name").Optional().Comment("Product name snapshot taken when the item was added"),
 		field.Int("quantity").Positive(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -31,7 +32,7 @@ func (CartItem) Fields() []ent.Field {
 func (CartItem) Edges() []ent.Edge {
 	return []ent.Edge{
 		// A cart item belongs to one cart
-		edge.To("cart", Cart.Type).Unique().Required(),
+		edge.From("cart", Cart.Type).Ref("cart_items").Unique().Required(),
 	}
 }
 
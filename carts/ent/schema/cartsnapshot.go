@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CartSnapshotItem is a cart item as it was when the snapshot was taken
+type CartSnapshotItem struct {
+	ProductID   uuid.UUID `json:"product_id"`
+	ProductName string    `json:"product_name,omitempty"`
+	Quantity    int       `json:"quantity"`
+}
+
+// CartSnapshot holds the schema definition for the CartSnapshot entity.
+// It records a cart's items at one version so support can see its past contents.
+type CartSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the CartSnapshot.
+func (CartSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Int("version").Immutable().Comment("Cart version the items belong to"),
+		field.JSON("items", []CartSnapshotItem{}).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the CartSnapshot.
+func (CartSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		// A snapshot belongs to one cart
+		edge.From("cart", Cart.Type).Ref("snapshots").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the CartSnapshot.
+func (CartSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		// Snapshots are listed and pruned per cart, newest first
+		index.Fields("version").Edges("cart"),
+	}
+}
+
+// Annotations of the CartSnapshot.
+func (CartSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "cart_snapshots",
+		},
+	}
+}
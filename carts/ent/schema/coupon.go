@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Coupon holds the schema definition for the Coupon entity.
+// A coupon takes a percentage or a fixed amount off the subtotal of the carts it is applied
+// to, and counts a use each time one of them is checked out.
+type Coupon struct {
+	ent.Schema
+}
+
+// Fields of the Coupon.
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("code").NotEmpty().Unique().Immutable().Comment("Code customers enter, stored upper-case"),
+		field.Enum("discount_type").Values("percent", "fixed").Immutable(),
+		field.Int64("amount").Positive().Immutable().Comment("Percentage off (1-100) for percent coupons, minor units off the cart's currency for fixed ones"),
+		field.Time("expires_at").Optional().Nillable().Comment("Coupon cannot be applied or checked out after this time; unset never expires"),
+		field.Int("max_uses").Optional().Nillable().Positive().Comment("Checkouts the coupon may be used for; unset is unlimited"),
+		field.Int("used_count").Default(0).NonNegative().Comment("Checkouts the coupon has been used for"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Coupon.
+func (Coupon) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Carts the coupon is applied to
+		edge.To("carts", Cart.Type),
+	}
+}
+
+// Annotations of the Coupon.
+func (Coupon) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "coupons",
+		},
+	}
+}
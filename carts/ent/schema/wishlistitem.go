@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WishlistItem holds the schema definition for the WishlistItem entity.
+// A user's wishlist keeps products saved for later, outliving the cart they came from.
+type WishlistItem struct {
+	ent.Schema
+}
+
+// Fields of the WishlistItem.
+func (WishlistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).Immutable().Comment("Reference to the user who owns the wishlist"),
+		field.UUID("product_id", uuid.UUID{}).Immutable().Comment("Reference to the product"),
+		field.String("product_name").Optional().Comment("Product name snapshot carried over from the cart item"),
+		field.Time("added_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WishlistItem.
+func (WishlistItem) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WishlistItem.
+func (WishlistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// A product is on a user's wishlist at most once
+		index.Fields("user_id", "product_id").Unique(),
+	}
+}
+
+// Annotations of the WishlistItem.
+func (WishlistItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "wishlist_items",
+		},
+	}
+}
@@ -16,6 +16,12 @@ type Tx struct {
 	Cart *CartClient
 	// CartItem is the client for interacting with the CartItem builders.
 	CartItem *CartItemClient
+	// CartSnapshot is the client for interacting with the CartSnapshot builders.
+	CartSnapshot *CartSnapshotClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,6 +155,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Cart = NewCartClient(tx.config)
 	tx.CartItem = NewCartItemClient(tx.config)
+	tx.CartSnapshot = NewCartSnapshotClient(tx.config)
+	tx.Coupon = NewCouponClient(tx.config)
+	tx.WishlistItem = NewWishlistItemClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/wishlistitem"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WishlistItem is the model entity for the WishlistItem schema.
+type WishlistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the user who owns the wishlist
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Reference to the product
+	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// Product name snapshot carried over from the cart item
+	ProductName string `json:"product_name,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt      time.Time `json:"added_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WishlistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldProductName:
+			values[i] = new(sql.NullString)
+		case wishlistitem.FieldAddedAt:
+			values[i] = new(sql.NullTime)
+		case wishlistitem.FieldID, wishlistitem.FieldUserID, wishlistitem.FieldProductID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WishlistItem fields.
+func (wi *WishlistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wi.ID = *value
+			}
+		case wishlistitem.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				wi.UserID = *value
+			}
+		case wishlistitem.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				wi.ProductID = *value
+			}
+		case wishlistitem.FieldProductName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name", values[i])
+			} else if value.Valid {
+				wi.ProductName = value.String
+			}
+		case wishlistitem.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				wi.AddedAt = value.Time
+			}
+		default:
+			wi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WishlistItem.
+// This includes values selected through modifiers, order, etc.
+func (wi *WishlistItem) Value(name string) (ent.Value, error) {
+	return wi.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WishlistItem.
+// Note that you need to call WishlistItem.Unwrap() before calling this method if this WishlistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wi *WishlistItem) Update() *WishlistItemUpdateOne {
+	return NewWishlistItemClient(wi.config).UpdateOne(wi)
+}
+
+// Unwrap unwraps the WishlistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wi *WishlistItem) Unwrap() *WishlistItem {
+	_tx, ok := wi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WishlistItem is not a transactional entity")
+	}
+	wi.config.driver = _tx.drv
+	return wi
+}
+
+// String implements the fmt.Stringer.
+func (wi *WishlistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("WishlistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wi.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", wi.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", wi.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("product_name=")
+	builder.WriteString(wi.ProductName)
+	builder.WriteString(", ")
+	builder.WriteString("added_at=")
+	builder.WriteString(wi.AddedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WishlistItems is a parsable slice of WishlistItem.
+type WishlistItems []*WishlistItem
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/wishlistitem"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WishlistItemCreate is the builder for creating a WishlistItem entity.
+type WishlistItemCreate struct {
+	config
+	mutation *WishlistItemMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (wic *WishlistItemCreate) SetUserID(u uuid.UUID) *WishlistItemCreate {
+	wic.mutation.SetUserID(u)
+	return wic
+}
+
+// SetProductID sets the "product_id" field.
+func (wic *WishlistItemCreate) SetProductID(u uuid.UUID) *WishlistItemCreate {
+	wic.mutation.SetProductID(u)
+	return wic
+}
+
+// SetProductName sets the "product_name" field.
+func (wic *WishlistItemCreate) SetProductName(s string) *WishlistItemCreate {
+	wic.mutation.SetProductName(s)
+	return wic
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (wic *WishlistItemCreate) SetNillableProductName(s *string) *WishlistItemCreate {
+	if s != nil {
+		wic.SetProductName(*s)
+	}
+	return wic
+}
+
+// SetAddedAt sets the "added_at" field.
+func (wic *WishlistItemCreate) SetAddedAt(t time.Time) *WishlistItemCreate {
+	wic.mutation.SetAddedAt(t)
+	return wic
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (wic *WishlistItemCreate) SetNillableAddedAt(t *time.Time) *WishlistItemCreate {
+	if t != nil {
+		wic.SetAddedAt(*t)
+	}
+	return wic
+}
+
+// SetID sets the "id" field.
+func (wic *WishlistItemCreate) SetID(u uuid.UUID) *WishlistItemCreate {
+	wic.mutation.SetID(u)
+	return wic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wic *WishlistItemCreate) SetNillableID(u *uuid.UUID) *WishlistItemCreate {
+	if u != nil {
+		wic.SetID(*u)
+	}
+	return wic
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (wic *WishlistItemCreate) Mutation() *WishlistItemMutation {
+	return wic.mutation
+}
+
+// Save creates the WishlistItem in the database.
+func (wic *WishlistItemCreate) Save(ctx context.Context) (*WishlistItem, error) {
+	wic.defaults()
+	return withHooks(ctx, wic.sqlSave, wic.mutation, wic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wic *WishlistItemCreate) SaveX(ctx context.Context) *WishlistItem {
+	v, err := wic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wic *WishlistItemCreate) Exec(ctx context.Context) error {
+	_, err := wic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wic *WishlistItemCreate) ExecX(ctx context.Context) {
+	if err := wic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wic *WishlistItemCreate) defaults() {
+	if _, ok := wic.mutation.AddedAt(); !ok {
+		v := wishlistitem.DefaultAddedAt()
+		wic.mutation.SetAddedAt(v)
+	}
+	if _, ok := wic.mutation.ID(); !ok {
+		v := wishlistitem.DefaultID()
+		wic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wic *WishlistItemCreate) check() error {
+	if _, ok := wic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WishlistItem.user_id"`)}
+	}
+	if _, ok := wic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "WishlistItem.product_id"`)}
+	}
+	if _, ok := wic.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "WishlistItem.added_at"`)}
+	}
+	return nil
+}
+
+func (wic *WishlistItemCreate) sqlSave(ctx context.Context) (*WishlistItem, error) {
+	if err := wic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wic.mutation.id = &_node.ID
+	wic.mutation.done = true
+	return _node, nil
+}
+
+func (wic *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WishlistItem{config: wic.config}
+		_spec = sqlgraph.NewCreateSpec(wishlistitem.Table, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := wic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wic.mutation.UserID(); ok {
+		_spec.SetField(wishlistitem.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := wic.mutation.ProductID(); ok {
+		_spec.SetField(wishlistitem.FieldProductID, field.TypeUUID, value)
+		_node.ProductID = value
+	}
+	if value, ok := wic.mutation.ProductName(); ok {
+		_spec.SetField(wishlistitem.FieldProductName, field.TypeString, value)
+		_node.ProductName = value
+	}
+	if value, ok := wic.mutation.AddedAt(); ok {
+		_spec.SetField(wishlistitem.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	return _node, _spec
+}
+
+// WishlistItemCreateBulk is the builder for creating many WishlistItem entities in bulk.
+type WishlistItemCreateBulk struct {
+	config
+	err      error
+	builders []*WishlistItemCreate
+}
+
+// Save creates the WishlistItem entities in the database.
+func (wicb *WishlistItemCreateBulk) Save(ctx context.Context) ([]*WishlistItem, error) {
+	if wicb.err != nil {
+		return nil, wicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wicb.builders))
+	nodes := make([]*WishlistItem, len(wicb.builders))
+	mutators := make([]Mutator, len(wicb.builders))
+	for i := range wicb.builders {
+		func(i int, root context.Context) {
+			builder := wicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WishlistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wicb *WishlistItemCreateBulk) SaveX(ctx context.Context) []*WishlistItem {
+	v, err := wicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wicb *WishlistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := wicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wicb *WishlistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := wicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
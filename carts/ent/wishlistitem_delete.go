@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/predicate"
+	"carts/ent/wishlistitem"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WishlistItemDelete is the builder for deleting a WishlistItem entity.
+type WishlistItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// Where appends a list predicates to the WishlistItemDelete builder.
+func (wid *WishlistItemDelete) Where(ps ...predicate.WishlistItem) *WishlistItemDelete {
+	wid.mutation.Where(ps...)
+	return wid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wid *WishlistItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wid.sqlExec, wid.mutation, wid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wid *WishlistItemDelete) ExecX(ctx context.Context) int {
+	n, err := wid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wid *WishlistItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wishlistitem.Table, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	if ps := wid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wid.mutation.done = true
+	return affected, err
+}
+
+// WishlistItemDeleteOne is the builder for deleting a single WishlistItem entity.
+type WishlistItemDeleteOne struct {
+	wid *WishlistItemDelete
+}
+
+// Where appends a list predicates to the WishlistItemDelete builder.
+func (wido *WishlistItemDeleteOne) Where(ps ...predicate.WishlistItem) *WishlistItemDeleteOne {
+	wido.wid.mutation.Where(ps...)
+	return wido
+}
+
+// Exec executes the deletion query.
+func (wido *WishlistItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := wido.wid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wishlistitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wido *WishlistItemDeleteOne) ExecX(ctx context.Context) {
+	if err := wido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"carts/ent/predicate"
+	"carts/ent/wishlistitem"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WishlistItemUpdate is the builder for updating WishlistItem entities.
+type WishlistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (wiu *WishlistItemUpdate) Where(ps ...predicate.WishlistItem) *WishlistItemUpdate {
+	wiu.mutation.Where(ps...)
+	return wiu
+}
+
+// SetProductName sets the "product_name" field.
+func (wiu *WishlistItemUpdate) SetProductName(s string) *WishlistItemUpdate {
+	wiu.mutation.SetProductName(s)
+	return wiu
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (wiu *WishlistItemUpdate) SetNillableProductName(s *string) *WishlistItemUpdate {
+	if s != nil {
+		wiu.SetProductName(*s)
+	}
+	return wiu
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (wiu *WishlistItemUpdate) ClearProductName() *WishlistItemUpdate {
+	wiu.mutation.ClearProductName()
+	return wiu
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (wiu *WishlistItemUpdate) Mutation() *WishlistItemMutation {
+	return wiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wiu *WishlistItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wiu.sqlSave, wiu.mutation, wiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wiu *WishlistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := wiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wiu *WishlistItemUpdate) Exec(ctx context.Context) error {
+	_, err := wiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wiu *WishlistItemUpdate) ExecX(ctx context.Context) {
+	if err := wiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	if ps := wiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wiu.mutation.ProductName(); ok {
+		_spec.SetField(wishlistitem.FieldProductName, field.TypeString, value)
+	}
+	if wiu.mutation.ProductNameCleared() {
+		_spec.ClearField(wishlistitem.FieldProductName, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wiu.mutation.done = true
+	return n, nil
+}
+
+// WishlistItemUpdateOne is the builder for updating a single WishlistItem entity.
+type WishlistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// SetProductName sets the "product_name" field.
+func (wiuo *WishlistItemUpdateOne) SetProductName(s string) *WishlistItemUpdateOne {
+	wiuo.mutation.SetProductName(s)
+	return wiuo
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (wiuo *WishlistItemUpdateOne) SetNillableProductName(s *string) *WishlistItemUpdateOne {
+	if s != nil {
+		wiuo.SetProductName(*s)
+	}
+	return wiuo
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (wiuo *WishlistItemUpdateOne) ClearProductName() *WishlistItemUpdateOne {
+	wiuo.mutation.ClearProductName()
+	return wiuo
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (wiuo *WishlistItemUpdateOne) Mutation() *WishlistItemMutation {
+	return wiuo.mutation
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (wiuo *WishlistItemUpdateOne) Where(ps ...predicate.WishlistItem) *WishlistItemUpdateOne {
+	wiuo.mutation.Where(ps...)
+	return wiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wiuo *WishlistItemUpdateOne) Select(field string, fields ...string) *WishlistItemUpdateOne {
+	wiuo.fields = append([]string{field}, fields...)
+	return wiuo
+}
+
+// Save executes the query and returns the updated WishlistItem entity.
+func (wiuo *WishlistItemUpdateOne) Save(ctx context.Context) (*WishlistItem, error) {
+	return withHooks(ctx, wiuo.sqlSave, wiuo.mutation, wiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wiuo *WishlistItemUpdateOne) SaveX(ctx context.Context) *WishlistItem {
+	node, err := wiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wiuo *WishlistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := wiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wiuo *WishlistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := wiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WishlistItem, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	id, ok := wiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WishlistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.FieldID)
+		for _, f := range fields {
+			if !wishlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wishlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wiuo.mutation.ProductName(); ok {
+		_spec.SetField(wishlistitem.FieldProductName, field.TypeString, value)
+	}
+	if wiuo.mutation.ProductNameCleared() {
+		_spec.ClearField(wishlistitem.FieldProductName, field.TypeString)
+	}
+	_node = &WishlistItem{config: wiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wiuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go-micro.dev/v5/logger"
@@ -10,26 +11,44 @@ import (
 	"carts/ent"
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/predicate"
 	pb "carts/proto"
+
+	productspb "products/proto"
 )
 
 // AdminService implements the AdminServiceServer interface
 type AdminService struct {
 	EntClient *ent.Client
+	// Products prices cart lines for GetUsersCartValue; nil disables that RPC
+	Products productspb.ProductService
+	// Purger hard-deletes carts past the soft-delete retention for PurgeDeletedCarts; nil disables that RPC
+	Purger *CartPurger
+	// Snapshots takes on-demand snapshots for SnapshotCart; nil disables that RPC
+	Snapshots *CartSnapshots
 }
 
 // ListCarts lists all carts with optional filtering and pagination
 func (h *AdminService) ListCarts(ctx context.Context, req *pb.ListCartsRequest, rsp *pb.ListCartsResponse) error {
-	logger.Infof("Received ListCarts request (limit: %d, offset: %d, user_id: %s, include_deleted: %v)", req.Limit, req.Offset, req.UserId, req.IncludeDeleted)
-
-	query := h.EntClient.Cart.Query().WithCartItems()
+	logger.Extract(ctx).Infof("Received ListCarts request (limit: %d, offset: %d, user_id: %s, include_deleted: %v)", req.Limit, req.Offset, req.UserId, req.IncludeDeleted)
 
+	// Filters apply to both the page and the total count
+	var preds []predicate.Cart
 	if req.UserId != "" {
-		query.Where(cart.UserID(uuid.MustParse(req.UserId)))
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return fmt.Errorf("invalid user_id: %v", err)
+		}
+		preds = append(preds, cart.UserID(userID))
 	}
 	if !req.IncludeDeleted {
-		query.Where(cart.DeletedAtIsNil())
+		preds = append(preds, cart.DeletedAtIsNil())
 	}
+	if req.Region != "" {
+		preds = append(preds, cart.Region(req.Region))
+	}
+
+	query := h.EntClient.Cart.Query().Where(preds...).WithCartItems()
 
 	if req.Limit > 0 {
 		query.Limit(int(req.Limit))
@@ -40,20 +59,12 @@ func (h *AdminService) ListCarts(ctx context.Context, req *pb.ListCartsRequest,
 
 	carts, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to list carts: %v", err)
+		logger.Extract(ctx).Errorf("Failed to list carts: %v", err)
 		return fmt.Errorf("failed to list carts: %w", err)
 	}
-	q := h.EntClient.Cart.Query()
-	if req.UserId != "" {
-		userId, err := uuid.Parse(req.UserId)
-		if err != nil {
-			fmt.Errorf("invalid user Id, %v", err)
-		}
-		q = q.Where(cart.UserID(userId))
-	}
-	total, err := q.Count(ctx)
+	total, err := h.EntClient.Cart.Query().Where(preds...).Count(ctx)
 	if err != nil {
-		logger.Errorf("Failed to count carts: %v", err)
+		logger.Extract(ctx).Errorf("Failed to count carts: %v", err)
 		return fmt.Errorf("failed to count carts: %w", err)
 	}
 
@@ -64,18 +75,18 @@ func (h *AdminService) ListCarts(ctx context.Context, req *pb.ListCartsRequest,
 
 	rsp.Carts = protoCarts
 	rsp.Total = int32(total)
-	logger.Infof("Listed %d carts (total: %d)", len(protoCarts), total)
+	logger.Extract(ctx).Infof("Listed %d carts (total: %d)", len(protoCarts), total)
 	return nil
 }
 
 // ForceDeleteCart permanently deletes a cart and its items (admin privilege)
 func (h *AdminService) ForceDeleteCart(ctx context.Context, req *pb.ForceDeleteCartRequest, rsp *pb.ForceDeleteCartResponse) error {
-	logger.Infof("Received ForceDeleteCart request for ID: %s (Admin operation)", req.Id)
+	logger.Extract(ctx).Infof("Received ForceDeleteCart request for ID: %s (Admin operation)", req.Id)
 
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction for force delete: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction for force delete: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -85,60 +96,60 @@ func (h *AdminService) ForceDeleteCart(ctx context.Context, req *pb.ForceDeleteC
 		Where(cartitem.HasCartWith(cart.ID(uuid.MustParse(req.Id)))).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to delete cart items for cart %s: %v", req.Id, err)
+		logger.Extract(ctx).Errorf("Failed to delete cart items for cart %s: %v", req.Id, err)
 		return fmt.Errorf("failed to delete cart items: %w", err)
 	}
 
 	// Delete cart
 	err = tx.Cart.DeleteOneID(uuid.MustParse(req.Id)).Exec(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found for deletion: %s", req.Id)
+		logger.Extract(ctx).Infof("Cart not found for deletion: %s", req.Id)
 		rsp.Success = false
 		return fmt.Errorf("cart not found for deletion: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to force delete cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to force delete cart: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to force delete cart: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction for force delete: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction for force delete: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	rsp.Id = req.Id
 	rsp.Success = true
-	logger.Infof("Cart force deleted successfully: %s", req.Id)
+	logger.Extract(ctx).Infof("Cart force deleted successfully: %s", req.Id)
 	return nil
 }
 
 // RestoreCart restores a soft-deleted cart
 func (h *AdminService) RestoreCart(ctx context.Context, req *pb.RestoreCartRequest, rsp *pb.RestoreCartResponse) error {
-	logger.Infof("Received RestoreCart request for ID: %s (Admin operation)", req.Id)
+	logger.Extract(ctx).Infof("Received RestoreCart request for ID: %s (Admin operation)", req.Id)
 
 	c, err := h.EntClient.Cart.UpdateOneID(uuid.MustParse(req.Id)).
 		ClearDeletedAt().
 		AddVersion(1).
 		Save(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found for restoration: %s", req.Id)
+		logger.Extract(ctx).Infof("Cart not found for restoration: %s", req.Id)
 		return fmt.Errorf("cart not found for restoration")
 	}
 	if err != nil {
-		logger.Errorf("Failed to restore cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to restore cart: %v", err)
 		return fmt.Errorf("failed to restore cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(c)
-	logger.Infof("Cart restored successfully: %s", req.Id)
+	logger.Extract(ctx).Infof("Cart restored successfully: %s", req.Id)
 	return nil
 }
 
 // ExportCarts streams all carts, optionally filtered and paginated
 func (h *AdminService) ExportCarts(ctx context.Context, req *pb.ExportCartsRequest, stream pb.AdminService_ExportCartsStream) error {
-	logger.Infof("Received ExportCarts stream request (limit: %d, offset: %d, user_id: %s, include_deleted: %v)", req.Limit, req.Offset, req.UserId, req.IncludeDeleted)
+	logger.Extract(ctx).Infof("Received ExportCarts stream request (limit: %d, offset: %d, user_id: %s, include_deleted: %v)", req.Limit, req.Offset, req.UserId, req.IncludeDeleted)
 
 	query := h.EntClient.Cart.Query().WithCartItems()
 
@@ -158,18 +169,150 @@ func (h *AdminService) ExportCarts(ctx context.Context, req *pb.ExportCartsReque
 
 	carts, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to retrieve carts for export: %v", err)
+		logger.Extract(ctx).Errorf("Failed to retrieve carts for export: %v", err)
 		return fmt.Errorf("failed to retrieve carts for export: %w", err)
 	}
 
 	for _, c := range carts {
 		protoCart := toProtoCart(c)
 		if err := stream.Send(protoCart); err != nil {
-			logger.Errorf("Error sending cart %s during export: %v", c.ID, err)
+			logger.Extract(ctx).Errorf("Error sending cart %s during export: %v", c.ID, err)
 			return fmt.Errorf("failed to stream cart: %w", err)
 		}
 	}
 
-	logger.Infof("Successfully exported %d carts.", len(carts))
+	logger.Extract(ctx).Infof("Successfully exported %d carts.", len(carts))
+	return nil
+}
+
+// GetUsersCartValue sums the value of each listed user's active cart at current catalog prices
+func (h *AdminService) GetUsersCartValue(ctx context.Context, req *pb.GetUsersCartValueRequest, rsp *pb.GetUsersCartValueResponse) error {
+	logger.Extract(ctx).Infof("Received GetUsersCartValue request for %d users (Admin operation)", len(req.UserIds))
+
+	if h.Products == nil {
+		return fmt.Errorf("product lookups are disabled")
+	}
+
+	userIDs := make([]uuid.UUID, len(req.UserIds))
+	values := make(map[uuid.UUID]*pb.UserCartValue, len(req.UserIds))
+	for i, id := range req.UserIds {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("invalid user_id: %s", id)
+		}
+		userIDs[i] = userID
+		values[userID] = &pb.UserCartValue{UserId: userID.String()}
+	}
+
+	carts, err := h.EntClient.Cart.Query().
+		Where(
+			cart.UserIDIn(userIDs...),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		WithCartItems().
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query carts for value: %v", err)
+		return fmt.Errorf("failed to query carts: %w", err)
+	}
+
+	// Look each product up once, remembering missing ones as nil
+	prices := make(map[uuid.UUID]*productspb.Product)
+	for _, c := range carts {
+		v := values[c.UserID]
+		for _, item := range c.Edges.CartItems {
+			p, seen := prices[item.ProductID]
+			if !seen {
+				p, err = lookupProduct(ctx, h.Products, item.ProductID.String())
+				if err != nil && !isProductNotFound(err) {
+					logger.Extract(ctx).Errorf("Failed to price product %s: %v", item.ProductID, err)
+					return err
+				}
+				prices[item.ProductID] = p
+			}
+			if p == nil {
+				v.MissingItems++
+				continue
+			}
+			v.TotalValueCents += int64(item.Quantity) * unitPrice(p, item.Quantity)
+			v.ItemCount++
+		}
+	}
+	for _, v := range values {
+		v.TotalValue = float64(v.TotalValueCents) / 100
+	}
+
+	rsp.Values = make([]*pb.UserCartValue, 0, len(userIDs))
+	for _, userID := range userIDs {
+		rsp.Values = append(rsp.Values, values[userID])
+	}
+	logger.Extract(ctx).Infof("Computed cart values for %d users from %d carts", len(rsp.Values), len(carts))
+	return nil
+}
+
+// GetConversionStats reports how many carts created since req.Since were checked out or abandoned
+func (h *AdminService) GetConversionStats(ctx context.Context, req *pb.GetConversionStatsRequest, rsp *pb.GetConversionStatsResponse) error {
+	logger.Extract(ctx).Infof("Received GetConversionStats request (since: %d) (Admin operation)", req.Since)
+
+	since := time.Unix(req.Since, 0)
+	created := cart.CreatedAtGTE(since)
+
+	total, err := h.EntClient.Cart.Query().Where(created).Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count created carts: %v", err)
+		return fmt.Errorf("failed to count created carts: %w", err)
+	}
+
+	checkedOut, err := h.EntClient.Cart.Query().
+		Where(created, cart.CheckedOutAtNotNil()).
+		Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count checked out carts: %v", err)
+		return fmt.Errorf("failed to count checked out carts: %w", err)
+	}
+
+	// A cart is abandoned once it expires with items in it without having been checked out
+	abandoned, err := h.EntClient.Cart.Query().
+		Where(
+			created,
+			cart.CheckedOutAtIsNil(),
+			cart.ExpiresAtLT(time.Now()),
+			cart.HasCartItems(),
+		).
+		Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count abandoned carts: %v", err)
+		return fmt.Errorf("failed to count abandoned carts: %w", err)
+	}
+
+	rsp.Created = int32(total)
+	rsp.CheckedOut = int32(checkedOut)
+	rsp.Abandoned = int32(abandoned)
+	if total > 0 {
+		rsp.ConversionRate = float64(checkedOut) / float64(total)
+		rsp.AbandonmentRate = float64(abandoned) / float64(total)
+	}
+	logger.Extract(ctx).Infof("Conversion stats since %s: %d created, %d checked out, %d abandoned", since.Format(time.RFC3339), total, checkedOut, abandoned)
+	return nil
+}
+
+// PurgeDeletedCarts hard-deletes carts soft-deleted for longer than the retention window (admin privilege)
+func (h *AdminService) PurgeDeletedCarts(ctx context.Context, req *pb.PurgeDeletedCartsRequest, rsp *pb.PurgeDeletedCartsResponse) error {
+	logger.Extract(ctx).Infof("Received PurgeDeletedCarts request (Admin operation)")
+
+	if h.Purger == nil || h.Purger.Retention <= 0 {
+		return fmt.Errorf("cart purging is disabled")
+	}
+
+	purged, err := h.Purger.Purge(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to purge deleted carts after purging %d: %v", purged, err)
+		return fmt.Errorf("failed to purge deleted carts: %w", err)
+	}
+
+	rsp.Purged = int32(purged)
+	rsp.Retention = int64(h.Purger.Retention / time.Second)
+	logger.Extract(ctx).Infof("Purged %d carts deleted more than %s ago", purged, h.Purger.Retention)
 	return nil
 }
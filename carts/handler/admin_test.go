@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"carts/ent/cartitem"
+	pb "carts/proto"
+
+	productspb "products/proto"
+)
+
+func TestGetUsersCartValueSumsEachUsersCart(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	mug, pen, gone := uuid.New(), uuid.New(), uuid.New()
+	h := &AdminService{EntClient: client, Products: &fakeProducts{catalog: map[string]*productspb.Product{
+		mug.String(): {Id: mug.String(), PriceCents: 1250},
+		pen.String(): {Id: pen.String(), PriceCents: 199},
+	}}}
+
+	first := createTestCart(t, client, mug, pen)
+	client.CartItem.Update().Where(cartitem.ProductID(mug)).SetQuantity(2).ExecX(ctx)
+	second := createTestCart(t, client, pen, gone)
+	noCart := uuid.New()
+
+	rsp := &pb.GetUsersCartValueResponse{}
+	err := h.GetUsersCartValue(ctx, &pb.GetUsersCartValueRequest{
+		UserIds: []string{first.UserID.String(), second.UserID.String(), noCart.String()},
+	}, rsp)
+	if err != nil {
+		t.Fatalf("GetUsersCartValue: %v", err)
+	}
+
+	want := []struct {
+		cents   int64
+		items   int32
+		missing int32
+	}{
+		{2*1250 + 199, 2, 0},
+		{199, 1, 1},
+		{0, 0, 0},
+	}
+	if len(rsp.Values) != len(want) {
+		t.Fatalf("expected %d values, got %d", len(want), len(rsp.Values))
+	}
+	for i, w := range want {
+		v := rsp.Values[i]
+		if v.TotalValueCents != w.cents || v.ItemCount != w.items || v.MissingItems != w.missing {
+			t.Errorf("user %d: got %d cents over %d items with %d missing, want %d cents over %d items with %d missing",
+				i, v.TotalValueCents, v.ItemCount, v.MissingItems, w.cents, w.items, w.missing)
+		}
+	}
+}
+
+func TestGetConversionStats(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	carts := &CartService{EntClient: client}
+	since := time.Now().Add(-time.Hour)
+
+	checkedOut := createTestCart(t, client, uuid.New())
+	err := carts.CheckoutCart(ctx, &pb.CheckoutCartRequest{Id: checkedOut.ID.String(), Version: int32(checkedOut.Version)}, &pb.CheckoutCartResponse{})
+	if err != nil {
+		t.Fatalf("CheckoutCart: %v", err)
+	}
+	abandoned := createTestCart(t, client, uuid.New())
+	client.Cart.UpdateOne(abandoned).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	expiredEmpty := createTestCart(t, client)
+	client.Cart.UpdateOne(expiredEmpty).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	createTestCart(t, client, uuid.New()) // still active
+	client.Cart.Create().
+		SetUserID(uuid.New()).
+		SetCreatedAt(since.Add(-time.Hour)).
+		SetCheckedOutAt(since.Add(-time.Minute)).
+		ExecX(ctx) // before the period
+
+	rsp := &pb.GetConversionStatsResponse{}
+	if err := (&AdminService{EntClient: client}).GetConversionStats(ctx, &pb.GetConversionStatsRequest{Since: since.Unix()}, rsp); err != nil {
+		t.Fatalf("GetConversionStats: %v", err)
+	}
+	if rsp.Created != 4 || rsp.CheckedOut != 1 || rsp.Abandoned != 1 {
+		t.Fatalf("expected 4 created, 1 checked out and 1 abandoned, got %d, %d and %d", rsp.Created, rsp.CheckedOut, rsp.Abandoned)
+	}
+	if rsp.ConversionRate != 0.25 || rsp.AbandonmentRate != 0.25 {
+		t.Fatalf("expected both rates 0.25, got %v and %v", rsp.ConversionRate, rsp.AbandonmentRate)
+	}
+
+	empty := &pb.GetConversionStatsResponse{}
+	if err := (&AdminService{EntClient: client}).GetConversionStats(ctx, &pb.GetConversionStatsRequest{Since: time.Now().Add(time.Hour).Unix()}, empty); err != nil {
+		t.Fatalf("GetConversionStats: %v", err)
+	}
+	if empty.Created != 0 || empty.ConversionRate != 0 {
+		t.Fatalf("expected no carts and a zero rate, got %v", empty)
+	}
+}
+
+func TestListCartsTotalMatchesDeletedFilter(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &AdminService{EntClient: client}
+
+	for i := 0; i < 3; i++ {
+		createTestCart(t, client)
+	}
+	for i := 0; i < 2; i++ {
+		c := createTestCart(t, client)
+		client.Cart.UpdateOne(c).SetDeletedAt(time.Now()).ExecX(ctx)
+	}
+
+	rsp := &pb.ListCartsResponse{}
+	if err := h.ListCarts(ctx, &pb.ListCartsRequest{Limit: 1}, rsp); err != nil {
+		t.Fatalf("ListCarts: %v", err)
+	}
+	if len(rsp.Carts) != 1 || rsp.Total != 3 {
+		t.Fatalf("expected a page of 1 of 3 live carts, got %d of %d", len(rsp.Carts), rsp.Total)
+	}
+
+	rsp = &pb.ListCartsResponse{}
+	if err := h.ListCarts(ctx, &pb.ListCartsRequest{Limit: 1, IncludeDeleted: true}, rsp); err != nil {
+		t.Fatalf("ListCarts: %v", err)
+	}
+	if len(rsp.Carts) != 1 || rsp.Total != 5 {
+		t.Fatalf("expected a page of 1 of 5 carts including deleted, got %d of %d", len(rsp.Carts), rsp.Total)
+	}
+
+	if err := h.ListCarts(ctx, &pb.ListCartsRequest{UserId: "not-a-uuid"}, &pb.ListCartsResponse{}); err == nil {
+		t.Fatal("expected a malformed user_id to be rejected")
+	}
+}
+
+func TestCreatedCartsCarryRegionAndListFiltersByIt(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	create := func(region string) *pb.Cart {
+		t.Helper()
+		h := &CartService{EntClient: client, Region: region}
+		rsp := &pb.GetOrCreateCartResponse{}
+		if err := h.GetOrCreateCart(ctx, &pb.GetOrCreateCartRequest{UserId: uuid.NewString()}, rsp); err != nil {
+			t.Fatalf("GetOrCreateCart in %q: %v", region, err)
+		}
+		return rsp.Cart
+	}
+
+	eu := create("eu-west")
+	create("us-east")
+	if unset := create(""); unset.Region != "" {
+		t.Fatalf("expected no region without one configured, got %q", unset.Region)
+	}
+	if eu.Region != "eu-west" {
+		t.Fatalf("expected the cart stamped with eu-west, got %q", eu.Region)
+	}
+
+	h := &AdminService{EntClient: client}
+	rsp := &pb.ListCartsResponse{}
+	if err := h.ListCarts(ctx, &pb.ListCartsRequest{Region: "eu-west"}, rsp); err != nil {
+		t.Fatalf("ListCarts: %v", err)
+	}
+	if rsp.Total != 1 || len(rsp.Carts) != 1 || rsp.Carts[0].Id != eu.Id {
+		t.Fatalf("expected only cart %s in eu-west, got %d", eu.Id, rsp.Total)
+	}
+	all := &pb.ListCartsResponse{}
+	if err := h.ListCarts(ctx, &pb.ListCartsRequest{}, all); err != nil {
+		t.Fatalf("ListCarts: %v", err)
+	}
+	if all.Total != 3 {
+		t.Fatalf("expected every cart without a region filter, got %d", all.Total)
+	}
+}
@@ -3,29 +3,76 @@ package handler
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
 	"go-micro.dev/v5/logger"
 
 	"carts/ent"
 	"carts/ent/cart"
 	"carts/ent/cartitem"
+	"carts/ent/coupon"
 	pb "carts/proto"
+	productspb "products/proto"
 )
 
 // CartService implements the CartServiceServer interface
 type CartService struct {
 	EntClient *ent.Client
+	// Products validates that added items exist in the catalog; nil disables the check
+	Products productspb.ProductService
+	// TTL is how long a cart stays active after its last activity; zero uses DefaultCartTTL
+	TTL time.Duration
+	// MaxItemQuantity caps the quantity of a single cart item; zero uses DefaultMaxItemQuantity
+	MaxItemQuantity int
+	// Region is stamped on created carts; empty leaves them without one
+	Region string
+}
+
+// DefaultMaxItemQuantity is the largest quantity of a single cart item unless configured otherwise
+const DefaultMaxItemQuantity = 999
+
+// DefaultCartTTL is how long a cart stays active after its last activity unless configured otherwise
+const DefaultCartTTL = 7 * 24 * time.Hour
+
+// expiresAt returns the expiry of a cart that is active now
+func (h *CartService) expiresAt() time.Time {
+	if h.TTL > 0 {
+		return time.Now().Add(h.TTL)
+	}
+	return time.Now().Add(DefaultCartTTL)
+}
+
+// maxItemQuantity returns the configured cap on a single cart item's quantity
+func (h *CartService) maxItemQuantity() int {
+	if h.MaxItemQuantity > 0 {
+		return h.MaxItemQuantity
+	}
+	return DefaultMaxItemQuantity
+}
+
+// checkItemQuantity rejects a resulting item quantity above the cap or, when p is known,
+// above the product's available stock
+func (h *CartService) checkItemQuantity(quantity int, p *productspb.Product) error {
+	if max := h.maxItemQuantity(); quantity > max {
+		return fmt.Errorf("quantity %d exceeds the maximum of %d per item", quantity, max)
+	}
+	if p != nil && quantity > int(p.StockQuantity) {
+		return fmt.Errorf("quantity %d exceeds available stock of %d for product %s", quantity, p.StockQuantity, p.Id)
+	}
+	return nil
 }
 
 // GetOrCreateCart gets an existing cart or creates a new one for the user
 func (h *CartService) GetOrCreateCart(ctx context.Context, req *pb.GetOrCreateCartRequest, rsp *pb.GetOrCreateCartResponse) error {
-	logger.Infof("Received GetOrCreateCart request for user_id: %s", req.UserId)
+	logger.Extract(ctx).Infof("Received GetOrCreateCart request for user_id: %s", req.UserId)
 
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
-		logger.Errorf("Invalid user_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid user_id format: %v", err)
 		return fmt.Errorf("invalid user_id format: %w", err)
 	}
 
@@ -42,96 +89,272 @@ func (h *CartService) GetOrCreateCart(ctx context.Context, req *pb.GetOrCreateCa
 		// Update last activity
 		c, err = h.EntClient.Cart.UpdateOneID(c.ID).
 			SetLastActivityAt(time.Now()).
-			SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+			SetExpiresAt(h.expiresAt()).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("Failed to update cart activity: %v", err)
+			logger.Extract(ctx).Errorf("Failed to update cart activity: %v", err)
 			return fmt.Errorf("failed to update cart: %w", err)
 		}
 		rsp.Cart = toProtoCart(c)
-		logger.Infof("Retrieved existing cart: %s", c.ID)
+		logger.Extract(ctx).Infof("Retrieved existing cart: %s", c.ID)
 		return nil
 	}
 	if !ent.IsNotFound(err) {
-		logger.Errorf("Failed to query cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
 		return fmt.Errorf("failed to query cart: %w", err)
 	}
 
 	// Create new cart
-	c, err = h.EntClient.Cart.Create().
+	create := h.EntClient.Cart.Create().
 		SetUserID(userID).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
-		SetLastActivityAt(time.Now()).
-		Save(ctx)
+		SetExpiresAt(h.expiresAt()).
+		SetLastActivityAt(time.Now())
+	if h.Region != "" {
+		create.SetRegion(h.Region)
+	}
+	c, err = create.Save(ctx)
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation: %v", err)
+		logger.Extract(ctx).Errorf("Constraint violation: %v", err)
 		return fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to create cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to create cart: %v", err)
 		return fmt.Errorf("failed to create cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(c)
-	logger.Infof("Created new cart: %s", c.ID)
+	logger.Extract(ctx).Infof("Created new cart: %s", c.ID)
 	return nil
 }
 
-// GetCart fetches a cart by ID
-func (h *CartService) GetCart(ctx context.Context, req *pb.GetCartRequest, rsp *pb.GetCartResponse) error {
-	logger.Infof("Received GetCart request for ID: %s", req.Id)
+// GetActiveCart returns the user's active cart for read-only views. It neither creates a
+// cart nor counts as cart activity, and returns NotFound when the user has no active cart.
+func (h *CartService) GetActiveCart(ctx context.Context, req *pb.GetActiveCartRequest, rsp *pb.GetActiveCartResponse) error {
+	logger.Extract(ctx).Infof("Received GetActiveCart request for user_id: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid user_id format: %v", err)
+		return fmt.Errorf("invalid user_id format: %w", err)
+	}
 
 	c, err := h.EntClient.Cart.Query().
 		Where(
-			cart.ID(uuid.MustParse(req.Id)),
+			cart.UserID(userID),
 			cart.DeletedAtIsNil(),
 			cart.ExpiresAtGT(time.Now()),
 		).
 		WithCartItems().
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found or expired: %s", req.Id)
-		return fmt.Errorf("cart not found or expired")
+		logger.Extract(ctx).Infof("No active cart for user_id: %s", req.UserId)
+		return errors.NotFound("carts.GetActiveCart", "no active cart")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
+		return fmt.Errorf("failed to query cart: %w", err)
+	}
+
+	rsp.Cart = toProtoCart(c)
+	logger.Extract(ctx).Infof("Active cart fetched successfully: %s", c.ID)
+	return nil
+}
+
+// GetCartItemCount counts the items of a user's active cart in a single aggregate query,
+// e.g. for a cart badge. Unlike GetActiveCart it loads no items, doesn't count as cart
+// activity, and reports zero rather than an error when the user has no active cart.
+func (h *CartService) GetCartItemCount(ctx context.Context, req *pb.GetCartItemCountRequest, rsp *pb.GetCartItemCountResponse) error {
+	logger.Extract(ctx).Infof("Received GetCartItemCount request for user_id: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid user_id format: %v", err)
+		return fmt.Errorf("invalid user_id format: %w", err)
+	}
+
+	var counts []struct {
+		Quantity int `json:"quantity"`
+		Items    int `json:"count"`
+	}
+	err = h.EntClient.CartItem.Query().
+		Where(cartitem.HasCartWith(
+			cart.UserID(userID),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		)).
+		Aggregate(
+			func(s *sql.Selector) string {
+				return sql.As("COALESCE("+sql.Sum(s.C(cartitem.FieldQuantity))+", 0)", "quantity")
+			},
+			ent.Count(),
+		).
+		Scan(ctx, &counts)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count cart items of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to count cart items: %w", err)
 	}
+
+	if len(counts) > 0 {
+		rsp.ItemCount = int32(counts[0].Quantity)
+		rsp.DistinctItemCount = int32(counts[0].Items)
+	}
+	return nil
+}
+
+// GetCart fetches a cart by ID
+func (h *CartService) GetCart(ctx context.Context, req *pb.GetCartRequest, rsp *pb.GetCartResponse) error {
+	logger.Extract(ctx).Infof("Received GetCart request for ID: %s", req.Id)
+
+	cartID, err := uuid.Parse(req.Id)
 	if err != nil {
-		logger.Errorf("Failed to get cart: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart ID format: %v", err)
+		return errors.BadRequest("carts.GetCart", "invalid cart ID format: %v", err)
+	}
+
+	// Read the cart whatever its state, so an unavailable one can be told apart from a missing one
+	c, err := h.EntClient.Cart.Query().
+		Where(cart.ID(cartID)).
+		WithCartItems().
+		WithCoupon().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found: %s", req.Id)
+		return errors.NotFound("carts.GetCart", "cart not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get cart: %v", err)
 		return fmt.Errorf("failed to get cart: %w", err)
 	}
+	ctx = withLogFields(ctx, map[string]interface{}{"user_id": c.UserID.String()})
+	if err := cartUnavailable("carts.GetCart", c, time.Now()); err != nil {
+		logger.Extract(ctx).Infof("Cart %s is unavailable: %v", req.Id, err)
+		return err
+	}
 
 	// Update last activity
-	c, err = h.EntClient.Cart.UpdateOneID(c.ID).
+	updated, err := h.EntClient.Cart.UpdateOneID(c.ID).
 		SetLastActivityAt(time.Now()).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetExpiresAt(h.expiresAt()).
 		Save(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart activity: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart activity: %v", err)
 		return fmt.Errorf("failed to update cart: %w", err)
 	}
+	// The update returns the cart without its items and coupon
+	updated.Edges = c.Edges
+
+	rsp.Cart = toProtoCart(updated)
+	logger.Extract(ctx).Infof("Cart fetched successfully: %s", c.ID)
+	return nil
+}
+
+// cartUnavailable returns a FailedPrecondition (412) error saying why an existing cart can
+// no longer be used as of now, or nil if it can. A deleted cart can be restored, so it is
+// reported as deleted even once it has also expired; an expired one can only be replaced.
+func cartUnavailable(id string, c *ent.Cart, now time.Time) error {
+	switch {
+	case c.DeletedAt != nil:
+		return errors.New(id, "cart deleted", http.StatusPreconditionFailed)
+	case !c.ExpiresAt.After(now):
+		return errors.New(id, "cart expired", http.StatusPreconditionFailed)
+	}
+	return nil
+}
+
+// GetCartWithAvailability fetches a cart and checks every item against the current catalog,
+// so clients can tell before checkout which items can no longer be bought. Unlike GetCart it
+// doesn't count as cart activity.
+func (h *CartService) GetCartWithAvailability(ctx context.Context, req *pb.GetCartWithAvailabilityRequest, rsp *pb.GetCartWithAvailabilityResponse) error {
+	logger.Extract(ctx).Infof("Received GetCartWithAvailability request for ID: %s", req.Id)
+
+	if h.Products == nil {
+		return fmt.Errorf("product validation is disabled, availability is unknown")
+	}
+
+	cartID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid cart id: %s", req.Id)
+	}
+
+	c, err := h.EntClient.Cart.Query().
+		Where(
+			cart.ID(cartID),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		WithCartItems().
+		WithCoupon().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found or expired: %s", req.Id)
+		return fmt.Errorf("cart not found or expired")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get cart: %v", err)
+		return fmt.Errorf("failed to get cart: %w", err)
+	}
+	ctx = withLogFields(ctx, map[string]interface{}{"user_id": c.UserID.String()})
+
+	catalog, err := lookupProducts(ctx, h.Products, c.Edges.CartItems)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to look up products for cart %s: %v", c.ID, err)
+		return err
+	}
 
 	rsp.Cart = toProtoCart(c)
-	logger.Infof("Cart fetched successfully: %s", c.ID)
+	rsp.AllAvailable = true
+	for i, item := range c.Edges.CartItems {
+		a := itemAvailability(catalog[item.ProductID.String()], item.Quantity)
+		rsp.Cart.CartItems[i].Availability = a
+		rsp.AllAvailable = rsp.AllAvailable && a.Available
+	}
+	rsp.Pricing = cartPricing(c, catalog)
+
+	logger.Extract(ctx).Infof("Cart fetched with availability: %s (all available: %v)", c.ID, rsp.AllAvailable)
 	return nil
 }
 
 // AddCartItem adds an item to the cart, merging quantities if the product exists
 func (h *CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest, rsp *pb.AddCartItemResponse) error {
-	logger.Infof("Received AddCartItem request for cart_id: %s, product_id: %s", req.CartId, req.ProductId)
+	logger.Extract(ctx).Infof("Received AddCartItem request for cart_id: %s, product_id: %s", req.CartId, req.ProductId)
 
 	if req.Quantity <= 0 {
-		logger.Infof("Invalid quantity: %d", req.Quantity)
+		logger.Extract(ctx).Infof("Invalid quantity: %d", req.Quantity)
 		return fmt.Errorf("quantity must be positive")
 	}
 
 	cartID, err := uuid.Parse(req.CartId)
 	if err != nil {
-		logger.Errorf("Invalid cart_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
 		return fmt.Errorf("invalid cart_id format: %w", err)
 	}
 
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid product_id format: %v", err)
+		return fmt.Errorf("invalid product_id format: %w", err)
+	}
+
+	// Validate the product exists in the catalog
+	var p *productspb.Product
+	var productName string
+	if h.Products != nil {
+		p, err = lookupProduct(ctx, h.Products, req.ProductId)
+		if err != nil {
+			logger.Extract(ctx).Infof("Product validation failed for %s: %v", req.ProductId, err)
+			return err
+		}
+		productName = p.Name
+	}
+	if err := h.checkItemQuantity(int(req.Quantity), p); err != nil {
+		logger.Extract(ctx).Infof("Rejected AddCartItem for product %s: %v", req.ProductId, err)
+		return err
+	}
+
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -145,11 +368,11 @@ func (h *CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemReques
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found or expired: %s", req.CartId)
+		logger.Extract(ctx).Infof("Cart not found or expired: %s", req.CartId)
 		return fmt.Errorf("cart not found or expired")
 	}
 	if err != nil {
-		logger.Errorf("Failed to query cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
 		return fmt.Errorf("failed to query cart: %w", err)
 	}
 
@@ -157,33 +380,45 @@ func (h *CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemReques
 	existingItem, err := tx.CartItem.Query().
 		Where(
 			cartitem.HasCartWith(cart.ID(cartID)),
-			cartitem.ProductID(uuid.MustParse(req.ProductId)),
+			cartitem.ProductID(productID),
 		).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
-		logger.Errorf("Failed to query cart item: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart item: %v", err)
 		return fmt.Errorf("failed to query cart item: %w", err)
 	}
 
 	if existingItem != nil {
+		// The merged quantity must respect the same limits as a new item
+		if err := h.checkItemQuantity(existingItem.Quantity+int(req.Quantity), p); err != nil {
+			logger.Extract(ctx).Infof("Rejected AddCartItem for product %s: %v", req.ProductId, err)
+			return err
+		}
+
 		// Update quantity
-		err = tx.CartItem.UpdateOneID(existingItem.ID).
+		updater := tx.CartItem.UpdateOneID(existingItem.ID).
 			AddQuantity(int(req.Quantity)).
-			SetUpdatedAt(time.Now()).
-			Exec(ctx)
+			SetUpdatedAt(time.Now())
+		if productName != "" {
+			updater.SetProductName(productName)
+		}
+		err = updater.Exec(ctx)
 		if err != nil {
-			logger.Errorf("Failed to update cart item quantity: %v", err)
+			logger.Extract(ctx).Errorf("Failed to update cart item quantity: %v", err)
 			return fmt.Errorf("failed to update cart item: %w", err)
 		}
 	} else {
 		// Create new cart item
-		_, err = tx.CartItem.Create().
+		creator := tx.CartItem.Create().
 			SetCartID(cartID).
-			SetProductID(uuid.MustParse(req.ProductId)).
-			SetQuantity(int(req.Quantity)).
-			Save(ctx)
+			SetProductID(productID).
+			SetQuantity(int(req.Quantity))
+		if productName != "" {
+			creator.SetProductName(productName)
+		}
+		_, err = creator.Save(ctx)
 		if err != nil {
-			logger.Errorf("Failed to create cart item: %v", err)
+			logger.Extract(ctx).Errorf("Failed to create cart item: %v", err)
 			return fmt.Errorf("failed to create cart item: %w", err)
 		}
 	}
@@ -191,17 +426,17 @@ func (h *CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemReques
 	// Update cart metadata
 	err = tx.Cart.UpdateOneID(cartID).
 		SetLastActivityAt(time.Now()).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetExpiresAt(h.expiresAt()).
 		AddVersion(1).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart metadata: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
 		return fmt.Errorf("failed to update cart: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
@@ -211,34 +446,67 @@ func (h *CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemReques
 		WithCartItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch updated cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
 		return fmt.Errorf("failed to fetch updated cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(cWithItems)
-	logger.Infof("Added item to cart: %s", req.CartId)
+	logger.Extract(ctx).Infof("Added item to cart: %s", req.CartId)
 	return nil
 }
 
 // UpdateCartItem updates the quantity of a cart item
 func (h *CartService) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItemRequest, rsp *pb.UpdateCartItemResponse) error {
-	logger.Infof("Received UpdateCartItem request for cart_id: %s, cart_item_id: %s", req.CartId, req.CartItemId)
+	logger.Extract(ctx).Infof("Received UpdateCartItem request for cart_id: %s, cart_item_id: %s", req.CartId, req.CartItemId)
 
 	if req.Quantity <= 0 {
-		logger.Infof("Invalid quantity: %d", req.Quantity)
+		logger.Extract(ctx).Infof("Invalid quantity: %d", req.Quantity)
 		return fmt.Errorf("quantity must be positive")
 	}
 
 	cartID, err := uuid.Parse(req.CartId)
 	if err != nil {
-		logger.Errorf("Invalid cart_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
 		return fmt.Errorf("invalid cart_id format: %w", err)
 	}
+	cartItemID, err := uuid.Parse(req.CartItemId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_item_id format: %v", err)
+		return fmt.Errorf("invalid cart_item_id format: %w", err)
+	}
+
+	// Check the new quantity against the cap and the product's stock before locking the cart
+	var p *productspb.Product
+	if h.Products != nil {
+		item, err := h.EntClient.CartItem.Query().
+			Where(
+				cartitem.ID(cartItemID),
+				cartitem.HasCartWith(cart.ID(cartID)),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			logger.Extract(ctx).Infof("Cart item %s not found in cart %s", req.CartItemId, req.CartId)
+			return fmt.Errorf("cart item not found")
+		}
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to get cart item: %v", err)
+			return fmt.Errorf("failed to get cart item: %w", err)
+		}
+		p, err = lookupProduct(ctx, h.Products, item.ProductID.String())
+		if err != nil {
+			logger.Extract(ctx).Infof("Product validation failed for %s: %v", item.ProductID, err)
+			return err
+		}
+	}
+	if err := h.checkItemQuantity(int(req.Quantity), p); err != nil {
+		logger.Extract(ctx).Infof("Rejected UpdateCartItem for cart item %s: %v", req.CartItemId, err)
+		return err
+	}
 
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -253,42 +521,46 @@ func (h *CartService) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItem
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
+		logger.Extract(ctx).Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
 		return fmt.Errorf("cart not found, expired, or version mismatch")
 	}
 	if err != nil {
-		logger.Errorf("Failed to query cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
 		return fmt.Errorf("failed to query cart: %w", err)
 	}
 
-	// Update cart item
-	err = tx.CartItem.UpdateOneID(uuid.MustParse(req.CartItemId)).
+	// Update cart item, only if it belongs to this cart
+	n, err := tx.CartItem.Update().
+		Where(
+			cartitem.ID(cartItemID),
+			cartitem.HasCartWith(cart.ID(cartID)),
+		).
 		SetQuantity(int(req.Quantity)).
 		SetUpdatedAt(time.Now()).
-		Exec(ctx)
-	if ent.IsNotFound(err) {
-		logger.Infof("Cart item not found: %s", req.CartItemId)
-		return fmt.Errorf("cart item not found")
-	}
+		Save(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart item: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart item: %v", err)
 		return fmt.Errorf("failed to update cart item: %w", err)
 	}
+	if n == 0 {
+		logger.Extract(ctx).Infof("Cart item %s not found in cart %s", req.CartItemId, req.CartId)
+		return fmt.Errorf("cart item not found")
+	}
 
 	// Update cart metadata
 	err = tx.Cart.UpdateOneID(cartID).
 		SetLastActivityAt(time.Now()).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetExpiresAt(h.expiresAt()).
 		AddVersion(1).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart metadata: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
 		return fmt.Errorf("failed to update cart: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
@@ -298,29 +570,29 @@ func (h *CartService) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItem
 		WithCartItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch updated cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
 		return fmt.Errorf("failed to fetch updated cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(cWithItems)
-	logger.Infof("Updated cart item: %s in cart: %s", req.CartItemId, req.CartId)
+	logger.Extract(ctx).Infof("Updated cart item: %s in cart: %s", req.CartItemId, req.CartId)
 	return nil
 }
 
 // RemoveCartItem removes a cart item
 func (h *CartService) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemRequest, rsp *pb.RemoveCartItemResponse) error {
-	logger.Infof("Received RemoveCartItem request for cart_id: %s, cart_item_id: %s", req.CartId, req.CartItemId)
+	logger.Extract(ctx).Infof("Received RemoveCartItem request for cart_id: %s, cart_item_id: %s", req.CartId, req.CartItemId)
 
 	cartID, err := uuid.Parse(req.CartId)
 	if err != nil {
-		logger.Errorf("Invalid cart_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
 		return fmt.Errorf("invalid cart_id format: %w", err)
 	}
 
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -335,39 +607,39 @@ func (h *CartService) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItem
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
+		logger.Extract(ctx).Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
 		return fmt.Errorf("cart not found, expired, or version mismatch")
 	}
 	if err != nil {
-		logger.Errorf("Failed to query cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
 		return fmt.Errorf("failed to query cart: %w", err)
 	}
 
 	// Delete cart item
 	err = tx.CartItem.DeleteOneID(uuid.MustParse(req.CartItemId)).Exec(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart item not found: %s", req.CartItemId)
+		logger.Extract(ctx).Infof("Cart item not found: %s", req.CartItemId)
 		return fmt.Errorf("cart item not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to delete cart item: %v", err)
+		logger.Extract(ctx).Errorf("Failed to delete cart item: %v", err)
 		return fmt.Errorf("failed to delete cart item: %w", err)
 	}
 
 	// Update cart metadata
 	err = tx.Cart.UpdateOneID(cartID).
 		SetLastActivityAt(time.Now()).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetExpiresAt(h.expiresAt()).
 		AddVersion(1).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart metadata: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
 		return fmt.Errorf("failed to update cart: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
@@ -377,29 +649,119 @@ func (h *CartService) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItem
 		WithCartItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch updated cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
 		return fmt.Errorf("failed to fetch updated cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(cWithItems)
-	logger.Infof("Removed cart item: %s from cart: %s", req.CartItemId, req.CartId)
+	logger.Extract(ctx).Infof("Removed cart item: %s from cart: %s", req.CartItemId, req.CartId)
+	return nil
+}
+
+// RemoveCartItemByProduct removes the cart item holding a product, so clients needn't track cart item IDs
+func (h *CartService) RemoveCartItemByProduct(ctx context.Context, req *pb.RemoveCartItemByProductRequest, rsp *pb.RemoveCartItemByProductResponse) error {
+	logger.Extract(ctx).Infof("Received RemoveCartItemByProduct request for cart_id: %s, product_id: %s", req.CartId, req.ProductId)
+
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid product_id format: %v", err)
+		return fmt.Errorf("invalid product_id format: %w", err)
+	}
+
+	// Start a transaction
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Verify cart exists and version matches
+	_, err = tx.Cart.Query().
+		Where(
+			cart.ID(cartID),
+			cart.Version(int(req.Version)),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
+		return fmt.Errorf("cart not found, expired, or version mismatch")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
+		return fmt.Errorf("failed to query cart: %w", err)
+	}
+
+	// Delete the product's cart item; AddCartItem keeps at most one per product
+	deleted, err := tx.CartItem.Delete().
+		Where(
+			cartitem.HasCartWith(cart.ID(cartID)),
+			cartitem.ProductID(productID),
+		).
+		Exec(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to delete cart item: %v", err)
+		return fmt.Errorf("failed to delete cart item: %w", err)
+	}
+	if deleted == 0 {
+		logger.Extract(ctx).Infof("No cart item for product %s in cart %s", req.ProductId, req.CartId)
+		return fmt.Errorf("cart item not found")
+	}
+
+	// Update cart metadata
+	err = tx.Cart.UpdateOneID(cartID).
+		SetLastActivityAt(time.Now()).
+		SetExpiresAt(h.expiresAt()).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	// Commit transaction
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Fetch updated cart
+	cWithItems, err := h.EntClient.Cart.Query().
+		Where(cart.ID(cartID)).
+		WithCartItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
+		return fmt.Errorf("failed to fetch updated cart: %w", err)
+	}
+
+	rsp.Cart = toProtoCart(cWithItems)
+	logger.Extract(ctx).Infof("Removed product %s from cart: %s", req.ProductId, req.CartId)
 	return nil
 }
 
 // ClearCart removes all items from a cart
 func (h *CartService) ClearCart(ctx context.Context, req *pb.ClearCartRequest, rsp *pb.ClearCartResponse) error {
-	logger.Infof("Received ClearCart request for cart_id: %s", req.CartId)
+	logger.Extract(ctx).Infof("Received ClearCart request for cart_id: %s", req.CartId)
 
 	cartID, err := uuid.Parse(req.CartId)
 	if err != nil {
-		logger.Errorf("Invalid cart_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
 		return fmt.Errorf("invalid cart_id format: %w", err)
 	}
 
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -414,11 +776,11 @@ func (h *CartService) ClearCart(ctx context.Context, req *pb.ClearCartRequest, r
 		).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
+		logger.Extract(ctx).Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
 		return fmt.Errorf("cart not found, expired, or version mismatch")
 	}
 	if err != nil {
-		logger.Errorf("Failed to query cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
 		return fmt.Errorf("failed to query cart: %w", err)
 	}
 
@@ -427,24 +789,24 @@ func (h *CartService) ClearCart(ctx context.Context, req *pb.ClearCartRequest, r
 		Where(cartitem.HasCartWith(cart.ID(cartID))).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to delete cart items: %v", err)
+		logger.Extract(ctx).Errorf("Failed to delete cart items: %v", err)
 		return fmt.Errorf("failed to delete cart items: %w", err)
 	}
 
 	// Update cart metadata
 	err = tx.Cart.UpdateOneID(cartID).
 		SetLastActivityAt(time.Now()).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetExpiresAt(h.expiresAt()).
 		AddVersion(1).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to update cart metadata: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
 		return fmt.Errorf("failed to update cart: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
@@ -454,22 +816,22 @@ func (h *CartService) ClearCart(ctx context.Context, req *pb.ClearCartRequest, r
 		WithCartItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch updated cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
 		return fmt.Errorf("failed to fetch updated cart: %w", err)
 	}
 
 	rsp.Cart = toProtoCart(cWithItems)
-	logger.Infof("Cleared cart: %s", req.CartId)
+	logger.Extract(ctx).Infof("Cleared cart: %s", req.CartId)
 	return nil
 }
 
 // SoftDeleteCart marks a cart as deleted
 func (h *CartService) SoftDeleteCart(ctx context.Context, req *pb.SoftDeleteCartRequest, rsp *pb.SoftDeleteCartResponse) error {
-	logger.Infof("Received SoftDeleteCart request for ID: %s", req.Id)
+	logger.Extract(ctx).Infof("Received SoftDeleteCart request for ID: %s", req.Id)
 
 	cartID, err := uuid.Parse(req.Id)
 	if err != nil {
-		logger.Errorf("Invalid cart_id format: %v", err)
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
 		return fmt.Errorf("invalid cart_id format: %w", err)
 	}
 
@@ -480,22 +842,287 @@ func (h *CartService) SoftDeleteCart(ctx context.Context, req *pb.SoftDeleteCart
 		AddVersion(1).
 		Exec(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Cart not found or version mismatch: %s", req.Id)
+		logger.Extract(ctx).Infof("Cart not found or version mismatch: %s", req.Id)
 		rsp.Success = false
 		return fmt.Errorf("cart not found or version mismatch")
 	}
 	if err != nil {
-		logger.Errorf("Failed to soft delete cart: %v", err)
+		logger.Extract(ctx).Errorf("Failed to soft delete cart: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to soft delete cart: %w", err)
 	}
 
 	rsp.Id = req.Id
 	rsp.Success = true
-	logger.Infof("Cart soft deleted successfully: %s", req.Id)
+	logger.Extract(ctx).Infof("Cart soft deleted successfully: %s", req.Id)
+	return nil
+}
+
+// CheckoutCart marks a cart as checked out and soft-deletes it, once an order has been placed from it
+func (h *CartService) CheckoutCart(ctx context.Context, req *pb.CheckoutCartRequest, rsp *pb.CheckoutCartResponse) error {
+	logger.Extract(ctx).Infof("Received CheckoutCart request for ID: %s", req.Id)
+
+	cartID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+
+	// Check the cart out and count a use of its coupon together
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	err = tx.Cart.UpdateOneID(cartID).
+		Where(
+			cart.Version(int(req.Version)),
+			cart.DeletedAtIsNil(),
+		).
+		SetCheckedOutAt(now).
+		SetDeletedAt(now).
+		AddVersion(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Active cart not found or version mismatch: %s", req.Id)
+		return fmt.Errorf("cart not found or version mismatch")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to check out cart: %v", err)
+		return fmt.Errorf("failed to check out cart: %w", err)
+	}
+
+	cp, err := tx.Coupon.Query().Where(coupon.HasCartsWith(cart.ID(cartID))).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Extract(ctx).Errorf("Failed to get coupon of cart %s: %v", req.Id, err)
+		return fmt.Errorf("failed to check out cart: %w", err)
+	}
+	if cp != nil {
+		// The coupon may have expired or run out of uses since it was applied
+		if err := couponUnavailable("carts.CheckoutCart", cp, now); err != nil {
+			logger.Extract(ctx).Infof("Refusing checkout of cart %s: %v", req.Id, err)
+			return err
+		}
+		n, err := tx.Coupon.Update().
+			Where(coupon.ID(cp.ID), usableCoupon()).
+			AddUsedCount(1).
+			Save(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to count use of coupon %s: %v", cp.Code, err)
+			return fmt.Errorf("failed to check out cart: %w", err)
+		}
+		if n == 0 {
+			logger.Extract(ctx).Infof("Refusing checkout of cart %s: coupon %s ran out of uses", req.Id, cp.Code)
+			return errors.New("carts.CheckoutCart", "coupon usage limit reached", http.StatusPreconditionFailed)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit checkout of cart %s: %v", req.Id, err)
+		return fmt.Errorf("failed to check out cart: %w", err)
+	}
+
+	rsp.Id = req.Id
+	rsp.Success = true
+	logger.Extract(ctx).Infof("Cart checked out successfully: %s", req.Id)
 	return nil
 }
 
+// MergeCarts merges a guest cart into a user's cart, summing quantities for duplicate products
+func (h *CartService) MergeCarts(ctx context.Context, req *pb.MergeCartsRequest, rsp *pb.MergeCartsResponse) error {
+	logger.Extract(ctx).Infof("Received MergeCarts request (source_cart_id: %s, target_cart_id: %s, user_id: %s, strategy: %s)", req.SourceCartId, req.TargetCartId, req.UserId, req.Strategy)
+
+	sourceID, err := uuid.Parse(req.SourceCartId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid source_cart_id format: %v", err)
+		return fmt.Errorf("invalid source_cart_id format: %w", err)
+	}
+
+	if _, ok := pb.MergeStrategy_name[int32(req.Strategy)]; !ok {
+		logger.Extract(ctx).Infof("Invalid merge strategy: %d", req.Strategy)
+		return fmt.Errorf("invalid merge strategy: %d", req.Strategy)
+	}
+
+	// Start a transaction
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Verify source cart exists and is active
+	source, err := tx.Cart.Query().
+		Where(
+			cart.ID(sourceID),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		WithCartItems().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Source cart not found or expired: %s", req.SourceCartId)
+		return fmt.Errorf("source cart not found or expired")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query source cart: %v", err)
+		return fmt.Errorf("failed to query source cart: %w", err)
+	}
+
+	// Resolve the target cart, either by ID or as the user's active cart
+	targetQuery := tx.Cart.Query().
+		Where(
+			cart.IDNEQ(sourceID),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		WithCartItems()
+	var userID uuid.UUID
+	switch {
+	case req.TargetCartId != "":
+		targetID, err := uuid.Parse(req.TargetCartId)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Invalid target_cart_id format: %v", err)
+			return fmt.Errorf("invalid target_cart_id format: %w", err)
+		}
+		if targetID == sourceID {
+			logger.Extract(ctx).Infof("Cannot merge cart into itself: %s", req.SourceCartId)
+			return fmt.Errorf("source and target carts must differ")
+		}
+		targetQuery.Where(cart.ID(targetID))
+	case req.UserId != "":
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Invalid user_id format: %v", err)
+			return fmt.Errorf("invalid user_id format: %w", err)
+		}
+		targetQuery.Where(cart.UserID(userID))
+	default:
+		logger.Extract(ctx).Infof("MergeCarts requires target_cart_id or user_id")
+		return fmt.Errorf("target_cart_id or user_id is required")
+	}
+
+	target, err := targetQuery.First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Extract(ctx).Errorf("Failed to query target cart: %v", err)
+		return fmt.Errorf("failed to query target cart: %w", err)
+	}
+
+	var mergedID uuid.UUID
+	if target == nil {
+		if req.TargetCartId != "" {
+			logger.Extract(ctx).Infof("Target cart not found or expired: %s", req.TargetCartId)
+			return fmt.Errorf("target cart not found or expired")
+		}
+
+		// The user has no cart yet, so the source cart simply becomes theirs
+		err = tx.Cart.UpdateOneID(sourceID).
+			SetUserID(userID).
+			SetLastActivityAt(time.Now()).
+			SetExpiresAt(h.expiresAt()).
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to reassign source cart: %v", err)
+			return fmt.Errorf("failed to reassign cart: %w", err)
+		}
+		mergedID = sourceID
+	} else {
+		existing := make(map[uuid.UUID]*ent.CartItem, len(target.Edges.CartItems))
+		for _, item := range target.Edges.CartItems {
+			existing[item.ProductID] = item
+		}
+
+		for _, item := range source.Edges.CartItems {
+			if targetItem, ok := existing[item.ProductID]; ok {
+				// Resolve the quantity into the target item, up to the cap, and drop the source item
+				err = tx.CartItem.UpdateOneID(targetItem.ID).
+					SetQuantity(min(mergeQuantity(req.Strategy, targetItem.Quantity, item.Quantity), h.maxItemQuantity())).
+					SetUpdatedAt(time.Now()).
+					Exec(ctx)
+				if err != nil {
+					logger.Extract(ctx).Errorf("Failed to merge cart item %s: %v", item.ID, err)
+					return fmt.Errorf("failed to merge cart item: %w", err)
+				}
+				if err = tx.CartItem.DeleteOneID(item.ID).Exec(ctx); err != nil {
+					logger.Extract(ctx).Errorf("Failed to delete merged cart item %s: %v", item.ID, err)
+					return fmt.Errorf("failed to delete merged cart item: %w", err)
+				}
+				continue
+			}
+
+			// Move the item over to the target cart
+			err = tx.CartItem.UpdateOneID(item.ID).
+				SetCartID(target.ID).
+				SetUpdatedAt(time.Now()).
+				Exec(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Failed to move cart item %s: %v", item.ID, err)
+				return fmt.Errorf("failed to move cart item: %w", err)
+			}
+		}
+
+		// Soft delete the now empty source cart
+		err = tx.Cart.UpdateOneID(sourceID).
+			SetDeletedAt(time.Now()).
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to soft delete source cart: %v", err)
+			return fmt.Errorf("failed to soft delete source cart: %w", err)
+		}
+
+		// Update target cart metadata
+		err = tx.Cart.UpdateOneID(target.ID).
+			SetLastActivityAt(time.Now()).
+			SetExpiresAt(h.expiresAt()).
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
+			return fmt.Errorf("failed to update cart: %w", err)
+		}
+		mergedID = target.ID
+	}
+
+	// Commit transaction
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Fetch merged cart
+	cWithItems, err := h.EntClient.Cart.Query().
+		Where(cart.ID(mergedID)).
+		WithCartItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch merged cart: %v", err)
+		return fmt.Errorf("failed to fetch merged cart: %w", err)
+	}
+
+	rsp.Cart = toProtoCart(cWithItems)
+	logger.Extract(ctx).Infof("Merged cart %s into cart: %s", req.SourceCartId, mergedID)
+	return nil
+}
+
+// mergeQuantity resolves the quantity of a product present in both the target and source carts
+func mergeQuantity(strategy pb.MergeStrategy, target, source int) int {
+	switch strategy {
+	case pb.MergeStrategy_MAX:
+		return max(target, source)
+	case pb.MergeStrategy_KEEP_TARGET:
+		return target
+	case pb.MergeStrategy_KEEP_SOURCE:
+		return source
+	default:
+		return target + source
+	}
+}
+
 // toProtoCart converts an Entgo Cart entity to a Protobuf Cart message
 func toProtoCart(c *ent.Cart) *pb.Cart {
 	if c == nil {
@@ -510,19 +1137,29 @@ func toProtoCart(c *ent.Cart) *pb.Cart {
 		UpdatedAt:      c.UpdatedAt.Unix(),
 		Version:        int32(c.Version),
 	}
-	if !c.DeletedAt.IsZero() {
+	if c.DeletedAt != nil {
 		protoCart.DeletedAt = c.DeletedAt.Unix()
 	}
+	if c.CheckedOutAt != nil {
+		protoCart.CheckedOutAt = c.CheckedOutAt.Unix()
+	}
+	if c.Region != nil {
+		protoCart.Region = *c.Region
+	}
+	if c.Edges.Coupon != nil {
+		protoCart.CouponCode = c.Edges.Coupon.Code
+	}
 	if c.Edges.CartItems != nil {
 		protoCart.CartItems = make([]*pb.CartItem, len(c.Edges.CartItems))
 		for i, item := range c.Edges.CartItems {
 			protoCart.CartItems[i] = &pb.CartItem{
-				Id:        item.ID.String(),
-				ProductId: item.ProductID.String(),
-				Quantity:  int32(item.Quantity),
-				CreatedAt: item.CreatedAt.Unix(),
-				UpdatedAt: item.UpdatedAt.Unix(),
-				CartId:    c.ID.String(),
+				Id:          item.ID.String(),
+				ProductId:   item.ProductID.String(),
+				Quantity:    int32(item.Quantity),
+				CreatedAt:   item.CreatedAt.Unix(),
+				UpdatedAt:   item.UpdatedAt.Unix(),
+				CartId:      c.ID.String(),
+				ProductName: item.ProductName,
 			}
 		}
 	}
@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+
+	"carts/ent/cart"
+	"carts/ent/cartitem"
+	pb "carts/proto"
+
+	productspb "products/proto"
+)
+
+func TestUpdateCartItemOnlyUpdatesItemsOfTheCart(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	products := &fakeProducts{catalog: map[string]*productspb.Product{
+		productID.String(): {Id: productID.String(), IsActive: true, StockQuantity: 100},
+	}}
+	mine := createTestCart(t, client, productID)
+	other := createTestCart(t, client, productID)
+	otherItem := other.Edges.CartItems[0]
+
+	for name, h := range map[string]*CartService{
+		"without products": {EntClient: client},
+		"with products":    {EntClient: client, Products: products},
+	} {
+		t.Run(name, func(t *testing.T) {
+			current := client.Cart.GetX(ctx, mine.ID)
+			err := h.UpdateCartItem(ctx, &pb.UpdateCartItemRequest{
+				CartId:     mine.ID.String(),
+				CartItemId: otherItem.ID.String(),
+				Quantity:   5,
+				Version:    int32(current.Version),
+			}, &pb.UpdateCartItemResponse{})
+			if err == nil || !strings.Contains(err.Error(), "cart item not found") {
+				t.Fatalf("expected another cart's item to be not found, got %v", err)
+			}
+			if q := client.CartItem.GetX(ctx, otherItem.ID).Quantity; q != 1 {
+				t.Fatalf("expected the other cart's item untouched, got quantity %d", q)
+			}
+		})
+	}
+
+	h := &CartService{EntClient: client, Products: products}
+	err := h.UpdateCartItem(ctx, &pb.UpdateCartItemRequest{CartId: mine.ID.String(), CartItemId: "not-a-uuid", Quantity: 1, Version: 1}, &pb.UpdateCartItemResponse{})
+	if err == nil || !strings.Contains(err.Error(), "invalid cart_item_id") {
+		t.Fatalf("expected a malformed cart_item_id to be rejected, got %v", err)
+	}
+}
+
+func TestUpdateCartItemEnforcesQuantityCap(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	h := &CartService{EntClient: client, MaxItemQuantity: 10, Products: &fakeProducts{catalog: map[string]*productspb.Product{
+		productID.String(): {Id: productID.String(), IsActive: true, StockQuantity: 6},
+	}}}
+	c := createTestCart(t, client, productID)
+	item := c.Edges.CartItems[0]
+
+	tests := []struct {
+		quantity int32
+		wantErr  string
+	}{
+		{11, "exceeds the maximum of 10"},
+		{7, "exceeds available stock of 6"},
+		{6, ""},
+	}
+	for _, tt := range tests {
+		current := client.Cart.GetX(ctx, c.ID)
+		rsp := &pb.UpdateCartItemResponse{}
+		err := h.UpdateCartItem(ctx, &pb.UpdateCartItemRequest{
+			CartId:     c.ID.String(),
+			CartItemId: item.ID.String(),
+			Quantity:   tt.quantity,
+			Version:    int32(current.Version),
+		}, rsp)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("quantity %d: %v", tt.quantity, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("quantity %d: expected error containing %q, got %v", tt.quantity, tt.wantErr, err)
+		}
+	}
+	if q := client.CartItem.GetX(ctx, item.ID).Quantity; q != 6 {
+		t.Fatalf("expected quantity 6, got %d", q)
+	}
+}
+
+func TestToProtoCartDeletedAt(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	live := createTestCart(t, client)
+	if got := toProtoCart(live).DeletedAt; got != 0 {
+		t.Fatalf("expected a live cart to have no DeletedAt, got %d", got)
+	}
+
+	deletedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	deleted := client.Cart.UpdateOne(createTestCart(t, client)).SetDeletedAt(deletedAt).SaveX(ctx)
+	if got := toProtoCart(deleted).DeletedAt; got != deletedAt.Unix() {
+		t.Fatalf("expected DeletedAt %d for a soft-deleted cart, got %d", deletedAt.Unix(), got)
+	}
+}
+
+func TestAddCartItemValidatesProductAgainstCatalog(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	known, unknown := uuid.New(), uuid.New()
+	h := &CartService{EntClient: client, Products: &fakeProducts{catalog: map[string]*productspb.Product{
+		known.String(): {Id: known.String(), Name: "Blue Mug", IsActive: true, StockQuantity: 10},
+	}}}
+	c := createTestCart(t, client)
+
+	err := h.AddCartItem(ctx, &pb.AddCartItemRequest{CartId: c.ID.String(), ProductId: unknown.String(), Quantity: 1}, &pb.AddCartItemResponse{})
+	if !isProductNotFound(err) {
+		t.Fatalf("expected the unknown product rejected, got %v", err)
+	}
+	if n := client.CartItem.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected no item stored, found %d", n)
+	}
+
+	rsp := &pb.AddCartItemResponse{}
+	if err := h.AddCartItem(ctx, &pb.AddCartItemRequest{CartId: c.ID.String(), ProductId: known.String(), Quantity: 1}, rsp); err != nil {
+		t.Fatalf("AddCartItem: %v", err)
+	}
+	if len(rsp.Cart.CartItems) != 1 || rsp.Cart.CartItems[0].ProductName != "Blue Mug" {
+		t.Fatalf("expected the item added with its product name, got %v", rsp.Cart.CartItems)
+	}
+}
+
+func TestGetCartWithAvailability(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	mug, pen, retired, scarce, gone := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	h := &CartService{EntClient: client, Products: &fakeProducts{catalog: map[string]*productspb.Product{
+		mug.String():     {Id: mug.String(), PriceCents: 1250, Currency: "USD", IsActive: true, StockQuantity: 10},
+		pen.String():     {Id: pen.String(), PriceCents: 199, Currency: "USD", IsActive: true, StockQuantity: 1},
+		retired.String(): {Id: retired.String(), PriceCents: 500, Currency: "USD", IsActive: false, StockQuantity: 10},
+		scarce.String():  {Id: scarce.String(), PriceCents: 800, Currency: "USD", IsActive: true, StockQuantity: 0},
+	}}}
+
+	available := createTestCart(t, client, mug, pen)
+	rsp := &pb.GetCartWithAvailabilityResponse{}
+	if err := h.GetCartWithAvailability(ctx, &pb.GetCartWithAvailabilityRequest{Id: available.ID.String()}, rsp); err != nil {
+		t.Fatalf("GetCartWithAvailability: %v", err)
+	}
+	if !rsp.AllAvailable {
+		t.Fatal("expected a cart of active, in-stock products to be all available")
+	}
+	for _, item := range rsp.Cart.CartItems {
+		a := item.Availability
+		if !a.Found || !a.IsActive || !a.InStock || !a.Available {
+			t.Errorf("expected item %s available, got %v", item.ProductId, a)
+		}
+	}
+	if got := client.Cart.GetX(ctx, available.ID).LastActivityAt; !got.Equal(available.LastActivityAt) {
+		t.Fatal("expected checking availability not to count as cart activity")
+	}
+
+	partial := createTestCart(t, client, mug, retired, scarce, gone)
+	rsp = &pb.GetCartWithAvailabilityResponse{}
+	if err := h.GetCartWithAvailability(ctx, &pb.GetCartWithAvailabilityRequest{Id: partial.ID.String()}, rsp); err != nil {
+		t.Fatalf("GetCartWithAvailability: %v", err)
+	}
+	if rsp.AllAvailable {
+		t.Fatal("expected a cart with unavailable items not to be all available")
+	}
+	want := map[string]*pb.CartItemAvailability{
+		mug.String():     {Found: true, IsActive: true, InStock: true, Available: true, PriceCents: 1250, Currency: "USD"},
+		retired.String(): {Found: true, IsActive: false, InStock: true, PriceCents: 500, Currency: "USD"},
+		scarce.String():  {Found: true, IsActive: true, InStock: false, PriceCents: 800, Currency: "USD"},
+		gone.String():    {},
+	}
+	for _, item := range rsp.Cart.CartItems {
+		a, w := item.Availability, want[item.ProductId]
+		if a.Found != w.Found || a.IsActive != w.IsActive || a.InStock != w.InStock || a.Available != w.Available || a.PriceCents != w.PriceCents || a.Currency != w.Currency {
+			t.Errorf("item %s: got %v", item.ProductId, a)
+		}
+	}
+}
+
+func TestGetCartReportsWhyCartIsUnavailable(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &CartService{EntClient: client}
+
+	live := createTestCart(t, client)
+	expired := createTestCart(t, client)
+	client.Cart.UpdateOneID(expired.ID).SetExpiresAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+	deleted := createTestCart(t, client)
+	client.Cart.UpdateOneID(deleted.ID).SetDeletedAt(time.Now()).ExecX(ctx)
+	// A deleted cart can be restored, so it is reported as deleted even once expired
+	both := createTestCart(t, client)
+	client.Cart.UpdateOneID(both.ID).SetDeletedAt(time.Now()).SetExpiresAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+
+	type want struct {
+		code   int32
+		detail string
+	}
+	cases := map[string]want{
+		uuid.NewString():    {404, "cart not found"},
+		expired.ID.String(): {412, "cart expired"},
+		deleted.ID.String(): {412, "cart deleted"},
+		both.ID.String():    {412, "cart deleted"},
+		"not-a-uuid":        {400, ""},
+	}
+	for id, w := range cases {
+		err := h.GetCart(ctx, &pb.GetCartRequest{Id: id}, &pb.GetCartResponse{})
+		if err == nil {
+			t.Errorf("cart %s: expected an error", id)
+			continue
+		}
+		got := errors.FromError(err)
+		if got.Code != w.code || (w.detail != "" && got.Detail != w.detail) {
+			t.Errorf("cart %s: expected %d %q, got %d %q", id, w.code, w.detail, got.Code, got.Detail)
+		}
+	}
+
+	rsp := &pb.GetCartResponse{}
+	if err := h.GetCart(ctx, &pb.GetCartRequest{Id: live.ID.String()}, rsp); err != nil {
+		t.Fatalf("GetCart of a live cart: %v", err)
+	}
+	if rsp.Cart.Id != live.ID.String() {
+		t.Fatalf("expected cart %s, got %s", live.ID, rsp.Cart.Id)
+	}
+}
+
+func TestCartMutationsApplyConfiguredTTL(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &CartService{EntClient: client, TTL: 2 * time.Hour}
+
+	// expectExpiry checks the cart expires one TTL from now, then moves its expiry aside
+	// so the next mutation has to extend it again
+	expectExpiry := func(op string, cartID uuid.UUID) *pb.Cart {
+		t.Helper()
+		c := client.Cart.GetX(ctx, cartID)
+		want := time.Now().Add(h.TTL)
+		if d := want.Sub(c.ExpiresAt); d < 0 || d > 5*time.Second {
+			t.Fatalf("%s: expected the cart to expire at %s, got %s", op, want, c.ExpiresAt)
+		}
+		client.Cart.UpdateOne(c).SetExpiresAt(time.Now().Add(time.Minute)).ExecX(ctx)
+		return toProtoCart(client.Cart.Query().Where(cart.ID(cartID)).WithCartItems().OnlyX(ctx))
+	}
+
+	created := &pb.GetOrCreateCartResponse{}
+	if err := h.GetOrCreateCart(ctx, &pb.GetOrCreateCartRequest{UserId: uuid.NewString()}, created); err != nil {
+		t.Fatalf("GetOrCreateCart: %v", err)
+	}
+	cartID := uuid.MustParse(created.Cart.Id)
+	expectExpiry("create", cartID)
+
+	for _, productID := range []string{uuid.NewString(), uuid.NewString()} {
+		if err := h.AddCartItem(ctx, &pb.AddCartItemRequest{CartId: cartID.String(), ProductId: productID, Quantity: 1}, &pb.AddCartItemResponse{}); err != nil {
+			t.Fatalf("AddCartItem: %v", err)
+		}
+	}
+	c := expectExpiry("add", cartID)
+
+	err := h.UpdateCartItem(ctx, &pb.UpdateCartItemRequest{CartId: c.Id, CartItemId: c.CartItems[0].Id, Quantity: 3, Version: c.Version}, &pb.UpdateCartItemResponse{})
+	if err != nil {
+		t.Fatalf("UpdateCartItem: %v", err)
+	}
+	c = expectExpiry("update", cartID)
+
+	err = h.RemoveCartItem(ctx, &pb.RemoveCartItemRequest{CartId: c.Id, CartItemId: c.CartItems[0].Id, Version: c.Version}, &pb.RemoveCartItemResponse{})
+	if err != nil {
+		t.Fatalf("RemoveCartItem: %v", err)
+	}
+	c = expectExpiry("remove", cartID)
+
+	if err := h.ClearCart(ctx, &pb.ClearCartRequest{CartId: c.Id, Version: c.Version}, &pb.ClearCartResponse{}); err != nil {
+		t.Fatalf("ClearCart: %v", err)
+	}
+	expectExpiry("clear", cartID)
+
+	// An unset TTL falls back to the default
+	h.TTL = 0
+	if d := time.Until(h.expiresAt()) - DefaultCartTTL; d < -time.Second || d > time.Second {
+		t.Fatalf("expected an unset TTL to use DefaultCartTTL, off by %s", d)
+	}
+}
+
+func TestMergeCartsStrategies(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &CartService{EntClient: client}
+
+	tests := map[pb.MergeStrategy]int{
+		pb.MergeStrategy_MERGE_STRATEGY_UNSPECIFIED: 7,
+		pb.MergeStrategy_SUM:                        7,
+		pb.MergeStrategy_MAX:                        5,
+		pb.MergeStrategy_KEEP_TARGET:                2,
+		pb.MergeStrategy_KEEP_SOURCE:                5,
+	}
+	for strategy, want := range tests {
+		t.Run(strategy.String(), func(t *testing.T) {
+			shared, targetOnly, sourceOnly := uuid.New(), uuid.New(), uuid.New()
+			target := createTestCart(t, client, shared, targetOnly)
+			source := createTestCart(t, client, shared, sourceOnly)
+			client.CartItem.Update().Where(cartitem.ProductID(shared), cartitem.HasCartWith(cart.ID(target.ID))).SetQuantity(2).ExecX(ctx)
+			client.CartItem.Update().Where(cartitem.ProductID(shared), cartitem.HasCartWith(cart.ID(source.ID))).SetQuantity(5).ExecX(ctx)
+
+			rsp := &pb.MergeCartsResponse{}
+			err := h.MergeCarts(ctx, &pb.MergeCartsRequest{SourceCartId: source.ID.String(), TargetCartId: target.ID.String(), Strategy: strategy}, rsp)
+			if err != nil {
+				t.Fatalf("MergeCarts: %v", err)
+			}
+			if rsp.Cart.Id != target.ID.String() || len(rsp.Cart.CartItems) != 3 {
+				t.Fatalf("expected the target cart holding 3 items, got cart %s with %d", rsp.Cart.Id, len(rsp.Cart.CartItems))
+			}
+			for _, item := range rsp.Cart.CartItems {
+				if item.ProductId == shared.String() && item.Quantity != int32(want) {
+					t.Errorf("expected the overlapping product resolved to %d, got %d", want, item.Quantity)
+				}
+			}
+			if client.Cart.GetX(ctx, source.ID).DeletedAt == nil {
+				t.Error("expected the source cart soft-deleted after the merge")
+			}
+		})
+	}
+
+	target, source := createTestCart(t, client), createTestCart(t, client)
+	err := h.MergeCarts(ctx, &pb.MergeCartsRequest{SourceCartId: source.ID.String(), TargetCartId: target.ID.String(), Strategy: 99}, &pb.MergeCartsResponse{})
+	if err == nil || !strings.Contains(err.Error(), "invalid merge strategy") {
+		t.Fatalf("expected an unknown strategy to be rejected, got %v", err)
+	}
+}
+
+func TestCartPricesApplyQuantityTiers(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	mug := uuid.New()
+	h := &CartService{EntClient: client, Products: &fakeProducts{catalog: map[string]*productspb.Product{
+		mug.String(): {Id: mug.String(), PriceCents: 1000, Currency: "USD", IsActive: true, StockQuantity: 20, PriceTiers: []*productspb.PriceTier{
+			{MinQuantity: 3, UnitPriceCents: 900},
+			{MinQuantity: 10, UnitPriceCents: 800},
+		}},
+	}}}
+	c := createTestCart(t, client, mug)
+	item := c.Edges.CartItems[0]
+
+	for _, tc := range []struct {
+		quantity int
+		unit     int64
+	}{{1, 1000}, {3, 900}, {9, 900}, {10, 800}} {
+		client.CartItem.UpdateOne(item).SetQuantity(tc.quantity).ExecX(ctx)
+		rsp := &pb.GetCartWithAvailabilityResponse{}
+		if err := h.GetCartWithAvailability(ctx, &pb.GetCartWithAvailabilityRequest{Id: c.ID.String()}, rsp); err != nil {
+			t.Fatalf("GetCartWithAvailability: %v", err)
+		}
+		if got := rsp.Cart.CartItems[0].Availability.PriceCents; got != tc.unit {
+			t.Errorf("quantity %d: expected a unit price of %d, got %d", tc.quantity, tc.unit, got)
+		}
+		if want := int64(tc.quantity) * tc.unit; rsp.Pricing.SubtotalCents != want {
+			t.Errorf("quantity %d: expected a subtotal of %d, got %d", tc.quantity, want, rsp.Pricing.SubtotalCents)
+		}
+	}
+}
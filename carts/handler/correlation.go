@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+	"go-micro.dev/v5/metadata"
+	"go-micro.dev/v5/server"
+)
+
+// CorrelationIDKey is the metadata key carrying the correlation ID of a request across services
+const CorrelationIDKey = "Correlation-Id"
+
+// withCorrelationID returns ctx carrying the caller's correlation ID, or a new one if the
+// caller sent none, with a logger tagged with the ID and fields. Keeping the ID in the
+// context's metadata forwards it on every call and event made with that context.
+func withCorrelationID(ctx context.Context, fields map[string]interface{}) (context.Context, *logger.Helper) {
+	id, ok := metadata.Get(ctx, CorrelationIDKey)
+	if !ok || id == "" {
+		id = uuid.NewString()
+		ctx = metadata.Set(ctx, CorrelationIDKey, id)
+	}
+	fields["correlation_id"] = id
+	log := logger.NewHelper(logger.DefaultLogger.Fields(fields))
+	return log.Inject(ctx), log
+}
+
+// requestLogFields returns the log fields of a request: a request ID unique to this call, and
+// the user and cart IDs its body names. Every id field of the cart service names a cart.
+func requestLogFields(req server.Request) map[string]interface{} {
+	fields := map[string]interface{}{
+		"method":     req.Endpoint(),
+		"request_id": uuid.NewString(),
+	}
+	body := req.Body()
+	if r, ok := body.(interface{ GetUserId() string }); ok && r.GetUserId() != "" {
+		fields["user_id"] = r.GetUserId()
+	}
+	if r, ok := body.(interface{ GetCartId() string }); ok && r.GetCartId() != "" {
+		fields["cart_id"] = r.GetCartId()
+	} else if r, ok := body.(interface{ GetId() string }); ok && r.GetId() != "" {
+		fields["cart_id"] = r.GetId()
+	}
+	return fields
+}
+
+// withLogFields returns ctx with its logger extended by fields, for a handler that learns
+// them only once it has loaded something, e.g. the owner of a cart fetched by ID
+func withLogFields(ctx context.Context, fields map[string]interface{}) context.Context {
+	return logger.Extract(ctx).WithFields(fields).Inject(ctx)
+}
+
+// CorrelationWrapper tags every request with a correlation ID, a request ID and the user and
+// cart it names, and logs its outcome. Handlers log through logger.Extract(ctx) so each of
+// their lines carries those fields.
+func CorrelationWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			ctx, log := withCorrelationID(ctx, requestLogFields(req))
+
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+			if err != nil && err.Error() != streamEnd {
+				log.Errorf("Request failed after %s: %v", time.Since(start), err)
+			} else {
+				log.Infof("Request handled in %s", time.Since(start))
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+	"go-micro.dev/v5/server"
+
+	pb "carts/proto"
+)
+
+// recordingLogger keeps the fields of every line logged through it or the loggers it derives
+type recordingLogger struct {
+	logger.Logger
+	fields map[string]interface{}
+	lines  *[]map[string]interface{}
+	mu     *sync.Mutex
+}
+
+func (l *recordingLogger) Options() logger.Options { return logger.Options{Level: logger.InfoLevel} }
+
+func (l *recordingLogger) Fields(fields map[string]interface{}) logger.Logger {
+	merged := make(map[string]interface{}, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &recordingLogger{fields: merged, lines: l.lines, mu: l.mu}
+}
+
+func (l *recordingLogger) Log(level logger.Level, v ...interface{}) {
+	l.Logf(level, "%s", fmt.Sprint(v...))
+}
+
+func (l *recordingLogger) Logf(level logger.Level, format string, v ...interface{}) {
+	line := map[string]interface{}{"msg": fmt.Sprintf(format, v...)}
+	for k, v := range l.fields {
+		line[k] = v
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	*l.lines = append(*l.lines, line)
+}
+
+// fakeRequest is a server.Request of an endpoint with a body
+type fakeRequest struct {
+	server.Request
+	endpoint string
+	body     interface{}
+}
+
+func (r *fakeRequest) Endpoint() string  { return r.endpoint }
+func (r *fakeRequest) Body() interface{} { return r.body }
+
+func TestCorrelationWrapperTagsHandlerLogs(t *testing.T) {
+	var lines []map[string]interface{}
+	previous := logger.DefaultLogger
+	logger.DefaultLogger = &recordingLogger{lines: &lines, mu: &sync.Mutex{}}
+	t.Cleanup(func() { logger.DefaultLogger = previous })
+
+	client := newTestClient(t)
+	c := createTestCart(t, client)
+	h := &CartService{EntClient: client}
+	handle := CorrelationWrapper()(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		return h.GetCart(ctx, req.Body().(*pb.GetCartRequest), rsp.(*pb.GetCartResponse))
+	})
+
+	req := &fakeRequest{endpoint: "CartService.GetCart", body: &pb.GetCartRequest{Id: c.ID.String()}}
+	if err := handle(context.Background(), req, &pb.GetCartResponse{}); err != nil {
+		t.Fatalf("GetCart: %v", err)
+	}
+
+	if len(lines) == 0 {
+		t.Fatal("expected the request to be logged")
+	}
+	requestID := lines[0]["request_id"]
+	if _, err := uuid.Parse(fmt.Sprint(requestID)); err != nil {
+		t.Fatalf("expected a request ID, got %v", requestID)
+	}
+	var ownerTagged bool
+	for _, line := range lines {
+		if line["request_id"] != requestID || line["cart_id"] != c.ID.String() || line["method"] != "CartService.GetCart" || line["correlation_id"] == nil {
+			t.Errorf("expected every line tagged with the request, got %v", line)
+		}
+		// GetCart learns the cart's owner once it has loaded the cart
+		ownerTagged = ownerTagged || line["user_id"] == c.UserID.String()
+	}
+	if !ownerTagged {
+		t.Fatalf("expected lines after the cart was loaded tagged with user %s", c.UserID)
+	}
+
+	// Each request gets its own request ID
+	lines = nil
+	if err := handle(context.Background(), req, &pb.GetCartResponse{}); err != nil {
+		t.Fatalf("GetCart: %v", err)
+	}
+	if len(lines) == 0 || lines[0]["request_id"] == requestID {
+		t.Fatalf("expected a new request ID, got %v", lines)
+	}
+}
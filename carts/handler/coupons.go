@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/coupon"
+	"carts/ent/predicate"
+	pb "carts/proto"
+	productspb "products/proto"
+)
+
+// couponCodePattern matches letters and digits, optionally separated by single dashes
+var couponCodePattern = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
+// normalizeCouponCode validates a coupon code and returns it upper-cased, so codes are matched case-insensitively
+func normalizeCouponCode(id, code string) (string, error) {
+	c := strings.TrimSpace(code)
+	if !couponCodePattern.MatchString(c) {
+		return "", errors.BadRequest(id, "invalid coupon code %q: use letters and digits, optionally separated by single dashes", code)
+	}
+	return strings.ToUpper(c), nil
+}
+
+// couponUnavailable returns a PreconditionFailed error telling why c can no longer be used,
+// expiry taking precedence over exhaustion, or nil if it still can be
+func couponUnavailable(id string, c *ent.Coupon, now time.Time) error {
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(now) {
+		return errors.New(id, "coupon expired", http.StatusPreconditionFailed)
+	}
+	if c.MaxUses != nil && c.UsedCount >= *c.MaxUses {
+		return errors.New(id, "coupon usage limit reached", http.StatusPreconditionFailed)
+	}
+	return nil
+}
+
+// couponDiscount returns how much c takes off subtotal, never more than the subtotal;
+// percentages are rounded down to the cent
+func couponDiscount(c *ent.Coupon, subtotal int64) int64 {
+	if c == nil {
+		return 0
+	}
+	discount := c.Amount
+	if c.DiscountType == coupon.DiscountTypePercent {
+		discount = subtotal * c.Amount / 100
+	}
+	return min(discount, subtotal)
+}
+
+// usableCoupon matches a coupon that has uses left, so counting a use cannot overshoot max_uses
+func usableCoupon() predicate.Coupon {
+	return coupon.Or(
+		coupon.MaxUsesIsNil(),
+		predicate.Coupon(func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(coupon.FieldUsedCount), s.C(coupon.FieldMaxUses)))
+		}),
+	)
+}
+
+// priceCart prices the items of c, loaded with its items and coupon, at current catalog
+// prices, each quantity's price tier applied, and applies its coupon; it returns nil when product validation is disabled
+func (h *CartService) priceCart(ctx context.Context, c *ent.Cart) (*pb.CartPricing, error) {
+	if h.Products == nil {
+		return nil, nil
+	}
+	catalog, err := lookupProducts(ctx, h.Products, c.Edges.CartItems)
+	if err != nil {
+		return nil, err
+	}
+	return cartPricing(c, catalog), nil
+}
+
+// cartPricing prices the items of c, loaded with its items and coupon, from catalog and applies its coupon
+func cartPricing(c *ent.Cart, catalog map[string]*productspb.Product) *pb.CartPricing {
+	pricing := &pb.CartPricing{}
+	for _, item := range c.Edges.CartItems {
+		p, ok := catalog[item.ProductID.String()]
+		if !ok {
+			continue
+		}
+		pricing.SubtotalCents += int64(item.Quantity) * unitPrice(p, item.Quantity)
+		if pricing.Currency == "" {
+			pricing.Currency = p.Currency
+		}
+	}
+	pricing.DiscountCents = couponDiscount(c.Edges.Coupon, pricing.SubtotalCents)
+	pricing.TotalCents = pricing.SubtotalCents - pricing.DiscountCents
+	return pricing
+}
+
+// pricedCart fetches an active cart with its items and coupon and prices it
+func (h *CartService) pricedCart(ctx context.Context, cartID uuid.UUID) (*pb.Cart, *pb.CartPricing, error) {
+	c, err := h.EntClient.Cart.Query().
+		Where(cart.ID(cartID)).
+		WithCartItems().
+		WithCoupon().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch cart %s: %v", cartID, err)
+		return nil, nil, fmt.Errorf("failed to fetch cart: %w", err)
+	}
+	pricing, err := h.priceCart(ctx, c)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to price cart %s: %v", cartID, err)
+		return nil, nil, err
+	}
+	return toProtoCart(c), pricing, nil
+}
+
+// ApplyCoupon applies a coupon to an active cart, replacing any coupon already applied,
+// and returns the cart priced with its discount. The coupon's use is counted at checkout.
+func (h *CartService) ApplyCoupon(ctx context.Context, req *pb.ApplyCouponRequest, rsp *pb.ApplyCouponResponse) error {
+	logger.Extract(ctx).Infof("Received ApplyCoupon request for cart_id: %s, code: %s", req.CartId, req.Code)
+
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+	code, err := normalizeCouponCode("carts.ApplyCoupon", req.Code)
+	if err != nil {
+		return err
+	}
+
+	cp, err := h.EntClient.Coupon.Query().Where(coupon.Code(code)).Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Coupon not found: %s", code)
+		return errors.NotFound("carts.ApplyCoupon", "coupon not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get coupon: %v", err)
+		return fmt.Errorf("failed to get coupon: %w", err)
+	}
+	now := time.Now()
+	if err := couponUnavailable("carts.ApplyCoupon", cp, now); err != nil {
+		logger.Extract(ctx).Infof("Coupon %s is unavailable: %v", code, err)
+		return err
+	}
+
+	err = h.EntClient.Cart.UpdateOneID(cartID).
+		Where(cart.DeletedAtIsNil(), cart.ExpiresAtGT(now)).
+		SetCoupon(cp).
+		SetLastActivityAt(now).
+		SetExpiresAt(h.expiresAt()).
+		AddVersion(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found or expired: %s", req.CartId)
+		return errors.NotFound("carts.ApplyCoupon", "cart not found or expired")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to apply coupon to cart: %v", err)
+		return fmt.Errorf("failed to apply coupon: %w", err)
+	}
+
+	rsp.Cart, rsp.Pricing, err = h.pricedCart(ctx, cartID)
+	if err != nil {
+		return err
+	}
+	logger.Extract(ctx).Infof("Coupon %s applied to cart %s", code, req.CartId)
+	return nil
+}
+
+// RemoveCoupon removes the coupon applied to an active cart, if any, and returns the cart priced without it
+func (h *CartService) RemoveCoupon(ctx context.Context, req *pb.RemoveCouponRequest, rsp *pb.RemoveCouponResponse) error {
+	logger.Extract(ctx).Infof("Received RemoveCoupon request for cart_id: %s", req.CartId)
+
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+
+	now := time.Now()
+	err = h.EntClient.Cart.UpdateOneID(cartID).
+		Where(cart.DeletedAtIsNil(), cart.ExpiresAtGT(now)).
+		ClearCoupon().
+		SetLastActivityAt(now).
+		SetExpiresAt(h.expiresAt()).
+		AddVersion(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found or expired: %s", req.CartId)
+		return errors.NotFound("carts.RemoveCoupon", "cart not found or expired")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to remove coupon from cart: %v", err)
+		return fmt.Errorf("failed to remove coupon: %w", err)
+	}
+
+	rsp.Cart, rsp.Pricing, err = h.pricedCart(ctx, cartID)
+	if err != nil {
+		return err
+	}
+	logger.Extract(ctx).Infof("Coupon removed from cart %s", req.CartId)
+	return nil
+}
+
+// CreateCoupon creates a percent or fixed-amount coupon (admin privilege)
+func (h *AdminService) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest, rsp *pb.CreateCouponResponse) error {
+	logger.Extract(ctx).Infof("Received CreateCoupon request for code: %s, type: %s, amount: %d (Admin operation)", req.Code, req.DiscountType, req.Amount)
+
+	code, err := normalizeCouponCode("carts.CreateCoupon", req.Code)
+	if err != nil {
+		return err
+	}
+	discountType := coupon.DiscountType(req.DiscountType)
+	if err := coupon.DiscountTypeValidator(discountType); err != nil {
+		return errors.BadRequest("carts.CreateCoupon", "invalid discount_type %q: use percent or fixed", req.DiscountType)
+	}
+	if req.Amount <= 0 || (discountType == coupon.DiscountTypePercent && req.Amount > 100) {
+		return errors.BadRequest("carts.CreateCoupon", "invalid amount %d for a %s coupon", req.Amount, discountType)
+	}
+	if req.MaxUses < 0 {
+		return errors.BadRequest("carts.CreateCoupon", "max_uses must not be negative")
+	}
+
+	create := h.EntClient.Coupon.Create().
+		SetCode(code).
+		SetDiscountType(discountType).
+		SetAmount(req.Amount)
+	if req.ExpiresAt > 0 {
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		if !expiresAt.After(time.Now()) {
+			return errors.BadRequest("carts.CreateCoupon", "expires_at must be in the future")
+		}
+		create.SetExpiresAt(expiresAt)
+	}
+	if req.MaxUses > 0 {
+		create.SetMaxUses(int(req.MaxUses))
+	}
+	cp, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		logger.Extract(ctx).Infof("Coupon code already in use: %s", code)
+		return errors.Conflict("carts.CreateCoupon", "coupon code already in use")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to create coupon: %v", err)
+		return fmt.Errorf("failed to create coupon: %w", err)
+	}
+
+	rsp.Coupon = toProtoCoupon(cp)
+	logger.Extract(ctx).Infof("Coupon created successfully: %s", code)
+	return nil
+}
+
+// toProtoCoupon converts an Entgo Coupon entity to a Protobuf Coupon message
+func toProtoCoupon(c *ent.Coupon) *pb.Coupon {
+	protoCoupon := &pb.Coupon{
+		Id:           c.ID.String(),
+		Code:         c.Code,
+		DiscountType: string(c.DiscountType),
+		Amount:       c.Amount,
+		UsedCount:    int32(c.UsedCount),
+		CreatedAt:    c.CreatedAt.Unix(),
+	}
+	if c.ExpiresAt != nil {
+		protoCoupon.ExpiresAt = c.ExpiresAt.Unix()
+	}
+	if c.MaxUses != nil {
+		protoCoupon.MaxUses = int32(*c.MaxUses)
+	}
+	return protoCoupon
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"go-micro.dev/v5/client"
+	"go-micro.dev/v5/errors"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/enttest"
+
+	productspb "products/proto"
+)
+
+// newTestClient opens a migrated in-memory SQLite database private to the test
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestCart stores a cart of a new user holding one item of quantity 1 per product
+func createTestCart(t *testing.T, client *ent.Client, productIDs ...uuid.UUID) *ent.Cart {
+	t.Helper()
+	ctx := context.Background()
+	c, err := client.Cart.Create().SetUserID(uuid.New()).Save(ctx)
+	if err != nil {
+		t.Fatalf("creating cart: %v", err)
+	}
+	for _, productID := range productIDs {
+		_, err := client.CartItem.Create().
+			SetCartID(c.ID).
+			SetProductID(productID).
+			SetQuantity(1).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("creating cart item: %v", err)
+		}
+	}
+	return client.Cart.Query().Where(cart.ID(c.ID)).WithCartItems().OnlyX(ctx)
+}
+
+// fakeProducts serves GetProduct and GetProductsByIDs from a fixed catalog
+type fakeProducts struct {
+	productspb.ProductService
+	catalog map[string]*productspb.Product
+}
+
+func (f *fakeProducts) GetProduct(ctx context.Context, req *productspb.GetProductRequest, opts ...client.CallOption) (*productspb.GetProductResponse, error) {
+	p, ok := f.catalog[req.Id]
+	if !ok {
+		return nil, errors.NotFound("products.GetProduct", "product not found")
+	}
+	return &productspb.GetProductResponse{Product: p}, nil
+}
+
+func (f *fakeProducts) GetProductsByIDs(ctx context.Context, req *productspb.GetProductsByIDsRequest, opts ...client.CallOption) (*productspb.GetProductsByIDsResponse, error) {
+	rsp := &productspb.GetProductsByIDsResponse{}
+	for _, id := range req.Ids {
+		if p, ok := f.catalog[id]; ok {
+			rsp.Products = append(rsp.Products, p)
+		}
+	}
+	return rsp, nil
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"go-micro.dev/v5/errors"
+
+	"carts/ent"
+	pb "carts/proto"
+
+	productspb "products/proto"
+)
+
+// errProductNotFound is returned by lookupProduct when the catalog has no such product
+var errProductNotFound = stderrors.New("product not found")
+
+// lookupProduct fetches a product from the products service, distinguishing a missing product from a failed call
+func lookupProduct(ctx context.Context, products productspb.ProductService, productID string) (*productspb.Product, error) {
+	rsp, err := products.GetProduct(ctx, &productspb.GetProductRequest{Id: productID})
+	if err != nil {
+		if errors.FromError(err).Code == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", errProductNotFound, productID)
+		}
+		return nil, fmt.Errorf("failed to validate product: %w", err)
+	}
+	return rsp.Product, nil
+}
+
+// isProductNotFound reports whether err came from looking up a product missing from the catalog
+func isProductNotFound(err error) bool {
+	return stderrors.Is(err, errProductNotFound)
+}
+
+// lookupProducts fetches the products of items in a single call, keyed by product ID;
+// products missing from the catalog are absent from the map
+func lookupProducts(ctx context.Context, products productspb.ProductService, items []*ent.CartItem) (map[string]*productspb.Product, error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ProductID.String()
+	}
+
+	rsp, err := products.GetProductsByIDs(ctx, &productspb.GetProductsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up products: %w", err)
+	}
+
+	catalog := make(map[string]*productspb.Product, len(rsp.Products))
+	for _, p := range rsp.Products {
+		catalog[p.Id] = p
+	}
+	return catalog, nil
+}
+
+// unitPrice returns the price of one unit of p when quantity units are bought: the price of
+// the tier with the highest min_quantity that quantity reaches, or the product price below
+// every tier, as products.GetEffectivePrice prices it
+func unitPrice(p *productspb.Product, quantity int) int64 {
+	price, best := p.PriceCents, int32(0)
+	for _, t := range p.PriceTiers {
+		if int(t.MinQuantity) <= quantity && t.MinQuantity > best {
+			price, best = t.UnitPriceCents, t.MinQuantity
+		}
+	}
+	return price
+}
+
+// itemAvailability reports whether quantity of p can be bought; p is nil for a product missing from the catalog
+func itemAvailability(p *productspb.Product, quantity int) *pb.CartItemAvailability {
+	if p == nil {
+		return &pb.CartItemAvailability{}
+	}
+	a := &pb.CartItemAvailability{
+		Found:      true,
+		IsActive:   p.IsActive,
+		InStock:    int(p.StockQuantity) >= quantity,
+		PriceCents: unitPrice(p, quantity),
+		Currency:   p.Currency,
+	}
+	a.Available = a.IsActive && a.InStock
+	return a
+}
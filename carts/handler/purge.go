@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/cartitem"
+)
+
+// purgeBatches repeatedly takes the next batch of purgeable ids and hard-deletes
+// them until a short batch shows nothing is left, returning how many were purged.
+// The services share no code, so users/handler/purge.go has the same copy; change both.
+func purgeBatches[ID any](ctx context.Context, batchSize int, next func(context.Context, int) ([]ID, error), purge func(context.Context, []ID) (int, error)) (int, error) {
+	var purged int
+	for {
+		ids, err := next(ctx, batchSize)
+		if err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+
+		n, err := purge(ctx, ids)
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+
+		if len(ids) < batchSize {
+			return purged, nil
+		}
+	}
+}
+
+// CartPurger hard-deletes carts once they have been soft-deleted for longer than Retention
+type CartPurger struct {
+	EntClient *ent.Client
+	// Retention is how long a soft-deleted cart is kept; zero disables purging
+	Retention time.Duration
+	// BatchSize caps the carts purged per transaction; zero uses defaultPurgeBatchSize
+	BatchSize int
+}
+
+// defaultPurgeBatchSize is used when CartPurger.BatchSize is unset
+const defaultPurgeBatchSize = 500
+
+// Purge hard-deletes carts soft-deleted before the retention window, with their items
+func (p *CartPurger) Purge(ctx context.Context) (int, error) {
+	if p.Retention <= 0 {
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-p.Retention)
+
+	next := func(ctx context.Context, limit int) ([]uuid.UUID, error) {
+		ids, err := p.EntClient.Cart.Query().
+			Where(cart.DeletedAtLT(cutoff)).
+			Limit(limit).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query purgeable carts: %w", err)
+		}
+		return ids, nil
+	}
+	batchSize := p.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+	return purgeBatches(ctx, batchSize, next, p.purge)
+}
+
+// purge hard-deletes the given carts and their items in one transaction
+func (p *CartPurger) purge(ctx context.Context, ids []uuid.UUID) (int, error) {
+	tx, err := p.EntClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.CartItem.Delete().Where(cartitem.HasCartWith(cart.IDIn(ids...))).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to purge cart items: %w", err)
+	}
+	n, err := tx.Cart.Delete().Where(cart.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge carts: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit purge: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"carts/ent/cart"
+	"carts/ent/cartitem"
+)
+
+func TestCartPurgerHonoursRetention(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	p := &CartPurger{EntClient: client, Retention: 24 * time.Hour, BatchSize: 1}
+
+	live := createTestCart(t, client, uuid.New())
+	recent := createTestCart(t, client, uuid.New())
+	client.Cart.UpdateOne(recent).SetDeletedAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+	var expired []uuid.UUID
+	for i := 0; i < 3; i++ {
+		c := createTestCart(t, client, uuid.New())
+		client.Cart.UpdateOne(c).SetDeletedAt(time.Now().Add(-48 * time.Hour)).ExecX(ctx)
+		expired = append(expired, c.ID)
+	}
+
+	n, err := p.Purge(ctx)
+	if err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	if n != len(expired) {
+		t.Fatalf("expected %d carts purged, got %d", len(expired), n)
+	}
+	if client.Cart.Query().Where(cart.IDIn(expired...)).ExistX(ctx) {
+		t.Fatal("expected carts deleted past the retention window to be purged")
+	}
+	if client.CartItem.Query().Where(cartitem.HasCartWith(cart.IDIn(expired...))).ExistX(ctx) {
+		t.Fatal("expected the items of purged carts to be purged")
+	}
+	if ids := client.Cart.Query().IDsX(ctx); len(ids) != 2 {
+		t.Fatalf("expected the live and recently deleted carts to survive, %d carts left", len(ids))
+	}
+	if client.CartItem.Query().CountX(ctx) != len(live.Edges.CartItems)+len(recent.Edges.CartItems) {
+		t.Fatal("expected the items of surviving carts to be kept")
+	}
+
+	p.Retention = 0
+	if n, err := p.Purge(ctx); err != nil || n != 0 {
+		t.Fatalf("expected a zero retention to purge nothing, got %d, %v", n, err)
+	}
+}
+
+func TestCartPurgerDefaultsBatchSize(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	p := &CartPurger{EntClient: client, Retention: time.Hour}
+
+	for i := 0; i < 3; i++ {
+		c := createTestCart(t, client, uuid.New())
+		client.Cart.UpdateOne(c).SetDeletedAt(time.Now().Add(-2 * time.Hour)).ExecX(ctx)
+	}
+	if n, err := p.Purge(ctx); err != nil || n != 3 {
+		t.Fatalf("expected an unset batch size to purge all 3 carts, got %d, %v", n, err)
+	}
+}
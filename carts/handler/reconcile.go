@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/cartitem"
+	"carts/ent/cartsnapshot"
+	pb "carts/proto"
+)
+
+// ReconcileCartVersions raises the version of carts that fell behind their contents, e.g.
+// after a bulk import or restore wrote them directly (admin privilege). A cart starts at
+// version 1 and every item added bumps it, so its version is at least one more than its
+// item count, and never below the version of its newest snapshot. Raising a version only
+// makes clients holding an older one re-read the cart.
+func (h *AdminService) ReconcileCartVersions(ctx context.Context, req *pb.ReconcileCartVersionsRequest, rsp *pb.ReconcileCartVersionsResponse) error {
+	logger.Extract(ctx).Infof("Received ReconcileCartVersions request (limit: %d, after_id: %s) (Admin operation)", req.Limit, req.AfterId)
+
+	query := h.EntClient.Cart.Query().Order(ent.Asc(cart.FieldID))
+	if req.AfterId != "" {
+		afterID, err := uuid.Parse(req.AfterId)
+		if err != nil {
+			return fmt.Errorf("invalid after_id format: %w", err)
+		}
+		query.Where(cart.IDGT(afterID))
+	}
+	if req.Limit > 0 {
+		query.Limit(int(req.Limit))
+	}
+	carts, err := query.Select(cart.FieldID, cart.FieldVersion).All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query carts to reconcile: %v", err)
+		return fmt.Errorf("failed to query carts: %w", err)
+	}
+	if len(carts) == 0 {
+		logger.Extract(ctx).Info("No carts to reconcile")
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(carts))
+	for i, c := range carts {
+		ids[i] = c.ID
+	}
+	floors, err := versionFloors(ctx, h.EntClient, ids)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to compute cart version floors: %v", err)
+		return fmt.Errorf("failed to compute cart versions: %w", err)
+	}
+
+	var repaired int
+	for _, c := range carts {
+		floor := floors[c.ID]
+		if c.Version >= floor {
+			continue
+		}
+		// Only raise the version; a cart changed since it was read needs no repair from us
+		n, err := h.EntClient.Cart.Update().
+			Where(cart.ID(c.ID), cart.VersionLT(floor)).
+			SetVersion(floor).
+			Save(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to repair version of cart %s after repairing %d: %v", c.ID, repaired, err)
+			return fmt.Errorf("failed to repair cart version: %w", err)
+		}
+		if n > 0 {
+			logger.Extract(ctx).Infof("Raised version of cart %s from %d to %d", c.ID, c.Version, floor)
+			repaired++
+		}
+	}
+
+	rsp.Scanned = int32(len(carts))
+	rsp.Repaired = int32(repaired)
+	rsp.LastId = carts[len(carts)-1].ID.String()
+	logger.Extract(ctx).Infof("Reconciled cart versions: %d scanned, %d repaired", len(carts), repaired)
+	return nil
+}
+
+// versionFloors returns the lowest consistent version of each cart: one more than its item
+// count, or the version of its newest snapshot if that is higher
+func versionFloors(ctx context.Context, client *ent.Client, ids []uuid.UUID) (map[uuid.UUID]int, error) {
+	var items []struct {
+		CartID uuid.UUID `json:"cart_cart_items"`
+		Count  int       `json:"count"`
+	}
+	err := client.CartItem.Query().
+		Where(cartitem.HasCartWith(cart.IDIn(ids...))).
+		GroupBy(cartitem.CartColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cart items: %w", err)
+	}
+
+	var snapshots []struct {
+		CartID  uuid.UUID `json:"cart_snapshots"`
+		Version int       `json:"max_version"`
+	}
+	err = client.CartSnapshot.Query().
+		Where(cartsnapshot.HasCartWith(cart.IDIn(ids...))).
+		GroupBy(cartsnapshot.CartColumn).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(sql.Max(s.C(cartsnapshot.FieldVersion)), "max_version")
+		}).
+		Scan(ctx, &snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find newest cart snapshots: %w", err)
+	}
+
+	floors := make(map[uuid.UUID]int, len(ids))
+	for _, id := range ids {
+		floors[id] = 1
+	}
+	for _, i := range items {
+		floors[i.CartID] = i.Count + 1
+	}
+	for _, s := range snapshots {
+		floors[s.CartID] = max(floors[s.CartID], s.Version)
+	}
+	return floors, nil
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"carts/ent/schema"
+	pb "carts/proto"
+)
+
+func TestReconcileCartVersionsRepairsDriftedCarts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+
+	// Three items imported without bumping the version
+	drifted := createTestCart(t, client, uuid.New(), uuid.New(), uuid.New())
+	// Ahead of its items, as after removals
+	consistent := createTestCart(t, client, uuid.New())
+	client.Cart.UpdateOne(consistent).SetVersion(5).ExecX(ctx)
+	// Restored behind a snapshot it already had
+	restored := createTestCart(t, client)
+	client.Cart.UpdateOne(restored).SetVersion(2).ExecX(ctx)
+	client.CartSnapshot.Create().SetCartID(restored.ID).SetVersion(7).SetItems([]schema.CartSnapshotItem{}).ExecX(ctx)
+
+	// Page through every cart two at a time
+	var scanned, repaired int32
+	var after string
+	for {
+		rsp := &pb.ReconcileCartVersionsResponse{}
+		if err := admin.ReconcileCartVersions(ctx, &pb.ReconcileCartVersionsRequest{Limit: 2, AfterId: after}, rsp); err != nil {
+			t.Fatalf("ReconcileCartVersions: %v", err)
+		}
+		if rsp.Scanned == 0 {
+			break
+		}
+		scanned, repaired, after = scanned+rsp.Scanned, repaired+rsp.Repaired, rsp.LastId
+	}
+	if scanned != 3 || repaired != 2 {
+		t.Fatalf("expected 2 of 3 carts repaired, got %d of %d", repaired, scanned)
+	}
+
+	want := map[uuid.UUID]int{drifted.ID: 4, consistent.ID: 5, restored.ID: 7}
+	for id, version := range want {
+		if got := client.Cart.GetX(ctx, id).Version; got != version {
+			t.Errorf("cart %s: expected version %d, got %d", id, version, got)
+		}
+	}
+
+	rsp := &pb.ReconcileCartVersionsResponse{}
+	if err := admin.ReconcileCartVersions(ctx, &pb.ReconcileCartVersionsRequest{}, rsp); err != nil {
+		t.Fatalf("ReconcileCartVersions: %v", err)
+	}
+	if rsp.Repaired != 0 {
+		t.Fatalf("expected reconciled carts to need no further repair, got %d", rsp.Repaired)
+	}
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/cartsnapshot"
+	"carts/ent/hook"
+	"carts/ent/schema"
+	pb "carts/proto"
+)
+
+// DefaultMaxCartSnapshots is how many snapshots are kept per cart unless configured otherwise
+const DefaultMaxCartSnapshots = 20
+
+// CartSnapshots records a cart's items each time its version is bumped, keeping the newest
+// Max snapshots per cart (zero keeps them all)
+type CartSnapshots struct {
+	Max int
+}
+
+// Hook returns an ent hook that snapshots a cart after every update bumping its version.
+// Handlers bump the version last, after changing the items, and the snapshot is taken in
+// the same transaction, so it holds exactly the contents being committed.
+func (s *CartSnapshots) Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.CartFunc(func(ctx context.Context, m *ent.CartMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			id, ok := m.ID()
+			if _, bumped := m.AddedVersion(); !bumped || !ok {
+				return v, nil
+			}
+			if _, err := s.record(ctx, m.Client(), id); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}, ent.OpUpdateOne)
+}
+
+// record snapshots a cart's current items and prunes its snapshots beyond Max
+func (s *CartSnapshots) record(ctx context.Context, client *ent.Client, cartID uuid.UUID) (*ent.CartSnapshot, error) {
+	c, err := client.Cart.Query().Where(cart.ID(cartID)).WithCartItems().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cart %s for snapshot: %w", cartID, err)
+	}
+
+	items := make([]schema.CartSnapshotItem, len(c.Edges.CartItems))
+	for i, item := range c.Edges.CartItems {
+		items[i] = schema.CartSnapshotItem{ProductID: item.ProductID, ProductName: item.ProductName, Quantity: item.Quantity}
+	}
+	snapshot, err := client.CartSnapshot.Create().
+		SetCartID(cartID).
+		SetVersion(c.Version).
+		SetItems(items).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save snapshot of cart %s: %w", cartID, err)
+	}
+
+	if s.Max > 0 {
+		stale, err := client.CartSnapshot.Query().
+			Where(cartsnapshot.HasCartWith(cart.ID(cartID))).
+			Order(ent.Desc(cartsnapshot.FieldVersion, cartsnapshot.FieldCreatedAt)).
+			Offset(s.Max).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query stale snapshots of cart %s: %w", cartID, err)
+		}
+		if len(stale) > 0 {
+			if _, err := client.CartSnapshot.Delete().Where(cartsnapshot.IDIn(stale...)).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to prune snapshots of cart %s: %w", cartID, err)
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// SnapshotCart records a snapshot of a cart's current contents on demand (admin privilege)
+func (h *AdminService) SnapshotCart(ctx context.Context, req *pb.SnapshotCartRequest, rsp *pb.SnapshotCartResponse) error {
+	logger.Extract(ctx).Infof("Received SnapshotCart request for cart_id: %s (Admin operation)", req.CartId)
+
+	if h.Snapshots == nil {
+		return fmt.Errorf("cart snapshots are disabled")
+	}
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+
+	snapshot, err := h.Snapshots.record(ctx, h.EntClient, cartID)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found for snapshot: %s", req.CartId)
+		return fmt.Errorf("cart not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to snapshot cart: %v", err)
+		return err
+	}
+
+	rsp.Snapshot = toProtoCartSnapshot(cartID, snapshot)
+	logger.Extract(ctx).Infof("Cart %s snapshotted at version %d", req.CartId, snapshot.Version)
+	return nil
+}
+
+// GetCartSnapshots lists the retained snapshots of a cart, newest first (admin privilege)
+func (h *AdminService) GetCartSnapshots(ctx context.Context, req *pb.GetCartSnapshotsRequest, rsp *pb.GetCartSnapshotsResponse) error {
+	logger.Extract(ctx).Infof("Received GetCartSnapshots request for cart_id: %s (Admin operation)", req.CartId)
+
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+
+	snapshots, err := h.EntClient.CartSnapshot.Query().
+		Where(cartsnapshot.HasCartWith(cart.ID(cartID))).
+		Order(ent.Desc(cartsnapshot.FieldVersion, cartsnapshot.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list snapshots of cart %s: %v", req.CartId, err)
+		return fmt.Errorf("failed to list cart snapshots: %w", err)
+	}
+
+	rsp.Snapshots = make([]*pb.CartSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		rsp.Snapshots[i] = toProtoCartSnapshot(cartID, snapshot)
+	}
+	logger.Extract(ctx).Infof("Listed %d snapshots of cart %s", len(snapshots), req.CartId)
+	return nil
+}
+
+// toProtoCartSnapshot converts an Entgo CartSnapshot entity to a Protobuf CartSnapshot message
+func toProtoCartSnapshot(cartID uuid.UUID, s *ent.CartSnapshot) *pb.CartSnapshot {
+	items := make([]*pb.CartSnapshotItem, len(s.Items))
+	for i, item := range s.Items {
+		items[i] = &pb.CartSnapshotItem{
+			ProductId:   item.ProductID.String(),
+			ProductName: item.ProductName,
+			Quantity:    int32(item.Quantity),
+		}
+	}
+	return &pb.CartSnapshot{
+		Id:        s.ID.String(),
+		CartId:    cartID.String(),
+		Version:   int32(s.Version),
+		Items:     items,
+		CreatedAt: s.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "carts/proto"
+)
+
+func TestCartSnapshotsRecordedOnMutationAndCapped(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	snapshots := &CartSnapshots{Max: 3}
+	client.Use(snapshots.Hook())
+	h := &CartService{EntClient: client}
+	admin := &AdminService{EntClient: client, Snapshots: snapshots}
+
+	c := createTestCart(t, client)
+	for i := 0; i < 5; i++ {
+		err := h.AddCartItem(ctx, &pb.AddCartItemRequest{CartId: c.ID.String(), ProductId: uuid.NewString(), Quantity: 1}, &pb.AddCartItemResponse{})
+		if err != nil {
+			t.Fatalf("AddCartItem: %v", err)
+		}
+	}
+
+	rsp := &pb.GetCartSnapshotsResponse{}
+	if err := admin.GetCartSnapshots(ctx, &pb.GetCartSnapshotsRequest{CartId: c.ID.String()}, rsp); err != nil {
+		t.Fatalf("GetCartSnapshots: %v", err)
+	}
+	if len(rsp.Snapshots) != snapshots.Max {
+		t.Fatalf("expected snapshots capped at %d, got %d", snapshots.Max, len(rsp.Snapshots))
+	}
+	current := client.Cart.GetX(ctx, c.ID)
+	for i, s := range rsp.Snapshots {
+		// Newest first, each holding the items as of its version
+		wantVersion, wantItems := int32(current.Version-i), 5-i
+		if s.Version != wantVersion || len(s.Items) != wantItems {
+			t.Errorf("snapshot %d: got version %d with %d items, want version %d with %d", i, s.Version, len(s.Items), wantVersion, wantItems)
+		}
+	}
+
+	// An explicit snapshot is recorded at the current version and counts against the cap
+	snap := &pb.SnapshotCartResponse{}
+	if err := admin.SnapshotCart(ctx, &pb.SnapshotCartRequest{CartId: c.ID.String()}, snap); err != nil {
+		t.Fatalf("SnapshotCart: %v", err)
+	}
+	if snap.Snapshot.Version != int32(current.Version) || len(snap.Snapshot.Items) != 5 {
+		t.Fatalf("expected a snapshot of version %d with 5 items, got %v", current.Version, snap.Snapshot)
+	}
+	if n := client.CartSnapshot.Query().CountX(ctx); n != snapshots.Max {
+		t.Fatalf("expected %d snapshots retained, got %d", snapshots.Max, n)
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-micro.dev/v5/logger"
+
+	"carts/ent"
+	"carts/ent/cart"
+)
+
+// CartSweeper periodically soft-deletes carts that have passed their expiry time
+// and hard-purges carts soft-deleted for longer than the retention window
+type CartSweeper struct {
+	EntClient *ent.Client
+	Interval  time.Duration
+	BatchSize int
+	// Purger hard-deletes carts past retention after each sweep; nil disables purging
+	Purger *CartPurger
+}
+
+// Run sweeps expired carts every Interval until ctx is cancelled
+func (s *CartSweeper) Run(ctx context.Context) {
+	logger.Extract(ctx).Infof("Cart sweeper started (interval: %s, batch size: %d)", s.Interval, s.BatchSize)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Extract(ctx).Info("Cart sweeper stopped")
+			return
+		case <-ticker.C:
+			swept, err := s.Sweep(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Cart sweep failed after sweeping %d carts: %v", swept, err)
+				continue
+			}
+			logger.Extract(ctx).Infof("Cart sweep completed: %d expired carts soft deleted", swept)
+
+			if s.Purger == nil {
+				continue
+			}
+			purged, err := s.Purger.Purge(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Cart purge failed after purging %d carts: %v", purged, err)
+				continue
+			}
+			logger.Extract(ctx).Infof("Cart purge completed: %d carts past retention hard deleted", purged)
+		}
+	}
+}
+
+// Sweep soft-deletes all expired carts in batches and returns how many were swept
+func (s *CartSweeper) Sweep(ctx context.Context) (int, error) {
+	var swept int
+	for {
+		ids, err := s.EntClient.Cart.Query().
+			Where(
+				cart.DeletedAtIsNil(),
+				cart.ExpiresAtLT(time.Now()),
+			).
+			Limit(s.BatchSize).
+			IDs(ctx)
+		if err != nil {
+			return swept, fmt.Errorf("failed to query expired carts: %w", err)
+		}
+		if len(ids) == 0 {
+			return swept, nil
+		}
+
+		n, err := s.EntClient.Cart.Update().
+			Where(
+				cart.IDIn(ids...),
+				cart.DeletedAtIsNil(),
+			).
+			SetDeletedAt(time.Now()).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return swept, fmt.Errorf("failed to soft delete expired carts: %w", err)
+		}
+		swept += n
+
+		if len(ids) < s.BatchSize {
+			return swept, nil
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"carts/ent"
+	"carts/ent/cart"
+	"carts/ent/cartitem"
+	"carts/ent/wishlistitem"
+	pb "carts/proto"
+)
+
+// MoveCartItemToWishlist removes an item from a cart and saves its product to the cart
+// owner's wishlist in one transaction. A product already on the wishlist keeps its entry.
+func (h *CartService) MoveCartItemToWishlist(ctx context.Context, req *pb.MoveCartItemToWishlistRequest, rsp *pb.MoveCartItemToWishlistResponse) error {
+	logger.Extract(ctx).Infof("Received MoveCartItemToWishlist request for cart_id: %s, cart_item_id: %s", req.CartId, req.CartItemId)
+
+	cartID, err := uuid.Parse(req.CartId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_id format: %v", err)
+		return fmt.Errorf("invalid cart_id format: %w", err)
+	}
+	cartItemID, err := uuid.Parse(req.CartItemId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid cart_item_id format: %v", err)
+		return fmt.Errorf("invalid cart_item_id format: %w", err)
+	}
+
+	// Start a transaction
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Verify cart exists and version matches
+	c, err := tx.Cart.Query().
+		Where(
+			cart.ID(cartID),
+			cart.Version(int(req.Version)),
+			cart.DeletedAtIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart not found, expired, or version mismatch: %s", req.CartId)
+		return fmt.Errorf("cart not found, expired, or version mismatch")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query cart: %v", err)
+		return fmt.Errorf("failed to query cart: %w", err)
+	}
+
+	item, err := tx.CartItem.Query().
+		Where(
+			cartitem.ID(cartItemID),
+			cartitem.HasCartWith(cart.ID(cartID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Cart item %s not found in cart %s", req.CartItemId, req.CartId)
+		return fmt.Errorf("cart item not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query cart item: %v", err)
+		return fmt.Errorf("failed to query cart item: %w", err)
+	}
+
+	saved, err := tx.WishlistItem.Query().
+		Where(
+			wishlistitem.UserID(c.UserID),
+			wishlistitem.ProductID(item.ProductID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		create := tx.WishlistItem.Create().
+			SetUserID(c.UserID).
+			SetProductID(item.ProductID)
+		if item.ProductName != "" {
+			create.SetProductName(item.ProductName)
+		}
+		saved, err = create.Save(ctx)
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to save product %s to wishlist of user %s: %v", item.ProductID, c.UserID, err)
+		return fmt.Errorf("failed to save wishlist item: %w", err)
+	}
+
+	if err := tx.CartItem.DeleteOne(item).Exec(ctx); err != nil {
+		logger.Extract(ctx).Errorf("Failed to delete cart item: %v", err)
+		return fmt.Errorf("failed to delete cart item: %w", err)
+	}
+
+	// Update cart metadata
+	err = tx.Cart.UpdateOneID(cartID).
+		SetLastActivityAt(time.Now()).
+		SetExpiresAt(h.expiresAt()).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update cart metadata: %v", err)
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	// Commit transaction
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Fetch updated cart
+	cWithItems, err := h.EntClient.Cart.Query().
+		Where(cart.ID(cartID)).
+		WithCartItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch updated cart: %v", err)
+		return fmt.Errorf("failed to fetch updated cart: %w", err)
+	}
+
+	rsp.Cart = toProtoCart(cWithItems)
+	rsp.WishlistItem = toProtoWishlistItem(saved)
+	logger.Extract(ctx).Infof("Moved cart item %s from cart %s to wishlist of user %s", req.CartItemId, req.CartId, c.UserID)
+	return nil
+}
+
+// ListWishlist lists the products a user saved for later, newest first
+func (h *CartService) ListWishlist(ctx context.Context, req *pb.ListWishlistRequest, rsp *pb.ListWishlistResponse) error {
+	logger.Extract(ctx).Infof("Received ListWishlist request for user_id: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid user_id format: %v", err)
+		return fmt.Errorf("invalid user_id format: %w", err)
+	}
+
+	items, err := h.EntClient.WishlistItem.Query().
+		Where(wishlistitem.UserID(userID)).
+		Order(ent.Desc(wishlistitem.FieldAddedAt, wishlistitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list wishlist of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to list wishlist: %w", err)
+	}
+
+	rsp.Items = make([]*pb.WishlistItem, len(items))
+	for i, item := range items {
+		rsp.Items[i] = toProtoWishlistItem(item)
+	}
+	logger.Extract(ctx).Infof("Listed %d wishlist items of user %s", len(items), req.UserId)
+	return nil
+}
+
+// toProtoWishlistItem converts an Entgo WishlistItem entity to a Protobuf WishlistItem message
+func toProtoWishlistItem(w *ent.WishlistItem) *pb.WishlistItem {
+	return &pb.WishlistItem{
+		Id:          w.ID.String(),
+		UserId:      w.UserID.String(),
+		ProductId:   w.ProductID.String(),
+		ProductName: w.ProductName,
+		AddedAt:     w.AddedAt.Unix(),
+	}
+}
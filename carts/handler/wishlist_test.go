@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "carts/proto"
+)
+
+func TestMoveCartItemToWishlist(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &CartService{EntClient: client}
+	mug, pen := uuid.New(), uuid.New()
+	c := createTestCart(t, client, mug, pen)
+	other := createTestCart(t, client, mug)
+
+	move := func(cartID uuid.UUID, itemID string) (*pb.MoveCartItemToWishlistResponse, error) {
+		rsp := &pb.MoveCartItemToWishlistResponse{}
+		version := int32(client.Cart.GetX(ctx, cartID).Version)
+		return rsp, h.MoveCartItemToWishlist(ctx, &pb.MoveCartItemToWishlistRequest{CartId: cartID.String(), CartItemId: itemID, Version: version}, rsp)
+	}
+
+	mugItem := c.Edges.CartItems[0]
+	if mugItem.ProductID != mug {
+		mugItem = c.Edges.CartItems[1]
+	}
+	err := h.MoveCartItemToWishlist(ctx, &pb.MoveCartItemToWishlistRequest{CartId: c.ID.String(), CartItemId: mugItem.ID.String(), Version: int32(c.Version) + 1}, &pb.MoveCartItemToWishlistResponse{})
+	if err == nil || !strings.Contains(err.Error(), "version mismatch") {
+		t.Fatalf("expected a stale version to be rejected, got %v", err)
+	}
+	if _, err := move(c.ID, other.Edges.CartItems[0].ID.String()); err == nil || !strings.Contains(err.Error(), "cart item not found") {
+		t.Fatalf("expected another cart's item to be not found, got %v", err)
+	}
+
+	rsp, err := move(c.ID, mugItem.ID.String())
+	if err != nil {
+		t.Fatalf("MoveCartItemToWishlist: %v", err)
+	}
+	if len(rsp.Cart.CartItems) != 1 || rsp.Cart.CartItems[0].ProductId != pen.String() {
+		t.Fatalf("expected only the pen left in the cart, got %v", rsp.Cart.CartItems)
+	}
+	if rsp.Cart.Version != int32(c.Version)+1 {
+		t.Fatalf("expected the cart version bumped to %d, got %d", c.Version+1, rsp.Cart.Version)
+	}
+	if rsp.WishlistItem.UserId != c.UserID.String() || rsp.WishlistItem.ProductId != mug.String() {
+		t.Fatalf("expected the mug saved to the cart owner's wishlist, got %v", rsp.WishlistItem)
+	}
+
+	// Saving a product already on the wishlist keeps its one entry
+	again := client.CartItem.Create().SetCartID(c.ID).SetProductID(mug).SetQuantity(1).SaveX(ctx)
+	if _, err := move(c.ID, again.ID.String()); err != nil {
+		t.Fatalf("MoveCartItemToWishlist: %v", err)
+	}
+	penItem := rsp.Cart.CartItems[0].Id
+	if _, err := move(c.ID, penItem); err != nil {
+		t.Fatalf("MoveCartItemToWishlist: %v", err)
+	}
+
+	list := &pb.ListWishlistResponse{}
+	if err := h.ListWishlist(ctx, &pb.ListWishlistRequest{UserId: c.UserID.String()}, list); err != nil {
+		t.Fatalf("ListWishlist: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].ProductId != pen.String() || list.Items[1].ProductId != mug.String() {
+		t.Fatalf("expected the pen and mug listed newest first, got %v", list.Items)
+	}
+
+	list = &pb.ListWishlistResponse{}
+	if err := h.ListWishlist(ctx, &pb.ListWishlistRequest{UserId: other.UserID.String()}, list); err != nil {
+		t.Fatalf("ListWishlist: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Fatalf("expected another user's wishlist to be empty, got %d items", len(list.Items))
+	}
+}
@@ -3,43 +3,108 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
-	"carts/ent"
 	"carts/handler"
+	"carts/seed"
 
-	_ "github.com/mattn/go-sqlite3" // Import for SQLite driver
-
-	"entgo.io/ent/dialect"
 	"go-micro.dev/v5"
 	"go-micro.dev/v5/logger"
 
 	pb "carts/proto"
+	productspb "products/proto"
 )
 
 func main() {
-	// Initialize EntgoClient
-	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	// Initialize EntgoClient from DB_DRIVER and DB_DSN
+	client, db, driver, err := openDB()
 	if err != nil {
-		logger.Fatalf("Failed opening connection to sqlite: %v", err)
+		logger.Fatalf("Failed opening connection to %s: %v", driver, err)
 	}
 	defer client.Close()
 
+	// Report not ready until migrations and seeding are done; HEALTH_ADDR enables HTTP probes
+	health := &handler.Health{DB: db}
+	if addr := os.Getenv("HEALTH_ADDR"); addr != "" {
+		go func() {
+			if err := health.ServeProbes(addr); err != nil {
+				logger.Errorf("Health probe server stopped: %v", err)
+			}
+		}()
+	}
+
+	// Record RPC count, error and latency metrics; METRICS_ADDR exposes them at /metrics
+	metrics := handler.NewMetrics()
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		go func() {
+			if err := metrics.Serve(addr); err != nil {
+				logger.Errorf("Metrics server stopped: %v", err)
+			}
+		}()
+	}
+
 	// Run the auto migration tool
 	ctx := context.Background()
 	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("Failed creating schema resources: %v", err)
 	}
 
+	// Load sample fixtures for local development when SEED names a fixture set
+	if set := os.Getenv("SEED"); set != "" {
+		if err := seed.Load(ctx, client, seed.FixtureSet(set)); err != nil {
+			logger.Fatalf("Failed seeding database with %q fixtures: %v", set, err)
+		}
+		logger.Infof("Seeded database with %q fixtures", set)
+	}
+
+	// Snapshot carts on every change so support can see past contents
+	snapshots := &handler.CartSnapshots{Max: envInt("MAX_CART_SNAPSHOTS", handler.DefaultMaxCartSnapshots)}
+	client.Use(snapshots.Hook())
+
+	// Configure the expired cart sweeper and the purge of carts past the soft-delete retention
+	batchSize := envInt("CART_SWEEP_BATCH_SIZE", 500)
+	purger := &handler.CartPurger{
+		EntClient: client,
+		Retention: envDuration("SOFT_DELETE_RETENTION", 30*24*time.Hour),
+		BatchSize: batchSize,
+	}
+	sweeper := &handler.CartSweeper{
+		EntClient: client,
+		Interval:  envDuration("CART_SWEEP_INTERVAL", 10*time.Minute),
+		BatchSize: batchSize,
+		Purger:    purger,
+	}
+	sweepCtx, stopSweeper := context.WithCancel(ctx)
+	sweeperDone := make(chan struct{})
+
+	// Advertise the deployment's region, if any, and stamp it on the rows this service creates
+	region := os.Getenv("REGION")
+	metadata := serviceMetadata(region)
+
 	// Create a new service
 	service := micro.NewService(
 		micro.Name("carts"),
 		micro.Version("latest"),
-		micro.Metadata(map[string]string{
-			"StartTime": time.Now().String(),
-		}),
+		micro.Metadata(metadata),
+		micro.WrapHandler(metrics.Wrapper()),
+		micro.WrapHandler(handler.CorrelationWrapper()),
 		micro.BeforeStart(func() error {
 			logger.Info("Cart service starting...")
+			go func() {
+				defer close(sweeperDone)
+				sweeper.Run(sweepCtx)
+			}()
+			return nil
+		}),
+		micro.AfterStart(func() error {
+			health.SetReady(true)
+			return nil
+		}),
+		micro.BeforeStop(func() error {
+			health.SetReady(false)
+			stopSweeper()
+			<-sweeperDone
 			return nil
 		}),
 		micro.AfterStop(func() error {
@@ -51,13 +116,33 @@ func main() {
 	// Initialize service
 	service.Init()
 
+	// Register Health.Check and Debug.Health for orchestrator probes
+	if err := health.Register(service.Server()); err != nil {
+		logger.Fatalf("Failed to register health handler: %v", err)
+	}
+
+	// Validate cart items against the products service unless disabled for offline environments
+	var products productspb.ProductService
+	if envBool("PRODUCT_VALIDATION", true) {
+		products = productspb.NewProductService("products", service.Client())
+	} else {
+		logger.Warn("Product validation disabled, cart items will not be checked against the catalog")
+	}
+
 	// Register CartService handler
-	if err := pb.RegisterCartServiceHandler(service.Server(), &handler.CartService{EntClient: client}); err != nil {
+	if err := pb.RegisterCartServiceHandler(service.Server(), &handler.CartService{
+		EntClient: client,
+		Products:  products,
+		TTL:       envDuration("CART_TTL", handler.DefaultCartTTL),
+		// Cap single-item quantities to keep carts sane
+		MaxItemQuantity: envInt("MAX_CART_ITEM_QUANTITY", handler.DefaultMaxItemQuantity),
+		Region:          region,
+	}); err != nil {
 		logger.Fatalf("Failed to register cart service handler: %v", err)
 	}
 
 	// Register AdminService handler
-	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{EntClient: client}); err != nil {
+	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{EntClient: client, Products: products, Purger: purger, Snapshots: snapshots}); err != nil {
 		logger.Fatalf("Failed to register admin service handler: %v", err)
 	}
 
@@ -21,6 +21,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MergeStrategy resolves the quantity of a product present in both merged carts
+type MergeStrategy int32
+
+const (
+	MergeStrategy_MERGE_STRATEGY_UNSPECIFIED MergeStrategy = 0 // Same as SUM
+	MergeStrategy_SUM                        MergeStrategy = 1 // Add both quantities
+	MergeStrategy_MAX                        MergeStrategy = 2 // Keep the larger quantity
+	MergeStrategy_KEEP_TARGET                MergeStrategy = 3 // Keep the target cart's quantity
+	MergeStrategy_KEEP_SOURCE                MergeStrategy = 4 // Keep the source cart's quantity
+)
+
+// Enum value maps for MergeStrategy.
+var (
+	MergeStrategy_name = map[int32]string{
+		0: "MERGE_STRATEGY_UNSPECIFIED",
+		1: "SUM",
+		2: "MAX",
+		3: "KEEP_TARGET",
+		4: "KEEP_SOURCE",
+	}
+	MergeStrategy_value = map[string]int32{
+		"MERGE_STRATEGY_UNSPECIFIED": 0,
+		"SUM":                        1,
+		"MAX":                        2,
+		"KEEP_TARGET":                3,
+		"KEEP_SOURCE":                4,
+	}
+)
+
+func (x MergeStrategy) Enum() *MergeStrategy {
+	p := new(MergeStrategy)
+	*p = x
+	return p
+}
+
+func (x MergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_carts_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeStrategy) Type() protoreflect.EnumType {
+	return &file_proto_carts_proto_enumTypes[0]
+}
+
+func (x MergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeStrategy.Descriptor instead.
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{0}
+}
+
 // CartItem represents an item within a cart
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -30,6 +86,8 @@ type CartItem struct {
 	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
 	CartId        string                 `protobuf:"bytes,6,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // Product name snapshot, empty when not validated
+	Availability  *CartItemAvailability  `protobuf:"bytes,8,opt,name=availability,proto3" json:"availability,omitempty"`                  // Set only by GetCartWithAvailability
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +164,105 @@ func (x *CartItem) GetCartId() string {
 	return ""
 }
 
+func (x *CartItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CartItem) GetAvailability() *CartItemAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// CartItemAvailability reports whether a cart item's product can still be purchased
+type CartItemAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"` // False when the product no longer exists in the catalog
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	InStock       bool                   `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`          // Current stock covers the item's quantity
+	PriceCents    int64                  `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"` // Current catalog unit price in minor units (cents), the price tier for the item's quantity applied
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                        // ISO 4217 code of price_cents
+	Available     bool                   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`                     // Found, active and in stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemAvailability) Reset() {
+	*x = CartItemAvailability{}
+	mi := &file_proto_carts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemAvailability) ProtoMessage() {}
+
+func (x *CartItemAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemAvailability.ProtoReflect.Descriptor instead.
+func (*CartItemAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartItemAvailability) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *CartItemAvailability) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CartItemAvailability) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *CartItemAvailability) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *CartItemAvailability) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CartItemAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
 // Cart represents a shopping cart in the system
 type Cart struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -118,13 +275,16 @@ type Cart struct {
 	DeletedAt      int64                  `protobuf:"varint,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                  // Unix timestamp, nullable
 	Version        int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                                       // Optimistic lock version
 	CartItems      []*CartItem            `protobuf:"bytes,9,rep,name=cart_items,json=cartItems,proto3" json:"cart_items,omitempty"`                   // Embedded cart items
+	CheckedOutAt   int64                  `protobuf:"varint,10,opt,name=checked_out_at,json=checkedOutAt,proto3" json:"checked_out_at,omitempty"`      // Unix timestamp, zero unless the cart was checked out
+	Region         string                 `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`                                         // Region the cart was created in, empty if unknown
+	CouponCode     string                 `protobuf:"bytes,12,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`               // Applied coupon; set by GetCart, GetCartWithAvailability, ApplyCoupon and RemoveCoupon
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Cart) Reset() {
 	*x = Cart{}
-	mi := &file_proto_carts_proto_msgTypes[1]
+	mi := &file_proto_carts_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +296,7 @@ func (x *Cart) String() string {
 func (*Cart) ProtoMessage() {}
 
 func (x *Cart) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[1]
+	mi := &file_proto_carts_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +309,7 @@ func (x *Cart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cart.ProtoReflect.Descriptor instead.
 func (*Cart) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{1}
+	return file_proto_carts_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Cart) GetId() string {
@@ -215,29 +375,57 @@ func (x *Cart) GetCartItems() []*CartItem {
 	return nil
 }
 
-// Request message for creating or getting a cart
-type GetOrCreateCartRequest struct {
+func (x *Cart) GetCheckedOutAt() int64 {
+	if x != nil {
+		return x.CheckedOutAt
+	}
+	return 0
+}
+
+func (x *Cart) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Cart) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+// Coupon takes a percentage or a fixed amount off a cart's subtotal
+type Coupon struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"` // percent or fixed
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                // Percentage off for percent coupons, minor units off for fixed ones
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`         // Unix timestamp, zero if the coupon never expires
+	MaxUses       int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`               // Zero for unlimited
+	UsedCount     int32                  `protobuf:"varint,7,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`         // Checkouts the coupon has been used for
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrCreateCartRequest) Reset() {
-	*x = GetOrCreateCartRequest{}
-	mi := &file_proto_carts_proto_msgTypes[2]
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_proto_carts_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrCreateCartRequest) String() string {
+func (x *Coupon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrCreateCartRequest) ProtoMessage() {}
+func (*Coupon) ProtoMessage() {}
 
-func (x *GetOrCreateCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[2]
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,85 +436,92 @@ func (x *GetOrCreateCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrCreateCartRequest.ProtoReflect.Descriptor instead.
-func (*GetOrCreateCartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetOrCreateCartRequest) GetUserId() string {
+func (x *Coupon) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-// Response message for creating or getting a cart
-type GetOrCreateCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-func (x *GetOrCreateCartResponse) Reset() {
-	*x = GetOrCreateCartResponse{}
-	mi := &file_proto_carts_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Coupon) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
 }
 
-func (x *GetOrCreateCartResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Coupon) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*GetOrCreateCartResponse) ProtoMessage() {}
+func (x *Coupon) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
 
-func (x *GetOrCreateCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[3]
+func (x *Coupon) GetMaxUses() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxUses
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetOrCreateCartResponse.ProtoReflect.Descriptor instead.
-func (*GetOrCreateCartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{3}
+func (x *Coupon) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
 }
 
-func (x *GetOrCreateCartResponse) GetCart() *Cart {
+func (x *Coupon) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Cart
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-// Request message for getting a cart by ID
-type GetCartRequest struct {
+// CartPricing is a cart's subtotal at current catalog prices and its coupon discount
+type CartPricing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubtotalCents int64                  `protobuf:"varint,1,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"` // Lines whose product is missing from the catalog are left out
+	DiscountCents int64                  `protobuf:"varint,2,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"` // Never more than the subtotal
+	TotalCents    int64                  `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`          // subtotal_cents minus discount_cents
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCartRequest) Reset() {
-	*x = GetCartRequest{}
+func (x *CartPricing) Reset() {
+	*x = CartPricing{}
 	mi := &file_proto_carts_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCartRequest) String() string {
+func (x *CartPricing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCartRequest) ProtoMessage() {}
+func (*CartPricing) ProtoMessage() {}
 
-func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+func (x *CartPricing) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -338,40 +533,61 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
-func (*GetCartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CartPricing.ProtoReflect.Descriptor instead.
+func (*CartPricing) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetCartRequest) GetId() string {
+func (x *CartPricing) GetSubtotalCents() int64 {
 	if x != nil {
-		return x.Id
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *CartPricing) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *CartPricing) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *CartPricing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-// Response message for getting a cart
-type GetCartResponse struct {
+// Request message for creating or getting a cart
+type GetOrCreateCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCartResponse) Reset() {
-	*x = GetCartResponse{}
+func (x *GetOrCreateCartRequest) Reset() {
+	*x = GetOrCreateCartRequest{}
 	mi := &file_proto_carts_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCartResponse) String() string {
+func (x *GetOrCreateCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCartResponse) ProtoMessage() {}
+func (*GetOrCreateCartRequest) ProtoMessage() {}
 
-func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrCreateCartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,42 +599,40 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
-func (*GetCartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrCreateCartRequest.ProtoReflect.Descriptor instead.
+func (*GetOrCreateCartRequest) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCartResponse) GetCart() *Cart {
+func (x *GetOrCreateCartRequest) GetUserId() string {
 	if x != nil {
-		return x.Cart
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Request message for adding an item to the cart
-type AddCartItemRequest struct {
+// Response message for creating or getting a cart
+type GetOrCreateCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCartItemRequest) Reset() {
-	*x = AddCartItemRequest{}
+func (x *GetOrCreateCartResponse) Reset() {
+	*x = GetOrCreateCartResponse{}
 	mi := &file_proto_carts_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCartItemRequest) String() string {
+func (x *GetOrCreateCartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCartItemRequest) ProtoMessage() {}
+func (*GetOrCreateCartResponse) ProtoMessage() {}
 
-func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrCreateCartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,54 +644,40 @@ func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCartItemRequest.ProtoReflect.Descriptor instead.
-func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrCreateCartResponse.ProtoReflect.Descriptor instead.
+func (*GetOrCreateCartResponse) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AddCartItemRequest) GetCartId() string {
+func (x *GetOrCreateCartResponse) GetCart() *Cart {
 	if x != nil {
-		return x.CartId
-	}
-	return ""
-}
-
-func (x *AddCartItemRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
-}
-
-func (x *AddCartItemRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
+		return x.Cart
 	}
-	return 0
+	return nil
 }
 
-// Response message for adding an item to the cart
-type AddCartItemResponse struct {
+// Request message for getting a user's active cart without creating one
+type GetActiveCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCartItemResponse) Reset() {
-	*x = AddCartItemResponse{}
+func (x *GetActiveCartRequest) Reset() {
+	*x = GetActiveCartRequest{}
 	mi := &file_proto_carts_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCartItemResponse) String() string {
+func (x *GetActiveCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCartItemResponse) ProtoMessage() {}
+func (*GetActiveCartRequest) ProtoMessage() {}
 
-func (x *AddCartItemResponse) ProtoReflect() protoreflect.Message {
+func (x *GetActiveCartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,43 +689,40 @@ func (x *AddCartItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCartItemResponse.ProtoReflect.Descriptor instead.
-func (*AddCartItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetActiveCartRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveCartRequest) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AddCartItemResponse) GetCart() *Cart {
+func (x *GetActiveCartRequest) GetUserId() string {
 	if x != nil {
-		return x.Cart
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Request message for updating a cart item quantity
-type UpdateCartItemRequest struct {
+// Response message for getting a user's active cart
+type GetActiveCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	CartItemId    string                 `protobuf:"bytes,2,opt,name=cart_item_id,json=cartItemId,proto3" json:"cart_item_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCartItemRequest) Reset() {
-	*x = UpdateCartItemRequest{}
+func (x *GetActiveCartResponse) Reset() {
+	*x = GetActiveCartResponse{}
 	mi := &file_proto_carts_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCartItemRequest) String() string {
+func (x *GetActiveCartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCartItemRequest) ProtoMessage() {}
+func (*GetActiveCartResponse) ProtoMessage() {}
 
-func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
+func (x *GetActiveCartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,61 +734,40 @@ func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCartItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetActiveCartResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveCartResponse) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateCartItemRequest) GetCartId() string {
-	if x != nil {
-		return x.CartId
-	}
-	return ""
-}
-
-func (x *UpdateCartItemRequest) GetCartItemId() string {
-	if x != nil {
-		return x.CartItemId
-	}
-	return ""
-}
-
-func (x *UpdateCartItemRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *UpdateCartItemRequest) GetVersion() int32 {
+func (x *GetActiveCartResponse) GetCart() *Cart {
 	if x != nil {
-		return x.Version
+		return x.Cart
 	}
-	return 0
+	return nil
 }
 
-// Response message for updating a cart item
-type UpdateCartItemResponse struct {
+// Request message for counting the items of a user's active cart
+type GetCartItemCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCartItemResponse) Reset() {
-	*x = UpdateCartItemResponse{}
+func (x *GetCartItemCountRequest) Reset() {
+	*x = GetCartItemCountRequest{}
 	mi := &file_proto_carts_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCartItemResponse) String() string {
+func (x *GetCartItemCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCartItemResponse) ProtoMessage() {}
+func (*GetCartItemCountRequest) ProtoMessage() {}
 
-func (x *UpdateCartItemResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCartItemCountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,42 +779,41 @@ func (x *UpdateCartItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCartItemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCartItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartItemCountRequest.ProtoReflect.Descriptor instead.
+func (*GetCartItemCountRequest) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateCartItemResponse) GetCart() *Cart {
+func (x *GetCartItemCountRequest) GetUserId() string {
 	if x != nil {
-		return x.Cart
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Request message for removing a cart item
-type RemoveCartItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	CartItemId    string                 `protobuf:"bytes,2,opt,name=cart_item_id,json=cartItemId,proto3" json:"cart_item_id,omitempty"`
-	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Response message for counting the items of a user's active cart, zero without one
+type GetCartItemCountResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ItemCount         int32                  `protobuf:"varint,1,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`                           // Sum of the item quantities
+	DistinctItemCount int32                  `protobuf:"varint,2,opt,name=distinct_item_count,json=distinctItemCount,proto3" json:"distinct_item_count,omitempty"` // Number of cart items
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *RemoveCartItemRequest) Reset() {
-	*x = RemoveCartItemRequest{}
+func (x *GetCartItemCountResponse) Reset() {
+	*x = GetCartItemCountResponse{}
 	mi := &file_proto_carts_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCartItemRequest) String() string {
+func (x *GetCartItemCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCartItemRequest) ProtoMessage() {}
+func (*GetCartItemCountResponse) ProtoMessage() {}
 
-func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCartItemCountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,54 +825,47 @@ func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartItemCountResponse.ProtoReflect.Descriptor instead.
+func (*GetCartItemCountResponse) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemoveCartItemRequest) GetCartId() string {
-	if x != nil {
-		return x.CartId
-	}
-	return ""
-}
-
-func (x *RemoveCartItemRequest) GetCartItemId() string {
+func (x *GetCartItemCountResponse) GetItemCount() int32 {
 	if x != nil {
-		return x.CartItemId
+		return x.ItemCount
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoveCartItemRequest) GetVersion() int32 {
+func (x *GetCartItemCountResponse) GetDistinctItemCount() int32 {
 	if x != nil {
-		return x.Version
+		return x.DistinctItemCount
 	}
 	return 0
 }
 
-// Response message for removing a cart item
-type RemoveCartItemResponse struct {
+// Request message for getting a cart by ID
+type GetCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCartItemResponse) Reset() {
-	*x = RemoveCartItemResponse{}
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
 	mi := &file_proto_carts_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCartItemResponse) String() string {
+func (x *GetCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCartItemResponse) ProtoMessage() {}
+func (*GetCartRequest) ProtoMessage() {}
 
-func (x *RemoveCartItemResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,41 +877,40 @@ func (x *RemoveCartItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCartItemResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCartItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveCartItemResponse) GetCart() *Cart {
+func (x *GetCartRequest) GetId() string {
 	if x != nil {
-		return x.Cart
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for clearing a cart
-type ClearCartRequest struct {
+// Response message for getting a cart
+type GetCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearCartRequest) Reset() {
-	*x = ClearCartRequest{}
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
 	mi := &file_proto_carts_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearCartRequest) String() string {
+func (x *GetCartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearCartRequest) ProtoMessage() {}
+func (*GetCartResponse) ProtoMessage() {}
 
-func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,47 +922,40 @@ func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
-func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ClearCartRequest) GetCartId() string {
-	if x != nil {
-		return x.CartId
-	}
-	return ""
-}
-
-func (x *ClearCartRequest) GetVersion() int32 {
+func (x *GetCartResponse) GetCart() *Cart {
 	if x != nil {
-		return x.Version
+		return x.Cart
 	}
-	return 0
+	return nil
 }
 
-// Response message for clearing a cart
-type ClearCartResponse struct {
+// Request message for getting a cart with the current availability of its items
+type GetCartWithAvailabilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearCartResponse) Reset() {
-	*x = ClearCartResponse{}
+func (x *GetCartWithAvailabilityRequest) Reset() {
+	*x = GetCartWithAvailabilityRequest{}
 	mi := &file_proto_carts_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearCartResponse) String() string {
+func (x *GetCartWithAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearCartResponse) ProtoMessage() {}
+func (*GetCartWithAvailabilityRequest) ProtoMessage() {}
 
-func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCartWithAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,43 +967,42 @@ func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
-func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartWithAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetCartWithAvailabilityRequest) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ClearCartResponse) GetCart() *Cart {
+func (x *GetCartWithAvailabilityRequest) GetId() string {
 	if x != nil {
-		return x.Cart
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for listing carts (admin)
-type ListCartsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // Optional filter by user_id
-	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted carts
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Response message for getting a cart with availability
+type GetCartWithAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`                                      // Each cart item carries its availability
+	AllAvailable  bool                   `protobuf:"varint,2,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"` // Every item can be purchased; true for an empty cart
+	Pricing       *CartPricing           `protobuf:"bytes,3,opt,name=pricing,proto3" json:"pricing,omitempty"`                                // Subtotal at current catalog prices, less the applied coupon's discount
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCartsRequest) Reset() {
-	*x = ListCartsRequest{}
+func (x *GetCartWithAvailabilityResponse) Reset() {
+	*x = GetCartWithAvailabilityResponse{}
 	mi := &file_proto_carts_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCartsRequest) String() string {
+func (x *GetCartWithAvailabilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCartsRequest) ProtoMessage() {}
+func (*GetCartWithAvailabilityResponse) ProtoMessage() {}
 
-func (x *ListCartsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCartWithAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_carts_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,63 +1014,2271 @@ func (x *ListCartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCartsRequest.ProtoReflect.Descriptor instead.
-func (*ListCartsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCartWithAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetCartWithAvailabilityResponse) Descriptor() ([]byte, []int) {
 	return file_proto_carts_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListCartsRequest) GetLimit() int32 {
+func (x *GetCartWithAvailabilityResponse) GetCart() *Cart {
 	if x != nil {
-		return x.Limit
+		return x.Cart
 	}
-	return 0
+	return nil
 }
 
-func (x *ListCartsRequest) GetOffset() int32 {
+func (x *GetCartWithAvailabilityResponse) GetAllAvailable() bool {
 	if x != nil {
-		return x.Offset
+		return x.AllAvailable
 	}
-	return 0
+	return false
 }
 
-func (x *ListCartsRequest) GetUserId() string {
+func (x *GetCartWithAvailabilityResponse) GetPricing() *CartPricing {
 	if x != nil {
-		return x.UserId
+		return x.Pricing
 	}
-	return ""
+	return nil
 }
 
-func (x *ListCartsRequest) GetIncludeDeleted() bool {
+// Request message for adding an item to the cart
+type AddCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCartItemRequest) Reset() {
+	*x = AddCartItemRequest{}
+	mi := &file_proto_carts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCartItemRequest) ProtoMessage() {}
+
+func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[15]
 	if x != nil {
-		return x.IncludeDeleted
-	}
-	return false
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCartItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *AddCartItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Response message for adding an item to the cart
+type AddCartItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCartItemResponse) Reset() {
+	*x = AddCartItemResponse{}
+	mi := &file_proto_carts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCartItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCartItemResponse) ProtoMessage() {}
+
+func (x *AddCartItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCartItemResponse.ProtoReflect.Descriptor instead.
+func (*AddCartItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddCartItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for updating a cart item quantity
+type UpdateCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	CartItemId    string                 `protobuf:"bytes,2,opt,name=cart_item_id,json=cartItemId,proto3" json:"cart_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCartItemRequest) Reset() {
+	*x = UpdateCartItemRequest{}
+	mi := &file_proto_carts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartItemRequest) ProtoMessage() {}
+
+func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *UpdateCartItemRequest) GetCartItemId() string {
+	if x != nil {
+		return x.CartItemId
+	}
+	return ""
+}
+
+func (x *UpdateCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateCartItemRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for updating a cart item
+type UpdateCartItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCartItemResponse) Reset() {
+	*x = UpdateCartItemResponse{}
+	mi := &file_proto_carts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCartItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartItemResponse) ProtoMessage() {}
+
+func (x *UpdateCartItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCartItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCartItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for removing a cart item
+type RemoveCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	CartItemId    string                 `protobuf:"bytes,2,opt,name=cart_item_id,json=cartItemId,proto3" json:"cart_item_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemRequest) Reset() {
+	*x = RemoveCartItemRequest{}
+	mi := &file_proto_carts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveCartItemRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *RemoveCartItemRequest) GetCartItemId() string {
+	if x != nil {
+		return x.CartItemId
+	}
+	return ""
+}
+
+func (x *RemoveCartItemRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for removing a cart item
+type RemoveCartItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemResponse) Reset() {
+	*x = RemoveCartItemResponse{}
+	mi := &file_proto_carts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemResponse) ProtoMessage() {}
+
+func (x *RemoveCartItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveCartItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for removing a cart item by its product
+type RemoveCartItemByProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemByProductRequest) Reset() {
+	*x = RemoveCartItemByProductRequest{}
+	mi := &file_proto_carts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemByProductRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemByProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemByProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveCartItemByProductRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *RemoveCartItemByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveCartItemByProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for removing a cart item by its product
+type RemoveCartItemByProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemByProductResponse) Reset() {
+	*x = RemoveCartItemByProductResponse{}
+	mi := &file_proto_carts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemByProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemByProductResponse) ProtoMessage() {}
+
+func (x *RemoveCartItemByProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemByProductResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemByProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveCartItemByProductResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for clearing a cart
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClearCartRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *ClearCartRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for clearing a cart
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClearCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for merging a guest cart into a user's cart
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceCartId  string                 `protobuf:"bytes,1,opt,name=source_cart_id,json=sourceCartId,proto3" json:"source_cart_id,omitempty"` // Cart whose items are moved, soft-deleted after the merge
+	TargetCartId  string                 `protobuf:"bytes,2,opt,name=target_cart_id,json=targetCartId,proto3" json:"target_cart_id,omitempty"` // Optional; defaults to the active cart of user_id
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // Owner of the merged cart, used when target_cart_id is empty
+	Strategy      MergeStrategy          `protobuf:"varint,4,opt,name=strategy,proto3,enum=carts.MergeStrategy" json:"strategy,omitempty"`     // How to resolve a product present in both carts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MergeCartsRequest) GetSourceCartId() string {
+	if x != nil {
+		return x.SourceCartId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetTargetCartId() string {
+	if x != nil {
+		return x.TargetCartId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetStrategy() MergeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return MergeStrategy_MERGE_STRATEGY_UNSPECIFIED
+}
+
+// Response message for merging carts
+type MergeCartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartsResponse) Reset() {
+	*x = MergeCartsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsResponse) ProtoMessage() {}
+
+func (x *MergeCartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsResponse.ProtoReflect.Descriptor instead.
+func (*MergeCartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MergeCartsResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for listing carts (admin)
+type ListCartsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // Optional filter by user_id
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted carts
+	Region         string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                        // Optional filter by the region the cart was created in
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCartsRequest) Reset() {
+	*x = ListCartsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartsRequest) ProtoMessage() {}
+
+func (x *ListCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartsRequest.ProtoReflect.Descriptor instead.
+func (*ListCartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCartsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCartsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListCartsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// Response message for listing carts
+type ListCartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Carts         []*Cart                `protobuf:"bytes,1,rep,name=carts,proto3" json:"carts,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartsResponse) Reset() {
+	*x = ListCartsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartsResponse) ProtoMessage() {}
+
+func (x *ListCartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartsResponse.ProtoReflect.Descriptor instead.
+func (*ListCartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCartsResponse) GetCarts() []*Cart {
+	if x != nil {
+		return x.Carts
+	}
+	return nil
+}
+
+func (x *ListCartsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for force deleting a cart (Admin operation)
+type ForceDeleteCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteCartRequest) Reset() {
+	*x = ForceDeleteCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteCartRequest) ProtoMessage() {}
+
+func (x *ForceDeleteCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteCartRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForceDeleteCartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for force deleting a cart
+type ForceDeleteCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteCartResponse) Reset() {
+	*x = ForceDeleteCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteCartResponse) ProtoMessage() {}
+
+func (x *ForceDeleteCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteCartResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ForceDeleteCartResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceDeleteCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for soft deleting a cart (Admin or User operation)
+type SoftDeleteCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftDeleteCartRequest) Reset() {
+	*x = SoftDeleteCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftDeleteCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteCartRequest) ProtoMessage() {}
+
+func (x *SoftDeleteCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteCartRequest.ProtoReflect.Descriptor instead.
+func (*SoftDeleteCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SoftDeleteCartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SoftDeleteCartRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for soft deleting a cart
+type SoftDeleteCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftDeleteCartResponse) Reset() {
+	*x = SoftDeleteCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftDeleteCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteCartResponse) ProtoMessage() {}
+
+func (x *SoftDeleteCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteCartResponse.ProtoReflect.Descriptor instead.
+func (*SoftDeleteCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SoftDeleteCartResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SoftDeleteCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for applying a coupon to a cart
+type ApplyCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Matched case-insensitively
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCouponRequest) Reset() {
+	*x = ApplyCouponRequest{}
+	mi := &file_proto_carts_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponRequest) ProtoMessage() {}
+
+func (x *ApplyCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ApplyCouponRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *ApplyCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Response message for applying a coupon to a cart
+type ApplyCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	Pricing       *CartPricing           `protobuf:"bytes,2,opt,name=pricing,proto3" json:"pricing,omitempty"` // Unset when product validation is disabled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCouponResponse) Reset() {
+	*x = ApplyCouponResponse{}
+	mi := &file_proto_carts_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponResponse) ProtoMessage() {}
+
+func (x *ApplyCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApplyCouponResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *ApplyCouponResponse) GetPricing() *CartPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+// Request message for removing a cart's coupon
+type RemoveCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponRequest) Reset() {
+	*x = RemoveCouponRequest{}
+	mi := &file_proto_carts_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponRequest) ProtoMessage() {}
+
+func (x *RemoveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RemoveCouponRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+// Response message for removing a cart's coupon
+type RemoveCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	Pricing       *CartPricing           `protobuf:"bytes,2,opt,name=pricing,proto3" json:"pricing,omitempty"` // Unset when product validation is disabled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponResponse) Reset() {
+	*x = RemoveCouponResponse{}
+	mi := &file_proto_carts_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponResponse) ProtoMessage() {}
+
+func (x *RemoveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveCouponResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *RemoveCouponResponse) GetPricing() *CartPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+// Request message for checking out a cart once its order has been placed; a use of the
+// applied coupon is counted, and checkout fails if the coupon expired or ran out of uses
+type CheckoutCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutCartRequest) Reset() {
+	*x = CheckoutCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartRequest) ProtoMessage() {}
+
+func (x *CheckoutCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckoutCartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutCartRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for checking out a cart
+type CheckoutCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutCartResponse) Reset() {
+	*x = CheckoutCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartResponse) ProtoMessage() {}
+
+func (x *CheckoutCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckoutCartResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for creating a coupon (Admin operation)
+type CreateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                     // Letters and digits, optionally separated by single dashes; stored upper-case
+	DiscountType  string                 `protobuf:"bytes,2,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"` // percent or fixed
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                // 1 to 100 for percent coupons, minor units for fixed ones
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`         // Optional Unix timestamp
+	MaxUses       int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`               // Optional; zero for unlimited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_proto_carts_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+// Response message for creating a coupon
+type CreateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponResponse) Reset() {
+	*x = CreateCouponResponse{}
+	mi := &file_proto_carts_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponResponse) ProtoMessage() {}
+
+func (x *CreateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponResponse.ProtoReflect.Descriptor instead.
+func (*CreateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateCouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// Request message for restoring a soft-deleted cart (Admin operation)
+type RestoreCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCartRequest) Reset() {
+	*x = RestoreCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCartRequest) ProtoMessage() {}
+
+func (x *RestoreCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCartRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RestoreCartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for restoring a cart
+type RestoreCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCartResponse) Reset() {
+	*x = RestoreCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCartResponse) ProtoMessage() {}
+
+func (x *RestoreCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCartResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request message for hard-deleting carts past the soft-delete retention (Admin operation)
+type PurgeDeletedCartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedCartsRequest) Reset() {
+	*x = PurgeDeletedCartsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedCartsRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedCartsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedCartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{43}
+}
+
+// Response message for purging deleted carts
+type PurgeDeletedCartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int32                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	Retention     int64                  `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"` // Retention window applied, in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedCartsResponse) Reset() {
+	*x = PurgeDeletedCartsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedCartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedCartsResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedCartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedCartsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedCartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PurgeDeletedCartsResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *PurgeDeletedCartsResponse) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+// Request message for reconciling cart versions (Admin operation)
+type ReconcileCartVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                   // Carts to scan, in id order; 0 scans them all
+	AfterId       string                 `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // Optional; resume the scan after this cart, e.g. the last_id of a previous call
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCartVersionsRequest) Reset() {
+	*x = ReconcileCartVersionsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCartVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCartVersionsRequest) ProtoMessage() {}
+
+func (x *ReconcileCartVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCartVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileCartVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReconcileCartVersionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReconcileCartVersionsRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+// Response message for reconciling cart versions
+type ReconcileCartVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scanned       int32                  `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Repaired      int32                  `protobuf:"varint,2,opt,name=repaired,proto3" json:"repaired,omitempty"`          // Carts whose version was raised
+	LastId        string                 `protobuf:"bytes,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"` // Last cart scanned, empty when none were
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCartVersionsResponse) Reset() {
+	*x = ReconcileCartVersionsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCartVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCartVersionsResponse) ProtoMessage() {}
+
+func (x *ReconcileCartVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCartVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileCartVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReconcileCartVersionsResponse) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *ReconcileCartVersionsResponse) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+func (x *ReconcileCartVersionsResponse) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+// CartSnapshot is a cart's contents at one version
+type CartSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CartId        string                 `protobuf:"bytes,2,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Cart version the items belong to
+	Items         []*CartSnapshotItem    `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartSnapshot) Reset() {
+	*x = CartSnapshot{}
+	mi := &file_proto_carts_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSnapshot) ProtoMessage() {}
+
+func (x *CartSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSnapshot.ProtoReflect.Descriptor instead.
+func (*CartSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CartSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CartSnapshot) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *CartSnapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CartSnapshot) GetItems() []*CartSnapshotItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CartSnapshot) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// CartSnapshotItem is a cart item as it was when the snapshot was taken
+type CartSnapshotItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartSnapshotItem) Reset() {
+	*x = CartSnapshotItem{}
+	mi := &file_proto_carts_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartSnapshotItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSnapshotItem) ProtoMessage() {}
+
+func (x *CartSnapshotItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSnapshotItem.ProtoReflect.Descriptor instead.
+func (*CartSnapshotItem) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CartSnapshotItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartSnapshotItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CartSnapshotItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Request message for snapshotting a cart on demand (Admin operation)
+type SnapshotCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotCartRequest) Reset() {
+	*x = SnapshotCartRequest{}
+	mi := &file_proto_carts_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotCartRequest) ProtoMessage() {}
+
+func (x *SnapshotCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotCartRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SnapshotCartRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+// Response message for snapshotting a cart
+type SnapshotCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *CartSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotCartResponse) Reset() {
+	*x = SnapshotCartResponse{}
+	mi := &file_proto_carts_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotCartResponse) ProtoMessage() {}
+
+func (x *SnapshotCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotCartResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SnapshotCartResponse) GetSnapshot() *CartSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Request message for listing a cart's snapshots (Admin operation)
+type GetCartSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartSnapshotsRequest) Reset() {
+	*x = GetCartSnapshotsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartSnapshotsRequest) ProtoMessage() {}
+
+func (x *GetCartSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetCartSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetCartSnapshotsRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+// Response message for listing a cart's snapshots
+type GetCartSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*CartSnapshot        `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartSnapshotsResponse) Reset() {
+	*x = GetCartSnapshotsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartSnapshotsResponse) ProtoMessage() {}
+
+func (x *GetCartSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetCartSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetCartSnapshotsResponse) GetSnapshots() []*CartSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// Request message for exporting carts (Admin operation)
+type ExportCartsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportCartsRequest) Reset() {
+	*x = ExportCartsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCartsRequest) ProtoMessage() {}
+
+func (x *ExportCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCartsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExportCartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExportCartsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ExportCartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportCartsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Request message for summing the value of users' active carts (Admin operation)
+type GetUsersCartValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersCartValueRequest) Reset() {
+	*x = GetUsersCartValueRequest{}
+	mi := &file_proto_carts_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersCartValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersCartValueRequest) ProtoMessage() {}
+
+func (x *GetUsersCartValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersCartValueRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersCartValueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetUsersCartValueRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// UserCartValue is the total value of a user's active cart at current catalog prices
+type UserCartValue struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/carts.proto.
+	TotalValue      float64 `protobuf:"fixed64,2,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`                 // Use total_value_cents
+	ItemCount       int32   `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`                     // Lines included in total_value
+	MissingItems    int32   `protobuf:"varint,4,opt,name=missing_items,json=missingItems,proto3" json:"missing_items,omitempty"`            // Lines skipped because the product no longer exists
+	TotalValueCents int64   `protobuf:"varint,5,opt,name=total_value_cents,json=totalValueCents,proto3" json:"total_value_cents,omitempty"` // Total in minor units (cents)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserCartValue) Reset() {
+	*x = UserCartValue{}
+	mi := &file_proto_carts_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCartValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCartValue) ProtoMessage() {}
+
+func (x *UserCartValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCartValue.ProtoReflect.Descriptor instead.
+func (*UserCartValue) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UserCartValue) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/carts.proto.
+func (x *UserCartValue) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *UserCartValue) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *UserCartValue) GetMissingItems() int32 {
+	if x != nil {
+		return x.MissingItems
+	}
+	return 0
+}
+
+func (x *UserCartValue) GetTotalValueCents() int64 {
+	if x != nil {
+		return x.TotalValueCents
+	}
+	return 0
 }
 
-// Response message for listing carts
-type ListCartsResponse struct {
+// Response message for users' cart values, in request order
+type GetUsersCartValueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Carts         []*Cart                `protobuf:"bytes,1,rep,name=carts,proto3" json:"carts,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Values        []*UserCartValue       `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCartsResponse) Reset() {
-	*x = ListCartsResponse{}
-	mi := &file_proto_carts_proto_msgTypes[15]
+func (x *GetUsersCartValueResponse) Reset() {
+	*x = GetUsersCartValueResponse{}
+	mi := &file_proto_carts_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCartsResponse) String() string {
+func (x *GetUsersCartValueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCartsResponse) ProtoMessage() {}
+func (*GetUsersCartValueResponse) ProtoMessage() {}
 
-func (x *ListCartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[15]
+func (x *GetUsersCartValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,48 +3289,41 @@ func (x *ListCartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCartsResponse.ProtoReflect.Descriptor instead.
-func (*ListCartsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetUsersCartValueResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersCartValueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListCartsResponse) GetCarts() []*Cart {
+func (x *GetUsersCartValueResponse) GetValues() []*UserCartValue {
 	if x != nil {
-		return x.Carts
+		return x.Values
 	}
 	return nil
 }
 
-func (x *ListCartsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Request message for force deleting a cart (Admin operation)
-type ForceDeleteCartRequest struct {
+// Request message for cart conversion statistics (Admin operation)
+type GetConversionStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Since         int64                  `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"` // Unix timestamp; only carts created at or after it are counted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteCartRequest) Reset() {
-	*x = ForceDeleteCartRequest{}
-	mi := &file_proto_carts_proto_msgTypes[16]
+func (x *GetConversionStatsRequest) Reset() {
+	*x = GetConversionStatsRequest{}
+	mi := &file_proto_carts_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteCartRequest) String() string {
+func (x *GetConversionStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteCartRequest) ProtoMessage() {}
+func (*GetConversionStatsRequest) ProtoMessage() {}
 
-func (x *ForceDeleteCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[16]
+func (x *GetConversionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,42 +3334,45 @@ func (x *ForceDeleteCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteCartRequest.ProtoReflect.Descriptor instead.
-func (*ForceDeleteCartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetConversionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ForceDeleteCartRequest) GetId() string {
+func (x *GetConversionStatsRequest) GetSince() int64 {
 	if x != nil {
-		return x.Id
+		return x.Since
 	}
-	return ""
+	return 0
 }
 
-// Response message for force deleting a cart
-type ForceDeleteCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Response message for cart conversion statistics
+type GetConversionStatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Created         int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`                                         // Carts created since the given time
+	CheckedOut      int32                  `protobuf:"varint,2,opt,name=checked_out,json=checkedOut,proto3" json:"checked_out,omitempty"`                 // Of those, carts checked out into an order
+	Abandoned       int32                  `protobuf:"varint,3,opt,name=abandoned,proto3" json:"abandoned,omitempty"`                                     // Of those, carts that expired with items without being checked out
+	ConversionRate  float64                `protobuf:"fixed64,4,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`    // checked_out / created, zero when no carts were created
+	AbandonmentRate float64                `protobuf:"fixed64,5,opt,name=abandonment_rate,json=abandonmentRate,proto3" json:"abandonment_rate,omitempty"` // abandoned / created, zero when no carts were created
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ForceDeleteCartResponse) Reset() {
-	*x = ForceDeleteCartResponse{}
-	mi := &file_proto_carts_proto_msgTypes[17]
+func (x *GetConversionStatsResponse) Reset() {
+	*x = GetConversionStatsResponse{}
+	mi := &file_proto_carts_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteCartResponse) String() string {
+func (x *GetConversionStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteCartResponse) ProtoMessage() {}
+func (*GetConversionStatsResponse) ProtoMessage() {}
 
-func (x *ForceDeleteCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[17]
+func (x *GetConversionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,49 +3383,73 @@ func (x *ForceDeleteCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteCartResponse.ProtoReflect.Descriptor instead.
-func (*ForceDeleteCartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetConversionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ForceDeleteCartResponse) GetId() string {
+func (x *GetConversionStatsResponse) GetCreated() int32 {
 	if x != nil {
-		return x.Id
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *ForceDeleteCartResponse) GetSuccess() bool {
+func (x *GetConversionStatsResponse) GetCheckedOut() int32 {
 	if x != nil {
-		return x.Success
+		return x.CheckedOut
 	}
-	return false
+	return 0
 }
 
-// Request message for soft deleting a cart (Admin or User operation)
-type SoftDeleteCartRequest struct {
+func (x *GetConversionStatsResponse) GetAbandoned() int32 {
+	if x != nil {
+		return x.Abandoned
+	}
+	return 0
+}
+
+func (x *GetConversionStatsResponse) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *GetConversionStatsResponse) GetAbandonmentRate() float64 {
+	if x != nil {
+		return x.AbandonmentRate
+	}
+	return 0
+}
+
+// WishlistItem is a product a user saved for later
+type WishlistItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // Product name snapshot, empty when not validated
+	AddedAt       int64                  `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`            // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SoftDeleteCartRequest) Reset() {
-	*x = SoftDeleteCartRequest{}
-	mi := &file_proto_carts_proto_msgTypes[18]
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_proto_carts_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoftDeleteCartRequest) String() string {
+func (x *WishlistItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoftDeleteCartRequest) ProtoMessage() {}
+func (*WishlistItem) ProtoMessage() {}
 
-func (x *SoftDeleteCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[18]
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,49 +3460,71 @@ func (x *SoftDeleteCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoftDeleteCartRequest.ProtoReflect.Descriptor instead.
-func (*SoftDeleteCartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SoftDeleteCartRequest) GetId() string {
+func (x *WishlistItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *SoftDeleteCartRequest) GetVersion() int32 {
+func (x *WishlistItem) GetUserId() string {
 	if x != nil {
-		return x.Version
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
 	}
 	return 0
 }
 
-// Response message for soft deleting a cart
-type SoftDeleteCartResponse struct {
+// Request message for moving a cart item to the cart owner's wishlist
+type MoveCartItemToWishlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	CartItemId    string                 `protobuf:"bytes,2,opt,name=cart_item_id,json=cartItemId,proto3" json:"cart_item_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Cart version for optimistic locking
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SoftDeleteCartResponse) Reset() {
-	*x = SoftDeleteCartResponse{}
-	mi := &file_proto_carts_proto_msgTypes[19]
+func (x *MoveCartItemToWishlistRequest) Reset() {
+	*x = MoveCartItemToWishlistRequest{}
+	mi := &file_proto_carts_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoftDeleteCartResponse) String() string {
+func (x *MoveCartItemToWishlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoftDeleteCartResponse) ProtoMessage() {}
+func (*MoveCartItemToWishlistRequest) ProtoMessage() {}
 
-func (x *SoftDeleteCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[19]
+func (x *MoveCartItemToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,48 +3535,56 @@ func (x *SoftDeleteCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoftDeleteCartResponse.ProtoReflect.Descriptor instead.
-func (*SoftDeleteCartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MoveCartItemToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*MoveCartItemToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SoftDeleteCartResponse) GetId() string {
+func (x *MoveCartItemToWishlistRequest) GetCartId() string {
 	if x != nil {
-		return x.Id
+		return x.CartId
 	}
 	return ""
 }
 
-func (x *SoftDeleteCartResponse) GetSuccess() bool {
+func (x *MoveCartItemToWishlistRequest) GetCartItemId() string {
 	if x != nil {
-		return x.Success
+		return x.CartItemId
 	}
-	return false
+	return ""
 }
 
-// Request message for restoring a soft-deleted cart (Admin operation)
-type RestoreCartRequest struct {
+func (x *MoveCartItemToWishlistRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for moving a cart item to the wishlist
+type MoveCartItemToWishlistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	WishlistItem  *WishlistItem          `protobuf:"bytes,2,opt,name=wishlist_item,json=wishlistItem,proto3" json:"wishlist_item,omitempty"` // The wishlist entry, which may predate the move
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreCartRequest) Reset() {
-	*x = RestoreCartRequest{}
-	mi := &file_proto_carts_proto_msgTypes[20]
+func (x *MoveCartItemToWishlistResponse) Reset() {
+	*x = MoveCartItemToWishlistResponse{}
+	mi := &file_proto_carts_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreCartRequest) String() string {
+func (x *MoveCartItemToWishlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreCartRequest) ProtoMessage() {}
+func (*MoveCartItemToWishlistResponse) ProtoMessage() {}
 
-func (x *RestoreCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[20]
+func (x *MoveCartItemToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,41 +3595,48 @@ func (x *RestoreCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreCartRequest.ProtoReflect.Descriptor instead.
-func (*RestoreCartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MoveCartItemToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*MoveCartItemToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *RestoreCartRequest) GetId() string {
+func (x *MoveCartItemToWishlistResponse) GetCart() *Cart {
 	if x != nil {
-		return x.Id
+		return x.Cart
 	}
-	return ""
+	return nil
 }
 
-// Response message for restoring a cart
-type RestoreCartResponse struct {
+func (x *MoveCartItemToWishlistResponse) GetWishlistItem() *WishlistItem {
+	if x != nil {
+		return x.WishlistItem
+	}
+	return nil
+}
+
+// Request message for listing a user's wishlist
+type ListWishlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreCartResponse) Reset() {
-	*x = RestoreCartResponse{}
-	mi := &file_proto_carts_proto_msgTypes[21]
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	mi := &file_proto_carts_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreCartResponse) String() string {
+func (x *ListWishlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreCartResponse) ProtoMessage() {}
+func (*ListWishlistRequest) ProtoMessage() {}
 
-func (x *RestoreCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[21]
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,44 +3647,41 @@ func (x *RestoreCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreCartResponse.ProtoReflect.Descriptor instead.
-func (*RestoreCartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *RestoreCartResponse) GetCart() *Cart {
+func (x *ListWishlistRequest) GetUserId() string {
 	if x != nil {
-		return x.Cart
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Request message for exporting carts (Admin operation)
-type ExportCartsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Response message for listing a user's wishlist
+type ListWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WishlistItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportCartsRequest) Reset() {
-	*x = ExportCartsRequest{}
-	mi := &file_proto_carts_proto_msgTypes[22]
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	mi := &file_proto_carts_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportCartsRequest) String() string {
+func (x *ListWishlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportCartsRequest) ProtoMessage() {}
+func (*ListWishlistResponse) ProtoMessage() {}
 
-func (x *ExportCartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_carts_proto_msgTypes[22]
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_carts_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,44 +3692,23 @@ func (x *ExportCartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportCartsRequest.ProtoReflect.Descriptor instead.
-func (*ExportCartsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_carts_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ExportCartsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ExportCartsRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ExportCartsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_carts_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ExportCartsRequest) GetIncludeDeleted() bool {
+func (x *ListWishlistResponse) GetItems() []*WishlistItem {
 	if x != nil {
-		return x.IncludeDeleted
+		return x.Items
 	}
-	return false
+	return nil
 }
 
 var File_proto_carts_proto protoreflect.FileDescriptor
 
 const file_proto_carts_proto_rawDesc = "" +
 	"\n" +
-	"\x11proto/carts.proto\x12\x05carts\"\xac\x01\n" +
+	"\x11proto/carts.proto\x12\x05carts\"\x90\x02\n" +
 	"\bCartItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1318,7 +3718,17 @@ const file_proto_carts_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x17\n" +
-	"\acart_id\x18\x06 \x01(\tR\x06cartId\"\x9f\x02\n" +
+	"\acart_id\x18\x06 \x01(\tR\x06cartId\x12!\n" +
+	"\fproduct_name\x18\a \x01(\tR\vproductName\x12?\n" +
+	"\favailability\x18\b \x01(\v2\x1b.carts.CartItemAvailabilityR\favailability\"\xbf\x01\n" +
+	"\x14CartItemAvailability\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x19\n" +
+	"\bin_stock\x18\x03 \x01(\bR\ainStock\x12\x1f\n" +
+	"\vprice_cents\x18\x04 \x01(\x03R\n" +
+	"priceCents\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\"\xfe\x02\n" +
 	"\x04Cart\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -1333,15 +3743,54 @@ const file_proto_carts_proto_rawDesc = "" +
 	"deleted_at\x18\a \x01(\x03R\tdeletedAt\x12\x18\n" +
 	"\aversion\x18\b \x01(\x05R\aversion\x12.\n" +
 	"\n" +
-	"cart_items\x18\t \x03(\v2\x0f.carts.CartItemR\tcartItems\"1\n" +
+	"cart_items\x18\t \x03(\v2\x0f.carts.CartItemR\tcartItems\x12$\n" +
+	"\x0echecked_out_at\x18\n" +
+	" \x01(\x03R\fcheckedOutAt\x12\x16\n" +
+	"\x06region\x18\v \x01(\tR\x06region\x12\x1f\n" +
+	"\vcoupon_code\x18\f \x01(\tR\n" +
+	"couponCode\"\xe1\x01\n" +
+	"\x06Coupon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x03 \x01(\tR\fdiscountType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\a \x01(\x05R\tusedCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\x98\x01\n" +
+	"\vCartPricing\x12%\n" +
+	"\x0esubtotal_cents\x18\x01 \x01(\x03R\rsubtotalCents\x12%\n" +
+	"\x0ediscount_cents\x18\x02 \x01(\x03R\rdiscountCents\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"1\n" +
 	"\x16GetOrCreateCartRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\":\n" +
 	"\x17GetOrCreateCartResponse\x12\x1f\n" +
-	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\" \n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"/\n" +
+	"\x14GetActiveCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"8\n" +
+	"\x15GetActiveCartResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"2\n" +
+	"\x17GetCartItemCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"i\n" +
+	"\x18GetCartItemCountResponse\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x01 \x01(\x05R\titemCount\x12.\n" +
+	"\x13distinct_item_count\x18\x02 \x01(\x05R\x11distinctItemCount\" \n" +
 	"\x0eGetCartRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
 	"\x0fGetCartResponse\x12\x1f\n" +
-	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"h\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"0\n" +
+	"\x1eGetCartWithAvailabilityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x95\x01\n" +
+	"\x1fGetCartWithAvailabilityResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\x12#\n" +
+	"\rall_available\x18\x02 \x01(\bR\fallAvailable\x12,\n" +
+	"\apricing\x18\x03 \x01(\v2\x12.carts.CartPricingR\apricing\"h\n" +
 	"\x12AddCartItemRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
@@ -1363,17 +3812,32 @@ const file_proto_carts_proto_rawDesc = "" +
 	"cartItemId\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\x05R\aversion\"9\n" +
 	"\x16RemoveCartItemResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"r\n" +
+	"\x1eRemoveCartItemByProductRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"B\n" +
+	"\x1fRemoveCartItemByProductResponse\x12\x1f\n" +
 	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"E\n" +
 	"\x10ClearCartRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\"4\n" +
 	"\x11ClearCartResponse\x12\x1f\n" +
-	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"\x82\x01\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"\xaa\x01\n" +
+	"\x11MergeCartsRequest\x12$\n" +
+	"\x0esource_cart_id\x18\x01 \x01(\tR\fsourceCartId\x12$\n" +
+	"\x0etarget_cart_id\x18\x02 \x01(\tR\ftargetCartId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x120\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x14.carts.MergeStrategyR\bstrategy\"5\n" +
+	"\x12MergeCartsResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"\x9a\x01\n" +
 	"\x10ListCartsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12'\n" +
-	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"L\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\"L\n" +
 	"\x11ListCartsResponse\x12!\n" +
 	"\x05carts\x18\x01 \x03(\v2\v.carts.CartR\x05carts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"(\n" +
@@ -1387,29 +3851,151 @@ const file_proto_carts_proto_rawDesc = "" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\"B\n" +
 	"\x16SoftDeleteCartResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"$\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"A\n" +
+	"\x12ApplyCouponRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"d\n" +
+	"\x13ApplyCouponResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\x12,\n" +
+	"\apricing\x18\x02 \x01(\v2\x12.carts.CartPricingR\apricing\".\n" +
+	"\x13RemoveCouponRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\"e\n" +
+	"\x14RemoveCouponResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\x12,\n" +
+	"\apricing\x18\x02 \x01(\v2\x12.carts.CartPricingR\apricing\"?\n" +
+	"\x13CheckoutCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"@\n" +
+	"\x14CheckoutCartResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\xa0\x01\n" +
+	"\x13CreateCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rdiscount_type\x18\x02 \x01(\tR\fdiscountType\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\x05 \x01(\x05R\amaxUses\"=\n" +
+	"\x14CreateCouponResponse\x12%\n" +
+	"\x06coupon\x18\x01 \x01(\v2\r.carts.CouponR\x06coupon\"$\n" +
 	"\x12RestoreCartRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x13RestoreCartResponse\x12\x1f\n" +
-	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"\x84\x01\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\"\x1a\n" +
+	"\x18PurgeDeletedCartsRequest\"Q\n" +
+	"\x19PurgeDeletedCartsResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x05R\x06purged\x12\x1c\n" +
+	"\tretention\x18\x02 \x01(\x03R\tretention\"O\n" +
+	"\x1cReconcileCartVersionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\tR\aafterId\"n\n" +
+	"\x1dReconcileCartVersionsResponse\x12\x18\n" +
+	"\ascanned\x18\x01 \x01(\x05R\ascanned\x12\x1a\n" +
+	"\brepaired\x18\x02 \x01(\x05R\brepaired\x12\x17\n" +
+	"\alast_id\x18\x03 \x01(\tR\x06lastId\"\x9f\x01\n" +
+	"\fCartSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\acart_id\x18\x02 \x01(\tR\x06cartId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12-\n" +
+	"\x05items\x18\x04 \x03(\v2\x17.carts.CartSnapshotItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"p\n" +
+	"\x10CartSnapshotItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\".\n" +
+	"\x13SnapshotCartRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\"G\n" +
+	"\x14SnapshotCartResponse\x12/\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.carts.CartSnapshotR\bsnapshot\"2\n" +
+	"\x17GetCartSnapshotsRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\"M\n" +
+	"\x18GetCartSnapshotsResponse\x121\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x13.carts.CartSnapshotR\tsnapshots\"\x84\x01\n" +
 	"\x12ExportCartsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12'\n" +
-	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted2\x9a\x04\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"5\n" +
+	"\x18GetUsersCartValueRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\xbd\x01\n" +
+	"\rUserCartValue\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\vtotal_value\x18\x02 \x01(\x01B\x02\x18\x01R\n" +
+	"totalValue\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x03 \x01(\x05R\titemCount\x12#\n" +
+	"\rmissing_items\x18\x04 \x01(\x05R\fmissingItems\x12*\n" +
+	"\x11total_value_cents\x18\x05 \x01(\x03R\x0ftotalValueCents\"I\n" +
+	"\x19GetUsersCartValueResponse\x12,\n" +
+	"\x06values\x18\x01 \x03(\v2\x14.carts.UserCartValueR\x06values\"1\n" +
+	"\x19GetConversionStatsRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\x03R\x05since\"\xc9\x01\n" +
+	"\x1aGetConversionStatsResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x1f\n" +
+	"\vchecked_out\x18\x02 \x01(\x05R\n" +
+	"checkedOut\x12\x1c\n" +
+	"\tabandoned\x18\x03 \x01(\x05R\tabandoned\x12'\n" +
+	"\x0fconversion_rate\x18\x04 \x01(\x01R\x0econversionRate\x12)\n" +
+	"\x10abandonment_rate\x18\x05 \x01(\x01R\x0fabandonmentRate\"\x94\x01\n" +
+	"\fWishlistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12\x19\n" +
+	"\badded_at\x18\x05 \x01(\x03R\aaddedAt\"t\n" +
+	"\x1dMoveCartItemToWishlistRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12 \n" +
+	"\fcart_item_id\x18\x02 \x01(\tR\n" +
+	"cartItemId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"{\n" +
+	"\x1eMoveCartItemToWishlistResponse\x12\x1f\n" +
+	"\x04cart\x18\x01 \x01(\v2\v.carts.CartR\x04cart\x128\n" +
+	"\rwishlist_item\x18\x02 \x01(\v2\x13.carts.WishlistItemR\fwishlistItem\".\n" +
+	"\x13ListWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"A\n" +
+	"\x14ListWishlistResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.carts.WishlistItemR\x05items*c\n" +
+	"\rMergeStrategy\x12\x1e\n" +
+	"\x1aMERGE_STRATEGY_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03SUM\x10\x01\x12\a\n" +
+	"\x03MAX\x10\x02\x12\x0f\n" +
+	"\vKEEP_TARGET\x10\x03\x12\x0f\n" +
+	"\vKEEP_SOURCE\x10\x042\xee\n" +
+	"\n" +
 	"\vCartService\x12R\n" +
-	"\x0fGetOrCreateCart\x12\x1d.carts.GetOrCreateCartRequest\x1a\x1e.carts.GetOrCreateCartResponse\"\x00\x12:\n" +
-	"\aGetCart\x12\x15.carts.GetCartRequest\x1a\x16.carts.GetCartResponse\"\x00\x12F\n" +
+	"\x0fGetOrCreateCart\x12\x1d.carts.GetOrCreateCartRequest\x1a\x1e.carts.GetOrCreateCartResponse\"\x00\x12L\n" +
+	"\rGetActiveCart\x12\x1b.carts.GetActiveCartRequest\x1a\x1c.carts.GetActiveCartResponse\"\x00\x12U\n" +
+	"\x10GetCartItemCount\x12\x1e.carts.GetCartItemCountRequest\x1a\x1f.carts.GetCartItemCountResponse\"\x00\x12:\n" +
+	"\aGetCart\x12\x15.carts.GetCartRequest\x1a\x16.carts.GetCartResponse\"\x00\x12j\n" +
+	"\x17GetCartWithAvailability\x12%.carts.GetCartWithAvailabilityRequest\x1a&.carts.GetCartWithAvailabilityResponse\"\x00\x12F\n" +
 	"\vAddCartItem\x12\x19.carts.AddCartItemRequest\x1a\x1a.carts.AddCartItemResponse\"\x00\x12O\n" +
 	"\x0eUpdateCartItem\x12\x1c.carts.UpdateCartItemRequest\x1a\x1d.carts.UpdateCartItemResponse\"\x00\x12O\n" +
-	"\x0eRemoveCartItem\x12\x1c.carts.RemoveCartItemRequest\x1a\x1d.carts.RemoveCartItemResponse\"\x00\x12@\n" +
+	"\x0eRemoveCartItem\x12\x1c.carts.RemoveCartItemRequest\x1a\x1d.carts.RemoveCartItemResponse\"\x00\x12j\n" +
+	"\x17RemoveCartItemByProduct\x12%.carts.RemoveCartItemByProductRequest\x1a&.carts.RemoveCartItemByProductResponse\"\x00\x12@\n" +
 	"\tClearCart\x12\x17.carts.ClearCartRequest\x1a\x18.carts.ClearCartResponse\"\x00\x12O\n" +
-	"\x0eSoftDeleteCart\x12\x1c.carts.SoftDeleteCartRequest\x1a\x1d.carts.SoftDeleteCartResponse\"\x002\xa7\x02\n" +
+	"\x0eSoftDeleteCart\x12\x1c.carts.SoftDeleteCartRequest\x1a\x1d.carts.SoftDeleteCartResponse\"\x00\x12C\n" +
+	"\n" +
+	"MergeCarts\x12\x18.carts.MergeCartsRequest\x1a\x19.carts.MergeCartsResponse\"\x00\x12I\n" +
+	"\fCheckoutCart\x12\x1a.carts.CheckoutCartRequest\x1a\x1b.carts.CheckoutCartResponse\"\x00\x12F\n" +
+	"\vApplyCoupon\x12\x19.carts.ApplyCouponRequest\x1a\x1a.carts.ApplyCouponResponse\"\x00\x12I\n" +
+	"\fRemoveCoupon\x12\x1a.carts.RemoveCouponRequest\x1a\x1b.carts.RemoveCouponResponse\"\x00\x12g\n" +
+	"\x16MoveCartItemToWishlist\x12$.carts.MoveCartItemToWishlistRequest\x1a%.carts.MoveCartItemToWishlistResponse\"\x00\x12I\n" +
+	"\fListWishlist\x12\x1a.carts.ListWishlistRequest\x1a\x1b.carts.ListWishlistResponse\"\x002\x8b\a\n" +
 	"\fAdminService\x12@\n" +
 	"\tListCarts\x12\x17.carts.ListCartsRequest\x1a\x18.carts.ListCartsResponse\"\x00\x12R\n" +
 	"\x0fForceDeleteCart\x12\x1d.carts.ForceDeleteCartRequest\x1a\x1e.carts.ForceDeleteCartResponse\"\x00\x12F\n" +
 	"\vRestoreCart\x12\x19.carts.RestoreCartRequest\x1a\x1a.carts.RestoreCartResponse\"\x00\x129\n" +
-	"\vExportCarts\x12\x19.carts.ExportCartsRequest\x1a\v.carts.Cart\"\x000\x01B\x0fZ\r./proto;cartsb\x06proto3"
+	"\vExportCarts\x12\x19.carts.ExportCartsRequest\x1a\v.carts.Cart\"\x000\x01\x12X\n" +
+	"\x11GetUsersCartValue\x12\x1f.carts.GetUsersCartValueRequest\x1a .carts.GetUsersCartValueResponse\"\x00\x12[\n" +
+	"\x12GetConversionStats\x12 .carts.GetConversionStatsRequest\x1a!.carts.GetConversionStatsResponse\"\x00\x12X\n" +
+	"\x11PurgeDeletedCarts\x12\x1f.carts.PurgeDeletedCartsRequest\x1a .carts.PurgeDeletedCartsResponse\"\x00\x12I\n" +
+	"\fSnapshotCart\x12\x1a.carts.SnapshotCartRequest\x1a\x1b.carts.SnapshotCartResponse\"\x00\x12U\n" +
+	"\x10GetCartSnapshots\x12\x1e.carts.GetCartSnapshotsRequest\x1a\x1f.carts.GetCartSnapshotsResponse\"\x00\x12d\n" +
+	"\x15ReconcileCartVersions\x12#.carts.ReconcileCartVersionsRequest\x1a$.carts.ReconcileCartVersionsResponse\"\x00\x12I\n" +
+	"\fCreateCoupon\x12\x1a.carts.CreateCouponRequest\x1a\x1b.carts.CreateCouponResponse\"\x00B\x0fZ\r./proto;cartsb\x06proto3"
 
 var (
 	file_proto_carts_proto_rawDescOnce sync.Once
@@ -1423,69 +4009,165 @@ func file_proto_carts_proto_rawDescGZIP() []byte {
 	return file_proto_carts_proto_rawDescData
 }
 
-var file_proto_carts_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_proto_carts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_carts_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_proto_carts_proto_goTypes = []any{
-	(*CartItem)(nil),                // 0: carts.CartItem
-	(*Cart)(nil),                    // 1: carts.Cart
-	(*GetOrCreateCartRequest)(nil),  // 2: carts.GetOrCreateCartRequest
-	(*GetOrCreateCartResponse)(nil), // 3: carts.GetOrCreateCartResponse
-	(*GetCartRequest)(nil),          // 4: carts.GetCartRequest
-	(*GetCartResponse)(nil),         // 5: carts.GetCartResponse
-	(*AddCartItemRequest)(nil),      // 6: carts.AddCartItemRequest
-	(*AddCartItemResponse)(nil),     // 7: carts.AddCartItemResponse
-	(*UpdateCartItemRequest)(nil),   // 8: carts.UpdateCartItemRequest
-	(*UpdateCartItemResponse)(nil),  // 9: carts.UpdateCartItemResponse
-	(*RemoveCartItemRequest)(nil),   // 10: carts.RemoveCartItemRequest
-	(*RemoveCartItemResponse)(nil),  // 11: carts.RemoveCartItemResponse
-	(*ClearCartRequest)(nil),        // 12: carts.ClearCartRequest
-	(*ClearCartResponse)(nil),       // 13: carts.ClearCartResponse
-	(*ListCartsRequest)(nil),        // 14: carts.ListCartsRequest
-	(*ListCartsResponse)(nil),       // 15: carts.ListCartsResponse
-	(*ForceDeleteCartRequest)(nil),  // 16: carts.ForceDeleteCartRequest
-	(*ForceDeleteCartResponse)(nil), // 17: carts.ForceDeleteCartResponse
-	(*SoftDeleteCartRequest)(nil),   // 18: carts.SoftDeleteCartRequest
-	(*SoftDeleteCartResponse)(nil),  // 19: carts.SoftDeleteCartResponse
-	(*RestoreCartRequest)(nil),      // 20: carts.RestoreCartRequest
-	(*RestoreCartResponse)(nil),     // 21: carts.RestoreCartResponse
-	(*ExportCartsRequest)(nil),      // 22: carts.ExportCartsRequest
+	(MergeStrategy)(0),                      // 0: carts.MergeStrategy
+	(*CartItem)(nil),                        // 1: carts.CartItem
+	(*CartItemAvailability)(nil),            // 2: carts.CartItemAvailability
+	(*Cart)(nil),                            // 3: carts.Cart
+	(*Coupon)(nil),                          // 4: carts.Coupon
+	(*CartPricing)(nil),                     // 5: carts.CartPricing
+	(*GetOrCreateCartRequest)(nil),          // 6: carts.GetOrCreateCartRequest
+	(*GetOrCreateCartResponse)(nil),         // 7: carts.GetOrCreateCartResponse
+	(*GetActiveCartRequest)(nil),            // 8: carts.GetActiveCartRequest
+	(*GetActiveCartResponse)(nil),           // 9: carts.GetActiveCartResponse
+	(*GetCartItemCountRequest)(nil),         // 10: carts.GetCartItemCountRequest
+	(*GetCartItemCountResponse)(nil),        // 11: carts.GetCartItemCountResponse
+	(*GetCartRequest)(nil),                  // 12: carts.GetCartRequest
+	(*GetCartResponse)(nil),                 // 13: carts.GetCartResponse
+	(*GetCartWithAvailabilityRequest)(nil),  // 14: carts.GetCartWithAvailabilityRequest
+	(*GetCartWithAvailabilityResponse)(nil), // 15: carts.GetCartWithAvailabilityResponse
+	(*AddCartItemRequest)(nil),              // 16: carts.AddCartItemRequest
+	(*AddCartItemResponse)(nil),             // 17: carts.AddCartItemResponse
+	(*UpdateCartItemRequest)(nil),           // 18: carts.UpdateCartItemRequest
+	(*UpdateCartItemResponse)(nil),          // 19: carts.UpdateCartItemResponse
+	(*RemoveCartItemRequest)(nil),           // 20: carts.RemoveCartItemRequest
+	(*RemoveCartItemResponse)(nil),          // 21: carts.RemoveCartItemResponse
+	(*RemoveCartItemByProductRequest)(nil),  // 22: carts.RemoveCartItemByProductRequest
+	(*RemoveCartItemByProductResponse)(nil), // 23: carts.RemoveCartItemByProductResponse
+	(*ClearCartRequest)(nil),                // 24: carts.ClearCartRequest
+	(*ClearCartResponse)(nil),               // 25: carts.ClearCartResponse
+	(*MergeCartsRequest)(nil),               // 26: carts.MergeCartsRequest
+	(*MergeCartsResponse)(nil),              // 27: carts.MergeCartsResponse
+	(*ListCartsRequest)(nil),                // 28: carts.ListCartsRequest
+	(*ListCartsResponse)(nil),               // 29: carts.ListCartsResponse
+	(*ForceDeleteCartRequest)(nil),          // 30: carts.ForceDeleteCartRequest
+	(*ForceDeleteCartResponse)(nil),         // 31: carts.ForceDeleteCartResponse
+	(*SoftDeleteCartRequest)(nil),           // 32: carts.SoftDeleteCartRequest
+	(*SoftDeleteCartResponse)(nil),          // 33: carts.SoftDeleteCartResponse
+	(*ApplyCouponRequest)(nil),              // 34: carts.ApplyCouponRequest
+	(*ApplyCouponResponse)(nil),             // 35: carts.ApplyCouponResponse
+	(*RemoveCouponRequest)(nil),             // 36: carts.RemoveCouponRequest
+	(*RemoveCouponResponse)(nil),            // 37: carts.RemoveCouponResponse
+	(*CheckoutCartRequest)(nil),             // 38: carts.CheckoutCartRequest
+	(*CheckoutCartResponse)(nil),            // 39: carts.CheckoutCartResponse
+	(*CreateCouponRequest)(nil),             // 40: carts.CreateCouponRequest
+	(*CreateCouponResponse)(nil),            // 41: carts.CreateCouponResponse
+	(*RestoreCartRequest)(nil),              // 42: carts.RestoreCartRequest
+	(*RestoreCartResponse)(nil),             // 43: carts.RestoreCartResponse
+	(*PurgeDeletedCartsRequest)(nil),        // 44: carts.PurgeDeletedCartsRequest
+	(*PurgeDeletedCartsResponse)(nil),       // 45: carts.PurgeDeletedCartsResponse
+	(*ReconcileCartVersionsRequest)(nil),    // 46: carts.ReconcileCartVersionsRequest
+	(*ReconcileCartVersionsResponse)(nil),   // 47: carts.ReconcileCartVersionsResponse
+	(*CartSnapshot)(nil),                    // 48: carts.CartSnapshot
+	(*CartSnapshotItem)(nil),                // 49: carts.CartSnapshotItem
+	(*SnapshotCartRequest)(nil),             // 50: carts.SnapshotCartRequest
+	(*SnapshotCartResponse)(nil),            // 51: carts.SnapshotCartResponse
+	(*GetCartSnapshotsRequest)(nil),         // 52: carts.GetCartSnapshotsRequest
+	(*GetCartSnapshotsResponse)(nil),        // 53: carts.GetCartSnapshotsResponse
+	(*ExportCartsRequest)(nil),              // 54: carts.ExportCartsRequest
+	(*GetUsersCartValueRequest)(nil),        // 55: carts.GetUsersCartValueRequest
+	(*UserCartValue)(nil),                   // 56: carts.UserCartValue
+	(*GetUsersCartValueResponse)(nil),       // 57: carts.GetUsersCartValueResponse
+	(*GetConversionStatsRequest)(nil),       // 58: carts.GetConversionStatsRequest
+	(*GetConversionStatsResponse)(nil),      // 59: carts.GetConversionStatsResponse
+	(*WishlistItem)(nil),                    // 60: carts.WishlistItem
+	(*MoveCartItemToWishlistRequest)(nil),   // 61: carts.MoveCartItemToWishlistRequest
+	(*MoveCartItemToWishlistResponse)(nil),  // 62: carts.MoveCartItemToWishlistResponse
+	(*ListWishlistRequest)(nil),             // 63: carts.ListWishlistRequest
+	(*ListWishlistResponse)(nil),            // 64: carts.ListWishlistResponse
 }
 var file_proto_carts_proto_depIdxs = []int32{
-	0,  // 0: carts.Cart.cart_items:type_name -> carts.CartItem
-	1,  // 1: carts.GetOrCreateCartResponse.cart:type_name -> carts.Cart
-	1,  // 2: carts.GetCartResponse.cart:type_name -> carts.Cart
-	1,  // 3: carts.AddCartItemResponse.cart:type_name -> carts.Cart
-	1,  // 4: carts.UpdateCartItemResponse.cart:type_name -> carts.Cart
-	1,  // 5: carts.RemoveCartItemResponse.cart:type_name -> carts.Cart
-	1,  // 6: carts.ClearCartResponse.cart:type_name -> carts.Cart
-	1,  // 7: carts.ListCartsResponse.carts:type_name -> carts.Cart
-	1,  // 8: carts.RestoreCartResponse.cart:type_name -> carts.Cart
-	2,  // 9: carts.CartService.GetOrCreateCart:input_type -> carts.GetOrCreateCartRequest
-	4,  // 10: carts.CartService.GetCart:input_type -> carts.GetCartRequest
-	6,  // 11: carts.CartService.AddCartItem:input_type -> carts.AddCartItemRequest
-	8,  // 12: carts.CartService.UpdateCartItem:input_type -> carts.UpdateCartItemRequest
-	10, // 13: carts.CartService.RemoveCartItem:input_type -> carts.RemoveCartItemRequest
-	12, // 14: carts.CartService.ClearCart:input_type -> carts.ClearCartRequest
-	18, // 15: carts.CartService.SoftDeleteCart:input_type -> carts.SoftDeleteCartRequest
-	14, // 16: carts.AdminService.ListCarts:input_type -> carts.ListCartsRequest
-	16, // 17: carts.AdminService.ForceDeleteCart:input_type -> carts.ForceDeleteCartRequest
-	20, // 18: carts.AdminService.RestoreCart:input_type -> carts.RestoreCartRequest
-	22, // 19: carts.AdminService.ExportCarts:input_type -> carts.ExportCartsRequest
-	3,  // 20: carts.CartService.GetOrCreateCart:output_type -> carts.GetOrCreateCartResponse
-	5,  // 21: carts.CartService.GetCart:output_type -> carts.GetCartResponse
-	7,  // 22: carts.CartService.AddCartItem:output_type -> carts.AddCartItemResponse
-	9,  // 23: carts.CartService.UpdateCartItem:output_type -> carts.UpdateCartItemResponse
-	11, // 24: carts.CartService.RemoveCartItem:output_type -> carts.RemoveCartItemResponse
-	13, // 25: carts.CartService.ClearCart:output_type -> carts.ClearCartResponse
-	19, // 26: carts.CartService.SoftDeleteCart:output_type -> carts.SoftDeleteCartResponse
-	15, // 27: carts.AdminService.ListCarts:output_type -> carts.ListCartsResponse
-	17, // 28: carts.AdminService.ForceDeleteCart:output_type -> carts.ForceDeleteCartResponse
-	21, // 29: carts.AdminService.RestoreCart:output_type -> carts.RestoreCartResponse
-	1,  // 30: carts.AdminService.ExportCarts:output_type -> carts.Cart
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	2,  // 0: carts.CartItem.availability:type_name -> carts.CartItemAvailability
+	1,  // 1: carts.Cart.cart_items:type_name -> carts.CartItem
+	3,  // 2: carts.GetOrCreateCartResponse.cart:type_name -> carts.Cart
+	3,  // 3: carts.GetActiveCartResponse.cart:type_name -> carts.Cart
+	3,  // 4: carts.GetCartResponse.cart:type_name -> carts.Cart
+	3,  // 5: carts.GetCartWithAvailabilityResponse.cart:type_name -> carts.Cart
+	5,  // 6: carts.GetCartWithAvailabilityResponse.pricing:type_name -> carts.CartPricing
+	3,  // 7: carts.AddCartItemResponse.cart:type_name -> carts.Cart
+	3,  // 8: carts.UpdateCartItemResponse.cart:type_name -> carts.Cart
+	3,  // 9: carts.RemoveCartItemResponse.cart:type_name -> carts.Cart
+	3,  // 10: carts.RemoveCartItemByProductResponse.cart:type_name -> carts.Cart
+	3,  // 11: carts.ClearCartResponse.cart:type_name -> carts.Cart
+	0,  // 12: carts.MergeCartsRequest.strategy:type_name -> carts.MergeStrategy
+	3,  // 13: carts.MergeCartsResponse.cart:type_name -> carts.Cart
+	3,  // 14: carts.ListCartsResponse.carts:type_name -> carts.Cart
+	3,  // 15: carts.ApplyCouponResponse.cart:type_name -> carts.Cart
+	5,  // 16: carts.ApplyCouponResponse.pricing:type_name -> carts.CartPricing
+	3,  // 17: carts.RemoveCouponResponse.cart:type_name -> carts.Cart
+	5,  // 18: carts.RemoveCouponResponse.pricing:type_name -> carts.CartPricing
+	4,  // 19: carts.CreateCouponResponse.coupon:type_name -> carts.Coupon
+	3,  // 20: carts.RestoreCartResponse.cart:type_name -> carts.Cart
+	49, // 21: carts.CartSnapshot.items:type_name -> carts.CartSnapshotItem
+	48, // 22: carts.SnapshotCartResponse.snapshot:type_name -> carts.CartSnapshot
+	48, // 23: carts.GetCartSnapshotsResponse.snapshots:type_name -> carts.CartSnapshot
+	56, // 24: carts.GetUsersCartValueResponse.values:type_name -> carts.UserCartValue
+	3,  // 25: carts.MoveCartItemToWishlistResponse.cart:type_name -> carts.Cart
+	60, // 26: carts.MoveCartItemToWishlistResponse.wishlist_item:type_name -> carts.WishlistItem
+	60, // 27: carts.ListWishlistResponse.items:type_name -> carts.WishlistItem
+	6,  // 28: carts.CartService.GetOrCreateCart:input_type -> carts.GetOrCreateCartRequest
+	8,  // 29: carts.CartService.GetActiveCart:input_type -> carts.GetActiveCartRequest
+	10, // 30: carts.CartService.GetCartItemCount:input_type -> carts.GetCartItemCountRequest
+	12, // 31: carts.CartService.GetCart:input_type -> carts.GetCartRequest
+	14, // 32: carts.CartService.GetCartWithAvailability:input_type -> carts.GetCartWithAvailabilityRequest
+	16, // 33: carts.CartService.AddCartItem:input_type -> carts.AddCartItemRequest
+	18, // 34: carts.CartService.UpdateCartItem:input_type -> carts.UpdateCartItemRequest
+	20, // 35: carts.CartService.RemoveCartItem:input_type -> carts.RemoveCartItemRequest
+	22, // 36: carts.CartService.RemoveCartItemByProduct:input_type -> carts.RemoveCartItemByProductRequest
+	24, // 37: carts.CartService.ClearCart:input_type -> carts.ClearCartRequest
+	32, // 38: carts.CartService.SoftDeleteCart:input_type -> carts.SoftDeleteCartRequest
+	26, // 39: carts.CartService.MergeCarts:input_type -> carts.MergeCartsRequest
+	38, // 40: carts.CartService.CheckoutCart:input_type -> carts.CheckoutCartRequest
+	34, // 41: carts.CartService.ApplyCoupon:input_type -> carts.ApplyCouponRequest
+	36, // 42: carts.CartService.RemoveCoupon:input_type -> carts.RemoveCouponRequest
+	61, // 43: carts.CartService.MoveCartItemToWishlist:input_type -> carts.MoveCartItemToWishlistRequest
+	63, // 44: carts.CartService.ListWishlist:input_type -> carts.ListWishlistRequest
+	28, // 45: carts.AdminService.ListCarts:input_type -> carts.ListCartsRequest
+	30, // 46: carts.AdminService.ForceDeleteCart:input_type -> carts.ForceDeleteCartRequest
+	42, // 47: carts.AdminService.RestoreCart:input_type -> carts.RestoreCartRequest
+	54, // 48: carts.AdminService.ExportCarts:input_type -> carts.ExportCartsRequest
+	55, // 49: carts.AdminService.GetUsersCartValue:input_type -> carts.GetUsersCartValueRequest
+	58, // 50: carts.AdminService.GetConversionStats:input_type -> carts.GetConversionStatsRequest
+	44, // 51: carts.AdminService.PurgeDeletedCarts:input_type -> carts.PurgeDeletedCartsRequest
+	50, // 52: carts.AdminService.SnapshotCart:input_type -> carts.SnapshotCartRequest
+	52, // 53: carts.AdminService.GetCartSnapshots:input_type -> carts.GetCartSnapshotsRequest
+	46, // 54: carts.AdminService.ReconcileCartVersions:input_type -> carts.ReconcileCartVersionsRequest
+	40, // 55: carts.AdminService.CreateCoupon:input_type -> carts.CreateCouponRequest
+	7,  // 56: carts.CartService.GetOrCreateCart:output_type -> carts.GetOrCreateCartResponse
+	9,  // 57: carts.CartService.GetActiveCart:output_type -> carts.GetActiveCartResponse
+	11, // 58: carts.CartService.GetCartItemCount:output_type -> carts.GetCartItemCountResponse
+	13, // 59: carts.CartService.GetCart:output_type -> carts.GetCartResponse
+	15, // 60: carts.CartService.GetCartWithAvailability:output_type -> carts.GetCartWithAvailabilityResponse
+	17, // 61: carts.CartService.AddCartItem:output_type -> carts.AddCartItemResponse
+	19, // 62: carts.CartService.UpdateCartItem:output_type -> carts.UpdateCartItemResponse
+	21, // 63: carts.CartService.RemoveCartItem:output_type -> carts.RemoveCartItemResponse
+	23, // 64: carts.CartService.RemoveCartItemByProduct:output_type -> carts.RemoveCartItemByProductResponse
+	25, // 65: carts.CartService.ClearCart:output_type -> carts.ClearCartResponse
+	33, // 66: carts.CartService.SoftDeleteCart:output_type -> carts.SoftDeleteCartResponse
+	27, // 67: carts.CartService.MergeCarts:output_type -> carts.MergeCartsResponse
+	39, // 68: carts.CartService.CheckoutCart:output_type -> carts.CheckoutCartResponse
+	35, // 69: carts.CartService.ApplyCoupon:output_type -> carts.ApplyCouponResponse
+	37, // 70: carts.CartService.RemoveCoupon:output_type -> carts.RemoveCouponResponse
+	62, // 71: carts.CartService.MoveCartItemToWishlist:output_type -> carts.MoveCartItemToWishlistResponse
+	64, // 72: carts.CartService.ListWishlist:output_type -> carts.ListWishlistResponse
+	29, // 73: carts.AdminService.ListCarts:output_type -> carts.ListCartsResponse
+	31, // 74: carts.AdminService.ForceDeleteCart:output_type -> carts.ForceDeleteCartResponse
+	43, // 75: carts.AdminService.RestoreCart:output_type -> carts.RestoreCartResponse
+	3,  // 76: carts.AdminService.ExportCarts:output_type -> carts.Cart
+	57, // 77: carts.AdminService.GetUsersCartValue:output_type -> carts.GetUsersCartValueResponse
+	59, // 78: carts.AdminService.GetConversionStats:output_type -> carts.GetConversionStatsResponse
+	45, // 79: carts.AdminService.PurgeDeletedCarts:output_type -> carts.PurgeDeletedCartsResponse
+	51, // 80: carts.AdminService.SnapshotCart:output_type -> carts.SnapshotCartResponse
+	53, // 81: carts.AdminService.GetCartSnapshots:output_type -> carts.GetCartSnapshotsResponse
+	47, // 82: carts.AdminService.ReconcileCartVersions:output_type -> carts.ReconcileCartVersionsResponse
+	41, // 83: carts.AdminService.CreateCoupon:output_type -> carts.CreateCouponResponse
+	56, // [56:84] is the sub-list for method output_type
+	28, // [28:56] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_proto_carts_proto_init() }
@@ -1498,13 +4180,14 @@ func file_proto_carts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_carts_proto_rawDesc), len(file_proto_carts_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      1,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_proto_carts_proto_goTypes,
 		DependencyIndexes: file_proto_carts_proto_depIdxs,
+		EnumInfos:         file_proto_carts_proto_enumTypes,
 		MessageInfos:      file_proto_carts_proto_msgTypes,
 	}.Build()
 	File_proto_carts_proto = out.File
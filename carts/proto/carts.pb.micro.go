@@ -30,12 +30,24 @@ var _ server.Option
 type CartService interface {
 	// Cart operations
 	GetOrCreateCart(ctx context.Context, in *GetOrCreateCartRequest, opts ...client.CallOption) (*GetOrCreateCartResponse, error)
+	GetActiveCart(ctx context.Context, in *GetActiveCartRequest, opts ...client.CallOption) (*GetActiveCartResponse, error)
+	GetCartItemCount(ctx context.Context, in *GetCartItemCountRequest, opts ...client.CallOption) (*GetCartItemCountResponse, error)
 	GetCart(ctx context.Context, in *GetCartRequest, opts ...client.CallOption) (*GetCartResponse, error)
+	GetCartWithAvailability(ctx context.Context, in *GetCartWithAvailabilityRequest, opts ...client.CallOption) (*GetCartWithAvailabilityResponse, error)
 	AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...client.CallOption) (*AddCartItemResponse, error)
 	UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, opts ...client.CallOption) (*UpdateCartItemResponse, error)
 	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...client.CallOption) (*RemoveCartItemResponse, error)
+	RemoveCartItemByProduct(ctx context.Context, in *RemoveCartItemByProductRequest, opts ...client.CallOption) (*RemoveCartItemByProductResponse, error)
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...client.CallOption) (*ClearCartResponse, error)
 	SoftDeleteCart(ctx context.Context, in *SoftDeleteCartRequest, opts ...client.CallOption) (*SoftDeleteCartResponse, error)
+	MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...client.CallOption) (*MergeCartsResponse, error)
+	CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...client.CallOption) (*CheckoutCartResponse, error)
+	// Coupon operations
+	ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...client.CallOption) (*ApplyCouponResponse, error)
+	RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...client.CallOption) (*RemoveCouponResponse, error)
+	// Wishlist operations
+	MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, opts ...client.CallOption) (*MoveCartItemToWishlistResponse, error)
+	ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...client.CallOption) (*ListWishlistResponse, error)
 }
 
 type cartService struct {
@@ -60,6 +72,26 @@ func (c *cartService) GetOrCreateCart(ctx context.Context, in *GetOrCreateCartRe
 	return out, nil
 }
 
+func (c *cartService) GetActiveCart(ctx context.Context, in *GetActiveCartRequest, opts ...client.CallOption) (*GetActiveCartResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.GetActiveCart", in)
+	out := new(GetActiveCartResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) GetCartItemCount(ctx context.Context, in *GetCartItemCountRequest, opts ...client.CallOption) (*GetCartItemCountResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.GetCartItemCount", in)
+	out := new(GetCartItemCountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartService) GetCart(ctx context.Context, in *GetCartRequest, opts ...client.CallOption) (*GetCartResponse, error) {
 	req := c.c.NewRequest(c.name, "CartService.GetCart", in)
 	out := new(GetCartResponse)
@@ -70,6 +102,16 @@ func (c *cartService) GetCart(ctx context.Context, in *GetCartRequest, opts ...c
 	return out, nil
 }
 
+func (c *cartService) GetCartWithAvailability(ctx context.Context, in *GetCartWithAvailabilityRequest, opts ...client.CallOption) (*GetCartWithAvailabilityResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.GetCartWithAvailability", in)
+	out := new(GetCartWithAvailabilityResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartService) AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...client.CallOption) (*AddCartItemResponse, error) {
 	req := c.c.NewRequest(c.name, "CartService.AddCartItem", in)
 	out := new(AddCartItemResponse)
@@ -100,6 +142,16 @@ func (c *cartService) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequ
 	return out, nil
 }
 
+func (c *cartService) RemoveCartItemByProduct(ctx context.Context, in *RemoveCartItemByProductRequest, opts ...client.CallOption) (*RemoveCartItemByProductResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.RemoveCartItemByProduct", in)
+	out := new(RemoveCartItemByProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartService) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...client.CallOption) (*ClearCartResponse, error) {
 	req := c.c.NewRequest(c.name, "CartService.ClearCart", in)
 	out := new(ClearCartResponse)
@@ -120,28 +172,110 @@ func (c *cartService) SoftDeleteCart(ctx context.Context, in *SoftDeleteCartRequ
 	return out, nil
 }
 
+func (c *cartService) MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...client.CallOption) (*MergeCartsResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.MergeCarts", in)
+	out := new(MergeCartsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...client.CallOption) (*CheckoutCartResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.CheckoutCart", in)
+	out := new(CheckoutCartResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...client.CallOption) (*ApplyCouponResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.ApplyCoupon", in)
+	out := new(ApplyCouponResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...client.CallOption) (*RemoveCouponResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.RemoveCoupon", in)
+	out := new(RemoveCouponResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, opts ...client.CallOption) (*MoveCartItemToWishlistResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.MoveCartItemToWishlist", in)
+	out := new(MoveCartItemToWishlistResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartService) ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...client.CallOption) (*ListWishlistResponse, error) {
+	req := c.c.NewRequest(c.name, "CartService.ListWishlist", in)
+	out := new(ListWishlistResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for CartService service
 
 type CartServiceHandler interface {
 	// Cart operations
 	GetOrCreateCart(context.Context, *GetOrCreateCartRequest, *GetOrCreateCartResponse) error
+	GetActiveCart(context.Context, *GetActiveCartRequest, *GetActiveCartResponse) error
+	GetCartItemCount(context.Context, *GetCartItemCountRequest, *GetCartItemCountResponse) error
 	GetCart(context.Context, *GetCartRequest, *GetCartResponse) error
+	GetCartWithAvailability(context.Context, *GetCartWithAvailabilityRequest, *GetCartWithAvailabilityResponse) error
 	AddCartItem(context.Context, *AddCartItemRequest, *AddCartItemResponse) error
 	UpdateCartItem(context.Context, *UpdateCartItemRequest, *UpdateCartItemResponse) error
 	RemoveCartItem(context.Context, *RemoveCartItemRequest, *RemoveCartItemResponse) error
+	RemoveCartItemByProduct(context.Context, *RemoveCartItemByProductRequest, *RemoveCartItemByProductResponse) error
 	ClearCart(context.Context, *ClearCartRequest, *ClearCartResponse) error
 	SoftDeleteCart(context.Context, *SoftDeleteCartRequest, *SoftDeleteCartResponse) error
+	MergeCarts(context.Context, *MergeCartsRequest, *MergeCartsResponse) error
+	CheckoutCart(context.Context, *CheckoutCartRequest, *CheckoutCartResponse) error
+	// Coupon operations
+	ApplyCoupon(context.Context, *ApplyCouponRequest, *ApplyCouponResponse) error
+	RemoveCoupon(context.Context, *RemoveCouponRequest, *RemoveCouponResponse) error
+	// Wishlist operations
+	MoveCartItemToWishlist(context.Context, *MoveCartItemToWishlistRequest, *MoveCartItemToWishlistResponse) error
+	ListWishlist(context.Context, *ListWishlistRequest, *ListWishlistResponse) error
 }
 
 func RegisterCartServiceHandler(s server.Server, hdlr CartServiceHandler, opts ...server.HandlerOption) error {
 	type cartService interface {
 		GetOrCreateCart(ctx context.Context, in *GetOrCreateCartRequest, out *GetOrCreateCartResponse) error
+		GetActiveCart(ctx context.Context, in *GetActiveCartRequest, out *GetActiveCartResponse) error
+		GetCartItemCount(ctx context.Context, in *GetCartItemCountRequest, out *GetCartItemCountResponse) error
 		GetCart(ctx context.Context, in *GetCartRequest, out *GetCartResponse) error
+		GetCartWithAvailability(ctx context.Context, in *GetCartWithAvailabilityRequest, out *GetCartWithAvailabilityResponse) error
 		AddCartItem(ctx context.Context, in *AddCartItemRequest, out *AddCartItemResponse) error
 		UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, out *UpdateCartItemResponse) error
 		RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, out *RemoveCartItemResponse) error
+		RemoveCartItemByProduct(ctx context.Context, in *RemoveCartItemByProductRequest, out *RemoveCartItemByProductResponse) error
 		ClearCart(ctx context.Context, in *ClearCartRequest, out *ClearCartResponse) error
 		SoftDeleteCart(ctx context.Context, in *SoftDeleteCartRequest, out *SoftDeleteCartResponse) error
+		MergeCarts(ctx context.Context, in *MergeCartsRequest, out *MergeCartsResponse) error
+		CheckoutCart(ctx context.Context, in *CheckoutCartRequest, out *CheckoutCartResponse) error
+		ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, out *ApplyCouponResponse) error
+		RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, out *RemoveCouponResponse) error
+		MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, out *MoveCartItemToWishlistResponse) error
+		ListWishlist(ctx context.Context, in *ListWishlistRequest, out *ListWishlistResponse) error
 	}
 	type CartService struct {
 		cartService
@@ -158,10 +292,22 @@ func (h *cartServiceHandler) GetOrCreateCart(ctx context.Context, in *GetOrCreat
 	return h.CartServiceHandler.GetOrCreateCart(ctx, in, out)
 }
 
+func (h *cartServiceHandler) GetActiveCart(ctx context.Context, in *GetActiveCartRequest, out *GetActiveCartResponse) error {
+	return h.CartServiceHandler.GetActiveCart(ctx, in, out)
+}
+
+func (h *cartServiceHandler) GetCartItemCount(ctx context.Context, in *GetCartItemCountRequest, out *GetCartItemCountResponse) error {
+	return h.CartServiceHandler.GetCartItemCount(ctx, in, out)
+}
+
 func (h *cartServiceHandler) GetCart(ctx context.Context, in *GetCartRequest, out *GetCartResponse) error {
 	return h.CartServiceHandler.GetCart(ctx, in, out)
 }
 
+func (h *cartServiceHandler) GetCartWithAvailability(ctx context.Context, in *GetCartWithAvailabilityRequest, out *GetCartWithAvailabilityResponse) error {
+	return h.CartServiceHandler.GetCartWithAvailability(ctx, in, out)
+}
+
 func (h *cartServiceHandler) AddCartItem(ctx context.Context, in *AddCartItemRequest, out *AddCartItemResponse) error {
 	return h.CartServiceHandler.AddCartItem(ctx, in, out)
 }
@@ -174,6 +320,10 @@ func (h *cartServiceHandler) RemoveCartItem(ctx context.Context, in *RemoveCartI
 	return h.CartServiceHandler.RemoveCartItem(ctx, in, out)
 }
 
+func (h *cartServiceHandler) RemoveCartItemByProduct(ctx context.Context, in *RemoveCartItemByProductRequest, out *RemoveCartItemByProductResponse) error {
+	return h.CartServiceHandler.RemoveCartItemByProduct(ctx, in, out)
+}
+
 func (h *cartServiceHandler) ClearCart(ctx context.Context, in *ClearCartRequest, out *ClearCartResponse) error {
 	return h.CartServiceHandler.ClearCart(ctx, in, out)
 }
@@ -182,6 +332,30 @@ func (h *cartServiceHandler) SoftDeleteCart(ctx context.Context, in *SoftDeleteC
 	return h.CartServiceHandler.SoftDeleteCart(ctx, in, out)
 }
 
+func (h *cartServiceHandler) MergeCarts(ctx context.Context, in *MergeCartsRequest, out *MergeCartsResponse) error {
+	return h.CartServiceHandler.MergeCarts(ctx, in, out)
+}
+
+func (h *cartServiceHandler) CheckoutCart(ctx context.Context, in *CheckoutCartRequest, out *CheckoutCartResponse) error {
+	return h.CartServiceHandler.CheckoutCart(ctx, in, out)
+}
+
+func (h *cartServiceHandler) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, out *ApplyCouponResponse) error {
+	return h.CartServiceHandler.ApplyCoupon(ctx, in, out)
+}
+
+func (h *cartServiceHandler) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, out *RemoveCouponResponse) error {
+	return h.CartServiceHandler.RemoveCoupon(ctx, in, out)
+}
+
+func (h *cartServiceHandler) MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, out *MoveCartItemToWishlistResponse) error {
+	return h.CartServiceHandler.MoveCartItemToWishlist(ctx, in, out)
+}
+
+func (h *cartServiceHandler) ListWishlist(ctx context.Context, in *ListWishlistRequest, out *ListWishlistResponse) error {
+	return h.CartServiceHandler.ListWishlist(ctx, in, out)
+}
+
 // Client API for AdminService service
 
 type AdminService interface {
@@ -189,6 +363,13 @@ type AdminService interface {
 	ForceDeleteCart(ctx context.Context, in *ForceDeleteCartRequest, opts ...client.CallOption) (*ForceDeleteCartResponse, error)
 	RestoreCart(ctx context.Context, in *RestoreCartRequest, opts ...client.CallOption) (*RestoreCartResponse, error)
 	ExportCarts(ctx context.Context, in *ExportCartsRequest, opts ...client.CallOption) (AdminService_ExportCartsService, error)
+	GetUsersCartValue(ctx context.Context, in *GetUsersCartValueRequest, opts ...client.CallOption) (*GetUsersCartValueResponse, error)
+	GetConversionStats(ctx context.Context, in *GetConversionStatsRequest, opts ...client.CallOption) (*GetConversionStatsResponse, error)
+	PurgeDeletedCarts(ctx context.Context, in *PurgeDeletedCartsRequest, opts ...client.CallOption) (*PurgeDeletedCartsResponse, error)
+	SnapshotCart(ctx context.Context, in *SnapshotCartRequest, opts ...client.CallOption) (*SnapshotCartResponse, error)
+	GetCartSnapshots(ctx context.Context, in *GetCartSnapshotsRequest, opts ...client.CallOption) (*GetCartSnapshotsResponse, error)
+	ReconcileCartVersions(ctx context.Context, in *ReconcileCartVersionsRequest, opts ...client.CallOption) (*ReconcileCartVersionsResponse, error)
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...client.CallOption) (*CreateCouponResponse, error)
 }
 
 type adminService struct {
@@ -287,6 +468,76 @@ func (x *adminServiceExportCarts) Recv() (*Cart, error) {
 	return m, nil
 }
 
+func (c *adminService) GetUsersCartValue(ctx context.Context, in *GetUsersCartValueRequest, opts ...client.CallOption) (*GetUsersCartValueResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetUsersCartValue", in)
+	out := new(GetUsersCartValueResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GetConversionStats(ctx context.Context, in *GetConversionStatsRequest, opts ...client.CallOption) (*GetConversionStatsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetConversionStats", in)
+	out := new(GetConversionStatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) PurgeDeletedCarts(ctx context.Context, in *PurgeDeletedCartsRequest, opts ...client.CallOption) (*PurgeDeletedCartsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.PurgeDeletedCarts", in)
+	out := new(PurgeDeletedCartsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) SnapshotCart(ctx context.Context, in *SnapshotCartRequest, opts ...client.CallOption) (*SnapshotCartResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.SnapshotCart", in)
+	out := new(SnapshotCartResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GetCartSnapshots(ctx context.Context, in *GetCartSnapshotsRequest, opts ...client.CallOption) (*GetCartSnapshotsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetCartSnapshots", in)
+	out := new(GetCartSnapshotsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ReconcileCartVersions(ctx context.Context, in *ReconcileCartVersionsRequest, opts ...client.CallOption) (*ReconcileCartVersionsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ReconcileCartVersions", in)
+	out := new(ReconcileCartVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...client.CallOption) (*CreateCouponResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.CreateCoupon", in)
+	out := new(CreateCouponResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceHandler interface {
@@ -294,6 +545,13 @@ type AdminServiceHandler interface {
 	ForceDeleteCart(context.Context, *ForceDeleteCartRequest, *ForceDeleteCartResponse) error
 	RestoreCart(context.Context, *RestoreCartRequest, *RestoreCartResponse) error
 	ExportCarts(context.Context, *ExportCartsRequest, AdminService_ExportCartsStream) error
+	GetUsersCartValue(context.Context, *GetUsersCartValueRequest, *GetUsersCartValueResponse) error
+	GetConversionStats(context.Context, *GetConversionStatsRequest, *GetConversionStatsResponse) error
+	PurgeDeletedCarts(context.Context, *PurgeDeletedCartsRequest, *PurgeDeletedCartsResponse) error
+	SnapshotCart(context.Context, *SnapshotCartRequest, *SnapshotCartResponse) error
+	GetCartSnapshots(context.Context, *GetCartSnapshotsRequest, *GetCartSnapshotsResponse) error
+	ReconcileCartVersions(context.Context, *ReconcileCartVersionsRequest, *ReconcileCartVersionsResponse) error
+	CreateCoupon(context.Context, *CreateCouponRequest, *CreateCouponResponse) error
 }
 
 func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts ...server.HandlerOption) error {
@@ -302,6 +560,13 @@ func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts
 		ForceDeleteCart(ctx context.Context, in *ForceDeleteCartRequest, out *ForceDeleteCartResponse) error
 		RestoreCart(ctx context.Context, in *RestoreCartRequest, out *RestoreCartResponse) error
 		ExportCarts(ctx context.Context, stream server.Stream) error
+		GetUsersCartValue(ctx context.Context, in *GetUsersCartValueRequest, out *GetUsersCartValueResponse) error
+		GetConversionStats(ctx context.Context, in *GetConversionStatsRequest, out *GetConversionStatsResponse) error
+		PurgeDeletedCarts(ctx context.Context, in *PurgeDeletedCartsRequest, out *PurgeDeletedCartsResponse) error
+		SnapshotCart(ctx context.Context, in *SnapshotCartRequest, out *SnapshotCartResponse) error
+		GetCartSnapshots(ctx context.Context, in *GetCartSnapshotsRequest, out *GetCartSnapshotsResponse) error
+		ReconcileCartVersions(ctx context.Context, in *ReconcileCartVersionsRequest, out *ReconcileCartVersionsResponse) error
+		CreateCoupon(ctx context.Context, in *CreateCouponRequest, out *CreateCouponResponse) error
 	}
 	type AdminService struct {
 		adminService
@@ -365,3 +630,31 @@ func (x *adminServiceExportCartsStream) RecvMsg(m interface{}) error {
 func (x *adminServiceExportCartsStream) Send(m *Cart) error {
 	return x.stream.Send(m)
 }
+
+func (h *adminServiceHandler) GetUsersCartValue(ctx context.Context, in *GetUsersCartValueRequest, out *GetUsersCartValueResponse) error {
+	return h.AdminServiceHandler.GetUsersCartValue(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GetConversionStats(ctx context.Context, in *GetConversionStatsRequest, out *GetConversionStatsResponse) error {
+	return h.AdminServiceHandler.GetConversionStats(ctx, in, out)
+}
+
+func (h *adminServiceHandler) PurgeDeletedCarts(ctx context.Context, in *PurgeDeletedCartsRequest, out *PurgeDeletedCartsResponse) error {
+	return h.AdminServiceHandler.PurgeDeletedCarts(ctx, in, out)
+}
+
+func (h *adminServiceHandler) SnapshotCart(ctx context.Context, in *SnapshotCartRequest, out *SnapshotCartResponse) error {
+	return h.AdminServiceHandler.SnapshotCart(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GetCartSnapshots(ctx context.Context, in *GetCartSnapshotsRequest, out *GetCartSnapshotsResponse) error {
+	return h.AdminServiceHandler.GetCartSnapshots(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ReconcileCartVersions(ctx context.Context, in *ReconcileCartVersionsRequest, out *ReconcileCartVersionsResponse) error {
+	return h.AdminServiceHandler.ReconcileCartVersions(ctx, in, out)
+}
+
+func (h *adminServiceHandler) CreateCoupon(ctx context.Context, in *CreateCouponRequest, out *CreateCouponResponse) error {
+	return h.AdminServiceHandler.CreateCoupon(ctx, in, out)
+}
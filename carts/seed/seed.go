@@ -0,0 +1,102 @@
+// Package seed loads deterministic sample data for tests and local development.
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"carts/ent"
+
+	productseed "products/seed"
+)
+
+// FixtureSet names a predefined collection of fixtures
+type FixtureSet string
+
+const (
+	// Minimal loads a single record of each kind, enough to exercise relationships
+	Minimal FixtureSet = "minimal"
+	// Demo loads the full sample data set for local development
+	Demo FixtureSet = "demo"
+)
+
+// UserIDs are the users seeded by the users service seed (alice, bob, carol)
+var UserIDs = []uuid.UUID{
+	uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+}
+
+// CartItemFixture describes a line of productseed.Products[Product]
+type CartItemFixture struct {
+	Product  int
+	Quantity int
+}
+
+// CartFixture describes a seeded active cart owned by UserIDs[User]
+type CartFixture struct {
+	ID    uuid.UUID
+	User  int
+	Items []CartItemFixture
+}
+
+// Carts are the seeded carts; the first only references the first product so Minimal stays consistent
+var Carts = []CartFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0005-000000000001"), User: 0, Items: []CartItemFixture{{Product: 0, Quantity: 1}}},
+	{ID: uuid.MustParse("00000000-0000-0000-0005-000000000002"), User: 1, Items: []CartItemFixture{{Product: 1, Quantity: 1}, {Product: 2, Quantity: 2}}},
+}
+
+// Load inserts the given fixture set into an empty database in a single transaction
+func Load(ctx context.Context, client *ent.Client, set FixtureSet) error {
+	n, err := set.limit(len(Carts))
+	if err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, f := range Carts[:n] {
+		c, err := tx.Cart.Create().
+			SetID(f.ID).
+			SetUserID(UserIDs[f.User]).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed cart %s: %w", f.ID, err)
+		}
+
+		for _, item := range f.Items {
+			p := productseed.Products[item.Product]
+			_, err := tx.CartItem.Create().
+				SetCartID(c.ID).
+				SetProductID(p.ID).
+				SetProductName(p.Name).
+				SetQuantity(item.Quantity).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to seed item %s for cart %s: %w", p.Name, f.ID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seed data: %w", err)
+	}
+	return nil
+}
+
+// limit returns how many of n fixtures the set includes
+func (s FixtureSet) limit(n int) (int, error) {
+	switch s {
+	case Minimal:
+		return min(1, n), nil
+	case Demo:
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown fixture set: %q", s)
+}
@@ -0,0 +1,95 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"carts/ent"
+	"carts/ent/enttest"
+
+	productseed "products/seed"
+)
+
+// load opens a fresh in-memory database and loads set into it
+func load(t *testing.T, set FixtureSet) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := Load(context.Background(), client, set); err != nil {
+		t.Fatalf("Load(%s): %v", set, err)
+	}
+	return client
+}
+
+// snapshot describes every seeded row, ignoring timestamps and generated item IDs
+func snapshot(client *ent.Client) []string {
+	var rows []string
+	for _, c := range client.Cart.Query().WithCartItems().AllX(context.Background()) {
+		rows = append(rows, fmt.Sprintf("cart %s %s", c.ID, c.UserID))
+		for _, item := range c.Edges.CartItems {
+			rows = append(rows, fmt.Sprintf("item %s %s %s %d", c.ID, item.ProductID, item.ProductName, item.Quantity))
+		}
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+func TestLoadSeedsCartsWithItems(t *testing.T) {
+	ctx := context.Background()
+	for set, want := range map[FixtureSet]int{Minimal: 1, Demo: len(Carts)} {
+		client := load(t, set)
+		carts := client.Cart.Query().WithCartItems().AllX(ctx)
+		if len(carts) != want {
+			t.Fatalf("%s: expected %d carts, got %d", set, want, len(carts))
+		}
+
+		var items int
+		for _, f := range Carts[:want] {
+			items += len(f.Items)
+		}
+		if n := client.CartItem.Query().CountX(ctx); n != items {
+			t.Errorf("%s: expected %d cart items, got %d", set, items, n)
+		}
+
+		for _, c := range carts {
+			i := slices.IndexFunc(Carts, func(f CartFixture) bool { return f.ID == c.ID })
+			if i < 0 {
+				t.Fatalf("%s: unexpected cart %s", set, c.ID)
+			}
+			f := Carts[i]
+			if c.UserID != UserIDs[f.User] || len(c.Edges.CartItems) != len(f.Items) {
+				t.Errorf("%s: cart %s doesn't match its fixture", set, c.ID)
+			}
+			// Items reference the products seed
+			for _, item := range c.Edges.CartItems {
+				if !slices.ContainsFunc(productseed.Products, func(p productseed.ProductFixture) bool { return p.ID == item.ProductID }) {
+					t.Errorf("%s: cart %s has an item outside the products seed", set, c.ID)
+				}
+			}
+		}
+	}
+}
+
+func TestLoadIsDeterministic(t *testing.T) {
+	first, second := snapshot(load(t, Demo)), snapshot(load(t, Demo))
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected identical data from two loads, got\n%v\n%v", first, second)
+	}
+
+	// Loading into a seeded database fails as a whole rather than duplicating
+	client := load(t, Minimal)
+	if err := Load(context.Background(), client, Demo); err == nil {
+		t.Fatal("expected loading into a seeded database to fail")
+	}
+	if n := client.Cart.Query().CountX(context.Background()); n != 1 {
+		t.Fatalf("expected the failed load rolled back, found %d carts", n)
+	}
+	if err := Load(context.Background(), client, "huge"); err == nil {
+		t.Fatal("expected an unknown fixture set to be rejected")
+	}
+}
@@ -0,0 +1,27 @@
+// Package currency holds the ISO 4217 currencies orders can be priced in, shared by the
+// handlers and the ent schema so both accept the same set
+package currency
+
+import "fmt"
+
+// Default is used for orders whose items don't name a currency
+const Default = "USD"
+
+// codes are the accepted ISO 4217 codes; all have two minor digits, matching the cents representation
+var codes = map[string]bool{
+	"AUD": true, "CAD": true, "CHF": true, "CNY": true, "EUR": true, "GBP": true,
+	"INR": true, "KES": true, "NGN": true, "TZS": true, "USD": true, "ZAR": true,
+}
+
+// Supported reports whether code is an accepted currency; codes are upper-case
+func Supported(code string) bool {
+	return codes[code]
+}
+
+// Validate rejects a code that isn't an accepted currency, for use as an ent field validator
+func Validate(code string) error {
+	if !Supported(code) {
+		return fmt.Errorf("unsupported currency: %q", code)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestOpenDB(t *testing.T) {
+	tests := []struct {
+		driver, dsn string
+		wantErr     string
+	}{
+		{"mysql", "", "unsupported DB_DRIVER"},
+		{"postgres", "", "DB_DSN is required"},
+	}
+	for _, tt := range tests {
+		t.Setenv("DB_DRIVER", tt.driver)
+		t.Setenv("DB_DSN", tt.dsn)
+		if _, _, _, err := openDB(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("openDB with %q: expected %q, got %v", tt.driver, tt.wantErr, err)
+		}
+	}
+
+	// Without configuration the service runs on in-memory SQLite
+	t.Setenv("DB_DRIVER", "")
+	t.Setenv("DB_DSN", "")
+	client, db, driver, err := openDB()
+	if err != nil {
+		t.Fatalf("openDB: %v", err)
+	}
+	defer client.Close()
+	if driver != "sqlite3" {
+		t.Fatalf("expected the sqlite3 driver by default, got %s", driver)
+	}
+	if err := db.PingContext(context.Background()); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("migrating the default database: %v", err)
+	}
+}
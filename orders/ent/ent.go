@@ -7,7 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"orders/ent/order"
+	"orders/ent/orderevent"
 	"orders/ent/orderitem"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+	"orders/ent/subscription"
 	"reflect"
 	"sync"
 
@@ -74,8 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			order.Table:     order.ValidColumn,
-			orderitem.Table: orderitem.ValidColumn,
+			order.Table:        order.ValidColumn,
+			orderevent.Table:   orderevent.ValidColumn,
+			orderitem.Table:    orderitem.ValidColumn,
+			shipment.Table:     shipment.ValidColumn,
+			shipmentitem.Table: shipmentitem.ValidColumn,
+			subscription.Table: subscription.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
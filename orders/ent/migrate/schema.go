@@ -12,27 +12,72 @@ var (
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
-		{Name: "total_amount", Type: field.TypeFloat64},
+		{Name: "total_amount_cents", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}, Default: "pending"},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fraud_hold", Type: field.TypeBool, Default: false},
+		{Name: "hold_reason", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "discount_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "coupon_code", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_recipient", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_line1", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_line2", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_city", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_region", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_postal_code", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_country", Type: field.TypeString, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
 		Name:       "orders",
 		Columns:    OrdersColumns,
 		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_user_id_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{OrdersColumns[1], OrdersColumns[5]},
+			},
+		},
+	}
+	// OrderEventsColumns holds the columns for the "order_events" table.
+	OrderEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_events", Type: field.TypeUUID},
+	}
+	// OrderEventsTable holds the schema information for the "order_events" table.
+	OrderEventsTable = &schema.Table{
+		Name:       "order_events",
+		Columns:    OrderEventsColumns,
+		PrimaryKey: []*schema.Column{OrderEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_events_orders_events",
+				Columns:    []*schema.Column{OrderEventsColumns[4]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// OrderItemsColumns holds the columns for the "order_items" table.
 	OrderItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "product_name", Type: field.TypeString, Nullable: true},
 		{Name: "quantity", Type: field.TypeInt},
-		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "unit_price_cents", Type: field.TypeInt64},
+		{Name: "position", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "order_order_items", Type: field.TypeUUID, Nullable: true},
-		{Name: "order_item_order", Type: field.TypeUUID},
+		{Name: "order_order_items", Type: field.TypeUUID},
 	}
 	// OrderItemsTable holds the schema information for the "order_items" table.
 	OrderItemsTable = &schema.Table{
@@ -42,26 +87,107 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_items_orders_order_items",
-				Columns:    []*schema.Column{OrderItemsColumns[6]},
+				Columns:    []*schema.Column{OrderItemsColumns[8]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
+		},
+	}
+	// ShipmentsColumns holds the columns for the "shipments" table.
+	ShipmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "carrier", Type: field.TypeString},
+		{Name: "tracking_number", Type: field.TypeString},
+		{Name: "shipped_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_shipments", Type: field.TypeUUID},
+	}
+	// ShipmentsTable holds the schema information for the "shipments" table.
+	ShipmentsTable = &schema.Table{
+		Name:       "shipments",
+		Columns:    ShipmentsColumns,
+		PrimaryKey: []*schema.Column{ShipmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "order_items_orders_order",
-				Columns:    []*schema.Column{OrderItemsColumns[7]},
+				Symbol:     "shipments_orders_shipments",
+				Columns:    []*schema.Column{ShipmentsColumns[7]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ShipmentItemsColumns holds the columns for the "shipment_items" table.
+	ShipmentItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "order_item_shipment_items", Type: field.TypeUUID},
+		{Name: "shipment_shipment_items", Type: field.TypeUUID},
+	}
+	// ShipmentItemsTable holds the schema information for the "shipment_items" table.
+	ShipmentItemsTable = &schema.Table{
+		Name:       "shipment_items",
+		Columns:    ShipmentItemsColumns,
+		PrimaryKey: []*schema.Column{ShipmentItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shipment_items_order_items_shipment_items",
+				Columns:    []*schema.Column{ShipmentItemsColumns[2]},
+				RefColumns: []*schema.Column{OrderItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "shipment_items_shipments_shipment_items",
+				Columns:    []*schema.Column{ShipmentItemsColumns[3]},
+				RefColumns: []*schema.Column{ShipmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "items", Type: field.TypeJSON},
+		{Name: "interval", Type: field.TypeEnum, Enums: []string{"daily", "weekly", "monthly"}},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure", Type: field.TypeString, Nullable: true},
+		{Name: "last_failed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_active_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[5], SubscriptionsColumns[4]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		OrdersTable,
+		OrderEventsTable,
 		OrderItemsTable,
+		ShipmentsTable,
+		ShipmentItemsTable,
+		SubscriptionsTable,
 	}
 )
 
 func init() {
+	OrderEventsTable.ForeignKeys[0].RefTable = OrdersTable
 	OrderItemsTable.ForeignKeys[0].RefTable = OrdersTable
-	OrderItemsTable.ForeignKeys[1].RefTable = OrdersTable
+	ShipmentsTable.ForeignKeys[0].RefTable = OrdersTable
+	ShipmentItemsTable.ForeignKeys[0].RefTable = OrderItemsTable
+	ShipmentItemsTable.ForeignKeys[1].RefTable = ShipmentsTable
 }
@@ -20,14 +20,44 @@ type Order struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Reference to the user who placed the order
 	UserID uuid.UUID `json:"user_id,omitempty"`
-	// TotalAmount holds the value of the "total_amount" field.
-	TotalAmount float64 `json:"total_amount,omitempty"`
+	// Total in minor units (cents) so sums are exact
+	TotalAmountCents int64 `json:"total_amount_cents,omitempty"`
+	// ISO 4217 currency code shared by all items
+	Currency string `json:"currency,omitempty"`
 	// Status holds the value of the "status" field.
 	Status order.Status `json:"status,omitempty"`
+	// Client-supplied key deduplicating retried creates, unique per user
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Soft delete timestamp set by ForceDeleteOrder, cleared by RestoreOrder
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Set by PlaceFraudHold; a held order cannot progress beyond pending
+	FraudHold bool `json:"fraud_hold,omitempty"`
+	// HoldReason holds the value of the "hold_reason" field.
+	HoldReason *string `json:"hold_reason,omitempty"`
+	// Region of the service that created the order; unset outside multi-region deployments
+	Region *string `json:"region,omitempty"`
+	// Coupon discount already taken off total_amount_cents
+	DiscountCents int64 `json:"discount_cents,omitempty"`
+	// CouponCode holds the value of the "coupon_code" field.
+	CouponCode *string `json:"coupon_code,omitempty"`
+	// ShippingRecipient holds the value of the "shipping_recipient" field.
+	ShippingRecipient *string `json:"shipping_recipient,omitempty"`
+	// ShippingLine1 holds the value of the "shipping_line1" field.
+	ShippingLine1 *string `json:"shipping_line1,omitempty"`
+	// ShippingLine2 holds the value of the "shipping_line2" field.
+	ShippingLine2 *string `json:"shipping_line2,omitempty"`
+	// ShippingCity holds the value of the "shipping_city" field.
+	ShippingCity *string `json:"shipping_city,omitempty"`
+	// State, province or county of the shipping address
+	ShippingRegion *string `json:"shipping_region,omitempty"`
+	// ShippingPostalCode holds the value of the "shipping_postal_code" field.
+	ShippingPostalCode *string `json:"shipping_postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 country code of the shipping address
+	ShippingCountry *string `json:"shipping_country,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
 	Edges        OrderEdges `json:"edges"`
@@ -38,9 +68,13 @@ type Order struct {
 type OrderEdges struct {
 	// OrderItems holds the value of the order_items edge.
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// Shipments holds the value of the shipments edge.
+	Shipments []*Shipment `json:"shipments,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*OrderEvent `json:"events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // OrderItemsOrErr returns the OrderItems value or an error if the edge
@@ -52,16 +86,36 @@ func (e OrderEdges) OrderItemsOrErr() ([]*OrderItem, error) {
 	return nil, &NotLoadedError{edge: "order_items"}
 }
 
+// ShipmentsOrErr returns the Shipments value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) ShipmentsOrErr() ([]*Shipment, error) {
+	if e.loadedTypes[1] {
+		return e.Shipments, nil
+	}
+	return nil, &NotLoadedError{edge: "shipments"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) EventsOrErr() ([]*OrderEvent, error) {
+	if e.loadedTypes[2] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldTotalAmount:
-			values[i] = new(sql.NullFloat64)
-		case order.FieldStatus:
+		case order.FieldFraudHold:
+			values[i] = new(sql.NullBool)
+		case order.FieldTotalAmountCents, order.FieldDiscountCents:
+			values[i] = new(sql.NullInt64)
+		case order.FieldCurrency, order.FieldStatus, order.FieldIdempotencyKey, order.FieldHoldReason, order.FieldRegion, order.FieldCouponCode, order.FieldShippingRecipient, order.FieldShippingLine1, order.FieldShippingLine2, order.FieldShippingCity, order.FieldShippingRegion, order.FieldShippingPostalCode, order.FieldShippingCountry:
 			values[i] = new(sql.NullString)
-		case order.FieldCreatedAt, order.FieldUpdatedAt:
+		case order.FieldCreatedAt, order.FieldUpdatedAt, order.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case order.FieldID, order.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -92,11 +146,17 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				o.UserID = *value
 			}
-		case order.FieldTotalAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+		case order.FieldTotalAmountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount_cents", values[i])
 			} else if value.Valid {
-				o.TotalAmount = value.Float64
+				o.TotalAmountCents = value.Int64
+			}
+		case order.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				o.Currency = value.String
 			}
 		case order.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -104,6 +164,13 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.Status = order.Status(value.String)
 			}
+		case order.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				o.IdempotencyKey = new(string)
+				*o.IdempotencyKey = value.String
+			}
 		case order.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -116,6 +183,95 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.UpdatedAt = value.Time
 			}
+		case order.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				o.DeletedAt = new(time.Time)
+				*o.DeletedAt = value.Time
+			}
+		case order.FieldFraudHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fraud_hold", values[i])
+			} else if value.Valid {
+				o.FraudHold = value.Bool
+			}
+		case order.FieldHoldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_reason", values[i])
+			} else if value.Valid {
+				o.HoldReason = new(string)
+				*o.HoldReason = value.String
+			}
+		case order.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				o.Region = new(string)
+				*o.Region = value.String
+			}
+		case order.FieldDiscountCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_cents", values[i])
+			} else if value.Valid {
+				o.DiscountCents = value.Int64
+			}
+		case order.FieldCouponCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_code", values[i])
+			} else if value.Valid {
+				o.CouponCode = new(string)
+				*o.CouponCode = value.String
+			}
+		case order.FieldShippingRecipient:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_recipient", values[i])
+			} else if value.Valid {
+				o.ShippingRecipient = new(string)
+				*o.ShippingRecipient = value.String
+			}
+		case order.FieldShippingLine1:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_line1", values[i])
+			} else if value.Valid {
+				o.ShippingLine1 = new(string)
+				*o.ShippingLine1 = value.String
+			}
+		case order.FieldShippingLine2:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_line2", values[i])
+			} else if value.Valid {
+				o.ShippingLine2 = new(string)
+				*o.ShippingLine2 = value.String
+			}
+		case order.FieldShippingCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_city", values[i])
+			} else if value.Valid {
+				o.ShippingCity = new(string)
+				*o.ShippingCity = value.String
+			}
+		case order.FieldShippingRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_region", values[i])
+			} else if value.Valid {
+				o.ShippingRegion = new(string)
+				*o.ShippingRegion = value.String
+			}
+		case order.FieldShippingPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_postal_code", values[i])
+			} else if value.Valid {
+				o.ShippingPostalCode = new(string)
+				*o.ShippingPostalCode = value.String
+			}
+		case order.FieldShippingCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_country", values[i])
+			} else if value.Valid {
+				o.ShippingCountry = new(string)
+				*o.ShippingCountry = value.String
+			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -134,6 +290,16 @@ func (o *Order) QueryOrderItems() *OrderItemQuery {
 	return NewOrderClient(o.config).QueryOrderItems(o)
 }
 
+// QueryShipments queries the "shipments" edge of the Order entity.
+func (o *Order) QueryShipments() *ShipmentQuery {
+	return NewOrderClient(o.config).QueryShipments(o)
+}
+
+// QueryEvents queries the "events" edge of the Order entity.
+func (o *Order) QueryEvents() *OrderEventQuery {
+	return NewOrderClient(o.config).QueryEvents(o)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -160,17 +326,86 @@ func (o *Order) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", o.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("total_amount=")
-	builder.WriteString(fmt.Sprintf("%v", o.TotalAmount))
+	builder.WriteString("total_amount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", o.TotalAmountCents))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(o.Currency)
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", o.Status))
 	builder.WriteString(", ")
+	if v := o.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(o.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := o.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fraud_hold=")
+	builder.WriteString(fmt.Sprintf("%v", o.FraudHold))
+	builder.WriteString(", ")
+	if v := o.HoldReason; v != nil {
+		builder.WriteString("hold_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.Region; v != nil {
+		builder.WriteString("region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_cents=")
+	builder.WriteString(fmt.Sprintf("%v", o.DiscountCents))
+	builder.WriteString(", ")
+	if v := o.CouponCode; v != nil {
+		builder.WriteString("coupon_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingRecipient; v != nil {
+		builder.WriteString("shipping_recipient=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingLine1; v != nil {
+		builder.WriteString("shipping_line1=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingLine2; v != nil {
+		builder.WriteString("shipping_line2=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingCity; v != nil {
+		builder.WriteString("shipping_city=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingRegion; v != nil {
+		builder.WriteString("shipping_region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingPostalCode; v != nil {
+		builder.WriteString("shipping_postal_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := o.ShippingCountry; v != nil {
+		builder.WriteString("shipping_country=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
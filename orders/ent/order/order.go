@@ -18,16 +18,50 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
-	// FieldTotalAmount holds the string denoting the total_amount field in the database.
-	FieldTotalAmount = "total_amount"
+	// FieldTotalAmountCents holds the string denoting the total_amount_cents field in the database.
+	FieldTotalAmountCents = "total_amount_cents"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFraudHold holds the string denoting the fraud_hold field in the database.
+	FieldFraudHold = "fraud_hold"
+	// FieldHoldReason holds the string denoting the hold_reason field in the database.
+	FieldHoldReason = "hold_reason"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldDiscountCents holds the string denoting the discount_cents field in the database.
+	FieldDiscountCents = "discount_cents"
+	// FieldCouponCode holds the string denoting the coupon_code field in the database.
+	FieldCouponCode = "coupon_code"
+	// FieldShippingRecipient holds the string denoting the shipping_recipient field in the database.
+	FieldShippingRecipient = "shipping_recipient"
+	// FieldShippingLine1 holds the string denoting the shipping_line1 field in the database.
+	FieldShippingLine1 = "shipping_line1"
+	// FieldShippingLine2 holds the string denoting the shipping_line2 field in the database.
+	FieldShippingLine2 = "shipping_line2"
+	// FieldShippingCity holds the string denoting the shipping_city field in the database.
+	FieldShippingCity = "shipping_city"
+	// FieldShippingRegion holds the string denoting the shipping_region field in the database.
+	FieldShippingRegion = "shipping_region"
+	// FieldShippingPostalCode holds the string denoting the shipping_postal_code field in the database.
+	FieldShippingPostalCode = "shipping_postal_code"
+	// FieldShippingCountry holds the string denoting the shipping_country field in the database.
+	FieldShippingCountry = "shipping_country"
 	// EdgeOrderItems holds the string denoting the order_items edge name in mutations.
 	EdgeOrderItems = "order_items"
+	// EdgeShipments holds the string denoting the shipments edge name in mutations.
+	EdgeShipments = "shipments"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 	// OrderItemsTable is the table that holds the order_items relation/edge.
@@ -37,16 +71,45 @@ const (
 	OrderItemsInverseTable = "order_items"
 	// OrderItemsColumn is the table column denoting the order_items relation/edge.
 	OrderItemsColumn = "order_order_items"
+	// ShipmentsTable is the table that holds the shipments relation/edge.
+	ShipmentsTable = "shipments"
+	// ShipmentsInverseTable is the table name for the Shipment entity.
+	// It exists in this package in order to avoid circular dependency with the "shipment" package.
+	ShipmentsInverseTable = "shipments"
+	// ShipmentsColumn is the table column denoting the shipments relation/edge.
+	ShipmentsColumn = "order_shipments"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "order_events"
+	// EventsInverseTable is the table name for the OrderEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderevent" package.
+	EventsInverseTable = "order_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "order_events"
 )
 
 // Columns holds all SQL columns for order fields.
 var Columns = []string{
 	FieldID,
 	FieldUserID,
-	FieldTotalAmount,
+	FieldTotalAmountCents,
+	FieldCurrency,
 	FieldStatus,
+	FieldIdempotencyKey,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFraudHold,
+	FieldHoldReason,
+	FieldRegion,
+	FieldDiscountCents,
+	FieldCouponCode,
+	FieldShippingRecipient,
+	FieldShippingLine1,
+	FieldShippingLine2,
+	FieldShippingCity,
+	FieldShippingRegion,
+	FieldShippingPostalCode,
+	FieldShippingCountry,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -60,14 +123,24 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
-	TotalAmountValidator func(float64) error
+	// TotalAmountCentsValidator is a validator for the "total_amount_cents" field. It is called by the builders before save.
+	TotalAmountCentsValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultFraudHold holds the default value on creation for the "fraud_hold" field.
+	DefaultFraudHold bool
+	// DefaultDiscountCents holds the default value on creation for the "discount_cents" field.
+	DefaultDiscountCents int64
+	// DiscountCentsValidator is a validator for the "discount_cents" field. It is called by the builders before save.
+	DiscountCentsValidator func(int64) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -114,9 +187,14 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
-// ByTotalAmount orders the results by the total_amount field.
-func ByTotalAmount(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldTotalAmount, opts...).ToFunc()
+// ByTotalAmountCents orders the results by the total_amount_cents field.
+func ByTotalAmountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAmountCents, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
 // ByStatus orders the results by the status field.
@@ -124,6 +202,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -134,6 +217,71 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFraudHold orders the results by the fraud_hold field.
+func ByFraudHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFraudHold, opts...).ToFunc()
+}
+
+// ByHoldReason orders the results by the hold_reason field.
+func ByHoldReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldReason, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByDiscountCents orders the results by the discount_cents field.
+func ByDiscountCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountCents, opts...).ToFunc()
+}
+
+// ByCouponCode orders the results by the coupon_code field.
+func ByCouponCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponCode, opts...).ToFunc()
+}
+
+// ByShippingRecipient orders the results by the shipping_recipient field.
+func ByShippingRecipient(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingRecipient, opts...).ToFunc()
+}
+
+// ByShippingLine1 orders the results by the shipping_line1 field.
+func ByShippingLine1(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingLine1, opts...).ToFunc()
+}
+
+// ByShippingLine2 orders the results by the shipping_line2 field.
+func ByShippingLine2(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingLine2, opts...).ToFunc()
+}
+
+// ByShippingCity orders the results by the shipping_city field.
+func ByShippingCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingCity, opts...).ToFunc()
+}
+
+// ByShippingRegion orders the results by the shipping_region field.
+func ByShippingRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingRegion, opts...).ToFunc()
+}
+
+// ByShippingPostalCode orders the results by the shipping_postal_code field.
+func ByShippingPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingPostalCode, opts...).ToFunc()
+}
+
+// ByShippingCountry orders the results by the shipping_country field.
+func ByShippingCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingCountry, opts...).ToFunc()
+}
+
 // ByOrderItemsCount orders the results by order_items count.
 func ByOrderItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -147,6 +295,34 @@ func ByOrderItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByShipmentsCount orders the results by shipments count.
+func ByShipmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShipmentsStep(), opts...)
+	}
+}
+
+// ByShipments orders the results by shipments terms.
+func ByShipments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShipmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrderItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -154,3 +330,17 @@ func newOrderItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
 	)
 }
+func newShipmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShipmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShipmentsTable, ShipmentsColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
@@ -61,9 +61,19 @@ func UserID(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUserID, v))
 }
 
-// TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
-func TotalAmount(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldTotalAmount, v))
+// TotalAmountCents applies equality check predicate on the "total_amount_cents" field. It's identical to TotalAmountCentsEQ.
+func TotalAmountCents(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTotalAmountCents, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldIdempotencyKey, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -76,6 +86,71 @@ func UpdatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FraudHold applies equality check predicate on the "fraud_hold" field. It's identical to FraudHoldEQ.
+func FraudHold(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFraudHold, v))
+}
+
+// HoldReason applies equality check predicate on the "hold_reason" field. It's identical to HoldReasonEQ.
+func HoldReason(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldHoldReason, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRegion, v))
+}
+
+// DiscountCents applies equality check predicate on the "discount_cents" field. It's identical to DiscountCentsEQ.
+func DiscountCents(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountCents, v))
+}
+
+// CouponCode applies equality check predicate on the "coupon_code" field. It's identical to CouponCodeEQ.
+func CouponCode(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCouponCode, v))
+}
+
+// ShippingRecipient applies equality check predicate on the "shipping_recipient" field. It's identical to ShippingRecipientEQ.
+func ShippingRecipient(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingRecipient, v))
+}
+
+// ShippingLine1 applies equality check predicate on the "shipping_line1" field. It's identical to ShippingLine1EQ.
+func ShippingLine1(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingLine1, v))
+}
+
+// ShippingLine2 applies equality check predicate on the "shipping_line2" field. It's identical to ShippingLine2EQ.
+func ShippingLine2(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingLine2, v))
+}
+
+// ShippingCity applies equality check predicate on the "shipping_city" field. It's identical to ShippingCityEQ.
+func ShippingCity(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingCity, v))
+}
+
+// ShippingRegion applies equality check predicate on the "shipping_region" field. It's identical to ShippingRegionEQ.
+func ShippingRegion(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingRegion, v))
+}
+
+// ShippingPostalCode applies equality check predicate on the "shipping_postal_code" field. It's identical to ShippingPostalCodeEQ.
+func ShippingPostalCode(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingPostalCode, v))
+}
+
+// ShippingCountry applies equality check predicate on the "shipping_country" field. It's identical to ShippingCountryEQ.
+func ShippingCountry(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingCountry, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUserID, v))
@@ -116,44 +191,109 @@ func UserIDLTE(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldUserID, v))
 }
 
-// TotalAmountEQ applies the EQ predicate on the "total_amount" field.
-func TotalAmountEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldTotalAmount, v))
+// TotalAmountCentsEQ applies the EQ predicate on the "total_amount_cents" field.
+func TotalAmountCentsEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTotalAmountCents, v))
+}
+
+// TotalAmountCentsNEQ applies the NEQ predicate on the "total_amount_cents" field.
+func TotalAmountCentsNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTotalAmountCents, v))
+}
+
+// TotalAmountCentsIn applies the In predicate on the "total_amount_cents" field.
+func TotalAmountCentsIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTotalAmountCents, vs...))
+}
+
+// TotalAmountCentsNotIn applies the NotIn predicate on the "total_amount_cents" field.
+func TotalAmountCentsNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTotalAmountCents, vs...))
+}
+
+// TotalAmountCentsGT applies the GT predicate on the "total_amount_cents" field.
+func TotalAmountCentsGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldTotalAmountCents, v))
+}
+
+// TotalAmountCentsGTE applies the GTE predicate on the "total_amount_cents" field.
+func TotalAmountCentsGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldTotalAmountCents, v))
+}
+
+// TotalAmountCentsLT applies the LT predicate on the "total_amount_cents" field.
+func TotalAmountCentsLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldTotalAmountCents, v))
+}
+
+// TotalAmountCentsLTE applies the LTE predicate on the "total_amount_cents" field.
+func TotalAmountCentsLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldTotalAmountCents, v))
 }
 
-// TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
-func TotalAmountNEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldNEQ(FieldTotalAmount, v))
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
 }
 
-// TotalAmountIn applies the In predicate on the "total_amount" field.
-func TotalAmountIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldIn(FieldTotalAmount, vs...))
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCurrency, v))
 }
 
-// TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
-func TotalAmountNotIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldNotIn(FieldTotalAmount, vs...))
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCurrency, vs...))
 }
 
-// TotalAmountGT applies the GT predicate on the "total_amount" field.
-func TotalAmountGT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGT(FieldTotalAmount, v))
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCurrency, vs...))
 }
 
-// TotalAmountGTE applies the GTE predicate on the "total_amount" field.
-func TotalAmountGTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGTE(FieldTotalAmount, v))
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCurrency, v))
 }
 
-// TotalAmountLT applies the LT predicate on the "total_amount" field.
-func TotalAmountLT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLT(FieldTotalAmount, v))
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCurrency, v))
 }
 
-// TotalAmountLTE applies the LTE predicate on the "total_amount" field.
-func TotalAmountLTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLTE(FieldTotalAmount, v))
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldCurrency, v))
 }
 
 // StatusEQ applies the EQ predicate on the "status" field.
@@ -176,6 +316,81 @@ func StatusNotIn(vs ...Status) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -256,6 +471,856 @@ func UpdatedAtLTE(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FraudHoldEQ applies the EQ predicate on the "fraud_hold" field.
+func FraudHoldEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFraudHold, v))
+}
+
+// FraudHoldNEQ applies the NEQ predicate on the "fraud_hold" field.
+func FraudHoldNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldFraudHold, v))
+}
+
+// HoldReasonEQ applies the EQ predicate on the "hold_reason" field.
+func HoldReasonEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldHoldReason, v))
+}
+
+// HoldReasonNEQ applies the NEQ predicate on the "hold_reason" field.
+func HoldReasonNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldHoldReason, v))
+}
+
+// HoldReasonIn applies the In predicate on the "hold_reason" field.
+func HoldReasonIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldHoldReason, vs...))
+}
+
+// HoldReasonNotIn applies the NotIn predicate on the "hold_reason" field.
+func HoldReasonNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldHoldReason, vs...))
+}
+
+// HoldReasonGT applies the GT predicate on the "hold_reason" field.
+func HoldReasonGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldHoldReason, v))
+}
+
+// HoldReasonGTE applies the GTE predicate on the "hold_reason" field.
+func HoldReasonGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldHoldReason, v))
+}
+
+// HoldReasonLT applies the LT predicate on the "hold_reason" field.
+func HoldReasonLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldHoldReason, v))
+}
+
+// HoldReasonLTE applies the LTE predicate on the "hold_reason" field.
+func HoldReasonLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldHoldReason, v))
+}
+
+// HoldReasonContains applies the Contains predicate on the "hold_reason" field.
+func HoldReasonContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldHoldReason, v))
+}
+
+// HoldReasonHasPrefix applies the HasPrefix predicate on the "hold_reason" field.
+func HoldReasonHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldHoldReason, v))
+}
+
+// HoldReasonHasSuffix applies the HasSuffix predicate on the "hold_reason" field.
+func HoldReasonHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldHoldReason, v))
+}
+
+// HoldReasonIsNil applies the IsNil predicate on the "hold_reason" field.
+func HoldReasonIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldHoldReason))
+}
+
+// HoldReasonNotNil applies the NotNil predicate on the "hold_reason" field.
+func HoldReasonNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldHoldReason))
+}
+
+// HoldReasonEqualFold applies the EqualFold predicate on the "hold_reason" field.
+func HoldReasonEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldHoldReason, v))
+}
+
+// HoldReasonContainsFold applies the ContainsFold predicate on the "hold_reason" field.
+func HoldReasonContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldHoldReason, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// DiscountCentsEQ applies the EQ predicate on the "discount_cents" field.
+func DiscountCentsEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountCents, v))
+}
+
+// DiscountCentsNEQ applies the NEQ predicate on the "discount_cents" field.
+func DiscountCentsNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDiscountCents, v))
+}
+
+// DiscountCentsIn applies the In predicate on the "discount_cents" field.
+func DiscountCentsIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDiscountCents, vs...))
+}
+
+// DiscountCentsNotIn applies the NotIn predicate on the "discount_cents" field.
+func DiscountCentsNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDiscountCents, vs...))
+}
+
+// DiscountCentsGT applies the GT predicate on the "discount_cents" field.
+func DiscountCentsGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDiscountCents, v))
+}
+
+// DiscountCentsGTE applies the GTE predicate on the "discount_cents" field.
+func DiscountCentsGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDiscountCents, v))
+}
+
+// DiscountCentsLT applies the LT predicate on the "discount_cents" field.
+func DiscountCentsLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDiscountCents, v))
+}
+
+// DiscountCentsLTE applies the LTE predicate on the "discount_cents" field.
+func DiscountCentsLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDiscountCents, v))
+}
+
+// CouponCodeEQ applies the EQ predicate on the "coupon_code" field.
+func CouponCodeEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCouponCode, v))
+}
+
+// CouponCodeNEQ applies the NEQ predicate on the "coupon_code" field.
+func CouponCodeNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCouponCode, v))
+}
+
+// CouponCodeIn applies the In predicate on the "coupon_code" field.
+func CouponCodeIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCouponCode, vs...))
+}
+
+// CouponCodeNotIn applies the NotIn predicate on the "coupon_code" field.
+func CouponCodeNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCouponCode, vs...))
+}
+
+// CouponCodeGT applies the GT predicate on the "coupon_code" field.
+func CouponCodeGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCouponCode, v))
+}
+
+// CouponCodeGTE applies the GTE predicate on the "coupon_code" field.
+func CouponCodeGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCouponCode, v))
+}
+
+// CouponCodeLT applies the LT predicate on the "coupon_code" field.
+func CouponCodeLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCouponCode, v))
+}
+
+// CouponCodeLTE applies the LTE predicate on the "coupon_code" field.
+func CouponCodeLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCouponCode, v))
+}
+
+// CouponCodeContains applies the Contains predicate on the "coupon_code" field.
+func CouponCodeContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldCouponCode, v))
+}
+
+// CouponCodeHasPrefix applies the HasPrefix predicate on the "coupon_code" field.
+func CouponCodeHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldCouponCode, v))
+}
+
+// CouponCodeHasSuffix applies the HasSuffix predicate on the "coupon_code" field.
+func CouponCodeHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldCouponCode, v))
+}
+
+// CouponCodeIsNil applies the IsNil predicate on the "coupon_code" field.
+func CouponCodeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldCouponCode))
+}
+
+// CouponCodeNotNil applies the NotNil predicate on the "coupon_code" field.
+func CouponCodeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldCouponCode))
+}
+
+// CouponCodeEqualFold applies the EqualFold predicate on the "coupon_code" field.
+func CouponCodeEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldCouponCode, v))
+}
+
+// CouponCodeContainsFold applies the ContainsFold predicate on the "coupon_code" field.
+func CouponCodeContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldCouponCode, v))
+}
+
+// ShippingRecipientEQ applies the EQ predicate on the "shipping_recipient" field.
+func ShippingRecipientEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientNEQ applies the NEQ predicate on the "shipping_recipient" field.
+func ShippingRecipientNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientIn applies the In predicate on the "shipping_recipient" field.
+func ShippingRecipientIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingRecipient, vs...))
+}
+
+// ShippingRecipientNotIn applies the NotIn predicate on the "shipping_recipient" field.
+func ShippingRecipientNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingRecipient, vs...))
+}
+
+// ShippingRecipientGT applies the GT predicate on the "shipping_recipient" field.
+func ShippingRecipientGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientGTE applies the GTE predicate on the "shipping_recipient" field.
+func ShippingRecipientGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientLT applies the LT predicate on the "shipping_recipient" field.
+func ShippingRecipientLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientLTE applies the LTE predicate on the "shipping_recipient" field.
+func ShippingRecipientLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientContains applies the Contains predicate on the "shipping_recipient" field.
+func ShippingRecipientContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientHasPrefix applies the HasPrefix predicate on the "shipping_recipient" field.
+func ShippingRecipientHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientHasSuffix applies the HasSuffix predicate on the "shipping_recipient" field.
+func ShippingRecipientHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientIsNil applies the IsNil predicate on the "shipping_recipient" field.
+func ShippingRecipientIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingRecipient))
+}
+
+// ShippingRecipientNotNil applies the NotNil predicate on the "shipping_recipient" field.
+func ShippingRecipientNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingRecipient))
+}
+
+// ShippingRecipientEqualFold applies the EqualFold predicate on the "shipping_recipient" field.
+func ShippingRecipientEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingRecipient, v))
+}
+
+// ShippingRecipientContainsFold applies the ContainsFold predicate on the "shipping_recipient" field.
+func ShippingRecipientContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingRecipient, v))
+}
+
+// ShippingLine1EQ applies the EQ predicate on the "shipping_line1" field.
+func ShippingLine1EQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingLine1, v))
+}
+
+// ShippingLine1NEQ applies the NEQ predicate on the "shipping_line1" field.
+func ShippingLine1NEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingLine1, v))
+}
+
+// ShippingLine1In applies the In predicate on the "shipping_line1" field.
+func ShippingLine1In(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingLine1, vs...))
+}
+
+// ShippingLine1NotIn applies the NotIn predicate on the "shipping_line1" field.
+func ShippingLine1NotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingLine1, vs...))
+}
+
+// ShippingLine1GT applies the GT predicate on the "shipping_line1" field.
+func ShippingLine1GT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingLine1, v))
+}
+
+// ShippingLine1GTE applies the GTE predicate on the "shipping_line1" field.
+func ShippingLine1GTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingLine1, v))
+}
+
+// ShippingLine1LT applies the LT predicate on the "shipping_line1" field.
+func ShippingLine1LT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingLine1, v))
+}
+
+// ShippingLine1LTE applies the LTE predicate on the "shipping_line1" field.
+func ShippingLine1LTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingLine1, v))
+}
+
+// ShippingLine1Contains applies the Contains predicate on the "shipping_line1" field.
+func ShippingLine1Contains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingLine1, v))
+}
+
+// ShippingLine1HasPrefix applies the HasPrefix predicate on the "shipping_line1" field.
+func ShippingLine1HasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingLine1, v))
+}
+
+// ShippingLine1HasSuffix applies the HasSuffix predicate on the "shipping_line1" field.
+func ShippingLine1HasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingLine1, v))
+}
+
+// ShippingLine1IsNil applies the IsNil predicate on the "shipping_line1" field.
+func ShippingLine1IsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingLine1))
+}
+
+// ShippingLine1NotNil applies the NotNil predicate on the "shipping_line1" field.
+func ShippingLine1NotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingLine1))
+}
+
+// ShippingLine1EqualFold applies the EqualFold predicate on the "shipping_line1" field.
+func ShippingLine1EqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingLine1, v))
+}
+
+// ShippingLine1ContainsFold applies the ContainsFold predicate on the "shipping_line1" field.
+func ShippingLine1ContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingLine1, v))
+}
+
+// ShippingLine2EQ applies the EQ predicate on the "shipping_line2" field.
+func ShippingLine2EQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingLine2, v))
+}
+
+// ShippingLine2NEQ applies the NEQ predicate on the "shipping_line2" field.
+func ShippingLine2NEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingLine2, v))
+}
+
+// ShippingLine2In applies the In predicate on the "shipping_line2" field.
+func ShippingLine2In(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingLine2, vs...))
+}
+
+// ShippingLine2NotIn applies the NotIn predicate on the "shipping_line2" field.
+func ShippingLine2NotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingLine2, vs...))
+}
+
+// ShippingLine2GT applies the GT predicate on the "shipping_line2" field.
+func ShippingLine2GT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingLine2, v))
+}
+
+// ShippingLine2GTE applies the GTE predicate on the "shipping_line2" field.
+func ShippingLine2GTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingLine2, v))
+}
+
+// ShippingLine2LT applies the LT predicate on the "shipping_line2" field.
+func ShippingLine2LT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingLine2, v))
+}
+
+// ShippingLine2LTE applies the LTE predicate on the "shipping_line2" field.
+func ShippingLine2LTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingLine2, v))
+}
+
+// ShippingLine2Contains applies the Contains predicate on the "shipping_line2" field.
+func ShippingLine2Contains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingLine2, v))
+}
+
+// ShippingLine2HasPrefix applies the HasPrefix predicate on the "shipping_line2" field.
+func ShippingLine2HasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingLine2, v))
+}
+
+// ShippingLine2HasSuffix applies the HasSuffix predicate on the "shipping_line2" field.
+func ShippingLine2HasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingLine2, v))
+}
+
+// ShippingLine2IsNil applies the IsNil predicate on the "shipping_line2" field.
+func ShippingLine2IsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingLine2))
+}
+
+// ShippingLine2NotNil applies the NotNil predicate on the "shipping_line2" field.
+func ShippingLine2NotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingLine2))
+}
+
+// ShippingLine2EqualFold applies the EqualFold predicate on the "shipping_line2" field.
+func ShippingLine2EqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingLine2, v))
+}
+
+// ShippingLine2ContainsFold applies the ContainsFold predicate on the "shipping_line2" field.
+func ShippingLine2ContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingLine2, v))
+}
+
+// ShippingCityEQ applies the EQ predicate on the "shipping_city" field.
+func ShippingCityEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingCity, v))
+}
+
+// ShippingCityNEQ applies the NEQ predicate on the "shipping_city" field.
+func ShippingCityNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingCity, v))
+}
+
+// ShippingCityIn applies the In predicate on the "shipping_city" field.
+func ShippingCityIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingCity, vs...))
+}
+
+// ShippingCityNotIn applies the NotIn predicate on the "shipping_city" field.
+func ShippingCityNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingCity, vs...))
+}
+
+// ShippingCityGT applies the GT predicate on the "shipping_city" field.
+func ShippingCityGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingCity, v))
+}
+
+// ShippingCityGTE applies the GTE predicate on the "shipping_city" field.
+func ShippingCityGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingCity, v))
+}
+
+// ShippingCityLT applies the LT predicate on the "shipping_city" field.
+func ShippingCityLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingCity, v))
+}
+
+// ShippingCityLTE applies the LTE predicate on the "shipping_city" field.
+func ShippingCityLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingCity, v))
+}
+
+// ShippingCityContains applies the Contains predicate on the "shipping_city" field.
+func ShippingCityContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingCity, v))
+}
+
+// ShippingCityHasPrefix applies the HasPrefix predicate on the "shipping_city" field.
+func ShippingCityHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingCity, v))
+}
+
+// ShippingCityHasSuffix applies the HasSuffix predicate on the "shipping_city" field.
+func ShippingCityHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingCity, v))
+}
+
+// ShippingCityIsNil applies the IsNil predicate on the "shipping_city" field.
+func ShippingCityIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingCity))
+}
+
+// ShippingCityNotNil applies the NotNil predicate on the "shipping_city" field.
+func ShippingCityNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingCity))
+}
+
+// ShippingCityEqualFold applies the EqualFold predicate on the "shipping_city" field.
+func ShippingCityEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingCity, v))
+}
+
+// ShippingCityContainsFold applies the ContainsFold predicate on the "shipping_city" field.
+func ShippingCityContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingCity, v))
+}
+
+// ShippingRegionEQ applies the EQ predicate on the "shipping_region" field.
+func ShippingRegionEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingRegion, v))
+}
+
+// ShippingRegionNEQ applies the NEQ predicate on the "shipping_region" field.
+func ShippingRegionNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingRegion, v))
+}
+
+// ShippingRegionIn applies the In predicate on the "shipping_region" field.
+func ShippingRegionIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingRegion, vs...))
+}
+
+// ShippingRegionNotIn applies the NotIn predicate on the "shipping_region" field.
+func ShippingRegionNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingRegion, vs...))
+}
+
+// ShippingRegionGT applies the GT predicate on the "shipping_region" field.
+func ShippingRegionGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingRegion, v))
+}
+
+// ShippingRegionGTE applies the GTE predicate on the "shipping_region" field.
+func ShippingRegionGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingRegion, v))
+}
+
+// ShippingRegionLT applies the LT predicate on the "shipping_region" field.
+func ShippingRegionLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingRegion, v))
+}
+
+// ShippingRegionLTE applies the LTE predicate on the "shipping_region" field.
+func ShippingRegionLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingRegion, v))
+}
+
+// ShippingRegionContains applies the Contains predicate on the "shipping_region" field.
+func ShippingRegionContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingRegion, v))
+}
+
+// ShippingRegionHasPrefix applies the HasPrefix predicate on the "shipping_region" field.
+func ShippingRegionHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingRegion, v))
+}
+
+// ShippingRegionHasSuffix applies the HasSuffix predicate on the "shipping_region" field.
+func ShippingRegionHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingRegion, v))
+}
+
+// ShippingRegionIsNil applies the IsNil predicate on the "shipping_region" field.
+func ShippingRegionIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingRegion))
+}
+
+// ShippingRegionNotNil applies the NotNil predicate on the "shipping_region" field.
+func ShippingRegionNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingRegion))
+}
+
+// ShippingRegionEqualFold applies the EqualFold predicate on the "shipping_region" field.
+func ShippingRegionEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingRegion, v))
+}
+
+// ShippingRegionContainsFold applies the ContainsFold predicate on the "shipping_region" field.
+func ShippingRegionContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingRegion, v))
+}
+
+// ShippingPostalCodeEQ applies the EQ predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeNEQ applies the NEQ predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeIn applies the In predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingPostalCode, vs...))
+}
+
+// ShippingPostalCodeNotIn applies the NotIn predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingPostalCode, vs...))
+}
+
+// ShippingPostalCodeGT applies the GT predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeGTE applies the GTE predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeLT applies the LT predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeLTE applies the LTE predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeContains applies the Contains predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeHasPrefix applies the HasPrefix predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeHasSuffix applies the HasSuffix predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeIsNil applies the IsNil predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingPostalCode))
+}
+
+// ShippingPostalCodeNotNil applies the NotNil predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingPostalCode))
+}
+
+// ShippingPostalCodeEqualFold applies the EqualFold predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingPostalCode, v))
+}
+
+// ShippingPostalCodeContainsFold applies the ContainsFold predicate on the "shipping_postal_code" field.
+func ShippingPostalCodeContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingPostalCode, v))
+}
+
+// ShippingCountryEQ applies the EQ predicate on the "shipping_country" field.
+func ShippingCountryEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingCountry, v))
+}
+
+// ShippingCountryNEQ applies the NEQ predicate on the "shipping_country" field.
+func ShippingCountryNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingCountry, v))
+}
+
+// ShippingCountryIn applies the In predicate on the "shipping_country" field.
+func ShippingCountryIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingCountry, vs...))
+}
+
+// ShippingCountryNotIn applies the NotIn predicate on the "shipping_country" field.
+func ShippingCountryNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingCountry, vs...))
+}
+
+// ShippingCountryGT applies the GT predicate on the "shipping_country" field.
+func ShippingCountryGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingCountry, v))
+}
+
+// ShippingCountryGTE applies the GTE predicate on the "shipping_country" field.
+func ShippingCountryGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingCountry, v))
+}
+
+// ShippingCountryLT applies the LT predicate on the "shipping_country" field.
+func ShippingCountryLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingCountry, v))
+}
+
+// ShippingCountryLTE applies the LTE predicate on the "shipping_country" field.
+func ShippingCountryLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingCountry, v))
+}
+
+// ShippingCountryContains applies the Contains predicate on the "shipping_country" field.
+func ShippingCountryContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingCountry, v))
+}
+
+// ShippingCountryHasPrefix applies the HasPrefix predicate on the "shipping_country" field.
+func ShippingCountryHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingCountry, v))
+}
+
+// ShippingCountryHasSuffix applies the HasSuffix predicate on the "shipping_country" field.
+func ShippingCountryHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingCountry, v))
+}
+
+// ShippingCountryIsNil applies the IsNil predicate on the "shipping_country" field.
+func ShippingCountryIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingCountry))
+}
+
+// ShippingCountryNotNil applies the NotNil predicate on the "shipping_country" field.
+func ShippingCountryNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingCountry))
+}
+
+// ShippingCountryEqualFold applies the EqualFold predicate on the "shipping_country" field.
+func ShippingCountryEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingCountry, v))
+}
+
+// ShippingCountryContainsFold applies the ContainsFold predicate on the "shipping_country" field.
+func ShippingCountryContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingCountry, v))
+}
+
 // HasOrderItems applies the HasEdge predicate on the "order_items" edge.
 func HasOrderItems() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -279,6 +1344,52 @@ func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.Order {
 	})
 }
 
+// HasShipments applies the HasEdge predicate on the "shipments" edge.
+func HasShipments() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShipmentsTable, ShipmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShipmentsWith applies the HasEdge predicate on the "shipments" edge with a given conditions (other predicates).
+func HasShipmentsWith(preds ...predicate.Shipment) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newShipmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.OrderEvent) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
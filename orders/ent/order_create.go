@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"orders/ent/order"
+	"orders/ent/orderevent"
 	"orders/ent/orderitem"
+	"orders/ent/shipment"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,9 +30,23 @@ func (oc *OrderCreate) SetUserID(u uuid.UUID) *OrderCreate {
 	return oc
 }
 
-// SetTotalAmount sets the "total_amount" field.
-func (oc *OrderCreate) SetTotalAmount(f float64) *OrderCreate {
-	oc.mutation.SetTotalAmount(f)
+// SetTotalAmountCents sets the "total_amount_cents" field.
+func (oc *OrderCreate) SetTotalAmountCents(i int64) *OrderCreate {
+	oc.mutation.SetTotalAmountCents(i)
+	return oc
+}
+
+// SetCurrency sets the "currency" field.
+func (oc *OrderCreate) SetCurrency(s string) *OrderCreate {
+	oc.mutation.SetCurrency(s)
+	return oc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableCurrency(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetCurrency(*s)
+	}
 	return oc
 }
 
@@ -48,6 +64,20 @@ func (oc *OrderCreate) SetNillableStatus(o *order.Status) *OrderCreate {
 	return oc
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (oc *OrderCreate) SetIdempotencyKey(s string) *OrderCreate {
+	oc.mutation.SetIdempotencyKey(s)
+	return oc
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableIdempotencyKey(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetIdempotencyKey(*s)
+	}
+	return oc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (oc *OrderCreate) SetCreatedAt(t time.Time) *OrderCreate {
 	oc.mutation.SetCreatedAt(t)
@@ -76,6 +106,188 @@ func (oc *OrderCreate) SetNillableUpdatedAt(t *time.Time) *OrderCreate {
 	return oc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (oc *OrderCreate) SetDeletedAt(t time.Time) *OrderCreate {
+	oc.mutation.SetDeletedAt(t)
+	return oc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDeletedAt(t *time.Time) *OrderCreate {
+	if t != nil {
+		oc.SetDeletedAt(*t)
+	}
+	return oc
+}
+
+// SetFraudHold sets the "fraud_hold" field.
+func (oc *OrderCreate) SetFraudHold(b bool) *OrderCreate {
+	oc.mutation.SetFraudHold(b)
+	return oc
+}
+
+// SetNillableFraudHold sets the "fraud_hold" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableFraudHold(b *bool) *OrderCreate {
+	if b != nil {
+		oc.SetFraudHold(*b)
+	}
+	return oc
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (oc *OrderCreate) SetHoldReason(s string) *OrderCreate {
+	oc.mutation.SetHoldReason(s)
+	return oc
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableHoldReason(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetHoldReason(*s)
+	}
+	return oc
+}
+
+// SetRegion sets the "region" field.
+func (oc *OrderCreate) SetRegion(s string) *OrderCreate {
+	oc.mutation.SetRegion(s)
+	return oc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableRegion(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetRegion(*s)
+	}
+	return oc
+}
+
+// SetDiscountCents sets the "discount_cents" field.
+func (oc *OrderCreate) SetDiscountCents(i int64) *OrderCreate {
+	oc.mutation.SetDiscountCents(i)
+	return oc
+}
+
+// SetNillableDiscountCents sets the "discount_cents" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDiscountCents(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetDiscountCents(*i)
+	}
+	return oc
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (oc *OrderCreate) SetCouponCode(s string) *OrderCreate {
+	oc.mutation.SetCouponCode(s)
+	return oc
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableCouponCode(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetCouponCode(*s)
+	}
+	return oc
+}
+
+// SetShippingRecipient sets the "shipping_recipient" field.
+func (oc *OrderCreate) SetShippingRecipient(s string) *OrderCreate {
+	oc.mutation.SetShippingRecipient(s)
+	return oc
+}
+
+// SetNillableShippingRecipient sets the "shipping_recipient" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingRecipient(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingRecipient(*s)
+	}
+	return oc
+}
+
+// SetShippingLine1 sets the "shipping_line1" field.
+func (oc *OrderCreate) SetShippingLine1(s string) *OrderCreate {
+	oc.mutation.SetShippingLine1(s)
+	return oc
+}
+
+// SetNillableShippingLine1 sets the "shipping_line1" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingLine1(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingLine1(*s)
+	}
+	return oc
+}
+
+// SetShippingLine2 sets the "shipping_line2" field.
+func (oc *OrderCreate) SetShippingLine2(s string) *OrderCreate {
+	oc.mutation.SetShippingLine2(s)
+	return oc
+}
+
+// SetNillableShippingLine2 sets the "shipping_line2" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingLine2(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingLine2(*s)
+	}
+	return oc
+}
+
+// SetShippingCity sets the "shipping_city" field.
+func (oc *OrderCreate) SetShippingCity(s string) *OrderCreate {
+	oc.mutation.SetShippingCity(s)
+	return oc
+}
+
+// SetNillableShippingCity sets the "shipping_city" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingCity(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingCity(*s)
+	}
+	return oc
+}
+
+// SetShippingRegion sets the "shipping_region" field.
+func (oc *OrderCreate) SetShippingRegion(s string) *OrderCreate {
+	oc.mutation.SetShippingRegion(s)
+	return oc
+}
+
+// SetNillableShippingRegion sets the "shipping_region" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingRegion(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingRegion(*s)
+	}
+	return oc
+}
+
+// SetShippingPostalCode sets the "shipping_postal_code" field.
+func (oc *OrderCreate) SetShippingPostalCode(s string) *OrderCreate {
+	oc.mutation.SetShippingPostalCode(s)
+	return oc
+}
+
+// SetNillableShippingPostalCode sets the "shipping_postal_code" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingPostalCode(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingPostalCode(*s)
+	}
+	return oc
+}
+
+// SetShippingCountry sets the "shipping_country" field.
+func (oc *OrderCreate) SetShippingCountry(s string) *OrderCreate {
+	oc.mutation.SetShippingCountry(s)
+	return oc
+}
+
+// SetNillableShippingCountry sets the "shipping_country" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingCountry(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingCountry(*s)
+	}
+	return oc
+}
+
 // SetID sets the "id" field.
 func (oc *OrderCreate) SetID(u uuid.UUID) *OrderCreate {
 	oc.mutation.SetID(u)
@@ -105,6 +317,36 @@ func (oc *OrderCreate) AddOrderItems(o ...*OrderItem) *OrderCreate {
 	return oc.AddOrderItemIDs(ids...)
 }
 
+// AddShipmentIDs adds the "shipments" edge to the Shipment entity by IDs.
+func (oc *OrderCreate) AddShipmentIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddShipmentIDs(ids...)
+	return oc
+}
+
+// AddShipments adds the "shipments" edges to the Shipment entity.
+func (oc *OrderCreate) AddShipments(s ...*Shipment) *OrderCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oc.AddShipmentIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (oc *OrderCreate) AddEventIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddEventIDs(ids...)
+	return oc
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (oc *OrderCreate) AddEvents(o ...*OrderEvent) *OrderCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -140,6 +382,10 @@ func (oc *OrderCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (oc *OrderCreate) defaults() {
+	if _, ok := oc.mutation.Currency(); !ok {
+		v := order.DefaultCurrency
+		oc.mutation.SetCurrency(v)
+	}
 	if _, ok := oc.mutation.Status(); !ok {
 		v := order.DefaultStatus
 		oc.mutation.SetStatus(v)
@@ -152,6 +398,14 @@ func (oc *OrderCreate) defaults() {
 		v := order.DefaultUpdatedAt()
 		oc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := oc.mutation.FraudHold(); !ok {
+		v := order.DefaultFraudHold
+		oc.mutation.SetFraudHold(v)
+	}
+	if _, ok := oc.mutation.DiscountCents(); !ok {
+		v := order.DefaultDiscountCents
+		oc.mutation.SetDiscountCents(v)
+	}
 	if _, ok := oc.mutation.ID(); !ok {
 		v := order.DefaultID()
 		oc.mutation.SetID(v)
@@ -163,12 +417,20 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Order.user_id"`)}
 	}
-	if _, ok := oc.mutation.TotalAmount(); !ok {
-		return &ValidationError{Name: "total_amount", err: errors.New(`ent: missing required field "Order.total_amount"`)}
+	if _, ok := oc.mutation.TotalAmountCents(); !ok {
+		return &ValidationError{Name: "total_amount_cents", err: errors.New(`ent: missing required field "Order.total_amount_cents"`)}
 	}
-	if v, ok := oc.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
-			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+	if v, ok := oc.mutation.TotalAmountCents(); ok {
+		if err := order.TotalAmountCentsValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount_cents", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount_cents": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Order.currency"`)}
+	}
+	if v, ok := oc.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
 		}
 	}
 	if _, ok := oc.mutation.Status(); !ok {
@@ -185,6 +447,17 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Order.updated_at"`)}
 	}
+	if _, ok := oc.mutation.FraudHold(); !ok {
+		return &ValidationError{Name: "fraud_hold", err: errors.New(`ent: missing required field "Order.fraud_hold"`)}
+	}
+	if _, ok := oc.mutation.DiscountCents(); !ok {
+		return &ValidationError{Name: "discount_cents", err: errors.New(`ent: missing required field "Order.discount_cents"`)}
+	}
+	if v, ok := oc.mutation.DiscountCents(); ok {
+		if err := order.DiscountCentsValidator(v); err != nil {
+			return &ValidationError{Name: "discount_cents", err: fmt.Errorf(`ent: validator failed for field "Order.discount_cents": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -224,14 +497,22 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldUserID, field.TypeUUID, value)
 		_node.UserID = value
 	}
-	if value, ok := oc.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
-		_node.TotalAmount = value
+	if value, ok := oc.mutation.TotalAmountCents(); ok {
+		_spec.SetField(order.FieldTotalAmountCents, field.TypeInt64, value)
+		_node.TotalAmountCents = value
+	}
+	if value, ok := oc.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
 	}
 	if value, ok := oc.mutation.Status(); ok {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := oc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(order.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if value, ok := oc.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -240,6 +521,58 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := oc.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := oc.mutation.FraudHold(); ok {
+		_spec.SetField(order.FieldFraudHold, field.TypeBool, value)
+		_node.FraudHold = value
+	}
+	if value, ok := oc.mutation.HoldReason(); ok {
+		_spec.SetField(order.FieldHoldReason, field.TypeString, value)
+		_node.HoldReason = &value
+	}
+	if value, ok := oc.mutation.Region(); ok {
+		_spec.SetField(order.FieldRegion, field.TypeString, value)
+		_node.Region = &value
+	}
+	if value, ok := oc.mutation.DiscountCents(); ok {
+		_spec.SetField(order.FieldDiscountCents, field.TypeInt64, value)
+		_node.DiscountCents = value
+	}
+	if value, ok := oc.mutation.CouponCode(); ok {
+		_spec.SetField(order.FieldCouponCode, field.TypeString, value)
+		_node.CouponCode = &value
+	}
+	if value, ok := oc.mutation.ShippingRecipient(); ok {
+		_spec.SetField(order.FieldShippingRecipient, field.TypeString, value)
+		_node.ShippingRecipient = &value
+	}
+	if value, ok := oc.mutation.ShippingLine1(); ok {
+		_spec.SetField(order.FieldShippingLine1, field.TypeString, value)
+		_node.ShippingLine1 = &value
+	}
+	if value, ok := oc.mutation.ShippingLine2(); ok {
+		_spec.SetField(order.FieldShippingLine2, field.TypeString, value)
+		_node.ShippingLine2 = &value
+	}
+	if value, ok := oc.mutation.ShippingCity(); ok {
+		_spec.SetField(order.FieldShippingCity, field.TypeString, value)
+		_node.ShippingCity = &value
+	}
+	if value, ok := oc.mutation.ShippingRegion(); ok {
+		_spec.SetField(order.FieldShippingRegion, field.TypeString, value)
+		_node.ShippingRegion = &value
+	}
+	if value, ok := oc.mutation.ShippingPostalCode(); ok {
+		_spec.SetField(order.FieldShippingPostalCode, field.TypeString, value)
+		_node.ShippingPostalCode = &value
+	}
+	if value, ok := oc.mutation.ShippingCountry(); ok {
+		_spec.SetField(order.FieldShippingCountry, field.TypeString, value)
+		_node.ShippingCountry = &value
+	}
 	if nodes := oc.mutation.OrderItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -256,6 +589,38 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.ShipmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
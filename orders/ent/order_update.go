@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"orders/ent/order"
+	"orders/ent/orderevent"
 	"orders/ent/orderitem"
 	"orders/ent/predicate"
+	"orders/ent/shipment"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -44,24 +46,38 @@ func (ou *OrderUpdate) SetNillableUserID(u *uuid.UUID) *OrderUpdate {
 	return ou
 }
 
-// SetTotalAmount sets the "total_amount" field.
-func (ou *OrderUpdate) SetTotalAmount(f float64) *OrderUpdate {
-	ou.mutation.ResetTotalAmount()
-	ou.mutation.SetTotalAmount(f)
+// SetTotalAmountCents sets the "total_amount_cents" field.
+func (ou *OrderUpdate) SetTotalAmountCents(i int64) *OrderUpdate {
+	ou.mutation.ResetTotalAmountCents()
+	ou.mutation.SetTotalAmountCents(i)
 	return ou
 }
 
-// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableTotalAmount(f *float64) *OrderUpdate {
-	if f != nil {
-		ou.SetTotalAmount(*f)
+// SetNillableTotalAmountCents sets the "total_amount_cents" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableTotalAmountCents(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetTotalAmountCents(*i)
 	}
 	return ou
 }
 
-// AddTotalAmount adds f to the "total_amount" field.
-func (ou *OrderUpdate) AddTotalAmount(f float64) *OrderUpdate {
-	ou.mutation.AddTotalAmount(f)
+// AddTotalAmountCents adds i to the "total_amount_cents" field.
+func (ou *OrderUpdate) AddTotalAmountCents(i int64) *OrderUpdate {
+	ou.mutation.AddTotalAmountCents(i)
+	return ou
+}
+
+// SetCurrency sets the "currency" field.
+func (ou *OrderUpdate) SetCurrency(s string) *OrderUpdate {
+	ou.mutation.SetCurrency(s)
+	return ou
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableCurrency(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetCurrency(*s)
+	}
 	return ou
 }
 
@@ -85,6 +101,200 @@ func (ou *OrderUpdate) SetUpdatedAt(t time.Time) *OrderUpdate {
 	return ou
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ou *OrderUpdate) SetDeletedAt(t time.Time) *OrderUpdate {
+	ou.mutation.SetDeletedAt(t)
+	return ou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDeletedAt(t *time.Time) *OrderUpdate {
+	if t != nil {
+		ou.SetDeletedAt(*t)
+	}
+	return ou
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ou *OrderUpdate) ClearDeletedAt() *OrderUpdate {
+	ou.mutation.ClearDeletedAt()
+	return ou
+}
+
+// SetFraudHold sets the "fraud_hold" field.
+func (ou *OrderUpdate) SetFraudHold(b bool) *OrderUpdate {
+	ou.mutation.SetFraudHold(b)
+	return ou
+}
+
+// SetNillableFraudHold sets the "fraud_hold" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableFraudHold(b *bool) *OrderUpdate {
+	if b != nil {
+		ou.SetFraudHold(*b)
+	}
+	return ou
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (ou *OrderUpdate) SetHoldReason(s string) *OrderUpdate {
+	ou.mutation.SetHoldReason(s)
+	return ou
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableHoldReason(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetHoldReason(*s)
+	}
+	return ou
+}
+
+// ClearHoldReason clears the value of the "hold_reason" field.
+func (ou *OrderUpdate) ClearHoldReason() *OrderUpdate {
+	ou.mutation.ClearHoldReason()
+	return ou
+}
+
+// SetShippingRecipient sets the "shipping_recipient" field.
+func (ou *OrderUpdate) SetShippingRecipient(s string) *OrderUpdate {
+	ou.mutation.SetShippingRecipient(s)
+	return ou
+}
+
+// SetNillableShippingRecipient sets the "shipping_recipient" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingRecipient(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingRecipient(*s)
+	}
+	return ou
+}
+
+// ClearShippingRecipient clears the value of the "shipping_recipient" field.
+func (ou *OrderUpdate) ClearShippingRecipient() *OrderUpdate {
+	ou.mutation.ClearShippingRecipient()
+	return ou
+}
+
+// SetShippingLine1 sets the "shipping_line1" field.
+func (ou *OrderUpdate) SetShippingLine1(s string) *OrderUpdate {
+	ou.mutation.SetShippingLine1(s)
+	return ou
+}
+
+// SetNillableShippingLine1 sets the "shipping_line1" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingLine1(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingLine1(*s)
+	}
+	return ou
+}
+
+// ClearShippingLine1 clears the value of the "shipping_line1" field.
+func (ou *OrderUpdate) ClearShippingLine1() *OrderUpdate {
+	ou.mutation.ClearShippingLine1()
+	return ou
+}
+
+// SetShippingLine2 sets the "shipping_line2" field.
+func (ou *OrderUpdate) SetShippingLine2(s string) *OrderUpdate {
+	ou.mutation.SetShippingLine2(s)
+	return ou
+}
+
+// SetNillableShippingLine2 sets the "shipping_line2" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingLine2(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingLine2(*s)
+	}
+	return ou
+}
+
+// ClearShippingLine2 clears the value of the "shipping_line2" field.
+func (ou *OrderUpdate) ClearShippingLine2() *OrderUpdate {
+	ou.mutation.ClearShippingLine2()
+	return ou
+}
+
+// SetShippingCity sets the "shipping_city" field.
+func (ou *OrderUpdate) SetShippingCity(s string) *OrderUpdate {
+	ou.mutation.SetShippingCity(s)
+	return ou
+}
+
+// SetNillableShippingCity sets the "shipping_city" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingCity(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingCity(*s)
+	}
+	return ou
+}
+
+// ClearShippingCity clears the value of the "shipping_city" field.
+func (ou *OrderUpdate) ClearShippingCity() *OrderUpdate {
+	ou.mutation.ClearShippingCity()
+	return ou
+}
+
+// SetShippingRegion sets the "shipping_region" field.
+func (ou *OrderUpdate) SetShippingRegion(s string) *OrderUpdate {
+	ou.mutation.SetShippingRegion(s)
+	return ou
+}
+
+// SetNillableShippingRegion sets the "shipping_region" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingRegion(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingRegion(*s)
+	}
+	return ou
+}
+
+// ClearShippingRegion clears the value of the "shipping_region" field.
+func (ou *OrderUpdate) ClearShippingRegion() *OrderUpdate {
+	ou.mutation.ClearShippingRegion()
+	return ou
+}
+
+// SetShippingPostalCode sets the "shipping_postal_code" field.
+func (ou *OrderUpdate) SetShippingPostalCode(s string) *OrderUpdate {
+	ou.mutation.SetShippingPostalCode(s)
+	return ou
+}
+
+// SetNillableShippingPostalCode sets the "shipping_postal_code" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingPostalCode(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingPostalCode(*s)
+	}
+	return ou
+}
+
+// ClearShippingPostalCode clears the value of the "shipping_postal_code" field.
+func (ou *OrderUpdate) ClearShippingPostalCode() *OrderUpdate {
+	ou.mutation.ClearShippingPostalCode()
+	return ou
+}
+
+// SetShippingCountry sets the "shipping_country" field.
+func (ou *OrderUpdate) SetShippingCountry(s string) *OrderUpdate {
+	ou.mutation.SetShippingCountry(s)
+	return ou
+}
+
+// SetNillableShippingCountry sets the "shipping_country" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingCountry(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingCountry(*s)
+	}
+	return ou
+}
+
+// ClearShippingCountry clears the value of the "shipping_country" field.
+func (ou *OrderUpdate) ClearShippingCountry() *OrderUpdate {
+	ou.mutation.ClearShippingCountry()
+	return ou
+}
+
 // AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
 func (ou *OrderUpdate) AddOrderItemIDs(ids ...uuid.UUID) *OrderUpdate {
 	ou.mutation.AddOrderItemIDs(ids...)
@@ -100,6 +310,36 @@ func (ou *OrderUpdate) AddOrderItems(o ...*OrderItem) *OrderUpdate {
 	return ou.AddOrderItemIDs(ids...)
 }
 
+// AddShipmentIDs adds the "shipments" edge to the Shipment entity by IDs.
+func (ou *OrderUpdate) AddShipmentIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddShipmentIDs(ids...)
+	return ou
+}
+
+// AddShipments adds the "shipments" edges to the Shipment entity.
+func (ou *OrderUpdate) AddShipments(s ...*Shipment) *OrderUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddShipmentIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (ou *OrderUpdate) AddEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddEventIDs(ids...)
+	return ou
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (ou *OrderUpdate) AddEvents(o ...*OrderEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
@@ -126,6 +366,48 @@ func (ou *OrderUpdate) RemoveOrderItems(o ...*OrderItem) *OrderUpdate {
 	return ou.RemoveOrderItemIDs(ids...)
 }
 
+// ClearShipments clears all "shipments" edges to the Shipment entity.
+func (ou *OrderUpdate) ClearShipments() *OrderUpdate {
+	ou.mutation.ClearShipments()
+	return ou
+}
+
+// RemoveShipmentIDs removes the "shipments" edge to Shipment entities by IDs.
+func (ou *OrderUpdate) RemoveShipmentIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveShipmentIDs(ids...)
+	return ou
+}
+
+// RemoveShipments removes "shipments" edges to Shipment entities.
+func (ou *OrderUpdate) RemoveShipments(s ...*Shipment) *OrderUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveShipmentIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the OrderEvent entity.
+func (ou *OrderUpdate) ClearEvents() *OrderUpdate {
+	ou.mutation.ClearEvents()
+	return ou
+}
+
+// RemoveEventIDs removes the "events" edge to OrderEvent entities by IDs.
+func (ou *OrderUpdate) RemoveEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveEventIDs(ids...)
+	return ou
+}
+
+// RemoveEvents removes "events" edges to OrderEvent entities.
+func (ou *OrderUpdate) RemoveEvents(o ...*OrderEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -164,9 +446,14 @@ func (ou *OrderUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ou *OrderUpdate) check() error {
-	if v, ok := ou.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
-			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+	if v, ok := ou.mutation.TotalAmountCents(); ok {
+		if err := order.TotalAmountCentsValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount_cents", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount_cents": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
 		}
 	}
 	if v, ok := ou.mutation.Status(); ok {
@@ -192,18 +479,87 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.UserID(); ok {
 		_spec.SetField(order.FieldUserID, field.TypeUUID, value)
 	}
-	if value, ok := ou.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+	if value, ok := ou.mutation.TotalAmountCents(); ok {
+		_spec.SetField(order.FieldTotalAmountCents, field.TypeInt64, value)
 	}
-	if value, ok := ou.mutation.AddedTotalAmount(); ok {
-		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+	if value, ok := ou.mutation.AddedTotalAmountCents(); ok {
+		_spec.AddField(order.FieldTotalAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := ou.mutation.Status(); ok {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 	}
+	if ou.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(order.FieldIdempotencyKey, field.TypeString)
+	}
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ou.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ou.mutation.DeletedAtCleared() {
+		_spec.ClearField(order.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ou.mutation.FraudHold(); ok {
+		_spec.SetField(order.FieldFraudHold, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.HoldReason(); ok {
+		_spec.SetField(order.FieldHoldReason, field.TypeString, value)
+	}
+	if ou.mutation.HoldReasonCleared() {
+		_spec.ClearField(order.FieldHoldReason, field.TypeString)
+	}
+	if ou.mutation.RegionCleared() {
+		_spec.ClearField(order.FieldRegion, field.TypeString)
+	}
+	if ou.mutation.CouponCodeCleared() {
+		_spec.ClearField(order.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingRecipient(); ok {
+		_spec.SetField(order.FieldShippingRecipient, field.TypeString, value)
+	}
+	if ou.mutation.ShippingRecipientCleared() {
+		_spec.ClearField(order.FieldShippingRecipient, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingLine1(); ok {
+		_spec.SetField(order.FieldShippingLine1, field.TypeString, value)
+	}
+	if ou.mutation.ShippingLine1Cleared() {
+		_spec.ClearField(order.FieldShippingLine1, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingLine2(); ok {
+		_spec.SetField(order.FieldShippingLine2, field.TypeString, value)
+	}
+	if ou.mutation.ShippingLine2Cleared() {
+		_spec.ClearField(order.FieldShippingLine2, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingCity(); ok {
+		_spec.SetField(order.FieldShippingCity, field.TypeString, value)
+	}
+	if ou.mutation.ShippingCityCleared() {
+		_spec.ClearField(order.FieldShippingCity, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingRegion(); ok {
+		_spec.SetField(order.FieldShippingRegion, field.TypeString, value)
+	}
+	if ou.mutation.ShippingRegionCleared() {
+		_spec.ClearField(order.FieldShippingRegion, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingPostalCode(); ok {
+		_spec.SetField(order.FieldShippingPostalCode, field.TypeString, value)
+	}
+	if ou.mutation.ShippingPostalCodeCleared() {
+		_spec.ClearField(order.FieldShippingPostalCode, field.TypeString)
+	}
+	if value, ok := ou.mutation.ShippingCountry(); ok {
+		_spec.SetField(order.FieldShippingCountry, field.TypeString, value)
+	}
+	if ou.mutation.ShippingCountryCleared() {
+		_spec.ClearField(order.FieldShippingCountry, field.TypeString)
+	}
 	if ou.mutation.OrderItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -249,6 +605,96 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.ShipmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedShipmentsIDs(); len(nodes) > 0 && !ou.mutation.ShipmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ShipmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ou.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -283,24 +729,38 @@ func (ouo *OrderUpdateOne) SetNillableUserID(u *uuid.UUID) *OrderUpdateOne {
 	return ouo
 }
 
-// SetTotalAmount sets the "total_amount" field.
-func (ouo *OrderUpdateOne) SetTotalAmount(f float64) *OrderUpdateOne {
-	ouo.mutation.ResetTotalAmount()
-	ouo.mutation.SetTotalAmount(f)
+// SetTotalAmountCents sets the "total_amount_cents" field.
+func (ouo *OrderUpdateOne) SetTotalAmountCents(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetTotalAmountCents()
+	ouo.mutation.SetTotalAmountCents(i)
 	return ouo
 }
 
-// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableTotalAmount(f *float64) *OrderUpdateOne {
-	if f != nil {
-		ouo.SetTotalAmount(*f)
+// SetNillableTotalAmountCents sets the "total_amount_cents" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableTotalAmountCents(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetTotalAmountCents(*i)
 	}
 	return ouo
 }
 
-// AddTotalAmount adds f to the "total_amount" field.
-func (ouo *OrderUpdateOne) AddTotalAmount(f float64) *OrderUpdateOne {
-	ouo.mutation.AddTotalAmount(f)
+// AddTotalAmountCents adds i to the "total_amount_cents" field.
+func (ouo *OrderUpdateOne) AddTotalAmountCents(i int64) *OrderUpdateOne {
+	ouo.mutation.AddTotalAmountCents(i)
+	return ouo
+}
+
+// SetCurrency sets the "currency" field.
+func (ouo *OrderUpdateOne) SetCurrency(s string) *OrderUpdateOne {
+	ouo.mutation.SetCurrency(s)
+	return ouo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableCurrency(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetCurrency(*s)
+	}
 	return ouo
 }
 
@@ -324,6 +784,200 @@ func (ouo *OrderUpdateOne) SetUpdatedAt(t time.Time) *OrderUpdateOne {
 	return ouo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ouo *OrderUpdateOne) SetDeletedAt(t time.Time) *OrderUpdateOne {
+	ouo.mutation.SetDeletedAt(t)
+	return ouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDeletedAt(t *time.Time) *OrderUpdateOne {
+	if t != nil {
+		ouo.SetDeletedAt(*t)
+	}
+	return ouo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ouo *OrderUpdateOne) ClearDeletedAt() *OrderUpdateOne {
+	ouo.mutation.ClearDeletedAt()
+	return ouo
+}
+
+// SetFraudHold sets the "fraud_hold" field.
+func (ouo *OrderUpdateOne) SetFraudHold(b bool) *OrderUpdateOne {
+	ouo.mutation.SetFraudHold(b)
+	return ouo
+}
+
+// SetNillableFraudHold sets the "fraud_hold" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableFraudHold(b *bool) *OrderUpdateOne {
+	if b != nil {
+		ouo.SetFraudHold(*b)
+	}
+	return ouo
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (ouo *OrderUpdateOne) SetHoldReason(s string) *OrderUpdateOne {
+	ouo.mutation.SetHoldReason(s)
+	return ouo
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableHoldReason(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetHoldReason(*s)
+	}
+	return ouo
+}
+
+// ClearHoldReason clears the value of the "hold_reason" field.
+func (ouo *OrderUpdateOne) ClearHoldReason() *OrderUpdateOne {
+	ouo.mutation.ClearHoldReason()
+	return ouo
+}
+
+// SetShippingRecipient sets the "shipping_recipient" field.
+func (ouo *OrderUpdateOne) SetShippingRecipient(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingRecipient(s)
+	return ouo
+}
+
+// SetNillableShippingRecipient sets the "shipping_recipient" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingRecipient(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingRecipient(*s)
+	}
+	return ouo
+}
+
+// ClearShippingRecipient clears the value of the "shipping_recipient" field.
+func (ouo *OrderUpdateOne) ClearShippingRecipient() *OrderUpdateOne {
+	ouo.mutation.ClearShippingRecipient()
+	return ouo
+}
+
+// SetShippingLine1 sets the "shipping_line1" field.
+func (ouo *OrderUpdateOne) SetShippingLine1(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingLine1(s)
+	return ouo
+}
+
+// SetNillableShippingLine1 sets the "shipping_line1" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingLine1(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingLine1(*s)
+	}
+	return ouo
+}
+
+// ClearShippingLine1 clears the value of the "shipping_line1" field.
+func (ouo *OrderUpdateOne) ClearShippingLine1() *OrderUpdateOne {
+	ouo.mutation.ClearShippingLine1()
+	return ouo
+}
+
+// SetShippingLine2 sets the "shipping_line2" field.
+func (ouo *OrderUpdateOne) SetShippingLine2(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingLine2(s)
+	return ouo
+}
+
+// SetNillableShippingLine2 sets the "shipping_line2" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingLine2(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingLine2(*s)
+	}
+	return ouo
+}
+
+// ClearShippingLine2 clears the value of the "shipping_line2" field.
+func (ouo *OrderUpdateOne) ClearShippingLine2() *OrderUpdateOne {
+	ouo.mutation.ClearShippingLine2()
+	return ouo
+}
+
+// SetShippingCity sets the "shipping_city" field.
+func (ouo *OrderUpdateOne) SetShippingCity(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingCity(s)
+	return ouo
+}
+
+// SetNillableShippingCity sets the "shipping_city" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingCity(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingCity(*s)
+	}
+	return ouo
+}
+
+// ClearShippingCity clears the value of the "shipping_city" field.
+func (ouo *OrderUpdateOne) ClearShippingCity() *OrderUpdateOne {
+	ouo.mutation.ClearShippingCity()
+	return ouo
+}
+
+// SetShippingRegion sets the "shipping_region" field.
+func (ouo *OrderUpdateOne) SetShippingRegion(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingRegion(s)
+	return ouo
+}
+
+// SetNillableShippingRegion sets the "shipping_region" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingRegion(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingRegion(*s)
+	}
+	return ouo
+}
+
+// ClearShippingRegion clears the value of the "shipping_region" field.
+func (ouo *OrderUpdateOne) ClearShippingRegion() *OrderUpdateOne {
+	ouo.mutation.ClearShippingRegion()
+	return ouo
+}
+
+// SetShippingPostalCode sets the "shipping_postal_code" field.
+func (ouo *OrderUpdateOne) SetShippingPostalCode(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingPostalCode(s)
+	return ouo
+}
+
+// SetNillableShippingPostalCode sets the "shipping_postal_code" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingPostalCode(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingPostalCode(*s)
+	}
+	return ouo
+}
+
+// ClearShippingPostalCode clears the value of the "shipping_postal_code" field.
+func (ouo *OrderUpdateOne) ClearShippingPostalCode() *OrderUpdateOne {
+	ouo.mutation.ClearShippingPostalCode()
+	return ouo
+}
+
+// SetShippingCountry sets the "shipping_country" field.
+func (ouo *OrderUpdateOne) SetShippingCountry(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingCountry(s)
+	return ouo
+}
+
+// SetNillableShippingCountry sets the "shipping_country" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingCountry(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingCountry(*s)
+	}
+	return ouo
+}
+
+// ClearShippingCountry clears the value of the "shipping_country" field.
+func (ouo *OrderUpdateOne) ClearShippingCountry() *OrderUpdateOne {
+	ouo.mutation.ClearShippingCountry()
+	return ouo
+}
+
 // AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
 func (ouo *OrderUpdateOne) AddOrderItemIDs(ids ...uuid.UUID) *OrderUpdateOne {
 	ouo.mutation.AddOrderItemIDs(ids...)
@@ -339,6 +993,36 @@ func (ouo *OrderUpdateOne) AddOrderItems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.AddOrderItemIDs(ids...)
 }
 
+// AddShipmentIDs adds the "shipments" edge to the Shipment entity by IDs.
+func (ouo *OrderUpdateOne) AddShipmentIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddShipmentIDs(ids...)
+	return ouo
+}
+
+// AddShipments adds the "shipments" edges to the Shipment entity.
+func (ouo *OrderUpdateOne) AddShipments(s ...*Shipment) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddShipmentIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (ouo *OrderUpdateOne) AddEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddEventIDs(ids...)
+	return ouo
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (ouo *OrderUpdateOne) AddEvents(o ...*OrderEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
@@ -365,6 +1049,48 @@ func (ouo *OrderUpdateOne) RemoveOrderItems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.RemoveOrderItemIDs(ids...)
 }
 
+// ClearShipments clears all "shipments" edges to the Shipment entity.
+func (ouo *OrderUpdateOne) ClearShipments() *OrderUpdateOne {
+	ouo.mutation.ClearShipments()
+	return ouo
+}
+
+// RemoveShipmentIDs removes the "shipments" edge to Shipment entities by IDs.
+func (ouo *OrderUpdateOne) RemoveShipmentIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveShipmentIDs(ids...)
+	return ouo
+}
+
+// RemoveShipments removes "shipments" edges to Shipment entities.
+func (ouo *OrderUpdateOne) RemoveShipments(s ...*Shipment) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveShipmentIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the OrderEvent entity.
+func (ouo *OrderUpdateOne) ClearEvents() *OrderUpdateOne {
+	ouo.mutation.ClearEvents()
+	return ouo
+}
+
+// RemoveEventIDs removes the "events" edge to OrderEvent entities by IDs.
+func (ouo *OrderUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveEventIDs(ids...)
+	return ouo
+}
+
+// RemoveEvents removes "events" edges to OrderEvent entities.
+func (ouo *OrderUpdateOne) RemoveEvents(o ...*OrderEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveEventIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -416,9 +1142,14 @@ func (ouo *OrderUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ouo *OrderUpdateOne) check() error {
-	if v, ok := ouo.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
-			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+	if v, ok := ouo.mutation.TotalAmountCents(); ok {
+		if err := order.TotalAmountCentsValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount_cents", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount_cents": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
 		}
 	}
 	if v, ok := ouo.mutation.Status(); ok {
@@ -461,18 +1192,87 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.UserID(); ok {
 		_spec.SetField(order.FieldUserID, field.TypeUUID, value)
 	}
-	if value, ok := ouo.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+	if value, ok := ouo.mutation.TotalAmountCents(); ok {
+		_spec.SetField(order.FieldTotalAmountCents, field.TypeInt64, value)
 	}
-	if value, ok := ouo.mutation.AddedTotalAmount(); ok {
-		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+	if value, ok := ouo.mutation.AddedTotalAmountCents(); ok {
+		_spec.AddField(order.FieldTotalAmountCents, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := ouo.mutation.Status(); ok {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 	}
+	if ouo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(order.FieldIdempotencyKey, field.TypeString)
+	}
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ouo.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.DeletedAtCleared() {
+		_spec.ClearField(order.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.FraudHold(); ok {
+		_spec.SetField(order.FieldFraudHold, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.HoldReason(); ok {
+		_spec.SetField(order.FieldHoldReason, field.TypeString, value)
+	}
+	if ouo.mutation.HoldReasonCleared() {
+		_spec.ClearField(order.FieldHoldReason, field.TypeString)
+	}
+	if ouo.mutation.RegionCleared() {
+		_spec.ClearField(order.FieldRegion, field.TypeString)
+	}
+	if ouo.mutation.CouponCodeCleared() {
+		_spec.ClearField(order.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingRecipient(); ok {
+		_spec.SetField(order.FieldShippingRecipient, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingRecipientCleared() {
+		_spec.ClearField(order.FieldShippingRecipient, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingLine1(); ok {
+		_spec.SetField(order.FieldShippingLine1, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingLine1Cleared() {
+		_spec.ClearField(order.FieldShippingLine1, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingLine2(); ok {
+		_spec.SetField(order.FieldShippingLine2, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingLine2Cleared() {
+		_spec.ClearField(order.FieldShippingLine2, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingCity(); ok {
+		_spec.SetField(order.FieldShippingCity, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingCityCleared() {
+		_spec.ClearField(order.FieldShippingCity, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingRegion(); ok {
+		_spec.SetField(order.FieldShippingRegion, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingRegionCleared() {
+		_spec.ClearField(order.FieldShippingRegion, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingPostalCode(); ok {
+		_spec.SetField(order.FieldShippingPostalCode, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingPostalCodeCleared() {
+		_spec.ClearField(order.FieldShippingPostalCode, field.TypeString)
+	}
+	if value, ok := ouo.mutation.ShippingCountry(); ok {
+		_spec.SetField(order.FieldShippingCountry, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingCountryCleared() {
+		_spec.ClearField(order.FieldShippingCountry, field.TypeString)
+	}
 	if ouo.mutation.OrderItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -518,6 +1318,96 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.ShipmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedShipmentsIDs(); len(nodes) > 0 && !ouo.mutation.ShipmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ShipmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ShipmentsTable,
+			Columns: []string{order.ShipmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ouo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"orders/ent/order"
+	"orders/ent/orderevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// OrderEvent is the model entity for the OrderEvent schema.
+type OrderEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Status before the change, unset when the order was created
+	FromStatus *orderevent.FromStatus `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus orderevent.ToStatus `json:"to_status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderEventQuery when eager-loading is set.
+	Edges        OrderEventEdges `json:"edges"`
+	order_events *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// OrderEventEdges holds the relations/edges for other nodes in the graph.
+type OrderEventEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEventEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldFromStatus, orderevent.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case orderevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case orderevent.FieldID:
+			values[i] = new(uuid.UUID)
+		case orderevent.ForeignKeys[0]: // order_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderEvent fields.
+func (oe *OrderEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				oe.ID = *value
+			}
+		case orderevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				oe.FromStatus = new(orderevent.FromStatus)
+				*oe.FromStatus = orderevent.FromStatus(value.String)
+			}
+		case orderevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				oe.ToStatus = orderevent.ToStatus(value.String)
+			}
+		case orderevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oe.CreatedAt = value.Time
+			}
+		case orderevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field order_events", values[i])
+			} else if value.Valid {
+				oe.order_events = new(uuid.UUID)
+				*oe.order_events = *value.S.(*uuid.UUID)
+			}
+		default:
+			oe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderEvent.
+// This includes values selected through modifiers, order, etc.
+func (oe *OrderEvent) Value(name string) (ent.Value, error) {
+	return oe.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderEvent entity.
+func (oe *OrderEvent) QueryOrder() *OrderQuery {
+	return NewOrderEventClient(oe.config).QueryOrder(oe)
+}
+
+// Update returns a builder for updating this OrderEvent.
+// Note that you need to call OrderEvent.Unwrap() before calling this method if this OrderEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OrderEvent) Update() *OrderEventUpdateOne {
+	return NewOrderEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OrderEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OrderEvent) Unwrap() *OrderEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OrderEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	if v := oe.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", oe.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(oe.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderEvents is a parsable slice of OrderEvent.
+type OrderEvents []*OrderEvent
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/order"
+	"orders/ent/orderevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OrderEventCreate is the builder for creating a OrderEvent entity.
+type OrderEventCreate struct {
+	config
+	mutation *OrderEventMutation
+	hooks    []Hook
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oec *OrderEventCreate) SetFromStatus(os orderevent.FromStatus) *OrderEventCreate {
+	oec.mutation.SetFromStatus(os)
+	return oec
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableFromStatus(os *orderevent.FromStatus) *OrderEventCreate {
+	if os != nil {
+		oec.SetFromStatus(*os)
+	}
+	return oec
+}
+
+// SetToStatus sets the "to_status" field.
+func (oec *OrderEventCreate) SetToStatus(os orderevent.ToStatus) *OrderEventCreate {
+	oec.mutation.SetToStatus(os)
+	return oec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oec *OrderEventCreate) SetCreatedAt(t time.Time) *OrderEventCreate {
+	oec.mutation.SetCreatedAt(t)
+	return oec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableCreatedAt(t *time.Time) *OrderEventCreate {
+	if t != nil {
+		oec.SetCreatedAt(*t)
+	}
+	return oec
+}
+
+// SetID sets the "id" field.
+func (oec *OrderEventCreate) SetID(u uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetID(u)
+	return oec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableID(u *uuid.UUID) *OrderEventCreate {
+	if u != nil {
+		oec.SetID(*u)
+	}
+	return oec
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (oec *OrderEventCreate) SetOrderID(id uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetOrderID(id)
+	return oec
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oec *OrderEventCreate) SetOrder(o *Order) *OrderEventCreate {
+	return oec.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderEventMutation object of the builder.
+func (oec *OrderEventCreate) Mutation() *OrderEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OrderEvent in the database.
+func (oec *OrderEventCreate) Save(ctx context.Context) (*OrderEvent, error) {
+	oec.defaults()
+	return withHooks(ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OrderEventCreate) SaveX(ctx context.Context) *OrderEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OrderEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OrderEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OrderEventCreate) defaults() {
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		v := orderevent.DefaultCreatedAt()
+		oec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oec.mutation.ID(); !ok {
+		v := orderevent.DefaultID()
+		oec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OrderEventCreate) check() error {
+	if v, ok := oec.mutation.FromStatus(); ok {
+		if err := orderevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "OrderEvent.to_status"`)}
+	}
+	if v, ok := oec.mutation.ToStatus(); ok {
+		if err := orderevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderEvent.created_at"`)}
+	}
+	if len(oec.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderEvent.order"`)}
+	}
+	return nil
+}
+
+func (oec *OrderEventCreate) sqlSave(ctx context.Context) (*OrderEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OrderEventCreate) createSpec() (*OrderEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(orderevent.Table, sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := oec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oec.mutation.FromStatus(); ok {
+		_spec.SetField(orderevent.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := oec.mutation.ToStatus(); ok {
+		_spec.SetField(orderevent.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := oec.mutation.CreatedAt(); ok {
+		_spec.SetField(orderevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := oec.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderevent.OrderTable,
+			Columns: []string{orderevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderEventCreateBulk is the builder for creating many OrderEvent entities in bulk.
+type OrderEventCreateBulk struct {
+	config
+	err      error
+	builders []*OrderEventCreate
+}
+
+// Save creates the OrderEvent entities in the database.
+func (oecb *OrderEventCreateBulk) Save(ctx context.Context) ([]*OrderEvent, error) {
+	if oecb.err != nil {
+		return nil, oecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OrderEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OrderEventCreateBulk) SaveX(ctx context.Context) []*OrderEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OrderEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OrderEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -21,10 +21,14 @@ type OrderItem struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Reference to the product
 	ProductID uuid.UUID `json:"product_id,omitempty"`
+	// Product name snapshot taken when the order was placed
+	ProductName string `json:"product_name,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
-	// UnitPrice holds the value of the "unit_price" field.
-	UnitPrice float64 `json:"unit_price,omitempty"`
+	// Unit price in minor units (cents)
+	UnitPriceCents int64 `json:"unit_price_cents,omitempty"`
+	// Zero-based place of the item in the order as it was submitted
+	Position int `json:"position,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,7 +37,6 @@ type OrderItem struct {
 	// The values are being populated by the OrderItemQuery when eager-loading is set.
 	Edges             OrderItemEdges `json:"edges"`
 	order_order_items *uuid.UUID
-	order_item_order  *uuid.UUID
 	selectValues      sql.SelectValues
 }
 
@@ -41,9 +44,11 @@ type OrderItem struct {
 type OrderItemEdges struct {
 	// Order holds the value of the order edge.
 	Order *Order `json:"order,omitempty"`
+	// ShipmentItems holds the value of the shipment_items edge.
+	ShipmentItems []*ShipmentItem `json:"shipment_items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // OrderOrErr returns the Order value or an error if the edge
@@ -57,23 +62,30 @@ func (e OrderItemEdges) OrderOrErr() (*Order, error) {
 	return nil, &NotLoadedError{edge: "order"}
 }
 
+// ShipmentItemsOrErr returns the ShipmentItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderItemEdges) ShipmentItemsOrErr() ([]*ShipmentItem, error) {
+	if e.loadedTypes[1] {
+		return e.ShipmentItems, nil
+	}
+	return nil, &NotLoadedError{edge: "shipment_items"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OrderItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orderitem.FieldUnitPrice:
-			values[i] = new(sql.NullFloat64)
-		case orderitem.FieldQuantity:
+		case orderitem.FieldQuantity, orderitem.FieldUnitPriceCents, orderitem.FieldPosition:
 			values[i] = new(sql.NullInt64)
+		case orderitem.FieldProductName:
+			values[i] = new(sql.NullString)
 		case orderitem.FieldCreatedAt, orderitem.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case orderitem.FieldID, orderitem.FieldProductID:
 			values[i] = new(uuid.UUID)
 		case orderitem.ForeignKeys[0]: // order_order_items
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case orderitem.ForeignKeys[1]: // order_item_order
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,17 +113,29 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				oi.ProductID = *value
 			}
+		case orderitem.FieldProductName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_name", values[i])
+			} else if value.Valid {
+				oi.ProductName = value.String
+			}
 		case orderitem.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
 			} else if value.Valid {
 				oi.Quantity = int(value.Int64)
 			}
-		case orderitem.FieldUnitPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+		case orderitem.FieldUnitPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price_cents", values[i])
+			} else if value.Valid {
+				oi.UnitPriceCents = value.Int64
+			}
+		case orderitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
 			} else if value.Valid {
-				oi.UnitPrice = value.Float64
+				oi.Position = int(value.Int64)
 			}
 		case orderitem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -132,13 +156,6 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 				oi.order_order_items = new(uuid.UUID)
 				*oi.order_order_items = *value.S.(*uuid.UUID)
 			}
-		case orderitem.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field order_item_order", values[i])
-			} else if value.Valid {
-				oi.order_item_order = new(uuid.UUID)
-				*oi.order_item_order = *value.S.(*uuid.UUID)
-			}
 		default:
 			oi.selectValues.Set(columns[i], values[i])
 		}
@@ -157,6 +174,11 @@ func (oi *OrderItem) QueryOrder() *OrderQuery {
 	return NewOrderItemClient(oi.config).QueryOrder(oi)
 }
 
+// QueryShipmentItems queries the "shipment_items" edge of the OrderItem entity.
+func (oi *OrderItem) QueryShipmentItems() *ShipmentItemQuery {
+	return NewOrderItemClient(oi.config).QueryShipmentItems(oi)
+}
+
 // Update returns a builder for updating this OrderItem.
 // Note that you need to call OrderItem.Unwrap() before calling this method if this OrderItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -183,11 +205,17 @@ func (oi *OrderItem) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", oi.ProductID))
 	builder.WriteString(", ")
+	builder.WriteString("product_name=")
+	builder.WriteString(oi.ProductName)
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", oi.Quantity))
 	builder.WriteString(", ")
-	builder.WriteString("unit_price=")
-	builder.WriteString(fmt.Sprintf("%v", oi.UnitPrice))
+	builder.WriteString("unit_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", oi.UnitPriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", oi.Position))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(oi.CreatedAt.Format(time.ANSIC))
@@ -17,16 +17,22 @@ const (
 	FieldID = "id"
 	// FieldProductID holds the string denoting the product_id field in the database.
 	FieldProductID = "product_id"
+	// FieldProductName holds the string denoting the product_name field in the database.
+	FieldProductName = "product_name"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
-	// FieldUnitPrice holds the string denoting the unit_price field in the database.
-	FieldUnitPrice = "unit_price"
+	// FieldUnitPriceCents holds the string denoting the unit_price_cents field in the database.
+	FieldUnitPriceCents = "unit_price_cents"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeOrder holds the string denoting the order edge name in mutations.
 	EdgeOrder = "order"
+	// EdgeShipmentItems holds the string denoting the shipment_items edge name in mutations.
+	EdgeShipmentItems = "shipment_items"
 	// Table holds the table name of the orderitem in the database.
 	Table = "order_items"
 	// OrderTable is the table that holds the order relation/edge.
@@ -35,15 +41,24 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "order" package.
 	OrderInverseTable = "orders"
 	// OrderColumn is the table column denoting the order relation/edge.
-	OrderColumn = "order_item_order"
+	OrderColumn = "order_order_items"
+	// ShipmentItemsTable is the table that holds the shipment_items relation/edge.
+	ShipmentItemsTable = "shipment_items"
+	// ShipmentItemsInverseTable is the table name for the ShipmentItem entity.
+	// It exists in this package in order to avoid circular dependency with the "shipmentitem" package.
+	ShipmentItemsInverseTable = "shipment_items"
+	// ShipmentItemsColumn is the table column denoting the shipment_items relation/edge.
+	ShipmentItemsColumn = "order_item_shipment_items"
 )
 
 // Columns holds all SQL columns for orderitem fields.
 var Columns = []string{
 	FieldID,
 	FieldProductID,
+	FieldProductName,
 	FieldQuantity,
-	FieldUnitPrice,
+	FieldUnitPriceCents,
+	FieldPosition,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -52,7 +67,6 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"order_order_items",
-	"order_item_order",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -73,8 +87,12 @@ func ValidColumn(column string) bool {
 var (
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	QuantityValidator func(int) error
-	// UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
-	UnitPriceValidator func(float64) error
+	// UnitPriceCentsValidator is a validator for the "unit_price_cents" field. It is called by the builders before save.
+	UnitPriceCentsValidator func(int64) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -98,14 +116,24 @@ func ByProductID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProductID, opts...).ToFunc()
 }
 
+// ByProductName orders the results by the product_name field.
+func ByProductName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductName, opts...).ToFunc()
+}
+
 // ByQuantity orders the results by the quantity field.
 func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
 }
 
-// ByUnitPrice orders the results by the unit_price field.
-func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+// ByUnitPriceCents orders the results by the unit_price_cents field.
+func ByUnitPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPriceCents, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -124,10 +152,31 @@ func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByShipmentItemsCount orders the results by shipment_items count.
+func ByShipmentItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShipmentItemsStep(), opts...)
+	}
+}
+
+// ByShipmentItems orders the results by shipment_items terms.
+func ByShipmentItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShipmentItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(OrderInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, false, OrderTable, OrderColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
+func newShipmentItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShipmentItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShipmentItemsTable, ShipmentItemsColumn),
 	)
 }
@@ -61,14 +61,24 @@ func ProductID(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldProductID, v))
 }
 
+// ProductName applies equality check predicate on the "product_name" field. It's identical to ProductNameEQ.
+func ProductName(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldProductName, v))
+}
+
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
 func Quantity(v int) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldQuantity, v))
 }
 
-// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
-func UnitPrice(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldEQ(FieldUnitPrice, v))
+// UnitPriceCents applies equality check predicate on the "unit_price_cents" field. It's identical to UnitPriceCentsEQ.
+func UnitPriceCents(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldUnitPriceCents, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldPosition, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -121,6 +131,81 @@ func ProductIDLTE(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldLTE(FieldProductID, v))
 }
 
+// ProductNameEQ applies the EQ predicate on the "product_name" field.
+func ProductNameEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldProductName, v))
+}
+
+// ProductNameNEQ applies the NEQ predicate on the "product_name" field.
+func ProductNameNEQ(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldProductName, v))
+}
+
+// ProductNameIn applies the In predicate on the "product_name" field.
+func ProductNameIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldProductName, vs...))
+}
+
+// ProductNameNotIn applies the NotIn predicate on the "product_name" field.
+func ProductNameNotIn(vs ...string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldProductName, vs...))
+}
+
+// ProductNameGT applies the GT predicate on the "product_name" field.
+func ProductNameGT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldProductName, v))
+}
+
+// ProductNameGTE applies the GTE predicate on the "product_name" field.
+func ProductNameGTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldProductName, v))
+}
+
+// ProductNameLT applies the LT predicate on the "product_name" field.
+func ProductNameLT(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldProductName, v))
+}
+
+// ProductNameLTE applies the LTE predicate on the "product_name" field.
+func ProductNameLTE(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldProductName, v))
+}
+
+// ProductNameContains applies the Contains predicate on the "product_name" field.
+func ProductNameContains(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContains(FieldProductName, v))
+}
+
+// ProductNameHasPrefix applies the HasPrefix predicate on the "product_name" field.
+func ProductNameHasPrefix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasPrefix(FieldProductName, v))
+}
+
+// ProductNameHasSuffix applies the HasSuffix predicate on the "product_name" field.
+func ProductNameHasSuffix(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldHasSuffix(FieldProductName, v))
+}
+
+// ProductNameIsNil applies the IsNil predicate on the "product_name" field.
+func ProductNameIsNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIsNull(FieldProductName))
+}
+
+// ProductNameNotNil applies the NotNil predicate on the "product_name" field.
+func ProductNameNotNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotNull(FieldProductName))
+}
+
+// ProductNameEqualFold applies the EqualFold predicate on the "product_name" field.
+func ProductNameEqualFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEqualFold(FieldProductName, v))
+}
+
+// ProductNameContainsFold applies the ContainsFold predicate on the "product_name" field.
+func ProductNameContainsFold(v string) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldContainsFold(FieldProductName, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldQuantity, v))
@@ -161,44 +246,84 @@ func QuantityLTE(v int) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldLTE(FieldQuantity, v))
 }
 
-// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
-func UnitPriceEQ(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldEQ(FieldUnitPrice, v))
+// UnitPriceCentsEQ applies the EQ predicate on the "unit_price_cents" field.
+func UnitPriceCentsEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldUnitPriceCents, v))
 }
 
-// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
-func UnitPriceNEQ(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldNEQ(FieldUnitPrice, v))
+// UnitPriceCentsNEQ applies the NEQ predicate on the "unit_price_cents" field.
+func UnitPriceCentsNEQ(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldUnitPriceCents, v))
 }
 
-// UnitPriceIn applies the In predicate on the "unit_price" field.
-func UnitPriceIn(vs ...float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldIn(FieldUnitPrice, vs...))
+// UnitPriceCentsIn applies the In predicate on the "unit_price_cents" field.
+func UnitPriceCentsIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldUnitPriceCents, vs...))
 }
 
-// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
-func UnitPriceNotIn(vs ...float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldNotIn(FieldUnitPrice, vs...))
+// UnitPriceCentsNotIn applies the NotIn predicate on the "unit_price_cents" field.
+func UnitPriceCentsNotIn(vs ...int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldUnitPriceCents, vs...))
 }
 
-// UnitPriceGT applies the GT predicate on the "unit_price" field.
-func UnitPriceGT(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldGT(FieldUnitPrice, v))
+// UnitPriceCentsGT applies the GT predicate on the "unit_price_cents" field.
+func UnitPriceCentsGT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldUnitPriceCents, v))
 }
 
-// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
-func UnitPriceGTE(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldGTE(FieldUnitPrice, v))
+// UnitPriceCentsGTE applies the GTE predicate on the "unit_price_cents" field.
+func UnitPriceCentsGTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldUnitPriceCents, v))
 }
 
-// UnitPriceLT applies the LT predicate on the "unit_price" field.
-func UnitPriceLT(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldLT(FieldUnitPrice, v))
+// UnitPriceCentsLT applies the LT predicate on the "unit_price_cents" field.
+func UnitPriceCentsLT(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldUnitPriceCents, v))
 }
 
-// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
-func UnitPriceLTE(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldLTE(FieldUnitPrice, v))
+// UnitPriceCentsLTE applies the LTE predicate on the "unit_price_cents" field.
+func UnitPriceCentsLTE(v int64) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldUnitPriceCents, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldPosition, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -286,7 +411,7 @@ func HasOrder() predicate.OrderItem {
 	return predicate.OrderItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, OrderTable, OrderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -304,6 +429,29 @@ func HasOrderWith(preds ...predicate.Order) predicate.OrderItem {
 	})
 }
 
+// HasShipmentItems applies the HasEdge predicate on the "shipment_items" edge.
+func HasShipmentItems() predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShipmentItemsTable, ShipmentItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShipmentItemsWith applies the HasEdge predicate on the "shipment_items" edge with a given conditions (other predicates).
+func HasShipmentItemsWith(preds ...predicate.ShipmentItem) predicate.OrderItem {
+	return predicate.OrderItem(func(s *sql.Selector) {
+		step := newShipmentItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrderItem) predicate.OrderItem {
 	return predicate.OrderItem(sql.AndPredicates(predicates...))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"orders/ent/order"
 	"orders/ent/orderitem"
+	"orders/ent/shipmentitem"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,15 +29,43 @@ func (oic *OrderItemCreate) SetProductID(u uuid.UUID) *OrderItemCreate {
 	return oic
 }
 
+// SetProductName sets the "product_name" field.
+func (oic *OrderItemCreate) SetProductName(s string) *OrderItemCreate {
+	oic.mutation.SetProductName(s)
+	return oic
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableProductName(s *string) *OrderItemCreate {
+	if s != nil {
+		oic.SetProductName(*s)
+	}
+	return oic
+}
+
 // SetQuantity sets the "quantity" field.
 func (oic *OrderItemCreate) SetQuantity(i int) *OrderItemCreate {
 	oic.mutation.SetQuantity(i)
 	return oic
 }
 
-// SetUnitPrice sets the "unit_price" field.
-func (oic *OrderItemCreate) SetUnitPrice(f float64) *OrderItemCreate {
-	oic.mutation.SetUnitPrice(f)
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (oic *OrderItemCreate) SetUnitPriceCents(i int64) *OrderItemCreate {
+	oic.mutation.SetUnitPriceCents(i)
+	return oic
+}
+
+// SetPosition sets the "position" field.
+func (oic *OrderItemCreate) SetPosition(i int) *OrderItemCreate {
+	oic.mutation.SetPosition(i)
+	return oic
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillablePosition(i *int) *OrderItemCreate {
+	if i != nil {
+		oic.SetPosition(*i)
+	}
 	return oic
 }
 
@@ -93,6 +122,21 @@ func (oic *OrderItemCreate) SetOrder(o *Order) *OrderItemCreate {
 	return oic.SetOrderID(o.ID)
 }
 
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (oic *OrderItemCreate) AddShipmentItemIDs(ids ...uuid.UUID) *OrderItemCreate {
+	oic.mutation.AddShipmentItemIDs(ids...)
+	return oic
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (oic *OrderItemCreate) AddShipmentItems(s ...*ShipmentItem) *OrderItemCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oic.AddShipmentItemIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oic *OrderItemCreate) Mutation() *OrderItemMutation {
 	return oic.mutation
@@ -128,6 +172,10 @@ func (oic *OrderItemCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (oic *OrderItemCreate) defaults() {
+	if _, ok := oic.mutation.Position(); !ok {
+		v := orderitem.DefaultPosition
+		oic.mutation.SetPosition(v)
+	}
 	if _, ok := oic.mutation.CreatedAt(); !ok {
 		v := orderitem.DefaultCreatedAt()
 		oic.mutation.SetCreatedAt(v)
@@ -155,12 +203,20 @@ func (oic *OrderItemCreate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.quantity": %w`, err)}
 		}
 	}
-	if _, ok := oic.mutation.UnitPrice(); !ok {
-		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "OrderItem.unit_price"`)}
+	if _, ok := oic.mutation.UnitPriceCents(); !ok {
+		return &ValidationError{Name: "unit_price_cents", err: errors.New(`ent: missing required field "OrderItem.unit_price_cents"`)}
+	}
+	if v, ok := oic.mutation.UnitPriceCents(); ok {
+		if err := orderitem.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price_cents": %w`, err)}
+		}
+	}
+	if _, ok := oic.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "OrderItem.position"`)}
 	}
-	if v, ok := oic.mutation.UnitPrice(); ok {
-		if err := orderitem.UnitPriceValidator(v); err != nil {
-			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price": %w`, err)}
+	if v, ok := oic.mutation.Position(); ok {
+		if err := orderitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "OrderItem.position": %w`, err)}
 		}
 	}
 	if _, ok := oic.mutation.CreatedAt(); !ok {
@@ -211,13 +267,21 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 		_node.ProductID = value
 	}
+	if value, ok := oic.mutation.ProductName(); ok {
+		_spec.SetField(orderitem.FieldProductName, field.TypeString, value)
+		_node.ProductName = value
+	}
 	if value, ok := oic.mutation.Quantity(); ok {
 		_spec.SetField(orderitem.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
 	}
-	if value, ok := oic.mutation.UnitPrice(); ok {
-		_spec.SetField(orderitem.FieldUnitPrice, field.TypeFloat64, value)
-		_node.UnitPrice = value
+	if value, ok := oic.mutation.UnitPriceCents(); ok {
+		_spec.SetField(orderitem.FieldUnitPriceCents, field.TypeInt64, value)
+		_node.UnitPriceCents = value
+	}
+	if value, ok := oic.mutation.Position(); ok {
+		_spec.SetField(orderitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
 	}
 	if value, ok := oic.mutation.CreatedAt(); ok {
 		_spec.SetField(orderitem.FieldCreatedAt, field.TypeTime, value)
@@ -230,7 +294,7 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 	if nodes := oic.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   orderitem.OrderTable,
 			Columns: []string{orderitem.OrderColumn},
 			Bidi:    false,
@@ -241,7 +305,23 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.order_item_order = &nodes[0]
+		_node.order_order_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oic.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -4,11 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"orders/ent/order"
 	"orders/ent/orderitem"
 	"orders/ent/predicate"
+	"orders/ent/shipmentitem"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,12 +22,13 @@ import (
 // OrderItemQuery is the builder for querying OrderItem entities.
 type OrderItemQuery struct {
 	config
-	ctx        *QueryContext
-	order      []orderitem.OrderOption
-	inters     []Interceptor
-	predicates []predicate.OrderItem
-	withOrder  *OrderQuery
-	withFKs    bool
+	ctx               *QueryContext
+	order             []orderitem.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.OrderItem
+	withOrder         *OrderQuery
+	withShipmentItems *ShipmentItemQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -76,7 +79,29 @@ func (oiq *OrderItemQuery) QueryOrder() *OrderQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
 			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, orderitem.OrderTable, orderitem.OrderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.OrderTable, orderitem.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShipmentItems chains the current query on the "shipment_items" edge.
+func (oiq *OrderItemQuery) QueryShipmentItems() *ShipmentItemQuery {
+	query := (&ShipmentItemClient{config: oiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
+			sqlgraph.To(shipmentitem.Table, shipmentitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderitem.ShipmentItemsTable, orderitem.ShipmentItemsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(oiq.driver.Dialect(), step)
 		return fromU, nil
@@ -271,12 +296,13 @@ func (oiq *OrderItemQuery) Clone() *OrderItemQuery {
 		return nil
 	}
 	return &OrderItemQuery{
-		config:     oiq.config,
-		ctx:        oiq.ctx.Clone(),
-		order:      append([]orderitem.OrderOption{}, oiq.order...),
-		inters:     append([]Interceptor{}, oiq.inters...),
-		predicates: append([]predicate.OrderItem{}, oiq.predicates...),
-		withOrder:  oiq.withOrder.Clone(),
+		config:            oiq.config,
+		ctx:               oiq.ctx.Clone(),
+		order:             append([]orderitem.OrderOption{}, oiq.order...),
+		inters:            append([]Interceptor{}, oiq.inters...),
+		predicates:        append([]predicate.OrderItem{}, oiq.predicates...),
+		withOrder:         oiq.withOrder.Clone(),
+		withShipmentItems: oiq.withShipmentItems.Clone(),
 		// clone intermediate query.
 		sql:  oiq.sql.Clone(),
 		path: oiq.path,
@@ -294,6 +320,17 @@ func (oiq *OrderItemQuery) WithOrder(opts ...func(*OrderQuery)) *OrderItemQuery
 	return oiq
 }
 
+// WithShipmentItems tells the query-builder to eager-load the nodes that are connected to
+// the "shipment_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (oiq *OrderItemQuery) WithShipmentItems(opts ...func(*ShipmentItemQuery)) *OrderItemQuery {
+	query := (&ShipmentItemClient{config: oiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oiq.withShipmentItems = query
+	return oiq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +410,9 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 		nodes       = []*OrderItem{}
 		withFKs     = oiq.withFKs
 		_spec       = oiq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			oiq.withOrder != nil,
+			oiq.withShipmentItems != nil,
 		}
 	)
 	if oiq.withOrder != nil {
@@ -407,6 +445,13 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 			return nil, err
 		}
 	}
+	if query := oiq.withShipmentItems; query != nil {
+		if err := oiq.loadShipmentItems(ctx, query, nodes,
+			func(n *OrderItem) { n.Edges.ShipmentItems = []*ShipmentItem{} },
+			func(n *OrderItem, e *ShipmentItem) { n.Edges.ShipmentItems = append(n.Edges.ShipmentItems, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -414,10 +459,10 @@ func (oiq *OrderItemQuery) loadOrder(ctx context.Context, query *OrderQuery, nod
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*OrderItem)
 	for i := range nodes {
-		if nodes[i].order_item_order == nil {
+		if nodes[i].order_order_items == nil {
 			continue
 		}
-		fk := *nodes[i].order_item_order
+		fk := *nodes[i].order_order_items
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -434,7 +479,7 @@ func (oiq *OrderItemQuery) loadOrder(ctx context.Context, query *OrderQuery, nod
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "order_item_order" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "order_order_items" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -442,6 +487,37 @@ func (oiq *OrderItemQuery) loadOrder(ctx context.Context, query *OrderQuery, nod
 	}
 	return nil
 }
+func (oiq *OrderItemQuery) loadShipmentItems(ctx context.Context, query *ShipmentItemQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *ShipmentItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*OrderItem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ShipmentItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(orderitem.ShipmentItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.order_item_shipment_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "order_item_shipment_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_item_shipment_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (oiq *OrderItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oiq.querySpec()
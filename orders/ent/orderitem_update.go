@@ -9,6 +9,7 @@ import (
 	"orders/ent/order"
 	"orders/ent/orderitem"
 	"orders/ent/predicate"
+	"orders/ent/shipmentitem"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -44,6 +45,26 @@ func (oiu *OrderItemUpdate) SetNillableProductID(u *uuid.UUID) *OrderItemUpdate
 	return oiu
 }
 
+// SetProductName sets the "product_name" field.
+func (oiu *OrderItemUpdate) SetProductName(s string) *OrderItemUpdate {
+	oiu.mutation.SetProductName(s)
+	return oiu
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableProductName(s *string) *OrderItemUpdate {
+	if s != nil {
+		oiu.SetProductName(*s)
+	}
+	return oiu
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (oiu *OrderItemUpdate) ClearProductName() *OrderItemUpdate {
+	oiu.mutation.ClearProductName()
+	return oiu
+}
+
 // SetQuantity sets the "quantity" field.
 func (oiu *OrderItemUpdate) SetQuantity(i int) *OrderItemUpdate {
 	oiu.mutation.ResetQuantity()
@@ -65,24 +86,45 @@ func (oiu *OrderItemUpdate) AddQuantity(i int) *OrderItemUpdate {
 	return oiu
 }
 
-// SetUnitPrice sets the "unit_price" field.
-func (oiu *OrderItemUpdate) SetUnitPrice(f float64) *OrderItemUpdate {
-	oiu.mutation.ResetUnitPrice()
-	oiu.mutation.SetUnitPrice(f)
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (oiu *OrderItemUpdate) SetUnitPriceCents(i int64) *OrderItemUpdate {
+	oiu.mutation.ResetUnitPriceCents()
+	oiu.mutation.SetUnitPriceCents(i)
+	return oiu
+}
+
+// SetNillableUnitPriceCents sets the "unit_price_cents" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableUnitPriceCents(i *int64) *OrderItemUpdate {
+	if i != nil {
+		oiu.SetUnitPriceCents(*i)
+	}
+	return oiu
+}
+
+// AddUnitPriceCents adds i to the "unit_price_cents" field.
+func (oiu *OrderItemUpdate) AddUnitPriceCents(i int64) *OrderItemUpdate {
+	oiu.mutation.AddUnitPriceCents(i)
+	return oiu
+}
+
+// SetPosition sets the "position" field.
+func (oiu *OrderItemUpdate) SetPosition(i int) *OrderItemUpdate {
+	oiu.mutation.ResetPosition()
+	oiu.mutation.SetPosition(i)
 	return oiu
 }
 
-// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
-func (oiu *OrderItemUpdate) SetNillableUnitPrice(f *float64) *OrderItemUpdate {
-	if f != nil {
-		oiu.SetUnitPrice(*f)
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillablePosition(i *int) *OrderItemUpdate {
+	if i != nil {
+		oiu.SetPosition(*i)
 	}
 	return oiu
 }
 
-// AddUnitPrice adds f to the "unit_price" field.
-func (oiu *OrderItemUpdate) AddUnitPrice(f float64) *OrderItemUpdate {
-	oiu.mutation.AddUnitPrice(f)
+// AddPosition adds i to the "position" field.
+func (oiu *OrderItemUpdate) AddPosition(i int) *OrderItemUpdate {
+	oiu.mutation.AddPosition(i)
 	return oiu
 }
 
@@ -103,6 +145,21 @@ func (oiu *OrderItemUpdate) SetOrder(o *Order) *OrderItemUpdate {
 	return oiu.SetOrderID(o.ID)
 }
 
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (oiu *OrderItemUpdate) AddShipmentItemIDs(ids ...uuid.UUID) *OrderItemUpdate {
+	oiu.mutation.AddShipmentItemIDs(ids...)
+	return oiu
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (oiu *OrderItemUpdate) AddShipmentItems(s ...*ShipmentItem) *OrderItemUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oiu.AddShipmentItemIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oiu *OrderItemUpdate) Mutation() *OrderItemMutation {
 	return oiu.mutation
@@ -114,6 +171,27 @@ func (oiu *OrderItemUpdate) ClearOrder() *OrderItemUpdate {
 	return oiu
 }
 
+// ClearShipmentItems clears all "shipment_items" edges to the ShipmentItem entity.
+func (oiu *OrderItemUpdate) ClearShipmentItems() *OrderItemUpdate {
+	oiu.mutation.ClearShipmentItems()
+	return oiu
+}
+
+// RemoveShipmentItemIDs removes the "shipment_items" edge to ShipmentItem entities by IDs.
+func (oiu *OrderItemUpdate) RemoveShipmentItemIDs(ids ...uuid.UUID) *OrderItemUpdate {
+	oiu.mutation.RemoveShipmentItemIDs(ids...)
+	return oiu
+}
+
+// RemoveShipmentItems removes "shipment_items" edges to ShipmentItem entities.
+func (oiu *OrderItemUpdate) RemoveShipmentItems(s ...*ShipmentItem) *OrderItemUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oiu.RemoveShipmentItemIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (oiu *OrderItemUpdate) Save(ctx context.Context) (int, error) {
 	oiu.defaults()
@@ -157,9 +235,14 @@ func (oiu *OrderItemUpdate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.quantity": %w`, err)}
 		}
 	}
-	if v, ok := oiu.mutation.UnitPrice(); ok {
-		if err := orderitem.UnitPriceValidator(v); err != nil {
-			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price": %w`, err)}
+	if v, ok := oiu.mutation.UnitPriceCents(); ok {
+		if err := orderitem.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price_cents": %w`, err)}
+		}
+	}
+	if v, ok := oiu.mutation.Position(); ok {
+		if err := orderitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "OrderItem.position": %w`, err)}
 		}
 	}
 	if oiu.mutation.OrderCleared() && len(oiu.mutation.OrderIDs()) > 0 {
@@ -183,17 +266,29 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := oiu.mutation.ProductID(); ok {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := oiu.mutation.ProductName(); ok {
+		_spec.SetField(orderitem.FieldProductName, field.TypeString, value)
+	}
+	if oiu.mutation.ProductNameCleared() {
+		_spec.ClearField(orderitem.FieldProductName, field.TypeString)
+	}
 	if value, ok := oiu.mutation.Quantity(); ok {
 		_spec.SetField(orderitem.FieldQuantity, field.TypeInt, value)
 	}
 	if value, ok := oiu.mutation.AddedQuantity(); ok {
 		_spec.AddField(orderitem.FieldQuantity, field.TypeInt, value)
 	}
-	if value, ok := oiu.mutation.UnitPrice(); ok {
-		_spec.SetField(orderitem.FieldUnitPrice, field.TypeFloat64, value)
+	if value, ok := oiu.mutation.UnitPriceCents(); ok {
+		_spec.SetField(orderitem.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := oiu.mutation.AddedUnitPriceCents(); ok {
+		_spec.AddField(orderitem.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := oiu.mutation.Position(); ok {
+		_spec.SetField(orderitem.FieldPosition, field.TypeInt, value)
 	}
-	if value, ok := oiu.mutation.AddedUnitPrice(); ok {
-		_spec.AddField(orderitem.FieldUnitPrice, field.TypeFloat64, value)
+	if value, ok := oiu.mutation.AddedPosition(); ok {
+		_spec.AddField(orderitem.FieldPosition, field.TypeInt, value)
 	}
 	if value, ok := oiu.mutation.UpdatedAt(); ok {
 		_spec.SetField(orderitem.FieldUpdatedAt, field.TypeTime, value)
@@ -201,7 +296,7 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if oiu.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   orderitem.OrderTable,
 			Columns: []string{orderitem.OrderColumn},
 			Bidi:    false,
@@ -214,7 +309,7 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := oiu.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   orderitem.OrderTable,
 			Columns: []string{orderitem.OrderColumn},
 			Bidi:    false,
@@ -227,6 +322,51 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if oiu.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiu.mutation.RemovedShipmentItemsIDs(); len(nodes) > 0 && !oiu.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiu.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, oiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{orderitem.Label}
@@ -261,6 +401,26 @@ func (oiuo *OrderItemUpdateOne) SetNillableProductID(u *uuid.UUID) *OrderItemUpd
 	return oiuo
 }
 
+// SetProductName sets the "product_name" field.
+func (oiuo *OrderItemUpdateOne) SetProductName(s string) *OrderItemUpdateOne {
+	oiuo.mutation.SetProductName(s)
+	return oiuo
+}
+
+// SetNillableProductName sets the "product_name" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableProductName(s *string) *OrderItemUpdateOne {
+	if s != nil {
+		oiuo.SetProductName(*s)
+	}
+	return oiuo
+}
+
+// ClearProductName clears the value of the "product_name" field.
+func (oiuo *OrderItemUpdateOne) ClearProductName() *OrderItemUpdateOne {
+	oiuo.mutation.ClearProductName()
+	return oiuo
+}
+
 // SetQuantity sets the "quantity" field.
 func (oiuo *OrderItemUpdateOne) SetQuantity(i int) *OrderItemUpdateOne {
 	oiuo.mutation.ResetQuantity()
@@ -282,24 +442,45 @@ func (oiuo *OrderItemUpdateOne) AddQuantity(i int) *OrderItemUpdateOne {
 	return oiuo
 }
 
-// SetUnitPrice sets the "unit_price" field.
-func (oiuo *OrderItemUpdateOne) SetUnitPrice(f float64) *OrderItemUpdateOne {
-	oiuo.mutation.ResetUnitPrice()
-	oiuo.mutation.SetUnitPrice(f)
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (oiuo *OrderItemUpdateOne) SetUnitPriceCents(i int64) *OrderItemUpdateOne {
+	oiuo.mutation.ResetUnitPriceCents()
+	oiuo.mutation.SetUnitPriceCents(i)
+	return oiuo
+}
+
+// SetNillableUnitPriceCents sets the "unit_price_cents" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableUnitPriceCents(i *int64) *OrderItemUpdateOne {
+	if i != nil {
+		oiuo.SetUnitPriceCents(*i)
+	}
+	return oiuo
+}
+
+// AddUnitPriceCents adds i to the "unit_price_cents" field.
+func (oiuo *OrderItemUpdateOne) AddUnitPriceCents(i int64) *OrderItemUpdateOne {
+	oiuo.mutation.AddUnitPriceCents(i)
+	return oiuo
+}
+
+// SetPosition sets the "position" field.
+func (oiuo *OrderItemUpdateOne) SetPosition(i int) *OrderItemUpdateOne {
+	oiuo.mutation.ResetPosition()
+	oiuo.mutation.SetPosition(i)
 	return oiuo
 }
 
-// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
-func (oiuo *OrderItemUpdateOne) SetNillableUnitPrice(f *float64) *OrderItemUpdateOne {
-	if f != nil {
-		oiuo.SetUnitPrice(*f)
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillablePosition(i *int) *OrderItemUpdateOne {
+	if i != nil {
+		oiuo.SetPosition(*i)
 	}
 	return oiuo
 }
 
-// AddUnitPrice adds f to the "unit_price" field.
-func (oiuo *OrderItemUpdateOne) AddUnitPrice(f float64) *OrderItemUpdateOne {
-	oiuo.mutation.AddUnitPrice(f)
+// AddPosition adds i to the "position" field.
+func (oiuo *OrderItemUpdateOne) AddPosition(i int) *OrderItemUpdateOne {
+	oiuo.mutation.AddPosition(i)
 	return oiuo
 }
 
@@ -320,6 +501,21 @@ func (oiuo *OrderItemUpdateOne) SetOrder(o *Order) *OrderItemUpdateOne {
 	return oiuo.SetOrderID(o.ID)
 }
 
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (oiuo *OrderItemUpdateOne) AddShipmentItemIDs(ids ...uuid.UUID) *OrderItemUpdateOne {
+	oiuo.mutation.AddShipmentItemIDs(ids...)
+	return oiuo
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (oiuo *OrderItemUpdateOne) AddShipmentItems(s ...*ShipmentItem) *OrderItemUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oiuo.AddShipmentItemIDs(ids...)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oiuo *OrderItemUpdateOne) Mutation() *OrderItemMutation {
 	return oiuo.mutation
@@ -331,6 +527,27 @@ func (oiuo *OrderItemUpdateOne) ClearOrder() *OrderItemUpdateOne {
 	return oiuo
 }
 
+// ClearShipmentItems clears all "shipment_items" edges to the ShipmentItem entity.
+func (oiuo *OrderItemUpdateOne) ClearShipmentItems() *OrderItemUpdateOne {
+	oiuo.mutation.ClearShipmentItems()
+	return oiuo
+}
+
+// RemoveShipmentItemIDs removes the "shipment_items" edge to ShipmentItem entities by IDs.
+func (oiuo *OrderItemUpdateOne) RemoveShipmentItemIDs(ids ...uuid.UUID) *OrderItemUpdateOne {
+	oiuo.mutation.RemoveShipmentItemIDs(ids...)
+	return oiuo
+}
+
+// RemoveShipmentItems removes "shipment_items" edges to ShipmentItem entities.
+func (oiuo *OrderItemUpdateOne) RemoveShipmentItems(s ...*ShipmentItem) *OrderItemUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oiuo.RemoveShipmentItemIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderItemUpdate builder.
 func (oiuo *OrderItemUpdateOne) Where(ps ...predicate.OrderItem) *OrderItemUpdateOne {
 	oiuo.mutation.Where(ps...)
@@ -387,9 +604,14 @@ func (oiuo *OrderItemUpdateOne) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "OrderItem.quantity": %w`, err)}
 		}
 	}
-	if v, ok := oiuo.mutation.UnitPrice(); ok {
-		if err := orderitem.UnitPriceValidator(v); err != nil {
-			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price": %w`, err)}
+	if v, ok := oiuo.mutation.UnitPriceCents(); ok {
+		if err := orderitem.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "OrderItem.unit_price_cents": %w`, err)}
+		}
+	}
+	if v, ok := oiuo.mutation.Position(); ok {
+		if err := orderitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "OrderItem.position": %w`, err)}
 		}
 	}
 	if oiuo.mutation.OrderCleared() && len(oiuo.mutation.OrderIDs()) > 0 {
@@ -430,17 +652,29 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 	if value, ok := oiuo.mutation.ProductID(); ok {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := oiuo.mutation.ProductName(); ok {
+		_spec.SetField(orderitem.FieldProductName, field.TypeString, value)
+	}
+	if oiuo.mutation.ProductNameCleared() {
+		_spec.ClearField(orderitem.FieldProductName, field.TypeString)
+	}
 	if value, ok := oiuo.mutation.Quantity(); ok {
 		_spec.SetField(orderitem.FieldQuantity, field.TypeInt, value)
 	}
 	if value, ok := oiuo.mutation.AddedQuantity(); ok {
 		_spec.AddField(orderitem.FieldQuantity, field.TypeInt, value)
 	}
-	if value, ok := oiuo.mutation.UnitPrice(); ok {
-		_spec.SetField(orderitem.FieldUnitPrice, field.TypeFloat64, value)
+	if value, ok := oiuo.mutation.UnitPriceCents(); ok {
+		_spec.SetField(orderitem.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := oiuo.mutation.AddedUnitPriceCents(); ok {
+		_spec.AddField(orderitem.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := oiuo.mutation.Position(); ok {
+		_spec.SetField(orderitem.FieldPosition, field.TypeInt, value)
 	}
-	if value, ok := oiuo.mutation.AddedUnitPrice(); ok {
-		_spec.AddField(orderitem.FieldUnitPrice, field.TypeFloat64, value)
+	if value, ok := oiuo.mutation.AddedPosition(); ok {
+		_spec.AddField(orderitem.FieldPosition, field.TypeInt, value)
 	}
 	if value, ok := oiuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(orderitem.FieldUpdatedAt, field.TypeTime, value)
@@ -448,7 +682,7 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 	if oiuo.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   orderitem.OrderTable,
 			Columns: []string{orderitem.OrderColumn},
 			Bidi:    false,
@@ -461,7 +695,7 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 	if nodes := oiuo.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   orderitem.OrderTable,
 			Columns: []string{orderitem.OrderColumn},
 			Bidi:    false,
@@ -474,6 +708,51 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if oiuo.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiuo.mutation.RemovedShipmentItemsIDs(); len(nodes) > 0 && !oiuo.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiuo.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderitem.ShipmentItemsTable,
+			Columns: []string{orderitem.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OrderItem{config: oiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
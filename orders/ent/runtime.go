@@ -4,8 +4,12 @@ package ent
 
 import (
 	"orders/ent/order"
+	"orders/ent/orderevent"
 	"orders/ent/orderitem"
 	"orders/ent/schema"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+	"orders/ent/subscription"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,40 +21,72 @@ import (
 func init() {
 	orderFields := schema.Order{}.Fields()
 	_ = orderFields
-	// orderDescTotalAmount is the schema descriptor for total_amount field.
-	orderDescTotalAmount := orderFields[2].Descriptor()
-	// order.TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
-	order.TotalAmountValidator = orderDescTotalAmount.Validators[0].(func(float64) error)
+	// orderDescTotalAmountCents is the schema descriptor for total_amount_cents field.
+	orderDescTotalAmountCents := orderFields[2].Descriptor()
+	// order.TotalAmountCentsValidator is a validator for the "total_amount_cents" field. It is called by the builders before save.
+	order.TotalAmountCentsValidator = orderDescTotalAmountCents.Validators[0].(func(int64) error)
+	// orderDescCurrency is the schema descriptor for currency field.
+	orderDescCurrency := orderFields[3].Descriptor()
+	// order.DefaultCurrency holds the default value on creation for the currency field.
+	order.DefaultCurrency = orderDescCurrency.Default.(string)
+	// order.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	order.CurrencyValidator = orderDescCurrency.Validators[0].(func(string) error)
 	// orderDescCreatedAt is the schema descriptor for created_at field.
-	orderDescCreatedAt := orderFields[4].Descriptor()
+	orderDescCreatedAt := orderFields[6].Descriptor()
 	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
 	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
 	// orderDescUpdatedAt is the schema descriptor for updated_at field.
-	orderDescUpdatedAt := orderFields[5].Descriptor()
+	orderDescUpdatedAt := orderFields[7].Descriptor()
 	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
 	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderDescFraudHold is the schema descriptor for fraud_hold field.
+	orderDescFraudHold := orderFields[9].Descriptor()
+	// order.DefaultFraudHold holds the default value on creation for the fraud_hold field.
+	order.DefaultFraudHold = orderDescFraudHold.Default.(bool)
+	// orderDescDiscountCents is the schema descriptor for discount_cents field.
+	orderDescDiscountCents := orderFields[12].Descriptor()
+	// order.DefaultDiscountCents holds the default value on creation for the discount_cents field.
+	order.DefaultDiscountCents = orderDescDiscountCents.Default.(int64)
+	// order.DiscountCentsValidator is a validator for the "discount_cents" field. It is called by the builders before save.
+	order.DiscountCentsValidator = orderDescDiscountCents.Validators[0].(func(int64) error)
 	// orderDescID is the schema descriptor for id field.
 	orderDescID := orderFields[0].Descriptor()
 	// order.DefaultID holds the default value on creation for the id field.
 	order.DefaultID = orderDescID.Default.(func() uuid.UUID)
+	ordereventFields := schema.OrderEvent{}.Fields()
+	_ = ordereventFields
+	// ordereventDescCreatedAt is the schema descriptor for created_at field.
+	ordereventDescCreatedAt := ordereventFields[3].Descriptor()
+	// orderevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderevent.DefaultCreatedAt = ordereventDescCreatedAt.Default.(func() time.Time)
+	// ordereventDescID is the schema descriptor for id field.
+	ordereventDescID := ordereventFields[0].Descriptor()
+	// orderevent.DefaultID holds the default value on creation for the id field.
+	orderevent.DefaultID = ordereventDescID.Default.(func() uuid.UUID)
 	orderitemFields := schema.OrderItem{}.Fields()
 	_ = orderitemFields
 	// orderitemDescQuantity is the schema descriptor for quantity field.
-	orderitemDescQuantity := orderitemFields[2].Descriptor()
+	orderitemDescQuantity := orderitemFields[3].Descriptor()
 	// orderitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	orderitem.QuantityValidator = orderitemDescQuantity.Validators[0].(func(int) error)
-	// orderitemDescUnitPrice is the schema descriptor for unit_price field.
-	orderitemDescUnitPrice := orderitemFields[3].Descriptor()
-	// orderitem.UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
-	orderitem.UnitPriceValidator = orderitemDescUnitPrice.Validators[0].(func(float64) error)
+	// orderitemDescUnitPriceCents is the schema descriptor for unit_price_cents field.
+	orderitemDescUnitPriceCents := orderitemFields[4].Descriptor()
+	// orderitem.UnitPriceCentsValidator is a validator for the "unit_price_cents" field. It is called by the builders before save.
+	orderitem.UnitPriceCentsValidator = orderitemDescUnitPriceCents.Validators[0].(func(int64) error)
+	// orderitemDescPosition is the schema descriptor for position field.
+	orderitemDescPosition := orderitemFields[5].Descriptor()
+	// orderitem.DefaultPosition holds the default value on creation for the position field.
+	orderitem.DefaultPosition = orderitemDescPosition.Default.(int)
+	// orderitem.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	orderitem.PositionValidator = orderitemDescPosition.Validators[0].(func(int) error)
 	// orderitemDescCreatedAt is the schema descriptor for created_at field.
-	orderitemDescCreatedAt := orderitemFields[4].Descriptor()
+	orderitemDescCreatedAt := orderitemFields[6].Descriptor()
 	// orderitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	orderitem.DefaultCreatedAt = orderitemDescCreatedAt.Default.(func() time.Time)
 	// orderitemDescUpdatedAt is the schema descriptor for updated_at field.
-	orderitemDescUpdatedAt := orderitemFields[5].Descriptor()
+	orderitemDescUpdatedAt := orderitemFields[7].Descriptor()
 	// orderitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	orderitem.DefaultUpdatedAt = orderitemDescUpdatedAt.Default.(func() time.Time)
 	// orderitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -59,4 +95,66 @@ func init() {
 	orderitemDescID := orderitemFields[0].Descriptor()
 	// orderitem.DefaultID holds the default value on creation for the id field.
 	orderitem.DefaultID = orderitemDescID.Default.(func() uuid.UUID)
+	shipmentFields := schema.Shipment{}.Fields()
+	_ = shipmentFields
+	// shipmentDescCarrier is the schema descriptor for carrier field.
+	shipmentDescCarrier := shipmentFields[1].Descriptor()
+	// shipment.CarrierValidator is a validator for the "carrier" field. It is called by the builders before save.
+	shipment.CarrierValidator = shipmentDescCarrier.Validators[0].(func(string) error)
+	// shipmentDescTrackingNumber is the schema descriptor for tracking_number field.
+	shipmentDescTrackingNumber := shipmentFields[2].Descriptor()
+	// shipment.TrackingNumberValidator is a validator for the "tracking_number" field. It is called by the builders before save.
+	shipment.TrackingNumberValidator = shipmentDescTrackingNumber.Validators[0].(func(string) error)
+	// shipmentDescShippedAt is the schema descriptor for shipped_at field.
+	shipmentDescShippedAt := shipmentFields[3].Descriptor()
+	// shipment.DefaultShippedAt holds the default value on creation for the shipped_at field.
+	shipment.DefaultShippedAt = shipmentDescShippedAt.Default.(func() time.Time)
+	// shipmentDescCreatedAt is the schema descriptor for created_at field.
+	shipmentDescCreatedAt := shipmentFields[5].Descriptor()
+	// shipment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shipment.DefaultCreatedAt = shipmentDescCreatedAt.Default.(func() time.Time)
+	// shipmentDescUpdatedAt is the schema descriptor for updated_at field.
+	shipmentDescUpdatedAt := shipmentFields[6].Descriptor()
+	// shipment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shipment.DefaultUpdatedAt = shipmentDescUpdatedAt.Default.(func() time.Time)
+	// shipment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shipment.UpdateDefaultUpdatedAt = shipmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shipmentDescID is the schema descriptor for id field.
+	shipmentDescID := shipmentFields[0].Descriptor()
+	// shipment.DefaultID holds the default value on creation for the id field.
+	shipment.DefaultID = shipmentDescID.Default.(func() uuid.UUID)
+	shipmentitemFields := schema.ShipmentItem{}.Fields()
+	_ = shipmentitemFields
+	// shipmentitemDescQuantity is the schema descriptor for quantity field.
+	shipmentitemDescQuantity := shipmentitemFields[1].Descriptor()
+	// shipmentitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	shipmentitem.QuantityValidator = shipmentitemDescQuantity.Validators[0].(func(int) error)
+	// shipmentitemDescID is the schema descriptor for id field.
+	shipmentitemDescID := shipmentitemFields[0].Descriptor()
+	// shipmentitem.DefaultID holds the default value on creation for the id field.
+	shipmentitem.DefaultID = shipmentitemDescID.Default.(func() uuid.UUID)
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescActive is the schema descriptor for active field.
+	subscriptionDescActive := subscriptionFields[5].Descriptor()
+	// subscription.DefaultActive holds the default value on creation for the active field.
+	subscription.DefaultActive = subscriptionDescActive.Default.(bool)
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[6].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionFields[7].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionDescFailureCount is the schema descriptor for failure_count field.
+	subscriptionDescFailureCount := subscriptionFields[9].Descriptor()
+	// subscription.DefaultFailureCount holds the default value on creation for the failure_count field.
+	subscription.DefaultFailureCount = subscriptionDescFailureCount.Default.(int)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.DefaultID holds the default value on creation for the id field.
+	subscription.DefaultID = subscriptionDescID.Default.(func() uuid.UUID)
 }
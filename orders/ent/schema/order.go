@@ -4,9 +4,13 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"orders/currency"
 )
 
 // Order holds the schema definition for the Order entity.
@@ -19,10 +23,27 @@ func (Order) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.UUID("user_id", uuid.UUID{}).Comment("Reference to the user who placed the order"),
-		field.Float("total_amount").Positive(),
+		field.Int64("total_amount_cents").Positive().Comment("Total in minor units (cents) so sums are exact"),
+		// Handlers accept only supported currencies; this guards every other write path
+		field.String("currency").Default(currency.Default).Validate(currency.Validate).Comment("ISO 4217 currency code shared by all items"),
 		field.Enum("status").Values("pending", "processing", "shipped", "delivered", "cancelled").Default("pending"),
+		field.String("idempotency_key").Optional().Nillable().Immutable().Comment("Client-supplied key deduplicating retried creates, unique per user"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").Optional().Nillable().Comment("Soft delete timestamp set by ForceDeleteOrder, cleared by RestoreOrder"),
+		field.Bool("fraud_hold").Default(false).Comment("Set by PlaceFraudHold; a held order cannot progress beyond pending"),
+		field.String("hold_reason").Optional().Nillable(),
+		field.String("region").Optional().Nillable().Immutable().Comment("Region of the service that created the order; unset outside multi-region deployments"),
+		field.Int64("discount_cents").Default(0).NonNegative().Immutable().Comment("Coupon discount already taken off total_amount_cents"),
+		field.String("coupon_code").Optional().Nillable().Immutable(),
+		// Shipping address; unset on orders placed without one
+		field.String("shipping_recipient").Optional().Nillable(),
+		field.String("shipping_line1").Optional().Nillable(),
+		field.String("shipping_line2").Optional().Nillable(),
+		field.String("shipping_city").Optional().Nillable(),
+		field.String("shipping_region").Optional().Nillable().Comment("State, province or county of the shipping address"),
+		field.String("shipping_postal_code").Optional().Nillable(),
+		field.String("shipping_country").Optional().Nillable().Comment("ISO 3166-1 alpha-2 country code of the shipping address"),
 	}
 }
 
@@ -31,5 +52,19 @@ func (Order) Edges() []ent.Edge {
 	return []ent.Edge{
 		// An order has many order items
 		edge.To("order_items", OrderItem.Type),
+		// An order ships in one or more shipments
+		edge.To("shipments", Shipment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// An order keeps a history of its status changes
+		edge.To("events", OrderEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Order.
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		// A retried create with the same key resolves to the user's existing order
+		index.Fields("user_id", "idempotency_key").Unique(),
 	}
 }
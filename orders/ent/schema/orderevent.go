@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OrderEvent holds the schema definition for the OrderEvent entity.
+type OrderEvent struct {
+	ent.Schema
+}
+
+// Fields of the OrderEvent.
+func (OrderEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("from_status").Values("pending", "processing", "shipped", "delivered", "cancelled").Optional().Nillable().Immutable().Comment("Status before the change, unset when the order was created"),
+		field.Enum("to_status").Values("pending", "processing", "shipped", "delivered", "cancelled").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the OrderEvent.
+func (OrderEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// An event belongs to one order
+		edge.From("order", Order.Type).Ref("events").Unique().Required().Immutable(),
+	}
+}
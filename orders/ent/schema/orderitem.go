@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,8 +20,10 @@ func (OrderItem) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.UUID("product_id", uuid.UUID{}).Comment("Reference to the product"),
+		field.String("product_name").Optional().Comment("Product name snapshot taken when the order was placed"),
 		field.Int("quantity").Positive(),
-		field.Float("unit_price").Positive(),
+		field.Int64("unit_price_cents").Positive().Comment("Unit price in minor units (cents)"),
+		field.Int("position").NonNegative().Default(0).Comment("Zero-based place of the item in the order as it was submitted"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -30,6 +33,9 @@ func (OrderItem) Fields() []ent.Field {
 func (OrderItem) Edges() []ent.Edge {
 	return []ent.Edge{
 		// An order item belongs to one order
-		edge.To("order", Order.Type).Unique().Required(),
+		edge.From("order", Order.Type).Ref("order_items").Unique().Required(),
+		// An order item may be split across several shipments
+		edge.To("shipment_items", ShipmentItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
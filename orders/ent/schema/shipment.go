@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Shipment holds the schema definition for the Shipment entity.
+type Shipment struct {
+	ent.Schema
+}
+
+// Fields of the Shipment.
+func (Shipment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("carrier").NotEmpty(),
+		field.String("tracking_number").NotEmpty(),
+		field.Time("shipped_at").Default(time.Now).Immutable(),
+		field.Time("delivered_at").Optional().Nillable().Comment("Set once the carrier reports the parcel delivered"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Shipment.
+func (Shipment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// A shipment belongs to one order
+		edge.From("order", Order.Type).Ref("shipments").Unique().Required(),
+		// A shipment carries many shipment items
+		edge.To("shipment_items", ShipmentItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShipmentItem holds the schema definition for the ShipmentItem entity.
+type ShipmentItem struct {
+	ent.Schema
+}
+
+// Fields of the ShipmentItem.
+func (ShipmentItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Int("quantity").Positive().Comment("Units of the order item in this parcel"),
+	}
+}
+
+// Edges of the ShipmentItem.
+func (ShipmentItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		// A shipment item belongs to one shipment
+		edge.From("shipment", Shipment.Type).Ref("shipment_items").Unique().Required(),
+		// A shipment item ships part or all of one order item
+		edge.From("order_item", OrderItem.Type).Ref("shipment_items").Unique().Required(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SubscriptionItem is one line of a recurring order. Its price is the one quoted when
+// subscribing; each run is priced from the catalog instead whenever products are validated.
+type SubscriptionItem struct {
+	ProductID      uuid.UUID `json:"product_id"`
+	Quantity       int       `json:"quantity"`
+	UnitPriceCents int64     `json:"unit_price_cents"`
+	Currency       string    `json:"currency,omitempty"`
+}
+
+// Subscription holds the schema definition for the Subscription entity.
+// The subscription scheduler places an order from its items every interval.
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).Immutable().Comment("Reference to the user the orders are placed for"),
+		field.JSON("items", []SubscriptionItem{}).Comment("Items of each recurring order"),
+		field.Enum("interval").Values("daily", "weekly", "monthly"),
+		field.Time("next_run_at").Comment("When the scheduler places the next order"),
+		field.Bool("active").Default(true).Comment("Cleared while the subscription is paused or once it is cancelled"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("cancelled_at").Optional().Nillable().Comment("Set by CancelSubscription; a cancelled subscription cannot be resumed"),
+		field.Int("failure_count").Default(0).Comment("Consecutive runs whose order could not be placed; reset once one is, or on resume"),
+		field.String("last_failure").Optional().Comment("Why the latest failed run's order could not be placed"),
+		field.Time("last_failed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		// The scheduler looks up active subscriptions that are due
+		index.Fields("active", "next_run_at"),
+	}
+}
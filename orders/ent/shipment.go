@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"orders/ent/order"
+	"orders/ent/shipment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Shipment is the model entity for the Shipment schema.
+type Shipment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Carrier holds the value of the "carrier" field.
+	Carrier string `json:"carrier,omitempty"`
+	// TrackingNumber holds the value of the "tracking_number" field.
+	TrackingNumber string `json:"tracking_number,omitempty"`
+	// ShippedAt holds the value of the "shipped_at" field.
+	ShippedAt time.Time `json:"shipped_at,omitempty"`
+	// Set once the carrier reports the parcel delivered
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShipmentQuery when eager-loading is set.
+	Edges           ShipmentEdges `json:"edges"`
+	order_shipments *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// ShipmentEdges holds the relations/edges for other nodes in the graph.
+type ShipmentEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// ShipmentItems holds the value of the shipment_items edge.
+	ShipmentItems []*ShipmentItem `json:"shipment_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShipmentEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// ShipmentItemsOrErr returns the ShipmentItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShipmentEdges) ShipmentItemsOrErr() ([]*ShipmentItem, error) {
+	if e.loadedTypes[1] {
+		return e.ShipmentItems, nil
+	}
+	return nil, &NotLoadedError{edge: "shipment_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Shipment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shipment.FieldCarrier, shipment.FieldTrackingNumber:
+			values[i] = new(sql.NullString)
+		case shipment.FieldShippedAt, shipment.FieldDeliveredAt, shipment.FieldCreatedAt, shipment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case shipment.FieldID:
+			values[i] = new(uuid.UUID)
+		case shipment.ForeignKeys[0]: // order_shipments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Shipment fields.
+func (s *Shipment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shipment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case shipment.FieldCarrier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field carrier", values[i])
+			} else if value.Valid {
+				s.Carrier = value.String
+			}
+		case shipment.FieldTrackingNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tracking_number", values[i])
+			} else if value.Valid {
+				s.TrackingNumber = value.String
+			}
+		case shipment.FieldShippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field shipped_at", values[i])
+			} else if value.Valid {
+				s.ShippedAt = value.Time
+			}
+		case shipment.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				s.DeliveredAt = new(time.Time)
+				*s.DeliveredAt = value.Time
+			}
+		case shipment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case shipment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case shipment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field order_shipments", values[i])
+			} else if value.Valid {
+				s.order_shipments = new(uuid.UUID)
+				*s.order_shipments = *value.S.(*uuid.UUID)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Shipment.
+// This includes values selected through modifiers, order, etc.
+func (s *Shipment) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the Shipment entity.
+func (s *Shipment) QueryOrder() *OrderQuery {
+	return NewShipmentClient(s.config).QueryOrder(s)
+}
+
+// QueryShipmentItems queries the "shipment_items" edge of the Shipment entity.
+func (s *Shipment) QueryShipmentItems() *ShipmentItemQuery {
+	return NewShipmentClient(s.config).QueryShipmentItems(s)
+}
+
+// Update returns a builder for updating this Shipment.
+// Note that you need to call Shipment.Unwrap() before calling this method if this Shipment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Shipment) Update() *ShipmentUpdateOne {
+	return NewShipmentClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Shipment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Shipment) Unwrap() *Shipment {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Shipment is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Shipment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Shipment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("carrier=")
+	builder.WriteString(s.Carrier)
+	builder.WriteString(", ")
+	builder.WriteString("tracking_number=")
+	builder.WriteString(s.TrackingNumber)
+	builder.WriteString(", ")
+	builder.WriteString("shipped_at=")
+	builder.WriteString(s.ShippedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shipments is a parsable slice of Shipment.
+type Shipments []*Shipment
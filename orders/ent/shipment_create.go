@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/order"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShipmentCreate is the builder for creating a Shipment entity.
+type ShipmentCreate struct {
+	config
+	mutation *ShipmentMutation
+	hooks    []Hook
+}
+
+// SetCarrier sets the "carrier" field.
+func (sc *ShipmentCreate) SetCarrier(s string) *ShipmentCreate {
+	sc.mutation.SetCarrier(s)
+	return sc
+}
+
+// SetTrackingNumber sets the "tracking_number" field.
+func (sc *ShipmentCreate) SetTrackingNumber(s string) *ShipmentCreate {
+	sc.mutation.SetTrackingNumber(s)
+	return sc
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (sc *ShipmentCreate) SetShippedAt(t time.Time) *ShipmentCreate {
+	sc.mutation.SetShippedAt(t)
+	return sc
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (sc *ShipmentCreate) SetNillableShippedAt(t *time.Time) *ShipmentCreate {
+	if t != nil {
+		sc.SetShippedAt(*t)
+	}
+	return sc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (sc *ShipmentCreate) SetDeliveredAt(t time.Time) *ShipmentCreate {
+	sc.mutation.SetDeliveredAt(t)
+	return sc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (sc *ShipmentCreate) SetNillableDeliveredAt(t *time.Time) *ShipmentCreate {
+	if t != nil {
+		sc.SetDeliveredAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShipmentCreate) SetCreatedAt(t time.Time) *ShipmentCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShipmentCreate) SetNillableCreatedAt(t *time.Time) *ShipmentCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ShipmentCreate) SetUpdatedAt(t time.Time) *ShipmentCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ShipmentCreate) SetNillableUpdatedAt(t *time.Time) *ShipmentCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ShipmentCreate) SetID(u uuid.UUID) *ShipmentCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *ShipmentCreate) SetNillableID(u *uuid.UUID) *ShipmentCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (sc *ShipmentCreate) SetOrderID(id uuid.UUID) *ShipmentCreate {
+	sc.mutation.SetOrderID(id)
+	return sc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (sc *ShipmentCreate) SetOrder(o *Order) *ShipmentCreate {
+	return sc.SetOrderID(o.ID)
+}
+
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (sc *ShipmentCreate) AddShipmentItemIDs(ids ...uuid.UUID) *ShipmentCreate {
+	sc.mutation.AddShipmentItemIDs(ids...)
+	return sc
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (sc *ShipmentCreate) AddShipmentItems(s ...*ShipmentItem) *ShipmentCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddShipmentItemIDs(ids...)
+}
+
+// Mutation returns the ShipmentMutation object of the builder.
+func (sc *ShipmentCreate) Mutation() *ShipmentMutation {
+	return sc.mutation
+}
+
+// Save creates the Shipment in the database.
+func (sc *ShipmentCreate) Save(ctx context.Context) (*Shipment, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShipmentCreate) SaveX(ctx context.Context) *Shipment {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShipmentCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShipmentCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShipmentCreate) defaults() {
+	if _, ok := sc.mutation.ShippedAt(); !ok {
+		v := shipment.DefaultShippedAt()
+		sc.mutation.SetShippedAt(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := shipment.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := shipment.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := shipment.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShipmentCreate) check() error {
+	if _, ok := sc.mutation.Carrier(); !ok {
+		return &ValidationError{Name: "carrier", err: errors.New(`ent: missing required field "Shipment.carrier"`)}
+	}
+	if v, ok := sc.mutation.Carrier(); ok {
+		if err := shipment.CarrierValidator(v); err != nil {
+			return &ValidationError{Name: "carrier", err: fmt.Errorf(`ent: validator failed for field "Shipment.carrier": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.TrackingNumber(); !ok {
+		return &ValidationError{Name: "tracking_number", err: errors.New(`ent: missing required field "Shipment.tracking_number"`)}
+	}
+	if v, ok := sc.mutation.TrackingNumber(); ok {
+		if err := shipment.TrackingNumberValidator(v); err != nil {
+			return &ValidationError{Name: "tracking_number", err: fmt.Errorf(`ent: validator failed for field "Shipment.tracking_number": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.ShippedAt(); !ok {
+		return &ValidationError{Name: "shipped_at", err: errors.New(`ent: missing required field "Shipment.shipped_at"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Shipment.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Shipment.updated_at"`)}
+	}
+	if len(sc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "Shipment.order"`)}
+	}
+	return nil
+}
+
+func (sc *ShipmentCreate) sqlSave(ctx context.Context) (*Shipment, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShipmentCreate) createSpec() (*Shipment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Shipment{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(shipment.Table, sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.Carrier(); ok {
+		_spec.SetField(shipment.FieldCarrier, field.TypeString, value)
+		_node.Carrier = value
+	}
+	if value, ok := sc.mutation.TrackingNumber(); ok {
+		_spec.SetField(shipment.FieldTrackingNumber, field.TypeString, value)
+		_node.TrackingNumber = value
+	}
+	if value, ok := sc.mutation.ShippedAt(); ok {
+		_spec.SetField(shipment.FieldShippedAt, field.TypeTime, value)
+		_node.ShippedAt = value
+	}
+	if value, ok := sc.mutation.DeliveredAt(); ok {
+		_spec.SetField(shipment.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(shipment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(shipment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_shipments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShipmentCreateBulk is the builder for creating many Shipment entities in bulk.
+type ShipmentCreateBulk struct {
+	config
+	err      error
+	builders []*ShipmentCreate
+}
+
+// Save creates the Shipment entities in the database.
+func (scb *ShipmentCreateBulk) Save(ctx context.Context) ([]*Shipment, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Shipment, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShipmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShipmentCreateBulk) SaveX(ctx context.Context) []*Shipment {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShipmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShipmentCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/order"
+	"orders/ent/predicate"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShipmentUpdate is the builder for updating Shipment entities.
+type ShipmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShipmentMutation
+}
+
+// Where appends a list predicates to the ShipmentUpdate builder.
+func (su *ShipmentUpdate) Where(ps ...predicate.Shipment) *ShipmentUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetCarrier sets the "carrier" field.
+func (su *ShipmentUpdate) SetCarrier(s string) *ShipmentUpdate {
+	su.mutation.SetCarrier(s)
+	return su
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableCarrier(s *string) *ShipmentUpdate {
+	if s != nil {
+		su.SetCarrier(*s)
+	}
+	return su
+}
+
+// SetTrackingNumber sets the "tracking_number" field.
+func (su *ShipmentUpdate) SetTrackingNumber(s string) *ShipmentUpdate {
+	su.mutation.SetTrackingNumber(s)
+	return su
+}
+
+// SetNillableTrackingNumber sets the "tracking_number" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableTrackingNumber(s *string) *ShipmentUpdate {
+	if s != nil {
+		su.SetTrackingNumber(*s)
+	}
+	return su
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (su *ShipmentUpdate) SetDeliveredAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetDeliveredAt(t)
+	return su
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableDeliveredAt(t *time.Time) *ShipmentUpdate {
+	if t != nil {
+		su.SetDeliveredAt(*t)
+	}
+	return su
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (su *ShipmentUpdate) ClearDeliveredAt() *ShipmentUpdate {
+	su.mutation.ClearDeliveredAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ShipmentUpdate) SetUpdatedAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (su *ShipmentUpdate) SetOrderID(id uuid.UUID) *ShipmentUpdate {
+	su.mutation.SetOrderID(id)
+	return su
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (su *ShipmentUpdate) SetOrder(o *Order) *ShipmentUpdate {
+	return su.SetOrderID(o.ID)
+}
+
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (su *ShipmentUpdate) AddShipmentItemIDs(ids ...uuid.UUID) *ShipmentUpdate {
+	su.mutation.AddShipmentItemIDs(ids...)
+	return su
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (su *ShipmentUpdate) AddShipmentItems(s ...*ShipmentItem) *ShipmentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddShipmentItemIDs(ids...)
+}
+
+// Mutation returns the ShipmentMutation object of the builder.
+func (su *ShipmentUpdate) Mutation() *ShipmentMutation {
+	return su.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (su *ShipmentUpdate) ClearOrder() *ShipmentUpdate {
+	su.mutation.ClearOrder()
+	return su
+}
+
+// ClearShipmentItems clears all "shipment_items" edges to the ShipmentItem entity.
+func (su *ShipmentUpdate) ClearShipmentItems() *ShipmentUpdate {
+	su.mutation.ClearShipmentItems()
+	return su
+}
+
+// RemoveShipmentItemIDs removes the "shipment_items" edge to ShipmentItem entities by IDs.
+func (su *ShipmentUpdate) RemoveShipmentItemIDs(ids ...uuid.UUID) *ShipmentUpdate {
+	su.mutation.RemoveShipmentItemIDs(ids...)
+	return su
+}
+
+// RemoveShipmentItems removes "shipment_items" edges to ShipmentItem entities.
+func (su *ShipmentUpdate) RemoveShipmentItems(s ...*ShipmentItem) *ShipmentUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveShipmentItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShipmentUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShipmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShipmentUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShipmentUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ShipmentUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := shipment.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShipmentUpdate) check() error {
+	if v, ok := su.mutation.Carrier(); ok {
+		if err := shipment.CarrierValidator(v); err != nil {
+			return &ValidationError{Name: "carrier", err: fmt.Errorf(`ent: validator failed for field "Shipment.carrier": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TrackingNumber(); ok {
+		if err := shipment.TrackingNumberValidator(v); err != nil {
+			return &ValidationError{Name: "tracking_number", err: fmt.Errorf(`ent: validator failed for field "Shipment.tracking_number": %w`, err)}
+		}
+	}
+	if su.mutation.OrderCleared() && len(su.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shipment.order"`)
+	}
+	return nil
+}
+
+func (su *ShipmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipment.Table, shipment.Columns, sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Carrier(); ok {
+		_spec.SetField(shipment.FieldCarrier, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TrackingNumber(); ok {
+		_spec.SetField(shipment.FieldTrackingNumber, field.TypeString, value)
+	}
+	if value, ok := su.mutation.DeliveredAt(); ok {
+		_spec.SetField(shipment.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if su.mutation.DeliveredAtCleared() {
+		_spec.ClearField(shipment.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(shipment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedShipmentItemsIDs(); len(nodes) > 0 && !su.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShipmentUpdateOne is the builder for updating a single Shipment entity.
+type ShipmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShipmentMutation
+}
+
+// SetCarrier sets the "carrier" field.
+func (suo *ShipmentUpdateOne) SetCarrier(s string) *ShipmentUpdateOne {
+	suo.mutation.SetCarrier(s)
+	return suo
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableCarrier(s *string) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetCarrier(*s)
+	}
+	return suo
+}
+
+// SetTrackingNumber sets the "tracking_number" field.
+func (suo *ShipmentUpdateOne) SetTrackingNumber(s string) *ShipmentUpdateOne {
+	suo.mutation.SetTrackingNumber(s)
+	return suo
+}
+
+// SetNillableTrackingNumber sets the "tracking_number" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableTrackingNumber(s *string) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetTrackingNumber(*s)
+	}
+	return suo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (suo *ShipmentUpdateOne) SetDeliveredAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetDeliveredAt(t)
+	return suo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableDeliveredAt(t *time.Time) *ShipmentUpdateOne {
+	if t != nil {
+		suo.SetDeliveredAt(*t)
+	}
+	return suo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (suo *ShipmentUpdateOne) ClearDeliveredAt() *ShipmentUpdateOne {
+	suo.mutation.ClearDeliveredAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ShipmentUpdateOne) SetUpdatedAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (suo *ShipmentUpdateOne) SetOrderID(id uuid.UUID) *ShipmentUpdateOne {
+	suo.mutation.SetOrderID(id)
+	return suo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (suo *ShipmentUpdateOne) SetOrder(o *Order) *ShipmentUpdateOne {
+	return suo.SetOrderID(o.ID)
+}
+
+// AddShipmentItemIDs adds the "shipment_items" edge to the ShipmentItem entity by IDs.
+func (suo *ShipmentUpdateOne) AddShipmentItemIDs(ids ...uuid.UUID) *ShipmentUpdateOne {
+	suo.mutation.AddShipmentItemIDs(ids...)
+	return suo
+}
+
+// AddShipmentItems adds the "shipment_items" edges to the ShipmentItem entity.
+func (suo *ShipmentUpdateOne) AddShipmentItems(s ...*ShipmentItem) *ShipmentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddShipmentItemIDs(ids...)
+}
+
+// Mutation returns the ShipmentMutation object of the builder.
+func (suo *ShipmentUpdateOne) Mutation() *ShipmentMutation {
+	return suo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (suo *ShipmentUpdateOne) ClearOrder() *ShipmentUpdateOne {
+	suo.mutation.ClearOrder()
+	return suo
+}
+
+// ClearShipmentItems clears all "shipment_items" edges to the ShipmentItem entity.
+func (suo *ShipmentUpdateOne) ClearShipmentItems() *ShipmentUpdateOne {
+	suo.mutation.ClearShipmentItems()
+	return suo
+}
+
+// RemoveShipmentItemIDs removes the "shipment_items" edge to ShipmentItem entities by IDs.
+func (suo *ShipmentUpdateOne) RemoveShipmentItemIDs(ids ...uuid.UUID) *ShipmentUpdateOne {
+	suo.mutation.RemoveShipmentItemIDs(ids...)
+	return suo
+}
+
+// RemoveShipmentItems removes "shipment_items" edges to ShipmentItem entities.
+func (suo *ShipmentUpdateOne) RemoveShipmentItems(s ...*ShipmentItem) *ShipmentUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveShipmentItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ShipmentUpdate builder.
+func (suo *ShipmentUpdateOne) Where(ps ...predicate.Shipment) *ShipmentUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShipmentUpdateOne) Select(field string, fields ...string) *ShipmentUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Shipment entity.
+func (suo *ShipmentUpdateOne) Save(ctx context.Context) (*Shipment, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShipmentUpdateOne) SaveX(ctx context.Context) *Shipment {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShipmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShipmentUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ShipmentUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := shipment.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShipmentUpdateOne) check() error {
+	if v, ok := suo.mutation.Carrier(); ok {
+		if err := shipment.CarrierValidator(v); err != nil {
+			return &ValidationError{Name: "carrier", err: fmt.Errorf(`ent: validator failed for field "Shipment.carrier": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TrackingNumber(); ok {
+		if err := shipment.TrackingNumberValidator(v); err != nil {
+			return &ValidationError{Name: "tracking_number", err: fmt.Errorf(`ent: validator failed for field "Shipment.tracking_number": %w`, err)}
+		}
+	}
+	if suo.mutation.OrderCleared() && len(suo.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shipment.order"`)
+	}
+	return nil
+}
+
+func (suo *ShipmentUpdateOne) sqlSave(ctx context.Context) (_node *Shipment, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipment.Table, shipment.Columns, sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Shipment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shipment.FieldID)
+		for _, f := range fields {
+			if !shipment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shipment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Carrier(); ok {
+		_spec.SetField(shipment.FieldCarrier, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TrackingNumber(); ok {
+		_spec.SetField(shipment.FieldTrackingNumber, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.DeliveredAt(); ok {
+		_spec.SetField(shipment.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(shipment.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shipment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedShipmentItemsIDs(); len(nodes) > 0 && !suo.mutation.ShipmentItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ShipmentItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.ShipmentItemsTable,
+			Columns: []string{shipment.ShipmentItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Shipment{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shipmentitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the shipmentitem type in the database.
+	Label = "shipment_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// EdgeShipment holds the string denoting the shipment edge name in mutations.
+	EdgeShipment = "shipment"
+	// EdgeOrderItem holds the string denoting the order_item edge name in mutations.
+	EdgeOrderItem = "order_item"
+	// Table holds the table name of the shipmentitem in the database.
+	Table = "shipment_items"
+	// ShipmentTable is the table that holds the shipment relation/edge.
+	ShipmentTable = "shipment_items"
+	// ShipmentInverseTable is the table name for the Shipment entity.
+	// It exists in this package in order to avoid circular dependency with the "shipment" package.
+	ShipmentInverseTable = "shipments"
+	// ShipmentColumn is the table column denoting the shipment relation/edge.
+	ShipmentColumn = "shipment_shipment_items"
+	// OrderItemTable is the table that holds the order_item relation/edge.
+	OrderItemTable = "shipment_items"
+	// OrderItemInverseTable is the table name for the OrderItem entity.
+	// It exists in this package in order to avoid circular dependency with the "orderitem" package.
+	OrderItemInverseTable = "order_items"
+	// OrderItemColumn is the table column denoting the order_item relation/edge.
+	OrderItemColumn = "order_item_shipment_items"
+)
+
+// Columns holds all SQL columns for shipmentitem fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "shipment_items"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"order_item_shipment_items",
+	"shipment_shipment_items",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ShipmentItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByShipmentField orders the results by shipment field.
+func ByShipmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShipmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrderItemField orders the results by order_item field.
+func ByOrderItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShipmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShipmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShipmentTable, ShipmentColumn),
+	)
+}
+func newOrderItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderItemTable, OrderItemColumn),
+	)
+}
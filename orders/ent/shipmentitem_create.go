@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/orderitem"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShipmentItemCreate is the builder for creating a ShipmentItem entity.
+type ShipmentItemCreate struct {
+	config
+	mutation *ShipmentItemMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (sic *ShipmentItemCreate) SetQuantity(i int) *ShipmentItemCreate {
+	sic.mutation.SetQuantity(i)
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *ShipmentItemCreate) SetID(u uuid.UUID) *ShipmentItemCreate {
+	sic.mutation.SetID(u)
+	return sic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sic *ShipmentItemCreate) SetNillableID(u *uuid.UUID) *ShipmentItemCreate {
+	if u != nil {
+		sic.SetID(*u)
+	}
+	return sic
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (sic *ShipmentItemCreate) SetShipmentID(id uuid.UUID) *ShipmentItemCreate {
+	sic.mutation.SetShipmentID(id)
+	return sic
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (sic *ShipmentItemCreate) SetShipment(s *Shipment) *ShipmentItemCreate {
+	return sic.SetShipmentID(s.ID)
+}
+
+// SetOrderItemID sets the "order_item" edge to the OrderItem entity by ID.
+func (sic *ShipmentItemCreate) SetOrderItemID(id uuid.UUID) *ShipmentItemCreate {
+	sic.mutation.SetOrderItemID(id)
+	return sic
+}
+
+// SetOrderItem sets the "order_item" edge to the OrderItem entity.
+func (sic *ShipmentItemCreate) SetOrderItem(o *OrderItem) *ShipmentItemCreate {
+	return sic.SetOrderItemID(o.ID)
+}
+
+// Mutation returns the ShipmentItemMutation object of the builder.
+func (sic *ShipmentItemCreate) Mutation() *ShipmentItemMutation {
+	return sic.mutation
+}
+
+// Save creates the ShipmentItem in the database.
+func (sic *ShipmentItemCreate) Save(ctx context.Context) (*ShipmentItem, error) {
+	sic.defaults()
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *ShipmentItemCreate) SaveX(ctx context.Context) *ShipmentItem {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *ShipmentItemCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *ShipmentItemCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *ShipmentItemCreate) defaults() {
+	if _, ok := sic.mutation.ID(); !ok {
+		v := shipmentitem.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *ShipmentItemCreate) check() error {
+	if _, ok := sic.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ShipmentItem.quantity"`)}
+	}
+	if v, ok := sic.mutation.Quantity(); ok {
+		if err := shipmentitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ShipmentItem.quantity": %w`, err)}
+		}
+	}
+	if len(sic.mutation.ShipmentIDs()) == 0 {
+		return &ValidationError{Name: "shipment", err: errors.New(`ent: missing required edge "ShipmentItem.shipment"`)}
+	}
+	if len(sic.mutation.OrderItemIDs()) == 0 {
+		return &ValidationError{Name: "order_item", err: errors.New(`ent: missing required edge "ShipmentItem.order_item"`)}
+	}
+	return nil
+}
+
+func (sic *ShipmentItemCreate) sqlSave(ctx context.Context) (*ShipmentItem, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *ShipmentItemCreate) createSpec() (*ShipmentItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShipmentItem{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(shipmentitem.Table, sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sic.mutation.Quantity(); ok {
+		_spec.SetField(shipmentitem.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if nodes := sic.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.ShipmentTable,
+			Columns: []string{shipmentitem.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.shipment_shipment_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sic.mutation.OrderItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.OrderItemTable,
+			Columns: []string{shipmentitem.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_item_shipment_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShipmentItemCreateBulk is the builder for creating many ShipmentItem entities in bulk.
+type ShipmentItemCreateBulk struct {
+	config
+	err      error
+	builders []*ShipmentItemCreate
+}
+
+// Save creates the ShipmentItem entities in the database.
+func (sicb *ShipmentItemCreateBulk) Save(ctx context.Context) ([]*ShipmentItem, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*ShipmentItem, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShipmentItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *ShipmentItemCreateBulk) SaveX(ctx context.Context) []*ShipmentItem {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *ShipmentItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *ShipmentItemCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/orderitem"
+	"orders/ent/predicate"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShipmentItemUpdate is the builder for updating ShipmentItem entities.
+type ShipmentItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShipmentItemMutation
+}
+
+// Where appends a list predicates to the ShipmentItemUpdate builder.
+func (siu *ShipmentItemUpdate) Where(ps ...predicate.ShipmentItem) *ShipmentItemUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetQuantity sets the "quantity" field.
+func (siu *ShipmentItemUpdate) SetQuantity(i int) *ShipmentItemUpdate {
+	siu.mutation.ResetQuantity()
+	siu.mutation.SetQuantity(i)
+	return siu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (siu *ShipmentItemUpdate) SetNillableQuantity(i *int) *ShipmentItemUpdate {
+	if i != nil {
+		siu.SetQuantity(*i)
+	}
+	return siu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (siu *ShipmentItemUpdate) AddQuantity(i int) *ShipmentItemUpdate {
+	siu.mutation.AddQuantity(i)
+	return siu
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (siu *ShipmentItemUpdate) SetShipmentID(id uuid.UUID) *ShipmentItemUpdate {
+	siu.mutation.SetShipmentID(id)
+	return siu
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (siu *ShipmentItemUpdate) SetShipment(s *Shipment) *ShipmentItemUpdate {
+	return siu.SetShipmentID(s.ID)
+}
+
+// SetOrderItemID sets the "order_item" edge to the OrderItem entity by ID.
+func (siu *ShipmentItemUpdate) SetOrderItemID(id uuid.UUID) *ShipmentItemUpdate {
+	siu.mutation.SetOrderItemID(id)
+	return siu
+}
+
+// SetOrderItem sets the "order_item" edge to the OrderItem entity.
+func (siu *ShipmentItemUpdate) SetOrderItem(o *OrderItem) *ShipmentItemUpdate {
+	return siu.SetOrderItemID(o.ID)
+}
+
+// Mutation returns the ShipmentItemMutation object of the builder.
+func (siu *ShipmentItemUpdate) Mutation() *ShipmentItemMutation {
+	return siu.mutation
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (siu *ShipmentItemUpdate) ClearShipment() *ShipmentItemUpdate {
+	siu.mutation.ClearShipment()
+	return siu
+}
+
+// ClearOrderItem clears the "order_item" edge to the OrderItem entity.
+func (siu *ShipmentItemUpdate) ClearOrderItem() *ShipmentItemUpdate {
+	siu.mutation.ClearOrderItem()
+	return siu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *ShipmentItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *ShipmentItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *ShipmentItemUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *ShipmentItemUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *ShipmentItemUpdate) check() error {
+	if v, ok := siu.mutation.Quantity(); ok {
+		if err := shipmentitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ShipmentItem.quantity": %w`, err)}
+		}
+	}
+	if siu.mutation.ShipmentCleared() && len(siu.mutation.ShipmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShipmentItem.shipment"`)
+	}
+	if siu.mutation.OrderItemCleared() && len(siu.mutation.OrderItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShipmentItem.order_item"`)
+	}
+	return nil
+}
+
+func (siu *ShipmentItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipmentitem.Table, shipmentitem.Columns, sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.Quantity(); ok {
+		_spec.SetField(shipmentitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := siu.mutation.AddedQuantity(); ok {
+		_spec.AddField(shipmentitem.FieldQuantity, field.TypeInt, value)
+	}
+	if siu.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.ShipmentTable,
+			Columns: []string{shipmentitem.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.ShipmentTable,
+			Columns: []string{shipmentitem.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siu.mutation.OrderItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.OrderItemTable,
+			Columns: []string{shipmentitem.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.OrderItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.OrderItemTable,
+			Columns: []string{shipmentitem.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipmentitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// ShipmentItemUpdateOne is the builder for updating a single ShipmentItem entity.
+type ShipmentItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShipmentItemMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (siuo *ShipmentItemUpdateOne) SetQuantity(i int) *ShipmentItemUpdateOne {
+	siuo.mutation.ResetQuantity()
+	siuo.mutation.SetQuantity(i)
+	return siuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (siuo *ShipmentItemUpdateOne) SetNillableQuantity(i *int) *ShipmentItemUpdateOne {
+	if i != nil {
+		siuo.SetQuantity(*i)
+	}
+	return siuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (siuo *ShipmentItemUpdateOne) AddQuantity(i int) *ShipmentItemUpdateOne {
+	siuo.mutation.AddQuantity(i)
+	return siuo
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (siuo *ShipmentItemUpdateOne) SetShipmentID(id uuid.UUID) *ShipmentItemUpdateOne {
+	siuo.mutation.SetShipmentID(id)
+	return siuo
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (siuo *ShipmentItemUpdateOne) SetShipment(s *Shipment) *ShipmentItemUpdateOne {
+	return siuo.SetShipmentID(s.ID)
+}
+
+// SetOrderItemID sets the "order_item" edge to the OrderItem entity by ID.
+func (siuo *ShipmentItemUpdateOne) SetOrderItemID(id uuid.UUID) *ShipmentItemUpdateOne {
+	siuo.mutation.SetOrderItemID(id)
+	return siuo
+}
+
+// SetOrderItem sets the "order_item" edge to the OrderItem entity.
+func (siuo *ShipmentItemUpdateOne) SetOrderItem(o *OrderItem) *ShipmentItemUpdateOne {
+	return siuo.SetOrderItemID(o.ID)
+}
+
+// Mutation returns the ShipmentItemMutation object of the builder.
+func (siuo *ShipmentItemUpdateOne) Mutation() *ShipmentItemMutation {
+	return siuo.mutation
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (siuo *ShipmentItemUpdateOne) ClearShipment() *ShipmentItemUpdateOne {
+	siuo.mutation.ClearShipment()
+	return siuo
+}
+
+// ClearOrderItem clears the "order_item" edge to the OrderItem entity.
+func (siuo *ShipmentItemUpdateOne) ClearOrderItem() *ShipmentItemUpdateOne {
+	siuo.mutation.ClearOrderItem()
+	return siuo
+}
+
+// Where appends a list predicates to the ShipmentItemUpdate builder.
+func (siuo *ShipmentItemUpdateOne) Where(ps ...predicate.ShipmentItem) *ShipmentItemUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *ShipmentItemUpdateOne) Select(field string, fields ...string) *ShipmentItemUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated ShipmentItem entity.
+func (siuo *ShipmentItemUpdateOne) Save(ctx context.Context) (*ShipmentItem, error) {
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *ShipmentItemUpdateOne) SaveX(ctx context.Context) *ShipmentItem {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *ShipmentItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *ShipmentItemUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *ShipmentItemUpdateOne) check() error {
+	if v, ok := siuo.mutation.Quantity(); ok {
+		if err := shipmentitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ShipmentItem.quantity": %w`, err)}
+		}
+	}
+	if siuo.mutation.ShipmentCleared() && len(siuo.mutation.ShipmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShipmentItem.shipment"`)
+	}
+	if siuo.mutation.OrderItemCleared() && len(siuo.mutation.OrderItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShipmentItem.order_item"`)
+	}
+	return nil
+}
+
+func (siuo *ShipmentItemUpdateOne) sqlSave(ctx context.Context) (_node *ShipmentItem, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipmentitem.Table, shipmentitem.Columns, sqlgraph.NewFieldSpec(shipmentitem.FieldID, field.TypeUUID))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShipmentItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shipmentitem.FieldID)
+		for _, f := range fields {
+			if !shipmentitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shipmentitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.Quantity(); ok {
+		_spec.SetField(shipmentitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := siuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(shipmentitem.FieldQuantity, field.TypeInt, value)
+	}
+	if siuo.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.ShipmentTable,
+			Columns: []string{shipmentitem.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.ShipmentTable,
+			Columns: []string{shipmentitem.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siuo.mutation.OrderItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.OrderItemTable,
+			Columns: []string{shipmentitem.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.OrderItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shipmentitem.OrderItemTable,
+			Columns: []string{shipmentitem.OrderItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShipmentItem{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipmentitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"orders/ent/schema"
+	"orders/ent/subscription"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the user the orders are placed for
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Items of each recurring order
+	Items []schema.SubscriptionItem `json:"items,omitempty"`
+	// Interval holds the value of the "interval" field.
+	Interval subscription.Interval `json:"interval,omitempty"`
+	// When the scheduler places the next order
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// Cleared while the subscription is paused or once it is cancelled
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set by CancelSubscription; a cancelled subscription cannot be resumed
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// Consecutive runs whose order could not be placed; reset once one is, or on resume
+	FailureCount int `json:"failure_count,omitempty"`
+	// Why the latest failed run's order could not be placed
+	LastFailure string `json:"last_failure,omitempty"`
+	// LastFailedAt holds the value of the "last_failed_at" field.
+	LastFailedAt *time.Time `json:"last_failed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldItems:
+			values[i] = new([]byte)
+		case subscription.FieldActive:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldFailureCount:
+			values[i] = new(sql.NullInt64)
+		case subscription.FieldInterval, subscription.FieldLastFailure:
+			values[i] = new(sql.NullString)
+		case subscription.FieldNextRunAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldCancelledAt, subscription.FieldLastFailedAt:
+			values[i] = new(sql.NullTime)
+		case subscription.FieldID, subscription.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (s *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case subscription.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				s.UserID = *value
+			}
+		case subscription.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		case subscription.FieldInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				s.Interval = subscription.Interval(value.String)
+			}
+		case subscription.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				s.NextRunAt = value.Time
+			}
+		case subscription.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				s.Active = value.Bool
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case subscription.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				s.CancelledAt = new(time.Time)
+				*s.CancelledAt = value.Time
+			}
+		case subscription.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				s.FailureCount = int(value.Int64)
+			}
+		case subscription.FieldLastFailure:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure", values[i])
+			} else if value.Valid {
+				s.LastFailure = value.String
+			}
+		case subscription.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				s.LastFailedAt = new(time.Time)
+				*s.LastFailedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (s *Subscription) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Subscription) Unwrap() *Subscription {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", s.Items))
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(fmt.Sprintf("%v", s.Interval))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(s.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", s.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.FailureCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_failure=")
+	builder.WriteString(s.LastFailure)
+	builder.WriteString(", ")
+	if v := s.LastFailedAt; v != nil {
+		builder.WriteString("last_failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/schema"
+	"orders/ent/subscription"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SubscriptionCreate) SetUserID(u uuid.UUID) *SubscriptionCreate {
+	sc.mutation.SetUserID(u)
+	return sc
+}
+
+// SetItems sets the "items" field.
+func (sc *SubscriptionCreate) SetItems(si []schema.SubscriptionItem) *SubscriptionCreate {
+	sc.mutation.SetItems(si)
+	return sc
+}
+
+// SetInterval sets the "interval" field.
+func (sc *SubscriptionCreate) SetInterval(s subscription.Interval) *SubscriptionCreate {
+	sc.mutation.SetInterval(s)
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *SubscriptionCreate) SetNextRunAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetActive sets the "active" field.
+func (sc *SubscriptionCreate) SetActive(b bool) *SubscriptionCreate {
+	sc.mutation.SetActive(b)
+	return sc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableActive(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetActive(*b)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SubscriptionCreate) SetCreatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SubscriptionCreate) SetUpdatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (sc *SubscriptionCreate) SetCancelledAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCancelledAt(t)
+	return sc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCancelledAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCancelledAt(*t)
+	}
+	return sc
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (sc *SubscriptionCreate) SetFailureCount(i int) *SubscriptionCreate {
+	sc.mutation.SetFailureCount(i)
+	return sc
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableFailureCount(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetFailureCount(*i)
+	}
+	return sc
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (sc *SubscriptionCreate) SetLastFailure(s string) *SubscriptionCreate {
+	sc.mutation.SetLastFailure(s)
+	return sc
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableLastFailure(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetLastFailure(*s)
+	}
+	return sc
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (sc *SubscriptionCreate) SetLastFailedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetLastFailedAt(t)
+	return sc
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableLastFailedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetLastFailedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SubscriptionCreate) SetID(u uuid.UUID) *SubscriptionCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableID(u *uuid.UUID) *SubscriptionCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return sc.mutation
+}
+
+// Save creates the Subscription in the database.
+func (sc *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SubscriptionCreate) defaults() {
+	if _, ok := sc.mutation.Active(); !ok {
+		v := subscription.DefaultActive
+		sc.mutation.SetActive(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.FailureCount(); !ok {
+		v := subscription.DefaultFailureCount
+		sc.mutation.SetFailureCount(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := subscription.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SubscriptionCreate) check() error {
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Subscription.user_id"`)}
+	}
+	if _, ok := sc.mutation.Items(); !ok {
+		return &ValidationError{Name: "items", err: errors.New(`ent: missing required field "Subscription.items"`)}
+	}
+	if _, ok := sc.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "Subscription.interval"`)}
+	}
+	if v, ok := sc.mutation.Interval(); ok {
+		if err := subscription.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "Subscription.next_run_at"`)}
+	}
+	if _, ok := sc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Subscription.active"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Subscription.updated_at"`)}
+	}
+	if _, ok := sc.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "Subscription.failure_count"`)}
+	}
+	return nil
+}
+
+func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.UserID(); ok {
+		_spec.SetField(subscription.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := sc.mutation.Items(); ok {
+		_spec.SetField(subscription.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	if value, ok := sc.mutation.Interval(); ok {
+		_spec.SetField(subscription.FieldInterval, field.TypeEnum, value)
+		_node.Interval = value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.SetField(subscription.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := sc.mutation.Active(); ok {
+		_spec.SetField(subscription.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := sc.mutation.FailureCount(); ok {
+		_spec.SetField(subscription.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
+	if value, ok := sc.mutation.LastFailure(); ok {
+		_spec.SetField(subscription.FieldLastFailure, field.TypeString, value)
+		_node.LastFailure = value
+	}
+	if value, ok := sc.mutation.LastFailedAt(); ok {
+		_spec.SetField(subscription.FieldLastFailedAt, field.TypeTime, value)
+		_node.LastFailedAt = &value
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Subscription, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
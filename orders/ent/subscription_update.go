@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"orders/ent/predicate"
+	"orders/ent/schema"
+	"orders/ent/subscription"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetItems sets the "items" field.
+func (su *SubscriptionUpdate) SetItems(si []schema.SubscriptionItem) *SubscriptionUpdate {
+	su.mutation.SetItems(si)
+	return su
+}
+
+// AppendItems appends si to the "items" field.
+func (su *SubscriptionUpdate) AppendItems(si []schema.SubscriptionItem) *SubscriptionUpdate {
+	su.mutation.AppendItems(si)
+	return su
+}
+
+// SetInterval sets the "interval" field.
+func (su *SubscriptionUpdate) SetInterval(s subscription.Interval) *SubscriptionUpdate {
+	su.mutation.SetInterval(s)
+	return su
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableInterval(s *subscription.Interval) *SubscriptionUpdate {
+	if s != nil {
+		su.SetInterval(*s)
+	}
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *SubscriptionUpdate) SetNextRunAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableNextRunAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// SetActive sets the "active" field.
+func (su *SubscriptionUpdate) SetActive(b bool) *SubscriptionUpdate {
+	su.mutation.SetActive(b)
+	return su
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableActive(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetActive(*b)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubscriptionUpdate) SetUpdatedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (su *SubscriptionUpdate) SetCancelledAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCancelledAt(t)
+	return su
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCancelledAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCancelledAt(*t)
+	}
+	return su
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (su *SubscriptionUpdate) ClearCancelledAt() *SubscriptionUpdate {
+	su.mutation.ClearCancelledAt()
+	return su
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (su *SubscriptionUpdate) SetFailureCount(i int) *SubscriptionUpdate {
+	su.mutation.ResetFailureCount()
+	su.mutation.SetFailureCount(i)
+	return su
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableFailureCount(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetFailureCount(*i)
+	}
+	return su
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (su *SubscriptionUpdate) AddFailureCount(i int) *SubscriptionUpdate {
+	su.mutation.AddFailureCount(i)
+	return su
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (su *SubscriptionUpdate) SetLastFailure(s string) *SubscriptionUpdate {
+	su.mutation.SetLastFailure(s)
+	return su
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableLastFailure(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetLastFailure(*s)
+	}
+	return su
+}
+
+// ClearLastFailure clears the value of the "last_failure" field.
+func (su *SubscriptionUpdate) ClearLastFailure() *SubscriptionUpdate {
+	su.mutation.ClearLastFailure()
+	return su
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (su *SubscriptionUpdate) SetLastFailedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetLastFailedAt(t)
+	return su
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableLastFailedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetLastFailedAt(*t)
+	}
+	return su
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (su *SubscriptionUpdate) ClearLastFailedAt() *SubscriptionUpdate {
+	su.mutation.ClearLastFailedAt()
+	return su
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubscriptionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if v, ok := su.mutation.Interval(); ok {
+		if err := subscription.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Items(); ok {
+		_spec.SetField(subscription.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldItems, value)
+		})
+	}
+	if value, ok := su.mutation.Interval(); ok {
+		_spec.SetField(subscription.FieldInterval, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.SetField(subscription.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Active(); ok {
+		_spec.SetField(subscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if su.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.FailureCount(); ok {
+		_spec.SetField(subscription.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedFailureCount(); ok {
+		_spec.AddField(subscription.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.LastFailure(); ok {
+		_spec.SetField(subscription.FieldLastFailure, field.TypeString, value)
+	}
+	if su.mutation.LastFailureCleared() {
+		_spec.ClearField(subscription.FieldLastFailure, field.TypeString)
+	}
+	if value, ok := su.mutation.LastFailedAt(); ok {
+		_spec.SetField(subscription.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if su.mutation.LastFailedAtCleared() {
+		_spec.ClearField(subscription.FieldLastFailedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetItems sets the "items" field.
+func (suo *SubscriptionUpdateOne) SetItems(si []schema.SubscriptionItem) *SubscriptionUpdateOne {
+	suo.mutation.SetItems(si)
+	return suo
+}
+
+// AppendItems appends si to the "items" field.
+func (suo *SubscriptionUpdateOne) AppendItems(si []schema.SubscriptionItem) *SubscriptionUpdateOne {
+	suo.mutation.AppendItems(si)
+	return suo
+}
+
+// SetInterval sets the "interval" field.
+func (suo *SubscriptionUpdateOne) SetInterval(s subscription.Interval) *SubscriptionUpdateOne {
+	suo.mutation.SetInterval(s)
+	return suo
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableInterval(s *subscription.Interval) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetInterval(*s)
+	}
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *SubscriptionUpdateOne) SetNextRunAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableNextRunAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// SetActive sets the "active" field.
+func (suo *SubscriptionUpdateOne) SetActive(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetActive(b)
+	return suo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableActive(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetActive(*b)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubscriptionUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (suo *SubscriptionUpdateOne) SetCancelledAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCancelledAt(t)
+	return suo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCancelledAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCancelledAt(*t)
+	}
+	return suo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (suo *SubscriptionUpdateOne) ClearCancelledAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearCancelledAt()
+	return suo
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (suo *SubscriptionUpdateOne) SetFailureCount(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetFailureCount()
+	suo.mutation.SetFailureCount(i)
+	return suo
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableFailureCount(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetFailureCount(*i)
+	}
+	return suo
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (suo *SubscriptionUpdateOne) AddFailureCount(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddFailureCount(i)
+	return suo
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (suo *SubscriptionUpdateOne) SetLastFailure(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetLastFailure(s)
+	return suo
+}
+
+// SetNillableLastFailure sets the "last_failure" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableLastFailure(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetLastFailure(*s)
+	}
+	return suo
+}
+
+// ClearLastFailure clears the value of the "last_failure" field.
+func (suo *SubscriptionUpdateOne) ClearLastFailure() *SubscriptionUpdateOne {
+	suo.mutation.ClearLastFailure()
+	return suo
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (suo *SubscriptionUpdateOne) SetLastFailedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetLastFailedAt(t)
+	return suo
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableLastFailedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetLastFailedAt(*t)
+	}
+	return suo
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (suo *SubscriptionUpdateOne) ClearLastFailedAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearLastFailedAt()
+	return suo
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (suo *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubscriptionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if v, ok := suo.mutation.Interval(); ok {
+		if err := subscription.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Items(); ok {
+		_spec.SetField(subscription.FieldItems, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscription.FieldItems, value)
+		})
+	}
+	if value, ok := suo.mutation.Interval(); ok {
+		_spec.SetField(subscription.FieldInterval, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.SetField(subscription.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Active(); ok {
+		_spec.SetField(subscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if suo.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.FailureCount(); ok {
+		_spec.SetField(subscription.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedFailureCount(); ok {
+		_spec.AddField(subscription.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.LastFailure(); ok {
+		_spec.SetField(subscription.FieldLastFailure, field.TypeString, value)
+	}
+	if suo.mutation.LastFailureCleared() {
+		_spec.ClearField(subscription.FieldLastFailure, field.TypeString)
+	}
+	if value, ok := suo.mutation.LastFailedAt(); ok {
+		_spec.SetField(subscription.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastFailedAtCleared() {
+		_spec.ClearField(subscription.FieldLastFailedAt, field.TypeTime)
+	}
+	_node = &Subscription{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -3,180 +3,354 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"go-micro.dev/v5"
+	"go-micro.dev/v5/errors"
 	"go-micro.dev/v5/logger"
 
 	"orders/ent"
 	"orders/ent/order"
+	"orders/ent/orderevent"
 	"orders/ent/orderitem"
 	pb "orders/proto"
+
+	productspb "products/proto"
 )
 
 // AdminService implements the AdminServiceServer interface
 type AdminService struct {
 	EntClient *ent.Client
+	// Events publishes bulk-created orders on OrderCreatedTopic; nil disables publishing
+	Events micro.Event
+	// Products restocks cancelled orders; nil disables restocking
+	Products productspb.ProductService
+	// Exports limits concurrent and repeated ExportOrders calls; nil disables the limits
+	Exports *ExportLimiter
+	// Region is stamped on bulk-created orders; empty leaves them without one
+	Region string
 }
 
-// ForceDeleteOrder handles the forced deletion of an order (admin privilege)
+// ForceDeleteOrder soft-deletes an order so RestoreOrder can bring it back, or permanently
+// deletes it with its items when hard is set (admin privilege)
 func (h *AdminService) ForceDeleteOrder(ctx context.Context, req *pb.ForceDeleteOrderRequest, rsp *pb.ForceDeleteOrderResponse) error {
-	logger.Infof("Received ForceDeleteOrder request for ID: %s (Admin operation)", req.Id)
+	logger.Extract(ctx).Infof("Received ForceDeleteOrder request for ID: %s, hard: %v (Admin operation)", req.Id, req.Hard)
+
+	orderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return errors.BadRequest("orders.ForceDeleteOrder", "invalid order id: %s", req.Id)
+	}
+
+	if !req.Hard {
+		err := h.EntClient.Order.UpdateOneID(orderID).
+			Where(order.DeletedAtIsNil()).
+			SetDeletedAt(time.Now()).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			logger.Extract(ctx).Infof("Order not found for deletion: %s", req.Id)
+			rsp.Success = false
+			return fmt.Errorf("order not found for deletion: %w", err)
+		}
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to soft-delete order: %v", err)
+			rsp.Success = false
+			return fmt.Errorf("failed to delete order: %w", err)
+		}
+
+		rsp.Id = req.Id
+		rsp.Success = true
+		logger.Extract(ctx).Infof("Order soft-deleted successfully: %s", req.Id)
+		return nil
+	}
 
 	// Start a transaction to ensure atomicity
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction for force delete: %v", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction for force delete: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
 
 	// Delete order items first due to foreign key constraints
 	_, err = tx.OrderItem.Delete().
-		Where(orderitem.HasOrderWith(order.ID(uuid.MustParse(req.Id)))).
+		Where(orderitem.HasOrderWith(order.ID(orderID))).
 		Exec(ctx)
 	if err != nil {
-		logger.Errorf("Failed to delete order items for order %s: %v", req.Id, err)
+		logger.Extract(ctx).Errorf("Failed to delete order items for order %s: %v", req.Id, err)
 		return fmt.Errorf("failed to delete order items: %w", err)
 	}
 
 	// Delete order
-	err = tx.Order.DeleteOneID(uuid.MustParse(req.Id)).Exec(ctx)
+	err = tx.Order.DeleteOneID(orderID).Exec(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Order not found for deletion: %s", req.Id)
+		logger.Extract(ctx).Infof("Order not found for deletion: %s", req.Id)
 		rsp.Success = false
 		return fmt.Errorf("order not found for deletion: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to force delete order: %v", err)
+		logger.Extract(ctx).Errorf("Failed to force delete order: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to force delete order: %w", err)
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction for force delete: %v", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction for force delete: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	rsp.Id = req.Id
 	rsp.Success = true
-	logger.Infof("Order force deleted successfully: %s", req.Id)
+	logger.Extract(ctx).Infof("Order force deleted successfully: %s", req.Id)
+	return nil
+}
+
+// RestoreOrder restores a soft-deleted order (admin privilege)
+func (h *AdminService) RestoreOrder(ctx context.Context, req *pb.RestoreOrderRequest, rsp *pb.RestoreOrderResponse) error {
+	logger.Extract(ctx).Infof("Received RestoreOrder request for ID: %s (Admin operation)", req.Id)
+
+	orderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return errors.BadRequest("orders.RestoreOrder", "invalid order id: %s", req.Id)
+	}
+
+	err = h.EntClient.Order.UpdateOneID(orderID).
+		Where(order.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("No deleted order found for restoration: %s", req.Id)
+		return fmt.Errorf("deleted order not found: %s", req.Id)
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to restore order: %v", err)
+		return fmt.Errorf("failed to restore order: %w", err)
+	}
+
+	o, err := h.EntClient.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch restored order %s: %v", req.Id, err)
+		return fmt.Errorf("failed to fetch order: %w", err)
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Order restored successfully: %s", req.Id)
 	return nil
 }
 
-// BulkCreateOrders handles streaming creation of multiple orders
+// GetOrder fetches an order like OrderService.GetOrder, including a soft-deleted one when requested (admin privilege)
+func (h *AdminService) GetOrder(ctx context.Context, req *pb.GetOrderRequest, rsp *pb.GetOrderResponse) error {
+	logger.Extract(ctx).Infof("Received GetOrder request for ID: %s, include_deleted: %v (Admin operation)", req.Id, req.IncludeDeleted)
+	return getOrder(ctx, h.EntClient, req.Id, req.IncludeDeleted, rsp)
+}
+
+// ListOrders lists orders like OrderService.ListOrders, including soft-deleted ones when requested (admin privilege)
+func (h *AdminService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest, rsp *pb.ListOrdersResponse) error {
+	logger.Extract(ctx).Infof("Received ListOrders request (limit: %d, offset: %d, user_id: %s, include_deleted: %v) (Admin operation)", req.Limit, req.Offset, req.UserId, req.IncludeDeleted)
+	return listOrders(ctx, h.EntClient, req, req.IncludeDeleted, rsp)
+}
+
+// BulkCreateOrders handles streaming creation of multiple orders. An order that fails to
+// create is skipped and reported in the response's failures, so the rest still go in.
 func (h *AdminService) BulkCreateOrders(ctx context.Context, stream pb.AdminService_BulkCreateOrdersStream) error {
-	logger.Infof("Received BulkCreateOrders stream request (Admin operation)")
+	logger.Extract(ctx).Infof("Received BulkCreateOrders stream request (Admin operation)")
 	var createdOrders []*pb.Order
+	var failures []*pb.BulkCreateOrderFailure
 	var totalCreated int32
 
-	for {
+	for index := int32(0); ; index++ {
 		req := &pb.CreateOrderRequest{}
 		err := stream.RecvMsg(req)
 		if err != nil {
 			if err.Error() == "EOF" { // go-micro uses EOF for end of stream
 				break
 			}
-			logger.Errorf("Error receiving from BulkCreateOrders stream: %v", err)
+			logger.Extract(ctx).Errorf("Error receiving from BulkCreateOrders stream: %v", err)
 			return fmt.Errorf("error receiving order data: %w", err)
 		}
 
-		logger.Infof("Bulk creating order for user_id: %s", req.UserId)
+		logger.Extract(ctx).Infof("Bulk creating order for user_id: %s", req.UserId)
 
-		// Calculate total amount
-		var totalAmount float64
-		for _, item := range req.OrderItems {
-			totalAmount += float64(item.Quantity) * item.UnitPrice
-		}
-
-		// Start a transaction
-		tx, err := h.EntClient.Tx(ctx)
+		o, err := h.bulkCreateOrder(ctx, req)
 		if err != nil {
-			logger.Errorf("BulkCreateOrders: Failed to start transaction for user %s: %v", req.UserId, err)
+			logger.Extract(ctx).Infof("BulkCreateOrders: Rejected order %d for user %s: %v", index, req.UserId, err)
+			failures = append(failures, &pb.BulkCreateOrderFailure{
+				Index:  index,
+				UserId: req.UserId,
+				Reason: err.Error(),
+			})
 			continue
 		}
 
-		// Create order
-		o, err := tx.Order.Create().
-			SetUserID(uuid.MustParse(req.UserId)).
-			SetTotalAmount(totalAmount).
-			Save(ctx)
-		if ent.IsConstraintError(err) {
-			logger.Errorf("BulkCreateOrders: Constraint violation for user %s: %v", req.UserId, err)
-			tx.Rollback()
-			continue
+		publishOrderCreated(ctx, h.Events, o)
+
+		createdOrders = append(createdOrders, toProtoOrder(o))
+		totalCreated++
+	}
+
+	// Send the final response containing all created orders and the ones that failed
+	err := stream.SendMsg(&pb.BulkCreateOrdersResponse{
+		Orders:   createdOrders,
+		Total:    totalCreated,
+		Failures: failures,
+	})
+	if err != nil {
+		logger.Extract(ctx).Errorf("Error sending BulkCreateOrders response: %v", err)
+		return fmt.Errorf("failed to send response: %w", err)
+	}
+
+	logger.Extract(ctx).Infof("BulkCreateOrders: Successfully created %d orders, %d failed.", totalCreated, len(failures))
+	return nil
+}
+
+// bulkCreateOrder validates and creates one order of BulkCreateOrders in its own
+// transaction, returning it with its items
+func (h *AdminService) bulkCreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*ent.Order, error) {
+	const id = "orders.BulkCreateOrders"
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.BadRequest(id, "invalid user_id: %s", req.UserId)
+	}
+	if err := checkOrderItems(id, req.OrderItems); err != nil {
+		return nil, err
+	}
+	productIDs := make([]uuid.UUID, len(req.OrderItems))
+	for i, item := range req.OrderItems {
+		if productIDs[i], err = uuid.Parse(item.ProductId); err != nil {
+			return nil, errors.BadRequest(id, "order item %d: invalid product_id: %s", i, item.ProductId)
 		}
-		if err != nil {
-			logger.Errorf("BulkCreateOrders: Failed to create order for user %s: %v", req.UserId, err)
-			tx.Rollback()
-			continue
+	}
+	var address *pb.ShippingAddress
+	if req.ShippingAddress != nil {
+		if address, err = normalizeShippingAddress(id, req.ShippingAddress); err != nil {
+			return nil, err
 		}
+	}
 
-		// Create order items
-		for _, item := range req.OrderItems {
-			_, err = tx.OrderItem.Create().
-				SetOrderID(o.ID).
-				SetProductID(uuid.MustParse(item.ProductId)).
-				SetQuantity(int(item.Quantity)).
-				SetUnitPrice(item.UnitPrice).
-				Save(ctx)
-			if err != nil {
-				logger.Errorf("BulkCreateOrders: Failed to create order item for product %s: %v", item.ProductId, err)
-				tx.Rollback()
-				continue
-			}
-		}
+	// Calculate total amount in cents so the sum is exact
+	var totalAmount int64
+	itemCurrencies := make([]string, len(req.OrderItems))
+	for i, item := range req.OrderItems {
+		totalAmount += int64(item.Quantity) * requestCents(item.UnitPriceCents, item.UnitPrice)
+		itemCurrencies[i] = item.Currency
+	}
 
-		if err = tx.Commit(); err != nil {
-			logger.Errorf("BulkCreateOrders: Failed to commit transaction for order %s: %v", o.ID, err)
-			continue
-		}
+	currency, err := commonCurrency(itemCurrencies)
+	if err != nil {
+		return nil, err
+	}
 
-		// Fetch order with items
-		oWithItems, err := h.EntClient.Order.Query().
-			Where(order.ID(o.ID)).
-			WithOrderItems().
-			Only(ctx)
+	// Start a transaction
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to start transaction for user %s: %v", req.UserId, err)
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Create order
+	create := tx.Order.Create().
+		SetUserID(userID).
+		SetTotalAmountCents(totalAmount).
+		SetCurrency(currency)
+	if h.Region != "" {
+		create.SetRegion(h.Region)
+	}
+	if address != nil {
+		setShippingAddress(create.Mutation(), address)
+	}
+	o, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Constraint violation for user %s: %v", req.UserId, err)
+		return nil, errors.Conflict(id, "order violates a constraint")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to create order for user %s: %v", req.UserId, err)
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+	if err := recordStatusChange(ctx, tx, o.ID, "", o.Status); err != nil {
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to record initial status of order %s: %v", o.ID, err)
+		return nil, fmt.Errorf("failed to record order status: %w", err)
+	}
+
+	// Create order items
+	for i, item := range req.OrderItems {
+		_, err = tx.OrderItem.Create().
+			SetOrderID(o.ID).
+			SetProductID(productIDs[i]).
+			SetQuantity(int(item.Quantity)).
+			SetUnitPriceCents(requestCents(item.UnitPriceCents, item.UnitPrice)).
+			SetPosition(i).
+			Save(ctx)
 		if err != nil {
-			logger.Errorf("BulkCreateOrders: Failed to fetch order with items %s: %v", o.ID, err)
-			continue
+			logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to create order item for product %s: %v", item.ProductId, err)
+			return nil, fmt.Errorf("failed to create order item %d: %w", i, err)
 		}
+	}
 
-		createdOrders = append(createdOrders, toProtoOrder(oWithItems))
-		totalCreated++
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to commit transaction for order %s: %v", o.ID, err)
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	// Send the final response
-	err := stream.SendMsg(&pb.BulkCreateOrdersResponse{
-		Orders: createdOrders,
-		Total:  totalCreated,
-	})
+	// Fetch order with items
+	oWithItems, err := h.EntClient.Order.Query().
+		Where(order.ID(o.ID)).
+		WithOrderItems().
+		Only(ctx)
 	if err != nil {
-		logger.Errorf("Error sending BulkCreateOrders response: %v", err)
-		return fmt.Errorf("failed to send response: %w", err)
+		logger.Extract(ctx).Errorf("BulkCreateOrders: Failed to fetch order with items %s: %v", o.ID, err)
+		return nil, fmt.Errorf("failed to fetch order: %w", err)
 	}
-
-	logger.Infof("BulkCreateOrders: Successfully created %d orders.", totalCreated)
-	return nil
+	return oWithItems, nil
 }
 
 // ExportOrders streams all orders, optionally filtered and paginated
 func (h *AdminService) ExportOrders(ctx context.Context, req *pb.ExportOrdersRequest, stream pb.AdminService_ExportOrdersStream) error {
-	logger.Infof("Received ExportOrders stream request (limit: %d, offset: %d, user_id: %s, status: %s)", req.Limit, req.Offset, req.UserId, req.Status)
+	logger.Extract(ctx).Infof("Received ExportOrders stream request (limit: %d, offset: %d, user_id: %s, status: %s, include_history: %v, include_deleted: %v)", req.Limit, req.Offset, req.UserId, req.Status, req.IncludeHistory, req.IncludeDeleted)
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		id, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return errors.BadRequest("orders.ExportOrders", "invalid user_id: %s", req.UserId)
+		}
+		userID = id
+	}
+
+	release, err := h.Exports.acquire(exportCaller(ctx))
+	if err != nil {
+		logger.Extract(ctx).Infof("Rejected ExportOrders request: %v", err)
+		return err
+	}
+	defer release()
 
 	query := h.EntClient.Order.Query().WithOrderItems()
+	if req.IncludeHistory {
+		query.WithEvents(func(q *ent.OrderEventQuery) {
+			q.Order(orderevent.ByCreatedAt())
+		})
+	}
 
 	if req.UserId != "" {
-		query.Where(order.UserID(uuid.MustParse(req.UserId)))
+		query.Where(order.UserID(userID))
 	}
 	if req.Status != "" {
 		query.Where(order.StatusEQ(order.Status(req.Status)))
 	}
+	if !req.IncludeDeleted {
+		query.Where(order.DeletedAtIsNil())
+	}
 
 	if req.Limit > 0 {
 		// Ensure limit does not exceed int max
 		if req.Limit > int32(uint(0)>>1) {
-			logger.Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
 			req.Limit = int32(uint(0) >> 1)
 		}
 		query.Limit(int(req.Limit))
@@ -184,7 +358,7 @@ func (h *AdminService) ExportOrders(ctx context.Context, req *pb.ExportOrdersReq
 	if req.Offset > 0 {
 		// Ensure offset does not exceed int max
 		if req.Offset > int32(uint(0)>>1) {
-			logger.Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
 			req.Offset = int32(uint(0) >> 1)
 		}
 		query.Offset(int(req.Offset))
@@ -192,18 +366,32 @@ func (h *AdminService) ExportOrders(ctx context.Context, req *pb.ExportOrdersReq
 
 	orders, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to retrieve orders for export: %v", err)
+		logger.Extract(ctx).Errorf("Failed to retrieve orders for export: %v", err)
 		return fmt.Errorf("failed to retrieve orders for export: %w", err)
 	}
 
 	for _, o := range orders {
 		protoOrder := toProtoOrder(o)
 		if err := stream.Send(protoOrder); err != nil {
-			logger.Errorf("Error sending order %s during export: %v", o.ID, err)
+			logger.Extract(ctx).Errorf("Error sending order %s during export: %v", o.ID, err)
 			return fmt.Errorf("failed to stream order: %w", err)
 		}
 	}
 
-	logger.Infof("Successfully exported %d orders.", len(orders))
+	logger.Extract(ctx).Infof("Successfully exported %d orders.", len(orders))
+	return nil
+}
+
+// CancelOrder cancels an order regardless of the customer cancellation window (admin privilege)
+func (h *AdminService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest, rsp *pb.CancelOrderResponse) error {
+	logger.Extract(ctx).Infof("Received CancelOrder request for ID: %s (Admin operation)", req.Id)
+
+	o, err := cancelOrder(ctx, h.EntClient, h.Products, req.Id, 0)
+	if err != nil {
+		return err
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Order cancelled by admin successfully: %s", o.ID)
 	return nil
 }
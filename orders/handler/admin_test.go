@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"google.golang.org/protobuf/proto"
+
+	pb "orders/proto"
+)
+
+func TestMalformedOrderIDsAreBadRequests(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+	orders := &OrderService{EntClient: client}
+
+	calls := map[string]func() error{
+		"ForceDeleteOrder": func() error {
+			return admin.ForceDeleteOrder(ctx, &pb.ForceDeleteOrderRequest{Id: "not-a-uuid"}, &pb.ForceDeleteOrderResponse{})
+		},
+		"ForceDeleteOrder hard": func() error {
+			return admin.ForceDeleteOrder(ctx, &pb.ForceDeleteOrderRequest{Id: "not-a-uuid", Hard: true}, &pb.ForceDeleteOrderResponse{})
+		},
+		"RestoreOrder": func() error {
+			return admin.RestoreOrder(ctx, &pb.RestoreOrderRequest{Id: "not-a-uuid"}, &pb.RestoreOrderResponse{})
+		},
+		"AdminService.GetOrder": func() error {
+			return admin.GetOrder(ctx, &pb.GetOrderRequest{Id: "not-a-uuid", IncludeDeleted: true}, &pb.GetOrderResponse{})
+		},
+		"GetOrder": func() error {
+			return orders.GetOrder(ctx, &pb.GetOrderRequest{Id: "not-a-uuid"}, &pb.GetOrderResponse{})
+		},
+		"UpdateOrderStatus": func() error {
+			return orders.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: "not-a-uuid", Status: "processing"}, &pb.UpdateOrderStatusResponse{})
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if merr := errors.FromError(call()); merr.Code != http.StatusBadRequest {
+				t.Fatalf("expected a BadRequest, got %v", merr)
+			}
+		})
+	}
+}
+
+func TestSoftDeleteAndRestoreOrder(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+	orders := &OrderService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+	id := o.ID.String()
+
+	if err := admin.ForceDeleteOrder(ctx, &pb.ForceDeleteOrderRequest{Id: id}, &pb.ForceDeleteOrderResponse{}); err != nil {
+		t.Fatalf("ForceDeleteOrder: %v", err)
+	}
+	if err := orders.GetOrder(ctx, &pb.GetOrderRequest{Id: id}, &pb.GetOrderResponse{}); err == nil {
+		t.Fatal("expected a soft-deleted order to be hidden from GetOrder")
+	}
+	deleted := &pb.GetOrderResponse{}
+	if err := admin.GetOrder(ctx, &pb.GetOrderRequest{Id: id, IncludeDeleted: true}, deleted); err != nil || deleted.Order.DeletedAt == 0 {
+		t.Fatalf("expected admins to see the deleted order, got %v, %v", deleted.Order, err)
+	}
+
+	restored := &pb.RestoreOrderResponse{}
+	if err := admin.RestoreOrder(ctx, &pb.RestoreOrderRequest{Id: id}, restored); err != nil {
+		t.Fatalf("RestoreOrder: %v", err)
+	}
+	if restored.Order.DeletedAt != 0 || len(restored.Order.OrderItems) != 1 {
+		t.Fatalf("expected the order restored with its item, got %v", restored.Order)
+	}
+	if err := admin.RestoreOrder(ctx, &pb.RestoreOrderRequest{Id: id}, &pb.RestoreOrderResponse{}); err == nil {
+		t.Fatal("expected restoring a live order to fail")
+	}
+}
+
+// fakeBulkCreateStream feeds BulkCreateOrders its requests and keeps the response
+type fakeBulkCreateStream struct {
+	pb.AdminService_BulkCreateOrdersStream
+	reqs []*pb.CreateOrderRequest
+	rsp  *pb.BulkCreateOrdersResponse
+}
+
+func (s *fakeBulkCreateStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(*pb.CreateOrderRequest), s.reqs[0])
+	s.reqs = s.reqs[1:]
+	return nil
+}
+
+func (s *fakeBulkCreateStream) SendMsg(m interface{}) error {
+	s.rsp = m.(*pb.BulkCreateOrdersResponse)
+	return nil
+}
+
+func TestBulkCreateOrdersReportsRejectedOrders(t *testing.T) {
+	client := newTestClient(t)
+	admin := &AdminService{EntClient: client}
+	userID := uuid.NewString()
+	item := func(quantity int32) *pb.OrderItemRequest {
+		return &pb.OrderItemRequest{ProductId: uuid.NewString(), Quantity: quantity, UnitPriceCents: 250}
+	}
+
+	stream := &fakeBulkCreateStream{reqs: []*pb.CreateOrderRequest{
+		{UserId: userID, OrderItems: []*pb.OrderItemRequest{item(2)}},
+		{UserId: userID},
+		{UserId: userID, OrderItems: []*pb.OrderItemRequest{item(1), item(0)}},
+		{UserId: "not-a-uuid", OrderItems: []*pb.OrderItemRequest{item(1)}},
+		{UserId: userID, OrderItems: []*pb.OrderItemRequest{item(1), item(3)}},
+	}}
+	if err := admin.BulkCreateOrders(context.Background(), stream); err != nil {
+		t.Fatalf("BulkCreateOrders: %v", err)
+	}
+
+	rsp := stream.rsp
+	if rsp.Total != 2 || len(rsp.Orders) != 2 {
+		t.Fatalf("expected 2 orders created, got %d", rsp.Total)
+	}
+	if rsp.Orders[0].TotalAmountCents != 500 || rsp.Orders[1].TotalAmountCents != 1000 {
+		t.Fatalf("unexpected totals %d and %d", rsp.Orders[0].TotalAmountCents, rsp.Orders[1].TotalAmountCents)
+	}
+	want := []struct {
+		index  int32
+		reason string
+	}{
+		{1, "at least one item"},
+		{2, "order item 1: quantity must be positive"},
+		{3, "invalid user_id"},
+	}
+	if len(rsp.Failures) != len(want) {
+		t.Fatalf("expected %d failures, got %v", len(want), rsp.Failures)
+	}
+	for i, w := range want {
+		f := rsp.Failures[i]
+		if f.Index != w.index || !strings.Contains(f.Reason, w.reason) {
+			t.Errorf("failure %d: got index %d reason %q, want index %d reason containing %q", i, f.Index, f.Reason, w.index, w.reason)
+		}
+	}
+	if n := client.Order.Query().CountX(context.Background()); n != 2 {
+		t.Fatalf("expected only the valid orders stored, found %d", n)
+	}
+}
+
+// fakeExportStream keeps the orders ExportOrders sends
+type fakeExportStream struct {
+	pb.AdminService_ExportOrdersStream
+	orders []*pb.Order
+}
+
+func (s *fakeExportStream) Send(o *pb.Order) error {
+	s.orders = append(s.orders, o)
+	return nil
+}
+
+func TestExportOrdersIncludesOrderedHistory(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	admin := &AdminService{EntClient: client}
+
+	o := createTestOrder(t, client, uuid.New())
+	if err := h.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: o.ID.String(), Status: "processing"}, &pb.UpdateOrderStatusResponse{}); err != nil {
+		t.Fatalf("UpdateOrderStatus: %v", err)
+	}
+	shipment := &pb.CreateShipmentResponse{}
+	err := h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+		OrderId:        o.ID.String(),
+		Carrier:        "DHL",
+		TrackingNumber: "T1",
+		Items:          []*pb.ShipmentItem{{OrderItemId: o.Edges.OrderItems[0].ID.String(), Quantity: 2}},
+	}, shipment)
+	if err != nil {
+		t.Fatalf("CreateShipment: %v", err)
+	}
+	if err := h.MarkShipmentDelivered(ctx, &pb.MarkShipmentDeliveredRequest{Id: shipment.Shipment.Id}, &pb.MarkShipmentDeliveredResponse{}); err != nil {
+		t.Fatalf("MarkShipmentDelivered: %v", err)
+	}
+
+	stream := &fakeExportStream{}
+	if err := admin.ExportOrders(ctx, &pb.ExportOrdersRequest{}, stream); err != nil {
+		t.Fatalf("ExportOrders: %v", err)
+	}
+	if len(stream.orders) != 1 || len(stream.orders[0].History) != 0 {
+		t.Fatalf("expected one order exported without history, got %v", stream.orders)
+	}
+
+	stream = &fakeExportStream{}
+	if err := admin.ExportOrders(ctx, &pb.ExportOrdersRequest{IncludeHistory: true}, stream); err != nil {
+		t.Fatalf("ExportOrders: %v", err)
+	}
+	if len(stream.orders) != 1 {
+		t.Fatalf("expected one order exported, got %d", len(stream.orders))
+	}
+	want := [][2]string{{"pending", "processing"}, {"processing", "shipped"}, {"shipped", "delivered"}}
+	history := stream.orders[0].History
+	if len(history) != len(want) {
+		t.Fatalf("expected %d status changes, got %v", len(want), history)
+	}
+	for i, w := range want {
+		if history[i].FromStatus != w[0] || history[i].ToStatus != w[1] {
+			t.Errorf("change %d: got %s -> %s, want %s -> %s", i, history[i].FromStatus, history[i].ToStatus, w[0], w[1])
+		}
+		if i > 0 && history[i].CreatedAt < history[i-1].CreatedAt {
+			t.Errorf("change %d recorded before the change preceding it", i)
+		}
+	}
+}
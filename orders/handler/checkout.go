@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	pb "orders/proto"
+
+	cartspb "carts/proto"
+)
+
+// Checkout handles placing an order from a cart. The cart's items are ordered at current
+// catalog prices, each quantity's price tier applied, less its coupon's discount, once every item is found, active and in
+// stock. The cart is then checked out; if that fails the order is cancelled again.
+// Only an order whose cart was checked out is announced, taking its items out of stock.
+func (h *OrderService) Checkout(ctx context.Context, req *pb.CheckoutRequest, rsp *pb.CheckoutResponse) error {
+	logger.Extract(ctx).Infof("Received Checkout request for cart_id: %s", req.CartId)
+
+	if h.Carts == nil {
+		return fmt.Errorf("checkout is disabled")
+	}
+	if _, err := uuid.Parse(req.CartId); err != nil {
+		return fmt.Errorf("invalid cart_id: %s", req.CartId)
+	}
+
+	cart, err := h.Carts.GetCartWithAvailability(ctx, &cartspb.GetCartWithAvailabilityRequest{Id: req.CartId})
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get cart %s for checkout: %v", req.CartId, err)
+		return err
+	}
+	if len(cart.Cart.CartItems) == 0 {
+		logger.Extract(ctx).Infof("Rejected checkout of empty cart %s", req.CartId)
+		return errors.BadRequest("orders.Checkout", "cart is empty")
+	}
+	if !cart.AllAvailable {
+		violations := unavailableItems(cart.Cart.CartItems)
+		logger.Extract(ctx).Infof("Rejected checkout of cart %s: %d items unavailable", req.CartId, len(violations))
+		return validationError("orders.Checkout", violations)
+	}
+
+	// Order every item at the unit price the cart was just priced at, which carts resolves to
+	// the price tier for the item's quantity, so the order total matches the cart's
+	orderReq := &pb.CreateOrderRequest{
+		UserId:          cart.Cart.UserId,
+		OrderItems:      make([]*pb.OrderItemRequest, len(cart.Cart.CartItems)),
+		ShippingAddress: req.ShippingAddress,
+	}
+	for i, item := range cart.Cart.CartItems {
+		orderReq.OrderItems[i] = &pb.OrderItemRequest{
+			ProductId:      item.ProductId,
+			Quantity:       item.Quantity,
+			UnitPriceCents: item.Availability.PriceCents,
+			Currency:       item.Availability.Currency,
+		}
+	}
+	var discount *orderDiscount
+	if cart.Pricing != nil && cart.Pricing.DiscountCents > 0 {
+		discount = &orderDiscount{couponCode: cart.Cart.CouponCode, cents: cart.Pricing.DiscountCents}
+	}
+
+	o, _, err := h.placeOrder(ctx, "orders.Checkout", orderReq, discount)
+	if err != nil {
+		return err
+	}
+
+	// Check the cart out at the version that was ordered, so items changed meanwhile are not lost
+	_, err = h.Carts.CheckoutCart(ctx, &cartspb.CheckoutCartRequest{Id: req.CartId, Version: cart.Cart.Version})
+	if err != nil {
+		logger.Extract(ctx).Infof("Failed to check out cart %s, cancelling order %s: %v", req.CartId, o.ID, err)
+		// Nothing has been taken out of stock yet, so there is nothing to restock
+		if _, cancelErr := cancelOrder(ctx, h.EntClient, nil, o.ID.String(), 0); cancelErr != nil {
+			logger.Extract(ctx).Errorf("Failed to cancel order %s of cart %s: %v", o.ID, req.CartId, cancelErr)
+		}
+		return err
+	}
+
+	publishOrderCreated(ctx, h.Events, o)
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Cart %s checked out as order %s", req.CartId, o.ID)
+	return nil
+}
+
+// unavailableItems reports the cart items that can't be purchased, with the first
+// availability check each fails
+func unavailableItems(items []*cartspb.CartItem) []*pb.OrderItemViolation {
+	var violations []*pb.OrderItemViolation
+	for _, item := range items {
+		a := item.Availability
+		if a == nil || a.Available {
+			continue
+		}
+		v := &pb.OrderItemViolation{ProductId: item.ProductId, CatalogPriceCents: a.PriceCents}
+		switch {
+		case !a.Found:
+			v.Reason = ViolationNotFound
+		case !a.IsActive:
+			v.Reason = ViolationInactive
+		default:
+			v.Reason = ViolationOutOfStock
+		}
+		violations = append(violations, v)
+	}
+	return violations
+}
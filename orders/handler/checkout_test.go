@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/client"
+	"go-micro.dev/v5/errors"
+
+	"orders/ent/order"
+	pb "orders/proto"
+
+	cartspb "carts/proto"
+	productspb "products/proto"
+)
+
+// fakeCarts serves one cart and records whether it was checked out
+type fakeCarts struct {
+	cartspb.CartService
+	cart        *cartspb.GetCartWithAvailabilityResponse
+	checkoutErr error
+	checkedOut  bool
+}
+
+func (f *fakeCarts) GetCartWithAvailability(ctx context.Context, in *cartspb.GetCartWithAvailabilityRequest, opts ...client.CallOption) (*cartspb.GetCartWithAvailabilityResponse, error) {
+	return f.cart, nil
+}
+
+func (f *fakeCarts) CheckoutCart(ctx context.Context, in *cartspb.CheckoutCartRequest, opts ...client.CallOption) (*cartspb.CheckoutCartResponse, error) {
+	if f.checkoutErr != nil {
+		return nil, f.checkoutErr
+	}
+	f.checkedOut = true
+	return &cartspb.CheckoutCartResponse{Id: in.Id, Success: true}, nil
+}
+
+// newFakeCart returns a cart of two units of one available product at 500 cents,
+// discounted by 100 cents with coupon SAVE
+func newFakeCart() *fakeCarts {
+	return &fakeCarts{cart: &cartspb.GetCartWithAvailabilityResponse{
+		AllAvailable: true,
+		Cart: &cartspb.Cart{
+			Id:         uuid.NewString(),
+			UserId:     uuid.NewString(),
+			Version:    3,
+			CouponCode: "SAVE",
+			CartItems: []*cartspb.CartItem{{
+				ProductId: uuid.NewString(),
+				Quantity:  2,
+				Availability: &cartspb.CartItemAvailability{
+					Found: true, IsActive: true, InStock: true, Available: true,
+					PriceCents: 500, Currency: "USD",
+				},
+			}},
+		},
+		Pricing: &cartspb.CartPricing{SubtotalCents: 1000, DiscountCents: 100, TotalCents: 900, Currency: "USD"},
+	}}
+}
+
+func TestCheckoutPlacesDiscountedOrder(t *testing.T) {
+	carts := newFakeCart()
+	h := &OrderService{EntClient: newTestClient(t), Carts: carts}
+
+	rsp := &pb.CheckoutResponse{}
+	if err := h.Checkout(context.Background(), &pb.CheckoutRequest{CartId: carts.cart.Cart.Id}, rsp); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if rsp.Order.TotalAmountCents != 900 || rsp.Order.DiscountCents != 100 || rsp.Order.CouponCode != "SAVE" {
+		t.Fatalf("expected a 900 cent order discounted by 100 with SAVE, got %v", rsp.Order)
+	}
+	if len(rsp.Order.OrderItems) != 1 || rsp.Order.OrderItems[0].Quantity != 2 {
+		t.Fatalf("expected the cart's item on the order, got %v", rsp.Order.OrderItems)
+	}
+	if !carts.checkedOut {
+		t.Fatal("expected the cart to be checked out")
+	}
+}
+
+func TestCheckoutCancelsOrderWhenCartCheckoutFails(t *testing.T) {
+	carts := newFakeCart()
+	carts.checkoutErr = fmt.Errorf("cart not found or version mismatch")
+	h := &OrderService{EntClient: newTestClient(t), Carts: carts}
+
+	err := h.Checkout(context.Background(), &pb.CheckoutRequest{CartId: carts.cart.Cart.Id}, &pb.CheckoutResponse{})
+	if err == nil {
+		t.Fatal("expected the cart checkout error")
+	}
+	o, err := h.EntClient.Order.Query().Only(context.Background())
+	if err != nil {
+		t.Fatalf("expected the one placed order to remain: %v", err)
+	}
+	if o.Status != order.StatusCancelled {
+		t.Fatalf("expected the order to be cancelled, got %s", o.Status)
+	}
+}
+
+func TestCheckoutRejectsUnavailableItems(t *testing.T) {
+	carts := newFakeCart()
+	carts.cart.AllAvailable = false
+	a := carts.cart.Cart.CartItems[0].Availability
+	a.InStock, a.Available = false, false
+	h := &OrderService{EntClient: newTestClient(t), Carts: carts}
+
+	err := h.Checkout(context.Background(), &pb.CheckoutRequest{CartId: carts.cart.Cart.Id}, &pb.CheckoutResponse{})
+	if merr := errors.FromError(err); merr.Id != "orders.Checkout" {
+		t.Fatalf("expected an orders.Checkout error, got %v", err)
+	}
+	verr, ok := ParseValidationError(err)
+	if !ok || len(verr.Violations) != 1 || verr.Violations[0].Reason != ViolationOutOfStock {
+		t.Fatalf("expected one insufficient_stock violation, got %v", err)
+	}
+	if n := h.EntClient.Order.Query().CountX(context.Background()); n != 0 {
+		t.Fatalf("expected no order, got %d", n)
+	}
+}
+
+func TestCheckoutOrdersAtTierPrices(t *testing.T) {
+	ctx := context.Background()
+	carts := newFakeCart()
+	item := carts.cart.Cart.CartItems[0]
+	productID := uuid.MustParse(item.ProductId)
+	products := newFakeProducts(productID)
+	products.catalog[item.ProductId].PriceTiers = []*productspb.PriceTier{{MinQuantity: 2, UnitPriceCents: 450}}
+	// The cart prices its two units at the tier, as carts does
+	item.Availability.PriceCents = 450
+	carts.cart.Pricing = &cartspb.CartPricing{SubtotalCents: 900, DiscountCents: 100, TotalCents: 800, Currency: "USD"}
+	h := &OrderService{EntClient: newTestClient(t), Carts: carts, Products: products, CheckPrices: true}
+
+	rsp := &pb.CheckoutResponse{}
+	if err := h.Checkout(ctx, &pb.CheckoutRequest{CartId: carts.cart.Cart.Id}, rsp); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if rsp.Order.TotalAmountCents != carts.cart.Pricing.TotalCents {
+		t.Fatalf("expected the order total to match the cart's %d cents, got %d", carts.cart.Pricing.TotalCents, rsp.Order.TotalAmountCents)
+	}
+	if got := rsp.Order.OrderItems[0].UnitPriceCents; got != 450 {
+		t.Fatalf("expected the item at the 450 cent tier price, got %d", got)
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"orders/currency"
+)
+
+// defaultCurrency is used for orders whose items don't name a currency
+const defaultCurrency = currency.Default
+
+// normalizeCurrency validates an ISO 4217 code and returns it upper-cased
+func normalizeCurrency(code string) (string, error) {
+	c := strings.ToUpper(strings.TrimSpace(code))
+	if !currency.Supported(c) {
+		return "", fmt.Errorf("unsupported currency: %q", code)
+	}
+	return c, nil
+}
+
+// commonCurrency returns the single currency shared by all items, ignoring unset ones and
+// defaulting to defaultCurrency, and rejects items that mix currencies
+func commonCurrency(codes []string) (string, error) {
+	currency := ""
+	for _, code := range codes {
+		if code == "" {
+			continue
+		}
+		c, err := normalizeCurrency(code)
+		if err != nil {
+			return "", err
+		}
+		if currency != "" && c != currency {
+			return "", fmt.Errorf("order items mix currencies: %s and %s", currency, c)
+		}
+		currency = c
+	}
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	return currency, nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"orders/ent"
+)
+
+func TestOrderCurrencyIsValidatedAtSave(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	for _, code := range []string{"", "usd", "XYZ", "EURO"} {
+		_, err := client.Order.Create().
+			SetUserID(uuid.New()).
+			SetTotalAmountCents(1000).
+			SetCurrency(code).
+			Save(ctx)
+		if !ent.IsValidationError(err) {
+			t.Errorf("currency %q: expected a validation error, got %v", code, err)
+		}
+	}
+
+	o := createTestOrder(t, client, uuid.New())
+	if o.Currency != defaultCurrency {
+		t.Fatalf("expected the default currency %s, got %s", defaultCurrency, o.Currency)
+	}
+	if err := client.Order.UpdateOne(o).SetCurrency("ABC").Exec(ctx); !ent.IsValidationError(err) {
+		t.Fatalf("expected updating to an unsupported currency to fail validation, got %v", err)
+	}
+	if err := client.Order.UpdateOne(o).SetCurrency("KES").Exec(ctx); err != nil {
+		t.Fatalf("updating to a supported currency: %v", err)
+	}
+}
+
+func TestCommonCurrency(t *testing.T) {
+	tests := []struct {
+		codes   []string
+		want    string
+		wantErr bool
+	}{
+		{codes: nil, want: defaultCurrency},
+		{codes: []string{"", " eur ", "EUR"}, want: "EUR"},
+		{codes: []string{"EUR", "GBP"}, wantErr: true},
+		{codes: []string{"XYZ"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := commonCurrency(tt.codes)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("commonCurrency(%q) = %q, %v; want %q, error %v", tt.codes, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
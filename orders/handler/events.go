@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+
+	"go-micro.dev/v5"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	pb "orders/proto"
+)
+
+// OrderCreatedTopic is the topic new orders are published on
+const OrderCreatedTopic = "orders.created"
+
+// publishOrderCreated announces a committed order so other services (e.g. products
+// stock) can react. The order is already stored, so a failed publish is logged rather
+// than failing the request; subscribers must tolerate redelivery of the same order.
+func publishOrderCreated(ctx context.Context, events micro.Event, o *ent.Order) {
+	if events == nil {
+		return
+	}
+
+	ev := &pb.OrderCreatedEvent{
+		OrderId:   o.ID.String(),
+		UserId:    o.UserID.String(),
+		Items:     make([]*pb.OrderCreatedEventItem, len(o.Edges.OrderItems)),
+		CreatedAt: o.CreatedAt.Unix(),
+	}
+	for i, item := range o.Edges.OrderItems {
+		ev.Items[i] = &pb.OrderCreatedEventItem{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+		}
+	}
+
+	if err := events.Publish(ctx, ev); err != nil {
+		logger.Extract(ctx).Errorf("Failed to publish %s event for order %s: %v", OrderCreatedTopic, o.ID, err)
+	}
+}
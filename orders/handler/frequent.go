@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/order"
+	"orders/ent/orderitem"
+	pb "orders/proto"
+)
+
+// productFrequency is how often a user has ordered a product, as scanned from the aggregate
+type productFrequency struct {
+	ProductID     uuid.UUID `json:"product_id"`
+	OrderCount    int       `json:"order_count"`
+	TotalQuantity int       `json:"total_quantity"`
+}
+
+// GetFrequentlyOrdered handles ranking the products a user orders most often, for "buy it
+// again" suggestions. A product counts once per non-cancelled order that contains it.
+func (h *OrderService) GetFrequentlyOrdered(ctx context.Context, req *pb.GetFrequentlyOrderedRequest, rsp *pb.GetFrequentlyOrderedResponse) error {
+	logger.Extract(ctx).Infof("Received GetFrequentlyOrdered request (user_id: %s, limit: %d)", req.UserId, req.Limit)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return errors.BadRequest("orders.GetFrequentlyOrdered", "invalid user_id: %s", req.UserId)
+	}
+
+	var counts []productFrequency
+	err = h.EntClient.OrderItem.Query().
+		Where(orderitem.HasOrderWith(
+			order.UserID(userID),
+			order.DeletedAtIsNil(),
+			order.StatusNEQ(order.StatusCancelled),
+		)).
+		GroupBy(orderitem.FieldProductID).
+		Aggregate(
+			// An order may list a product on several lines, so count orders rather than lines
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(orderitem.OrderColumn)), "order_count")
+			},
+			ent.As(ent.Sum(orderitem.FieldQuantity), "total_quantity"),
+		).
+		Scan(ctx, &counts)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count ordered products of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to count ordered products: %w", err)
+	}
+
+	// Ties are broken by quantity, then product ID so the ranking is stable
+	slices.SortFunc(counts, func(a, b productFrequency) int {
+		if c := cmp.Compare(b.OrderCount, a.OrderCount); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.TotalQuantity, a.TotalQuantity); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ProductID.String(), b.ProductID.String())
+	})
+	if req.Limit > 0 && len(counts) > int(req.Limit) {
+		counts = counts[:req.Limit]
+	}
+
+	rsp.Products = make([]*pb.FrequentlyOrderedProduct, len(counts))
+	for i, c := range counts {
+		rsp.Products[i] = &pb.FrequentlyOrderedProduct{
+			ProductId:     c.ProductID.String(),
+			OrderCount:    int32(c.OrderCount),
+			TotalQuantity: int32(c.TotalQuantity),
+		}
+	}
+	logger.Extract(ctx).Infof("Ranked %d frequently ordered products of user %s", len(rsp.Products), req.UserId)
+	return nil
+}
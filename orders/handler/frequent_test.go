@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+func TestGetFrequentlyOrderedRanksByOrderCount(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	userID := uuid.New()
+	a, b, c, cancelled, deleted := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// place stores an order of user with the given status, one line per product of quantity
+	place := func(user uuid.UUID, status order.Status, quantity int, products ...uuid.UUID) uuid.UUID {
+		t.Helper()
+		o := client.Order.Create().SetUserID(user).SetTotalAmountCents(1000).SetStatus(status).SaveX(ctx)
+		for _, p := range products {
+			client.OrderItem.Create().SetOrderID(o.ID).SetProductID(p).SetQuantity(quantity).SetUnitPriceCents(500).ExecX(ctx)
+		}
+		return o.ID
+	}
+	// a listed twice in one order still counts that order once
+	place(userID, order.StatusDelivered, 1, a, a, b)
+	place(userID, order.StatusShipped, 4, a, b)
+	place(userID, order.StatusPending, 1, a, c)
+	place(userID, order.StatusDelivered, 1, c)
+	place(userID, order.StatusCancelled, 1, cancelled)
+	place(userID, order.StatusCancelled, 1, cancelled)
+	place(userID, order.StatusCancelled, 1, cancelled)
+	client.Order.UpdateOneID(place(userID, order.StatusDelivered, 1, deleted)).SetDeletedAt(time.Now()).ExecX(ctx)
+	place(uuid.New(), order.StatusDelivered, 1, c, c, c)
+
+	rsp := &pb.GetFrequentlyOrderedResponse{}
+	if err := h.GetFrequentlyOrdered(ctx, &pb.GetFrequentlyOrderedRequest{UserId: userID.String()}, rsp); err != nil {
+		t.Fatalf("GetFrequentlyOrdered: %v", err)
+	}
+	// b and c are both in two orders; b ranks first on quantity
+	want := []struct {
+		id               uuid.UUID
+		orders, quantity int32
+	}{{a, 3, 4 + 1 + 1 + 1}, {b, 2, 5}, {c, 2, 2}}
+	if len(rsp.Products) != len(want) {
+		t.Fatalf("expected %d ranked products, got %v", len(want), rsp.Products)
+	}
+	for i, w := range want {
+		got := rsp.Products[i]
+		if got.ProductId != w.id.String() || got.OrderCount != w.orders || got.TotalQuantity != w.quantity {
+			t.Errorf("rank %d: expected %s in %d orders for %d units, got %v", i, w.id, w.orders, w.quantity, got)
+		}
+	}
+
+	limited := &pb.GetFrequentlyOrderedResponse{}
+	if err := h.GetFrequentlyOrdered(ctx, &pb.GetFrequentlyOrderedRequest{UserId: userID.String(), Limit: 1}, limited); err != nil {
+		t.Fatalf("GetFrequentlyOrdered: %v", err)
+	}
+	if len(limited.Products) != 1 || limited.Products[0].ProductId != a.String() {
+		t.Fatalf("expected only %s with a limit of 1, got %v", a, limited.Products)
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"go-micro.dev/v5/client"
+
+	"orders/ent"
+	"orders/ent/enttest"
+	"orders/ent/order"
+
+	productspb "products/proto"
+)
+
+// newTestClient opens a migrated in-memory SQLite database private to the test
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestOrder stores a pending order of userID with one item of quantity 2 at 500 cents
+func createTestOrder(t *testing.T, client *ent.Client, userID uuid.UUID) *ent.Order {
+	t.Helper()
+	ctx := context.Background()
+	o, err := client.Order.Create().
+		SetUserID(userID).
+		SetTotalAmountCents(1000).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("creating order: %v", err)
+	}
+	_, err = client.OrderItem.Create().
+		SetOrderID(o.ID).
+		SetProductID(uuid.New()).
+		SetQuantity(2).
+		SetUnitPriceCents(500).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("creating order item: %v", err)
+	}
+	return client.Order.Query().Where(order.ID(o.ID)).WithOrderItems().OnlyX(ctx)
+}
+
+// fakeProducts serves product lookups from a fixed catalog and records restocks
+type fakeProducts struct {
+	productspb.ProductService
+	catalog   map[string]*productspb.Product
+	restocked []*productspb.IncrementStockRequest
+}
+
+// newFakeProducts returns a catalog of active products priced at 500 USD cents with 10 in stock
+func newFakeProducts(productIDs ...uuid.UUID) *fakeProducts {
+	f := &fakeProducts{catalog: make(map[string]*productspb.Product, len(productIDs))}
+	for _, id := range productIDs {
+		f.catalog[id.String()] = &productspb.Product{
+			Id:            id.String(),
+			Name:          "Product " + id.String()[:8],
+			PriceCents:    500,
+			Currency:      "USD",
+			StockQuantity: 10,
+			IsActive:      true,
+		}
+	}
+	return f
+}
+
+func (f *fakeProducts) GetProductsByIDs(ctx context.Context, in *productspb.GetProductsByIDsRequest, opts ...client.CallOption) (*productspb.GetProductsByIDsResponse, error) {
+	rsp := &productspb.GetProductsByIDsResponse{}
+	for _, id := range in.Ids {
+		if p, ok := f.catalog[id]; ok {
+			rsp.Products = append(rsp.Products, p)
+		}
+	}
+	return rsp, nil
+}
+
+func (f *fakeProducts) IncrementStock(ctx context.Context, in *productspb.IncrementStockRequest, opts ...client.CallOption) (*productspb.IncrementStockResponse, error) {
+	f.restocked = append(f.restocked, in)
+	return &productspb.IncrementStockResponse{Restocked: true}, nil
+}
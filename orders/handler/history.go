@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"orders/ent"
+	"orders/ent/order"
+	"orders/ent/orderevent"
+	pb "orders/proto"
+)
+
+// recordStatusChange appends a status change to an order's history; from is empty for a newly created order
+func recordStatusChange(ctx context.Context, tx *ent.Tx, orderID uuid.UUID, from, to order.Status) error {
+	create := tx.OrderEvent.Create().
+		SetOrderID(orderID).
+		SetToStatus(orderevent.ToStatus(to))
+	if from != "" {
+		create.SetFromStatus(orderevent.FromStatus(from))
+	}
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+	return nil
+}
+
+// transitionStatus moves an order from one status to another and records the change in
+// the same transaction. It returns a NotFound error when the order is no longer in from,
+// or when to progresses an order that has since been put on fraud hold.
+func transitionStatus(ctx context.Context, client *ent.Client, orderID uuid.UUID, from, to order.Status) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	update := tx.Order.UpdateOneID(orderID).
+		Where(order.StatusEQ(from))
+	if progressesOrder(to) {
+		update.Where(order.FraudHold(false))
+	}
+	err = update.
+		SetStatus(to).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if from != to {
+		if err := recordStatusChange(ctx, tx, orderID, from, to); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// toProtoStatusChanges converts an order's history to Protobuf, oldest first as loaded
+func toProtoStatusChanges(events []*ent.OrderEvent) []*pb.OrderStatusChange {
+	changes := make([]*pb.OrderStatusChange, len(events))
+	for i, e := range events {
+		changes[i] = &pb.OrderStatusChange{
+			ToStatus:  e.ToStatus.String(),
+			CreatedAt: e.CreatedAt.Unix(),
+		}
+		if e.FromStatus != nil {
+			changes[i].FromStatus = e.FromStatus.String()
+		}
+	}
+	return changes
+}
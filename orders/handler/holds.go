@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+// progressesOrder reports whether moving an order to status takes it beyond pending,
+// which a fraud hold forbids; cancelling a held order is still allowed
+func progressesOrder(status order.Status) bool {
+	return status == order.StatusProcessing || status == order.StatusShipped || status == order.StatusDelivered
+}
+
+// PlaceFraudHold holds an active order for a risk review so it cannot progress beyond
+// pending until ReleaseFraudHold. Holding a held order replaces its reason (admin privilege).
+func (h *AdminService) PlaceFraudHold(ctx context.Context, req *pb.PlaceFraudHoldRequest, rsp *pb.PlaceFraudHoldResponse) error {
+	logger.Extract(ctx).Infof("Received PlaceFraudHold request for ID: %s, reason: %s (Admin operation)", req.Id, req.Reason)
+
+	orderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid order id: %s", req.Id)
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+
+	// Delivered and cancelled orders have nothing left to hold
+	err = h.EntClient.Order.UpdateOneID(orderID).
+		Where(order.DeletedAtIsNil(), order.StatusIn(activeStatuses...)).
+		SetFraudHold(true).
+		SetHoldReason(reason).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("No active order found to hold: %s", req.Id)
+		return fmt.Errorf("active order not found: %s", req.Id)
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to place fraud hold on order %s: %v", req.Id, err)
+		return fmt.Errorf("failed to place fraud hold: %w", err)
+	}
+
+	o, err := h.EntClient.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch held order %s: %v", req.Id, err)
+		return fmt.Errorf("failed to fetch order: %w", err)
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Fraud hold placed on order %s", req.Id)
+	return nil
+}
+
+// ReleaseFraudHold lifts an order's fraud hold so it can progress again; releasing an
+// order that is not held changes nothing (admin privilege)
+func (h *AdminService) ReleaseFraudHold(ctx context.Context, req *pb.ReleaseFraudHoldRequest, rsp *pb.ReleaseFraudHoldResponse) error {
+	logger.Extract(ctx).Infof("Received ReleaseFraudHold request for ID: %s (Admin operation)", req.Id)
+
+	orderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid order id: %s", req.Id)
+	}
+
+	err = h.EntClient.Order.UpdateOneID(orderID).
+		Where(order.DeletedAtIsNil()).
+		SetFraudHold(false).
+		ClearHoldReason().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order not found for hold release: %s", req.Id)
+		return fmt.Errorf("order not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to release fraud hold on order %s: %v", req.Id, err)
+		return fmt.Errorf("failed to release fraud hold: %w", err)
+	}
+
+	o, err := h.EntClient.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch released order %s: %v", req.Id, err)
+		return fmt.Errorf("failed to fetch order: %w", err)
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Fraud hold released on order %s", req.Id)
+	return nil
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+func TestFraudHoldBlocksProgressionUntilReleased(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	admin := &AdminService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+
+	setStatus := func(status string) error {
+		return h.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: o.ID.String(), Status: status}, &pb.UpdateOrderStatusResponse{})
+	}
+
+	if err := admin.PlaceFraudHold(ctx, &pb.PlaceFraudHoldRequest{Id: o.ID.String(), Reason: "  "}, &pb.PlaceFraudHoldResponse{}); err == nil {
+		t.Fatal("expected a hold without a reason to be rejected")
+	}
+	held := &pb.PlaceFraudHoldResponse{}
+	if err := admin.PlaceFraudHold(ctx, &pb.PlaceFraudHoldRequest{Id: o.ID.String(), Reason: "card mismatch"}, held); err != nil {
+		t.Fatalf("PlaceFraudHold: %v", err)
+	}
+	if !held.Order.FraudHold || held.Order.HoldReason != "card mismatch" {
+		t.Fatalf("expected the order held for card mismatch, got %v, %q", held.Order.FraudHold, held.Order.HoldReason)
+	}
+
+	if err := setStatus("processing"); err == nil {
+		t.Fatal("expected a held order not to move to processing")
+	}
+	ship := func() (*pb.CreateShipmentResponse, error) {
+		rsp := &pb.CreateShipmentResponse{}
+		err := h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+			OrderId:        o.ID.String(),
+			Carrier:        "DHL",
+			TrackingNumber: "T1",
+			Items:          []*pb.ShipmentItem{{OrderItemId: o.Edges.OrderItems[0].ID.String(), Quantity: 2}},
+		}, rsp)
+		return rsp, err
+	}
+	if _, err := ship(); err == nil {
+		t.Fatal("expected a held order not to be shipped")
+	}
+	if got := client.Order.GetX(ctx, o.ID).Status; got != order.StatusPending {
+		t.Fatalf("expected the held order still pending, got %s", got)
+	}
+
+	released := &pb.ReleaseFraudHoldResponse{}
+	if err := admin.ReleaseFraudHold(ctx, &pb.ReleaseFraudHoldRequest{Id: o.ID.String()}, released); err != nil {
+		t.Fatalf("ReleaseFraudHold: %v", err)
+	}
+	if released.Order.FraudHold || released.Order.HoldReason != "" {
+		t.Fatalf("expected the hold lifted, got %v, %q", released.Order.FraudHold, released.Order.HoldReason)
+	}
+	if err := setStatus("processing"); err != nil {
+		t.Fatalf("expected a released order to move to processing, got %v", err)
+	}
+	shipped, err := ship()
+	if err != nil {
+		t.Fatalf("expected a released order to ship, got %v", err)
+	}
+	if shipped.OrderStatus != "shipped" {
+		t.Fatalf("expected the order shipped, got %s", shipped.OrderStatus)
+	}
+}
+
+func TestFraudHoldStillAllowsCancelling(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	admin := &AdminService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+
+	if err := admin.PlaceFraudHold(ctx, &pb.PlaceFraudHoldRequest{Id: o.ID.String(), Reason: "velocity"}, &pb.PlaceFraudHoldResponse{}); err != nil {
+		t.Fatalf("PlaceFraudHold: %v", err)
+	}
+	if err := h.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: o.ID.String(), Status: "cancelled"}, &pb.UpdateOrderStatusResponse{}); err != nil {
+		t.Fatalf("expected a held order to be cancellable, got %v", err)
+	}
+
+	// A cancelled order has nothing left to hold
+	if err := admin.PlaceFraudHold(ctx, &pb.PlaceFraudHoldRequest{Id: o.ID.String(), Reason: "velocity"}, &pb.PlaceFraudHoldResponse{}); err == nil {
+		t.Fatal("expected holding a cancelled order to fail")
+	}
+}
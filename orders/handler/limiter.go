@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/metadata"
+)
+
+// ExportLimiter keeps ExportOrders from overwhelming the database: at most MaxConcurrent
+// exports stream at once and each caller may start one export per Interval. Zero values
+// disable the respective limit, and a nil *ExportLimiter allows everything.
+type ExportLimiter struct {
+	MaxConcurrent int
+	Interval      time.Duration
+
+	mu        sync.Mutex
+	running   int
+	lastStart map[string]time.Time
+}
+
+// acquire reserves an export for caller, returning a func releasing it once the export is
+// done, or a ResourceExhausted (429) error when a limit is hit
+func (l *ExportLimiter) acquire(caller string) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.Interval > 0 {
+		if last, ok := l.lastStart[caller]; ok && now.Sub(last) < l.Interval {
+			wait := (l.Interval - now.Sub(last)).Truncate(time.Second) + time.Second
+			return nil, errors.New("orders.ExportOrders", "export rate limit exceeded, retry in "+wait.String(), http.StatusTooManyRequests)
+		}
+	}
+	if l.MaxConcurrent > 0 && l.running >= l.MaxConcurrent {
+		return nil, errors.New("orders.ExportOrders", "too many exports in progress, retry later", http.StatusTooManyRequests)
+	}
+
+	l.running++
+	if l.Interval > 0 {
+		if l.lastStart == nil {
+			l.lastStart = make(map[string]time.Time)
+		}
+		// Forget callers whose interval has passed so the map doesn't grow without bound
+		for c, last := range l.lastStart {
+			if now.Sub(last) >= l.Interval {
+				delete(l.lastStart, c)
+			}
+		}
+		l.lastStart[caller] = now
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.running--
+			l.mu.Unlock()
+		})
+	}, nil
+}
+
+// exportCaller identifies the caller of an RPC by its remote host, ignoring the port
+func exportCaller(ctx context.Context) string {
+	remote, ok := metadata.Get(ctx, "Remote")
+	if !ok || remote == "" {
+		return "unknown"
+	}
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		return host
+	}
+	return remote
+}
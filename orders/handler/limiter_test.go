@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/metadata"
+
+	pb "orders/proto"
+)
+
+// blockingExportStream holds ExportOrders in its first Send until unblock is closed
+type blockingExportStream struct {
+	pb.AdminService_ExportOrdersStream
+	sending chan<- struct{}
+	unblock <-chan struct{}
+}
+
+func (s *blockingExportStream) Send(o *pb.Order) error {
+	s.sending <- struct{}{}
+	<-s.unblock
+	return nil
+}
+
+// callerContext returns a context whose RPC came from host
+func callerContext(host string) context.Context {
+	return metadata.NewContext(context.Background(), metadata.Metadata{"Remote": host + ":4242"})
+}
+
+func TestExportOrdersConcurrencyGuard(t *testing.T) {
+	client := newTestClient(t)
+	createTestOrder(t, client, uuid.New())
+	admin := &AdminService{EntClient: client, Exports: &ExportLimiter{MaxConcurrent: 2}}
+
+	sending, unblock := make(chan struct{}), make(chan struct{})
+	done := make(chan error, 2)
+	for _, host := range []string{"10.0.0.1", "10.0.0.2"} {
+		go func() {
+			done <- admin.ExportOrders(callerContext(host), &pb.ExportOrdersRequest{}, &blockingExportStream{sending: sending, unblock: unblock})
+		}()
+		<-sending
+	}
+
+	// Both slots are streaming, so a third export is refused whoever asks
+	err := admin.ExportOrders(callerContext("10.0.0.3"), &pb.ExportOrdersRequest{}, &fakeExportStream{})
+	if code := errors.FromError(err).Code; code != http.StatusTooManyRequests {
+		t.Fatalf("expected a third concurrent export to be refused with 429, got %v", err)
+	}
+
+	close(unblock)
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Fatalf("ExportOrders: %v", err)
+		}
+	}
+	stream := &fakeExportStream{}
+	if err := admin.ExportOrders(callerContext("10.0.0.3"), &pb.ExportOrdersRequest{}, stream); err != nil {
+		t.Fatalf("expected an export once the others finished, got %v", err)
+	}
+	if len(stream.orders) != 1 {
+		t.Fatalf("expected 1 order exported, got %d", len(stream.orders))
+	}
+}
+
+func TestExportOrdersRateLimitsEachCaller(t *testing.T) {
+	client := newTestClient(t)
+	admin := &AdminService{EntClient: client, Exports: &ExportLimiter{Interval: time.Hour}}
+
+	if err := admin.ExportOrders(callerContext("10.0.0.1"), &pb.ExportOrdersRequest{}, &fakeExportStream{}); err != nil {
+		t.Fatalf("ExportOrders: %v", err)
+	}
+	err := admin.ExportOrders(callerContext("10.0.0.1"), &pb.ExportOrdersRequest{}, &fakeExportStream{})
+	if code := errors.FromError(err).Code; code != http.StatusTooManyRequests {
+		t.Fatalf("expected a repeat export within the interval to be refused with 429, got %v", err)
+	}
+	if err := admin.ExportOrders(callerContext("10.0.0.2"), &pb.ExportOrdersRequest{}, &fakeExportStream{}); err != nil {
+		t.Fatalf("expected another caller to be allowed, got %v", err)
+	}
+}
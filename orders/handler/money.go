@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"fmt"
+	"math"
+)
+
+// Amounts are stored as integer minor units (cents) so sums are exact; the
+// double fields on the proto remain only for clients that predate the change.
+
+// toCents converts a decimal amount to cents, rounding to the nearest cent
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// requestCents prefers an explicit cents value, falling back to the legacy decimal field
+func requestCents(cents int64, legacy float64) int64 {
+	if cents != 0 {
+		return cents
+	}
+	return toCents(legacy)
+}
+
+// fromCents converts cents to a decimal amount for the legacy double fields
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// formatCents renders cents as a decimal string, e.g. 1999 -> "19.99"
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
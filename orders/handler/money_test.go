@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "orders/proto"
+)
+
+func TestMoneyConversions(t *testing.T) {
+	for amount, want := range map[float64]int64{0.1: 10, 0.2: 20, 19.99: 1999, 1e6: 100000000} {
+		if got := toCents(amount); got != want {
+			t.Errorf("toCents(%v) = %d, want %d", amount, got, want)
+		}
+	}
+	for cents, want := range map[int64]string{0: "0.00", 5: "0.05", 1999: "19.99", -250: "-2.50"} {
+		if got := formatCents(cents); got != want {
+			t.Errorf("formatCents(%d) = %q, want %q", cents, got, want)
+		}
+	}
+	if got := requestCents(30, 99.99); got != 30 {
+		t.Errorf("expected explicit cents to win over the legacy amount, got %d", got)
+	}
+}
+
+func TestCreateOrderTotalIsExact(t *testing.T) {
+	h := &OrderService{EntClient: newTestClient(t)}
+	rsp := &pb.CreateOrderResponse{}
+	err := h.CreateOrder(context.Background(), &pb.CreateOrderRequest{
+		UserId: uuid.NewString(),
+		OrderItems: []*pb.OrderItemRequest{
+			{ProductId: uuid.NewString(), Quantity: 1, UnitPrice: 0.1},
+			{ProductId: uuid.NewString(), Quantity: 1, UnitPrice: 0.2},
+			{ProductId: uuid.NewString(), Quantity: 3, UnitPriceCents: 333},
+		},
+	}, rsp)
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	// 0.1 + 0.2 is 0.30000000000000004 in floating point
+	if rsp.Order.TotalAmountCents != 1029 || rsp.Order.TotalAmountDecimal != "10.29" {
+		t.Fatalf("expected a total of exactly 1029 cents (10.29), got %d (%s)", rsp.Order.TotalAmountCents, rsp.Order.TotalAmountDecimal)
+	}
+}
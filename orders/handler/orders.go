@@ -1,72 +1,227 @@
 package handler
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"go-micro.dev/v5"
+	"go-micro.dev/v5/errors"
 	"go-micro.dev/v5/logger"
 
 	"orders/ent"
 	"orders/ent/order"
+	"orders/ent/orderitem"
+	"orders/ent/predicate"
+	"orders/ent/shipment"
 	pb "orders/proto"
+
+	cartspb "carts/proto"
+	productspb "products/proto"
+	userspb "users/proto"
 )
 
 // OrderService implements the OrderServiceServer interface
 type OrderService struct {
 	EntClient *ent.Client
+	// CancellationWindow is how long after placing an order a customer may cancel it; zero disables the limit
+	CancellationWindow time.Duration
+	// Products validates that ordered items exist in the catalog and restocks cancelled
+	// orders; nil disables both
+	Products productspb.ProductService
+	// CheckPrices rejects items whose unit price differs from the catalog price by more
+	// than PriceToleranceCents; it has no effect without Products
+	CheckPrices         bool
+	PriceToleranceCents int64
+	// Events publishes new orders on OrderCreatedTopic; nil disables publishing
+	Events micro.Event
+	// Users resolves customer emails for SearchOrders; nil disables searching by email
+	Users userspb.UserService
+	// Region is stamped on created orders; empty leaves them without one
+	Region string
+	// Carts supplies the carts Checkout places orders from; nil disables Checkout
+	Carts cartspb.CartService
 }
 
 // CreateOrder handles the creation of a new order
 func (h *OrderService) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest, rsp *pb.CreateOrderResponse) error {
-	logger.Infof("Received CreateOrder request for user_id: %s", req.UserId)
+	logger.Extract(ctx).Infof("Received CreateOrder request for user_id: %s", req.UserId)
+
+	o, created, err := h.placeOrder(ctx, "orders.CreateOrder", req, nil)
+	if err != nil {
+		return err
+	}
+	if created {
+		publishOrderCreated(ctx, h.Events, o)
+		logger.Extract(ctx).Infof("Order created successfully: %s", o.ID)
+	}
+
+	rsp.Order = toProtoOrder(o)
+	return nil
+}
+
+// orderDiscount is a coupon discount taken off the total of a placed order
+type orderDiscount struct {
+	couponCode string
+	cents      int64
+}
+
+// placeOrder validates and stores an order without announcing it, returning it with its
+// items and whether it was created rather than found by its idempotency key
+func (h *OrderService) placeOrder(ctx context.Context, id string, req *pb.CreateOrderRequest, discount *orderDiscount) (*ent.Order, bool, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+	if err := checkOrderItems(id, req.OrderItems); err != nil {
+		logger.Extract(ctx).Infof("Rejected order for user_id %s: %v", req.UserId, err)
+		return nil, false, err
+	}
+	var address *pb.ShippingAddress
+	if req.ShippingAddress != nil {
+		if address, err = normalizeShippingAddress(id, req.ShippingAddress); err != nil {
+			logger.Extract(ctx).Infof("Rejected order for user_id %s: %v", req.UserId, err)
+			return nil, false, err
+		}
+	}
+
+	// A retry of a create that already succeeded returns the original order
+	if req.IdempotencyKey != "" {
+		existing, err := h.orderByIdempotencyKey(ctx, userID, req.IdempotencyKey)
+		if err != nil && !ent.IsNotFound(err) {
+			logger.Extract(ctx).Errorf("Failed to look up idempotency key for user_id %s: %v", req.UserId, err)
+			return nil, false, fmt.Errorf("failed to look up idempotency key: %w", err)
+		}
+		if existing != nil {
+			logger.Extract(ctx).Infof("Returning existing order %s for idempotency key of user_id %s", existing.ID, req.UserId)
+			return existing, false, nil
+		}
+	}
+
+	// Validate order items and snapshot product names before touching the database
+	productIDs := make([]uuid.UUID, len(req.OrderItems))
+	productNames := make([]string, len(req.OrderItems))
+	itemCurrencies := make([]string, len(req.OrderItems))
+	for i, item := range req.OrderItems {
+		productID, err := uuid.Parse(item.ProductId)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid product_id: %s", item.ProductId)
+		}
+		productIDs[i] = productID
+		itemCurrencies[i] = item.Currency
+	}
+	if h.Products != nil {
+		catalog, err := h.validateItems(ctx, id, req.OrderItems)
+		if err != nil {
+			logger.Extract(ctx).Infof("Rejected order for user_id %s: %v", req.UserId, err)
+			return nil, false, err
+		}
+		for i, item := range req.OrderItems {
+			productNames[i] = catalog[item.ProductId].Name
+			itemCurrencies[i] = catalog[item.ProductId].Currency
+		}
+	}
+
+	// All items must share one currency, which becomes the order's currency
+	currency, err := commonCurrency(itemCurrencies)
+	if err != nil {
+		logger.Extract(ctx).Infof("Rejected order for user_id %s: %v", req.UserId, err)
+		return nil, false, err
+	}
 
-	// Calculate total amount
-	var totalAmount float64
+	// Calculate total amount in cents so the sum is exact
+	var totalAmount int64
 	for _, item := range req.OrderItems {
-		totalAmount += float64(item.Quantity) * item.UnitPrice
+		totalAmount += int64(item.Quantity) * requestCents(item.UnitPriceCents, item.UnitPrice)
+	}
+	if discount != nil {
+		totalAmount -= discount.cents
+		if totalAmount <= 0 {
+			logger.Extract(ctx).Infof("Rejected order for user_id %s: discount of %d cents leaves nothing to pay", req.UserId, discount.cents)
+			return nil, false, errors.BadRequest(id, "order total must be positive after the discount")
+		}
 	}
 
 	// Start a transaction
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		logger.Errorf("Failed to start transaction: %v", err)
-		return fmt.Errorf("failed to start transaction: %w", err)
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return nil, false, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback()
 
 	// Create order
-	o, err := tx.Order.Create().
-		SetUserID(uuid.MustParse(req.UserId)).
-		SetTotalAmount(totalAmount).
-		Save(ctx)
+	create := tx.Order.Create().
+		SetUserID(userID).
+		SetTotalAmountCents(totalAmount).
+		SetCurrency(currency)
+	if req.IdempotencyKey != "" {
+		create.SetIdempotencyKey(req.IdempotencyKey)
+	}
+	if h.Region != "" {
+		create.SetRegion(h.Region)
+	}
+	if address != nil {
+		setShippingAddress(create.Mutation(), address)
+	}
+	if discount != nil {
+		create.SetDiscountCents(discount.cents)
+		if discount.couponCode != "" {
+			create.SetCouponCode(discount.couponCode)
+		}
+	}
+	o, err := create.Save(ctx)
+	if ent.IsConstraintError(err) && req.IdempotencyKey != "" {
+		// A concurrent request with the same key won the race; return its order
+		tx.Rollback()
+		existing, lookupErr := h.orderByIdempotencyKey(ctx, userID, req.IdempotencyKey)
+		if lookupErr != nil {
+			logger.Extract(ctx).Errorf("Failed to fetch order for idempotency key of user_id %s: %v", req.UserId, lookupErr)
+			return nil, false, fmt.Errorf("failed to fetch order: %w", lookupErr)
+		}
+		logger.Extract(ctx).Infof("Returning existing order %s for idempotency key of user_id %s", existing.ID, req.UserId)
+		return existing, false, nil
+	}
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation: %v", err)
-		return fmt.Errorf("constraint violation: %w", err)
+		logger.Extract(ctx).Errorf("Constraint violation: %v", err)
+		return nil, false, fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to create order: %v", err)
-		return fmt.Errorf("failed to create order: %w", err)
+		logger.Extract(ctx).Errorf("Failed to create order: %v", err)
+		return nil, false, fmt.Errorf("failed to create order: %w", err)
+	}
+	if err := recordStatusChange(ctx, tx, o.ID, "", o.Status); err != nil {
+		logger.Extract(ctx).Errorf("Failed to record initial status of order %s: %v", o.ID, err)
+		return nil, false, err
 	}
 
 	// Create order items
-	for _, item := range req.OrderItems {
-		_, err = tx.OrderItem.Create().
+	for i, item := range req.OrderItems {
+		create := tx.OrderItem.Create().
 			SetOrderID(o.ID).
-			SetProductID(uuid.MustParse(item.ProductId)).
+			SetProductID(productIDs[i]).
 			SetQuantity(int(item.Quantity)).
-			SetUnitPrice(item.UnitPrice).
-			Save(ctx)
+			SetUnitPriceCents(requestCents(item.UnitPriceCents, item.UnitPrice)).
+			SetPosition(i)
+		if productNames[i] != "" {
+			create.SetProductName(productNames[i])
+		}
+		_, err = create.Save(ctx)
 		if err != nil {
-			logger.Errorf("Failed to create order item for product %s: %v", item.ProductId, err)
-			return fmt.Errorf("failed to create order item: %w", err)
+			logger.Extract(ctx).Errorf("Failed to create order item for product %s: %v", item.ProductId, err)
+			return nil, false, fmt.Errorf("failed to create order item: %w", err)
 		}
 	}
 
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
-		logger.Errorf("Failed to commit transaction: %v", err)
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		logger.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	// Fetch order with items
@@ -75,63 +230,169 @@ func (h *OrderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		WithOrderItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch order with items: %v", err)
-		return fmt.Errorf("failed to fetch order: %w", err)
+		logger.Extract(ctx).Errorf("Failed to fetch order with items: %v", err)
+		return nil, false, fmt.Errorf("failed to fetch order: %w", err)
 	}
 
-	rsp.Order = toProtoOrder(oWithItems)
-	logger.Infof("Order created successfully: %s", o.ID)
-	return nil
+	return oWithItems, true, nil
 }
 
-// GetOrder handles fetching an order by ID
-func (h *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest, rsp *pb.GetOrderResponse) error {
-	logger.Infof("Received GetOrder request for ID: %s", req.Id)
-
-	o, err := h.EntClient.Order.Query().
-		Where(order.ID(uuid.MustParse(req.Id))).
+// orderByIdempotencyKey returns the user's order created with key, with its items
+func (h *OrderService) orderByIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (*ent.Order, error) {
+	return h.EntClient.Order.Query().
+		Where(order.UserID(userID), order.IdempotencyKey(key)).
 		WithOrderItems().
 		Only(ctx)
+}
+
+// GetOrder handles fetching an order by ID, hiding soft-deleted orders
+func (h *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest, rsp *pb.GetOrderResponse) error {
+	logger.Extract(ctx).Infof("Received GetOrder request for ID: %s", req.Id)
+
+	// include_deleted is reserved for AdminService.GetOrder
+	return getOrder(ctx, h.EntClient, req.Id, false, rsp)
+}
+
+// getOrder fetches an order with its items, optionally including a soft-deleted one
+func getOrder(ctx context.Context, client *ent.Client, id string, includeDeleted bool, rsp *pb.GetOrderResponse) error {
+	orderID, err := uuid.Parse(id)
+	if err != nil {
+		return errors.BadRequest("orders.GetOrder", "invalid order id: %s", id)
+	}
+	query := client.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems()
+	if !includeDeleted {
+		query.Where(order.DeletedAtIsNil())
+	}
+	o, err := query.Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Order not found: %s", req.Id)
+		logger.Extract(ctx).Infof("Order not found: %s", id)
 		return fmt.Errorf("order not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to get order: %v", err)
+		logger.Extract(ctx).Errorf("Failed to get order: %v", err)
 		return fmt.Errorf("failed to get order: %w", err)
 	}
 
 	rsp.Order = toProtoOrder(o)
-	logger.Infof("Order fetched successfully: %s", o.ID)
+	logger.Extract(ctx).Infof("Order fetched successfully: %s", o.ID)
+	return nil
+}
+
+// GetOrdersByIDs fetches several orders with their items in one query, keeping the
+// requested order and reporting the IDs that are malformed or don't exist
+func (h *OrderService) GetOrdersByIDs(ctx context.Context, req *pb.GetOrdersByIDsRequest, rsp *pb.GetOrdersByIDsResponse) error {
+	logger.Extract(ctx).Infof("Received GetOrdersByIDs request for %d IDs", len(req.Ids))
+
+	ids := make([]uuid.UUID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		orderID, err := uuid.Parse(id)
+		if err != nil {
+			rsp.MissingIds = append(rsp.MissingIds, id)
+			continue
+		}
+		ids = append(ids, orderID)
+	}
+
+	orders, err := h.EntClient.Order.Query().
+		Where(order.IDIn(ids...), order.DeletedAtIsNil()).
+		WithOrderItems().
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get orders: %v", err)
+		return fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*ent.Order, len(orders))
+	for _, o := range orders {
+		byID[o.ID] = o
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	rsp.Orders = make([]*pb.Order, 0, len(orders))
+	for _, id := range ids {
+		if seen[id] {
+			continue // return repeated IDs once
+		}
+		seen[id] = true
+		if o, ok := byID[id]; ok {
+			rsp.Orders = append(rsp.Orders, toProtoOrder(o))
+		} else {
+			rsp.MissingIds = append(rsp.MissingIds, id.String())
+		}
+	}
+
+	logger.Extract(ctx).Infof("Fetched %d orders, %d missing", len(rsp.Orders), len(rsp.MissingIds))
 	return nil
 }
 
-// UpdateOrderStatus handles updating an order's status
+// UpdateOrderStatus handles updating an order's status to pending, processing or cancelled.
+// Cancelling restocks the order like CancelOrder, and a cancelled order can't move to any
+// other status.
 func (h *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest, rsp *pb.UpdateOrderStatusResponse) error {
-	logger.Infof("Received UpdateOrderStatus request for ID: %s, status: %s", req.Id, req.Status)
+	logger.Extract(ctx).Infof("Received UpdateOrderStatus request for ID: %s, status: %s", req.Id, req.Status)
 
-	// Validate status
+	// Validate status; shipped and delivered follow from the order's shipments, so only
+	// CreateShipment and MarkShipmentDelivered set them
+	if req.Status == order.StatusShipped.String() || req.Status == order.StatusDelivered.String() {
+		logger.Extract(ctx).Infof("Refusing manual status %s for order %s", req.Status, req.Id)
+		return errors.BadRequest("orders.UpdateOrderStatus", "status %s is set by shipments", req.Status)
+	}
 	validStatuses := map[string]bool{
 		"pending":    true,
 		"processing": true,
-		"shipped":    true,
-		"delivered":  true,
 		"cancelled":  true,
 	}
 	if !validStatuses[req.Status] {
-		logger.Infof("Invalid status: %s", req.Status)
+		logger.Extract(ctx).Infof("Invalid status: %s", req.Status)
 		return fmt.Errorf("invalid status: %s", req.Status)
 	}
 
-	o, err := h.EntClient.Order.UpdateOneID(uuid.MustParse(req.Id)).
-		SetStatus(order.Status(req.Status)).
-		Save(ctx)
+	orderID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return errors.BadRequest("orders.UpdateOrderStatus", "invalid order id: %s", req.Id)
+	}
+
+	// Cancelling returns the items to stock, so it takes the same path and window as CancelOrder
+	if req.Status == order.StatusCancelled.String() {
+		o, err := cancelOrder(ctx, h.EntClient, h.Products, req.Id, h.CancellationWindow)
+		if err != nil {
+			return err
+		}
+		rsp.Order = toProtoOrder(o)
+		logger.Extract(ctx).Infof("Order status updated successfully: %s", o.ID)
+		return nil
+	}
+
+	o, err := h.EntClient.Order.Query().
+		Where(order.ID(orderID), order.DeletedAtIsNil()).
+		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Order not found for update: %s", req.Id)
+		logger.Extract(ctx).Infof("Order not found for update: %s", req.Id)
 		return fmt.Errorf("order not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to update order status: %v", err)
+		logger.Extract(ctx).Errorf("Failed to get order: %v", err)
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if o.Status == order.StatusCancelled {
+		// Its items were returned to stock, so it can't be revived without taking them out again
+		logger.Extract(ctx).Infof("Refusing status %s for cancelled order %s", req.Status, req.Id)
+		return fmt.Errorf("order is cancelled and cannot move to %s", req.Status)
+	}
+	if o.FraudHold && progressesOrder(order.Status(req.Status)) {
+		logger.Extract(ctx).Infof("Refusing status %s for held order %s", req.Status, req.Id)
+		return fmt.Errorf("order is on fraud hold and cannot move to %s", req.Status)
+	}
+
+	// Only apply the change if the status hasn't moved since it was read, so the history stays accurate
+	err = transitionStatus(ctx, h.EntClient, o.ID, o.Status, order.Status(req.Status))
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order %s status changed during update", req.Id)
+		return fmt.Errorf("order status changed, please retry")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update order status: %v", err)
 		return fmt.Errorf("failed to update order status: %w", err)
 	}
 
@@ -141,29 +402,172 @@ func (h *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrde
 		WithOrderItems().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch order with items: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch order with items: %v", err)
 		return fmt.Errorf("failed to fetch order: %w", err)
 	}
 
 	rsp.Order = toProtoOrder(oWithItems)
-	logger.Infof("Order status updated successfully: %s", o.ID)
+	logger.Extract(ctx).Infof("Order status updated successfully: %s", o.ID)
+	return nil
+}
+
+// CancelOrder handles a customer-initiated cancellation within the cancellation window
+func (h *OrderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest, rsp *pb.CancelOrderResponse) error {
+	logger.Extract(ctx).Infof("Received CancelOrder request for ID: %s", req.Id)
+
+	o, err := cancelOrder(ctx, h.EntClient, h.Products, req.Id, h.CancellationWindow)
+	if err != nil {
+		return err
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Order cancelled successfully: %s", o.ID)
 	return nil
 }
 
-// ListOrders handles listing all orders with optional filtering and pagination
+// activeStatuses are the non-terminal statuses of an order still in flight
+var activeStatuses = []order.Status{order.StatusPending, order.StatusProcessing, order.StatusShipped}
+
+// orderSortFields maps the sort_by values ListOrders accepts to their columns
+var orderSortFields = map[string]string{
+	"created_at":   order.FieldCreatedAt,
+	"total_amount": order.FieldTotalAmountCents,
+	"status":       order.FieldStatus,
+}
+
+// orderSort returns the ordering for a ListOrders request, breaking ties by id so pages are stable
+func orderSort(sortBy string, desc bool) (order.OrderOption, error) {
+	if sortBy == "" {
+		sortBy, desc = "created_at", true
+	}
+	field, ok := orderSortFields[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort_by: %q", sortBy)
+	}
+	if desc {
+		return func(s *sql.Selector) {
+			ent.Desc(field)(s)
+			ent.Desc(order.FieldID)(s)
+		}, nil
+	}
+	return func(s *sql.Selector) {
+		ent.Asc(field)(s)
+		ent.Asc(order.FieldID)(s)
+	}, nil
+}
+
+// createdRange returns the predicates restricting orders to those created within
+// [after, before]; a zero bound is left open
+func createdRange(after, before int64) ([]predicate.Order, error) {
+	if after != 0 && before != 0 && after > before {
+		return nil, fmt.Errorf("created_after must not be later than created_before")
+	}
+	var preds []predicate.Order
+	if after != 0 {
+		preds = append(preds, order.CreatedAtGTE(time.Unix(after, 0)))
+	}
+	if before != 0 {
+		preds = append(preds, order.CreatedAtLTE(time.Unix(before, 0)))
+	}
+	return preds, nil
+}
+
+// ListOrders handles listing all orders with optional filtering and pagination, hiding soft-deleted orders
 func (h *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest, rsp *pb.ListOrdersResponse) error {
-	logger.Infof("Received ListOrders request (limit: %d, offset: %d, user_id: %s)", req.Limit, req.Offset, req.UserId)
+	logger.Extract(ctx).Infof("Received ListOrders request (limit: %d, offset: %d, user_id: %s, active_only: %v, sort_by: %q, sort_desc: %v)", req.Limit, req.Offset, req.UserId, req.ActiveOnly, req.SortBy, req.SortDesc)
+
+	// include_deleted is reserved for AdminService.ListOrders
+	return listOrders(ctx, h.EntClient, req, false, rsp)
+}
+
+// GetOrdersByUser lists one user's orders with their items, newest first, optionally
+// only those in one status
+func (h *OrderService) GetOrdersByUser(ctx context.Context, req *pb.GetOrdersByUserRequest, rsp *pb.GetOrdersByUserResponse) error {
+	logger.Extract(ctx).Infof("Received GetOrdersByUser request (user_id: %s, status: %s, limit: %d, offset: %d)", req.UserId, req.Status, req.Limit, req.Offset)
 
-	query := h.EntClient.Order.Query().WithOrderItems()
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return errors.BadRequest("orders.GetOrdersByUser", "invalid user_id: %s", req.UserId)
+	}
 
+	preds := []predicate.Order{order.UserID(userID), order.DeletedAtIsNil()}
+	if req.Status != "" {
+		status := order.Status(req.Status)
+		if err := order.StatusValidator(status); err != nil {
+			return errors.BadRequest("orders.GetOrdersByUser", "invalid status: %s", req.Status)
+		}
+		preds = append(preds, order.StatusEQ(status))
+	}
+
+	query := h.EntClient.Order.Query().
+		Where(preds...).
+		WithOrderItems().
+		Order(ent.Desc(order.FieldCreatedAt, order.FieldID))
+	if req.Limit > 0 {
+		query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query.Offset(int(req.Offset))
+	}
+
+	orders, err := query.All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list orders of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to list orders: %w", err)
+	}
+	total, err := h.EntClient.Order.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count orders of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	rsp.Orders = make([]*pb.Order, len(orders))
+	for i, o := range orders {
+		rsp.Orders[i] = toProtoOrder(o)
+	}
+	rsp.Total = int32(total)
+	logger.Extract(ctx).Infof("Listed %d of %d orders of user %s", len(orders), total, req.UserId)
+	return nil
+}
+
+// listOrders lists orders matching req, optionally including soft-deleted ones
+func listOrders(ctx context.Context, client *ent.Client, req *pb.ListOrdersRequest, includeDeleted bool, rsp *pb.ListOrdersResponse) error {
+	sort, err := orderSort(req.SortBy, req.SortDesc)
+	if err != nil {
+		return err
+	}
+	createdPreds, err := createdRange(req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return err
+	}
+	if !includeDeleted {
+		createdPreds = append(createdPreds, order.DeletedAtIsNil())
+	}
+
+	// Filters apply to both the page and the total count
 	if req.UserId != "" {
-		query.Where(order.UserID(uuid.MustParse(req.UserId)))
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return errors.BadRequest("orders.ListOrders", "invalid user_id: %s", req.UserId)
+		}
+		createdPreds = append(createdPreds, order.UserID(userID))
+	}
+	if req.ActiveOnly {
+		createdPreds = append(createdPreds, order.StatusIn(activeStatuses...))
+	}
+	if req.Region != "" {
+		createdPreds = append(createdPreds, order.Region(req.Region))
+	}
+
+	query := client.Order.Query().Where(createdPreds...).Order(sort)
+	if !req.SummaryOnly {
+		query.WithOrderItems()
 	}
 
 	if req.Limit > 0 {
 		// Ensure limit does not exceed int max
 		if req.Limit > int32(uint(0)>>1) {
-			logger.Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
 			req.Limit = int32(uint(0) >> 1)
 		}
 		query.Limit(int(req.Limit))
@@ -171,7 +575,7 @@ func (h *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest
 	if req.Offset > 0 {
 		// Ensure offset does not exceed int max
 		if req.Offset > int32(uint(0)>>1) {
-			logger.Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
 			req.Offset = int32(uint(0) >> 1)
 		}
 		query.Offset(int(req.Offset))
@@ -179,21 +583,13 @@ func (h *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest
 
 	orders, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to list orders: %v", err)
+		logger.Extract(ctx).Errorf("Failed to list orders: %v", err)
 		return fmt.Errorf("failed to list orders: %w", err)
 	}
 
-	q := h.EntClient.Order.Query()
-	if req.UserId != "" {
-		userID, err := uuid.Parse(req.UserId)
-		if err != nil {
-			return fmt.Errorf("invalid user id: %v", err)
-		}
-		q = q.Where(order.UserID(userID))
-	}
-	total, err := q.Count(ctx)
+	total, err := client.Order.Query().Where(createdPreds...).Count(ctx)
 	if err != nil {
-		logger.Errorf("Failed to count orders: %v", err)
+		logger.Extract(ctx).Errorf("Failed to count orders: %v", err)
 		return fmt.Errorf("failed to count orders: %w", err)
 	}
 
@@ -201,30 +597,108 @@ func (h *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest
 	for i, o := range orders {
 		protoOrders[i] = toProtoOrder(o)
 	}
+	if req.SummaryOnly {
+		if err := summarizeOrderItems(ctx, client, protoOrders); err != nil {
+			logger.Extract(ctx).Errorf("Failed to summarize order items: %v", err)
+			return fmt.Errorf("failed to summarize order items: %w", err)
+		}
+	}
 
 	rsp.Orders = protoOrders
 	rsp.Total = int32(total)
-	logger.Infof("Listed %d orders (total: %d)", len(protoOrders), total)
+	logger.Extract(ctx).Infof("Listed %d orders (total: %d)", len(protoOrders), total)
+	return nil
+}
+
+// summarizeOrderItems sets the item and distinct product counts of orders, aggregated in
+// the database so their items are never loaded
+func summarizeOrderItems(ctx context.Context, client *ent.Client, orders []*pb.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(orders))
+	for i, o := range orders {
+		ids[i] = uuid.MustParse(o.Id)
+	}
+
+	var summaries []struct {
+		OrderID          uuid.UUID `json:"order_order_items"`
+		ItemCount        int       `json:"item_count"`
+		DistinctProducts int       `json:"distinct_products"`
+	}
+	err := client.OrderItem.Query().
+		Where(orderitem.HasOrderWith(order.IDIn(ids...))).
+		GroupBy(orderitem.OrderColumn).
+		Aggregate(
+			ent.As(ent.Sum(orderitem.FieldQuantity), "item_count"),
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(orderitem.FieldProductID)), "distinct_products")
+			},
+		).
+		Scan(ctx, &summaries)
+	if err != nil {
+		return err
+	}
+
+	byOrder := make(map[string]*pb.Order, len(orders))
+	for _, o := range orders {
+		byOrder[o.Id] = o
+	}
+	for _, s := range summaries {
+		if o, ok := byOrder[s.OrderID.String()]; ok {
+			o.ItemCount = int32(s.ItemCount)
+			o.DistinctProductCount = int32(s.DistinctProducts)
+		}
+	}
 	return nil
 }
 
 // SearchOrders searches orders by user_id and/or status
 func (h *OrderService) SearchOrders(ctx context.Context, req *pb.SearchOrdersRequest, rsp *pb.SearchOrdersResponse) error {
-	logger.Infof("Received SearchOrders request (user_id: %s, status: %s, limit: %d, offset: %d)", req.UserId, req.Status, req.Limit, req.Offset)
-
-	query := h.EntClient.Order.Query().WithOrderItems()
+	logger.Extract(ctx).Infof("Received SearchOrders request (user_id: %s, email: %s, status: %s, limit: %d, offset: %d, created_after: %d, created_before: %d)", req.UserId, req.Email, req.Status, req.Limit, req.Offset, req.CreatedAfter, req.CreatedBefore)
 
+	// Filters shared by the page and the total count
+	preds, err := createdRange(req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return err
+	}
+	preds = append(preds, order.DeletedAtIsNil())
 	if req.UserId != "" {
-		query.Where(order.UserID(uuid.MustParse(req.UserId)))
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return errors.BadRequest("orders.SearchOrders", "invalid user_id: %s", req.UserId)
+		}
+		preds = append(preds, order.UserID(userID))
 	}
 	if req.Status != "" {
-		query.Where(order.StatusEQ(order.Status(req.Status)))
+		preds = append(preds, order.StatusEQ(order.Status(req.Status)))
+	}
+
+	// An email is resolved to the customer's user id; an unknown email matches no orders
+	if req.Email != "" {
+		if h.Users == nil {
+			return fmt.Errorf("email search is disabled")
+		}
+		userID, found, err := lookupUserByEmail(ctx, h.Users, req.Email)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to resolve email %s: %v", req.Email, err)
+			return err
+		}
+		if !found {
+			logger.Extract(ctx).Infof("No user found for email %s, returning no orders", req.Email)
+			rsp.Orders = []*pb.Order{}
+			rsp.Total = 0
+			return nil
+		}
+		preds = append(preds, order.UserID(userID))
 	}
 
+	query := h.EntClient.Order.Query().WithOrderItems().Where(preds...)
+
 	if req.Limit > 0 {
 		// Ensure limit does not exceed int max
 		if req.Limit > int32(uint(0)>>1) {
-			logger.Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Limit %d exceeds maximum allowed value, capping at %d", req.Limit, int32(uint(0)>>1))
 			req.Limit = int32(uint(0) >> 1)
 		}
 		query.Limit(int(req.Limit))
@@ -232,7 +706,7 @@ func (h *OrderService) SearchOrders(ctx context.Context, req *pb.SearchOrdersReq
 	if req.Offset > 0 {
 		// Ensure offset does not exceed int max
 		if req.Offset > int32(uint(0)>>1) {
-			logger.Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
+			logger.Extract(ctx).Infof("Offset %d exceeds maximum allowed value, capping at %d", req.Offset, int32(uint(0)>>1))
 			req.Offset = int32(uint(0) >> 1)
 		}
 		query.Offset(int(req.Offset))
@@ -240,30 +714,13 @@ func (h *OrderService) SearchOrders(ctx context.Context, req *pb.SearchOrdersReq
 
 	orders, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to search orders: %v", err)
+		logger.Extract(ctx).Errorf("Failed to search orders: %v", err)
 		return fmt.Errorf("failed to search orders: %w", err)
 	}
 
-	q := h.EntClient.Order.Query()
-	// user Id filter
-	if req.UserId != "" {
-		userID, err := uuid.Parse(req.UserId)
-		if err != nil {
-			return fmt.Errorf("invalid user ID: %v", err)
-		}
-		q = q.Where(order.UserID(userID))
-	}
-
-	// Status filter
-	if req.Status != "" {
-		status := order.Status(req.Status)
-		q = q.Where(order.StatusEQ(status))
-	}
-
-	total, err := q.Count(ctx)
-
+	total, err := h.EntClient.Order.Query().Where(preds...).Count(ctx)
 	if err != nil {
-		logger.Errorf("Failed to count orders for search: %v", err)
+		logger.Extract(ctx).Errorf("Failed to count orders for search: %v", err)
 		return fmt.Errorf("failed to count orders for search: %w", err)
 	}
 
@@ -274,34 +731,180 @@ func (h *OrderService) SearchOrders(ctx context.Context, req *pb.SearchOrdersReq
 
 	rsp.Orders = protoOrders
 	rsp.Total = int32(total)
-	logger.Infof("Found %d orders (total: %d)", len(protoOrders), total)
+	logger.Extract(ctx).Infof("Found %d orders (total: %d)", len(protoOrders), total)
 	return nil
 }
 
+// VerifyOrderAmount lets a payment gateway confirm the amount it is about to charge matches the stored order
+func (h *OrderService) VerifyOrderAmount(ctx context.Context, req *pb.VerifyOrderAmountRequest, rsp *pb.VerifyOrderAmountResponse) error {
+	expected := requestCents(req.ExpectedAmountCents, req.ExpectedAmount)
+	logger.Extract(ctx).Infof("Received VerifyOrderAmount request for order %s (amount: %s %s)", req.OrderId, formatCents(expected), req.Currency)
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return fmt.Errorf("invalid order_id: %s", req.OrderId)
+	}
+
+	o, err := h.EntClient.Order.Query().Where(order.ID(orderID), order.DeletedAtIsNil()).Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order not found for amount verification: %s", req.OrderId)
+		return fmt.Errorf("order not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get order: %v", err)
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	rsp.AmountCents = o.TotalAmountCents
+	rsp.Amount = fromCents(o.TotalAmountCents)
+	rsp.Currency = o.Currency
+	rsp.Match = o.TotalAmountCents == expected && strings.EqualFold(req.Currency, o.Currency)
+	if !rsp.Match {
+		logger.Extract(ctx).Infof("Order amount mismatch for %s: expected %s %s, stored %s %s", o.ID, formatCents(expected), req.Currency, formatCents(o.TotalAmountCents), rsp.Currency)
+	}
+	return nil
+}
+
+// cancelOrder transitions a pending or processing order to cancelled and returns its
+// items to stock, rejecting the request once the window since the order was placed has
+// elapsed (a zero window never expires). Cancelling an already-cancelled order succeeds
+// without changes. If the restock fails the order is put back to its previous status.
+func cancelOrder(ctx context.Context, client *ent.Client, products productspb.ProductService, id string, window time.Duration) (*ent.Order, error) {
+	orderID, err := uuid.Parse(id)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Invalid order id format: %v", err)
+		return nil, fmt.Errorf("invalid order id format: %w", err)
+	}
+
+	o, err := client.Order.Query().Where(order.ID(orderID), order.DeletedAtIsNil()).WithOrderItems().Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order not found for cancellation: %s", id)
+		return nil, fmt.Errorf("order not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get order: %v", err)
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	switch o.Status {
+	case order.StatusCancelled:
+		// Restocking is idempotent, so repeat it in case an earlier compensation failed
+		if err := restockOrder(ctx, products, o); err != nil {
+			logger.Extract(ctx).Errorf("Failed to re-check restock of cancelled order %s: %v", id, err)
+		}
+		logger.Extract(ctx).Infof("Order already cancelled: %s", id)
+		return o, nil
+	case order.StatusPending, order.StatusProcessing:
+	default:
+		logger.Extract(ctx).Infof("Order %s can no longer be cancelled (status: %s)", id, o.Status)
+		return nil, fmt.Errorf("order cannot be cancelled once %s", o.Status)
+	}
+
+	shipped, err := client.Shipment.Query().Where(shipment.HasOrderWith(order.ID(orderID))).Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to check shipments of order %s: %v", id, err)
+		return nil, fmt.Errorf("failed to check shipments: %w", err)
+	}
+	if shipped {
+		logger.Extract(ctx).Infof("Order %s can no longer be cancelled, it is partially shipped", id)
+		return nil, fmt.Errorf("order cannot be cancelled once partially shipped")
+	}
+
+	if window > 0 && time.Since(o.CreatedAt) > window {
+		logger.Extract(ctx).Infof("Cancellation window expired for order: %s", id)
+		return nil, fmt.Errorf("cancellation window expired")
+	}
+
+	// Only cancel if the status has not changed since it was read
+	err = transitionStatus(ctx, client, orderID, o.Status, order.StatusCancelled)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order %s status changed during cancellation", id)
+		return nil, fmt.Errorf("order status changed, please retry")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to cancel order: %v", err)
+		return nil, fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	if err := restockOrder(ctx, products, o); err != nil {
+		logger.Extract(ctx).Errorf("Failed to restock cancelled order %s: %v", id, err)
+		// Compensate so the cancellation can be retried as a whole
+		revertErr := transitionStatus(ctx, client, orderID, order.StatusCancelled, o.Status)
+		if revertErr != nil {
+			logger.Extract(ctx).Errorf("Failed to revert cancellation of order %s, it stays cancelled until restocked by a retry: %v", id, revertErr)
+		}
+		return nil, fmt.Errorf("failed to restock order: %w", err)
+	}
+
+	// Fetch order with items
+	oWithItems, err := client.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch order with items: %v", err)
+		return nil, fmt.Errorf("failed to fetch order: %w", err)
+	}
+	return oWithItems, nil
+}
+
 // toProtoOrder converts an Entgo Order entity to a Protobuf Order message
 func toProtoOrder(o *ent.Order) *pb.Order {
 	if o == nil {
 		return nil
 	}
 	protoOrder := &pb.Order{
-		Id:          o.ID.String(),
-		UserId:      o.UserID.String(),
-		TotalAmount: o.TotalAmount,
-		Status:      o.Status.String(),
-		CreatedAt:   o.CreatedAt.Unix(),
-		UpdatedAt:   o.UpdatedAt.Unix(),
+		Id:                 o.ID.String(),
+		UserId:             o.UserID.String(),
+		TotalAmount:        fromCents(o.TotalAmountCents),
+		Status:             o.Status.String(),
+		CreatedAt:          o.CreatedAt.Unix(),
+		UpdatedAt:          o.UpdatedAt.Unix(),
+		TotalAmountCents:   o.TotalAmountCents,
+		TotalAmountDecimal: formatCents(o.TotalAmountCents),
+		Currency:           o.Currency,
+		DiscountCents:      o.DiscountCents,
+	}
+	if o.CouponCode != nil {
+		protoOrder.CouponCode = *o.CouponCode
+	}
+	if o.DeletedAt != nil {
+		protoOrder.DeletedAt = o.DeletedAt.Unix()
+	}
+	if o.Region != nil {
+		protoOrder.Region = *o.Region
+	}
+	protoOrder.ShippingAddress = toProtoShippingAddress(o)
+	if o.FraudHold {
+		protoOrder.FraudHold = true
+		if o.HoldReason != nil {
+			protoOrder.HoldReason = *o.HoldReason
+		}
+	}
+	if o.Edges.Events != nil {
+		protoOrder.History = toProtoStatusChanges(o.Edges.Events)
 	}
 	if o.Edges.OrderItems != nil {
-		protoOrder.OrderItems = make([]*pb.OrderItem, len(o.Edges.OrderItems))
-		for i, item := range o.Edges.OrderItems {
+		// Items keep the order they were submitted in; items predating positions all
+		// share position 0 and fall back to creation order
+		items := slices.Clone(o.Edges.OrderItems)
+		slices.SortStableFunc(items, func(a, b *ent.OrderItem) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), a.CreatedAt.Compare(b.CreatedAt))
+		})
+		protoOrder.OrderItems = make([]*pb.OrderItem, len(items))
+		for i, item := range items {
 			protoOrder.OrderItems[i] = &pb.OrderItem{
-				Id:        item.ID.String(),
-				ProductId: item.ProductID.String(),
-				Quantity:  int32(item.Quantity),
-				UnitPrice: item.UnitPrice,
-				CreatedAt: item.CreatedAt.Unix(),
-				UpdatedAt: item.UpdatedAt.Unix(),
-				OrderId:   o.ID.String(),
+				Id:               item.ID.String(),
+				ProductId:        item.ProductID.String(),
+				Quantity:         int32(item.Quantity),
+				UnitPrice:        fromCents(item.UnitPriceCents),
+				CreatedAt:        item.CreatedAt.Unix(),
+				UpdatedAt:        item.UpdatedAt.Unix(),
+				OrderId:          o.ID.String(),
+				ProductName:      item.ProductName,
+				UnitPriceCents:   item.UnitPriceCents,
+				UnitPriceDecimal: formatCents(item.UnitPriceCents),
+				Position:         int32(item.Position),
 			}
 		}
 	}
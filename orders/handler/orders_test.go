@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+func TestMalformedUserIDFiltersAreBadRequests(t *testing.T) {
+	h := &OrderService{EntClient: newTestClient(t)}
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"orders.ListOrders": func() error {
+			return h.ListOrders(ctx, &pb.ListOrdersRequest{UserId: "not-a-uuid"}, &pb.ListOrdersResponse{})
+		},
+		"orders.SearchOrders": func() error {
+			return h.SearchOrders(ctx, &pb.SearchOrdersRequest{UserId: "not-a-uuid"}, &pb.SearchOrdersResponse{})
+		},
+	}
+	for id, call := range calls {
+		err := errors.FromError(call())
+		if err.Code != http.StatusBadRequest || err.Id != id {
+			t.Errorf("%s: expected a BadRequest from %s, got %v", id, id, err)
+		}
+	}
+}
+
+func TestCancelOrderWindow(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	orders := &OrderService{EntClient: client, CancellationWindow: time.Hour}
+	admin := &AdminService{EntClient: client}
+	placed := func(ago time.Duration) string {
+		return client.Order.Create().
+			SetUserID(uuid.New()).
+			SetTotalAmountCents(1000).
+			SetCreatedAt(time.Now().Add(-ago)).
+			SaveX(ctx).ID.String()
+	}
+
+	recent := placed(10 * time.Minute)
+	rsp := &pb.CancelOrderResponse{}
+	if err := orders.CancelOrder(ctx, &pb.CancelOrderRequest{Id: recent}, rsp); err != nil {
+		t.Fatalf("cancelling within the window: %v", err)
+	}
+	if rsp.Order.Status != order.StatusCancelled.String() {
+		t.Fatalf("expected the order cancelled, got %s", rsp.Order.Status)
+	}
+
+	old := placed(2 * time.Hour)
+	err := orders.CancelOrder(ctx, &pb.CancelOrderRequest{Id: old}, &pb.CancelOrderResponse{})
+	if err == nil || !strings.Contains(err.Error(), "cancellation window expired") {
+		t.Fatalf("expected the window to have expired, got %v", err)
+	}
+	if s := client.Order.GetX(ctx, uuid.MustParse(old)).Status; s != order.StatusPending {
+		t.Fatalf("expected the order left pending, got %s", s)
+	}
+
+	if err := admin.CancelOrder(ctx, &pb.CancelOrderRequest{Id: old}, &pb.CancelOrderResponse{}); err != nil {
+		t.Fatalf("expected admins to cancel after the window, got %v", err)
+	}
+	if s := client.Order.GetX(ctx, uuid.MustParse(old)).Status; s != order.StatusCancelled {
+		t.Fatalf("expected the order cancelled by the admin, got %s", s)
+	}
+}
+
+func TestUpdateOrderStatusCancellationRestocks(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	products := newFakeProducts()
+	h := &OrderService{EntClient: client, Products: products, CancellationWindow: time.Hour}
+	setStatus := func(id uuid.UUID, status string) error {
+		return h.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: id.String(), Status: status}, &pb.UpdateOrderStatusResponse{})
+	}
+
+	o := createTestOrder(t, client, uuid.New())
+	if err := setStatus(o.ID, "cancelled"); err != nil {
+		t.Fatalf("cancelling through UpdateOrderStatus: %v", err)
+	}
+	if len(products.restocked) != 1 || products.restocked[0].OrderId != o.ID.String() || products.restocked[0].Items[0].Quantity != 2 {
+		t.Fatalf("expected the order's 2 units restocked, got %v", products.restocked)
+	}
+
+	// A cancelled order stays cancelled
+	for _, status := range []string{"pending", "processing"} {
+		if err := setStatus(o.ID, status); err == nil {
+			t.Fatalf("expected a cancelled order not to move to %s", status)
+		}
+	}
+	if s := client.Order.GetX(ctx, o.ID).Status; s != order.StatusCancelled {
+		t.Fatalf("expected the order still cancelled, got %s", s)
+	}
+
+	// The customer cancellation window applies too
+	old := client.Order.Create().SetUserID(uuid.New()).SetTotalAmountCents(1000).SetCreatedAt(time.Now().Add(-2 * time.Hour)).SaveX(ctx)
+	if err := setStatus(old.ID, "cancelled"); err == nil || !strings.Contains(err.Error(), "cancellation window expired") {
+		t.Fatalf("expected the window to have expired, got %v", err)
+	}
+}
+
+func TestCreateOrderValidatesProductsAgainstCatalog(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	known, unknown := uuid.New(), uuid.New()
+	products := newFakeProducts(known)
+	h := &OrderService{EntClient: client, Products: products}
+	item := func(id uuid.UUID) *pb.OrderItemRequest {
+		return &pb.OrderItemRequest{ProductId: id.String(), Quantity: 1, UnitPriceCents: 500}
+	}
+
+	err := h.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId:     uuid.NewString(),
+		OrderItems: []*pb.OrderItemRequest{item(known), item(unknown)},
+	}, &pb.CreateOrderResponse{})
+	verr, ok := ParseValidationError(err)
+	if !ok || len(verr.Violations) != 1 || verr.Violations[0].ProductId != unknown.String() || verr.Violations[0].Reason != ViolationNotFound {
+		t.Fatalf("expected the unknown product reported not found, got %v", err)
+	}
+	if n := client.Order.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected no order stored, found %d", n)
+	}
+
+	rsp := &pb.CreateOrderResponse{}
+	if err := h.CreateOrder(ctx, &pb.CreateOrderRequest{UserId: uuid.NewString(), OrderItems: []*pb.OrderItemRequest{item(known)}}, rsp); err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if got, want := rsp.Order.OrderItems[0].ProductName, products.catalog[known.String()].Name; got != want {
+		t.Fatalf("expected the product name %q snapshotted, got %q", want, got)
+	}
+
+	// Without a products client the check is skipped, e.g. offline
+	offline := &OrderService{EntClient: client}
+	if err := offline.CreateOrder(ctx, &pb.CreateOrderRequest{UserId: uuid.NewString(), OrderItems: []*pb.OrderItemRequest{item(unknown)}}, &pb.CreateOrderResponse{}); err != nil {
+		t.Fatalf("expected offline orders to skip the catalog check, got %v", err)
+	}
+}
+
+func TestVerifyOrderAmount(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	id := createTestOrder(t, client, uuid.New()).ID.String()
+
+	tests := []struct {
+		name     string
+		cents    int64
+		currency string
+		match    bool
+	}{
+		{"match", 1000, "USD", true},
+		{"currency case is ignored", 1000, "usd", true},
+		{"tampered amount", 999, "USD", false},
+		{"other currency", 1000, "EUR", false},
+	}
+	for _, tt := range tests {
+		rsp := &pb.VerifyOrderAmountResponse{}
+		err := h.VerifyOrderAmount(ctx, &pb.VerifyOrderAmountRequest{OrderId: id, ExpectedAmountCents: tt.cents, Currency: tt.currency}, rsp)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if rsp.Match != tt.match || rsp.AmountCents != 1000 || rsp.Currency != "USD" {
+			t.Errorf("%s: got match %v with %d %s, want match %v with 1000 USD", tt.name, rsp.Match, rsp.AmountCents, rsp.Currency, tt.match)
+		}
+	}
+}
+
+func TestListOrdersActiveOnly(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	userID := uuid.New()
+	statuses := []order.Status{order.StatusPending, order.StatusProcessing, order.StatusShipped, order.StatusDelivered, order.StatusCancelled}
+	for _, status := range statuses {
+		client.Order.Create().SetUserID(userID).SetTotalAmountCents(1000).SetStatus(status).ExecX(ctx)
+	}
+
+	rsp := &pb.ListOrdersResponse{}
+	if err := h.ListOrders(ctx, &pb.ListOrdersRequest{UserId: userID.String(), ActiveOnly: true}, rsp); err != nil {
+		t.Fatalf("ListOrders: %v", err)
+	}
+	if rsp.Total != 3 {
+		t.Fatalf("expected a total of 3 active orders, got %d", rsp.Total)
+	}
+	var got []string
+	for _, o := range rsp.Orders {
+		got = append(got, o.Status)
+	}
+	slices.Sort(got)
+	if want := []string{"pending", "processing", "shipped"}; !slices.Equal(got, want) {
+		t.Fatalf("expected only %v, got %v", want, got)
+	}
+
+	all := &pb.ListOrdersResponse{}
+	if err := h.ListOrders(ctx, &pb.ListOrdersRequest{UserId: userID.String()}, all); err != nil {
+		t.Fatalf("ListOrders: %v", err)
+	}
+	if all.Total != int32(len(statuses)) {
+		t.Fatalf("expected every order without active_only, got %d", all.Total)
+	}
+}
+
+func TestCreatedOrdersCarryRegionAndListFiltersByIt(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	userID := uuid.NewString()
+	create := func(region string) *pb.Order {
+		t.Helper()
+		h := &OrderService{EntClient: client, Region: region}
+		rsp := &pb.CreateOrderResponse{}
+		err := h.CreateOrder(ctx, &pb.CreateOrderRequest{
+			UserId:     userID,
+			OrderItems: []*pb.OrderItemRequest{{ProductId: uuid.NewString(), Quantity: 1, UnitPriceCents: 500}},
+		}, rsp)
+		if err != nil {
+			t.Fatalf("CreateOrder in %q: %v", region, err)
+		}
+		return rsp.Order
+	}
+
+	eu := create("eu-west")
+	create("us-east")
+	if unset := create(""); unset.Region != "" {
+		t.Fatalf("expected no region without one configured, got %q", unset.Region)
+	}
+	if eu.Region != "eu-west" {
+		t.Fatalf("expected the order stamped with eu-west, got %q", eu.Region)
+	}
+
+	h := &OrderService{EntClient: client}
+	rsp := &pb.ListOrdersResponse{}
+	if err := h.ListOrders(ctx, &pb.ListOrdersRequest{UserId: userID, Region: "eu-west"}, rsp); err != nil {
+		t.Fatalf("ListOrders: %v", err)
+	}
+	if rsp.Total != 1 || len(rsp.Orders) != 1 || rsp.Orders[0].Id != eu.Id {
+		t.Fatalf("expected only order %s in eu-west, got %d", eu.Id, rsp.Total)
+	}
+	all := &pb.ListOrdersResponse{}
+	if err := h.ListOrders(ctx, &pb.ListOrdersRequest{UserId: userID}, all); err != nil {
+		t.Fatalf("ListOrders: %v", err)
+	}
+	if all.Total != 3 {
+		t.Fatalf("expected every order without a region filter, got %d", all.Total)
+	}
+}
+
+func TestOrderItemsRoundTripInSubmittedOrder(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+
+	var submitted []string
+	var items []*pb.OrderItemRequest
+	for i := 0; i < 6; i++ {
+		id := uuid.NewString()
+		submitted = append(submitted, id)
+		items = append(items, &pb.OrderItemRequest{ProductId: id, Quantity: 1, UnitPriceCents: 500})
+	}
+	created := &pb.CreateOrderResponse{}
+	if err := h.CreateOrder(ctx, &pb.CreateOrderRequest{UserId: uuid.NewString(), OrderItems: items}, created); err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+
+	rsp := &pb.GetOrderResponse{}
+	if err := h.GetOrder(ctx, &pb.GetOrderRequest{Id: created.Order.Id}, rsp); err != nil {
+		t.Fatalf("GetOrder: %v", err)
+	}
+	var got []string
+	for i, item := range rsp.Order.OrderItems {
+		if item.Position != int32(i) {
+			t.Errorf("item %d: expected position %d, got %d", i, i, item.Position)
+		}
+		got = append(got, item.ProductId)
+	}
+	if !slices.Equal(got, submitted) {
+		t.Fatalf("expected items in submitted order %v, got %v", submitted, got)
+	}
+
+	// Items are sorted by position however they were loaded
+	o := client.Order.Query().Where(order.ID(uuid.MustParse(created.Order.Id))).WithOrderItems().OnlyX(ctx)
+	slices.Reverse(o.Edges.OrderItems)
+	got = got[:0]
+	for _, item := range toProtoOrder(o).OrderItems {
+		got = append(got, item.ProductId)
+	}
+	if !slices.Equal(got, submitted) {
+		t.Fatalf("expected reversed items sorted back to %v, got %v", submitted, got)
+	}
+}
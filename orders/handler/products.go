@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"orders/ent"
+
+	productspb "products/proto"
+)
+
+// restockOrder returns an order's items to stock through the products service; a nil
+// client skips it. The products service applies it at most once per order.
+func restockOrder(ctx context.Context, products productspb.ProductService, o *ent.Order) error {
+	if products == nil {
+		return nil
+	}
+
+	req := &productspb.IncrementStockRequest{
+		OrderId: o.ID.String(),
+		Items:   make([]*productspb.StockItem, len(o.Edges.OrderItems)),
+	}
+	for i, item := range o.Edges.OrderItems {
+		req.Items[i] = &productspb.StockItem{
+			ProductId: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),
+		}
+	}
+
+	if _, err := products.IncrementStock(ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
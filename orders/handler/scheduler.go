@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/subscription"
+	pb "orders/proto"
+)
+
+// SubscriptionScheduler periodically places the orders of subscriptions that are due
+// and advances them to their next run
+type SubscriptionScheduler struct {
+	// Orders places each order through CreateOrder, so it is validated and published
+	// like any other
+	Orders    *OrderService
+	Interval  time.Duration
+	BatchSize int
+	// MaxFailures pauses a subscription once this many of its runs in a row have failed;
+	// zero keeps retrying indefinitely
+	MaxFailures int
+}
+
+// Run places due subscription orders every Interval until ctx is cancelled
+func (s *SubscriptionScheduler) Run(ctx context.Context) {
+	logger.Extract(ctx).Infof("Subscription scheduler started (interval: %s, batch size: %d)", s.Interval, s.BatchSize)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Extract(ctx).Info("Subscription scheduler stopped")
+			return
+		case <-ticker.C:
+			placed, failed, err := s.RunDue(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Subscription run failed after placing %d orders: %v", placed, err)
+				continue
+			}
+			logger.Extract(ctx).Infof("Subscription run completed: %d orders placed, %d failed", placed, failed)
+		}
+	}
+}
+
+// RunDue places an order for every active subscription whose next run has come, in
+// batches of BatchSize (zero runs them all at once), and returns how many were placed and how many failed. A failed subscription
+// keeps its next run and is retried on the following pass, until MaxFailures pauses it.
+func (s *SubscriptionScheduler) RunDue(ctx context.Context) (int, int, error) {
+	var placed int
+	var failed []uuid.UUID
+	for {
+		query := s.Orders.EntClient.Subscription.Query().
+			Where(
+				subscription.Active(true),
+				subscription.NextRunAtLTE(time.Now()),
+				subscription.IDNotIn(failed...),
+			).
+			Order(ent.Asc(subscription.FieldNextRunAt))
+		if s.BatchSize > 0 {
+			query.Limit(s.BatchSize)
+		}
+		due, err := query.All(ctx)
+		if err != nil {
+			return placed, len(failed), fmt.Errorf("failed to query due subscriptions: %w", err)
+		}
+
+		for _, sub := range due {
+			if err := s.place(ctx, sub); err != nil {
+				logger.Extract(ctx).Errorf("Failed to place order for subscription %s: %v", sub.ID, err)
+				s.recordFailure(ctx, sub, err)
+				failed = append(failed, sub.ID)
+				continue
+			}
+			placed++
+		}
+
+		if s.BatchSize <= 0 || len(due) < s.BatchSize {
+			return placed, len(failed), nil
+		}
+	}
+}
+
+// place creates the order of a subscription's current run at current catalog prices and
+// advances it to the next run after now, clearing its failure count. The order's
+// idempotency key names the run, so if advancing fails the retry returns the order
+// already placed instead of placing another.
+func (s *SubscriptionScheduler) place(ctx context.Context, sub *ent.Subscription) error {
+	ctx, log := withCorrelationID(ctx, map[string]interface{}{"subscription_id": sub.ID.String()})
+
+	items, err := s.orderItems(ctx, sub)
+	if err != nil {
+		return err
+	}
+	rsp := &pb.CreateOrderResponse{}
+	err = s.Orders.CreateOrder(ctx, &pb.CreateOrderRequest{
+		UserId:         sub.UserID.String(),
+		OrderItems:     items,
+		IdempotencyKey: fmt.Sprintf("subscription:%s:%d", sub.ID, sub.NextRunAt.Unix()),
+	}, rsp)
+	if err != nil {
+		return err
+	}
+
+	next := advanceSubscriptionRun(sub.Interval, sub.NextRunAt, time.Now())
+	n, err := s.Orders.EntClient.Subscription.Update().
+		Where(
+			subscription.ID(sub.ID),
+			subscription.NextRunAt(sub.NextRunAt),
+		).
+		SetNextRunAt(next).
+		SetFailureCount(0).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to advance subscription: %w", err)
+	}
+	if n == 0 {
+		// Paused, resumed or cancelled while the order was placed; leave it as it is
+		log.Infof("Subscription changed while placing order %s, not advancing", rsp.Order.Id)
+		return nil
+	}
+	log.Infof("Placed order %s for subscription, next run at %s", rsp.Order.Id, next)
+	return nil
+}
+
+// orderItems prices the items of a subscription's run from the catalog, each quantity's
+// price tier applied, so recurring orders follow price changes. Without products
+// validation the prices quoted when subscribing are used; products missing from the
+// catalog are left for CreateOrder to reject.
+func (s *SubscriptionScheduler) orderItems(ctx context.Context, sub *ent.Subscription) ([]*pb.OrderItemRequest, error) {
+	items := toOrderItemRequests(sub.Items)
+	if s.Orders.Products == nil {
+		return items, nil
+	}
+	catalog, err := s.Orders.lookupCatalog(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if p, ok := catalog[item.ProductId]; ok {
+			item.UnitPriceCents = catalogPrice(p, item.Quantity)
+			item.Currency = p.Currency
+		}
+	}
+	return items, nil
+}
+
+// recordFailure counts a failed run of sub and why it failed, pausing the subscription
+// once MaxFailures runs in a row have failed. A subscription changed meanwhile is left
+// as it is.
+func (s *SubscriptionScheduler) recordFailure(ctx context.Context, sub *ent.Subscription, cause error) {
+	failures := sub.FailureCount + 1
+	pause := s.MaxFailures > 0 && failures >= s.MaxFailures
+	update := s.Orders.EntClient.Subscription.Update().
+		Where(
+			subscription.ID(sub.ID),
+			subscription.NextRunAt(sub.NextRunAt),
+			subscription.Active(true),
+		).
+		SetFailureCount(failures).
+		SetLastFailure(cause.Error()).
+		SetLastFailedAt(time.Now())
+	if pause {
+		update.SetActive(false)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to record failure of subscription %s: %v", sub.ID, err)
+		return
+	}
+	if n > 0 && pause {
+		logger.Extract(ctx).Infof("Paused subscription %s after %d failed runs", sub.ID, failures)
+	}
+}
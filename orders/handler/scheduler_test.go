@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"orders/ent/order"
+	"orders/ent/subscription"
+	pb "orders/proto"
+
+	productspb "products/proto"
+)
+
+func TestSubscriptionSchedulerPlacesDueOrders(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	h := &OrderService{EntClient: client, Products: newFakeProducts(productID)}
+	scheduler := &SubscriptionScheduler{Orders: h, BatchSize: 1}
+
+	subscribe := func(interval string, startAt time.Time) *pb.Subscription {
+		t.Helper()
+		rsp := &pb.CreateSubscriptionResponse{}
+		err := h.CreateSubscription(ctx, &pb.CreateSubscriptionRequest{
+			UserId:   uuid.NewString(),
+			Items:    []*pb.OrderItemRequest{{ProductId: productID.String(), Quantity: 2, UnitPriceCents: 500, Currency: "USD"}},
+			Interval: interval,
+			StartAt:  startAt.Unix(),
+		}, rsp)
+		if err != nil {
+			t.Fatalf("CreateSubscription: %v", err)
+		}
+		return rsp.Subscription
+	}
+
+	// Due for two days, so one order is placed and the missed run is skipped
+	start := time.Now().Add(-47 * time.Hour).Truncate(time.Second)
+	due := subscribe("daily", start)
+	paused := subscribe("weekly", start)
+	if err := h.PauseSubscription(ctx, &pb.PauseSubscriptionRequest{Id: paused.Id}, &pb.PauseSubscriptionResponse{}); err != nil {
+		t.Fatalf("PauseSubscription: %v", err)
+	}
+	subscribe("weekly", time.Now().Add(time.Hour))
+
+	placed, failed, err := scheduler.RunDue(ctx)
+	if err != nil || placed != 1 || failed != 0 {
+		t.Fatalf("expected 1 order placed, got %d placed, %d failed, %v", placed, failed, err)
+	}
+
+	orders := client.Order.Query().WithOrderItems().AllX(ctx)
+	if len(orders) != 1 || orders[0].UserID.String() != due.UserId {
+		t.Fatalf("expected one order for the due subscription, got %d", len(orders))
+	}
+	if o := orders[0]; o.Status != order.StatusPending || len(o.Edges.OrderItems) != 1 || o.Edges.OrderItems[0].Quantity != 2 {
+		t.Fatalf("expected a pending order of the subscription's items, got %v", o)
+	}
+
+	next := client.Subscription.GetX(ctx, uuid.MustParse(due.Id)).NextRunAt
+	if want := start.AddDate(0, 0, 2); !next.Equal(want) {
+		t.Fatalf("expected the next run advanced to %s, got %s", want, next)
+	}
+
+	// Nothing is due until the next run
+	placed, _, err = scheduler.RunDue(ctx)
+	if err != nil || placed != 0 {
+		t.Fatalf("expected no orders placed before the next run, got %d, %v", placed, err)
+	}
+}
+
+func TestAdvanceSubscriptionRunKeepsSchedule(t *testing.T) {
+	run := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"daily":   time.Date(2026, 4, 16, 9, 0, 0, 0, time.UTC),
+		"weekly":  time.Date(2026, 4, 18, 9, 0, 0, 0, time.UTC),
+		"monthly": time.Date(2026, 5, 3, 9, 0, 0, 0, time.UTC), // Jan 31 + 1 month normalizes to Mar 3
+	}
+	for interval, want := range tests {
+		if got := advanceSubscriptionRun(subscription.Interval(interval), run, now); !got.Equal(want) {
+			t.Errorf("%s: advanced to %s, want %s", interval, got, want)
+		}
+	}
+}
+
+func TestSubscriptionOrdersUseCurrentCatalogPrices(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	products := newFakeProducts(productID)
+	h := &OrderService{EntClient: client, Products: products, CheckPrices: true}
+	scheduler := &SubscriptionScheduler{Orders: h}
+
+	err := h.CreateSubscription(ctx, &pb.CreateSubscriptionRequest{
+		UserId:   uuid.NewString(),
+		Items:    []*pb.OrderItemRequest{{ProductId: productID.String(), Quantity: 2, UnitPriceCents: 500, Currency: "USD"}},
+		Interval: "weekly",
+		StartAt:  time.Now().Add(-time.Minute).Unix(),
+	}, &pb.CreateSubscriptionResponse{})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+
+	// The price rose after subscribing, with a tier for two units
+	p := products.catalog[productID.String()]
+	p.PriceCents = 700
+	p.PriceTiers = []*productspb.PriceTier{{MinQuantity: 2, UnitPriceCents: 650}}
+
+	placed, failed, err := scheduler.RunDue(ctx)
+	if err != nil || placed != 1 || failed != 0 {
+		t.Fatalf("expected 1 order placed, got %d placed, %d failed, %v", placed, failed, err)
+	}
+	o := client.Order.Query().WithOrderItems().OnlyX(ctx)
+	if got := o.Edges.OrderItems[0].UnitPriceCents; got != 650 {
+		t.Fatalf("expected the item at the current 650 cent tier price, got %d", got)
+	}
+	if o.TotalAmountCents != 1300 {
+		t.Fatalf("expected a 1300 cent order, got %d", o.TotalAmountCents)
+	}
+}
+
+func TestSubscriptionSchedulerPausesAfterRepeatedFailures(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	products := newFakeProducts()
+	h := &OrderService{EntClient: client, Products: products}
+	scheduler := &SubscriptionScheduler{Orders: h, MaxFailures: 2}
+
+	rsp := &pb.CreateSubscriptionResponse{}
+	err := h.CreateSubscription(ctx, &pb.CreateSubscriptionRequest{
+		UserId:   uuid.NewString(),
+		Items:    []*pb.OrderItemRequest{{ProductId: productID.String(), Quantity: 1, UnitPriceCents: 500, Currency: "USD"}},
+		Interval: "weekly",
+		StartAt:  time.Now().Add(-time.Minute).Unix(),
+	}, rsp)
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	id := uuid.MustParse(rsp.Subscription.Id)
+
+	// The product isn't in the catalog, so every run fails and is retried until the limit
+	for run, wantActive := range []bool{true, false} {
+		placed, failed, err := scheduler.RunDue(ctx)
+		if err != nil || placed != 0 || failed != 1 {
+			t.Fatalf("run %d: expected 1 failure, got %d placed, %d failed, %v", run, placed, failed, err)
+		}
+		s := client.Subscription.GetX(ctx, id)
+		if s.FailureCount != run+1 || s.LastFailure == "" || s.LastFailedAt == nil || s.Active != wantActive {
+			t.Fatalf("run %d: expected %d failures recorded with active %v, got %d (%q), active %v", run, run+1, wantActive, s.FailureCount, s.LastFailure, s.Active)
+		}
+	}
+	if placed, failed, _ := scheduler.RunDue(ctx); placed != 0 || failed != 0 {
+		t.Fatalf("expected a paused subscription not to run, got %d placed, %d failed", placed, failed)
+	}
+
+	// Resuming starts the count over, and a placed order clears it
+	resumed := &pb.ResumeSubscriptionResponse{}
+	if err := h.ResumeSubscription(ctx, &pb.ResumeSubscriptionRequest{Id: id.String()}, resumed); err != nil {
+		t.Fatalf("ResumeSubscription: %v", err)
+	}
+	if !resumed.Subscription.Active || resumed.Subscription.FailureCount != 0 {
+		t.Fatalf("expected an active subscription with no failures, got %v", resumed.Subscription)
+	}
+	client.Subscription.UpdateOneID(id).SetNextRunAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	scheduler.RunDue(ctx)
+	if s := client.Subscription.GetX(ctx, id); s.FailureCount != 1 || !s.Active {
+		t.Fatalf("expected one failure after resuming, got %d, active %v", s.FailureCount, s.Active)
+	}
+	products.catalog[productID.String()] = newFakeProducts(productID).catalog[productID.String()]
+	if placed, _, err := scheduler.RunDue(ctx); err != nil || placed != 1 {
+		t.Fatalf("expected the order placed once the product is back, got %d, %v", placed, err)
+	}
+	if s := client.Subscription.GetX(ctx, id); s.FailureCount != 0 {
+		t.Fatalf("expected the failure count cleared by a placed order, got %d", s.FailureCount)
+	}
+}
@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/order"
+	"orders/ent/orderitem"
+	"orders/ent/shipment"
+	"orders/ent/shipmentitem"
+	pb "orders/proto"
+)
+
+// CreateShipment records a parcel carrying some or all of an order's remaining items
+// and moves the order to shipped once every item has been shipped; deleted orders are
+// not found
+func (h *OrderService) CreateShipment(ctx context.Context, req *pb.CreateShipmentRequest, rsp *pb.CreateShipmentResponse) error {
+	logger.Extract(ctx).Infof("Received CreateShipment request for order %s (carrier: %s, tracking: %s, items: %d)", req.OrderId, req.Carrier, req.TrackingNumber, len(req.Items))
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return fmt.Errorf("invalid order_id: %s", req.OrderId)
+	}
+	if req.Carrier == "" || req.TrackingNumber == "" {
+		return fmt.Errorf("carrier and tracking_number are required")
+	}
+	if len(req.Items) == 0 {
+		return fmt.Errorf("shipment must contain at least one item")
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for shipment: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	o, err := tx.Order.Query().Where(order.ID(orderID), order.DeletedAtIsNil()).WithOrderItems().Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Order not found for shipment: %s", req.OrderId)
+		return fmt.Errorf("order not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get order for shipment: %v", err)
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if o.Status == order.StatusCancelled || o.Status == order.StatusDelivered {
+		logger.Extract(ctx).Infof("Refusing shipment for order %s (status: %s)", req.OrderId, o.Status)
+		return fmt.Errorf("order cannot be shipped once %s", o.Status)
+	}
+	if o.FraudHold {
+		logger.Extract(ctx).Infof("Refusing shipment for held order %s", req.OrderId)
+		return fmt.Errorf("order is on fraud hold and cannot be shipped")
+	}
+
+	shipped, _, err := shippedQuantities(ctx, tx, orderID)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to sum shipped quantities for order %s: %v", req.OrderId, err)
+		return err
+	}
+
+	// Every line must belong to this order and fit in the quantity not yet shipped
+	items := make(map[uuid.UUID]*ent.OrderItem, len(o.Edges.OrderItems))
+	for _, item := range o.Edges.OrderItems {
+		items[item.ID] = item
+	}
+	lines := make(map[uuid.UUID]int, len(req.Items))
+	for _, line := range req.Items {
+		itemID, err := uuid.Parse(line.OrderItemId)
+		if err != nil {
+			return fmt.Errorf("invalid order_item_id: %s", line.OrderItemId)
+		}
+		item, ok := items[itemID]
+		if !ok {
+			return fmt.Errorf("order item %s does not belong to order %s", line.OrderItemId, req.OrderId)
+		}
+		if line.Quantity <= 0 {
+			return fmt.Errorf("quantity must be positive for order item %s", line.OrderItemId)
+		}
+		lines[itemID] += int(line.Quantity)
+		if remaining := item.Quantity - shipped[itemID]; lines[itemID] > remaining {
+			return fmt.Errorf("order item %s has only %d units left to ship", line.OrderItemId, remaining)
+		}
+	}
+
+	s, err := tx.Shipment.Create().
+		SetOrderID(orderID).
+		SetCarrier(req.Carrier).
+		SetTrackingNumber(req.TrackingNumber).
+		Save(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to create shipment for order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to create shipment: %w", err)
+	}
+	for itemID, quantity := range lines {
+		err := tx.ShipmentItem.Create().
+			SetShipmentID(s.ID).
+			SetOrderItemID(itemID).
+			SetQuantity(quantity).
+			Exec(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to create shipment item for order item %s: %v", itemID, err)
+			return fmt.Errorf("failed to create shipment item: %w", err)
+		}
+	}
+
+	status, err := syncShipmentStatus(ctx, tx, o)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update status of order %s: %v", req.OrderId, err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit shipment: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s, err = h.EntClient.Shipment.Query().
+		Where(shipment.ID(s.ID)).
+		WithShipmentItems(func(q *ent.ShipmentItemQuery) { q.WithOrderItem() }).
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch shipment with items: %v", err)
+		return fmt.Errorf("failed to fetch shipment: %w", err)
+	}
+
+	rsp.Shipment = toProtoShipment(s, orderID)
+	rsp.OrderStatus = status.String()
+	logger.Extract(ctx).Infof("Shipment %s created for order %s (order status: %s)", s.ID, req.OrderId, status)
+	return nil
+}
+
+// ListShipments lists an order's shipments, oldest first; deleted orders are not found
+func (h *OrderService) ListShipments(ctx context.Context, req *pb.ListShipmentsRequest, rsp *pb.ListShipmentsResponse) error {
+	logger.Extract(ctx).Infof("Received ListShipments request for order %s", req.OrderId)
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return fmt.Errorf("invalid order_id: %s", req.OrderId)
+	}
+
+	exists, err := h.EntClient.Order.Query().Where(order.ID(orderID), order.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to look up order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if !exists {
+		logger.Extract(ctx).Infof("Order not found for shipments: %s", req.OrderId)
+		return fmt.Errorf("order not found")
+	}
+
+	shipments, err := h.EntClient.Shipment.Query().
+		Where(shipment.HasOrderWith(order.ID(orderID))).
+		WithShipmentItems(func(q *ent.ShipmentItemQuery) { q.WithOrderItem() }).
+		Order(ent.Asc(shipment.FieldShippedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list shipments for order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to list shipments: %w", err)
+	}
+
+	rsp.Shipments = make([]*pb.Shipment, len(shipments))
+	for i, s := range shipments {
+		rsp.Shipments[i] = toProtoShipment(s, orderID)
+	}
+	logger.Extract(ctx).Infof("Listed %d shipments for order %s", len(shipments), req.OrderId)
+	return nil
+}
+
+// MarkShipmentDelivered records a shipment's delivery and moves the order to
+// delivered once every item has been delivered; shipments of deleted orders are not found
+func (h *OrderService) MarkShipmentDelivered(ctx context.Context, req *pb.MarkShipmentDeliveredRequest, rsp *pb.MarkShipmentDeliveredResponse) error {
+	logger.Extract(ctx).Infof("Received MarkShipmentDelivered request for shipment %s", req.Id)
+
+	shipmentID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid shipment id: %s", req.Id)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for shipment delivery: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	s, err := tx.Shipment.Query().
+		Where(shipment.ID(shipmentID), shipment.HasOrderWith(order.DeletedAtIsNil())).
+		WithOrder().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Shipment not found: %s", req.Id)
+		return fmt.Errorf("shipment not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get shipment: %v", err)
+		return fmt.Errorf("failed to get shipment: %w", err)
+	}
+	if s.DeliveredAt != nil {
+		logger.Extract(ctx).Infof("Shipment already delivered: %s", req.Id)
+		return fmt.Errorf("shipment already delivered")
+	}
+
+	err = tx.Shipment.UpdateOneID(shipmentID).
+		Where(shipment.DeliveredAtIsNil()).
+		SetDeliveredAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to mark shipment %s delivered: %v", req.Id, err)
+		return fmt.Errorf("failed to mark shipment delivered: %w", err)
+	}
+
+	o := s.Edges.Order
+	status, err := syncShipmentStatus(ctx, tx, o)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update status of order %s: %v", o.ID, err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit shipment delivery: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s, err = h.EntClient.Shipment.Query().
+		Where(shipment.ID(shipmentID)).
+		WithShipmentItems(func(q *ent.ShipmentItemQuery) { q.WithOrderItem() }).
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch shipment with items: %v", err)
+		return fmt.Errorf("failed to fetch shipment: %w", err)
+	}
+
+	rsp.Shipment = toProtoShipment(s, o.ID)
+	rsp.OrderStatus = status.String()
+	logger.Extract(ctx).Infof("Shipment %s delivered (order %s status: %s)", req.Id, o.ID, status)
+	return nil
+}
+
+// shippedQuantities sums, per order item, the units in any shipment and the units in delivered shipments
+func shippedQuantities(ctx context.Context, tx *ent.Tx, orderID uuid.UUID) (shipped, delivered map[uuid.UUID]int, err error) {
+	lines, err := tx.ShipmentItem.Query().
+		Where(shipmentitem.HasShipmentWith(shipment.HasOrderWith(order.ID(orderID)))).
+		WithShipment().
+		WithOrderItem().
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get shipment items: %w", err)
+	}
+
+	shipped = make(map[uuid.UUID]int)
+	delivered = make(map[uuid.UUID]int)
+	for _, line := range lines {
+		itemID := line.Edges.OrderItem.ID
+		shipped[itemID] += line.Quantity
+		if line.Edges.Shipment.DeliveredAt != nil {
+			delivered[itemID] += line.Quantity
+		}
+	}
+	return shipped, delivered, nil
+}
+
+// syncShipmentStatus derives the order's status from how much of each item its
+// shipments cover: delivered once all units are delivered, shipped once all units
+// have left, and processing while only part of the order has shipped
+func syncShipmentStatus(ctx context.Context, tx *ent.Tx, o *ent.Order) (order.Status, error) {
+	shipped, delivered, err := shippedQuantities(ctx, tx, o.ID)
+	if err != nil {
+		return o.Status, err
+	}
+
+	items, err := tx.OrderItem.Query().Where(orderitem.HasOrderWith(order.ID(o.ID))).All(ctx)
+	if err != nil {
+		return o.Status, fmt.Errorf("failed to get order items: %w", err)
+	}
+
+	allShipped, allDelivered := true, true
+	for _, item := range items {
+		if shipped[item.ID] < item.Quantity {
+			allShipped = false
+		}
+		if delivered[item.ID] < item.Quantity {
+			allDelivered = false
+		}
+	}
+
+	status := order.StatusProcessing
+	switch {
+	case allDelivered:
+		status = order.StatusDelivered
+	case allShipped:
+		status = order.StatusShipped
+	}
+	if status == o.Status {
+		return status, nil
+	}
+
+	if err := tx.Order.UpdateOneID(o.ID).SetStatus(status).Exec(ctx); err != nil {
+		return o.Status, fmt.Errorf("failed to update order status: %w", err)
+	}
+	if err := recordStatusChange(ctx, tx, o.ID, o.Status, status); err != nil {
+		return o.Status, err
+	}
+	return status, nil
+}
+
+// toProtoShipment converts an Entgo Shipment entity to a Protobuf Shipment message
+func toProtoShipment(s *ent.Shipment, orderID uuid.UUID) *pb.Shipment {
+	if s == nil {
+		return nil
+	}
+	protoShipment := &pb.Shipment{
+		Id:             s.ID.String(),
+		OrderId:        orderID.String(),
+		Carrier:        s.Carrier,
+		TrackingNumber: s.TrackingNumber,
+		ShippedAt:      s.ShippedAt.Unix(),
+	}
+	if s.DeliveredAt != nil {
+		protoShipment.DeliveredAt = s.DeliveredAt.Unix()
+	}
+	protoShipment.Items = make([]*pb.ShipmentItem, len(s.Edges.ShipmentItems))
+	for i, line := range s.Edges.ShipmentItems {
+		protoShipment.Items[i] = &pb.ShipmentItem{
+			OrderItemId: line.Edges.OrderItem.ID.String(),
+			Quantity:    int32(line.Quantity),
+		}
+	}
+	return protoShipment
+}
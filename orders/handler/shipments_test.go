@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+func TestShipmentCoverageDrivesOrderStatus(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+	itemID := o.Edges.OrderItems[0].ID.String() // quantity 2
+
+	ship := func(tracking string, quantity int32) *pb.CreateShipmentResponse {
+		t.Helper()
+		rsp := &pb.CreateShipmentResponse{}
+		err := h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+			OrderId:        o.ID.String(),
+			Carrier:        "DHL",
+			TrackingNumber: tracking,
+			Items:          []*pb.ShipmentItem{{OrderItemId: itemID, Quantity: quantity}},
+		}, rsp)
+		if err != nil {
+			t.Fatalf("CreateShipment: %v", err)
+		}
+		return rsp
+	}
+	deliver := func(id string) string {
+		t.Helper()
+		rsp := &pb.MarkShipmentDeliveredResponse{}
+		if err := h.MarkShipmentDelivered(ctx, &pb.MarkShipmentDeliveredRequest{Id: id}, rsp); err != nil {
+			t.Fatalf("MarkShipmentDelivered: %v", err)
+		}
+		return rsp.OrderStatus
+	}
+
+	first := ship("T1", 1)
+	if first.OrderStatus != "processing" {
+		t.Fatalf("expected a partial shipment to leave the order processing, got %s", first.OrderStatus)
+	}
+	second := ship("T2", 1)
+	if second.OrderStatus != "shipped" {
+		t.Fatalf("expected the order shipped once every unit shipped, got %s", second.OrderStatus)
+	}
+
+	err := h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+		OrderId:        o.ID.String(),
+		Carrier:        "DHL",
+		TrackingNumber: "T3",
+		Items:          []*pb.ShipmentItem{{OrderItemId: itemID, Quantity: 1}},
+	}, &pb.CreateShipmentResponse{})
+	if err == nil {
+		t.Fatal("expected shipping more units than ordered to fail")
+	}
+
+	if status := deliver(first.Shipment.Id); status != "shipped" {
+		t.Fatalf("expected a partial delivery to leave the order shipped, got %s", status)
+	}
+	if status := deliver(second.Shipment.Id); status != "delivered" {
+		t.Fatalf("expected the order delivered once every unit delivered, got %s", status)
+	}
+
+	list := &pb.ListShipmentsResponse{}
+	if err := h.ListShipments(ctx, &pb.ListShipmentsRequest{OrderId: o.ID.String()}, list); err != nil {
+		t.Fatalf("ListShipments: %v", err)
+	}
+	if len(list.Shipments) != 2 {
+		t.Fatalf("expected 2 shipments, got %d", len(list.Shipments))
+	}
+}
+
+func TestUpdateOrderStatusLeavesShippingToShipments(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+
+	for _, status := range []string{"shipped", "delivered"} {
+		err := h.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{Id: o.ID.String(), Status: status}, &pb.UpdateOrderStatusResponse{})
+		if errors.FromError(err).Code != http.StatusBadRequest {
+			t.Errorf("expected setting %s by hand to be a BadRequest, got %v", status, err)
+		}
+	}
+	if s := client.Order.GetX(ctx, o.ID).Status; s != order.StatusPending {
+		t.Fatalf("expected the order still pending without shipments, got %s", s)
+	}
+}
+
+func TestShipmentsOfDeletedOrdersAreNotFound(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &OrderService{EntClient: client}
+	o := createTestOrder(t, client, uuid.New())
+	itemID := o.Edges.OrderItems[0].ID.String()
+
+	shipped := &pb.CreateShipmentResponse{}
+	err := h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+		OrderId:        o.ID.String(),
+		Carrier:        "DHL",
+		TrackingNumber: "T1",
+		Items:          []*pb.ShipmentItem{{OrderItemId: itemID, Quantity: 1}},
+	}, shipped)
+	if err != nil {
+		t.Fatalf("CreateShipment: %v", err)
+	}
+	admin := &AdminService{EntClient: client}
+	if err := admin.ForceDeleteOrder(ctx, &pb.ForceDeleteOrderRequest{Id: o.ID.String()}, &pb.ForceDeleteOrderResponse{}); err != nil {
+		t.Fatalf("ForceDeleteOrder: %v", err)
+	}
+
+	err = h.CreateShipment(ctx, &pb.CreateShipmentRequest{
+		OrderId:        o.ID.String(),
+		Carrier:        "DHL",
+		TrackingNumber: "T2",
+		Items:          []*pb.ShipmentItem{{OrderItemId: itemID, Quantity: 1}},
+	}, &pb.CreateShipmentResponse{})
+	if err == nil || err.Error() != "order not found" {
+		t.Fatalf("expected shipping a deleted order to find no order, got %v", err)
+	}
+	err = h.ListShipments(ctx, &pb.ListShipmentsRequest{OrderId: o.ID.String()}, &pb.ListShipmentsResponse{})
+	if err == nil || err.Error() != "order not found" {
+		t.Fatalf("expected listing a deleted order's shipments to find no order, got %v", err)
+	}
+	err = h.MarkShipmentDelivered(ctx, &pb.MarkShipmentDeliveredRequest{Id: shipped.Shipment.Id}, &pb.MarkShipmentDeliveredResponse{})
+	if err == nil || err.Error() != "shipment not found" {
+		t.Fatalf("expected delivering a deleted order's shipment to find no shipment, got %v", err)
+	}
+	if n := client.Shipment.Query().CountX(ctx); n != 1 {
+		t.Fatalf("expected only the first shipment stored, found %d", n)
+	}
+
+	// Restored, the order ships again
+	if err := admin.RestoreOrder(ctx, &pb.RestoreOrderRequest{Id: o.ID.String()}, &pb.RestoreOrderResponse{}); err != nil {
+		t.Fatalf("RestoreOrder: %v", err)
+	}
+	if err := h.MarkShipmentDelivered(ctx, &pb.MarkShipmentDeliveredRequest{Id: shipped.Shipment.Id}, &pb.MarkShipmentDeliveredResponse{}); err != nil {
+		t.Fatalf("MarkShipmentDelivered after restore: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/order"
+	pb "orders/proto"
+)
+
+// countryCode matches an ISO 3166-1 alpha-2 country code once upper-cased
+var countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// normalizeShippingAddress trims every field of a, upper-cases its country and rejects it
+// if a required field is missing or the country is not a two-letter code
+func normalizeShippingAddress(id string, a *pb.ShippingAddress) (*pb.ShippingAddress, error) {
+	n := &pb.ShippingAddress{
+		Recipient:  strings.TrimSpace(a.Recipient),
+		Line1:      strings.TrimSpace(a.Line1),
+		Line2:      strings.TrimSpace(a.Line2),
+		City:       strings.TrimSpace(a.City),
+		Region:     strings.TrimSpace(a.Region),
+		PostalCode: strings.TrimSpace(a.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+	for _, f := range []struct{ name, value string }{
+		{"recipient", n.Recipient},
+		{"line1", n.Line1},
+		{"city", n.City},
+		{"postal_code", n.PostalCode},
+		{"country", n.Country},
+	} {
+		if f.value == "" {
+			return nil, errors.BadRequest(id, "shipping address %s is required", f.name)
+		}
+	}
+	if !countryCode.MatchString(n.Country) {
+		return nil, errors.BadRequest(id, "invalid shipping address country %q: use an ISO 3166-1 alpha-2 code", a.Country)
+	}
+	return n, nil
+}
+
+// setShippingAddress writes a normalized address to an order create or update, clearing
+// the optional fields a leaves empty
+func setShippingAddress(m *ent.OrderMutation, a *pb.ShippingAddress) {
+	m.SetShippingRecipient(a.Recipient)
+	m.SetShippingLine1(a.Line1)
+	m.SetShippingCity(a.City)
+	m.SetShippingPostalCode(a.PostalCode)
+	m.SetShippingCountry(a.Country)
+	if a.Line2 != "" {
+		m.SetShippingLine2(a.Line2)
+	} else {
+		m.ClearShippingLine2()
+	}
+	if a.Region != "" {
+		m.SetShippingRegion(a.Region)
+	} else {
+		m.ClearShippingRegion()
+	}
+}
+
+// toProtoShippingAddress returns the shipping address of o, or nil if it was placed without one
+func toProtoShippingAddress(o *ent.Order) *pb.ShippingAddress {
+	if o.ShippingLine1 == nil {
+		return nil
+	}
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return &pb.ShippingAddress{
+		Recipient:  value(o.ShippingRecipient),
+		Line1:      *o.ShippingLine1,
+		Line2:      value(o.ShippingLine2),
+		City:       value(o.ShippingCity),
+		Region:     value(o.ShippingRegion),
+		PostalCode: value(o.ShippingPostalCode),
+		Country:    value(o.ShippingCountry),
+	}
+}
+
+// UpdateShippingAddress handles changing where an order is delivered, which is allowed
+// only while the order is pending
+func (h *OrderService) UpdateShippingAddress(ctx context.Context, req *pb.UpdateShippingAddressRequest, rsp *pb.UpdateShippingAddressResponse) error {
+	logger.Extract(ctx).Infof("Received UpdateShippingAddress request for order %s", req.OrderId)
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return fmt.Errorf("invalid order_id: %s", req.OrderId)
+	}
+	if req.ShippingAddress == nil {
+		return errors.BadRequest("orders.UpdateShippingAddress", "shipping address is required")
+	}
+	address, err := normalizeShippingAddress("orders.UpdateShippingAddress", req.ShippingAddress)
+	if err != nil {
+		return err
+	}
+
+	// The status condition keeps an order that moved on concurrently from being changed
+	update := h.EntClient.Order.UpdateOneID(orderID).
+		Where(order.DeletedAtIsNil(), order.StatusEQ(order.StatusPending))
+	setShippingAddress(update.Mutation(), address)
+	err = update.Exec(ctx)
+	if ent.IsNotFound(err) {
+		o, getErr := h.EntClient.Order.Query().Where(order.ID(orderID), order.DeletedAtIsNil()).Only(ctx)
+		if getErr != nil {
+			logger.Extract(ctx).Infof("Order not found for shipping address update: %s", req.OrderId)
+			return errors.NotFound("orders.UpdateShippingAddress", "order not found")
+		}
+		logger.Extract(ctx).Infof("Refusing shipping address update of %s order %s", o.Status, req.OrderId)
+		return errors.New("orders.UpdateShippingAddress", fmt.Sprintf("order is %s, the shipping address can only change while it is pending", o.Status), http.StatusPreconditionFailed)
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to update shipping address of order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to update shipping address: %w", err)
+	}
+
+	o, err := h.EntClient.Order.Query().
+		Where(order.ID(orderID)).
+		WithOrderItems().
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to fetch order: %w", err)
+	}
+
+	rsp.Order = toProtoOrder(o)
+	logger.Extract(ctx).Infof("Shipping address of order %s updated", req.OrderId)
+	return nil
+}
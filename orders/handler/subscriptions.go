@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"orders/ent"
+	"orders/ent/schema"
+	"orders/ent/subscription"
+	pb "orders/proto"
+)
+
+// CreateSubscription sets up a recurring order the scheduler places every interval
+func (h *OrderService) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest, rsp *pb.CreateSubscriptionResponse) error {
+	logger.Extract(ctx).Infof("Received CreateSubscription request for user_id: %s (interval: %s, items: %d)", req.UserId, req.Interval, len(req.Items))
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+	interval := subscription.Interval(req.Interval)
+	if err := subscription.IntervalValidator(interval); err != nil {
+		return fmt.Errorf("invalid interval %q: must be daily, weekly or monthly", req.Interval)
+	}
+	if len(req.Items) == 0 {
+		return fmt.Errorf("subscription must contain at least one item")
+	}
+
+	// Items are only checked against the catalog, and priced from it, when each order is placed
+	items := make([]schema.SubscriptionItem, len(req.Items))
+	for i, item := range req.Items {
+		productID, err := uuid.Parse(item.ProductId)
+		if err != nil {
+			return fmt.Errorf("invalid product_id: %s", item.ProductId)
+		}
+		if item.Quantity <= 0 {
+			return fmt.Errorf("quantity of product %s must be positive", item.ProductId)
+		}
+		items[i] = schema.SubscriptionItem{
+			ProductID:      productID,
+			Quantity:       int(item.Quantity),
+			UnitPriceCents: requestCents(item.UnitPriceCents, item.UnitPrice),
+			Currency:       item.Currency,
+		}
+	}
+
+	nextRunAt := nextSubscriptionRun(interval, time.Now())
+	if req.StartAt != 0 {
+		nextRunAt = time.Unix(req.StartAt, 0)
+	}
+
+	s, err := h.EntClient.Subscription.Create().
+		SetUserID(userID).
+		SetItems(items).
+		SetInterval(interval).
+		SetNextRunAt(nextRunAt).
+		Save(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to create subscription: %v", err)
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	rsp.Subscription = toProtoSubscription(s)
+	logger.Extract(ctx).Infof("Subscription created successfully: %s (next run at %s)", s.ID, s.NextRunAt)
+	return nil
+}
+
+// PauseSubscription stops the scheduler placing orders for a subscription until it is
+// resumed. Pausing an already-paused subscription succeeds without changes.
+func (h *OrderService) PauseSubscription(ctx context.Context, req *pb.PauseSubscriptionRequest, rsp *pb.PauseSubscriptionResponse) error {
+	logger.Extract(ctx).Infof("Received PauseSubscription request for ID: %s", req.Id)
+
+	s, err := h.getSubscription(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if s.CancelledAt != nil {
+		logger.Extract(ctx).Infof("Refusing to pause cancelled subscription: %s", req.Id)
+		return fmt.Errorf("subscription is cancelled")
+	}
+	if s.Active {
+		s, err = h.EntClient.Subscription.UpdateOne(s).SetActive(false).Save(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to pause subscription %s: %v", req.Id, err)
+			return fmt.Errorf("failed to pause subscription: %w", err)
+		}
+	}
+
+	rsp.Subscription = toProtoSubscription(s)
+	logger.Extract(ctx).Infof("Subscription paused successfully: %s", s.ID)
+	return nil
+}
+
+// ResumeSubscription restarts a paused subscription, including one the scheduler paused
+// after repeated failures, whose failure count starts over. Runs missed while paused are
+// skipped rather than placed all at once on resume.
+func (h *OrderService) ResumeSubscription(ctx context.Context, req *pb.ResumeSubscriptionRequest, rsp *pb.ResumeSubscriptionResponse) error {
+	logger.Extract(ctx).Infof("Received ResumeSubscription request for ID: %s", req.Id)
+
+	s, err := h.getSubscription(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if s.CancelledAt != nil {
+		logger.Extract(ctx).Infof("Refusing to resume cancelled subscription: %s", req.Id)
+		return fmt.Errorf("subscription is cancelled")
+	}
+	if !s.Active {
+		s, err = h.EntClient.Subscription.UpdateOne(s).
+			SetActive(true).
+			SetFailureCount(0).
+			SetNextRunAt(advanceSubscriptionRun(s.Interval, s.NextRunAt, time.Now())).
+			Save(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to resume subscription %s: %v", req.Id, err)
+			return fmt.Errorf("failed to resume subscription: %w", err)
+		}
+	}
+
+	rsp.Subscription = toProtoSubscription(s)
+	logger.Extract(ctx).Infof("Subscription resumed successfully: %s (next run at %s)", s.ID, s.NextRunAt)
+	return nil
+}
+
+// CancelSubscription permanently stops a subscription. Cancelling an already-cancelled
+// subscription succeeds without changes.
+func (h *OrderService) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest, rsp *pb.CancelSubscriptionResponse) error {
+	logger.Extract(ctx).Infof("Received CancelSubscription request for ID: %s", req.Id)
+
+	s, err := h.getSubscription(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	if s.CancelledAt == nil {
+		s, err = h.EntClient.Subscription.UpdateOne(s).
+			SetActive(false).
+			SetCancelledAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to cancel subscription %s: %v", req.Id, err)
+			return fmt.Errorf("failed to cancel subscription: %w", err)
+		}
+	}
+
+	rsp.Subscription = toProtoSubscription(s)
+	logger.Extract(ctx).Infof("Subscription cancelled successfully: %s", s.ID)
+	return nil
+}
+
+// getSubscription fetches a subscription by ID
+func (h *OrderService) getSubscription(ctx context.Context, id string) (*ent.Subscription, error) {
+	subscriptionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription id: %s", id)
+	}
+	s, err := h.EntClient.Subscription.Get(ctx, subscriptionID)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Subscription not found: %s", id)
+		return nil, fmt.Errorf("subscription not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get subscription: %v", err)
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return s, nil
+}
+
+// nextSubscriptionRun returns the run one interval after from
+func nextSubscriptionRun(interval subscription.Interval, from time.Time) time.Time {
+	switch interval {
+	case subscription.IntervalDaily:
+		return from.AddDate(0, 0, 1)
+	case subscription.IntervalWeekly:
+		return from.AddDate(0, 0, 7)
+	default:
+		return from.AddDate(0, 1, 0)
+	}
+}
+
+// advanceSubscriptionRun steps a run forward by whole intervals until it is after now,
+// keeping the subscription on its original schedule
+func advanceSubscriptionRun(interval subscription.Interval, run, now time.Time) time.Time {
+	for !run.After(now) {
+		run = nextSubscriptionRun(interval, run)
+	}
+	return run
+}
+
+// toProtoSubscription converts an Entgo Subscription entity to a Protobuf Subscription message
+func toProtoSubscription(s *ent.Subscription) *pb.Subscription {
+	protoSubscription := &pb.Subscription{
+		Id:           s.ID.String(),
+		UserId:       s.UserID.String(),
+		Items:        toOrderItemRequests(s.Items),
+		Interval:     s.Interval.String(),
+		NextRunAt:    s.NextRunAt.Unix(),
+		Active:       s.Active,
+		CreatedAt:    s.CreatedAt.Unix(),
+		UpdatedAt:    s.UpdatedAt.Unix(),
+		FailureCount: int32(s.FailureCount),
+		LastFailure:  s.LastFailure,
+	}
+	if s.CancelledAt != nil {
+		protoSubscription.CancelledAt = s.CancelledAt.Unix()
+	}
+	if s.LastFailedAt != nil {
+		protoSubscription.LastFailedAt = s.LastFailedAt.Unix()
+	}
+	return protoSubscription
+}
+
+// toOrderItemRequests converts subscription items to the items of an order request
+func toOrderItemRequests(items []schema.SubscriptionItem) []*pb.OrderItemRequest {
+	requests := make([]*pb.OrderItemRequest, len(items))
+	for i, item := range items {
+		requests[i] = &pb.OrderItemRequest{
+			ProductId:      item.ProductID.String(),
+			Quantity:       int32(item.Quantity),
+			UnitPriceCents: item.UnitPriceCents,
+			Currency:       item.Currency,
+		}
+	}
+	return requests
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+
+	userspb "users/proto"
+)
+
+// lookupUserByEmail resolves an email to a user id through the users service,
+// reporting found=false rather than an error when no user has that email
+func lookupUserByEmail(ctx context.Context, users userspb.UserService, email string) (id uuid.UUID, found bool, err error) {
+	rsp, err := users.GetUserByEmail(ctx, &userspb.GetUserByEmailRequest{Email: email})
+	if err != nil {
+		if errors.FromError(err).Code == http.StatusNotFound {
+			return uuid.Nil, false, nil
+		}
+		return uuid.Nil, false, fmt.Errorf("failed to look up user by email: %w", err)
+	}
+	id, err = uuid.Parse(rsp.User.GetId())
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("users service returned invalid user id %q", rsp.User.GetId())
+	}
+	return id, true, nil
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/client"
+	"go-micro.dev/v5/errors"
+
+	pb "orders/proto"
+
+	userspb "users/proto"
+)
+
+// fakeUsers serves GetUserByEmail from a fixed directory of emails to user ids
+type fakeUsers struct {
+	userspb.UserService
+	byEmail map[string]uuid.UUID
+}
+
+func (f *fakeUsers) GetUserByEmail(ctx context.Context, req *userspb.GetUserByEmailRequest, opts ...client.CallOption) (*userspb.GetUserResponse, error) {
+	id, ok := f.byEmail[req.Email]
+	if !ok {
+		return nil, errors.NotFound("users.GetUserByEmail", "user not found")
+	}
+	return &userspb.GetUserResponse{User: &userspb.User{Id: id.String(), Email: req.Email}}, nil
+}
+
+func TestSearchOrdersByEmail(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	h := &OrderService{EntClient: client, Users: &fakeUsers{byEmail: map[string]uuid.UUID{"alice@example.com": alice}}}
+
+	want := createTestOrder(t, client, alice)
+	createTestOrder(t, client, bob)
+
+	rsp := &pb.SearchOrdersResponse{}
+	if err := h.SearchOrders(ctx, &pb.SearchOrdersRequest{Email: "alice@example.com"}, rsp); err != nil {
+		t.Fatalf("SearchOrders: %v", err)
+	}
+	if rsp.Total != 1 || len(rsp.Orders) != 1 || rsp.Orders[0].Id != want.ID.String() {
+		t.Fatalf("expected only alice's order, got %d of %d", len(rsp.Orders), rsp.Total)
+	}
+
+	rsp = &pb.SearchOrdersResponse{}
+	if err := h.SearchOrders(ctx, &pb.SearchOrdersRequest{Email: "nobody@example.com"}, rsp); err != nil {
+		t.Fatalf("SearchOrders: %v", err)
+	}
+	if rsp.Total != 0 || len(rsp.Orders) != 0 {
+		t.Fatalf("expected an unknown email to match no orders, got %d of %d", len(rsp.Orders), rsp.Total)
+	}
+
+	h.Users = nil
+	if err := h.SearchOrders(ctx, &pb.SearchOrdersRequest{Email: "alice@example.com"}, &pb.SearchOrdersResponse{}); err == nil {
+		t.Fatal("expected email search to fail without a users client")
+	}
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "orders/proto"
+
+	productspb "products/proto"
+)
+
+// Reasons reported in an OrderItemViolation
+const (
+	ViolationNotFound      = "not_found"
+	ViolationPriceMismatch = "price_mismatch"
+)
+
+// Further reasons reported by ValidateOrderItems, for checks CreateOrder fails with a plain error,
+// and by Checkout for cart items that can't be purchased
+const (
+	ViolationInvalidQuantity  = "invalid_quantity"
+	ViolationInvalidPrice     = "invalid_price"
+	ViolationInvalidProductID = "invalid_product_id"
+	ViolationOutOfStock       = "insufficient_stock"
+	ViolationInactive         = "inactive"
+)
+
+// checkOrderItems rejects an order without items, or with an item whose quantity or unit
+// price is not positive, naming the zero-based index of the first offending item
+func checkOrderItems(id string, items []*pb.OrderItemRequest) error {
+	if len(items) == 0 {
+		return errors.BadRequest(id, "order must contain at least one item")
+	}
+	for i, item := range items {
+		if item.Quantity <= 0 {
+			return errors.BadRequest(id, "order item %d: quantity must be positive, got %d", i, item.Quantity)
+		}
+		if cents := requestCents(item.UnitPriceCents, item.UnitPrice); cents <= 0 {
+			return errors.BadRequest(id, "order item %d: unit price must be positive, got %d cents", i, cents)
+		}
+	}
+	return nil
+}
+
+// validateItems checks every order item against the catalog in a single products call,
+// returning the catalog products by ID. Items referencing unknown products, or priced
+// outside the tolerance when CheckPrices is set, are all reported together in one
+// BadRequest error whose detail is an OrderValidationError.
+func (h *OrderService) validateItems(ctx context.Context, id string, items []*pb.OrderItemRequest) (map[string]*productspb.Product, error) {
+	catalog, err := h.lookupCatalog(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []*pb.OrderItemViolation
+	for _, item := range items {
+		if v := h.catalogViolation(item, catalog); v != nil {
+			violations = append(violations, v)
+		}
+	}
+	if len(violations) > 0 {
+		return nil, validationError(id, violations)
+	}
+	return catalog, nil
+}
+
+// lookupCatalog fetches the products of all items in a single products call, by ID
+func (h *OrderService) lookupCatalog(ctx context.Context, items []*pb.OrderItemRequest) (map[string]*productspb.Product, error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ProductId
+	}
+
+	rsp, err := h.Products.GetProductsByIDs(ctx, &productspb.GetProductsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate products: %w", err)
+	}
+
+	catalog := make(map[string]*productspb.Product, len(rsp.Products))
+	for _, p := range rsp.Products {
+		catalog[p.Id] = p
+	}
+	return catalog, nil
+}
+
+// catalogPrice returns the catalog price of one unit of p when quantity units are ordered:
+// the price of the tier with the highest min_quantity that quantity reaches, or the product
+// price below every tier, as products.GetEffectivePrice prices it
+func catalogPrice(p *productspb.Product, quantity int32) int64 {
+	price, best := p.PriceCents, int32(0)
+	for _, t := range p.PriceTiers {
+		if t.MinQuantity <= quantity && t.MinQuantity > best {
+			price, best = t.UnitPriceCents, t.MinQuantity
+		}
+	}
+	return price
+}
+
+// catalogViolation checks an item against the catalog, returning nil if it passes; its price
+// is checked against the price tier for its quantity
+func (h *OrderService) catalogViolation(item *pb.OrderItemRequest, catalog map[string]*productspb.Product) *pb.OrderItemViolation {
+	cents := requestCents(item.UnitPriceCents, item.UnitPrice)
+	p, ok := catalog[item.ProductId]
+	if !ok {
+		return &pb.OrderItemViolation{
+			ProductId:      item.ProductId,
+			Reason:         ViolationNotFound,
+			UnitPriceCents: cents,
+		}
+	}
+	if catalogCents := catalogPrice(p, item.Quantity); h.CheckPrices && abs(cents-catalogCents) > h.PriceToleranceCents {
+		return &pb.OrderItemViolation{
+			ProductId:         item.ProductId,
+			Reason:            ViolationPriceMismatch,
+			UnitPriceCents:    cents,
+			CatalogPriceCents: catalogCents,
+		}
+	}
+	return nil
+}
+
+// ValidateOrderItems handles checking proposed order items the way CreateOrder would, and
+// their stock besides, without placing an order or changing anything. Every item is
+// reported with the first check it fails. Catalog checks are skipped when product
+// validation is disabled, as they are by CreateOrder.
+func (h *OrderService) ValidateOrderItems(ctx context.Context, req *pb.ValidateOrderItemsRequest, rsp *pb.ValidateOrderItemsResponse) error {
+	logger.Extract(ctx).Infof("Received ValidateOrderItems request for %d items", len(req.Items))
+
+	var catalog map[string]*productspb.Product
+	if h.Products != nil {
+		var err error
+		if catalog, err = h.lookupCatalog(ctx, req.Items); err != nil {
+			logger.Extract(ctx).Errorf("Failed to look up products for validation: %v", err)
+			return err
+		}
+	}
+
+	rsp.Valid = true
+	rsp.Items = make([]*pb.OrderItemValidation, len(req.Items))
+	currencies := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		v := &pb.OrderItemValidation{
+			Index:          int32(i),
+			ProductId:      item.ProductId,
+			UnitPriceCents: requestCents(item.UnitPriceCents, item.UnitPrice),
+		}
+		currencies[i] = item.Currency
+		if p, ok := catalog[item.ProductId]; ok {
+			v.CatalogPriceCents = catalogPrice(p, item.Quantity)
+			v.AvailableStock = p.StockQuantity
+			currencies[i] = p.Currency
+		}
+
+		if item.Quantity <= 0 {
+			v.Reason = ViolationInvalidQuantity
+		} else if v.UnitPriceCents <= 0 {
+			v.Reason = ViolationInvalidPrice
+		} else if _, err := uuid.Parse(item.ProductId); err != nil {
+			v.Reason = ViolationInvalidProductID
+		} else if catalog != nil {
+			if violation := h.catalogViolation(item, catalog); violation != nil {
+				v.Reason = violation.Reason
+			} else if item.Quantity > v.AvailableStock {
+				v.Reason = ViolationOutOfStock
+			}
+		}
+		v.Valid = v.Reason == ""
+		rsp.Valid = rsp.Valid && v.Valid
+		rsp.Items[i] = v
+	}
+
+	if len(req.Items) == 0 {
+		rsp.Error = "order must contain at least one item"
+	} else if currency, err := commonCurrency(currencies); err != nil {
+		rsp.Error = err.Error()
+	} else {
+		rsp.Currency = currency
+	}
+	if rsp.Error != "" {
+		rsp.Valid = false
+	}
+
+	logger.Extract(ctx).Infof("Validated %d order items, valid: %v", len(req.Items), rsp.Valid)
+	return nil
+}
+
+// validationError wraps violations in a BadRequest error so callers can decode them with ParseValidationError
+func validationError(id string, violations []*pb.OrderItemViolation) error {
+	detail, err := protojson.Marshal(&pb.OrderValidationError{Violations: violations})
+	if err != nil {
+		return errors.BadRequest(id, "%d order items failed validation", len(violations))
+	}
+	return errors.BadRequest(id, "%s", detail)
+}
+
+// ParseValidationError extracts the rejected items from an error returned by CreateOrder or Checkout,
+// reporting false if err is not an order validation error
+func ParseValidationError(err error) (*pb.OrderValidationError, bool) {
+	merr := errors.FromError(err)
+	if merr == nil || merr.Code != http.StatusBadRequest {
+		return nil, false
+	}
+	verr := &pb.OrderValidationError{}
+	if protojson.Unmarshal([]byte(merr.Detail), verr) != nil || len(verr.Violations) == 0 {
+		return nil, false
+	}
+	return verr, true
+}
+
+// abs returns the absolute value of n
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
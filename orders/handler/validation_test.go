@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "orders/proto"
+
+	productspb "products/proto"
+)
+
+func TestValidateOrderItemsAllValid(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+	products := newFakeProducts(a, b)
+	h := &OrderService{EntClient: client, Products: products, CheckPrices: true}
+
+	rsp := &pb.ValidateOrderItemsResponse{}
+	err := h.ValidateOrderItems(ctx, &pb.ValidateOrderItemsRequest{Items: []*pb.OrderItemRequest{
+		{ProductId: a.String(), Quantity: 2, UnitPriceCents: 500},
+		{ProductId: b.String(), Quantity: 10, UnitPriceCents: 500},
+	}}, rsp)
+	if err != nil {
+		t.Fatalf("ValidateOrderItems: %v", err)
+	}
+	if !rsp.Valid || rsp.Error != "" || rsp.Currency != "USD" {
+		t.Fatalf("expected the items valid in USD, got %v, %q, %q", rsp.Valid, rsp.Error, rsp.Currency)
+	}
+	for _, v := range rsp.Items {
+		if !v.Valid || v.Reason != "" || v.CatalogPriceCents != 500 || v.AvailableStock != 10 {
+			t.Errorf("item %d: expected valid with catalog details, got %v", v.Index, v)
+		}
+	}
+
+	// Validation places nothing and touches no stock
+	if n := client.Order.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected no order stored, found %d", n)
+	}
+	if len(products.restocked) != 0 {
+		t.Fatalf("expected no stock changes, got %v", products.restocked)
+	}
+}
+
+func TestValidateOrderItemsReportsEachInvalidItem(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	known, lowStock, unknown := uuid.New(), uuid.New(), uuid.New()
+	products := newFakeProducts(known, lowStock)
+	products.catalog[lowStock.String()].StockQuantity = 1
+	h := &OrderService{EntClient: client, Products: products, CheckPrices: true}
+
+	items := []*pb.OrderItemRequest{
+		{ProductId: known.String(), Quantity: 1, UnitPriceCents: 500},
+		{ProductId: unknown.String(), Quantity: 1, UnitPriceCents: 500},
+		{ProductId: known.String(), Quantity: 1, UnitPriceCents: 450},
+		{ProductId: lowStock.String(), Quantity: 2, UnitPriceCents: 500},
+		{ProductId: known.String(), Quantity: 0, UnitPriceCents: 500},
+		{ProductId: known.String(), Quantity: 1, UnitPriceCents: 0},
+		{ProductId: "not-a-uuid", Quantity: 1, UnitPriceCents: 500},
+	}
+	want := []string{"", ViolationNotFound, ViolationPriceMismatch, ViolationOutOfStock, ViolationInvalidQuantity, ViolationInvalidPrice, ViolationInvalidProductID}
+
+	rsp := &pb.ValidateOrderItemsResponse{}
+	if err := h.ValidateOrderItems(ctx, &pb.ValidateOrderItemsRequest{Items: items}, rsp); err != nil {
+		t.Fatalf("ValidateOrderItems: %v", err)
+	}
+	if rsp.Valid {
+		t.Fatal("expected the item set to be invalid")
+	}
+	if len(rsp.Items) != len(want) {
+		t.Fatalf("expected %d item results, got %d", len(want), len(rsp.Items))
+	}
+	for i, v := range rsp.Items {
+		if v.Index != int32(i) || v.Reason != want[i] || v.Valid != (want[i] == "") {
+			t.Errorf("item %d: expected reason %q, got %v", i, want[i], v)
+		}
+	}
+	if n := client.Order.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected no order stored, found %d", n)
+	}
+
+	empty := &pb.ValidateOrderItemsResponse{}
+	if err := h.ValidateOrderItems(ctx, &pb.ValidateOrderItemsRequest{}, empty); err != nil {
+		t.Fatalf("ValidateOrderItems: %v", err)
+	}
+	if empty.Valid || empty.Error == "" {
+		t.Fatalf("expected an empty item list to be invalid, got %v, %q", empty.Valid, empty.Error)
+	}
+}
+
+func TestCatalogPricesApplyQuantityTiers(t *testing.T) {
+	id := uuid.New()
+	products := newFakeProducts(id)
+	products.catalog[id.String()].PriceTiers = []*productspb.PriceTier{
+		{MinQuantity: 5, UnitPriceCents: 450},
+		{MinQuantity: 10, UnitPriceCents: 400},
+	}
+	h := &OrderService{EntClient: newTestClient(t), Products: products, CheckPrices: true}
+
+	rsp := &pb.ValidateOrderItemsResponse{}
+	err := h.ValidateOrderItems(context.Background(), &pb.ValidateOrderItemsRequest{Items: []*pb.OrderItemRequest{
+		{ProductId: id.String(), Quantity: 4, UnitPriceCents: 500},
+		{ProductId: id.String(), Quantity: 5, UnitPriceCents: 450},
+		{ProductId: id.String(), Quantity: 10, UnitPriceCents: 400},
+		{ProductId: id.String(), Quantity: 5, UnitPriceCents: 500},
+	}}, rsp)
+	if err != nil {
+		t.Fatalf("ValidateOrderItems: %v", err)
+	}
+	for i, want := range []struct {
+		valid   bool
+		catalog int64
+	}{{true, 500}, {true, 450}, {true, 400}, {false, 450}} {
+		v := rsp.Items[i]
+		if v.Valid != want.valid || v.CatalogPriceCents != want.catalog {
+			t.Errorf("item %d: expected valid %v at catalog price %d, got %v", i, want.valid, want.catalog, v)
+		}
+	}
+	if rsp.Items[3].Reason != ViolationPriceMismatch {
+		t.Fatalf("expected the base price for a tiered quantity to mismatch, got %q", rsp.Items[3].Reason)
+	}
+}
@@ -3,45 +3,93 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
-	"orders/ent"
 	"orders/handler"
+	"orders/seed"
 
-	_ "github.com/mattn/go-sqlite3" // Import for SQLite driver
-
-	"entgo.io/ent/dialect"
 	"go-micro.dev/v5"
 	"go-micro.dev/v5/logger"
 
 	pb "orders/proto"
+
+	cartspb "carts/proto"
+	productspb "products/proto"
+	userspb "users/proto"
 )
 
 func main() {
-	// Initialize EntgoClient
-	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	// Initialize EntgoClient from DB_DRIVER and DB_DSN
+	client, db, driver, err := openDB()
 	if err != nil {
-		logger.Fatalf("Failed opening connection to sqlite: %v", err)
+		logger.Fatalf("Failed opening connection to %s: %v", driver, err)
 	}
 	defer client.Close()
 
+	// Report not ready until migrations and seeding are done; HEALTH_ADDR enables HTTP probes
+	health := &handler.Health{DB: db}
+	if addr := os.Getenv("HEALTH_ADDR"); addr != "" {
+		go func() {
+			if err := health.ServeProbes(addr); err != nil {
+				logger.Errorf("Health probe server stopped: %v", err)
+			}
+		}()
+	}
+
+	// Record RPC count, error and latency metrics; METRICS_ADDR exposes them at /metrics
+	metrics := handler.NewMetrics()
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		go func() {
+			if err := metrics.Serve(addr); err != nil {
+				logger.Errorf("Metrics server stopped: %v", err)
+			}
+		}()
+	}
+
 	// Run the auto migration tool
 	ctx := context.Background()
 	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("Failed creating schema resources: %v", err)
 	}
 
+	// Load sample fixtures for local development when SEED names a fixture set
+	if set := os.Getenv("SEED"); set != "" {
+		if err := seed.Load(ctx, client, seed.FixtureSet(set)); err != nil {
+			logger.Fatalf("Failed seeding database with %q fixtures: %v", set, err)
+		}
+		logger.Infof("Seeded database with %q fixtures", set)
+	}
+
+	// Stop the subscription scheduler, started once OrderService is registered, on shutdown
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	schedulerDone := make(chan struct{})
+
+	// Advertise the deployment's region, if any, and stamp it on the rows this service creates
+	region := os.Getenv("REGION")
+	metadata := serviceMetadata(region)
+
 	// Create a new service
 	service := micro.NewService(
 		micro.Name("orders"),
 		micro.Version("latest"),
-		micro.Metadata(map[string]string{
-			"StartTime": time.Now().String(),
-		}),
+		micro.Metadata(metadata),
+		micro.WrapHandler(metrics.Wrapper()),
+		micro.WrapHandler(handler.CorrelationWrapper()),
 		micro.BeforeStart(func() error {
 			logger.Info("Order service starting...")
 			return nil
 		}),
+		micro.AfterStart(func() error {
+			health.SetReady(true)
+			return nil
+		}),
+		micro.BeforeStop(func() error {
+			health.SetReady(false)
+			stopScheduler()
+			<-schedulerDone
+			return nil
+		}),
 		micro.AfterStop(func() error {
 			logger.Info("Order service stopped")
 			return nil
@@ -51,13 +99,63 @@ func main() {
 	// Initialize service
 	service.Init()
 
+	// Register Health.Check and Debug.Health for orchestrator probes
+	if err := health.Register(service.Server()); err != nil {
+		logger.Fatalf("Failed to register health handler: %v", err)
+	}
+
+	// Validate order items against the products service unless disabled for offline environments
+	var products productspb.ProductService
+	if envBool("PRODUCT_VALIDATION", true) {
+		products = productspb.NewProductService("products", service.Client())
+	} else {
+		logger.Warn("Product validation disabled, order items will not be checked against the catalog")
+	}
+
+	// Publish new orders so the products service can take their items out of stock
+	events := micro.NewEvent(handler.OrderCreatedTopic, service.Client())
+
 	// Register OrderService handler
-	if err := pb.RegisterOrderServiceHandler(service.Server(), &handler.OrderService{EntClient: client}); err != nil {
+	orders := &handler.OrderService{
+		EntClient:          client,
+		CancellationWindow: envDuration("ORDER_CANCELLATION_WINDOW", time.Hour),
+		Products:           products,
+		// Reject items priced away from the catalog, e.g. tampered by the client
+		CheckPrices:         envBool("PRICE_VALIDATION", false),
+		PriceToleranceCents: int64(envInt("PRICE_TOLERANCE_CENTS", 0)),
+		Events:              events,
+		Users:               userspb.NewUserService("users", service.Client()),
+		Region:              region,
+		Carts:               cartspb.NewCartService("carts", service.Client()),
+	}
+	if err := pb.RegisterOrderServiceHandler(service.Server(), orders); err != nil {
 		logger.Fatalf("Failed to register order service handler: %v", err)
 	}
 
+	// Place the orders of subscriptions as they come due
+	scheduler := &handler.SubscriptionScheduler{
+		Orders:      orders,
+		Interval:    envDuration("SUBSCRIPTION_RUN_INTERVAL", time.Minute),
+		BatchSize:   envInt("SUBSCRIPTION_BATCH_SIZE", 100),
+		MaxFailures: envInt("SUBSCRIPTION_MAX_FAILURES", 3),
+	}
+	go func() {
+		defer close(schedulerDone)
+		scheduler.Run(schedulerCtx)
+	}()
+
 	// Register AdminService handler
-	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{EntClient: client}); err != nil {
+	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{
+		EntClient: client,
+		Events:    events,
+		Products:  products,
+		Region:    region,
+		// Keep exports, which stream the whole table, from overwhelming the database
+		Exports: &handler.ExportLimiter{
+			MaxConcurrent: envInt("EXPORT_MAX_CONCURRENT", 2),
+			Interval:      envDuration("EXPORT_CALLER_INTERVAL", time.Minute),
+		},
+	}); err != nil {
 		logger.Fatalf("Failed to register admin service handler: %v", err)
 	}
 
@@ -23,16 +23,21 @@ const (
 
 // OrderItem represents an item within an order
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
-	OrderId       string                 `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Deprecated: Marked as deprecated in proto/orders.proto.
+	UnitPrice        float64 `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Use unit_price_cents
+	CreatedAt        int64   `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // Unix timestamp
+	UpdatedAt        int64   `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`  // Unix timestamp
+	OrderId          string  `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductName      string  `protobuf:"bytes,8,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`                   // Product name snapshot, empty when not validated
+	UnitPriceCents   int64   `protobuf:"varint,9,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`       // Unit price in minor units (cents)
+	UnitPriceDecimal string  `protobuf:"bytes,10,opt,name=unit_price_decimal,json=unitPriceDecimal,proto3" json:"unit_price_decimal,omitempty"` // unit_price_cents rendered as a decimal string, e.g. "19.99"
+	Position         int32   `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`                                          // Zero-based place of the item in the order as it was submitted
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
@@ -65,84 +70,3083 @@ func (*OrderItem) Descriptor() ([]byte, []int) {
 	return file_proto_orders_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *OrderItem) GetId() string {
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/orders.proto.
+func (x *OrderItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OrderItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPriceDecimal() string {
+	if x != nil {
+		return x.UnitPriceDecimal
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// Order represents an order in the system
+type Order struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/orders.proto.
+	TotalAmount          float64              `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                              // Use total_amount_cents
+	Status               string               `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                                             // pending, processing, shipped, delivered, cancelled
+	CreatedAt            int64                `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                     // Unix timestamp
+	UpdatedAt            int64                `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                     // Unix timestamp
+	OrderItems           []*OrderItem         `protobuf:"bytes,7,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`                                   // Embedded order items
+	TotalAmountCents     int64                `protobuf:"varint,8,opt,name=total_amount_cents,json=totalAmountCents,proto3" json:"total_amount_cents,omitempty"`              // Total in minor units (cents)
+	TotalAmountDecimal   string               `protobuf:"bytes,9,opt,name=total_amount_decimal,json=totalAmountDecimal,proto3" json:"total_amount_decimal,omitempty"`         // total_amount_cents rendered as a decimal string, e.g. "19.99"
+	Currency             string               `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`                                                        // ISO 4217 code shared by all items
+	History              []*OrderStatusChange `protobuf:"bytes,11,rep,name=history,proto3" json:"history,omitempty"`                                                          // Status timeline, oldest first; set only by ExportOrders with include_history
+	DeletedAt            int64                `protobuf:"varint,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                                    // Unix timestamp, 0 unless the order is soft-deleted
+	ItemCount            int32                `protobuf:"varint,13,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`                                    // Total quantity across all items; set only by ListOrders with summary_only
+	DistinctProductCount int32                `protobuf:"varint,14,opt,name=distinct_product_count,json=distinctProductCount,proto3" json:"distinct_product_count,omitempty"` // Number of distinct products ordered; set only by ListOrders with summary_only
+	FraudHold            bool                 `protobuf:"varint,15,opt,name=fraud_hold,json=fraudHold,proto3" json:"fraud_hold,omitempty"`                                    // True while a risk review holds the order
+	HoldReason           string               `protobuf:"bytes,16,opt,name=hold_reason,json=holdReason,proto3" json:"hold_reason,omitempty"`                                  // Why the order is held, empty unless fraud_hold
+	Region               string               `protobuf:"bytes,17,opt,name=region,proto3" json:"region,omitempty"`                                                            // Region the order was created in, empty if unknown
+	ShippingAddress      *ShippingAddress     `protobuf:"bytes,18,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`                   // Unset for orders placed without one
+	DiscountCents        int64                `protobuf:"varint,19,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`                        // Coupon discount already taken off total_amount_cents
+	CouponCode           string               `protobuf:"bytes,20,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`                                  // Coupon the order was placed with, empty if none
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_proto_orders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/orders.proto.
+func (x *Order) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetOrderItems() []*OrderItem {
+	if x != nil {
+		return x.OrderItems
+	}
+	return nil
+}
+
+func (x *Order) GetTotalAmountCents() int64 {
+	if x != nil {
+		return x.TotalAmountCents
+	}
+	return 0
+}
+
+func (x *Order) GetTotalAmountDecimal() string {
+	if x != nil {
+		return x.TotalAmountDecimal
+	}
+	return ""
+}
+
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Order) GetHistory() []*OrderStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Order) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Order) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Order) GetDistinctProductCount() int32 {
+	if x != nil {
+		return x.DistinctProductCount
+	}
+	return 0
+}
+
+func (x *Order) GetFraudHold() bool {
+	if x != nil {
+		return x.FraudHold
+	}
+	return false
+}
+
+func (x *Order) GetHoldReason() string {
+	if x != nil {
+		return x.HoldReason
+	}
+	return ""
+}
+
+func (x *Order) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Order) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *Order) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *Order) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+// ShippingAddress is where an order is delivered
+type ShippingAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"` // Required
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`         // Required
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`                               // Required
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                           // State, province or county
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"` // Required
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`                         // Required ISO 3166-1 alpha-2 code, e.g. "KE"; stored upper-case
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingAddress) Reset() {
+	*x = ShippingAddress{}
+	mi := &file_proto_orders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingAddress) ProtoMessage() {}
+
+func (x *ShippingAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingAddress.ProtoReflect.Descriptor instead.
+func (*ShippingAddress) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShippingAddress) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// OrderStatusChange is one entry of an order's status history
+type OrderStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // Empty for the status the order was created with
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
+	mi := &file_proto_orders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChange) ProtoMessage() {}
+
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderStatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Request message for creating an order
+type CreateOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderItems      []*OrderItemRequest    `protobuf:"bytes,2,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`    // Optional; retries with the same key return the user's existing order
+	ShippingAddress *ShippingAddress       `protobuf:"bytes,4,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"` // Optional; can be changed with UpdateShippingAddress while pending
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_proto_orders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetOrderItems() []*OrderItemRequest {
+	if x != nil {
+		return x.OrderItems
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+// Request message for order items within CreateOrderRequest
+type OrderItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Deprecated: Marked as deprecated in proto/orders.proto.
+	UnitPrice      float64 `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Used only when unit_price_cents is unset
+	UnitPriceCents int64   `protobuf:"varint,4,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`
+	Currency       string  `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // Optional ISO 4217 code; the catalog's currency wins when products are validated
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderItemRequest) Reset() {
+	*x = OrderItemRequest{}
+	mi := &file_proto_orders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemRequest) ProtoMessage() {}
+
+func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemRequest.ProtoReflect.Descriptor instead.
+func (*OrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/orders.proto.
+func (x *OrderItemRequest) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItemRequest) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *OrderItemRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Response message for creating an order
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_proto_orders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for placing an order from a cart
+type CheckoutRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CartId          string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ShippingAddress *ShippingAddress       `protobuf:"bytes,2,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"` // Optional
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_proto_orders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckoutRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+func (x *CheckoutRequest) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+// Response message for placing an order from a cart
+type CheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutResponse) Reset() {
+	*x = CheckoutResponse{}
+	mi := &file_proto_orders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutResponse) ProtoMessage() {}
+
+func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckoutResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for getting an order by ID
+type GetOrderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Return the order even if soft-deleted; honored only by AdminService.GetOrder
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_proto_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response message for getting an order
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_proto_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for getting several orders at once
+type GetOrdersByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIDsRequest) Reset() {
+	*x = GetOrdersByIDsRequest{}
+	mi := &file_proto_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIDsRequest) ProtoMessage() {}
+
+func (x *GetOrdersByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrdersByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Response message for getting several orders
+type GetOrdersByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`                           // Found orders in the order their IDs were requested, each once
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // Requested IDs that are malformed or don't exist
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByIDsResponse) Reset() {
+	*x = GetOrdersByIDsResponse{}
+	mi := &file_proto_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByIDsResponse) ProtoMessage() {}
+
+func (x *GetOrdersByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetOrdersByIDsResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersByIDsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// Request message for updating an order status
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending, processing or cancelled; shipments set shipped and delivered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_proto_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateOrderStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Response message for updating an order status
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_proto_orders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateOrderStatusResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for cancelling an order
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_proto_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for cancelling an order
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_proto_orders_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for listing orders
+type ListOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // Optional filter by user_id
+	ActiveOnly     bool                   `protobuf:"varint,4,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`             // Only pending, processing and shipped orders
+	SortBy         string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                          // One of created_at, total_amount or status; defaults to created_at, newest first
+	SortDesc       bool                   `protobuf:"varint,6,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`                   // Sort in descending order; ignored when sort_by is empty
+	CreatedAfter   int64                  `protobuf:"varint,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`       // Optional Unix timestamp, inclusive
+	CreatedBefore  int64                  `protobuf:"varint,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`    // Optional Unix timestamp, inclusive
+	IncludeDeleted bool                   `protobuf:"varint,9,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted orders; honored only by AdminService.ListOrders
+	SummaryOnly    bool                   `protobuf:"varint,10,opt,name=summary_only,json=summaryOnly,proto3" json:"summary_only,omitempty"`         // Omit order_items and set item_count and distinct_product_count instead
+	Region         string                 `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`                                       // Optional filter by the region the order was created in
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_proto_orders_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetSummaryOnly() bool {
+	if x != nil {
+		return x.SummaryOnly
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// Response message for listing orders
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_proto_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for listing one user's orders
+type GetOrdersByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // Optional; pending, processing, shipped, delivered or cancelled
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserRequest) Reset() {
+	*x = GetOrdersByUserRequest{}
+	mi := &file_proto_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserRequest) ProtoMessage() {}
+
+func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrdersByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetOrdersByUserRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response message for listing one user's orders
+type GetOrdersByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"` // Newest first, with their items
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`  // Matching orders across all pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserResponse) Reset() {
+	*x = GetOrdersByUserResponse{}
+	mi := &file_proto_orders_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserResponse) ProtoMessage() {}
+
+func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetOrdersByUserResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersByUserResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for the products a user orders most often
+type GetFrequentlyOrderedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Optional; all ordered products when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrequentlyOrderedRequest) Reset() {
+	*x = GetFrequentlyOrderedRequest{}
+	mi := &file_proto_orders_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequentlyOrderedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequentlyOrderedRequest) ProtoMessage() {}
+
+func (x *GetFrequentlyOrderedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequentlyOrderedRequest.ProtoReflect.Descriptor instead.
+func (*GetFrequentlyOrderedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetFrequentlyOrderedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFrequentlyOrderedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FrequentlyOrderedProduct is a product with how often a user has ordered it
+type FrequentlyOrderedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OrderCount    int32                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`          // Non-cancelled orders containing the product
+	TotalQuantity int32                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // Units across those orders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequentlyOrderedProduct) Reset() {
+	*x = FrequentlyOrderedProduct{}
+	mi := &file_proto_orders_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequentlyOrderedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequentlyOrderedProduct) ProtoMessage() {}
+
+func (x *FrequentlyOrderedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequentlyOrderedProduct.ProtoReflect.Descriptor instead.
+func (*FrequentlyOrderedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FrequentlyOrderedProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FrequentlyOrderedProduct) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *FrequentlyOrderedProduct) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+// Response message for the products a user orders most often
+type GetFrequentlyOrderedResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Products      []*FrequentlyOrderedProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // Most frequent first, ties broken by quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrequentlyOrderedResponse) Reset() {
+	*x = GetFrequentlyOrderedResponse{}
+	mi := &file_proto_orders_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequentlyOrderedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequentlyOrderedResponse) ProtoMessage() {}
+
+func (x *GetFrequentlyOrderedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequentlyOrderedResponse.ProtoReflect.Descriptor instead.
+func (*GetFrequentlyOrderedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetFrequentlyOrderedResponse) GetProducts() []*FrequentlyOrderedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Request message for changing the shipping address of a pending order
+type UpdateShippingAddressRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderId         string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ShippingAddress *ShippingAddress       `protobuf:"bytes,2,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateShippingAddressRequest) Reset() {
+	*x = UpdateShippingAddressRequest{}
+	mi := &file_proto_orders_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingAddressRequest) ProtoMessage() {}
+
+func (x *UpdateShippingAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShippingAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateShippingAddressRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateShippingAddressRequest) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+// Response message for changing the shipping address of a pending order
+type UpdateShippingAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShippingAddressResponse) Reset() {
+	*x = UpdateShippingAddressResponse{}
+	mi := &file_proto_orders_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingAddressResponse) ProtoMessage() {}
+
+func (x *UpdateShippingAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShippingAddressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateShippingAddressResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for searching orders
+type SearchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	CreatedAfter  int64                  `protobuf:"varint,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Optional Unix timestamp, inclusive
+	CreatedBefore int64                  `protobuf:"varint,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Optional Unix timestamp, inclusive
+	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`                                       // Optional customer email, resolved to a user_id through the users service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersRequest) Reset() {
+	*x = SearchOrdersRequest{}
+	mi := &file_proto_orders_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersRequest) ProtoMessage() {}
+
+func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Response message for searching orders
+type SearchOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersResponse) Reset() {
+	*x = SearchOrdersResponse{}
+	mi := &file_proto_orders_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersResponse) ProtoMessage() {}
+
+func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SearchOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for force deleting an order (Admin operation)
+type ForceDeleteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hard          bool                   `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"` // Permanently delete the order and its items instead of soft-deleting it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteOrderRequest) Reset() {
+	*x = ForceDeleteOrderRequest{}
+	mi := &file_proto_orders_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteOrderRequest) ProtoMessage() {}
+
+func (x *ForceDeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ForceDeleteOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceDeleteOrderRequest) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+// Response message for force deleting an order
+type ForceDeleteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteOrderResponse) Reset() {
+	*x = ForceDeleteOrderResponse{}
+	mi := &file_proto_orders_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteOrderResponse) ProtoMessage() {}
+
+func (x *ForceDeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForceDeleteOrderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceDeleteOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message to restore a soft-deleted order (Admin operation)
+type RestoreOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreOrderRequest) Reset() {
+	*x = RestoreOrderRequest{}
+	mi := &file_proto_orders_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOrderRequest) ProtoMessage() {}
+
+func (x *RestoreOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOrderRequest.ProtoReflect.Descriptor instead.
+func (*RestoreOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestoreOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for restoring an order
+type RestoreOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreOrderResponse) Reset() {
+	*x = RestoreOrderResponse{}
+	mi := &file_proto_orders_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOrderResponse) ProtoMessage() {}
+
+func (x *RestoreOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOrderResponse.ProtoReflect.Descriptor instead.
+func (*RestoreOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for holding an order for fraud review (Admin operation)
+type PlaceFraudHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceFraudHoldRequest) Reset() {
+	*x = PlaceFraudHoldRequest{}
+	mi := &file_proto_orders_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceFraudHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceFraudHoldRequest) ProtoMessage() {}
+
+func (x *PlaceFraudHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceFraudHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceFraudHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlaceFraudHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlaceFraudHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response message after holding an order (Admin operation)
+type PlaceFraudHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceFraudHoldResponse) Reset() {
+	*x = PlaceFraudHoldResponse{}
+	mi := &file_proto_orders_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceFraudHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceFraudHoldResponse) ProtoMessage() {}
+
+func (x *PlaceFraudHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceFraudHoldResponse.ProtoReflect.Descriptor instead.
+func (*PlaceFraudHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlaceFraudHoldResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for releasing an order's fraud hold (Admin operation)
+type ReleaseFraudHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFraudHoldRequest) Reset() {
+	*x = ReleaseFraudHoldRequest{}
+	mi := &file_proto_orders_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFraudHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFraudHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseFraudHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFraudHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseFraudHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReleaseFraudHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message after releasing an order's fraud hold (Admin operation)
+type ReleaseFraudHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFraudHoldResponse) Reset() {
+	*x = ReleaseFraudHoldResponse{}
+	mi := &file_proto_orders_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFraudHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFraudHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseFraudHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFraudHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseFraudHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReleaseFraudHoldResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request message for bulk creating orders (Admin operation)
+type BulkCreateOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*CreateOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateOrdersRequest) Reset() {
+	*x = BulkCreateOrdersRequest{}
+	mi := &file_proto_orders_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateOrdersRequest) ProtoMessage() {}
+
+func (x *BulkCreateOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateOrdersRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkCreateOrdersRequest) GetOrders() []*CreateOrderRequest {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// Response message for bulk creating orders
+type BulkCreateOrdersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Orders        []*Order                  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Failures      []*BulkCreateOrderFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"` // Inputs that were not created, in stream order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateOrdersResponse) Reset() {
+	*x = BulkCreateOrdersResponse{}
+	mi := &file_proto_orders_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateOrdersResponse) ProtoMessage() {}
+
+func (x *BulkCreateOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateOrdersResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkCreateOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *BulkCreateOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkCreateOrdersResponse) GetFailures() []*BulkCreateOrderFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// BulkCreateOrderFailure is one input of BulkCreateOrders that could not be created
+type BulkCreateOrderFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                // Zero-based position of the input in the stream
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // As submitted
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateOrderFailure) Reset() {
+	*x = BulkCreateOrderFailure{}
+	mi := &file_proto_orders_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateOrderFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateOrderFailure) ProtoMessage() {}
+
+func (x *BulkCreateOrderFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateOrderFailure.ProtoReflect.Descriptor instead.
+func (*BulkCreateOrderFailure) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BulkCreateOrderFailure) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkCreateOrderFailure) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkCreateOrderFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Request message for exporting orders (Admin operation)
+type ExportOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,5,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"` // Nest each order's status history
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted orders
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_proto_orders_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersRequest) ProtoMessage() {}
+
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExportOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExportOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ExportOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportOrdersRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+func (x *ExportOrdersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Request message for verifying the amount a payment gateway is about to charge
+type VerifyOrderAmountRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Deprecated: Marked as deprecated in proto/orders.proto.
+	ExpectedAmount      float64 `protobuf:"fixed64,2,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"` // Used only when expected_amount_cents is unset
+	Currency            string  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                     // ISO 4217 code, e.g. "USD"
+	ExpectedAmountCents int64   `protobuf:"varint,4,opt,name=expected_amount_cents,json=expectedAmountCents,proto3" json:"expected_amount_cents,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VerifyOrderAmountRequest) Reset() {
+	*x = VerifyOrderAmountRequest{}
+	mi := &file_proto_orders_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOrderAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOrderAmountRequest) ProtoMessage() {}
+
+func (x *VerifyOrderAmountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOrderAmountRequest.ProtoReflect.Descriptor instead.
+func (*VerifyOrderAmountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyOrderAmountRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/orders.proto.
+func (x *VerifyOrderAmountRequest) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *VerifyOrderAmountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *VerifyOrderAmountRequest) GetExpectedAmountCents() int64 {
+	if x != nil {
+		return x.ExpectedAmountCents
+	}
+	return 0
+}
+
+// Response message for verifying an order amount
+type VerifyOrderAmountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Match bool                   `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Deprecated: Marked as deprecated in proto/orders.proto.
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                             // Use amount_cents
+	Currency      string  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                           // Authoritative currency of the order
+	AmountCents   int64   `protobuf:"varint,4,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"` // Authoritative amount stored on the order, in minor units
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOrderAmountResponse) Reset() {
+	*x = VerifyOrderAmountResponse{}
+	mi := &file_proto_orders_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOrderAmountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOrderAmountResponse) ProtoMessage() {}
+
+func (x *VerifyOrderAmountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOrderAmountResponse.ProtoReflect.Descriptor instead.
+func (*VerifyOrderAmountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VerifyOrderAmountResponse) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in proto/orders.proto.
+func (x *VerifyOrderAmountResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *VerifyOrderAmountResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *VerifyOrderAmountResponse) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+// Shipment is a parcel carrying some or all of an order's items
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	ShippedAt      int64                  `protobuf:"varint,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`       // Unix timestamp
+	DeliveredAt    int64                  `protobuf:"varint,6,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"` // Unix timestamp, zero until delivered
+	Items          []*ShipmentItem        `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_proto_orders_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetShippedAt() int64 {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return 0
+}
+
+func (x *Shipment) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *Shipment) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ShipmentItem is the quantity of one order item carried by a shipment
+type ShipmentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
+	mi := &file_proto_orders_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentItem) ProtoMessage() {}
+
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ShipmentItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ShipmentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Request message for recording a shipment of an order
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Items          []*ShipmentItem        `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_proto_orders_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Response message for recording a shipment
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	OrderStatus   string                 `protobuf:"bytes,2,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"` // Order status derived from shipment coverage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	mi := &file_proto_orders_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+func (x *CreateShipmentResponse) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+// Request message for listing an order's shipments
+type ListShipmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsRequest) Reset() {
+	*x = ListShipmentsRequest{}
+	mi := &file_proto_orders_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsRequest) ProtoMessage() {}
+
+func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListShipmentsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Response message for listing an order's shipments
+type ListShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsResponse) Reset() {
+	*x = ListShipmentsResponse{}
+	mi := &file_proto_orders_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsResponse) ProtoMessage() {}
+
+func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListShipmentsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+// Request message for marking a shipment delivered
+type MarkShipmentDeliveredRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkShipmentDeliveredRequest) Reset() {
+	*x = MarkShipmentDeliveredRequest{}
+	mi := &file_proto_orders_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkShipmentDeliveredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkShipmentDeliveredRequest) ProtoMessage() {}
+
+func (x *MarkShipmentDeliveredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkShipmentDeliveredRequest.ProtoReflect.Descriptor instead.
+func (*MarkShipmentDeliveredRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MarkShipmentDeliveredRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *OrderItem) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+// Response message for marking a shipment delivered
+type MarkShipmentDeliveredResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	OrderStatus   string                 `protobuf:"bytes,2,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"` // Order status derived from shipment coverage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
+func (x *MarkShipmentDeliveredResponse) Reset() {
+	*x = MarkShipmentDeliveredResponse{}
+	mi := &file_proto_orders_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) GetUnitPrice() float64 {
-	if x != nil {
-		return x.UnitPrice
-	}
-	return 0
+func (x *MarkShipmentDeliveredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrderItem) GetCreatedAt() int64 {
+func (*MarkShipmentDeliveredResponse) ProtoMessage() {}
+
+func (x *MarkShipmentDeliveredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[49]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OrderItem) GetUpdatedAt() int64 {
+// Deprecated: Use MarkShipmentDeliveredResponse.ProtoReflect.Descriptor instead.
+func (*MarkShipmentDeliveredResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MarkShipmentDeliveredResponse) GetShipment() *Shipment {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Shipment
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetOrderId() string {
+func (x *MarkShipmentDeliveredResponse) GetOrderStatus() string {
 	if x != nil {
-		return x.OrderId
+		return x.OrderStatus
 	}
 	return ""
 }
 
-// Order represents an order in the system
-type Order struct {
+// Subscription places a recurring order from its items every interval
+type Subscription struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // pending, processing, shipped, delivered, cancelled
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // Unix timestamp
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`   // Unix timestamp
-	OrderItems    []*OrderItem           `protobuf:"bytes,7,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"` // Embedded order items
+	Items         []*OrderItemRequest    `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Interval      string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`                                 // daily, weekly or monthly
+	NextRunAt     int64                  `protobuf:"varint,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`           // Unix timestamp of the next order
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                    // False while paused or once cancelled
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`             // Unix timestamp
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`             // Unix timestamp
+	CancelledAt   int64                  `protobuf:"varint,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`       // Unix timestamp, 0 unless cancelled
+	FailureCount  int32                  `protobuf:"varint,10,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`   // Consecutive runs whose order could not be placed
+	LastFailure   string                 `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`       // Why the latest failed run's order could not be placed
+	LastFailedAt  int64                  `protobuf:"varint,12,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"` // Unix timestamp, 0 unless a run has failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_proto_orders_proto_msgTypes[1]
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_orders_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *Subscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*Subscription) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[1]
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,138 +3157,121 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Order) GetId() string {
+func (x *Subscription) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Order) GetUserId() string {
+func (x *Subscription) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Order) GetTotalAmount() float64 {
+func (x *Subscription) GetItems() []*OrderItemRequest {
 	if x != nil {
-		return x.TotalAmount
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetStatus() string {
+func (x *Subscription) GetInterval() string {
 	if x != nil {
-		return x.Status
+		return x.Interval
 	}
 	return ""
 }
 
-func (x *Order) GetCreatedAt() int64 {
+func (x *Subscription) GetNextRunAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.NextRunAt
 	}
 	return 0
 }
 
-func (x *Order) GetUpdatedAt() int64 {
+func (x *Subscription) GetActive() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Active
 	}
-	return 0
+	return false
 }
 
-func (x *Order) GetOrderItems() []*OrderItem {
+func (x *Subscription) GetCreatedAt() int64 {
 	if x != nil {
-		return x.OrderItems
+		return x.CreatedAt
 	}
-	return nil
-}
-
-// Request message for creating an order
-type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrderItems    []*OrderItemRequest    `protobuf:"bytes,2,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_proto_orders_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *CreateOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Subscription) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
-
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[2]
+func (x *Subscription) GetCancelledAt() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CancelledAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{2}
+func (x *Subscription) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
 }
 
-func (x *CreateOrderRequest) GetUserId() string {
+func (x *Subscription) GetLastFailure() string {
 	if x != nil {
-		return x.UserId
+		return x.LastFailure
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetOrderItems() []*OrderItemRequest {
+func (x *Subscription) GetLastFailedAt() int64 {
 	if x != nil {
-		return x.OrderItems
+		return x.LastFailedAt
 	}
-	return nil
+	return 0
 }
 
-// Request message for order items within CreateOrderRequest
-type OrderItemRequest struct {
+// Request message for creating a subscription
+type CreateSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*OrderItemRequest    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Interval      string                 `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`               // daily, weekly or monthly
+	StartAt       int64                  `protobuf:"varint,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // Optional Unix timestamp of the first order; defaults to one interval from now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItemRequest) Reset() {
-	*x = OrderItemRequest{}
-	mi := &file_proto_orders_proto_msgTypes[3]
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_proto_orders_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemRequest) String() string {
+func (x *CreateSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItemRequest) ProtoMessage() {}
+func (*CreateSubscriptionRequest) ProtoMessage() {}
 
-func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[3]
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,55 +3282,62 @@ func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItemRequest.ProtoReflect.Descriptor instead.
-func (*OrderItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *OrderItemRequest) GetProductId() string {
+func (x *CreateSubscriptionRequest) GetUserId() string {
 	if x != nil {
-		return x.ProductId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderItemRequest) GetQuantity() int32 {
+func (x *CreateSubscriptionRequest) GetItems() []*OrderItemRequest {
 	if x != nil {
-		return x.Quantity
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItemRequest) GetUnitPrice() float64 {
+func (x *CreateSubscriptionRequest) GetInterval() string {
 	if x != nil {
-		return x.UnitPrice
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
 	}
 	return 0
 }
 
-// Response message for creating an order
-type CreateOrderResponse struct {
+// Response message for creating a subscription
+type CreateSubscriptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
-	mi := &file_proto_orders_proto_msgTypes[4]
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	mi := &file_proto_orders_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *CreateSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*CreateSubscriptionResponse) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[4]
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,41 +3348,41 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateOrderResponse) GetOrder() *Order {
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
 	if x != nil {
-		return x.Order
+		return x.Subscription
 	}
 	return nil
 }
 
-// Request message for getting an order by ID
-type GetOrderRequest struct {
+// Request message for pausing a subscription
+type PauseSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_proto_orders_proto_msgTypes[5]
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_proto_orders_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *PauseSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*PauseSubscriptionRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[5]
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,41 +3393,41 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetOrderRequest) GetId() string {
+func (x *PauseSubscriptionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Response message for getting an order
-type GetOrderResponse struct {
+// Response message for pausing a subscription
+type PauseSubscriptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_proto_orders_proto_msgTypes[6]
+func (x *PauseSubscriptionResponse) Reset() {
+	*x = PauseSubscriptionResponse{}
+	mi := &file_proto_orders_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *PauseSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*PauseSubscriptionResponse) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[6]
+func (x *PauseSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,42 +3438,41 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PauseSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *PauseSubscriptionResponse) GetSubscription() *Subscription {
 	if x != nil {
-		return x.Order
+		return x.Subscription
 	}
 	return nil
 }
 
-// Request message for updating an order status
-type UpdateOrderStatusRequest struct {
+// Request message for resuming a paused subscription
+type ResumeSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending, processing, shipped, delivered, cancelled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_proto_orders_proto_msgTypes[7]
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_proto_orders_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *ResumeSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[7]
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,48 +3483,41 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateOrderStatusRequest) GetId() string {
+func (x *ResumeSubscriptionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-// Response message for updating an order status
-type UpdateOrderStatusResponse struct {
+// Response message for resuming a paused subscription
+type ResumeSubscriptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_proto_orders_proto_msgTypes[8]
+func (x *ResumeSubscriptionResponse) Reset() {
+	*x = ResumeSubscriptionResponse{}
+	mi := &file_proto_orders_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *ResumeSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*ResumeSubscriptionResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[8]
+func (x *ResumeSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,43 +3528,41 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ResumeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UpdateOrderStatusResponse) GetOrder() *Order {
+func (x *ResumeSubscriptionResponse) GetSubscription() *Subscription {
 	if x != nil {
-		return x.Order
+		return x.Subscription
 	}
 	return nil
 }
 
-// Request message for listing orders
-type ListOrdersRequest struct {
+// Request message for cancelling a subscription
+type CancelSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional filter by user_id
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_proto_orders_proto_msgTypes[9]
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_proto_orders_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *CancelSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*CancelSubscriptionRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[9]
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,56 +3573,41 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListOrdersRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListOrdersRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListOrdersRequest) GetUserId() string {
+func (x *CancelSubscriptionRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
-
-// Response message for listing orders
-type ListOrdersResponse struct {
+
+// Response message for cancelling a subscription
+type CancelSubscriptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_proto_orders_proto_msgTypes[10]
+func (x *CancelSubscriptionResponse) Reset() {
+	*x = CancelSubscriptionResponse{}
+	mi := &file_proto_orders_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *CancelSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*CancelSubscriptionResponse) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[10]
+func (x *CancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,51 +3618,44 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *CancelSubscriptionResponse) GetSubscription() *Subscription {
 	if x != nil {
-		return x.Orders
+		return x.Subscription
 	}
 	return nil
 }
 
-func (x *ListOrdersResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Request message for searching orders
-type SearchOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// OrderItemViolation identifies an order item CreateOrder rejected
+type OrderItemViolation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason            string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                                   // not_found or price_mismatch; Checkout also reports inactive and insufficient_stock
+	UnitPriceCents    int64                  `protobuf:"varint,3,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`          // Price sent in the request
+	CatalogPriceCents int64                  `protobuf:"varint,4,opt,name=catalog_price_cents,json=catalogPriceCents,proto3" json:"catalog_price_cents,omitempty"` // Current catalog unit price for the item's quantity, tier applied, set for price_mismatch
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SearchOrdersRequest) Reset() {
-	*x = SearchOrdersRequest{}
-	mi := &file_proto_orders_proto_msgTypes[11]
+func (x *OrderItemViolation) Reset() {
+	*x = OrderItemViolation{}
+	mi := &file_proto_orders_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchOrdersRequest) String() string {
+func (x *OrderItemViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchOrdersRequest) ProtoMessage() {}
+func (*OrderItemViolation) ProtoMessage() {}
 
-func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[11]
+func (x *OrderItemViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,63 +3666,62 @@ func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
-func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use OrderItemViolation.ProtoReflect.Descriptor instead.
+func (*OrderItemViolation) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SearchOrdersRequest) GetUserId() string {
+func (x *OrderItemViolation) GetProductId() string {
 	if x != nil {
-		return x.UserId
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *SearchOrdersRequest) GetStatus() string {
+func (x *OrderItemViolation) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *SearchOrdersRequest) GetLimit() int32 {
+func (x *OrderItemViolation) GetUnitPriceCents() int64 {
 	if x != nil {
-		return x.Limit
+		return x.UnitPriceCents
 	}
 	return 0
 }
 
-func (x *SearchOrdersRequest) GetOffset() int32 {
+func (x *OrderItemViolation) GetCatalogPriceCents() int64 {
 	if x != nil {
-		return x.Offset
+		return x.CatalogPriceCents
 	}
 	return 0
 }
 
-// Response message for searching orders
-type SearchOrdersResponse struct {
+// Request message for checking proposed order items without placing an order
+type ValidateOrderItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Items         []*OrderItemRequest    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchOrdersResponse) Reset() {
-	*x = SearchOrdersResponse{}
-	mi := &file_proto_orders_proto_msgTypes[12]
+func (x *ValidateOrderItemsRequest) Reset() {
+	*x = ValidateOrderItemsRequest{}
+	mi := &file_proto_orders_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchOrdersResponse) String() string {
+func (x *ValidateOrderItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchOrdersResponse) ProtoMessage() {}
+func (*ValidateOrderItemsRequest) ProtoMessage() {}
 
-func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[12]
+func (x *ValidateOrderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,48 +3732,47 @@ func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchOrdersResponse.ProtoReflect.Descriptor instead.
-func (*SearchOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ValidateOrderItemsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateOrderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SearchOrdersResponse) GetOrders() []*Order {
+func (x *ValidateOrderItemsRequest) GetItems() []*OrderItemRequest {
 	if x != nil {
-		return x.Orders
+		return x.Items
 	}
 	return nil
 }
 
-func (x *SearchOrdersResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Request message for force deleting an order (Admin operation)
-type ForceDeleteOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// OrderItemValidation is the outcome of checking one proposed order item
+type OrderItemValidation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Index             int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Zero-based position in the request
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Valid             bool                   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                                   // First failed check: invalid_quantity, invalid_price, invalid_product_id, not_found, price_mismatch or insufficient_stock
+	UnitPriceCents    int64                  `protobuf:"varint,5,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`          // Price sent in the request
+	CatalogPriceCents int64                  `protobuf:"varint,6,opt,name=catalog_price_cents,json=catalogPriceCents,proto3" json:"catalog_price_cents,omitempty"` // Current catalog unit price for the item's quantity, tier applied, set when the product was found
+	AvailableStock    int32                  `protobuf:"varint,7,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`            // Current catalog stock, set when the product was found
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ForceDeleteOrderRequest) Reset() {
-	*x = ForceDeleteOrderRequest{}
-	mi := &file_proto_orders_proto_msgTypes[13]
+func (x *OrderItemValidation) Reset() {
+	*x = OrderItemValidation{}
+	mi := &file_proto_orders_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteOrderRequest) String() string {
+func (x *OrderItemValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteOrderRequest) ProtoMessage() {}
+func (*OrderItemValidation) ProtoMessage() {}
 
-func (x *ForceDeleteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[13]
+func (x *OrderItemValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,42 +3783,86 @@ func (x *ForceDeleteOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteOrderRequest.ProtoReflect.Descriptor instead.
-func (*ForceDeleteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use OrderItemValidation.ProtoReflect.Descriptor instead.
+func (*OrderItemValidation) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ForceDeleteOrderRequest) GetId() string {
+func (x *OrderItemValidation) GetIndex() int32 {
 	if x != nil {
-		return x.Id
+		return x.Index
+	}
+	return 0
+}
+
+func (x *OrderItemValidation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
 	}
 	return ""
 }
 
-// Response message for force deleting an order
-type ForceDeleteOrderResponse struct {
+func (x *OrderItemValidation) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *OrderItemValidation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderItemValidation) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *OrderItemValidation) GetCatalogPriceCents() int64 {
+	if x != nil {
+		return x.CatalogPriceCents
+	}
+	return 0
+}
+
+func (x *OrderItemValidation) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+// Response message for checking proposed order items
+type ValidateOrderItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`      // Whether CreateOrder would accept the items, stock permitting at the time
+	Items         []*OrderItemValidation `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`       // One per requested item, in request order
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the order would be placed in, empty when error is set
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`       // Problem with the item list as a whole, e.g. no items or mixed currencies
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteOrderResponse) Reset() {
-	*x = ForceDeleteOrderResponse{}
-	mi := &file_proto_orders_proto_msgTypes[14]
+func (x *ValidateOrderItemsResponse) Reset() {
+	*x = ValidateOrderItemsResponse{}
+	mi := &file_proto_orders_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteOrderResponse) String() string {
+func (x *ValidateOrderItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteOrderResponse) ProtoMessage() {}
+func (*ValidateOrderItemsResponse) ProtoMessage() {}
 
-func (x *ForceDeleteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[14]
+func (x *ValidateOrderItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,48 +3873,63 @@ func (x *ForceDeleteOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteOrderResponse.ProtoReflect.Descriptor instead.
-func (*ForceDeleteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ValidateOrderItemsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateOrderItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ForceDeleteOrderResponse) GetId() string {
+func (x *ValidateOrderItemsResponse) GetValid() bool {
 	if x != nil {
-		return x.Id
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateOrderItemsResponse) GetItems() []*OrderItemValidation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ValidateOrderItemsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ForceDeleteOrderResponse) GetSuccess() bool {
+func (x *ValidateOrderItemsResponse) GetError() string {
 	if x != nil {
-		return x.Success
+		return x.Error
 	}
-	return false
+	return ""
 }
 
-// Request message for bulk creating orders (Admin operation)
-type BulkCreateOrdersRequest struct {
+// OrderValidationError is carried as JSON in the detail of the BadRequest error
+// CreateOrder returns when order items fail validation
+type OrderValidationError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*CreateOrderRequest  `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Violations    []*OrderItemViolation  `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateOrdersRequest) Reset() {
-	*x = BulkCreateOrdersRequest{}
-	mi := &file_proto_orders_proto_msgTypes[15]
+func (x *OrderValidationError) Reset() {
+	*x = OrderValidationError{}
+	mi := &file_proto_orders_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateOrdersRequest) String() string {
+func (x *OrderValidationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateOrdersRequest) ProtoMessage() {}
+func (*OrderValidationError) ProtoMessage() {}
 
-func (x *BulkCreateOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[15]
+func (x *OrderValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,42 +3940,44 @@ func (x *BulkCreateOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateOrdersRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use OrderValidationError.ProtoReflect.Descriptor instead.
+func (*OrderValidationError) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BulkCreateOrdersRequest) GetOrders() []*CreateOrderRequest {
+func (x *OrderValidationError) GetViolations() []*OrderItemViolation {
 	if x != nil {
-		return x.Orders
+		return x.Violations
 	}
 	return nil
 }
 
-// Response message for bulk creating orders
-type BulkCreateOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+// OrderCreatedEvent is published on the "orders.created" topic after an order is committed
+type OrderCreatedEvent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	OrderId       string                   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*OrderCreatedEventItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     int64                    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateOrdersResponse) Reset() {
-	*x = BulkCreateOrdersResponse{}
-	mi := &file_proto_orders_proto_msgTypes[16]
+func (x *OrderCreatedEvent) Reset() {
+	*x = OrderCreatedEvent{}
+	mi := &file_proto_orders_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateOrdersResponse) String() string {
+func (x *OrderCreatedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateOrdersResponse) ProtoMessage() {}
+func (*OrderCreatedEvent) ProtoMessage() {}
 
-func (x *BulkCreateOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[16]
+func (x *OrderCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,51 +3988,63 @@ func (x *BulkCreateOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateOrdersResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use OrderCreatedEvent.ProtoReflect.Descriptor instead.
+func (*OrderCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *BulkCreateOrdersResponse) GetOrders() []*Order {
+func (x *OrderCreatedEvent) GetOrderId() string {
 	if x != nil {
-		return x.Orders
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetItems() []*OrderCreatedEventItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-func (x *BulkCreateOrdersResponse) GetTotal() int32 {
+func (x *OrderCreatedEvent) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Total
+		return x.CreatedAt
 	}
 	return 0
 }
 
-// Request message for exporting orders (Admin operation)
-type ExportOrdersRequest struct {
+// OrderCreatedEventItem is a line item of an OrderCreatedEvent
+type OrderCreatedEventItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportOrdersRequest) Reset() {
-	*x = ExportOrdersRequest{}
-	mi := &file_proto_orders_proto_msgTypes[17]
+func (x *OrderCreatedEventItem) Reset() {
+	*x = OrderCreatedEventItem{}
+	mi := &file_proto_orders_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportOrdersRequest) String() string {
+func (x *OrderCreatedEventItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOrdersRequest) ProtoMessage() {}
+func (*OrderCreatedEventItem) ProtoMessage() {}
 
-func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_orders_proto_msgTypes[17]
+func (x *OrderCreatedEventItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_orders_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,129 +4055,364 @@ func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_orders_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ExportOrdersRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ExportOrdersRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use OrderCreatedEventItem.ProtoReflect.Descriptor instead.
+func (*OrderCreatedEventItem) Descriptor() ([]byte, []int) {
+	return file_proto_orders_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ExportOrdersRequest) GetUserId() string {
+func (x *OrderCreatedEventItem) GetProductId() string {
 	if x != nil {
-		return x.UserId
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ExportOrdersRequest) GetStatus() string {
+func (x *OrderCreatedEventItem) GetQuantity() int32 {
 	if x != nil {
-		return x.Status
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
 var File_proto_orders_proto protoreflect.FileDescriptor
 
 const file_proto_orders_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/orders.proto\x12\x06orders\"\xce\x01\n" +
+	"\x12proto/orders.proto\x12\x06orders\"\xe9\x02\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12!\n" +
 	"\n" +
-	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12\x1d\n" +
+	"unit_price\x18\x04 \x01(\x01B\x02\x18\x01R\tunitPrice\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12\x19\n" +
-	"\border_id\x18\a \x01(\tR\aorderId\"\xdd\x01\n" +
+	"\border_id\x18\a \x01(\tR\aorderId\x12!\n" +
+	"\fproduct_name\x18\b \x01(\tR\vproductName\x12(\n" +
+	"\x10unit_price_cents\x18\t \x01(\x03R\x0eunitPriceCents\x12,\n" +
+	"\x12unit_price_decimal\x18\n" +
+	" \x01(\tR\x10unitPriceDecimal\x12\x1a\n" +
+	"\bposition\x18\v \x01(\x05R\bposition\"\xea\x05\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
-	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12\x16\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\ftotal_amount\x18\x03 \x01(\x01B\x02\x18\x01R\vtotalAmount\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x122\n" +
 	"\vorder_items\x18\a \x03(\v2\x11.orders.OrderItemR\n" +
-	"orderItems\"h\n" +
+	"orderItems\x12,\n" +
+	"\x12total_amount_cents\x18\b \x01(\x03R\x10totalAmountCents\x120\n" +
+	"\x14total_amount_decimal\x18\t \x01(\tR\x12totalAmountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x123\n" +
+	"\ahistory\x18\v \x03(\v2\x19.orders.OrderStatusChangeR\ahistory\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\x03R\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\r \x01(\x05R\titemCount\x124\n" +
+	"\x16distinct_product_count\x18\x0e \x01(\x05R\x14distinctProductCount\x12\x1d\n" +
+	"\n" +
+	"fraud_hold\x18\x0f \x01(\bR\tfraudHold\x12\x1f\n" +
+	"\vhold_reason\x18\x10 \x01(\tR\n" +
+	"holdReason\x12\x16\n" +
+	"\x06region\x18\x11 \x01(\tR\x06region\x12B\n" +
+	"\x10shipping_address\x18\x12 \x01(\v2\x17.orders.ShippingAddressR\x0fshippingAddress\x12%\n" +
+	"\x0ediscount_cents\x18\x13 \x01(\x03R\rdiscountCents\x12\x1f\n" +
+	"\vcoupon_code\x18\x14 \x01(\tR\n" +
+	"couponCode\"\xc2\x01\n" +
+	"\x0fShippingAddress\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\"p\n" +
+	"\x11OrderStatusChange\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"\xd5\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\vorder_items\x18\x02 \x03(\v2\x18.orders.OrderItemRequestR\n" +
-	"orderItems\"l\n" +
+	"orderItems\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12B\n" +
+	"\x10shipping_address\x18\x04 \x01(\v2\x17.orders.ShippingAddressR\x0fshippingAddress\"\xb6\x01\n" +
 	"\x10OrderItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12!\n" +
 	"\n" +
-	"unit_price\x18\x03 \x01(\x01R\tunitPrice\":\n" +
+	"unit_price\x18\x03 \x01(\x01B\x02\x18\x01R\tunitPrice\x12(\n" +
+	"\x10unit_price_cents\x18\x04 \x01(\x03R\x0eunitPriceCents\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\":\n" +
 	"\x13CreateOrderResponse\x12#\n" +
-	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"!\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"n\n" +
+	"\x0fCheckoutRequest\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12B\n" +
+	"\x10shipping_address\x18\x02 \x01(\v2\x17.orders.ShippingAddressR\x0fshippingAddress\"7\n" +
+	"\x10CheckoutResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"J\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"7\n" +
 	"\x10GetOrderResponse\x12#\n" +
-	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"B\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\")\n" +
+	"\x15GetOrdersByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"`\n" +
+	"\x16GetOrdersByIDsResponse\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"B\n" +
 	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"@\n" +
 	"\x19UpdateOrderStatusResponse\x12#\n" +
-	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"Z\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"$\n" +
+	"\x12CancelOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x13CancelOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"\xe1\x02\n" +
 	"\x11ListOrdersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"Q\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vactive_only\x18\x04 \x01(\bR\n" +
+	"activeOnly\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\x06 \x01(\bR\bsortDesc\x12#\n" +
+	"\rcreated_after\x18\a \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\b \x01(\x03R\rcreatedBefore\x12'\n" +
+	"\x0finclude_deleted\x18\t \x01(\bR\x0eincludeDeleted\x12!\n" +
+	"\fsummary_only\x18\n" +
+	" \x01(\bR\vsummaryOnly\x12\x16\n" +
+	"\x06region\x18\v \x01(\tR\x06region\"Q\n" +
 	"\x12ListOrdersResponse\x12%\n" +
 	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"t\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"w\n" +
+	"\x16GetOrdersByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"V\n" +
+	"\x17GetOrdersByUserResponse\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"L\n" +
+	"\x1bGetFrequentlyOrderedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x81\x01\n" +
+	"\x18FrequentlyOrderedProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vorder_count\x18\x02 \x01(\x05R\n" +
+	"orderCount\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\"\\\n" +
+	"\x1cGetFrequentlyOrderedResponse\x12<\n" +
+	"\bproducts\x18\x01 \x03(\v2 .orders.FrequentlyOrderedProductR\bproducts\"}\n" +
+	"\x1cUpdateShippingAddressRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12B\n" +
+	"\x10shipping_address\x18\x02 \x01(\v2\x17.orders.ShippingAddressR\x0fshippingAddress\"D\n" +
+	"\x1dUpdateShippingAddressResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"\xd6\x01\n" +
 	"\x13SearchOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"S\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12#\n" +
+	"\rcreated_after\x18\x05 \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x06 \x01(\x03R\rcreatedBefore\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\"S\n" +
 	"\x14SearchOrdersResponse\x12%\n" +
 	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\")\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"=\n" +
 	"\x17ForceDeleteOrderRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04hard\x18\x02 \x01(\bR\x04hard\"D\n" +
 	"\x18ForceDeleteOrderResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"M\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"%\n" +
+	"\x13RestoreOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x14RestoreOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"?\n" +
+	"\x15PlaceFraudHoldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"=\n" +
+	"\x16PlaceFraudHoldResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\")\n" +
+	"\x17ReleaseFraudHoldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x18ReleaseFraudHoldResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.orders.OrderR\x05order\"M\n" +
 	"\x17BulkCreateOrdersRequest\x122\n" +
-	"\x06orders\x18\x01 \x03(\v2\x1a.orders.CreateOrderRequestR\x06orders\"W\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1a.orders.CreateOrderRequestR\x06orders\"\x93\x01\n" +
 	"\x18BulkCreateOrdersResponse\x12%\n" +
 	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"t\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12:\n" +
+	"\bfailures\x18\x03 \x03(\v2\x1e.orders.BulkCreateOrderFailureR\bfailures\"_\n" +
+	"\x16BulkCreateOrderFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc6\x01\n" +
 	"\x13ExportOrdersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status2\x89\x03\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0finclude_history\x18\x05 \x01(\bR\x0eincludeHistory\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"\xb2\x01\n" +
+	"\x18VerifyOrderAmountRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12+\n" +
+	"\x0fexpected_amount\x18\x02 \x01(\x01B\x02\x18\x01R\x0eexpectedAmount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x122\n" +
+	"\x15expected_amount_cents\x18\x04 \x01(\x03R\x13expectedAmountCents\"\x8c\x01\n" +
+	"\x19VerifyOrderAmountResponse\x12\x14\n" +
+	"\x05match\x18\x01 \x01(\bR\x05match\x12\x1a\n" +
+	"\x06amount\x18\x02 \x01(\x01B\x02\x18\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\famount_cents\x18\x04 \x01(\x03R\vamountCents\"\xe6\x01\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x12\x1d\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\x03R\tshippedAt\x12!\n" +
+	"\fdelivered_at\x18\x06 \x01(\x03R\vdeliveredAt\x12*\n" +
+	"\x05items\x18\a \x03(\v2\x14.orders.ShipmentItemR\x05items\"N\n" +
+	"\fShipmentItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xa1\x01\n" +
+	"\x15CreateShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12*\n" +
+	"\x05items\x18\x04 \x03(\v2\x14.orders.ShipmentItemR\x05items\"i\n" +
+	"\x16CreateShipmentResponse\x12,\n" +
+	"\bshipment\x18\x01 \x01(\v2\x10.orders.ShipmentR\bshipment\x12!\n" +
+	"\forder_status\x18\x02 \x01(\tR\vorderStatus\"1\n" +
+	"\x14ListShipmentsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"G\n" +
+	"\x15ListShipmentsResponse\x12.\n" +
+	"\tshipments\x18\x01 \x03(\v2\x10.orders.ShipmentR\tshipments\".\n" +
+	"\x1cMarkShipmentDeliveredRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
+	"\x1dMarkShipmentDeliveredResponse\x12,\n" +
+	"\bshipment\x18\x01 \x01(\v2\x10.orders.ShipmentR\bshipment\x12!\n" +
+	"\forder_status\x18\x02 \x01(\tR\vorderStatus\"\x8a\x03\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x05items\x18\x03 \x03(\v2\x18.orders.OrderItemRequestR\x05items\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\x12\x1e\n" +
+	"\vnext_run_at\x18\x05 \x01(\x03R\tnextRunAt\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\x12!\n" +
+	"\fcancelled_at\x18\t \x01(\x03R\vcancelledAt\x12#\n" +
+	"\rfailure_count\x18\n" +
+	" \x01(\x05R\ffailureCount\x12!\n" +
+	"\flast_failure\x18\v \x01(\tR\vlastFailure\x12$\n" +
+	"\x0elast_failed_at\x18\f \x01(\x03R\flastFailedAt\"\x9b\x01\n" +
+	"\x19CreateSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.orders.OrderItemRequestR\x05items\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12\x19\n" +
+	"\bstart_at\x18\x04 \x01(\x03R\astartAt\"V\n" +
+	"\x1aCreateSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.orders.SubscriptionR\fsubscription\"*\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x19PauseSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.orders.SubscriptionR\fsubscription\"+\n" +
+	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x1aResumeSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.orders.SubscriptionR\fsubscription\"+\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x1aCancelSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.orders.SubscriptionR\fsubscription\"\xa5\x01\n" +
+	"\x12OrderItemViolation\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12(\n" +
+	"\x10unit_price_cents\x18\x03 \x01(\x03R\x0eunitPriceCents\x12.\n" +
+	"\x13catalog_price_cents\x18\x04 \x01(\x03R\x11catalogPriceCents\"K\n" +
+	"\x19ValidateOrderItemsRequest\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.orders.OrderItemRequestR\x05items\"\xfb\x01\n" +
+	"\x13OrderItemValidation\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12(\n" +
+	"\x10unit_price_cents\x18\x05 \x01(\x03R\x0eunitPriceCents\x12.\n" +
+	"\x13catalog_price_cents\x18\x06 \x01(\x03R\x11catalogPriceCents\x12'\n" +
+	"\x0favailable_stock\x18\a \x01(\x05R\x0eavailableStock\"\x97\x01\n" +
+	"\x1aValidateOrderItemsResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x121\n" +
+	"\x05items\x18\x02 \x03(\v2\x1b.orders.OrderItemValidationR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"R\n" +
+	"\x14OrderValidationError\x12:\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2\x1a.orders.OrderItemViolationR\n" +
+	"violations\"\x9b\x01\n" +
+	"\x11OrderCreatedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.orders.OrderCreatedEventItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"R\n" +
+	"\x15OrderCreatedEventItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity2\xc9\r\n" +
 	"\fOrderService\x12H\n" +
 	"\vCreateOrder\x12\x1a.orders.CreateOrderRequest\x1a\x1b.orders.CreateOrderResponse\"\x00\x12?\n" +
-	"\bGetOrder\x12\x17.orders.GetOrderRequest\x1a\x18.orders.GetOrderResponse\"\x00\x12Z\n" +
-	"\x11UpdateOrderStatus\x12 .orders.UpdateOrderStatusRequest\x1a!.orders.UpdateOrderStatusResponse\"\x00\x12E\n" +
+	"\bCheckout\x12\x17.orders.CheckoutRequest\x1a\x18.orders.CheckoutResponse\"\x00\x12?\n" +
+	"\bGetOrder\x12\x17.orders.GetOrderRequest\x1a\x18.orders.GetOrderResponse\"\x00\x12Q\n" +
+	"\x0eGetOrdersByIDs\x12\x1d.orders.GetOrdersByIDsRequest\x1a\x1e.orders.GetOrdersByIDsResponse\"\x00\x12Z\n" +
+	"\x11UpdateOrderStatus\x12 .orders.UpdateOrderStatusRequest\x1a!.orders.UpdateOrderStatusResponse\"\x00\x12f\n" +
+	"\x15UpdateShippingAddress\x12$.orders.UpdateShippingAddressRequest\x1a%.orders.UpdateShippingAddressResponse\"\x00\x12H\n" +
+	"\vCancelOrder\x12\x1a.orders.CancelOrderRequest\x1a\x1b.orders.CancelOrderResponse\"\x00\x12E\n" +
 	"\n" +
 	"ListOrders\x12\x19.orders.ListOrdersRequest\x1a\x1a.orders.ListOrdersResponse\"\x00\x12K\n" +
-	"\fSearchOrders\x12\x1b.orders.SearchOrdersRequest\x1a\x1c.orders.SearchOrdersResponse\"\x002\xfd\x01\n" +
+	"\fSearchOrders\x12\x1b.orders.SearchOrdersRequest\x1a\x1c.orders.SearchOrdersResponse\"\x00\x12T\n" +
+	"\x0fGetOrdersByUser\x12\x1e.orders.GetOrdersByUserRequest\x1a\x1f.orders.GetOrdersByUserResponse\"\x00\x12c\n" +
+	"\x14GetFrequentlyOrdered\x12#.orders.GetFrequentlyOrderedRequest\x1a$.orders.GetFrequentlyOrderedResponse\"\x00\x12]\n" +
+	"\x12ValidateOrderItems\x12!.orders.ValidateOrderItemsRequest\x1a\".orders.ValidateOrderItemsResponse\"\x00\x12Z\n" +
+	"\x11VerifyOrderAmount\x12 .orders.VerifyOrderAmountRequest\x1a!.orders.VerifyOrderAmountResponse\"\x00\x12Q\n" +
+	"\x0eCreateShipment\x12\x1d.orders.CreateShipmentRequest\x1a\x1e.orders.CreateShipmentResponse\"\x00\x12N\n" +
+	"\rListShipments\x12\x1c.orders.ListShipmentsRequest\x1a\x1d.orders.ListShipmentsResponse\"\x00\x12f\n" +
+	"\x15MarkShipmentDelivered\x12$.orders.MarkShipmentDeliveredRequest\x1a%.orders.MarkShipmentDeliveredResponse\"\x00\x12]\n" +
+	"\x12CreateSubscription\x12!.orders.CreateSubscriptionRequest\x1a\".orders.CreateSubscriptionResponse\"\x00\x12Z\n" +
+	"\x11PauseSubscription\x12 .orders.PauseSubscriptionRequest\x1a!.orders.PauseSubscriptionResponse\"\x00\x12]\n" +
+	"\x12ResumeSubscription\x12!.orders.ResumeSubscriptionRequest\x1a\".orders.ResumeSubscriptionResponse\"\x00\x12]\n" +
+	"\x12CancelSubscription\x12!.orders.CancelSubscriptionRequest\x1a\".orders.CancelSubscriptionResponse\"\x002\xc8\x05\n" +
 	"\fAdminService\x12W\n" +
-	"\x10ForceDeleteOrder\x12\x1f.orders.ForceDeleteOrderRequest\x1a .orders.ForceDeleteOrderResponse\"\x00\x12T\n" +
+	"\x10ForceDeleteOrder\x12\x1f.orders.ForceDeleteOrderRequest\x1a .orders.ForceDeleteOrderResponse\"\x00\x12K\n" +
+	"\fRestoreOrder\x12\x1b.orders.RestoreOrderRequest\x1a\x1c.orders.RestoreOrderResponse\"\x00\x12T\n" +
 	"\x10BulkCreateOrders\x12\x1a.orders.CreateOrderRequest\x1a .orders.BulkCreateOrdersResponse\"\x00(\x01\x12>\n" +
-	"\fExportOrders\x12\x1b.orders.ExportOrdersRequest\x1a\r.orders.Order\"\x000\x01B\x10Z\x0e./proto;ordersb\x06proto3"
+	"\fExportOrders\x12\x1b.orders.ExportOrdersRequest\x1a\r.orders.Order\"\x000\x01\x12H\n" +
+	"\vCancelOrder\x12\x1a.orders.CancelOrderRequest\x1a\x1b.orders.CancelOrderResponse\"\x00\x12?\n" +
+	"\bGetOrder\x12\x17.orders.GetOrderRequest\x1a\x18.orders.GetOrderResponse\"\x00\x12E\n" +
+	"\n" +
+	"ListOrders\x12\x19.orders.ListOrdersRequest\x1a\x1a.orders.ListOrdersResponse\"\x00\x12Q\n" +
+	"\x0ePlaceFraudHold\x12\x1d.orders.PlaceFraudHoldRequest\x1a\x1e.orders.PlaceFraudHoldResponse\"\x00\x12W\n" +
+	"\x10ReleaseFraudHold\x12\x1f.orders.ReleaseFraudHoldRequest\x1a .orders.ReleaseFraudHoldResponse\"\x00B\x10Z\x0e./proto;ordersb\x06proto3"
 
 var (
 	file_proto_orders_proto_rawDescOnce sync.Once
@@ -1158,58 +4426,178 @@ func file_proto_orders_proto_rawDescGZIP() []byte {
 	return file_proto_orders_proto_rawDescData
 }
 
-var file_proto_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_proto_orders_proto_goTypes = []any{
-	(*OrderItem)(nil),                 // 0: orders.OrderItem
-	(*Order)(nil),                     // 1: orders.Order
-	(*CreateOrderRequest)(nil),        // 2: orders.CreateOrderRequest
-	(*OrderItemRequest)(nil),          // 3: orders.OrderItemRequest
-	(*CreateOrderResponse)(nil),       // 4: orders.CreateOrderResponse
-	(*GetOrderRequest)(nil),           // 5: orders.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 6: orders.GetOrderResponse
-	(*UpdateOrderStatusRequest)(nil),  // 7: orders.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 8: orders.UpdateOrderStatusResponse
-	(*ListOrdersRequest)(nil),         // 9: orders.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 10: orders.ListOrdersResponse
-	(*SearchOrdersRequest)(nil),       // 11: orders.SearchOrdersRequest
-	(*SearchOrdersResponse)(nil),      // 12: orders.SearchOrdersResponse
-	(*ForceDeleteOrderRequest)(nil),   // 13: orders.ForceDeleteOrderRequest
-	(*ForceDeleteOrderResponse)(nil),  // 14: orders.ForceDeleteOrderResponse
-	(*BulkCreateOrdersRequest)(nil),   // 15: orders.BulkCreateOrdersRequest
-	(*BulkCreateOrdersResponse)(nil),  // 16: orders.BulkCreateOrdersResponse
-	(*ExportOrdersRequest)(nil),       // 17: orders.ExportOrdersRequest
+	(*OrderItem)(nil),                     // 0: orders.OrderItem
+	(*Order)(nil),                         // 1: orders.Order
+	(*ShippingAddress)(nil),               // 2: orders.ShippingAddress
+	(*OrderStatusChange)(nil),             // 3: orders.OrderStatusChange
+	(*CreateOrderRequest)(nil),            // 4: orders.CreateOrderRequest
+	(*OrderItemRequest)(nil),              // 5: orders.OrderItemRequest
+	(*CreateOrderResponse)(nil),           // 6: orders.CreateOrderResponse
+	(*CheckoutRequest)(nil),               // 7: orders.CheckoutRequest
+	(*CheckoutResponse)(nil),              // 8: orders.CheckoutResponse
+	(*GetOrderRequest)(nil),               // 9: orders.GetOrderRequest
+	(*GetOrderResponse)(nil),              // 10: orders.GetOrderResponse
+	(*GetOrdersByIDsRequest)(nil),         // 11: orders.GetOrdersByIDsRequest
+	(*GetOrdersByIDsResponse)(nil),        // 12: orders.GetOrdersByIDsResponse
+	(*UpdateOrderStatusRequest)(nil),      // 13: orders.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),     // 14: orders.UpdateOrderStatusResponse
+	(*CancelOrderRequest)(nil),            // 15: orders.CancelOrderRequest
+	(*CancelOrderResponse)(nil),           // 16: orders.CancelOrderResponse
+	(*ListOrdersRequest)(nil),             // 17: orders.ListOrdersRequest
+	(*ListOrdersResponse)(nil),            // 18: orders.ListOrdersResponse
+	(*GetOrdersByUserRequest)(nil),        // 19: orders.GetOrdersByUserRequest
+	(*GetOrdersByUserResponse)(nil),       // 20: orders.GetOrdersByUserResponse
+	(*GetFrequentlyOrderedRequest)(nil),   // 21: orders.GetFrequentlyOrderedRequest
+	(*FrequentlyOrderedProduct)(nil),      // 22: orders.FrequentlyOrderedProduct
+	(*GetFrequentlyOrderedResponse)(nil),  // 23: orders.GetFrequentlyOrderedResponse
+	(*UpdateShippingAddressRequest)(nil),  // 24: orders.UpdateShippingAddressRequest
+	(*UpdateShippingAddressResponse)(nil), // 25: orders.UpdateShippingAddressResponse
+	(*SearchOrdersRequest)(nil),           // 26: orders.SearchOrdersRequest
+	(*SearchOrdersResponse)(nil),          // 27: orders.SearchOrdersResponse
+	(*ForceDeleteOrderRequest)(nil),       // 28: orders.ForceDeleteOrderRequest
+	(*ForceDeleteOrderResponse)(nil),      // 29: orders.ForceDeleteOrderResponse
+	(*RestoreOrderRequest)(nil),           // 30: orders.RestoreOrderRequest
+	(*RestoreOrderResponse)(nil),          // 31: orders.RestoreOrderResponse
+	(*PlaceFraudHoldRequest)(nil),         // 32: orders.PlaceFraudHoldRequest
+	(*PlaceFraudHoldResponse)(nil),        // 33: orders.PlaceFraudHoldResponse
+	(*ReleaseFraudHoldRequest)(nil),       // 34: orders.ReleaseFraudHoldRequest
+	(*ReleaseFraudHoldResponse)(nil),      // 35: orders.ReleaseFraudHoldResponse
+	(*BulkCreateOrdersRequest)(nil),       // 36: orders.BulkCreateOrdersRequest
+	(*BulkCreateOrdersResponse)(nil),      // 37: orders.BulkCreateOrdersResponse
+	(*BulkCreateOrderFailure)(nil),        // 38: orders.BulkCreateOrderFailure
+	(*ExportOrdersRequest)(nil),           // 39: orders.ExportOrdersRequest
+	(*VerifyOrderAmountRequest)(nil),      // 40: orders.VerifyOrderAmountRequest
+	(*VerifyOrderAmountResponse)(nil),     // 41: orders.VerifyOrderAmountResponse
+	(*Shipment)(nil),                      // 42: orders.Shipment
+	(*ShipmentItem)(nil),                  // 43: orders.ShipmentItem
+	(*CreateShipmentRequest)(nil),         // 44: orders.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil),        // 45: orders.CreateShipmentResponse
+	(*ListShipmentsRequest)(nil),          // 46: orders.ListShipmentsRequest
+	(*ListShipmentsResponse)(nil),         // 47: orders.ListShipmentsResponse
+	(*MarkShipmentDeliveredRequest)(nil),  // 48: orders.MarkShipmentDeliveredRequest
+	(*MarkShipmentDeliveredResponse)(nil), // 49: orders.MarkShipmentDeliveredResponse
+	(*Subscription)(nil),                  // 50: orders.Subscription
+	(*CreateSubscriptionRequest)(nil),     // 51: orders.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil),    // 52: orders.CreateSubscriptionResponse
+	(*PauseSubscriptionRequest)(nil),      // 53: orders.PauseSubscriptionRequest
+	(*PauseSubscriptionResponse)(nil),     // 54: orders.PauseSubscriptionResponse
+	(*ResumeSubscriptionRequest)(nil),     // 55: orders.ResumeSubscriptionRequest
+	(*ResumeSubscriptionResponse)(nil),    // 56: orders.ResumeSubscriptionResponse
+	(*CancelSubscriptionRequest)(nil),     // 57: orders.CancelSubscriptionRequest
+	(*CancelSubscriptionResponse)(nil),    // 58: orders.CancelSubscriptionResponse
+	(*OrderItemViolation)(nil),            // 59: orders.OrderItemViolation
+	(*ValidateOrderItemsRequest)(nil),     // 60: orders.ValidateOrderItemsRequest
+	(*OrderItemValidation)(nil),           // 61: orders.OrderItemValidation
+	(*ValidateOrderItemsResponse)(nil),    // 62: orders.ValidateOrderItemsResponse
+	(*OrderValidationError)(nil),          // 63: orders.OrderValidationError
+	(*OrderCreatedEvent)(nil),             // 64: orders.OrderCreatedEvent
+	(*OrderCreatedEventItem)(nil),         // 65: orders.OrderCreatedEventItem
 }
 var file_proto_orders_proto_depIdxs = []int32{
 	0,  // 0: orders.Order.order_items:type_name -> orders.OrderItem
-	3,  // 1: orders.CreateOrderRequest.order_items:type_name -> orders.OrderItemRequest
-	1,  // 2: orders.CreateOrderResponse.order:type_name -> orders.Order
-	1,  // 3: orders.GetOrderResponse.order:type_name -> orders.Order
-	1,  // 4: orders.UpdateOrderStatusResponse.order:type_name -> orders.Order
-	1,  // 5: orders.ListOrdersResponse.orders:type_name -> orders.Order
-	1,  // 6: orders.SearchOrdersResponse.orders:type_name -> orders.Order
-	2,  // 7: orders.BulkCreateOrdersRequest.orders:type_name -> orders.CreateOrderRequest
-	1,  // 8: orders.BulkCreateOrdersResponse.orders:type_name -> orders.Order
-	2,  // 9: orders.OrderService.CreateOrder:input_type -> orders.CreateOrderRequest
-	5,  // 10: orders.OrderService.GetOrder:input_type -> orders.GetOrderRequest
-	7,  // 11: orders.OrderService.UpdateOrderStatus:input_type -> orders.UpdateOrderStatusRequest
-	9,  // 12: orders.OrderService.ListOrders:input_type -> orders.ListOrdersRequest
-	11, // 13: orders.OrderService.SearchOrders:input_type -> orders.SearchOrdersRequest
-	13, // 14: orders.AdminService.ForceDeleteOrder:input_type -> orders.ForceDeleteOrderRequest
-	2,  // 15: orders.AdminService.BulkCreateOrders:input_type -> orders.CreateOrderRequest
-	17, // 16: orders.AdminService.ExportOrders:input_type -> orders.ExportOrdersRequest
-	4,  // 17: orders.OrderService.CreateOrder:output_type -> orders.CreateOrderResponse
-	6,  // 18: orders.OrderService.GetOrder:output_type -> orders.GetOrderResponse
-	8,  // 19: orders.OrderService.UpdateOrderStatus:output_type -> orders.UpdateOrderStatusResponse
-	10, // 20: orders.OrderService.ListOrders:output_type -> orders.ListOrdersResponse
-	12, // 21: orders.OrderService.SearchOrders:output_type -> orders.SearchOrdersResponse
-	14, // 22: orders.AdminService.ForceDeleteOrder:output_type -> orders.ForceDeleteOrderResponse
-	16, // 23: orders.AdminService.BulkCreateOrders:output_type -> orders.BulkCreateOrdersResponse
-	1,  // 24: orders.AdminService.ExportOrders:output_type -> orders.Order
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	3,  // 1: orders.Order.history:type_name -> orders.OrderStatusChange
+	2,  // 2: orders.Order.shipping_address:type_name -> orders.ShippingAddress
+	5,  // 3: orders.CreateOrderRequest.order_items:type_name -> orders.OrderItemRequest
+	2,  // 4: orders.CreateOrderRequest.shipping_address:type_name -> orders.ShippingAddress
+	1,  // 5: orders.CreateOrderResponse.order:type_name -> orders.Order
+	2,  // 6: orders.CheckoutRequest.shipping_address:type_name -> orders.ShippingAddress
+	1,  // 7: orders.CheckoutResponse.order:type_name -> orders.Order
+	1,  // 8: orders.GetOrderResponse.order:type_name -> orders.Order
+	1,  // 9: orders.GetOrdersByIDsResponse.orders:type_name -> orders.Order
+	1,  // 10: orders.UpdateOrderStatusResponse.order:type_name -> orders.Order
+	1,  // 11: orders.CancelOrderResponse.order:type_name -> orders.Order
+	1,  // 12: orders.ListOrdersResponse.orders:type_name -> orders.Order
+	1,  // 13: orders.GetOrdersByUserResponse.orders:type_name -> orders.Order
+	22, // 14: orders.GetFrequentlyOrderedResponse.products:type_name -> orders.FrequentlyOrderedProduct
+	2,  // 15: orders.UpdateShippingAddressRequest.shipping_address:type_name -> orders.ShippingAddress
+	1,  // 16: orders.UpdateShippingAddressResponse.order:type_name -> orders.Order
+	1,  // 17: orders.SearchOrdersResponse.orders:type_name -> orders.Order
+	1,  // 18: orders.RestoreOrderResponse.order:type_name -> orders.Order
+	1,  // 19: orders.PlaceFraudHoldResponse.order:type_name -> orders.Order
+	1,  // 20: orders.ReleaseFraudHoldResponse.order:type_name -> orders.Order
+	4,  // 21: orders.BulkCreateOrdersRequest.orders:type_name -> orders.CreateOrderRequest
+	1,  // 22: orders.BulkCreateOrdersResponse.orders:type_name -> orders.Order
+	38, // 23: orders.BulkCreateOrdersResponse.failures:type_name -> orders.BulkCreateOrderFailure
+	43, // 24: orders.Shipment.items:type_name -> orders.ShipmentItem
+	43, // 25: orders.CreateShipmentRequest.items:type_name -> orders.ShipmentItem
+	42, // 26: orders.CreateShipmentResponse.shipment:type_name -> orders.Shipment
+	42, // 27: orders.ListShipmentsResponse.shipments:type_name -> orders.Shipment
+	42, // 28: orders.MarkShipmentDeliveredResponse.shipment:type_name -> orders.Shipment
+	5,  // 29: orders.Subscription.items:type_name -> orders.OrderItemRequest
+	5,  // 30: orders.CreateSubscriptionRequest.items:type_name -> orders.OrderItemRequest
+	50, // 31: orders.CreateSubscriptionResponse.subscription:type_name -> orders.Subscription
+	50, // 32: orders.PauseSubscriptionResponse.subscription:type_name -> orders.Subscription
+	50, // 33: orders.ResumeSubscriptionResponse.subscription:type_name -> orders.Subscription
+	50, // 34: orders.CancelSubscriptionResponse.subscription:type_name -> orders.Subscription
+	5,  // 35: orders.ValidateOrderItemsRequest.items:type_name -> orders.OrderItemRequest
+	61, // 36: orders.ValidateOrderItemsResponse.items:type_name -> orders.OrderItemValidation
+	59, // 37: orders.OrderValidationError.violations:type_name -> orders.OrderItemViolation
+	65, // 38: orders.OrderCreatedEvent.items:type_name -> orders.OrderCreatedEventItem
+	4,  // 39: orders.OrderService.CreateOrder:input_type -> orders.CreateOrderRequest
+	7,  // 40: orders.OrderService.Checkout:input_type -> orders.CheckoutRequest
+	9,  // 41: orders.OrderService.GetOrder:input_type -> orders.GetOrderRequest
+	11, // 42: orders.OrderService.GetOrdersByIDs:input_type -> orders.GetOrdersByIDsRequest
+	13, // 43: orders.OrderService.UpdateOrderStatus:input_type -> orders.UpdateOrderStatusRequest
+	24, // 44: orders.OrderService.UpdateShippingAddress:input_type -> orders.UpdateShippingAddressRequest
+	15, // 45: orders.OrderService.CancelOrder:input_type -> orders.CancelOrderRequest
+	17, // 46: orders.OrderService.ListOrders:input_type -> orders.ListOrdersRequest
+	26, // 47: orders.OrderService.SearchOrders:input_type -> orders.SearchOrdersRequest
+	19, // 48: orders.OrderService.GetOrdersByUser:input_type -> orders.GetOrdersByUserRequest
+	21, // 49: orders.OrderService.GetFrequentlyOrdered:input_type -> orders.GetFrequentlyOrderedRequest
+	60, // 50: orders.OrderService.ValidateOrderItems:input_type -> orders.ValidateOrderItemsRequest
+	40, // 51: orders.OrderService.VerifyOrderAmount:input_type -> orders.VerifyOrderAmountRequest
+	44, // 52: orders.OrderService.CreateShipment:input_type -> orders.CreateShipmentRequest
+	46, // 53: orders.OrderService.ListShipments:input_type -> orders.ListShipmentsRequest
+	48, // 54: orders.OrderService.MarkShipmentDelivered:input_type -> orders.MarkShipmentDeliveredRequest
+	51, // 55: orders.OrderService.CreateSubscription:input_type -> orders.CreateSubscriptionRequest
+	53, // 56: orders.OrderService.PauseSubscription:input_type -> orders.PauseSubscriptionRequest
+	55, // 57: orders.OrderService.ResumeSubscription:input_type -> orders.ResumeSubscriptionRequest
+	57, // 58: orders.OrderService.CancelSubscription:input_type -> orders.CancelSubscriptionRequest
+	28, // 59: orders.AdminService.ForceDeleteOrder:input_type -> orders.ForceDeleteOrderRequest
+	30, // 60: orders.AdminService.RestoreOrder:input_type -> orders.RestoreOrderRequest
+	4,  // 61: orders.AdminService.BulkCreateOrders:input_type -> orders.CreateOrderRequest
+	39, // 62: orders.AdminService.ExportOrders:input_type -> orders.ExportOrdersRequest
+	15, // 63: orders.AdminService.CancelOrder:input_type -> orders.CancelOrderRequest
+	9,  // 64: orders.AdminService.GetOrder:input_type -> orders.GetOrderRequest
+	17, // 65: orders.AdminService.ListOrders:input_type -> orders.ListOrdersRequest
+	32, // 66: orders.AdminService.PlaceFraudHold:input_type -> orders.PlaceFraudHoldRequest
+	34, // 67: orders.AdminService.ReleaseFraudHold:input_type -> orders.ReleaseFraudHoldRequest
+	6,  // 68: orders.OrderService.CreateOrder:output_type -> orders.CreateOrderResponse
+	8,  // 69: orders.OrderService.Checkout:output_type -> orders.CheckoutResponse
+	10, // 70: orders.OrderService.GetOrder:output_type -> orders.GetOrderResponse
+	12, // 71: orders.OrderService.GetOrdersByIDs:output_type -> orders.GetOrdersByIDsResponse
+	14, // 72: orders.OrderService.UpdateOrderStatus:output_type -> orders.UpdateOrderStatusResponse
+	25, // 73: orders.OrderService.UpdateShippingAddress:output_type -> orders.UpdateShippingAddressResponse
+	16, // 74: orders.OrderService.CancelOrder:output_type -> orders.CancelOrderResponse
+	18, // 75: orders.OrderService.ListOrders:output_type -> orders.ListOrdersResponse
+	27, // 76: orders.OrderService.SearchOrders:output_type -> orders.SearchOrdersResponse
+	20, // 77: orders.OrderService.GetOrdersByUser:output_type -> orders.GetOrdersByUserResponse
+	23, // 78: orders.OrderService.GetFrequentlyOrdered:output_type -> orders.GetFrequentlyOrderedResponse
+	62, // 79: orders.OrderService.ValidateOrderItems:output_type -> orders.ValidateOrderItemsResponse
+	41, // 80: orders.OrderService.VerifyOrderAmount:output_type -> orders.VerifyOrderAmountResponse
+	45, // 81: orders.OrderService.CreateShipment:output_type -> orders.CreateShipmentResponse
+	47, // 82: orders.OrderService.ListShipments:output_type -> orders.ListShipmentsResponse
+	49, // 83: orders.OrderService.MarkShipmentDelivered:output_type -> orders.MarkShipmentDeliveredResponse
+	52, // 84: orders.OrderService.CreateSubscription:output_type -> orders.CreateSubscriptionResponse
+	54, // 85: orders.OrderService.PauseSubscription:output_type -> orders.PauseSubscriptionResponse
+	56, // 86: orders.OrderService.ResumeSubscription:output_type -> orders.ResumeSubscriptionResponse
+	58, // 87: orders.OrderService.CancelSubscription:output_type -> orders.CancelSubscriptionResponse
+	29, // 88: orders.AdminService.ForceDeleteOrder:output_type -> orders.ForceDeleteOrderResponse
+	31, // 89: orders.AdminService.RestoreOrder:output_type -> orders.RestoreOrderResponse
+	37, // 90: orders.AdminService.BulkCreateOrders:output_type -> orders.BulkCreateOrdersResponse
+	1,  // 91: orders.AdminService.ExportOrders:output_type -> orders.Order
+	16, // 92: orders.AdminService.CancelOrder:output_type -> orders.CancelOrderResponse
+	10, // 93: orders.AdminService.GetOrder:output_type -> orders.GetOrderResponse
+	18, // 94: orders.AdminService.ListOrders:output_type -> orders.ListOrdersResponse
+	33, // 95: orders.AdminService.PlaceFraudHold:output_type -> orders.PlaceFraudHoldResponse
+	35, // 96: orders.AdminService.ReleaseFraudHold:output_type -> orders.ReleaseFraudHoldResponse
+	68, // [68:97] is the sub-list for method output_type
+	39, // [39:68] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_orders_proto_init() }
@@ -1223,7 +4611,7 @@ func file_proto_orders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_orders_proto_rawDesc), len(file_proto_orders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
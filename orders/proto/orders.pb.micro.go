@@ -30,10 +30,28 @@ var _ server.Option
 type OrderService interface {
 	// Order CRUD operations
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...client.CallOption) (*CreateOrderResponse, error)
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...client.CallOption) (*CheckoutResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderResponse, error)
+	GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, opts ...client.CallOption) (*GetOrdersByIDsResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...client.CallOption) (*UpdateOrderStatusResponse, error)
+	UpdateShippingAddress(ctx context.Context, in *UpdateShippingAddressRequest, opts ...client.CallOption) (*UpdateShippingAddressResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...client.CallOption) (*CancelOrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersResponse, error)
 	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...client.CallOption) (*SearchOrdersResponse, error)
+	GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...client.CallOption) (*GetOrdersByUserResponse, error)
+	GetFrequentlyOrdered(ctx context.Context, in *GetFrequentlyOrderedRequest, opts ...client.CallOption) (*GetFrequentlyOrderedResponse, error)
+	ValidateOrderItems(ctx context.Context, in *ValidateOrderItemsRequest, opts ...client.CallOption) (*ValidateOrderItemsResponse, error)
+	// Payment operations
+	VerifyOrderAmount(ctx context.Context, in *VerifyOrderAmountRequest, opts ...client.CallOption) (*VerifyOrderAmountResponse, error)
+	// Shipment operations
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...client.CallOption) (*CreateShipmentResponse, error)
+	ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...client.CallOption) (*ListShipmentsResponse, error)
+	MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...client.CallOption) (*MarkShipmentDeliveredResponse, error)
+	// Subscription operations
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*CreateSubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...client.CallOption) (*PauseSubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...client.CallOption) (*ResumeSubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...client.CallOption) (*CancelSubscriptionResponse, error)
 }
 
 type orderService struct {
@@ -58,6 +76,16 @@ func (c *orderService) CreateOrder(ctx context.Context, in *CreateOrderRequest,
 	return out, nil
 }
 
+func (c *orderService) Checkout(ctx context.Context, in *CheckoutRequest, opts ...client.CallOption) (*CheckoutResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.Checkout", in)
+	out := new(CheckoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderService) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderResponse, error) {
 	req := c.c.NewRequest(c.name, "OrderService.GetOrder", in)
 	out := new(GetOrderResponse)
@@ -68,6 +96,16 @@ func (c *orderService) GetOrder(ctx context.Context, in *GetOrderRequest, opts .
 	return out, nil
 }
 
+func (c *orderService) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, opts ...client.CallOption) (*GetOrdersByIDsResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.GetOrdersByIDs", in)
+	out := new(GetOrdersByIDsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderService) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...client.CallOption) (*UpdateOrderStatusResponse, error) {
 	req := c.c.NewRequest(c.name, "OrderService.UpdateOrderStatus", in)
 	out := new(UpdateOrderStatusResponse)
@@ -78,6 +116,26 @@ func (c *orderService) UpdateOrderStatus(ctx context.Context, in *UpdateOrderSta
 	return out, nil
 }
 
+func (c *orderService) UpdateShippingAddress(ctx context.Context, in *UpdateShippingAddressRequest, opts ...client.CallOption) (*UpdateShippingAddressResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.UpdateShippingAddress", in)
+	out := new(UpdateShippingAddressResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...client.CallOption) (*CancelOrderResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.CancelOrder", in)
+	out := new(CancelOrderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderService) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersResponse, error) {
 	req := c.c.NewRequest(c.name, "OrderService.ListOrders", in)
 	out := new(ListOrdersResponse)
@@ -98,24 +156,167 @@ func (c *orderService) SearchOrders(ctx context.Context, in *SearchOrdersRequest
 	return out, nil
 }
 
+func (c *orderService) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...client.CallOption) (*GetOrdersByUserResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.GetOrdersByUser", in)
+	out := new(GetOrdersByUserResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) GetFrequentlyOrdered(ctx context.Context, in *GetFrequentlyOrderedRequest, opts ...client.CallOption) (*GetFrequentlyOrderedResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.GetFrequentlyOrdered", in)
+	out := new(GetFrequentlyOrderedResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) ValidateOrderItems(ctx context.Context, in *ValidateOrderItemsRequest, opts ...client.CallOption) (*ValidateOrderItemsResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.ValidateOrderItems", in)
+	out := new(ValidateOrderItemsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) VerifyOrderAmount(ctx context.Context, in *VerifyOrderAmountRequest, opts ...client.CallOption) (*VerifyOrderAmountResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.VerifyOrderAmount", in)
+	out := new(VerifyOrderAmountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...client.CallOption) (*CreateShipmentResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.CreateShipment", in)
+	out := new(CreateShipmentResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...client.CallOption) (*ListShipmentsResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.ListShipments", in)
+	out := new(ListShipmentsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, opts ...client.CallOption) (*MarkShipmentDeliveredResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.MarkShipmentDelivered", in)
+	out := new(MarkShipmentDeliveredResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*CreateSubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.CreateSubscription", in)
+	out := new(CreateSubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...client.CallOption) (*PauseSubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.PauseSubscription", in)
+	out := new(PauseSubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...client.CallOption) (*ResumeSubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.ResumeSubscription", in)
+	out := new(ResumeSubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderService) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...client.CallOption) (*CancelSubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "OrderService.CancelSubscription", in)
+	out := new(CancelSubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for OrderService service
 
 type OrderServiceHandler interface {
 	// Order CRUD operations
 	CreateOrder(context.Context, *CreateOrderRequest, *CreateOrderResponse) error
+	Checkout(context.Context, *CheckoutRequest, *CheckoutResponse) error
 	GetOrder(context.Context, *GetOrderRequest, *GetOrderResponse) error
+	GetOrdersByIDs(context.Context, *GetOrdersByIDsRequest, *GetOrdersByIDsResponse) error
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest, *UpdateOrderStatusResponse) error
+	UpdateShippingAddress(context.Context, *UpdateShippingAddressRequest, *UpdateShippingAddressResponse) error
+	CancelOrder(context.Context, *CancelOrderRequest, *CancelOrderResponse) error
 	ListOrders(context.Context, *ListOrdersRequest, *ListOrdersResponse) error
 	SearchOrders(context.Context, *SearchOrdersRequest, *SearchOrdersResponse) error
+	GetOrdersByUser(context.Context, *GetOrdersByUserRequest, *GetOrdersByUserResponse) error
+	GetFrequentlyOrdered(context.Context, *GetFrequentlyOrderedRequest, *GetFrequentlyOrderedResponse) error
+	ValidateOrderItems(context.Context, *ValidateOrderItemsRequest, *ValidateOrderItemsResponse) error
+	// Payment operations
+	VerifyOrderAmount(context.Context, *VerifyOrderAmountRequest, *VerifyOrderAmountResponse) error
+	// Shipment operations
+	CreateShipment(context.Context, *CreateShipmentRequest, *CreateShipmentResponse) error
+	ListShipments(context.Context, *ListShipmentsRequest, *ListShipmentsResponse) error
+	MarkShipmentDelivered(context.Context, *MarkShipmentDeliveredRequest, *MarkShipmentDeliveredResponse) error
+	// Subscription operations
+	CreateSubscription(context.Context, *CreateSubscriptionRequest, *CreateSubscriptionResponse) error
+	PauseSubscription(context.Context, *PauseSubscriptionRequest, *PauseSubscriptionResponse) error
+	ResumeSubscription(context.Context, *ResumeSubscriptionRequest, *ResumeSubscriptionResponse) error
+	CancelSubscription(context.Context, *CancelSubscriptionRequest, *CancelSubscriptionResponse) error
 }
 
 func RegisterOrderServiceHandler(s server.Server, hdlr OrderServiceHandler, opts ...server.HandlerOption) error {
 	type orderService interface {
 		CreateOrder(ctx context.Context, in *CreateOrderRequest, out *CreateOrderResponse) error
+		Checkout(ctx context.Context, in *CheckoutRequest, out *CheckoutResponse) error
 		GetOrder(ctx context.Context, in *GetOrderRequest, out *GetOrderResponse) error
+		GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, out *GetOrdersByIDsResponse) error
 		UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, out *UpdateOrderStatusResponse) error
+		UpdateShippingAddress(ctx context.Context, in *UpdateShippingAddressRequest, out *UpdateShippingAddressResponse) error
+		CancelOrder(ctx context.Context, in *CancelOrderRequest, out *CancelOrderResponse) error
 		ListOrders(ctx context.Context, in *ListOrdersRequest, out *ListOrdersResponse) error
 		SearchOrders(ctx context.Context, in *SearchOrdersRequest, out *SearchOrdersResponse) error
+		GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, out *GetOrdersByUserResponse) error
+		GetFrequentlyOrdered(ctx context.Context, in *GetFrequentlyOrderedRequest, out *GetFrequentlyOrderedResponse) error
+		ValidateOrderItems(ctx context.Context, in *ValidateOrderItemsRequest, out *ValidateOrderItemsResponse) error
+		VerifyOrderAmount(ctx context.Context, in *VerifyOrderAmountRequest, out *VerifyOrderAmountResponse) error
+		CreateShipment(ctx context.Context, in *CreateShipmentRequest, out *CreateShipmentResponse) error
+		ListShipments(ctx context.Context, in *ListShipmentsRequest, out *ListShipmentsResponse) error
+		MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, out *MarkShipmentDeliveredResponse) error
+		CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *CreateSubscriptionResponse) error
+		PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, out *PauseSubscriptionResponse) error
+		ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, out *ResumeSubscriptionResponse) error
+		CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, out *CancelSubscriptionResponse) error
 	}
 	type OrderService struct {
 		orderService
@@ -132,14 +333,30 @@ func (h *orderServiceHandler) CreateOrder(ctx context.Context, in *CreateOrderRe
 	return h.OrderServiceHandler.CreateOrder(ctx, in, out)
 }
 
+func (h *orderServiceHandler) Checkout(ctx context.Context, in *CheckoutRequest, out *CheckoutResponse) error {
+	return h.OrderServiceHandler.Checkout(ctx, in, out)
+}
+
 func (h *orderServiceHandler) GetOrder(ctx context.Context, in *GetOrderRequest, out *GetOrderResponse) error {
 	return h.OrderServiceHandler.GetOrder(ctx, in, out)
 }
 
+func (h *orderServiceHandler) GetOrdersByIDs(ctx context.Context, in *GetOrdersByIDsRequest, out *GetOrdersByIDsResponse) error {
+	return h.OrderServiceHandler.GetOrdersByIDs(ctx, in, out)
+}
+
 func (h *orderServiceHandler) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, out *UpdateOrderStatusResponse) error {
 	return h.OrderServiceHandler.UpdateOrderStatus(ctx, in, out)
 }
 
+func (h *orderServiceHandler) UpdateShippingAddress(ctx context.Context, in *UpdateShippingAddressRequest, out *UpdateShippingAddressResponse) error {
+	return h.OrderServiceHandler.UpdateShippingAddress(ctx, in, out)
+}
+
+func (h *orderServiceHandler) CancelOrder(ctx context.Context, in *CancelOrderRequest, out *CancelOrderResponse) error {
+	return h.OrderServiceHandler.CancelOrder(ctx, in, out)
+}
+
 func (h *orderServiceHandler) ListOrders(ctx context.Context, in *ListOrdersRequest, out *ListOrdersResponse) error {
 	return h.OrderServiceHandler.ListOrders(ctx, in, out)
 }
@@ -148,12 +365,62 @@ func (h *orderServiceHandler) SearchOrders(ctx context.Context, in *SearchOrders
 	return h.OrderServiceHandler.SearchOrders(ctx, in, out)
 }
 
+func (h *orderServiceHandler) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, out *GetOrdersByUserResponse) error {
+	return h.OrderServiceHandler.GetOrdersByUser(ctx, in, out)
+}
+
+func (h *orderServiceHandler) GetFrequentlyOrdered(ctx context.Context, in *GetFrequentlyOrderedRequest, out *GetFrequentlyOrderedResponse) error {
+	return h.OrderServiceHandler.GetFrequentlyOrdered(ctx, in, out)
+}
+
+func (h *orderServiceHandler) ValidateOrderItems(ctx context.Context, in *ValidateOrderItemsRequest, out *ValidateOrderItemsResponse) error {
+	return h.OrderServiceHandler.ValidateOrderItems(ctx, in, out)
+}
+
+func (h *orderServiceHandler) VerifyOrderAmount(ctx context.Context, in *VerifyOrderAmountRequest, out *VerifyOrderAmountResponse) error {
+	return h.OrderServiceHandler.VerifyOrderAmount(ctx, in, out)
+}
+
+func (h *orderServiceHandler) CreateShipment(ctx context.Context, in *CreateShipmentRequest, out *CreateShipmentResponse) error {
+	return h.OrderServiceHandler.CreateShipment(ctx, in, out)
+}
+
+func (h *orderServiceHandler) ListShipments(ctx context.Context, in *ListShipmentsRequest, out *ListShipmentsResponse) error {
+	return h.OrderServiceHandler.ListShipments(ctx, in, out)
+}
+
+func (h *orderServiceHandler) MarkShipmentDelivered(ctx context.Context, in *MarkShipmentDeliveredRequest, out *MarkShipmentDeliveredResponse) error {
+	return h.OrderServiceHandler.MarkShipmentDelivered(ctx, in, out)
+}
+
+func (h *orderServiceHandler) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *CreateSubscriptionResponse) error {
+	return h.OrderServiceHandler.CreateSubscription(ctx, in, out)
+}
+
+func (h *orderServiceHandler) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, out *PauseSubscriptionResponse) error {
+	return h.OrderServiceHandler.PauseSubscription(ctx, in, out)
+}
+
+func (h *orderServiceHandler) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, out *ResumeSubscriptionResponse) error {
+	return h.OrderServiceHandler.ResumeSubscription(ctx, in, out)
+}
+
+func (h *orderServiceHandler) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, out *CancelSubscriptionResponse) error {
+	return h.OrderServiceHandler.CancelSubscription(ctx, in, out)
+}
+
 // Client API for AdminService service
 
 type AdminService interface {
 	ForceDeleteOrder(ctx context.Context, in *ForceDeleteOrderRequest, opts ...client.CallOption) (*ForceDeleteOrderResponse, error)
+	RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...client.CallOption) (*RestoreOrderResponse, error)
 	BulkCreateOrders(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateOrdersService, error)
 	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...client.CallOption) (AdminService_ExportOrdersService, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...client.CallOption) (*CancelOrderResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersResponse, error)
+	PlaceFraudHold(ctx context.Context, in *PlaceFraudHoldRequest, opts ...client.CallOption) (*PlaceFraudHoldResponse, error)
+	ReleaseFraudHold(ctx context.Context, in *ReleaseFraudHoldRequest, opts ...client.CallOption) (*ReleaseFraudHoldResponse, error)
 }
 
 type adminService struct {
@@ -178,6 +445,16 @@ func (c *adminService) ForceDeleteOrder(ctx context.Context, in *ForceDeleteOrde
 	return out, nil
 }
 
+func (c *adminService) RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...client.CallOption) (*RestoreOrderResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.RestoreOrder", in)
+	out := new(RestoreOrderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminService) BulkCreateOrders(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateOrdersService, error) {
 	req := c.c.NewRequest(c.name, "AdminService.BulkCreateOrders", &CreateOrderRequest{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -278,19 +555,81 @@ func (x *adminServiceExportOrders) Recv() (*Order, error) {
 	return m, nil
 }
 
+func (c *adminService) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...client.CallOption) (*CancelOrderResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.CancelOrder", in)
+	out := new(CancelOrderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetOrder", in)
+	out := new(GetOrderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ListOrders", in)
+	out := new(ListOrdersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) PlaceFraudHold(ctx context.Context, in *PlaceFraudHoldRequest, opts ...client.CallOption) (*PlaceFraudHoldResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.PlaceFraudHold", in)
+	out := new(PlaceFraudHoldResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ReleaseFraudHold(ctx context.Context, in *ReleaseFraudHoldRequest, opts ...client.CallOption) (*ReleaseFraudHoldResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ReleaseFraudHold", in)
+	out := new(ReleaseFraudHoldResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceHandler interface {
 	ForceDeleteOrder(context.Context, *ForceDeleteOrderRequest, *ForceDeleteOrderResponse) error
+	RestoreOrder(context.Context, *RestoreOrderRequest, *RestoreOrderResponse) error
 	BulkCreateOrders(context.Context, AdminService_BulkCreateOrdersStream) error
 	ExportOrders(context.Context, *ExportOrdersRequest, AdminService_ExportOrdersStream) error
+	CancelOrder(context.Context, *CancelOrderRequest, *CancelOrderResponse) error
+	GetOrder(context.Context, *GetOrderRequest, *GetOrderResponse) error
+	ListOrders(context.Context, *ListOrdersRequest, *ListOrdersResponse) error
+	PlaceFraudHold(context.Context, *PlaceFraudHoldRequest, *PlaceFraudHoldResponse) error
+	ReleaseFraudHold(context.Context, *ReleaseFraudHoldRequest, *ReleaseFraudHoldResponse) error
 }
 
 func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts ...server.HandlerOption) error {
 	type adminService interface {
 		ForceDeleteOrder(ctx context.Context, in *ForceDeleteOrderRequest, out *ForceDeleteOrderResponse) error
+		RestoreOrder(ctx context.Context, in *RestoreOrderRequest, out *RestoreOrderResponse) error
 		BulkCreateOrders(ctx context.Context, stream server.Stream) error
 		ExportOrders(ctx context.Context, stream server.Stream) error
+		CancelOrder(ctx context.Context, in *CancelOrderRequest, out *CancelOrderResponse) error
+		GetOrder(ctx context.Context, in *GetOrderRequest, out *GetOrderResponse) error
+		ListOrders(ctx context.Context, in *ListOrdersRequest, out *ListOrdersResponse) error
+		PlaceFraudHold(ctx context.Context, in *PlaceFraudHoldRequest, out *PlaceFraudHoldResponse) error
+		ReleaseFraudHold(ctx context.Context, in *ReleaseFraudHoldRequest, out *ReleaseFraudHoldResponse) error
 	}
 	type AdminService struct {
 		adminService
@@ -307,6 +646,10 @@ func (h *adminServiceHandler) ForceDeleteOrder(ctx context.Context, in *ForceDel
 	return h.AdminServiceHandler.ForceDeleteOrder(ctx, in, out)
 }
 
+func (h *adminServiceHandler) RestoreOrder(ctx context.Context, in *RestoreOrderRequest, out *RestoreOrderResponse) error {
+	return h.AdminServiceHandler.RestoreOrder(ctx, in, out)
+}
+
 func (h *adminServiceHandler) BulkCreateOrders(ctx context.Context, stream server.Stream) error {
 	return h.AdminServiceHandler.BulkCreateOrders(ctx, &adminServiceBulkCreateOrdersStream{stream})
 }
@@ -386,3 +729,23 @@ func (x *adminServiceExportOrdersStream) RecvMsg(m interface{}) error {
 func (x *adminServiceExportOrdersStream) Send(m *Order) error {
 	return x.stream.Send(m)
 }
+
+func (h *adminServiceHandler) CancelOrder(ctx context.Context, in *CancelOrderRequest, out *CancelOrderResponse) error {
+	return h.AdminServiceHandler.CancelOrder(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GetOrder(ctx context.Context, in *GetOrderRequest, out *GetOrderResponse) error {
+	return h.AdminServiceHandler.GetOrder(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ListOrders(ctx context.Context, in *ListOrdersRequest, out *ListOrdersResponse) error {
+	return h.AdminServiceHandler.ListOrders(ctx, in, out)
+}
+
+func (h *adminServiceHandler) PlaceFraudHold(ctx context.Context, in *PlaceFraudHoldRequest, out *PlaceFraudHoldResponse) error {
+	return h.AdminServiceHandler.PlaceFraudHold(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ReleaseFraudHold(ctx context.Context, in *ReleaseFraudHoldRequest, out *ReleaseFraudHoldResponse) error {
+	return h.AdminServiceHandler.ReleaseFraudHold(ctx, in, out)
+}
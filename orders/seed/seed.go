@@ -0,0 +1,121 @@
+// Package seed loads deterministic sample data for tests and local development.
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"orders/ent"
+	"orders/ent/order"
+	"orders/ent/orderevent"
+
+	productseed "products/seed"
+)
+
+// FixtureSet names a predefined collection of fixtures
+type FixtureSet string
+
+const (
+	// Minimal loads a single record of each kind, enough to exercise relationships
+	Minimal FixtureSet = "minimal"
+	// Demo loads the full sample data set for local development
+	Demo FixtureSet = "demo"
+)
+
+// UserIDs are the users seeded by the users service seed (alice, bob, carol)
+var UserIDs = []uuid.UUID{
+	uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+}
+
+// OrderItemFixture describes a line of productseed.Products[Product]
+type OrderItemFixture struct {
+	Product  int
+	Quantity int
+}
+
+// OrderFixture describes a seeded order placed by UserIDs[User]
+type OrderFixture struct {
+	ID     uuid.UUID
+	User   int
+	Status order.Status
+	Items  []OrderItemFixture
+}
+
+// Orders are the seeded orders; the first only references the first product so Minimal stays consistent
+var Orders = []OrderFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0004-000000000001"), User: 0, Status: order.StatusPending, Items: []OrderItemFixture{{Product: 0, Quantity: 1}}},
+	{ID: uuid.MustParse("00000000-0000-0000-0004-000000000002"), User: 1, Status: order.StatusShipped, Items: []OrderItemFixture{{Product: 1, Quantity: 2}, {Product: 2, Quantity: 3}}},
+	{ID: uuid.MustParse("00000000-0000-0000-0004-000000000003"), User: 0, Status: order.StatusDelivered, Items: []OrderItemFixture{{Product: 2, Quantity: 1}}},
+}
+
+// Load inserts the given fixture set into an empty database in a single transaction
+func Load(ctx context.Context, client *ent.Client, set FixtureSet) error {
+	n, err := set.limit(len(Orders))
+	if err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, f := range Orders[:n] {
+		var totalAmount int64
+		for _, item := range f.Items {
+			totalAmount += int64(item.Quantity) * productseed.Products[item.Product].PriceCents
+		}
+
+		o, err := tx.Order.Create().
+			SetID(f.ID).
+			SetUserID(UserIDs[f.User]).
+			SetTotalAmountCents(totalAmount).
+			SetStatus(f.Status).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed order %s: %w", f.ID, err)
+		}
+		err = tx.OrderEvent.Create().
+			SetOrderID(o.ID).
+			SetToStatus(orderevent.ToStatus(f.Status)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed history for order %s: %w", f.ID, err)
+		}
+
+		for _, item := range f.Items {
+			p := productseed.Products[item.Product]
+			_, err := tx.OrderItem.Create().
+				SetOrderID(o.ID).
+				SetProductID(p.ID).
+				SetProductName(p.Name).
+				SetQuantity(item.Quantity).
+				SetUnitPriceCents(p.PriceCents).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to seed item %s for order %s: %w", p.Name, f.ID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seed data: %w", err)
+	}
+	return nil
+}
+
+// limit returns how many of n fixtures the set includes
+func (s FixtureSet) limit(n int) (int, error) {
+	switch s {
+	case Minimal:
+		return min(1, n), nil
+	case Demo:
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown fixture set: %q", s)
+}
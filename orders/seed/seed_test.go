@@ -0,0 +1,105 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"orders/ent"
+	"orders/ent/enttest"
+
+	productseed "products/seed"
+)
+
+// load opens a fresh in-memory database and loads set into it
+func load(t *testing.T, set FixtureSet) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := Load(context.Background(), client, set); err != nil {
+		t.Fatalf("Load(%s): %v", set, err)
+	}
+	return client
+}
+
+// snapshot describes every seeded row, ignoring timestamps and generated item IDs
+func snapshot(client *ent.Client) []string {
+	var rows []string
+	for _, o := range client.Order.Query().WithOrderItems().WithEvents().AllX(context.Background()) {
+		rows = append(rows, fmt.Sprintf("order %s %s %s %d %d events", o.ID, o.UserID, o.Status, o.TotalAmountCents, len(o.Edges.Events)))
+		for _, item := range o.Edges.OrderItems {
+			rows = append(rows, fmt.Sprintf("item %s %s %d %d", o.ID, item.ProductID, item.Quantity, item.UnitPriceCents))
+		}
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+func TestLoadSeedsOrdersWithItems(t *testing.T) {
+	ctx := context.Background()
+	for set, want := range map[FixtureSet]int{Minimal: 1, Demo: len(Orders)} {
+		client := load(t, set)
+		orders := client.Order.Query().WithOrderItems().WithEvents().AllX(ctx)
+		if len(orders) != want {
+			t.Fatalf("%s: expected %d orders, got %d", set, want, len(orders))
+		}
+
+		var items int
+		for _, f := range Orders[:want] {
+			items += len(f.Items)
+		}
+		if n := client.OrderItem.Query().CountX(ctx); n != items {
+			t.Errorf("%s: expected %d order items, got %d", set, items, n)
+		}
+
+		for _, o := range orders {
+			i := slices.IndexFunc(Orders, func(f OrderFixture) bool { return f.ID == o.ID })
+			if i < 0 {
+				t.Fatalf("%s: unexpected order %s", set, o.ID)
+			}
+			f := Orders[i]
+			if o.UserID != UserIDs[f.User] || o.Status != f.Status {
+				t.Errorf("%s: order %s doesn't match its fixture", set, o.ID)
+			}
+			if len(o.Edges.Events) != 1 || o.Edges.Events[0].ToStatus.String() != f.Status.String() {
+				t.Errorf("%s: expected order %s's history to record its status", set, o.ID)
+			}
+
+			// Items reference the products seed, and the total is their sum
+			var total int64
+			for _, item := range o.Edges.OrderItems {
+				j := slices.IndexFunc(productseed.Products, func(p productseed.ProductFixture) bool { return p.ID == item.ProductID })
+				if j < 0 || item.UnitPriceCents != productseed.Products[j].PriceCents {
+					t.Errorf("%s: order %s has an item not priced from the products seed", set, o.ID)
+				}
+				total += int64(item.Quantity) * item.UnitPriceCents
+			}
+			if o.TotalAmountCents != total {
+				t.Errorf("%s: order %s totals %d, items sum to %d", set, o.ID, o.TotalAmountCents, total)
+			}
+		}
+	}
+}
+
+func TestLoadIsDeterministic(t *testing.T) {
+	first, second := snapshot(load(t, Demo)), snapshot(load(t, Demo))
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected identical data from two loads, got\n%v\n%v", first, second)
+	}
+
+	// Loading into a seeded database fails as a whole rather than duplicating
+	client := load(t, Minimal)
+	if err := Load(context.Background(), client, Demo); err == nil {
+		t.Fatal("expected loading into a seeded database to fail")
+	}
+	if n := client.Order.Query().CountX(context.Background()); n != 1 {
+		t.Fatalf("expected the failed load rolled back, found %d orders", n)
+	}
+	if err := Load(context.Background(), client, "huge"); err == nil {
+		t.Fatal("expected an unknown fixture set to be rejected")
+	}
+}
@@ -12,7 +12,12 @@ import (
 	"products/ent/migrate"
 
 	"products/ent/category"
+	"products/ent/failedstockadjustment"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
+	"products/ent/stockdeduction"
+	"products/ent/stockrestock"
 	"products/ent/subcategory"
 
 	"entgo.io/ent"
@@ -29,8 +34,18 @@ type Client struct {
 	Schema *migrate.Schema
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// FailedStockAdjustment is the client for interacting with the FailedStockAdjustment builders.
+	FailedStockAdjustment *FailedStockAdjustmentClient
+	// PriceTier is the client for interacting with the PriceTier builders.
+	PriceTier *PriceTierClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// Review is the client for interacting with the Review builders.
+	Review *ReviewClient
+	// StockDeduction is the client for interacting with the StockDeduction builders.
+	StockDeduction *StockDeductionClient
+	// StockRestock is the client for interacting with the StockRestock builders.
+	StockRestock *StockRestockClient
 	// SubCategory is the client for interacting with the SubCategory builders.
 	SubCategory *SubCategoryClient
 }
@@ -45,7 +60,12 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Category = NewCategoryClient(c.config)
+	c.FailedStockAdjustment = NewFailedStockAdjustmentClient(c.config)
+	c.PriceTier = NewPriceTierClient(c.config)
 	c.Product = NewProductClient(c.config)
+	c.Review = NewReviewClient(c.config)
+	c.StockDeduction = NewStockDeductionClient(c.config)
+	c.StockRestock = NewStockRestockClient(c.config)
 	c.SubCategory = NewSubCategoryClient(c.config)
 }
 
@@ -137,11 +157,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Category:    NewCategoryClient(cfg),
-		Product:     NewProductClient(cfg),
-		SubCategory: NewSubCategoryClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Category:              NewCategoryClient(cfg),
+		FailedStockAdjustment: NewFailedStockAdjustmentClient(cfg),
+		PriceTier:             NewPriceTierClient(cfg),
+		Product:               NewProductClient(cfg),
+		Review:                NewReviewClient(cfg),
+		StockDeduction:        NewStockDeductionClient(cfg),
+		StockRestock:          NewStockRestockClient(cfg),
+		SubCategory:           NewSubCategoryClient(cfg),
 	}, nil
 }
 
@@ -159,11 +184,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Category:    NewCategoryClient(cfg),
-		Product:     NewProductClient(cfg),
-		SubCategory: NewSubCategoryClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Category:              NewCategoryClient(cfg),
+		FailedStockAdjustment: NewFailedStockAdjustmentClient(cfg),
+		PriceTier:             NewPriceTierClient(cfg),
+		Product:               NewProductClient(cfg),
+		Review:                NewReviewClient(cfg),
+		StockDeduction:        NewStockDeductionClient(cfg),
+		StockRestock:          NewStockRestockClient(cfg),
+		SubCategory:           NewSubCategoryClient(cfg),
 	}, nil
 }
 
@@ -192,17 +222,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Category.Use(hooks...)
-	c.Product.Use(hooks...)
-	c.SubCategory.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Category, c.FailedStockAdjustment, c.PriceTier, c.Product, c.Review,
+		c.StockDeduction, c.StockRestock, c.SubCategory,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Category.Intercept(interceptors...)
-	c.Product.Intercept(interceptors...)
-	c.SubCategory.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Category, c.FailedStockAdjustment, c.PriceTier, c.Product, c.Review,
+		c.StockDeduction, c.StockRestock, c.SubCategory,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -210,8 +246,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *FailedStockAdjustmentMutation:
+		return c.FailedStockAdjustment.mutate(ctx, m)
+	case *PriceTierMutation:
+		return c.PriceTier.mutate(ctx, m)
 	case *ProductMutation:
 		return c.Product.mutate(ctx, m)
+	case *ReviewMutation:
+		return c.Review.mutate(ctx, m)
+	case *StockDeductionMutation:
+		return c.StockDeduction.mutate(ctx, m)
+	case *StockRestockMutation:
+		return c.StockRestock.mutate(ctx, m)
 	case *SubCategoryMutation:
 		return c.SubCategory.mutate(ctx, m)
 	default:
@@ -368,6 +414,288 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
+// FailedStockAdjustmentClient is a client for the FailedStockAdjustment schema.
+type FailedStockAdjustmentClient struct {
+	config
+}
+
+// NewFailedStockAdjustmentClient returns a client for the FailedStockAdjustment from the given config.
+func NewFailedStockAdjustmentClient(c config) *FailedStockAdjustmentClient {
+	return &FailedStockAdjustmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `failedstockadjustment.Hooks(f(g(h())))`.
+func (c *FailedStockAdjustmentClient) Use(hooks ...Hook) {
+	c.hooks.FailedStockAdjustment = append(c.hooks.FailedStockAdjustment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `failedstockadjustment.Intercept(f(g(h())))`.
+func (c *FailedStockAdjustmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FailedStockAdjustment = append(c.inters.FailedStockAdjustment, interceptors...)
+}
+
+// Create returns a builder for creating a FailedStockAdjustment entity.
+func (c *FailedStockAdjustmentClient) Create() *FailedStockAdjustmentCreate {
+	mutation := newFailedStockAdjustmentMutation(c.config, OpCreate)
+	return &FailedStockAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FailedStockAdjustment entities.
+func (c *FailedStockAdjustmentClient) CreateBulk(builders ...*FailedStockAdjustmentCreate) *FailedStockAdjustmentCreateBulk {
+	return &FailedStockAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FailedStockAdjustmentClient) MapCreateBulk(slice any, setFunc func(*FailedStockAdjustmentCreate, int)) *FailedStockAdjustmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FailedStockAdjustmentCreateBulk{err: fmt.Errorf("calling to FailedStockAdjustmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FailedStockAdjustmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FailedStockAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FailedStockAdjustment.
+func (c *FailedStockAdjustmentClient) Update() *FailedStockAdjustmentUpdate {
+	mutation := newFailedStockAdjustmentMutation(c.config, OpUpdate)
+	return &FailedStockAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FailedStockAdjustmentClient) UpdateOne(fsa *FailedStockAdjustment) *FailedStockAdjustmentUpdateOne {
+	mutation := newFailedStockAdjustmentMutation(c.config, OpUpdateOne, withFailedStockAdjustment(fsa))
+	return &FailedStockAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FailedStockAdjustmentClient) UpdateOneID(id uuid.UUID) *FailedStockAdjustmentUpdateOne {
+	mutation := newFailedStockAdjustmentMutation(c.config, OpUpdateOne, withFailedStockAdjustmentID(id))
+	return &FailedStockAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FailedStockAdjustment.
+func (c *FailedStockAdjustmentClient) Delete() *FailedStockAdjustmentDelete {
+	mutation := newFailedStockAdjustmentMutation(c.config, OpDelete)
+	return &FailedStockAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FailedStockAdjustmentClient) DeleteOne(fsa *FailedStockAdjustment) *FailedStockAdjustmentDeleteOne {
+	return c.DeleteOneID(fsa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FailedStockAdjustmentClient) DeleteOneID(id uuid.UUID) *FailedStockAdjustmentDeleteOne {
+	builder := c.Delete().Where(failedstockadjustment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FailedStockAdjustmentDeleteOne{builder}
+}
+
+// Query returns a query builder for FailedStockAdjustment.
+func (c *FailedStockAdjustmentClient) Query() *FailedStockAdjustmentQuery {
+	return &FailedStockAdjustmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFailedStockAdjustment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FailedStockAdjustment entity by its id.
+func (c *FailedStockAdjustmentClient) Get(ctx context.Context, id uuid.UUID) (*FailedStockAdjustment, error) {
+	return c.Query().Where(failedstockadjustment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FailedStockAdjustmentClient) GetX(ctx context.Context, id uuid.UUID) *FailedStockAdjustment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FailedStockAdjustmentClient) Hooks() []Hook {
+	return c.hooks.FailedStockAdjustment
+}
+
+// Interceptors returns the client interceptors.
+func (c *FailedStockAdjustmentClient) Interceptors() []Interceptor {
+	return c.inters.FailedStockAdjustment
+}
+
+func (c *FailedStockAdjustmentClient) mutate(ctx context.Context, m *FailedStockAdjustmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FailedStockAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FailedStockAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FailedStockAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FailedStockAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FailedStockAdjustment mutation op: %q", m.Op())
+	}
+}
+
+// PriceTierClient is a client for the PriceTier schema.
+type PriceTierClient struct {
+	config
+}
+
+// NewPriceTierClient returns a client for the PriceTier from the given config.
+func NewPriceTierClient(c config) *PriceTierClient {
+	return &PriceTierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricetier.Hooks(f(g(h())))`.
+func (c *PriceTierClient) Use(hooks ...Hook) {
+	c.hooks.PriceTier = append(c.hooks.PriceTier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pricetier.Intercept(f(g(h())))`.
+func (c *PriceTierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PriceTier = append(c.inters.PriceTier, interceptors...)
+}
+
+// Create returns a builder for creating a PriceTier entity.
+func (c *PriceTierClient) Create() *PriceTierCreate {
+	mutation := newPriceTierMutation(c.config, OpCreate)
+	return &PriceTierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PriceTier entities.
+func (c *PriceTierClient) CreateBulk(builders ...*PriceTierCreate) *PriceTierCreateBulk {
+	return &PriceTierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PriceTierClient) MapCreateBulk(slice any, setFunc func(*PriceTierCreate, int)) *PriceTierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PriceTierCreateBulk{err: fmt.Errorf("calling to PriceTierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PriceTierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PriceTierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PriceTier.
+func (c *PriceTierClient) Update() *PriceTierUpdate {
+	mutation := newPriceTierMutation(c.config, OpUpdate)
+	return &PriceTierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PriceTierClient) UpdateOne(pt *PriceTier) *PriceTierUpdateOne {
+	mutation := newPriceTierMutation(c.config, OpUpdateOne, withPriceTier(pt))
+	return &PriceTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PriceTierClient) UpdateOneID(id uuid.UUID) *PriceTierUpdateOne {
+	mutation := newPriceTierMutation(c.config, OpUpdateOne, withPriceTierID(id))
+	return &PriceTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PriceTier.
+func (c *PriceTierClient) Delete() *PriceTierDelete {
+	mutation := newPriceTierMutation(c.config, OpDelete)
+	return &PriceTierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PriceTierClient) DeleteOne(pt *PriceTier) *PriceTierDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PriceTierClient) DeleteOneID(id uuid.UUID) *PriceTierDeleteOne {
+	builder := c.Delete().Where(pricetier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PriceTierDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceTier.
+func (c *PriceTierClient) Query() *PriceTierQuery {
+	return &PriceTierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePriceTier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PriceTier entity by its id.
+func (c *PriceTierClient) Get(ctx context.Context, id uuid.UUID) (*PriceTier, error) {
+	return c.Query().Where(pricetier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PriceTierClient) GetX(ctx context.Context, id uuid.UUID) *PriceTier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a PriceTier.
+func (c *PriceTierClient) QueryProduct(pt *PriceTier) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricetier.Table, pricetier.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricetier.ProductTable, pricetier.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PriceTierClient) Hooks() []Hook {
+	return c.hooks.PriceTier
+}
+
+// Interceptors returns the client interceptors.
+func (c *PriceTierClient) Interceptors() []Interceptor {
+	return c.inters.PriceTier
+}
+
+func (c *PriceTierClient) mutate(ctx context.Context, m *PriceTierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PriceTierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PriceTierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PriceTierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PriceTierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PriceTier mutation op: %q", m.Op())
+	}
+}
+
 // ProductClient is a client for the Product schema.
 type ProductClient struct {
 	config
@@ -492,6 +820,38 @@ func (c *ProductClient) QuerySubcategory(pr *Product) *SubCategoryQuery {
 	return query
 }
 
+// QueryPriceTiers queries the price_tiers edge of a Product.
+func (c *ProductClient) QueryPriceTiers(pr *Product) *PriceTierQuery {
+	query := (&PriceTierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(pricetier.Table, pricetier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.PriceTiersTable, product.PriceTiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviews queries the reviews edge of a Product.
+func (c *ProductClient) QueryReviews(pr *Product) *ReviewQuery {
+	query := (&ReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.ReviewsTable, product.ReviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProductClient) Hooks() []Hook {
 	return c.hooks.Product
@@ -517,6 +877,421 @@ func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value,
 	}
 }
 
+// ReviewClient is a client for the Review schema.
+type ReviewClient struct {
+	config
+}
+
+// NewReviewClient returns a client for the Review from the given config.
+func NewReviewClient(c config) *ReviewClient {
+	return &ReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `review.Hooks(f(g(h())))`.
+func (c *ReviewClient) Use(hooks ...Hook) {
+	c.hooks.Review = append(c.hooks.Review, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `review.Intercept(f(g(h())))`.
+func (c *ReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Review = append(c.inters.Review, interceptors...)
+}
+
+// Create returns a builder for creating a Review entity.
+func (c *ReviewClient) Create() *ReviewCreate {
+	mutation := newReviewMutation(c.config, OpCreate)
+	return &ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Review entities.
+func (c *ReviewClient) CreateBulk(builders ...*ReviewCreate) *ReviewCreateBulk {
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewClient) MapCreateBulk(slice any, setFunc func(*ReviewCreate, int)) *ReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewCreateBulk{err: fmt.Errorf("calling to ReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Review.
+func (c *ReviewClient) Update() *ReviewUpdate {
+	mutation := newReviewMutation(c.config, OpUpdate)
+	return &ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewClient) UpdateOne(r *Review) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReview(r))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewClient) UpdateOneID(id uuid.UUID) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReviewID(id))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Review.
+func (c *ReviewClient) Delete() *ReviewDelete {
+	mutation := newReviewMutation(c.config, OpDelete)
+	return &ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewClient) DeleteOne(r *Review) *ReviewDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewClient) DeleteOneID(id uuid.UUID) *ReviewDeleteOne {
+	builder := c.Delete().Where(review.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for Review.
+func (c *ReviewClient) Query() *ReviewQuery {
+	return &ReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Review entity by its id.
+func (c *ReviewClient) Get(ctx context.Context, id uuid.UUID) (*Review, error) {
+	return c.Query().Where(review.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewClient) GetX(ctx context.Context, id uuid.UUID) *Review {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a Review.
+func (c *ReviewClient) QueryProduct(r *Review) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.ProductTable, review.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewClient) Hooks() []Hook {
+	return c.hooks.Review
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewClient) Interceptors() []Interceptor {
+	return c.inters.Review
+}
+
+func (c *ReviewClient) mutate(ctx context.Context, m *ReviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Review mutation op: %q", m.Op())
+	}
+}
+
+// StockDeductionClient is a client for the StockDeduction schema.
+type StockDeductionClient struct {
+	config
+}
+
+// NewStockDeductionClient returns a client for the StockDeduction from the given config.
+func NewStockDeductionClient(c config) *StockDeductionClient {
+	return &StockDeductionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockdeduction.Hooks(f(g(h())))`.
+func (c *StockDeductionClient) Use(hooks ...Hook) {
+	c.hooks.StockDeduction = append(c.hooks.StockDeduction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stockdeduction.Intercept(f(g(h())))`.
+func (c *StockDeductionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockDeduction = append(c.inters.StockDeduction, interceptors...)
+}
+
+// Create returns a builder for creating a StockDeduction entity.
+func (c *StockDeductionClient) Create() *StockDeductionCreate {
+	mutation := newStockDeductionMutation(c.config, OpCreate)
+	return &StockDeductionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockDeduction entities.
+func (c *StockDeductionClient) CreateBulk(builders ...*StockDeductionCreate) *StockDeductionCreateBulk {
+	return &StockDeductionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StockDeductionClient) MapCreateBulk(slice any, setFunc func(*StockDeductionCreate, int)) *StockDeductionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StockDeductionCreateBulk{err: fmt.Errorf("calling to StockDeductionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StockDeductionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StockDeductionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockDeduction.
+func (c *StockDeductionClient) Update() *StockDeductionUpdate {
+	mutation := newStockDeductionMutation(c.config, OpUpdate)
+	return &StockDeductionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockDeductionClient) UpdateOne(sd *StockDeduction) *StockDeductionUpdateOne {
+	mutation := newStockDeductionMutation(c.config, OpUpdateOne, withStockDeduction(sd))
+	return &StockDeductionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockDeductionClient) UpdateOneID(id uuid.UUID) *StockDeductionUpdateOne {
+	mutation := newStockDeductionMutation(c.config, OpUpdateOne, withStockDeductionID(id))
+	return &StockDeductionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockDeduction.
+func (c *StockDeductionClient) Delete() *StockDeductionDelete {
+	mutation := newStockDeductionMutation(c.config, OpDelete)
+	return &StockDeductionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockDeductionClient) DeleteOne(sd *StockDeduction) *StockDeductionDeleteOne {
+	return c.DeleteOneID(sd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StockDeductionClient) DeleteOneID(id uuid.UUID) *StockDeductionDeleteOne {
+	builder := c.Delete().Where(stockdeduction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockDeductionDeleteOne{builder}
+}
+
+// Query returns a query builder for StockDeduction.
+func (c *StockDeductionClient) Query() *StockDeductionQuery {
+	return &StockDeductionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStockDeduction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StockDeduction entity by its id.
+func (c *StockDeductionClient) Get(ctx context.Context, id uuid.UUID) (*StockDeduction, error) {
+	return c.Query().Where(stockdeduction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockDeductionClient) GetX(ctx context.Context, id uuid.UUID) *StockDeduction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StockDeductionClient) Hooks() []Hook {
+	return c.hooks.StockDeduction
+}
+
+// Interceptors returns the client interceptors.
+func (c *StockDeductionClient) Interceptors() []Interceptor {
+	return c.inters.StockDeduction
+}
+
+func (c *StockDeductionClient) mutate(ctx context.Context, m *StockDeductionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StockDeductionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StockDeductionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StockDeductionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StockDeductionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StockDeduction mutation op: %q", m.Op())
+	}
+}
+
+// StockRestockClient is a client for the StockRestock schema.
+type StockRestockClient struct {
+	config
+}
+
+// NewStockRestockClient returns a client for the StockRestock from the given config.
+func NewStockRestockClient(c config) *StockRestockClient {
+	return &StockRestockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockrestock.Hooks(f(g(h())))`.
+func (c *StockRestockClient) Use(hooks ...Hook) {
+	c.hooks.StockRestock = append(c.hooks.StockRestock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stockrestock.Intercept(f(g(h())))`.
+func (c *StockRestockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockRestock = append(c.inters.StockRestock, interceptors...)
+}
+
+// Create returns a builder for creating a StockRestock entity.
+func (c *StockRestockClient) Create() *StockRestockCreate {
+	mutation := newStockRestockMutation(c.config, OpCreate)
+	return &StockRestockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockRestock entities.
+func (c *StockRestockClient) CreateBulk(builders ...*StockRestockCreate) *StockRestockCreateBulk {
+	return &StockRestockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StockRestockClient) MapCreateBulk(slice any, setFunc func(*StockRestockCreate, int)) *StockRestockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StockRestockCreateBulk{err: fmt.Errorf("calling to StockRestockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StockRestockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StockRestockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockRestock.
+func (c *StockRestockClient) Update() *StockRestockUpdate {
+	mutation := newStockRestockMutation(c.config, OpUpdate)
+	return &StockRestockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockRestockClient) UpdateOne(sr *StockRestock) *StockRestockUpdateOne {
+	mutation := newStockRestockMutation(c.config, OpUpdateOne, withStockRestock(sr))
+	return &StockRestockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockRestockClient) UpdateOneID(id uuid.UUID) *StockRestockUpdateOne {
+	mutation := newStockRestockMutation(c.config, OpUpdateOne, withStockRestockID(id))
+	return &StockRestockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockRestock.
+func (c *StockRestockClient) Delete() *StockRestockDelete {
+	mutation := newStockRestockMutation(c.config, OpDelete)
+	return &StockRestockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockRestockClient) DeleteOne(sr *StockRestock) *StockRestockDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StockRestockClient) DeleteOneID(id uuid.UUID) *StockRestockDeleteOne {
+	builder := c.Delete().Where(stockrestock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockRestockDeleteOne{builder}
+}
+
+// Query returns a query builder for StockRestock.
+func (c *StockRestockClient) Query() *StockRestockQuery {
+	return &StockRestockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStockRestock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StockRestock entity by its id.
+func (c *StockRestockClient) Get(ctx context.Context, id uuid.UUID) (*StockRestock, error) {
+	return c.Query().Where(stockrestock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockRestockClient) GetX(ctx context.Context, id uuid.UUID) *StockRestock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StockRestockClient) Hooks() []Hook {
+	return c.hooks.StockRestock
+}
+
+// Interceptors returns the client interceptors.
+func (c *StockRestockClient) Interceptors() []Interceptor {
+	return c.inters.StockRestock
+}
+
+func (c *StockRestockClient) mutate(ctx context.Context, m *StockRestockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StockRestockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StockRestockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StockRestockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StockRestockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StockRestock mutation op: %q", m.Op())
+	}
+}
+
 // SubCategoryClient is a client for the SubCategory schema.
 type SubCategoryClient struct {
 	config
@@ -633,7 +1408,7 @@ func (c *SubCategoryClient) QueryCategory(sc *SubCategory) *CategoryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(subcategory.Table, subcategory.FieldID, id),
 			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, subcategory.CategoryTable, subcategory.CategoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, subcategory.CategoryTable, subcategory.CategoryColumn),
 		)
 		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
 		return fromV, nil
@@ -685,9 +1460,11 @@ func (c *SubCategoryClient) mutate(ctx context.Context, m *SubCategoryMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Category, Product, SubCategory []ent.Hook
+		Category, FailedStockAdjustment, PriceTier, Product, Review, StockDeduction,
+		StockRestock, SubCategory []ent.Hook
 	}
 	inters struct {
-		Category, Product, SubCategory []ent.Interceptor
+		Category, FailedStockAdjustment, PriceTier, Product, Review, StockDeduction,
+		StockRestock, SubCategory []ent.Interceptor
 	}
 )
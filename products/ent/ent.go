@@ -7,7 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"products/ent/category"
+	"products/ent/failedstockadjustment"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
+	"products/ent/stockdeduction"
+	"products/ent/stockrestock"
 	"products/ent/subcategory"
 	"reflect"
 	"sync"
@@ -75,9 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			category.Table:    category.ValidColumn,
-			product.Table:     product.ValidColumn,
-			subcategory.Table: subcategory.ValidColumn,
+			category.Table:              category.ValidColumn,
+			failedstockadjustment.Table: failedstockadjustment.ValidColumn,
+			pricetier.Table:             pricetier.ValidColumn,
+			product.Table:               product.ValidColumn,
+			review.Table:                review.ValidColumn,
+			stockdeduction.Table:        stockdeduction.ValidColumn,
+			stockrestock.Table:          stockrestock.ValidColumn,
+			subcategory.Table:           subcategory.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
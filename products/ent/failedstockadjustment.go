@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"products/ent/failedstockadjustment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// FailedStockAdjustment is the model entity for the FailedStockAdjustment schema.
+type FailedStockAdjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Order whose item was not deducted
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// Product id as received in the event, which may not be a valid UUID
+	ProductID string `json:"product_id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Why the last attempt failed
+	Reason string `json:"reason,omitempty"`
+	// Number of deduction attempts, including the original event
+	Attempts int `json:"attempts,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Set once the stock is deducted, or the order was cancelled and nothing is owed
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FailedStockAdjustment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case failedstockadjustment.FieldQuantity, failedstockadjustment.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case failedstockadjustment.FieldProductID, failedstockadjustment.FieldReason:
+			values[i] = new(sql.NullString)
+		case failedstockadjustment.FieldCreatedAt, failedstockadjustment.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case failedstockadjustment.FieldID, failedstockadjustment.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FailedStockAdjustment fields.
+func (fsa *FailedStockAdjustment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case failedstockadjustment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				fsa.ID = *value
+			}
+		case failedstockadjustment.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				fsa.OrderID = *value
+			}
+		case failedstockadjustment.FieldProductID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				fsa.ProductID = value.String
+			}
+		case failedstockadjustment.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				fsa.Quantity = int(value.Int64)
+			}
+		case failedstockadjustment.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				fsa.Reason = value.String
+			}
+		case failedstockadjustment.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				fsa.Attempts = int(value.Int64)
+			}
+		case failedstockadjustment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fsa.CreatedAt = value.Time
+			}
+		case failedstockadjustment.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				fsa.ResolvedAt = new(time.Time)
+				*fsa.ResolvedAt = value.Time
+			}
+		default:
+			fsa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FailedStockAdjustment.
+// This includes values selected through modifiers, order, etc.
+func (fsa *FailedStockAdjustment) Value(name string) (ent.Value, error) {
+	return fsa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FailedStockAdjustment.
+// Note that you need to call FailedStockAdjustment.Unwrap() before calling this method if this FailedStockAdjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fsa *FailedStockAdjustment) Update() *FailedStockAdjustmentUpdateOne {
+	return NewFailedStockAdjustmentClient(fsa.config).UpdateOne(fsa)
+}
+
+// Unwrap unwraps the FailedStockAdjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fsa *FailedStockAdjustment) Unwrap() *FailedStockAdjustment {
+	_tx, ok := fsa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FailedStockAdjustment is not a transactional entity")
+	}
+	fsa.config.driver = _tx.drv
+	return fsa
+}
+
+// String implements the fmt.Stringer.
+func (fsa *FailedStockAdjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("FailedStockAdjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fsa.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", fsa.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fsa.ProductID)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", fsa.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fsa.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", fsa.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fsa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fsa.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FailedStockAdjustments is a parsable slice of FailedStockAdjustment.
+type FailedStockAdjustments []*FailedStockAdjustment
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package failedstockadjustment
+
+import (
+	"products/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldID, id))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldOrderID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldProductID, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldReason, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldAttempts, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v uuid.UUID) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldOrderID, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldProductID, v))
+}
+
+// ProductIDContains applies the Contains predicate on the "product_id" field.
+func ProductIDContains(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldContains(FieldProductID, v))
+}
+
+// ProductIDHasPrefix applies the HasPrefix predicate on the "product_id" field.
+func ProductIDHasPrefix(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldHasPrefix(FieldProductID, v))
+}
+
+// ProductIDHasSuffix applies the HasSuffix predicate on the "product_id" field.
+func ProductIDHasSuffix(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldHasSuffix(FieldProductID, v))
+}
+
+// ProductIDEqualFold applies the EqualFold predicate on the "product_id" field.
+func ProductIDEqualFold(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEqualFold(FieldProductID, v))
+}
+
+// ProductIDContainsFold applies the ContainsFold predicate on the "product_id" field.
+func ProductIDContainsFold(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldContainsFold(FieldProductID, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldQuantity, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldContainsFold(FieldReason, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldAttempts, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FailedStockAdjustment) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FailedStockAdjustment) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FailedStockAdjustment) predicate.FailedStockAdjustment {
+	return predicate.FailedStockAdjustment(sql.NotPredicates(p))
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/failedstockadjustment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FailedStockAdjustmentCreate is the builder for creating a FailedStockAdjustment entity.
+type FailedStockAdjustmentCreate struct {
+	config
+	mutation *FailedStockAdjustmentMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (fsac *FailedStockAdjustmentCreate) SetOrderID(u uuid.UUID) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetOrderID(u)
+	return fsac
+}
+
+// SetProductID sets the "product_id" field.
+func (fsac *FailedStockAdjustmentCreate) SetProductID(s string) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetProductID(s)
+	return fsac
+}
+
+// SetQuantity sets the "quantity" field.
+func (fsac *FailedStockAdjustmentCreate) SetQuantity(i int) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetQuantity(i)
+	return fsac
+}
+
+// SetReason sets the "reason" field.
+func (fsac *FailedStockAdjustmentCreate) SetReason(s string) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetReason(s)
+	return fsac
+}
+
+// SetAttempts sets the "attempts" field.
+func (fsac *FailedStockAdjustmentCreate) SetAttempts(i int) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetAttempts(i)
+	return fsac
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (fsac *FailedStockAdjustmentCreate) SetNillableAttempts(i *int) *FailedStockAdjustmentCreate {
+	if i != nil {
+		fsac.SetAttempts(*i)
+	}
+	return fsac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fsac *FailedStockAdjustmentCreate) SetCreatedAt(t time.Time) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetCreatedAt(t)
+	return fsac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fsac *FailedStockAdjustmentCreate) SetNillableCreatedAt(t *time.Time) *FailedStockAdjustmentCreate {
+	if t != nil {
+		fsac.SetCreatedAt(*t)
+	}
+	return fsac
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (fsac *FailedStockAdjustmentCreate) SetResolvedAt(t time.Time) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetResolvedAt(t)
+	return fsac
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (fsac *FailedStockAdjustmentCreate) SetNillableResolvedAt(t *time.Time) *FailedStockAdjustmentCreate {
+	if t != nil {
+		fsac.SetResolvedAt(*t)
+	}
+	return fsac
+}
+
+// SetID sets the "id" field.
+func (fsac *FailedStockAdjustmentCreate) SetID(u uuid.UUID) *FailedStockAdjustmentCreate {
+	fsac.mutation.SetID(u)
+	return fsac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fsac *FailedStockAdjustmentCreate) SetNillableID(u *uuid.UUID) *FailedStockAdjustmentCreate {
+	if u != nil {
+		fsac.SetID(*u)
+	}
+	return fsac
+}
+
+// Mutation returns the FailedStockAdjustmentMutation object of the builder.
+func (fsac *FailedStockAdjustmentCreate) Mutation() *FailedStockAdjustmentMutation {
+	return fsac.mutation
+}
+
+// Save creates the FailedStockAdjustment in the database.
+func (fsac *FailedStockAdjustmentCreate) Save(ctx context.Context) (*FailedStockAdjustment, error) {
+	fsac.defaults()
+	return withHooks(ctx, fsac.sqlSave, fsac.mutation, fsac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fsac *FailedStockAdjustmentCreate) SaveX(ctx context.Context) *FailedStockAdjustment {
+	v, err := fsac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fsac *FailedStockAdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := fsac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsac *FailedStockAdjustmentCreate) ExecX(ctx context.Context) {
+	if err := fsac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsac *FailedStockAdjustmentCreate) defaults() {
+	if _, ok := fsac.mutation.Attempts(); !ok {
+		v := failedstockadjustment.DefaultAttempts
+		fsac.mutation.SetAttempts(v)
+	}
+	if _, ok := fsac.mutation.CreatedAt(); !ok {
+		v := failedstockadjustment.DefaultCreatedAt()
+		fsac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fsac.mutation.ID(); !ok {
+		v := failedstockadjustment.DefaultID()
+		fsac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsac *FailedStockAdjustmentCreate) check() error {
+	if _, ok := fsac.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "FailedStockAdjustment.order_id"`)}
+	}
+	if _, ok := fsac.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "FailedStockAdjustment.product_id"`)}
+	}
+	if _, ok := fsac.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "FailedStockAdjustment.quantity"`)}
+	}
+	if _, ok := fsac.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "FailedStockAdjustment.reason"`)}
+	}
+	if _, ok := fsac.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "FailedStockAdjustment.attempts"`)}
+	}
+	if _, ok := fsac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FailedStockAdjustment.created_at"`)}
+	}
+	return nil
+}
+
+func (fsac *FailedStockAdjustmentCreate) sqlSave(ctx context.Context) (*FailedStockAdjustment, error) {
+	if err := fsac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fsac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fsac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	fsac.mutation.id = &_node.ID
+	fsac.mutation.done = true
+	return _node, nil
+}
+
+func (fsac *FailedStockAdjustmentCreate) createSpec() (*FailedStockAdjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FailedStockAdjustment{config: fsac.config}
+		_spec = sqlgraph.NewCreateSpec(failedstockadjustment.Table, sqlgraph.NewFieldSpec(failedstockadjustment.FieldID, field.TypeUUID))
+	)
+	if id, ok := fsac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := fsac.mutation.OrderID(); ok {
+		_spec.SetField(failedstockadjustment.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := fsac.mutation.ProductID(); ok {
+		_spec.SetField(failedstockadjustment.FieldProductID, field.TypeString, value)
+		_node.ProductID = value
+	}
+	if value, ok := fsac.mutation.Quantity(); ok {
+		_spec.SetField(failedstockadjustment.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := fsac.mutation.Reason(); ok {
+		_spec.SetField(failedstockadjustment.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := fsac.mutation.Attempts(); ok {
+		_spec.SetField(failedstockadjustment.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := fsac.mutation.CreatedAt(); ok {
+		_spec.SetField(failedstockadjustment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fsac.mutation.ResolvedAt(); ok {
+		_spec.SetField(failedstockadjustment.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	return _node, _spec
+}
+
+// FailedStockAdjustmentCreateBulk is the builder for creating many FailedStockAdjustment entities in bulk.
+type FailedStockAdjustmentCreateBulk struct {
+	config
+	err      error
+	builders []*FailedStockAdjustmentCreate
+}
+
+// Save creates the FailedStockAdjustment entities in the database.
+func (fsacb *FailedStockAdjustmentCreateBulk) Save(ctx context.Context) ([]*FailedStockAdjustment, error) {
+	if fsacb.err != nil {
+		return nil, fsacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fsacb.builders))
+	nodes := make([]*FailedStockAdjustment, len(fsacb.builders))
+	mutators := make([]Mutator, len(fsacb.builders))
+	for i := range fsacb.builders {
+		func(i int, root context.Context) {
+			builder := fsacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FailedStockAdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fsacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fsacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fsacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsacb *FailedStockAdjustmentCreateBulk) SaveX(ctx context.Context) []*FailedStockAdjustment {
+	v, err := fsacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fsacb *FailedStockAdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := fsacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsacb *FailedStockAdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := fsacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
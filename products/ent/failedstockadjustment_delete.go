@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"products/ent/failedstockadjustment"
+	"products/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FailedStockAdjustmentDelete is the builder for deleting a FailedStockAdjustment entity.
+type FailedStockAdjustmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *FailedStockAdjustmentMutation
+}
+
+// Where appends a list predicates to the FailedStockAdjustmentDelete builder.
+func (fsad *FailedStockAdjustmentDelete) Where(ps ...predicate.FailedStockAdjustment) *FailedStockAdjustmentDelete {
+	fsad.mutation.Where(ps...)
+	return fsad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fsad *FailedStockAdjustmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fsad.sqlExec, fsad.mutation, fsad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsad *FailedStockAdjustmentDelete) ExecX(ctx context.Context) int {
+	n, err := fsad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fsad *FailedStockAdjustmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(failedstockadjustment.Table, sqlgraph.NewFieldSpec(failedstockadjustment.FieldID, field.TypeUUID))
+	if ps := fsad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fsad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fsad.mutation.done = true
+	return affected, err
+}
+
+// FailedStockAdjustmentDeleteOne is the builder for deleting a single FailedStockAdjustment entity.
+type FailedStockAdjustmentDeleteOne struct {
+	fsad *FailedStockAdjustmentDelete
+}
+
+// Where appends a list predicates to the FailedStockAdjustmentDelete builder.
+func (fsado *FailedStockAdjustmentDeleteOne) Where(ps ...predicate.FailedStockAdjustment) *FailedStockAdjustmentDeleteOne {
+	fsado.fsad.mutation.Where(ps...)
+	return fsado
+}
+
+// Exec executes the deletion query.
+func (fsado *FailedStockAdjustmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := fsado.fsad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{failedstockadjustment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsado *FailedStockAdjustmentDeleteOne) ExecX(ctx context.Context) {
+	if err := fsado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
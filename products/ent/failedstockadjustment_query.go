@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"products/ent/failedstockadjustment"
+	"products/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FailedStockAdjustmentQuery is the builder for querying FailedStockAdjustment entities.
+type FailedStockAdjustmentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []failedstockadjustment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FailedStockAdjustment
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FailedStockAdjustmentQuery builder.
+func (fsaq *FailedStockAdjustmentQuery) Where(ps ...predicate.FailedStockAdjustment) *FailedStockAdjustmentQuery {
+	fsaq.predicates = append(fsaq.predicates, ps...)
+	return fsaq
+}
+
+// Limit the number of records to be returned by this query.
+func (fsaq *FailedStockAdjustmentQuery) Limit(limit int) *FailedStockAdjustmentQuery {
+	fsaq.ctx.Limit = &limit
+	return fsaq
+}
+
+// Offset to start from.
+func (fsaq *FailedStockAdjustmentQuery) Offset(offset int) *FailedStockAdjustmentQuery {
+	fsaq.ctx.Offset = &offset
+	return fsaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fsaq *FailedStockAdjustmentQuery) Unique(unique bool) *FailedStockAdjustmentQuery {
+	fsaq.ctx.Unique = &unique
+	return fsaq
+}
+
+// Order specifies how the records should be ordered.
+func (fsaq *FailedStockAdjustmentQuery) Order(o ...failedstockadjustment.OrderOption) *FailedStockAdjustmentQuery {
+	fsaq.order = append(fsaq.order, o...)
+	return fsaq
+}
+
+// First returns the first FailedStockAdjustment entity from the query.
+// Returns a *NotFoundError when no FailedStockAdjustment was found.
+func (fsaq *FailedStockAdjustmentQuery) First(ctx context.Context) (*FailedStockAdjustment, error) {
+	nodes, err := fsaq.Limit(1).All(setContextOp(ctx, fsaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{failedstockadjustment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) FirstX(ctx context.Context) *FailedStockAdjustment {
+	node, err := fsaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FailedStockAdjustment ID from the query.
+// Returns a *NotFoundError when no FailedStockAdjustment ID was found.
+func (fsaq *FailedStockAdjustmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fsaq.Limit(1).IDs(setContextOp(ctx, fsaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{failedstockadjustment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := fsaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FailedStockAdjustment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FailedStockAdjustment entity is found.
+// Returns a *NotFoundError when no FailedStockAdjustment entities are found.
+func (fsaq *FailedStockAdjustmentQuery) Only(ctx context.Context) (*FailedStockAdjustment, error) {
+	nodes, err := fsaq.Limit(2).All(setContextOp(ctx, fsaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{failedstockadjustment.Label}
+	default:
+		return nil, &NotSingularError{failedstockadjustment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) OnlyX(ctx context.Context) *FailedStockAdjustment {
+	node, err := fsaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FailedStockAdjustment ID in the query.
+// Returns a *NotSingularError when more than one FailedStockAdjustment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fsaq *FailedStockAdjustmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fsaq.Limit(2).IDs(setContextOp(ctx, fsaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{failedstockadjustment.Label}
+	default:
+		err = &NotSingularError{failedstockadjustment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := fsaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FailedStockAdjustments.
+func (fsaq *FailedStockAdjustmentQuery) All(ctx context.Context) ([]*FailedStockAdjustment, error) {
+	ctx = setContextOp(ctx, fsaq.ctx, ent.OpQueryAll)
+	if err := fsaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FailedStockAdjustment, *FailedStockAdjustmentQuery]()
+	return withInterceptors[[]*FailedStockAdjustment](ctx, fsaq, qr, fsaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) AllX(ctx context.Context) []*FailedStockAdjustment {
+	nodes, err := fsaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FailedStockAdjustment IDs.
+func (fsaq *FailedStockAdjustmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if fsaq.ctx.Unique == nil && fsaq.path != nil {
+		fsaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fsaq.ctx, ent.OpQueryIDs)
+	if err = fsaq.Select(failedstockadjustment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := fsaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fsaq *FailedStockAdjustmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fsaq.ctx, ent.OpQueryCount)
+	if err := fsaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fsaq, querierCount[*FailedStockAdjustmentQuery](), fsaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) CountX(ctx context.Context) int {
+	count, err := fsaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fsaq *FailedStockAdjustmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fsaq.ctx, ent.OpQueryExist)
+	switch _, err := fsaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fsaq *FailedStockAdjustmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := fsaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FailedStockAdjustmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fsaq *FailedStockAdjustmentQuery) Clone() *FailedStockAdjustmentQuery {
+	if fsaq == nil {
+		return nil
+	}
+	return &FailedStockAdjustmentQuery{
+		config:     fsaq.config,
+		ctx:        fsaq.ctx.Clone(),
+		order:      append([]failedstockadjustment.OrderOption{}, fsaq.order...),
+		inters:     append([]Interceptor{}, fsaq.inters...),
+		predicates: append([]predicate.FailedStockAdjustment{}, fsaq.predicates...),
+		// clone intermediate query.
+		sql:  fsaq.sql.Clone(),
+		path: fsaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FailedStockAdjustment.Query().
+//		GroupBy(failedstockadjustment.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fsaq *FailedStockAdjustmentQuery) GroupBy(field string, fields ...string) *FailedStockAdjustmentGroupBy {
+	fsaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FailedStockAdjustmentGroupBy{build: fsaq}
+	grbuild.flds = &fsaq.ctx.Fields
+	grbuild.label = failedstockadjustment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//	}
+//
+//	client.FailedStockAdjustment.Query().
+//		Select(failedstockadjustment.FieldOrderID).
+//		Scan(ctx, &v)
+func (fsaq *FailedStockAdjustmentQuery) Select(fields ...string) *FailedStockAdjustmentSelect {
+	fsaq.ctx.Fields = append(fsaq.ctx.Fields, fields...)
+	sbuild := &FailedStockAdjustmentSelect{FailedStockAdjustmentQuery: fsaq}
+	sbuild.label = failedstockadjustment.Label
+	sbuild.flds, sbuild.scan = &fsaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FailedStockAdjustmentSelect configured with the given aggregations.
+func (fsaq *FailedStockAdjustmentQuery) Aggregate(fns ...AggregateFunc) *FailedStockAdjustmentSelect {
+	return fsaq.Select().Aggregate(fns...)
+}
+
+func (fsaq *FailedStockAdjustmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fsaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fsaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fsaq.ctx.Fields {
+		if !failedstockadjustment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fsaq.path != nil {
+		prev, err := fsaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fsaq.sql = prev
+	}
+	return nil
+}
+
+func (fsaq *FailedStockAdjustmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FailedStockAdjustment, error) {
+	var (
+		nodes = []*FailedStockAdjustment{}
+		_spec = fsaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FailedStockAdjustment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FailedStockAdjustment{config: fsaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fsaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (fsaq *FailedStockAdjustmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fsaq.querySpec()
+	_spec.Node.Columns = fsaq.ctx.Fields
+	if len(fsaq.ctx.Fields) > 0 {
+		_spec.Unique = fsaq.ctx.Unique != nil && *fsaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fsaq.driver, _spec)
+}
+
+func (fsaq *FailedStockAdjustmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(failedstockadjustment.Table, failedstockadjustment.Columns, sqlgraph.NewFieldSpec(failedstockadjustment.FieldID, field.TypeUUID))
+	_spec.From = fsaq.sql
+	if unique := fsaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fsaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fsaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, failedstockadjustment.FieldID)
+		for i := range fields {
+			if fields[i] != failedstockadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fsaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fsaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fsaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fsaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fsaq *FailedStockAdjustmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fsaq.driver.Dialect())
+	t1 := builder.Table(failedstockadjustment.Table)
+	columns := fsaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = failedstockadjustment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fsaq.sql != nil {
+		selector = fsaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fsaq.ctx.Unique != nil && *fsaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fsaq.predicates {
+		p(selector)
+	}
+	for _, p := range fsaq.order {
+		p(selector)
+	}
+	if offset := fsaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fsaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FailedStockAdjustmentGroupBy is the group-by builder for FailedStockAdjustment entities.
+type FailedStockAdjustmentGroupBy struct {
+	selector
+	build *FailedStockAdjustmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fsagb *FailedStockAdjustmentGroupBy) Aggregate(fns ...AggregateFunc) *FailedStockAdjustmentGroupBy {
+	fsagb.fns = append(fsagb.fns, fns...)
+	return fsagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fsagb *FailedStockAdjustmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fsagb.build.ctx, ent.OpQueryGroupBy)
+	if err := fsagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FailedStockAdjustmentQuery, *FailedStockAdjustmentGroupBy](ctx, fsagb.build, fsagb, fsagb.build.inters, v)
+}
+
+func (fsagb *FailedStockAdjustmentGroupBy) sqlScan(ctx context.Context, root *FailedStockAdjustmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fsagb.fns))
+	for _, fn := range fsagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fsagb.flds)+len(fsagb.fns))
+		for _, f := range *fsagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fsagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fsagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FailedStockAdjustmentSelect is the builder for selecting fields of FailedStockAdjustment entities.
+type FailedStockAdjustmentSelect struct {
+	*FailedStockAdjustmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fsas *FailedStockAdjustmentSelect) Aggregate(fns ...AggregateFunc) *FailedStockAdjustmentSelect {
+	fsas.fns = append(fsas.fns, fns...)
+	return fsas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fsas *FailedStockAdjustmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fsas.ctx, ent.OpQuerySelect)
+	if err := fsas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FailedStockAdjustmentQuery, *FailedStockAdjustmentSelect](ctx, fsas.FailedStockAdjustmentQuery, fsas, fsas.inters, v)
+}
+
+func (fsas *FailedStockAdjustmentSelect) sqlScan(ctx context.Context, root *FailedStockAdjustmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fsas.fns))
+	for _, fn := range fsas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fsas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fsas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/failedstockadjustment"
+	"products/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FailedStockAdjustmentUpdate is the builder for updating FailedStockAdjustment entities.
+type FailedStockAdjustmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FailedStockAdjustmentMutation
+}
+
+// Where appends a list predicates to the FailedStockAdjustmentUpdate builder.
+func (fsau *FailedStockAdjustmentUpdate) Where(ps ...predicate.FailedStockAdjustment) *FailedStockAdjustmentUpdate {
+	fsau.mutation.Where(ps...)
+	return fsau
+}
+
+// SetReason sets the "reason" field.
+func (fsau *FailedStockAdjustmentUpdate) SetReason(s string) *FailedStockAdjustmentUpdate {
+	fsau.mutation.SetReason(s)
+	return fsau
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (fsau *FailedStockAdjustmentUpdate) SetNillableReason(s *string) *FailedStockAdjustmentUpdate {
+	if s != nil {
+		fsau.SetReason(*s)
+	}
+	return fsau
+}
+
+// SetAttempts sets the "attempts" field.
+func (fsau *FailedStockAdjustmentUpdate) SetAttempts(i int) *FailedStockAdjustmentUpdate {
+	fsau.mutation.ResetAttempts()
+	fsau.mutation.SetAttempts(i)
+	return fsau
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (fsau *FailedStockAdjustmentUpdate) SetNillableAttempts(i *int) *FailedStockAdjustmentUpdate {
+	if i != nil {
+		fsau.SetAttempts(*i)
+	}
+	return fsau
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (fsau *FailedStockAdjustmentUpdate) AddAttempts(i int) *FailedStockAdjustmentUpdate {
+	fsau.mutation.AddAttempts(i)
+	return fsau
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (fsau *FailedStockAdjustmentUpdate) SetResolvedAt(t time.Time) *FailedStockAdjustmentUpdate {
+	fsau.mutation.SetResolvedAt(t)
+	return fsau
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (fsau *FailedStockAdjustmentUpdate) SetNillableResolvedAt(t *time.Time) *FailedStockAdjustmentUpdate {
+	if t != nil {
+		fsau.SetResolvedAt(*t)
+	}
+	return fsau
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (fsau *FailedStockAdjustmentUpdate) ClearResolvedAt() *FailedStockAdjustmentUpdate {
+	fsau.mutation.ClearResolvedAt()
+	return fsau
+}
+
+// Mutation returns the FailedStockAdjustmentMutation object of the builder.
+func (fsau *FailedStockAdjustmentUpdate) Mutation() *FailedStockAdjustmentMutation {
+	return fsau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fsau *FailedStockAdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fsau.sqlSave, fsau.mutation, fsau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsau *FailedStockAdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := fsau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fsau *FailedStockAdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := fsau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsau *FailedStockAdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := fsau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fsau *FailedStockAdjustmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(failedstockadjustment.Table, failedstockadjustment.Columns, sqlgraph.NewFieldSpec(failedstockadjustment.FieldID, field.TypeUUID))
+	if ps := fsau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsau.mutation.Reason(); ok {
+		_spec.SetField(failedstockadjustment.FieldReason, field.TypeString, value)
+	}
+	if value, ok := fsau.mutation.Attempts(); ok {
+		_spec.SetField(failedstockadjustment.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := fsau.mutation.AddedAttempts(); ok {
+		_spec.AddField(failedstockadjustment.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := fsau.mutation.ResolvedAt(); ok {
+		_spec.SetField(failedstockadjustment.FieldResolvedAt, field.TypeTime, value)
+	}
+	if fsau.mutation.ResolvedAtCleared() {
+		_spec.ClearField(failedstockadjustment.FieldResolvedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fsau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{failedstockadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fsau.mutation.done = true
+	return n, nil
+}
+
+// FailedStockAdjustmentUpdateOne is the builder for updating a single FailedStockAdjustment entity.
+type FailedStockAdjustmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FailedStockAdjustmentMutation
+}
+
+// SetReason sets the "reason" field.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetReason(s string) *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.SetReason(s)
+	return fsauo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetNillableReason(s *string) *FailedStockAdjustmentUpdateOne {
+	if s != nil {
+		fsauo.SetReason(*s)
+	}
+	return fsauo
+}
+
+// SetAttempts sets the "attempts" field.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetAttempts(i int) *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.ResetAttempts()
+	fsauo.mutation.SetAttempts(i)
+	return fsauo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetNillableAttempts(i *int) *FailedStockAdjustmentUpdateOne {
+	if i != nil {
+		fsauo.SetAttempts(*i)
+	}
+	return fsauo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (fsauo *FailedStockAdjustmentUpdateOne) AddAttempts(i int) *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.AddAttempts(i)
+	return fsauo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetResolvedAt(t time.Time) *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.SetResolvedAt(t)
+	return fsauo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (fsauo *FailedStockAdjustmentUpdateOne) SetNillableResolvedAt(t *time.Time) *FailedStockAdjustmentUpdateOne {
+	if t != nil {
+		fsauo.SetResolvedAt(*t)
+	}
+	return fsauo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (fsauo *FailedStockAdjustmentUpdateOne) ClearResolvedAt() *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.ClearResolvedAt()
+	return fsauo
+}
+
+// Mutation returns the FailedStockAdjustmentMutation object of the builder.
+func (fsauo *FailedStockAdjustmentUpdateOne) Mutation() *FailedStockAdjustmentMutation {
+	return fsauo.mutation
+}
+
+// Where appends a list predicates to the FailedStockAdjustmentUpdate builder.
+func (fsauo *FailedStockAdjustmentUpdateOne) Where(ps ...predicate.FailedStockAdjustment) *FailedStockAdjustmentUpdateOne {
+	fsauo.mutation.Where(ps...)
+	return fsauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fsauo *FailedStockAdjustmentUpdateOne) Select(field string, fields ...string) *FailedStockAdjustmentUpdateOne {
+	fsauo.fields = append([]string{field}, fields...)
+	return fsauo
+}
+
+// Save executes the query and returns the updated FailedStockAdjustment entity.
+func (fsauo *FailedStockAdjustmentUpdateOne) Save(ctx context.Context) (*FailedStockAdjustment, error) {
+	return withHooks(ctx, fsauo.sqlSave, fsauo.mutation, fsauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsauo *FailedStockAdjustmentUpdateOne) SaveX(ctx context.Context) *FailedStockAdjustment {
+	node, err := fsauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fsauo *FailedStockAdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := fsauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsauo *FailedStockAdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := fsauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fsauo *FailedStockAdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *FailedStockAdjustment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(failedstockadjustment.Table, failedstockadjustment.Columns, sqlgraph.NewFieldSpec(failedstockadjustment.FieldID, field.TypeUUID))
+	id, ok := fsauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FailedStockAdjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fsauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, failedstockadjustment.FieldID)
+		for _, f := range fields {
+			if !failedstockadjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != failedstockadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fsauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsauo.mutation.Reason(); ok {
+		_spec.SetField(failedstockadjustment.FieldReason, field.TypeString, value)
+	}
+	if value, ok := fsauo.mutation.Attempts(); ok {
+		_spec.SetField(failedstockadjustment.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := fsauo.mutation.AddedAttempts(); ok {
+		_spec.AddField(failedstockadjustment.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := fsauo.mutation.ResolvedAt(); ok {
+		_spec.SetField(failedstockadjustment.FieldResolvedAt, field.TypeTime, value)
+	}
+	if fsauo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(failedstockadjustment.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &FailedStockAdjustment{config: fsauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fsauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{failedstockadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fsauo.mutation.done = true
+	return _node, nil
+}
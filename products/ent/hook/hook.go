@@ -20,6 +20,30 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The FailedStockAdjustmentFunc type is an adapter to allow the use of ordinary
+// function as FailedStockAdjustment mutator.
+type FailedStockAdjustmentFunc func(context.Context, *ent.FailedStockAdjustmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FailedStockAdjustmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FailedStockAdjustmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FailedStockAdjustmentMutation", m)
+}
+
+// The PriceTierFunc type is an adapter to allow the use of ordinary
+// function as PriceTier mutator.
+type PriceTierFunc func(context.Context, *ent.PriceTierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceTierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PriceTierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceTierMutation", m)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary
 // function as Product mutator.
 type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
@@ -32,6 +56,42 @@ func (f ProductFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductMutation", m)
 }
 
+// The ReviewFunc type is an adapter to allow the use of ordinary
+// function as Review mutator.
+type ReviewFunc func(context.Context, *ent.ReviewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReviewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewMutation", m)
+}
+
+// The StockDeductionFunc type is an adapter to allow the use of ordinary
+// function as StockDeduction mutator.
+type StockDeductionFunc func(context.Context, *ent.StockDeductionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockDeductionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockDeductionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockDeductionMutation", m)
+}
+
+// The StockRestockFunc type is an adapter to allow the use of ordinary
+// function as StockRestock mutator.
+type StockRestockFunc func(context.Context, *ent.StockRestockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockRestockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockRestockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockRestockMutation", m)
+}
+
 // The SubCategoryFunc type is an adapter to allow the use of ordinary
 // function as SubCategory mutator.
 type SubCategoryFunc func(context.Context, *ent.SubCategoryMutation) (ent.Value, error)
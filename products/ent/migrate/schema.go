@@ -22,17 +22,73 @@ var (
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
 	}
+	// FailedStockAdjustmentsColumns holds the columns for the "failed_stock_adjustments" table.
+	FailedStockAdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "product_id", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// FailedStockAdjustmentsTable holds the schema information for the "failed_stock_adjustments" table.
+	FailedStockAdjustmentsTable = &schema.Table{
+		Name:       "failed_stock_adjustments",
+		Columns:    FailedStockAdjustmentsColumns,
+		PrimaryKey: []*schema.Column{FailedStockAdjustmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "failedstockadjustment_order_id_resolved_at",
+				Unique:  false,
+				Columns: []*schema.Column{FailedStockAdjustmentsColumns[1], FailedStockAdjustmentsColumns[7]},
+			},
+		},
+	}
+	// PriceTiersColumns holds the columns for the "price_tiers" table.
+	PriceTiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "min_quantity", Type: field.TypeInt},
+		{Name: "unit_price_cents", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_price_tiers", Type: field.TypeUUID},
+	}
+	// PriceTiersTable holds the schema information for the "price_tiers" table.
+	PriceTiersTable = &schema.Table{
+		Name:       "price_tiers",
+		Columns:    PriceTiersColumns,
+		PrimaryKey: []*schema.Column{PriceTiersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "price_tiers_products_price_tiers",
+				Columns:    []*schema.Column{PriceTiersColumns[4]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pricetier_min_quantity_product_price_tiers",
+				Unique:  true,
+				Columns: []*schema.Column{PriceTiersColumns[1], PriceTiersColumns[4]},
+			},
+		},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "sku", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "price_cents", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "stock_quantity", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "product_subcategory", Type: field.TypeUUID},
 	}
 	// ProductsTable holds the schema information for the "products" table.
@@ -43,12 +99,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "products_sub_categories_subcategory",
-				Columns:    []*schema.Column{ProductsColumns[9]},
+				Columns:    []*schema.Column{ProductsColumns[12]},
 				RefColumns: []*schema.Column{SubCategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// ReviewsColumns holds the columns for the "reviews" table.
+	ReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "rating", Type: field.TypeInt},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_reviews", Type: field.TypeUUID},
+	}
+	// ReviewsTable holds the schema information for the "reviews" table.
+	ReviewsTable = &schema.Table{
+		Name:       "reviews",
+		Columns:    ReviewsColumns,
+		PrimaryKey: []*schema.Column{ReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reviews_products_reviews",
+				Columns:    []*schema.Column{ReviewsColumns[5]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "review_user_id_product_reviews",
+				Unique:  true,
+				Columns: []*schema.Column{ReviewsColumns[1], ReviewsColumns[5]},
+			},
+		},
+	}
+	// StockDeductionsColumns holds the columns for the "stock_deductions" table.
+	StockDeductionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StockDeductionsTable holds the schema information for the "stock_deductions" table.
+	StockDeductionsTable = &schema.Table{
+		Name:       "stock_deductions",
+		Columns:    StockDeductionsColumns,
+		PrimaryKey: []*schema.Column{StockDeductionsColumns[0]},
+	}
+	// StockRestocksColumns holds the columns for the "stock_restocks" table.
+	StockRestocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "order_id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StockRestocksTable holds the schema information for the "stock_restocks" table.
+	StockRestocksTable = &schema.Table{
+		Name:       "stock_restocks",
+		Columns:    StockRestocksColumns,
+		PrimaryKey: []*schema.Column{StockRestocksColumns[0]},
+	}
 	// SubCategoriesColumns holds the columns for the "sub_categories" table.
 	SubCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -56,8 +166,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "category_subcategories", Type: field.TypeUUID, Nullable: true},
-		{Name: "sub_category_category", Type: field.TypeUUID},
+		{Name: "category_subcategories", Type: field.TypeUUID},
 	}
 	// SubCategoriesTable holds the schema information for the "sub_categories" table.
 	SubCategoriesTable = &schema.Table{
@@ -69,12 +178,6 @@ var (
 				Symbol:     "sub_categories_categories_subcategories",
 				Columns:    []*schema.Column{SubCategoriesColumns[5]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "sub_categories_categories_category",
-				Columns:    []*schema.Column{SubCategoriesColumns[6]},
-				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
@@ -82,13 +185,19 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CategoriesTable,
+		FailedStockAdjustmentsTable,
+		PriceTiersTable,
 		ProductsTable,
+		ReviewsTable,
+		StockDeductionsTable,
+		StockRestocksTable,
 		SubCategoriesTable,
 	}
 )
 
 func init() {
+	PriceTiersTable.ForeignKeys[0].RefTable = ProductsTable
 	ProductsTable.ForeignKeys[0].RefTable = SubCategoriesTable
+	ReviewsTable.ForeignKeys[0].RefTable = ProductsTable
 	SubCategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
-	SubCategoriesTable.ForeignKeys[1].RefTable = CategoriesTable
 }
@@ -9,8 +9,23 @@ import (
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// FailedStockAdjustment is the predicate function for failedstockadjustment builders.
+type FailedStockAdjustment func(*sql.Selector)
+
+// PriceTier is the predicate function for pricetier builders.
+type PriceTier func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
+// Review is the predicate function for review builders.
+type Review func(*sql.Selector)
+
+// StockDeduction is the predicate function for stockdeduction builders.
+type StockDeduction func(*sql.Selector)
+
+// StockRestock is the predicate function for stockrestock builders.
+type StockRestock func(*sql.Selector)
+
 // SubCategory is the predicate function for subcategory builders.
 type SubCategory func(*sql.Selector)
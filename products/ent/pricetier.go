@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"products/ent/pricetier"
+	"products/ent/product"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PriceTier is the model entity for the PriceTier schema.
+type PriceTier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Smallest quantity the tier applies to; fewer units pay the product price
+	MinQuantity int `json:"min_quantity,omitempty"`
+	// Unit price in minor units, in the product's currency
+	UnitPriceCents int64 `json:"unit_price_cents,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PriceTierQuery when eager-loading is set.
+	Edges               PriceTierEdges `json:"edges"`
+	product_price_tiers *uuid.UUID
+	selectValues        sql.SelectValues
+}
+
+// PriceTierEdges holds the relations/edges for other nodes in the graph.
+type PriceTierEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PriceTierEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceTier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricetier.FieldMinQuantity, pricetier.FieldUnitPriceCents:
+			values[i] = new(sql.NullInt64)
+		case pricetier.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pricetier.FieldID:
+			values[i] = new(uuid.UUID)
+		case pricetier.ForeignKeys[0]: // product_price_tiers
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceTier fields.
+func (pt *PriceTier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricetier.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pt.ID = *value
+			}
+		case pricetier.FieldMinQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_quantity", values[i])
+			} else if value.Valid {
+				pt.MinQuantity = int(value.Int64)
+			}
+		case pricetier.FieldUnitPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price_cents", values[i])
+			} else if value.Valid {
+				pt.UnitPriceCents = value.Int64
+			}
+		case pricetier.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pt.CreatedAt = value.Time
+			}
+		case pricetier.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field product_price_tiers", values[i])
+			} else if value.Valid {
+				pt.product_price_tiers = new(uuid.UUID)
+				*pt.product_price_tiers = *value.S.(*uuid.UUID)
+			}
+		default:
+			pt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceTier.
+// This includes values selected through modifiers, order, etc.
+func (pt *PriceTier) Value(name string) (ent.Value, error) {
+	return pt.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the PriceTier entity.
+func (pt *PriceTier) QueryProduct() *ProductQuery {
+	return NewPriceTierClient(pt.config).QueryProduct(pt)
+}
+
+// Update returns a builder for updating this PriceTier.
+// Note that you need to call PriceTier.Unwrap() before calling this method if this PriceTier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *PriceTier) Update() *PriceTierUpdateOne {
+	return NewPriceTierClient(pt.config).UpdateOne(pt)
+}
+
+// Unwrap unwraps the PriceTier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *PriceTier) Unwrap() *PriceTier {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceTier is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *PriceTier) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceTier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("min_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pt.MinQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", pt.UnitPriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceTiers is a parsable slice of PriceTier.
+type PriceTiers []*PriceTier
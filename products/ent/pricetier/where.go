@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricetier
+
+import (
+	"products/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLTE(FieldID, id))
+}
+
+// MinQuantity applies equality check predicate on the "min_quantity" field. It's identical to MinQuantityEQ.
+func MinQuantity(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldMinQuantity, v))
+}
+
+// UnitPriceCents applies equality check predicate on the "unit_price_cents" field. It's identical to UnitPriceCentsEQ.
+func UnitPriceCents(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldUnitPriceCents, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MinQuantityEQ applies the EQ predicate on the "min_quantity" field.
+func MinQuantityEQ(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldMinQuantity, v))
+}
+
+// MinQuantityNEQ applies the NEQ predicate on the "min_quantity" field.
+func MinQuantityNEQ(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNEQ(FieldMinQuantity, v))
+}
+
+// MinQuantityIn applies the In predicate on the "min_quantity" field.
+func MinQuantityIn(vs ...int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldIn(FieldMinQuantity, vs...))
+}
+
+// MinQuantityNotIn applies the NotIn predicate on the "min_quantity" field.
+func MinQuantityNotIn(vs ...int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNotIn(FieldMinQuantity, vs...))
+}
+
+// MinQuantityGT applies the GT predicate on the "min_quantity" field.
+func MinQuantityGT(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGT(FieldMinQuantity, v))
+}
+
+// MinQuantityGTE applies the GTE predicate on the "min_quantity" field.
+func MinQuantityGTE(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGTE(FieldMinQuantity, v))
+}
+
+// MinQuantityLT applies the LT predicate on the "min_quantity" field.
+func MinQuantityLT(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLT(FieldMinQuantity, v))
+}
+
+// MinQuantityLTE applies the LTE predicate on the "min_quantity" field.
+func MinQuantityLTE(v int) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLTE(FieldMinQuantity, v))
+}
+
+// UnitPriceCentsEQ applies the EQ predicate on the "unit_price_cents" field.
+func UnitPriceCentsEQ(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldUnitPriceCents, v))
+}
+
+// UnitPriceCentsNEQ applies the NEQ predicate on the "unit_price_cents" field.
+func UnitPriceCentsNEQ(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNEQ(FieldUnitPriceCents, v))
+}
+
+// UnitPriceCentsIn applies the In predicate on the "unit_price_cents" field.
+func UnitPriceCentsIn(vs ...int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldIn(FieldUnitPriceCents, vs...))
+}
+
+// UnitPriceCentsNotIn applies the NotIn predicate on the "unit_price_cents" field.
+func UnitPriceCentsNotIn(vs ...int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNotIn(FieldUnitPriceCents, vs...))
+}
+
+// UnitPriceCentsGT applies the GT predicate on the "unit_price_cents" field.
+func UnitPriceCentsGT(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGT(FieldUnitPriceCents, v))
+}
+
+// UnitPriceCentsGTE applies the GTE predicate on the "unit_price_cents" field.
+func UnitPriceCentsGTE(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGTE(FieldUnitPriceCents, v))
+}
+
+// UnitPriceCentsLT applies the LT predicate on the "unit_price_cents" field.
+func UnitPriceCentsLT(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLT(FieldUnitPriceCents, v))
+}
+
+// UnitPriceCentsLTE applies the LTE predicate on the "unit_price_cents" field.
+func UnitPriceCentsLTE(v int64) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLTE(FieldUnitPriceCents, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PriceTier {
+	return predicate.PriceTier(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.PriceTier {
+	return predicate.PriceTier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.PriceTier {
+	return predicate.PriceTier(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceTier) predicate.PriceTier {
+	return predicate.PriceTier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceTier) predicate.PriceTier {
+	return predicate.PriceTier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceTier) predicate.PriceTier {
+	return predicate.PriceTier(sql.NotPredicates(p))
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/pricetier"
+	"products/ent/product"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PriceTierCreate is the builder for creating a PriceTier entity.
+type PriceTierCreate struct {
+	config
+	mutation *PriceTierMutation
+	hooks    []Hook
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (ptc *PriceTierCreate) SetMinQuantity(i int) *PriceTierCreate {
+	ptc.mutation.SetMinQuantity(i)
+	return ptc
+}
+
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (ptc *PriceTierCreate) SetUnitPriceCents(i int64) *PriceTierCreate {
+	ptc.mutation.SetUnitPriceCents(i)
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PriceTierCreate) SetCreatedAt(t time.Time) *PriceTierCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PriceTierCreate) SetNillableCreatedAt(t *time.Time) *PriceTierCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetID sets the "id" field.
+func (ptc *PriceTierCreate) SetID(u uuid.UUID) *PriceTierCreate {
+	ptc.mutation.SetID(u)
+	return ptc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ptc *PriceTierCreate) SetNillableID(u *uuid.UUID) *PriceTierCreate {
+	if u != nil {
+		ptc.SetID(*u)
+	}
+	return ptc
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (ptc *PriceTierCreate) SetProductID(id uuid.UUID) *PriceTierCreate {
+	ptc.mutation.SetProductID(id)
+	return ptc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (ptc *PriceTierCreate) SetProduct(p *Product) *PriceTierCreate {
+	return ptc.SetProductID(p.ID)
+}
+
+// Mutation returns the PriceTierMutation object of the builder.
+func (ptc *PriceTierCreate) Mutation() *PriceTierMutation {
+	return ptc.mutation
+}
+
+// Save creates the PriceTier in the database.
+func (ptc *PriceTierCreate) Save(ctx context.Context) (*PriceTier, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PriceTierCreate) SaveX(ctx context.Context) *PriceTier {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PriceTierCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PriceTierCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PriceTierCreate) defaults() {
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := pricetier.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ptc.mutation.ID(); !ok {
+		v := pricetier.DefaultID()
+		ptc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PriceTierCreate) check() error {
+	if _, ok := ptc.mutation.MinQuantity(); !ok {
+		return &ValidationError{Name: "min_quantity", err: errors.New(`ent: missing required field "PriceTier.min_quantity"`)}
+	}
+	if v, ok := ptc.mutation.MinQuantity(); ok {
+		if err := pricetier.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceTier.min_quantity": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.UnitPriceCents(); !ok {
+		return &ValidationError{Name: "unit_price_cents", err: errors.New(`ent: missing required field "PriceTier.unit_price_cents"`)}
+	}
+	if v, ok := ptc.mutation.UnitPriceCents(); ok {
+		if err := pricetier.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "PriceTier.unit_price_cents": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceTier.created_at"`)}
+	}
+	if len(ptc.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "PriceTier.product"`)}
+	}
+	return nil
+}
+
+func (ptc *PriceTierCreate) sqlSave(ctx context.Context) (*PriceTier, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ptc.mutation.id = &_node.ID
+	ptc.mutation.done = true
+	return _node, nil
+}
+
+func (ptc *PriceTierCreate) createSpec() (*PriceTier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceTier{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(pricetier.Table, sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID))
+	)
+	if id, ok := ptc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ptc.mutation.MinQuantity(); ok {
+		_spec.SetField(pricetier.FieldMinQuantity, field.TypeInt, value)
+		_node.MinQuantity = value
+	}
+	if value, ok := ptc.mutation.UnitPriceCents(); ok {
+		_spec.SetField(pricetier.FieldUnitPriceCents, field.TypeInt64, value)
+		_node.UnitPriceCents = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(pricetier.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ptc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricetier.ProductTable,
+			Columns: []string{pricetier.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.product_price_tiers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PriceTierCreateBulk is the builder for creating many PriceTier entities in bulk.
+type PriceTierCreateBulk struct {
+	config
+	err      error
+	builders []*PriceTierCreate
+}
+
+// Save creates the PriceTier entities in the database.
+func (ptcb *PriceTierCreateBulk) Save(ctx context.Context) ([]*PriceTier, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PriceTier, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceTierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PriceTierCreateBulk) SaveX(ctx context.Context) []*PriceTier {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PriceTierCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PriceTierCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
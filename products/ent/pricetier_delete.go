@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"products/ent/predicate"
+	"products/ent/pricetier"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceTierDelete is the builder for deleting a PriceTier entity.
+type PriceTierDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceTierMutation
+}
+
+// Where appends a list predicates to the PriceTierDelete builder.
+func (ptd *PriceTierDelete) Where(ps ...predicate.PriceTier) *PriceTierDelete {
+	ptd.mutation.Where(ps...)
+	return ptd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ptd *PriceTierDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptd.sqlExec, ptd.mutation, ptd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptd *PriceTierDelete) ExecX(ctx context.Context) int {
+	n, err := ptd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ptd *PriceTierDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricetier.Table, sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID))
+	if ps := ptd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ptd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ptd.mutation.done = true
+	return affected, err
+}
+
+// PriceTierDeleteOne is the builder for deleting a single PriceTier entity.
+type PriceTierDeleteOne struct {
+	ptd *PriceTierDelete
+}
+
+// Where appends a list predicates to the PriceTierDelete builder.
+func (ptdo *PriceTierDeleteOne) Where(ps ...predicate.PriceTier) *PriceTierDeleteOne {
+	ptdo.ptd.mutation.Where(ps...)
+	return ptdo
+}
+
+// Exec executes the deletion query.
+func (ptdo *PriceTierDeleteOne) Exec(ctx context.Context) error {
+	n, err := ptdo.ptd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricetier.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptdo *PriceTierDeleteOne) ExecX(ctx context.Context) {
+	if err := ptdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"products/ent/predicate"
+	"products/ent/pricetier"
+	"products/ent/product"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PriceTierQuery is the builder for querying PriceTier entities.
+type PriceTierQuery struct {
+	config
+	ctx         *QueryContext
+	order       []pricetier.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.PriceTier
+	withProduct *ProductQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceTierQuery builder.
+func (ptq *PriceTierQuery) Where(ps ...predicate.PriceTier) *PriceTierQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit the number of records to be returned by this query.
+func (ptq *PriceTierQuery) Limit(limit int) *PriceTierQuery {
+	ptq.ctx.Limit = &limit
+	return ptq
+}
+
+// Offset to start from.
+func (ptq *PriceTierQuery) Offset(offset int) *PriceTierQuery {
+	ptq.ctx.Offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *PriceTierQuery) Unique(unique bool) *PriceTierQuery {
+	ptq.ctx.Unique = &unique
+	return ptq
+}
+
+// Order specifies how the records should be ordered.
+func (ptq *PriceTierQuery) Order(o ...pricetier.OrderOption) *PriceTierQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (ptq *PriceTierQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: ptq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ptq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricetier.Table, pricetier.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricetier.ProductTable, pricetier.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PriceTier entity from the query.
+// Returns a *NotFoundError when no PriceTier was found.
+func (ptq *PriceTierQuery) First(ctx context.Context) (*PriceTier, error) {
+	nodes, err := ptq.Limit(1).All(setContextOp(ctx, ptq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricetier.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *PriceTierQuery) FirstX(ctx context.Context) *PriceTier {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceTier ID from the query.
+// Returns a *NotFoundError when no PriceTier ID was found.
+func (ptq *PriceTierQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ptq.Limit(1).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricetier.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ptq *PriceTierQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceTier entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceTier entity is found.
+// Returns a *NotFoundError when no PriceTier entities are found.
+func (ptq *PriceTierQuery) Only(ctx context.Context) (*PriceTier, error) {
+	nodes, err := ptq.Limit(2).All(setContextOp(ctx, ptq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricetier.Label}
+	default:
+		return nil, &NotSingularError{pricetier.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *PriceTierQuery) OnlyX(ctx context.Context) *PriceTier {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceTier ID in the query.
+// Returns a *NotSingularError when more than one PriceTier ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ptq *PriceTierQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ptq.Limit(2).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricetier.Label}
+	default:
+		err = &NotSingularError{pricetier.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ptq *PriceTierQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceTiers.
+func (ptq *PriceTierQuery) All(ctx context.Context) ([]*PriceTier, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryAll)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PriceTier, *PriceTierQuery]()
+	return withInterceptors[[]*PriceTier](ctx, ptq, qr, ptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *PriceTierQuery) AllX(ctx context.Context) []*PriceTier {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceTier IDs.
+func (ptq *PriceTierQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ptq.ctx.Unique == nil && ptq.path != nil {
+		ptq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryIDs)
+	if err = ptq.Select(pricetier.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ptq *PriceTierQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ptq *PriceTierQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryCount)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ptq, querierCount[*PriceTierQuery](), ptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *PriceTierQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *PriceTierQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryExist)
+	switch _, err := ptq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *PriceTierQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceTierQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *PriceTierQuery) Clone() *PriceTierQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &PriceTierQuery{
+		config:      ptq.config,
+		ctx:         ptq.ctx.Clone(),
+		order:       append([]pricetier.OrderOption{}, ptq.order...),
+		inters:      append([]Interceptor{}, ptq.inters...),
+		predicates:  append([]predicate.PriceTier{}, ptq.predicates...),
+		withProduct: ptq.withProduct.Clone(),
+		// clone intermediate query.
+		sql:  ptq.sql.Clone(),
+		path: ptq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (ptq *PriceTierQuery) WithProduct(opts ...func(*ProductQuery)) *PriceTierQuery {
+	query := (&ProductClient{config: ptq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ptq.withProduct = query
+	return ptq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MinQuantity int `json:"min_quantity,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceTier.Query().
+//		GroupBy(pricetier.FieldMinQuantity).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *PriceTierQuery) GroupBy(field string, fields ...string) *PriceTierGroupBy {
+	ptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PriceTierGroupBy{build: ptq}
+	grbuild.flds = &ptq.ctx.Fields
+	grbuild.label = pricetier.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MinQuantity int `json:"min_quantity,omitempty"`
+//	}
+//
+//	client.PriceTier.Query().
+//		Select(pricetier.FieldMinQuantity).
+//		Scan(ctx, &v)
+func (ptq *PriceTierQuery) Select(fields ...string) *PriceTierSelect {
+	ptq.ctx.Fields = append(ptq.ctx.Fields, fields...)
+	sbuild := &PriceTierSelect{PriceTierQuery: ptq}
+	sbuild.label = pricetier.Label
+	sbuild.flds, sbuild.scan = &ptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PriceTierSelect configured with the given aggregations.
+func (ptq *PriceTierQuery) Aggregate(fns ...AggregateFunc) *PriceTierSelect {
+	return ptq.Select().Aggregate(fns...)
+}
+
+func (ptq *PriceTierQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ptq.ctx.Fields {
+		if !pricetier.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *PriceTierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceTier, error) {
+	var (
+		nodes       = []*PriceTier{}
+		withFKs     = ptq.withFKs
+		_spec       = ptq.querySpec()
+		loadedTypes = [1]bool{
+			ptq.withProduct != nil,
+		}
+	)
+	if ptq.withProduct != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, pricetier.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PriceTier).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PriceTier{config: ptq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ptq.withProduct; query != nil {
+		if err := ptq.loadProduct(ctx, query, nodes, nil,
+			func(n *PriceTier, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ptq *PriceTierQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*PriceTier, init func(*PriceTier), assign func(*PriceTier, *Product)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PriceTier)
+	for i := range nodes {
+		if nodes[i].product_price_tiers == nil {
+			continue
+		}
+		fk := *nodes[i].product_price_tiers
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_price_tiers" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ptq *PriceTierQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	_spec.Node.Columns = ptq.ctx.Fields
+	if len(ptq.ctx.Fields) > 0 {
+		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *PriceTierQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricetier.Table, pricetier.Columns, sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID))
+	_spec.From = ptq.sql
+	if unique := ptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricetier.FieldID)
+		for i := range fields {
+			if fields[i] != pricetier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *PriceTierQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(pricetier.Table)
+	columns := ptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricetier.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PriceTierGroupBy is the group-by builder for PriceTier entities.
+type PriceTierGroupBy struct {
+	selector
+	build *PriceTierQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *PriceTierGroupBy) Aggregate(fns ...AggregateFunc) *PriceTierGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ptgb *PriceTierGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ptgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceTierQuery, *PriceTierGroupBy](ctx, ptgb.build, ptgb, ptgb.build.inters, v)
+}
+
+func (ptgb *PriceTierGroupBy) sqlScan(ctx context.Context, root *PriceTierQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ptgb.flds)+len(ptgb.fns))
+		for _, f := range *ptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PriceTierSelect is the builder for selecting fields of PriceTier entities.
+type PriceTierSelect struct {
+	*PriceTierQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pts *PriceTierSelect) Aggregate(fns ...AggregateFunc) *PriceTierSelect {
+	pts.fns = append(pts.fns, fns...)
+	return pts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *PriceTierSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pts.ctx, ent.OpQuerySelect)
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceTierQuery, *PriceTierSelect](ctx, pts.PriceTierQuery, pts, pts.inters, v)
+}
+
+func (pts *PriceTierSelect) sqlScan(ctx context.Context, root *PriceTierQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pts.fns))
+	for _, fn := range pts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
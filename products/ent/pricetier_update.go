@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/predicate"
+	"products/ent/pricetier"
+	"products/ent/product"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PriceTierUpdate is the builder for updating PriceTier entities.
+type PriceTierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceTierMutation
+}
+
+// Where appends a list predicates to the PriceTierUpdate builder.
+func (ptu *PriceTierUpdate) Where(ps ...predicate.PriceTier) *PriceTierUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (ptu *PriceTierUpdate) SetMinQuantity(i int) *PriceTierUpdate {
+	ptu.mutation.ResetMinQuantity()
+	ptu.mutation.SetMinQuantity(i)
+	return ptu
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (ptu *PriceTierUpdate) SetNillableMinQuantity(i *int) *PriceTierUpdate {
+	if i != nil {
+		ptu.SetMinQuantity(*i)
+	}
+	return ptu
+}
+
+// AddMinQuantity adds i to the "min_quantity" field.
+func (ptu *PriceTierUpdate) AddMinQuantity(i int) *PriceTierUpdate {
+	ptu.mutation.AddMinQuantity(i)
+	return ptu
+}
+
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (ptu *PriceTierUpdate) SetUnitPriceCents(i int64) *PriceTierUpdate {
+	ptu.mutation.ResetUnitPriceCents()
+	ptu.mutation.SetUnitPriceCents(i)
+	return ptu
+}
+
+// SetNillableUnitPriceCents sets the "unit_price_cents" field if the given value is not nil.
+func (ptu *PriceTierUpdate) SetNillableUnitPriceCents(i *int64) *PriceTierUpdate {
+	if i != nil {
+		ptu.SetUnitPriceCents(*i)
+	}
+	return ptu
+}
+
+// AddUnitPriceCents adds i to the "unit_price_cents" field.
+func (ptu *PriceTierUpdate) AddUnitPriceCents(i int64) *PriceTierUpdate {
+	ptu.mutation.AddUnitPriceCents(i)
+	return ptu
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (ptu *PriceTierUpdate) SetProductID(id uuid.UUID) *PriceTierUpdate {
+	ptu.mutation.SetProductID(id)
+	return ptu
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (ptu *PriceTierUpdate) SetProduct(p *Product) *PriceTierUpdate {
+	return ptu.SetProductID(p.ID)
+}
+
+// Mutation returns the PriceTierMutation object of the builder.
+func (ptu *PriceTierUpdate) Mutation() *PriceTierMutation {
+	return ptu.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (ptu *PriceTierUpdate) ClearProduct() *PriceTierUpdate {
+	ptu.mutation.ClearProduct()
+	return ptu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PriceTierUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PriceTierUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PriceTierUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PriceTierUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PriceTierUpdate) check() error {
+	if v, ok := ptu.mutation.MinQuantity(); ok {
+		if err := pricetier.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceTier.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := ptu.mutation.UnitPriceCents(); ok {
+		if err := pricetier.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "PriceTier.unit_price_cents": %w`, err)}
+		}
+	}
+	if ptu.mutation.ProductCleared() && len(ptu.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceTier.product"`)
+	}
+	return nil
+}
+
+func (ptu *PriceTierUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricetier.Table, pricetier.Columns, sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.MinQuantity(); ok {
+		_spec.SetField(pricetier.FieldMinQuantity, field.TypeInt, value)
+	}
+	if value, ok := ptu.mutation.AddedMinQuantity(); ok {
+		_spec.AddField(pricetier.FieldMinQuantity, field.TypeInt, value)
+	}
+	if value, ok := ptu.mutation.UnitPriceCents(); ok {
+		_spec.SetField(pricetier.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := ptu.mutation.AddedUnitPriceCents(); ok {
+		_spec.AddField(pricetier.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if ptu.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricetier.ProductTable,
+			Columns: []string{pricetier.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptu.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricetier.ProductTable,
+			Columns: []string{pricetier.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricetier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// PriceTierUpdateOne is the builder for updating a single PriceTier entity.
+type PriceTierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceTierMutation
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (ptuo *PriceTierUpdateOne) SetMinQuantity(i int) *PriceTierUpdateOne {
+	ptuo.mutation.ResetMinQuantity()
+	ptuo.mutation.SetMinQuantity(i)
+	return ptuo
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (ptuo *PriceTierUpdateOne) SetNillableMinQuantity(i *int) *PriceTierUpdateOne {
+	if i != nil {
+		ptuo.SetMinQuantity(*i)
+	}
+	return ptuo
+}
+
+// AddMinQuantity adds i to the "min_quantity" field.
+func (ptuo *PriceTierUpdateOne) AddMinQuantity(i int) *PriceTierUpdateOne {
+	ptuo.mutation.AddMinQuantity(i)
+	return ptuo
+}
+
+// SetUnitPriceCents sets the "unit_price_cents" field.
+func (ptuo *PriceTierUpdateOne) SetUnitPriceCents(i int64) *PriceTierUpdateOne {
+	ptuo.mutation.ResetUnitPriceCents()
+	ptuo.mutation.SetUnitPriceCents(i)
+	return ptuo
+}
+
+// SetNillableUnitPriceCents sets the "unit_price_cents" field if the given value is not nil.
+func (ptuo *PriceTierUpdateOne) SetNillableUnitPriceCents(i *int64) *PriceTierUpdateOne {
+	if i != nil {
+		ptuo.SetUnitPriceCents(*i)
+	}
+	return ptuo
+}
+
+// AddUnitPriceCents adds i to the "unit_price_cents" field.
+func (ptuo *PriceTierUpdateOne) AddUnitPriceCents(i int64) *PriceTierUpdateOne {
+	ptuo.mutation.AddUnitPriceCents(i)
+	return ptuo
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (ptuo *PriceTierUpdateOne) SetProductID(id uuid.UUID) *PriceTierUpdateOne {
+	ptuo.mutation.SetProductID(id)
+	return ptuo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (ptuo *PriceTierUpdateOne) SetProduct(p *Product) *PriceTierUpdateOne {
+	return ptuo.SetProductID(p.ID)
+}
+
+// Mutation returns the PriceTierMutation object of the builder.
+func (ptuo *PriceTierUpdateOne) Mutation() *PriceTierMutation {
+	return ptuo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (ptuo *PriceTierUpdateOne) ClearProduct() *PriceTierUpdateOne {
+	ptuo.mutation.ClearProduct()
+	return ptuo
+}
+
+// Where appends a list predicates to the PriceTierUpdate builder.
+func (ptuo *PriceTierUpdateOne) Where(ps ...predicate.PriceTier) *PriceTierUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PriceTierUpdateOne) Select(field string, fields ...string) *PriceTierUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PriceTier entity.
+func (ptuo *PriceTierUpdateOne) Save(ctx context.Context) (*PriceTier, error) {
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PriceTierUpdateOne) SaveX(ctx context.Context) *PriceTier {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PriceTierUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PriceTierUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PriceTierUpdateOne) check() error {
+	if v, ok := ptuo.mutation.MinQuantity(); ok {
+		if err := pricetier.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceTier.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := ptuo.mutation.UnitPriceCents(); ok {
+		if err := pricetier.UnitPriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "unit_price_cents", err: fmt.Errorf(`ent: validator failed for field "PriceTier.unit_price_cents": %w`, err)}
+		}
+	}
+	if ptuo.mutation.ProductCleared() && len(ptuo.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceTier.product"`)
+	}
+	return nil
+}
+
+func (ptuo *PriceTierUpdateOne) sqlSave(ctx context.Context) (_node *PriceTier, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricetier.Table, pricetier.Columns, sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID))
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceTier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricetier.FieldID)
+		for _, f := range fields {
+			if !pricetier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricetier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.MinQuantity(); ok {
+		_spec.SetField(pricetier.FieldMinQuantity, field.TypeInt, value)
+	}
+	if value, ok := ptuo.mutation.AddedMinQuantity(); ok {
+		_spec.AddField(pricetier.FieldMinQuantity, field.TypeInt, value)
+	}
+	if value, ok := ptuo.mutation.UnitPriceCents(); ok {
+		_spec.SetField(pricetier.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := ptuo.mutation.AddedUnitPriceCents(); ok {
+		_spec.AddField(pricetier.FieldUnitPriceCents, field.TypeInt64, value)
+	}
+	if ptuo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricetier.ProductTable,
+			Columns: []string{pricetier.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptuo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricetier.ProductTable,
+			Columns: []string{pricetier.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PriceTier{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricetier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
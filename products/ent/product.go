@@ -21,10 +21,14 @@ type Product struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Stock keeping unit; CreateProduct requires one, only products that predate SKUs lack it
+	Sku *string `json:"sku,omitempty"`
 	// Description holds the value of the "description" field.
 	Description *string `json:"description,omitempty"`
-	// Price holds the value of the "price" field.
-	Price float64 `json:"price,omitempty"`
+	// Price in minor units (cents) so sums are exact
+	PriceCents int64 `json:"price_cents,omitempty"`
+	// ISO 4217 currency code of price_cents
+	Currency string `json:"currency,omitempty"`
 	// StockQuantity holds the value of the "stock_quantity" field.
 	StockQuantity int `json:"stock_quantity,omitempty"`
 	// Reference to the user who created/owns the product
@@ -35,6 +39,8 @@ type Product struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Optimistic lock version
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductQuery when eager-loading is set.
 	Edges               ProductEdges `json:"edges"`
@@ -46,9 +52,13 @@ type Product struct {
 type ProductEdges struct {
 	// Subcategory holds the value of the subcategory edge.
 	Subcategory *SubCategory `json:"subcategory,omitempty"`
+	// PriceTiers holds the value of the price_tiers edge.
+	PriceTiers []*PriceTier `json:"price_tiers,omitempty"`
+	// Reviews holds the value of the reviews edge.
+	Reviews []*Review `json:"reviews,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // SubcategoryOrErr returns the Subcategory value or an error if the edge
@@ -62,6 +72,24 @@ func (e ProductEdges) SubcategoryOrErr() (*SubCategory, error) {
 	return nil, &NotLoadedError{edge: "subcategory"}
 }
 
+// PriceTiersOrErr returns the PriceTiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) PriceTiersOrErr() ([]*PriceTier, error) {
+	if e.loadedTypes[1] {
+		return e.PriceTiers, nil
+	}
+	return nil, &NotLoadedError{edge: "price_tiers"}
+}
+
+// ReviewsOrErr returns the Reviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) ReviewsOrErr() ([]*Review, error) {
+	if e.loadedTypes[2] {
+		return e.Reviews, nil
+	}
+	return nil, &NotLoadedError{edge: "reviews"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -69,11 +97,9 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case product.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case product.FieldPrice:
-			values[i] = new(sql.NullFloat64)
-		case product.FieldStockQuantity:
+		case product.FieldPriceCents, product.FieldStockQuantity, product.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case product.FieldName, product.FieldDescription:
+		case product.FieldName, product.FieldSku, product.FieldDescription, product.FieldCurrency:
 			values[i] = new(sql.NullString)
 		case product.FieldCreatedAt, product.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -108,6 +134,13 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Name = value.String
 			}
+		case product.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				pr.Sku = new(string)
+				*pr.Sku = value.String
+			}
 		case product.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
@@ -115,11 +148,17 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 				pr.Description = new(string)
 				*pr.Description = value.String
 			}
-		case product.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field price", values[i])
+		case product.FieldPriceCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_cents", values[i])
+			} else if value.Valid {
+				pr.PriceCents = value.Int64
+			}
+		case product.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
 			} else if value.Valid {
-				pr.Price = value.Float64
+				pr.Currency = value.String
 			}
 		case product.FieldStockQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -151,6 +190,12 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.IsActive = value.Bool
 			}
+		case product.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = int(value.Int64)
+			}
 		case product.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field product_subcategory", values[i])
@@ -176,6 +221,16 @@ func (pr *Product) QuerySubcategory() *SubCategoryQuery {
 	return NewProductClient(pr.config).QuerySubcategory(pr)
 }
 
+// QueryPriceTiers queries the "price_tiers" edge of the Product entity.
+func (pr *Product) QueryPriceTiers() *PriceTierQuery {
+	return NewProductClient(pr.config).QueryPriceTiers(pr)
+}
+
+// QueryReviews queries the "reviews" edge of the Product entity.
+func (pr *Product) QueryReviews() *ReviewQuery {
+	return NewProductClient(pr.config).QueryReviews(pr)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,13 +257,21 @@ func (pr *Product) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(pr.Name)
 	builder.WriteString(", ")
+	if v := pr.Sku; v != nil {
+		builder.WriteString("sku=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := pr.Description; v != nil {
 		builder.WriteString("description=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	builder.WriteString("price=")
-	builder.WriteString(fmt.Sprintf("%v", pr.Price))
+	builder.WriteString("price_cents=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PriceCents))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(pr.Currency)
 	builder.WriteString(", ")
 	builder.WriteString("stock_quantity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.StockQuantity))
@@ -224,6 +287,9 @@ func (pr *Product) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", pr.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,10 +17,14 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSku holds the string denoting the sku field in the database.
+	FieldSku = "sku"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
-	// FieldPrice holds the string denoting the price field in the database.
-	FieldPrice = "price"
+	// FieldPriceCents holds the string denoting the price_cents field in the database.
+	FieldPriceCents = "price_cents"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldStockQuantity holds the string denoting the stock_quantity field in the database.
 	FieldStockQuantity = "stock_quantity"
 	// FieldUserID holds the string denoting the user_id field in the database.
@@ -31,8 +35,14 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeSubcategory holds the string denoting the subcategory edge name in mutations.
 	EdgeSubcategory = "subcategory"
+	// EdgePriceTiers holds the string denoting the price_tiers edge name in mutations.
+	EdgePriceTiers = "price_tiers"
+	// EdgeReviews holds the string denoting the reviews edge name in mutations.
+	EdgeReviews = "reviews"
 	// Table holds the table name of the product in the database.
 	Table = "products"
 	// SubcategoryTable is the table that holds the subcategory relation/edge.
@@ -42,19 +52,36 @@ const (
 	SubcategoryInverseTable = "sub_categories"
 	// SubcategoryColumn is the table column denoting the subcategory relation/edge.
 	SubcategoryColumn = "product_subcategory"
+	// PriceTiersTable is the table that holds the price_tiers relation/edge.
+	PriceTiersTable = "price_tiers"
+	// PriceTiersInverseTable is the table name for the PriceTier entity.
+	// It exists in this package in order to avoid circular dependency with the "pricetier" package.
+	PriceTiersInverseTable = "price_tiers"
+	// PriceTiersColumn is the table column denoting the price_tiers relation/edge.
+	PriceTiersColumn = "product_price_tiers"
+	// ReviewsTable is the table that holds the reviews relation/edge.
+	ReviewsTable = "reviews"
+	// ReviewsInverseTable is the table name for the Review entity.
+	// It exists in this package in order to avoid circular dependency with the "review" package.
+	ReviewsInverseTable = "reviews"
+	// ReviewsColumn is the table column denoting the reviews relation/edge.
+	ReviewsColumn = "product_reviews"
 )
 
 // Columns holds all SQL columns for product fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldSku,
 	FieldDescription,
-	FieldPrice,
+	FieldPriceCents,
+	FieldCurrency,
 	FieldStockQuantity,
 	FieldUserID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldIsActive,
+	FieldVersion,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "products"
@@ -81,8 +108,10 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	// PriceCentsValidator is a validator for the "price_cents" field. It is called by the builders before save.
+	PriceCentsValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 	// StockQuantityValidator is a validator for the "stock_quantity" field. It is called by the builders before save.
 	StockQuantityValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -93,6 +122,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -110,14 +141,24 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// BySku orders the results by the sku field.
+func BySku(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSku, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
-// ByPrice orders the results by the price field.
-func ByPrice(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+// ByPriceCents orders the results by the price_cents field.
+func ByPriceCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceCents, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
 // ByStockQuantity orders the results by the stock_quantity field.
@@ -145,12 +186,45 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // BySubcategoryField orders the results by subcategory field.
 func BySubcategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newSubcategoryStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPriceTiersCount orders the results by price_tiers count.
+func ByPriceTiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPriceTiersStep(), opts...)
+	}
+}
+
+// ByPriceTiers orders the results by price_tiers terms.
+func ByPriceTiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPriceTiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewsCount orders the results by reviews count.
+func ByReviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewsStep(), opts...)
+	}
+}
+
+// ByReviews orders the results by reviews terms.
+func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSubcategoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -158,3 +232,17 @@ func newSubcategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, SubcategoryTable, SubcategoryColumn),
 	)
 }
+func newPriceTiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PriceTiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PriceTiersTable, PriceTiersColumn),
+	)
+}
+func newReviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+	)
+}
@@ -61,14 +61,24 @@ func Name(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldName, v))
 }
 
+// Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
+func Sku(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSku, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldDescription, v))
 }
 
-// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.Product {
-	return predicate.Product(sql.FieldEQ(FieldPrice, v))
+// PriceCents applies equality check predicate on the "price_cents" field. It's identical to PriceCentsEQ.
+func PriceCents(v int64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPriceCents, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCurrency, v))
 }
 
 // StockQuantity applies equality check predicate on the "stock_quantity" field. It's identical to StockQuantityEQ.
@@ -96,6 +106,11 @@ func IsActive(v bool) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldIsActive, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVersion, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldName, v))
@@ -161,6 +176,81 @@ func NameContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldName, v))
 }
 
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSku, v))
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSku, v))
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSku, vs...))
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSku, vs...))
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldSku, v))
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldSku, v))
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldSku, v))
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldSku, v))
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldSku, v))
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldSku, v))
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldSku, v))
+}
+
+// SkuIsNil applies the IsNil predicate on the "sku" field.
+func SkuIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldSku))
+}
+
+// SkuNotNil applies the NotNil predicate on the "sku" field.
+func SkuNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldSku))
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldSku, v))
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldSku, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldDescription, v))
@@ -236,44 +326,109 @@ func DescriptionContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldDescription, v))
 }
 
-// PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.Product {
-	return predicate.Product(sql.FieldEQ(FieldPrice, v))
+// PriceCentsEQ applies the EQ predicate on the "price_cents" field.
+func PriceCentsEQ(v int64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPriceCents, v))
+}
+
+// PriceCentsNEQ applies the NEQ predicate on the "price_cents" field.
+func PriceCentsNEQ(v int64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPriceCents, v))
+}
+
+// PriceCentsIn applies the In predicate on the "price_cents" field.
+func PriceCentsIn(vs ...int64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPriceCents, vs...))
+}
+
+// PriceCentsNotIn applies the NotIn predicate on the "price_cents" field.
+func PriceCentsNotIn(vs ...int64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPriceCents, vs...))
+}
+
+// PriceCentsGT applies the GT predicate on the "price_cents" field.
+func PriceCentsGT(v int64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPriceCents, v))
+}
+
+// PriceCentsGTE applies the GTE predicate on the "price_cents" field.
+func PriceCentsGTE(v int64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPriceCents, v))
+}
+
+// PriceCentsLT applies the LT predicate on the "price_cents" field.
+func PriceCentsLT(v int64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPriceCents, v))
+}
+
+// PriceCentsLTE applies the LTE predicate on the "price_cents" field.
+func PriceCentsLTE(v int64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPriceCents, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldCurrency, vs...))
 }
 
-// PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.Product {
-	return predicate.Product(sql.FieldNEQ(FieldPrice, v))
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldCurrency, vs...))
 }
 
-// PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.Product {
-	return predicate.Product(sql.FieldIn(FieldPrice, vs...))
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldCurrency, v))
 }
 
-// PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.Product {
-	return predicate.Product(sql.FieldNotIn(FieldPrice, vs...))
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldCurrency, v))
 }
 
-// PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.Product {
-	return predicate.Product(sql.FieldGT(FieldPrice, v))
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldCurrency, v))
 }
 
-// PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.Product {
-	return predicate.Product(sql.FieldGTE(FieldPrice, v))
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldCurrency, v))
 }
 
-// PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.Product {
-	return predicate.Product(sql.FieldLT(FieldPrice, v))
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldCurrency, v))
 }
 
-// PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.Product {
-	return predicate.Product(sql.FieldLTE(FieldPrice, v))
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldCurrency, v))
 }
 
 // StockQuantityEQ applies the EQ predicate on the "stock_quantity" field.
@@ -446,6 +601,46 @@ func IsActiveNEQ(v bool) predicate.Product {
 	return predicate.Product(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasSubcategory applies the HasEdge predicate on the "subcategory" edge.
 func HasSubcategory() predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
@@ -469,6 +664,52 @@ func HasSubcategoryWith(preds ...predicate.SubCategory) predicate.Product {
 	})
 }
 
+// HasPriceTiers applies the HasEdge predicate on the "price_tiers" edge.
+func HasPriceTiers() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PriceTiersTable, PriceTiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPriceTiersWith applies the HasEdge predicate on the "price_tiers" edge with a given conditions (other predicates).
+func HasPriceTiersWith(preds ...predicate.PriceTier) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newPriceTiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviews applies the HasEdge predicate on the "reviews" edge.
+func HasReviews() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
+func HasReviewsWith(preds ...predicate.Review) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Product) predicate.Product {
 	return predicate.Product(sql.AndPredicates(predicates...))
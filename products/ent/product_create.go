@@ -6,7 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
 	"products/ent/subcategory"
 	"time"
 
@@ -28,6 +30,20 @@ func (pc *ProductCreate) SetName(s string) *ProductCreate {
 	return pc
 }
 
+// SetSku sets the "sku" field.
+func (pc *ProductCreate) SetSku(s string) *ProductCreate {
+	pc.mutation.SetSku(s)
+	return pc
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableSku(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetSku(*s)
+	}
+	return pc
+}
+
 // SetDescription sets the "description" field.
 func (pc *ProductCreate) SetDescription(s string) *ProductCreate {
 	pc.mutation.SetDescription(s)
@@ -42,9 +58,23 @@ func (pc *ProductCreate) SetNillableDescription(s *string) *ProductCreate {
 	return pc
 }
 
-// SetPrice sets the "price" field.
-func (pc *ProductCreate) SetPrice(f float64) *ProductCreate {
-	pc.mutation.SetPrice(f)
+// SetPriceCents sets the "price_cents" field.
+func (pc *ProductCreate) SetPriceCents(i int64) *ProductCreate {
+	pc.mutation.SetPriceCents(i)
+	return pc
+}
+
+// SetCurrency sets the "currency" field.
+func (pc *ProductCreate) SetCurrency(s string) *ProductCreate {
+	pc.mutation.SetCurrency(s)
+	return pc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCurrency(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetCurrency(*s)
+	}
 	return pc
 }
 
@@ -102,6 +132,20 @@ func (pc *ProductCreate) SetNillableIsActive(b *bool) *ProductCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *ProductCreate) SetVersion(i int) *ProductCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableVersion(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *ProductCreate) SetID(u uuid.UUID) *ProductCreate {
 	pc.mutation.SetID(u)
@@ -127,6 +171,36 @@ func (pc *ProductCreate) SetSubcategory(s *SubCategory) *ProductCreate {
 	return pc.SetSubcategoryID(s.ID)
 }
 
+// AddPriceTierIDs adds the "price_tiers" edge to the PriceTier entity by IDs.
+func (pc *ProductCreate) AddPriceTierIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddPriceTierIDs(ids...)
+	return pc
+}
+
+// AddPriceTiers adds the "price_tiers" edges to the PriceTier entity.
+func (pc *ProductCreate) AddPriceTiers(p ...*PriceTier) *ProductCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPriceTierIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (pc *ProductCreate) AddReviewIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddReviewIDs(ids...)
+	return pc
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (pc *ProductCreate) AddReviews(r ...*Review) *ProductCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReviewIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pc *ProductCreate) Mutation() *ProductMutation {
 	return pc.mutation
@@ -162,6 +236,10 @@ func (pc *ProductCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pc *ProductCreate) defaults() {
+	if _, ok := pc.mutation.Currency(); !ok {
+		v := product.DefaultCurrency
+		pc.mutation.SetCurrency(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := product.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -174,6 +252,10 @@ func (pc *ProductCreate) defaults() {
 		v := product.DefaultIsActive
 		pc.mutation.SetIsActive(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := product.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := product.DefaultID()
 		pc.mutation.SetID(v)
@@ -190,14 +272,17 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if _, ok := pc.mutation.Price(); !ok {
-		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
+	if _, ok := pc.mutation.PriceCents(); !ok {
+		return &ValidationError{Name: "price_cents", err: errors.New(`ent: missing required field "Product.price_cents"`)}
 	}
-	if v, ok := pc.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+	if v, ok := pc.mutation.PriceCents(); ok {
+		if err := product.PriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "price_cents", err: fmt.Errorf(`ent: validator failed for field "Product.price_cents": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Product.currency"`)}
+	}
 	if _, ok := pc.mutation.StockQuantity(); !ok {
 		return &ValidationError{Name: "stock_quantity", err: errors.New(`ent: missing required field "Product.stock_quantity"`)}
 	}
@@ -218,6 +303,9 @@ func (pc *ProductCreate) check() error {
 	if _, ok := pc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Product.is_active"`)}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Product.version"`)}
+	}
 	if len(pc.mutation.SubcategoryIDs()) == 0 {
 		return &ValidationError{Name: "subcategory", err: errors.New(`ent: missing required edge "Product.subcategory"`)}
 	}
@@ -260,13 +348,21 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := pc.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+		_node.Sku = &value
+	}
 	if value, ok := pc.mutation.Description(); ok {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 		_node.Description = &value
 	}
-	if value, ok := pc.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
-		_node.Price = value
+	if value, ok := pc.mutation.PriceCents(); ok {
+		_spec.SetField(product.FieldPriceCents, field.TypeInt64, value)
+		_node.PriceCents = value
+	}
+	if value, ok := pc.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
 	}
 	if value, ok := pc.mutation.StockQuantity(); ok {
 		_spec.SetField(product.FieldStockQuantity, field.TypeInt, value)
@@ -288,6 +384,10 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := pc.mutation.SubcategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -305,6 +405,38 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_node.product_subcategory = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.PriceTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
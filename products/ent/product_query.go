@@ -4,10 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"products/ent/predicate"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
 	"products/ent/subcategory"
 
 	"entgo.io/ent"
@@ -25,6 +28,8 @@ type ProductQuery struct {
 	inters          []Interceptor
 	predicates      []predicate.Product
 	withSubcategory *SubCategoryQuery
+	withPriceTiers  *PriceTierQuery
+	withReviews     *ReviewQuery
 	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -84,6 +89,50 @@ func (pq *ProductQuery) QuerySubcategory() *SubCategoryQuery {
 	return query
 }
 
+// QueryPriceTiers chains the current query on the "price_tiers" edge.
+func (pq *ProductQuery) QueryPriceTiers() *PriceTierQuery {
+	query := (&PriceTierClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(pricetier.Table, pricetier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.PriceTiersTable, product.PriceTiersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviews chains the current query on the "reviews" edge.
+func (pq *ProductQuery) QueryReviews() *ReviewQuery {
+	query := (&ReviewClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.ReviewsTable, product.ReviewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Product entity from the query.
 // Returns a *NotFoundError when no Product was found.
 func (pq *ProductQuery) First(ctx context.Context) (*Product, error) {
@@ -277,6 +326,8 @@ func (pq *ProductQuery) Clone() *ProductQuery {
 		inters:          append([]Interceptor{}, pq.inters...),
 		predicates:      append([]predicate.Product{}, pq.predicates...),
 		withSubcategory: pq.withSubcategory.Clone(),
+		withPriceTiers:  pq.withPriceTiers.Clone(),
+		withReviews:     pq.withReviews.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -294,6 +345,28 @@ func (pq *ProductQuery) WithSubcategory(opts ...func(*SubCategoryQuery)) *Produc
 	return pq
 }
 
+// WithPriceTiers tells the query-builder to eager-load the nodes that are connected to
+// the "price_tiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithPriceTiers(opts ...func(*PriceTierQuery)) *ProductQuery {
+	query := (&PriceTierClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withPriceTiers = query
+	return pq
+}
+
+// WithReviews tells the query-builder to eager-load the nodes that are connected to
+// the "reviews" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithReviews(opts ...func(*ReviewQuery)) *ProductQuery {
+	query := (&ReviewClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withReviews = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +446,10 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 		nodes       = []*Product{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			pq.withSubcategory != nil,
+			pq.withPriceTiers != nil,
+			pq.withReviews != nil,
 		}
 	)
 	if pq.withSubcategory != nil {
@@ -407,6 +482,20 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			return nil, err
 		}
 	}
+	if query := pq.withPriceTiers; query != nil {
+		if err := pq.loadPriceTiers(ctx, query, nodes,
+			func(n *Product) { n.Edges.PriceTiers = []*PriceTier{} },
+			func(n *Product, e *PriceTier) { n.Edges.PriceTiers = append(n.Edges.PriceTiers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withReviews; query != nil {
+		if err := pq.loadReviews(ctx, query, nodes,
+			func(n *Product) { n.Edges.Reviews = []*Review{} },
+			func(n *Product, e *Review) { n.Edges.Reviews = append(n.Edges.Reviews, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +531,68 @@ func (pq *ProductQuery) loadSubcategory(ctx context.Context, query *SubCategoryQ
 	}
 	return nil
 }
+func (pq *ProductQuery) loadPriceTiers(ctx context.Context, query *PriceTierQuery, nodes []*Product, init func(*Product), assign func(*Product, *PriceTier)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PriceTier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.PriceTiersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.product_price_tiers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_price_tiers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_price_tiers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadReviews(ctx context.Context, query *ReviewQuery, nodes []*Product, init func(*Product), assign func(*Product, *Review)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Review(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.ReviewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.product_reviews
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_reviews" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_reviews" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *ProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"products/ent/predicate"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
 	"products/ent/subcategory"
 	"time"
 
@@ -44,6 +46,26 @@ func (pu *ProductUpdate) SetNillableName(s *string) *ProductUpdate {
 	return pu
 }
 
+// SetSku sets the "sku" field.
+func (pu *ProductUpdate) SetSku(s string) *ProductUpdate {
+	pu.mutation.SetSku(s)
+	return pu
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSku(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetSku(*s)
+	}
+	return pu
+}
+
+// ClearSku clears the value of the "sku" field.
+func (pu *ProductUpdate) ClearSku() *ProductUpdate {
+	pu.mutation.ClearSku()
+	return pu
+}
+
 // SetDescription sets the "description" field.
 func (pu *ProductUpdate) SetDescription(s string) *ProductUpdate {
 	pu.mutation.SetDescription(s)
@@ -64,24 +86,38 @@ func (pu *ProductUpdate) ClearDescription() *ProductUpdate {
 	return pu
 }
 
-// SetPrice sets the "price" field.
-func (pu *ProductUpdate) SetPrice(f float64) *ProductUpdate {
-	pu.mutation.ResetPrice()
-	pu.mutation.SetPrice(f)
+// SetPriceCents sets the "price_cents" field.
+func (pu *ProductUpdate) SetPriceCents(i int64) *ProductUpdate {
+	pu.mutation.ResetPriceCents()
+	pu.mutation.SetPriceCents(i)
 	return pu
 }
 
-// SetNillablePrice sets the "price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillablePrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetPrice(*f)
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillablePriceCents(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetPriceCents(*i)
 	}
 	return pu
 }
 
-// AddPrice adds f to the "price" field.
-func (pu *ProductUpdate) AddPrice(f float64) *ProductUpdate {
-	pu.mutation.AddPrice(f)
+// AddPriceCents adds i to the "price_cents" field.
+func (pu *ProductUpdate) AddPriceCents(i int64) *ProductUpdate {
+	pu.mutation.AddPriceCents(i)
+	return pu
+}
+
+// SetCurrency sets the "currency" field.
+func (pu *ProductUpdate) SetCurrency(s string) *ProductUpdate {
+	pu.mutation.SetCurrency(s)
+	return pu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableCurrency(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetCurrency(*s)
+	}
 	return pu
 }
 
@@ -140,6 +176,27 @@ func (pu *ProductUpdate) SetNillableIsActive(b *bool) *ProductUpdate {
 	return pu
 }
 
+// SetVersion sets the "version" field.
+func (pu *ProductUpdate) SetVersion(i int) *ProductUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableVersion(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *ProductUpdate) AddVersion(i int) *ProductUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
 // SetSubcategoryID sets the "subcategory" edge to the SubCategory entity by ID.
 func (pu *ProductUpdate) SetSubcategoryID(id uuid.UUID) *ProductUpdate {
 	pu.mutation.SetSubcategoryID(id)
@@ -151,6 +208,36 @@ func (pu *ProductUpdate) SetSubcategory(s *SubCategory) *ProductUpdate {
 	return pu.SetSubcategoryID(s.ID)
 }
 
+// AddPriceTierIDs adds the "price_tiers" edge to the PriceTier entity by IDs.
+func (pu *ProductUpdate) AddPriceTierIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddPriceTierIDs(ids...)
+	return pu
+}
+
+// AddPriceTiers adds the "price_tiers" edges to the PriceTier entity.
+func (pu *ProductUpdate) AddPriceTiers(p ...*PriceTier) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPriceTierIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (pu *ProductUpdate) AddReviewIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddReviewIDs(ids...)
+	return pu
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (pu *ProductUpdate) AddReviews(r ...*Review) *ProductUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReviewIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
@@ -162,6 +249,48 @@ func (pu *ProductUpdate) ClearSubcategory() *ProductUpdate {
 	return pu
 }
 
+// ClearPriceTiers clears all "price_tiers" edges to the PriceTier entity.
+func (pu *ProductUpdate) ClearPriceTiers() *ProductUpdate {
+	pu.mutation.ClearPriceTiers()
+	return pu
+}
+
+// RemovePriceTierIDs removes the "price_tiers" edge to PriceTier entities by IDs.
+func (pu *ProductUpdate) RemovePriceTierIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemovePriceTierIDs(ids...)
+	return pu
+}
+
+// RemovePriceTiers removes "price_tiers" edges to PriceTier entities.
+func (pu *ProductUpdate) RemovePriceTiers(p ...*PriceTier) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePriceTierIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (pu *ProductUpdate) ClearReviews() *ProductUpdate {
+	pu.mutation.ClearReviews()
+	return pu
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (pu *ProductUpdate) RemoveReviewIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveReviewIDs(ids...)
+	return pu
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (pu *ProductUpdate) RemoveReviews(r ...*Review) *ProductUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReviewIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -205,9 +334,9 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if v, ok := pu.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+	if v, ok := pu.mutation.PriceCents(); ok {
+		if err := product.PriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "price_cents", err: fmt.Errorf(`ent: validator failed for field "Product.price_cents": %w`, err)}
 		}
 	}
 	if v, ok := pu.mutation.StockQuantity(); ok {
@@ -236,17 +365,26 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+	}
+	if pu.mutation.SkuCleared() {
+		_spec.ClearField(product.FieldSku, field.TypeString)
+	}
 	if value, ok := pu.mutation.Description(); ok {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
 	if pu.mutation.DescriptionCleared() {
 		_spec.ClearField(product.FieldDescription, field.TypeString)
 	}
-	if value, ok := pu.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+	if value, ok := pu.mutation.PriceCents(); ok {
+		_spec.SetField(product.FieldPriceCents, field.TypeInt64, value)
 	}
-	if value, ok := pu.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+	if value, ok := pu.mutation.AddedPriceCents(); ok {
+		_spec.AddField(product.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := pu.mutation.StockQuantity(); ok {
 		_spec.SetField(product.FieldStockQuantity, field.TypeInt, value)
@@ -263,6 +401,12 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.IsActive(); ok {
 		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(product.FieldVersion, field.TypeInt, value)
+	}
 	if pu.mutation.SubcategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -292,6 +436,96 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.PriceTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPriceTiersIDs(); len(nodes) > 0 && !pu.mutation.PriceTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PriceTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !pu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{product.Label}
@@ -326,6 +560,26 @@ func (puo *ProductUpdateOne) SetNillableName(s *string) *ProductUpdateOne {
 	return puo
 }
 
+// SetSku sets the "sku" field.
+func (puo *ProductUpdateOne) SetSku(s string) *ProductUpdateOne {
+	puo.mutation.SetSku(s)
+	return puo
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSku(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetSku(*s)
+	}
+	return puo
+}
+
+// ClearSku clears the value of the "sku" field.
+func (puo *ProductUpdateOne) ClearSku() *ProductUpdateOne {
+	puo.mutation.ClearSku()
+	return puo
+}
+
 // SetDescription sets the "description" field.
 func (puo *ProductUpdateOne) SetDescription(s string) *ProductUpdateOne {
 	puo.mutation.SetDescription(s)
@@ -346,24 +600,38 @@ func (puo *ProductUpdateOne) ClearDescription() *ProductUpdateOne {
 	return puo
 }
 
-// SetPrice sets the "price" field.
-func (puo *ProductUpdateOne) SetPrice(f float64) *ProductUpdateOne {
-	puo.mutation.ResetPrice()
-	puo.mutation.SetPrice(f)
+// SetPriceCents sets the "price_cents" field.
+func (puo *ProductUpdateOne) SetPriceCents(i int64) *ProductUpdateOne {
+	puo.mutation.ResetPriceCents()
+	puo.mutation.SetPriceCents(i)
 	return puo
 }
 
-// SetNillablePrice sets the "price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillablePrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetPrice(*f)
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillablePriceCents(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetPriceCents(*i)
 	}
 	return puo
 }
 
-// AddPrice adds f to the "price" field.
-func (puo *ProductUpdateOne) AddPrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddPrice(f)
+// AddPriceCents adds i to the "price_cents" field.
+func (puo *ProductUpdateOne) AddPriceCents(i int64) *ProductUpdateOne {
+	puo.mutation.AddPriceCents(i)
+	return puo
+}
+
+// SetCurrency sets the "currency" field.
+func (puo *ProductUpdateOne) SetCurrency(s string) *ProductUpdateOne {
+	puo.mutation.SetCurrency(s)
+	return puo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableCurrency(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetCurrency(*s)
+	}
 	return puo
 }
 
@@ -422,6 +690,27 @@ func (puo *ProductUpdateOne) SetNillableIsActive(b *bool) *ProductUpdateOne {
 	return puo
 }
 
+// SetVersion sets the "version" field.
+func (puo *ProductUpdateOne) SetVersion(i int) *ProductUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableVersion(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *ProductUpdateOne) AddVersion(i int) *ProductUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
 // SetSubcategoryID sets the "subcategory" edge to the SubCategory entity by ID.
 func (puo *ProductUpdateOne) SetSubcategoryID(id uuid.UUID) *ProductUpdateOne {
 	puo.mutation.SetSubcategoryID(id)
@@ -433,6 +722,36 @@ func (puo *ProductUpdateOne) SetSubcategory(s *SubCategory) *ProductUpdateOne {
 	return puo.SetSubcategoryID(s.ID)
 }
 
+// AddPriceTierIDs adds the "price_tiers" edge to the PriceTier entity by IDs.
+func (puo *ProductUpdateOne) AddPriceTierIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddPriceTierIDs(ids...)
+	return puo
+}
+
+// AddPriceTiers adds the "price_tiers" edges to the PriceTier entity.
+func (puo *ProductUpdateOne) AddPriceTiers(p ...*PriceTier) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPriceTierIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (puo *ProductUpdateOne) AddReviewIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddReviewIDs(ids...)
+	return puo
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (puo *ProductUpdateOne) AddReviews(r ...*Review) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReviewIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
@@ -444,6 +763,48 @@ func (puo *ProductUpdateOne) ClearSubcategory() *ProductUpdateOne {
 	return puo
 }
 
+// ClearPriceTiers clears all "price_tiers" edges to the PriceTier entity.
+func (puo *ProductUpdateOne) ClearPriceTiers() *ProductUpdateOne {
+	puo.mutation.ClearPriceTiers()
+	return puo
+}
+
+// RemovePriceTierIDs removes the "price_tiers" edge to PriceTier entities by IDs.
+func (puo *ProductUpdateOne) RemovePriceTierIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemovePriceTierIDs(ids...)
+	return puo
+}
+
+// RemovePriceTiers removes "price_tiers" edges to PriceTier entities.
+func (puo *ProductUpdateOne) RemovePriceTiers(p ...*PriceTier) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePriceTierIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (puo *ProductUpdateOne) ClearReviews() *ProductUpdateOne {
+	puo.mutation.ClearReviews()
+	return puo
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (puo *ProductUpdateOne) RemoveReviewIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveReviewIDs(ids...)
+	return puo
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (puo *ProductUpdateOne) RemoveReviews(r ...*Review) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReviewIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (puo *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	puo.mutation.Where(ps...)
@@ -500,9 +861,9 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if v, ok := puo.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+	if v, ok := puo.mutation.PriceCents(); ok {
+		if err := product.PriceCentsValidator(v); err != nil {
+			return &ValidationError{Name: "price_cents", err: fmt.Errorf(`ent: validator failed for field "Product.price_cents": %w`, err)}
 		}
 	}
 	if v, ok := puo.mutation.StockQuantity(); ok {
@@ -548,17 +909,26 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+	}
+	if puo.mutation.SkuCleared() {
+		_spec.ClearField(product.FieldSku, field.TypeString)
+	}
 	if value, ok := puo.mutation.Description(); ok {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
 	if puo.mutation.DescriptionCleared() {
 		_spec.ClearField(product.FieldDescription, field.TypeString)
 	}
-	if value, ok := puo.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+	if value, ok := puo.mutation.PriceCents(); ok {
+		_spec.SetField(product.FieldPriceCents, field.TypeInt64, value)
 	}
-	if value, ok := puo.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+	if value, ok := puo.mutation.AddedPriceCents(); ok {
+		_spec.AddField(product.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := puo.mutation.StockQuantity(); ok {
 		_spec.SetField(product.FieldStockQuantity, field.TypeInt, value)
@@ -575,6 +945,12 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.IsActive(); ok {
 		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(product.FieldVersion, field.TypeInt, value)
+	}
 	if puo.mutation.SubcategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -604,6 +980,96 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.PriceTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPriceTiersIDs(); len(nodes) > 0 && !puo.mutation.PriceTiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PriceTiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.PriceTiersTable,
+			Columns: []string{product.PriceTiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricetier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !puo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ReviewsTable,
+			Columns: []string{product.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
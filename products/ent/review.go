@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"products/ent/product"
+	"products/ent/review"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Review is the model entity for the Review schema.
+type Review struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the user who wrote the review
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Stars, from 1 to 5
+	Rating int `json:"rating,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment *string `json:"comment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewQuery when eager-loading is set.
+	Edges           ReviewEdges `json:"edges"`
+	product_reviews *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// ReviewEdges holds the relations/edges for other nodes in the graph.
+type ReviewEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Review) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case review.FieldRating:
+			values[i] = new(sql.NullInt64)
+		case review.FieldComment:
+			values[i] = new(sql.NullString)
+		case review.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case review.FieldID, review.FieldUserID:
+			values[i] = new(uuid.UUID)
+		case review.ForeignKeys[0]: // product_reviews
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Review fields.
+func (r *Review) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case review.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case review.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				r.UserID = *value
+			}
+		case review.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				r.Rating = int(value.Int64)
+			}
+		case review.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				r.Comment = new(string)
+				*r.Comment = value.String
+			}
+		case review.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case review.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field product_reviews", values[i])
+			} else if value.Valid {
+				r.product_reviews = new(uuid.UUID)
+				*r.product_reviews = *value.S.(*uuid.UUID)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Review.
+// This includes values selected through modifiers, order, etc.
+func (r *Review) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the Review entity.
+func (r *Review) QueryProduct() *ProductQuery {
+	return NewReviewClient(r.config).QueryProduct(r)
+}
+
+// Update returns a builder for updating this Review.
+// Note that you need to call Review.Unwrap() before calling this method if this Review
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Review) Update() *ReviewUpdateOne {
+	return NewReviewClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Review entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Review) Unwrap() *Review {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Review is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Review) String() string {
+	var builder strings.Builder
+	builder.WriteString("Review(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", r.Rating))
+	builder.WriteString(", ")
+	if v := r.Comment; v != nil {
+		builder.WriteString("comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reviews is a parsable slice of Review.
+type Reviews []*Review
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/product"
+	"products/ent/review"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReviewCreate is the builder for creating a Review entity.
+type ReviewCreate struct {
+	config
+	mutation *ReviewMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rc *ReviewCreate) SetUserID(u uuid.UUID) *ReviewCreate {
+	rc.mutation.SetUserID(u)
+	return rc
+}
+
+// SetRating sets the "rating" field.
+func (rc *ReviewCreate) SetRating(i int) *ReviewCreate {
+	rc.mutation.SetRating(i)
+	return rc
+}
+
+// SetComment sets the "comment" field.
+func (rc *ReviewCreate) SetComment(s string) *ReviewCreate {
+	rc.mutation.SetComment(s)
+	return rc
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableComment(s *string) *ReviewCreate {
+	if s != nil {
+		rc.SetComment(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReviewCreate) SetCreatedAt(t time.Time) *ReviewCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableCreatedAt(t *time.Time) *ReviewCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReviewCreate) SetID(u uuid.UUID) *ReviewCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableID(u *uuid.UUID) *ReviewCreate {
+	if u != nil {
+		rc.SetID(*u)
+	}
+	return rc
+}
+
+// SetProductID sets the "product" edge to the Product entity by ID.
+func (rc *ReviewCreate) SetProductID(id uuid.UUID) *ReviewCreate {
+	rc.mutation.SetProductID(id)
+	return rc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (rc *ReviewCreate) SetProduct(p *Product) *ReviewCreate {
+	return rc.SetProductID(p.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (rc *ReviewCreate) Mutation() *ReviewMutation {
+	return rc.mutation
+}
+
+// Save creates the Review in the database.
+func (rc *ReviewCreate) Save(ctx context.Context) (*Review, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReviewCreate) SaveX(ctx context.Context) *Review {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReviewCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReviewCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReviewCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := review.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := review.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReviewCreate) check() error {
+	if _, ok := rc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Review.user_id"`)}
+	}
+	if _, ok := rc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "Review.rating"`)}
+	}
+	if v, ok := rc.mutation.Rating(); ok {
+		if err := review.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Review.rating": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Review.created_at"`)}
+	}
+	if len(rc.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "Review.product"`)}
+	}
+	return nil
+}
+
+func (rc *ReviewCreate) sqlSave(ctx context.Context) (*Review, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Review{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(review.Table, sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.UserID(); ok {
+		_spec.SetField(review.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := rc.mutation.Rating(); ok {
+		_spec.SetField(review.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := rc.mutation.Comment(); ok {
+		_spec.SetField(review.FieldComment, field.TypeString, value)
+		_node.Comment = &value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(review.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ProductTable,
+			Columns: []string{review.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.product_reviews = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReviewCreateBulk is the builder for creating many Review entities in bulk.
+type ReviewCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewCreate
+}
+
+// Save creates the Review entities in the database.
+func (rcb *ReviewCreateBulk) Save(ctx context.Context) ([]*Review, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Review, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) SaveX(ctx context.Context) []*Review {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
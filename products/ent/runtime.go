@@ -4,8 +4,13 @@ package ent
 
 import (
 	"products/ent/category"
+	"products/ent/failedstockadjustment"
+	"products/ent/pricetier"
 	"products/ent/product"
+	"products/ent/review"
 	"products/ent/schema"
+	"products/ent/stockdeduction"
+	"products/ent/stockrestock"
 	"products/ent/subcategory"
 	"time"
 
@@ -36,38 +41,112 @@ func init() {
 	categoryDescID := categoryFields[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
 	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	failedstockadjustmentFields := schema.FailedStockAdjustment{}.Fields()
+	_ = failedstockadjustmentFields
+	// failedstockadjustmentDescAttempts is the schema descriptor for attempts field.
+	failedstockadjustmentDescAttempts := failedstockadjustmentFields[5].Descriptor()
+	// failedstockadjustment.DefaultAttempts holds the default value on creation for the attempts field.
+	failedstockadjustment.DefaultAttempts = failedstockadjustmentDescAttempts.Default.(int)
+	// failedstockadjustmentDescCreatedAt is the schema descriptor for created_at field.
+	failedstockadjustmentDescCreatedAt := failedstockadjustmentFields[6].Descriptor()
+	// failedstockadjustment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	failedstockadjustment.DefaultCreatedAt = failedstockadjustmentDescCreatedAt.Default.(func() time.Time)
+	// failedstockadjustmentDescID is the schema descriptor for id field.
+	failedstockadjustmentDescID := failedstockadjustmentFields[0].Descriptor()
+	// failedstockadjustment.DefaultID holds the default value on creation for the id field.
+	failedstockadjustment.DefaultID = failedstockadjustmentDescID.Default.(func() uuid.UUID)
+	pricetierFields := schema.PriceTier{}.Fields()
+	_ = pricetierFields
+	// pricetierDescMinQuantity is the schema descriptor for min_quantity field.
+	pricetierDescMinQuantity := pricetierFields[1].Descriptor()
+	// pricetier.MinQuantityValidator is a validator for the "min_quantity" field. It is called by the builders before save.
+	pricetier.MinQuantityValidator = pricetierDescMinQuantity.Validators[0].(func(int) error)
+	// pricetierDescUnitPriceCents is the schema descriptor for unit_price_cents field.
+	pricetierDescUnitPriceCents := pricetierFields[2].Descriptor()
+	// pricetier.UnitPriceCentsValidator is a validator for the "unit_price_cents" field. It is called by the builders before save.
+	pricetier.UnitPriceCentsValidator = pricetierDescUnitPriceCents.Validators[0].(func(int64) error)
+	// pricetierDescCreatedAt is the schema descriptor for created_at field.
+	pricetierDescCreatedAt := pricetierFields[3].Descriptor()
+	// pricetier.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricetier.DefaultCreatedAt = pricetierDescCreatedAt.Default.(func() time.Time)
+	// pricetierDescID is the schema descriptor for id field.
+	pricetierDescID := pricetierFields[0].Descriptor()
+	// pricetier.DefaultID holds the default value on creation for the id field.
+	pricetier.DefaultID = pricetierDescID.Default.(func() uuid.UUID)
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescName is the schema descriptor for name field.
 	productDescName := productFields[1].Descriptor()
 	// product.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	product.NameValidator = productDescName.Validators[0].(func(string) error)
-	// productDescPrice is the schema descriptor for price field.
-	productDescPrice := productFields[3].Descriptor()
-	// product.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	product.PriceValidator = productDescPrice.Validators[0].(func(float64) error)
+	// productDescPriceCents is the schema descriptor for price_cents field.
+	productDescPriceCents := productFields[4].Descriptor()
+	// product.PriceCentsValidator is a validator for the "price_cents" field. It is called by the builders before save.
+	product.PriceCentsValidator = productDescPriceCents.Validators[0].(func(int64) error)
+	// productDescCurrency is the schema descriptor for currency field.
+	productDescCurrency := productFields[5].Descriptor()
+	// product.DefaultCurrency holds the default value on creation for the currency field.
+	product.DefaultCurrency = productDescCurrency.Default.(string)
 	// productDescStockQuantity is the schema descriptor for stock_quantity field.
-	productDescStockQuantity := productFields[4].Descriptor()
+	productDescStockQuantity := productFields[6].Descriptor()
 	// product.StockQuantityValidator is a validator for the "stock_quantity" field. It is called by the builders before save.
 	product.StockQuantityValidator = productDescStockQuantity.Validators[0].(func(int) error)
 	// productDescCreatedAt is the schema descriptor for created_at field.
-	productDescCreatedAt := productFields[6].Descriptor()
+	productDescCreatedAt := productFields[8].Descriptor()
 	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
 	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
 	// productDescUpdatedAt is the schema descriptor for updated_at field.
-	productDescUpdatedAt := productFields[7].Descriptor()
+	productDescUpdatedAt := productFields[9].Descriptor()
 	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
 	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// productDescIsActive is the schema descriptor for is_active field.
-	productDescIsActive := productFields[8].Descriptor()
+	productDescIsActive := productFields[10].Descriptor()
 	// product.DefaultIsActive holds the default value on creation for the is_active field.
 	product.DefaultIsActive = productDescIsActive.Default.(bool)
+	// productDescVersion is the schema descriptor for version field.
+	productDescVersion := productFields[11].Descriptor()
+	// product.DefaultVersion holds the default value on creation for the version field.
+	product.DefaultVersion = productDescVersion.Default.(int)
 	// productDescID is the schema descriptor for id field.
 	productDescID := productFields[0].Descriptor()
 	// product.DefaultID holds the default value on creation for the id field.
 	product.DefaultID = productDescID.Default.(func() uuid.UUID)
+	reviewFields := schema.Review{}.Fields()
+	_ = reviewFields
+	// reviewDescRating is the schema descriptor for rating field.
+	reviewDescRating := reviewFields[2].Descriptor()
+	// review.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	review.RatingValidator = reviewDescRating.Validators[0].(func(int) error)
+	// reviewDescCreatedAt is the schema descriptor for created_at field.
+	reviewDescCreatedAt := reviewFields[4].Descriptor()
+	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
+	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
+	// reviewDescID is the schema descriptor for id field.
+	reviewDescID := reviewFields[0].Descriptor()
+	// review.DefaultID holds the default value on creation for the id field.
+	review.DefaultID = reviewDescID.Default.(func() uuid.UUID)
+	stockdeductionFields := schema.StockDeduction{}.Fields()
+	_ = stockdeductionFields
+	// stockdeductionDescCreatedAt is the schema descriptor for created_at field.
+	stockdeductionDescCreatedAt := stockdeductionFields[2].Descriptor()
+	// stockdeduction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockdeduction.DefaultCreatedAt = stockdeductionDescCreatedAt.Default.(func() time.Time)
+	// stockdeductionDescID is the schema descriptor for id field.
+	stockdeductionDescID := stockdeductionFields[0].Descriptor()
+	// stockdeduction.DefaultID holds the default value on creation for the id field.
+	stockdeduction.DefaultID = stockdeductionDescID.Default.(func() uuid.UUID)
+	stockrestockFields := schema.StockRestock{}.Fields()
+	_ = stockrestockFields
+	// stockrestockDescCreatedAt is the schema descriptor for created_at field.
+	stockrestockDescCreatedAt := stockrestockFields[2].Descriptor()
+	// stockrestock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockrestock.DefaultCreatedAt = stockrestockDescCreatedAt.Default.(func() time.Time)
+	// stockrestockDescID is the schema descriptor for id field.
+	stockrestockDescID := stockrestockFields[0].Descriptor()
+	// stockrestock.DefaultID holds the default value on creation for the id field.
+	stockrestock.DefaultID = stockrestockDescID.Default.(func() uuid.UUID)
 	subcategoryFields := schema.SubCategory{}.Fields()
 	_ = subcategoryFields
 	// subcategoryDescName is the schema descriptor for name field.
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FailedStockAdjustment holds the schema definition for the FailedStockAdjustment entity.
+// It dead-letters an order item whose stock could not be deducted, e.g. because the
+// product is missing, so ops can reconcile it with RetryStockAdjustment.
+type FailedStockAdjustment struct {
+	ent.Schema
+}
+
+// Fields of the FailedStockAdjustment.
+func (FailedStockAdjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("Order whose item was not deducted"),
+		field.String("product_id").Immutable().Comment("Product id as received in the event, which may not be a valid UUID"),
+		field.Int("quantity").Immutable(),
+		field.String("reason").Comment("Why the last attempt failed"),
+		field.Int("attempts").Default(1).Comment("Number of deduction attempts, including the original event"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("resolved_at").Optional().Nillable().Comment("Set once the stock is deducted, or the order was cancelled and nothing is owed"),
+	}
+}
+
+// Edges of the FailedStockAdjustment.
+func (FailedStockAdjustment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FailedStockAdjustment.
+func (FailedStockAdjustment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Cancellations look up an order's unresolved adjustments
+		index.Fields("order_id", "resolved_at"),
+	}
+}
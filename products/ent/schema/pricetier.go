@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PriceTier holds the schema definition for the PriceTier entity.
+// It is a quantity break: buying at least min_quantity units of its product
+// costs unit_price_cents each, until a tier with a higher min_quantity applies.
+type PriceTier struct {
+	ent.Schema
+}
+
+// Fields of the PriceTier.
+func (PriceTier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Int("min_quantity").Min(2).Comment("Smallest quantity the tier applies to; fewer units pay the product price"),
+		field.Int64("unit_price_cents").Positive().Comment("Unit price in minor units, in the product's currency"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PriceTier.
+func (PriceTier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).Ref("price_tiers").Unique().Required(),
+	}
+}
+
+// Indexes of the PriceTier.
+func (PriceTier) Indexes() []ent.Index {
+	return []ent.Index{
+		// Two tiers starting at the same quantity would overlap
+		index.Fields("min_quantity").Edges("product").Unique(),
+	}
+}
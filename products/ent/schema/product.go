@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,13 +20,16 @@ func (Product) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.String("name").NotEmpty(),
+		field.String("sku").Optional().Nillable().Unique().Comment("Stock keeping unit; CreateProduct requires one, only products that predate SKUs lack it"),
 		field.Text("description").Optional().Nillable(),
-		field.Float("price").Positive(),
+		field.Int64("price_cents").Positive().Comment("Price in minor units (cents) so sums are exact"),
+		field.String("currency").Default("USD").Comment("ISO 4217 currency code of price_cents"),
 		field.Int("stock_quantity").NonNegative(),
 		field.UUID("user_id", uuid.UUID{}).Comment("Reference to the user who created/owns the product"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Bool("is_active").Default(true),
+		field.Int("version").Default(1).Comment("Optimistic lock version"),
 	}
 }
 
@@ -33,5 +37,8 @@ func (Product) Fields() []ent.Field {
 func (Product) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("subcategory", SubCategory.Type).Unique().Required(),
+		// Tiers and reviews go with their product when it is force deleted
+		edge.To("price_tiers", PriceTier.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reviews", Review.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Review holds the schema definition for the Review entity.
+// It is a customer's rating of a product, one per user and product.
+type Review struct {
+	ent.Schema
+}
+
+// Fields of the Review.
+func (Review) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).Immutable().Comment("Reference to the user who wrote the review"),
+		field.Int("rating").Range(1, 5).Comment("Stars, from 1 to 5"),
+		field.Text("comment").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Review.
+func (Review) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).Ref("reviews").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the Review.
+func (Review) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user reviews a product once
+		index.Fields("user_id").Edges("product").Unique(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// StockDeduction holds the schema definition for the StockDeduction entity.
+// It records each order whose items have been taken out of stock so that
+// redelivered order events are not applied twice.
+type StockDeduction struct {
+	ent.Schema
+}
+
+// Fields of the StockDeduction.
+func (StockDeduction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("order_id", uuid.UUID{}).Unique().Immutable().Comment("Order whose items were deducted"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the StockDeduction.
+func (StockDeduction) Edges() []ent.Edge {
+	return nil
+}
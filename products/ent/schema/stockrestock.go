@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// StockRestock holds the schema definition for the StockRestock entity.
+// It records each cancelled order whose items have been returned to stock so
+// that retried cancellations are not applied twice.
+type StockRestock struct {
+	ent.Schema
+}
+
+// Fields of the StockRestock.
+func (StockRestock) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("order_id", uuid.UUID{}).Unique().Immutable().Comment("Cancelled order whose items were restocked"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the StockRestock.
+func (StockRestock) Edges() []ent.Edge {
+	return nil
+}
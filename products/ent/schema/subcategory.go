@@ -28,7 +28,7 @@ func (SubCategory) Fields() []ent.Field {
 // Edges of the SubCategory.
 func (SubCategory) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("category", Category.Type).Unique().Required(),
+		edge.From("category", Category.Type).Ref("subcategories").Unique().Required(),
 		edge.From("products", Product.Type).Ref("subcategory"),
 	}
 }
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"products/ent/stockdeduction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// StockDeduction is the model entity for the StockDeduction schema.
+type StockDeduction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Order whose items were deducted
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockDeduction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockdeduction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case stockdeduction.FieldID, stockdeduction.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockDeduction fields.
+func (sd *StockDeduction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockdeduction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sd.ID = *value
+			}
+		case stockdeduction.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				sd.OrderID = *value
+			}
+		case stockdeduction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sd.CreatedAt = value.Time
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockDeduction.
+// This includes values selected through modifiers, order, etc.
+func (sd *StockDeduction) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StockDeduction.
+// Note that you need to call StockDeduction.Unwrap() before calling this method if this StockDeduction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *StockDeduction) Update() *StockDeductionUpdateOne {
+	return NewStockDeductionClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the StockDeduction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *StockDeduction) Unwrap() *StockDeduction {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockDeduction is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *StockDeduction) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockDeduction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockDeductions is a parsable slice of StockDeduction.
+type StockDeductions []*StockDeduction
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/stockdeduction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// StockDeductionCreate is the builder for creating a StockDeduction entity.
+type StockDeductionCreate struct {
+	config
+	mutation *StockDeductionMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (sdc *StockDeductionCreate) SetOrderID(u uuid.UUID) *StockDeductionCreate {
+	sdc.mutation.SetOrderID(u)
+	return sdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdc *StockDeductionCreate) SetCreatedAt(t time.Time) *StockDeductionCreate {
+	sdc.mutation.SetCreatedAt(t)
+	return sdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdc *StockDeductionCreate) SetNillableCreatedAt(t *time.Time) *StockDeductionCreate {
+	if t != nil {
+		sdc.SetCreatedAt(*t)
+	}
+	return sdc
+}
+
+// SetID sets the "id" field.
+func (sdc *StockDeductionCreate) SetID(u uuid.UUID) *StockDeductionCreate {
+	sdc.mutation.SetID(u)
+	return sdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sdc *StockDeductionCreate) SetNillableID(u *uuid.UUID) *StockDeductionCreate {
+	if u != nil {
+		sdc.SetID(*u)
+	}
+	return sdc
+}
+
+// Mutation returns the StockDeductionMutation object of the builder.
+func (sdc *StockDeductionCreate) Mutation() *StockDeductionMutation {
+	return sdc.mutation
+}
+
+// Save creates the StockDeduction in the database.
+func (sdc *StockDeductionCreate) Save(ctx context.Context) (*StockDeduction, error) {
+	sdc.defaults()
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *StockDeductionCreate) SaveX(ctx context.Context) *StockDeduction {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *StockDeductionCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *StockDeductionCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *StockDeductionCreate) defaults() {
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		v := stockdeduction.DefaultCreatedAt()
+		sdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sdc.mutation.ID(); !ok {
+		v := stockdeduction.DefaultID()
+		sdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *StockDeductionCreate) check() error {
+	if _, ok := sdc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "StockDeduction.order_id"`)}
+	}
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockDeduction.created_at"`)}
+	}
+	return nil
+}
+
+func (sdc *StockDeductionCreate) sqlSave(ctx context.Context) (*StockDeduction, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *StockDeductionCreate) createSpec() (*StockDeduction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockDeduction{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(stockdeduction.Table, sqlgraph.NewFieldSpec(stockdeduction.FieldID, field.TypeUUID))
+	)
+	if id, ok := sdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sdc.mutation.OrderID(); ok {
+		_spec.SetField(stockdeduction.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := sdc.mutation.CreatedAt(); ok {
+		_spec.SetField(stockdeduction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// StockDeductionCreateBulk is the builder for creating many StockDeduction entities in bulk.
+type StockDeductionCreateBulk struct {
+	config
+	err      error
+	builders []*StockDeductionCreate
+}
+
+// Save creates the StockDeduction entities in the database.
+func (sdcb *StockDeductionCreateBulk) Save(ctx context.Context) ([]*StockDeduction, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*StockDeduction, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockDeductionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *StockDeductionCreateBulk) SaveX(ctx context.Context) []*StockDeduction {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *StockDeductionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *StockDeductionCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
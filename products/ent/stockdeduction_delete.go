@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"products/ent/predicate"
+	"products/ent/stockdeduction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockDeductionDelete is the builder for deleting a StockDeduction entity.
+type StockDeductionDelete struct {
+	config
+	hooks    []Hook
+	mutation *StockDeductionMutation
+}
+
+// Where appends a list predicates to the StockDeductionDelete builder.
+func (sdd *StockDeductionDelete) Where(ps ...predicate.StockDeduction) *StockDeductionDelete {
+	sdd.mutation.Where(ps...)
+	return sdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sdd *StockDeductionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdd.sqlExec, sdd.mutation, sdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdd *StockDeductionDelete) ExecX(ctx context.Context) int {
+	n, err := sdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sdd *StockDeductionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(stockdeduction.Table, sqlgraph.NewFieldSpec(stockdeduction.FieldID, field.TypeUUID))
+	if ps := sdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sdd.mutation.done = true
+	return affected, err
+}
+
+// StockDeductionDeleteOne is the builder for deleting a single StockDeduction entity.
+type StockDeductionDeleteOne struct {
+	sdd *StockDeductionDelete
+}
+
+// Where appends a list predicates to the StockDeductionDelete builder.
+func (sddo *StockDeductionDeleteOne) Where(ps ...predicate.StockDeduction) *StockDeductionDeleteOne {
+	sddo.sdd.mutation.Where(ps...)
+	return sddo
+}
+
+// Exec executes the deletion query.
+func (sddo *StockDeductionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sddo.sdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stockdeduction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sddo *StockDeductionDeleteOne) ExecX(ctx context.Context) {
+	if err := sddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/predicate"
+	"products/ent/stockdeduction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockDeductionUpdate is the builder for updating StockDeduction entities.
+type StockDeductionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockDeductionMutation
+}
+
+// Where appends a list predicates to the StockDeductionUpdate builder.
+func (sdu *StockDeductionUpdate) Where(ps ...predicate.StockDeduction) *StockDeductionUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// Mutation returns the StockDeductionMutation object of the builder.
+func (sdu *StockDeductionUpdate) Mutation() *StockDeductionMutation {
+	return sdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *StockDeductionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *StockDeductionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *StockDeductionUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *StockDeductionUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sdu *StockDeductionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stockdeduction.Table, stockdeduction.Columns, sqlgraph.NewFieldSpec(stockdeduction.FieldID, field.TypeUUID))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockdeduction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// StockDeductionUpdateOne is the builder for updating a single StockDeduction entity.
+type StockDeductionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockDeductionMutation
+}
+
+// Mutation returns the StockDeductionMutation object of the builder.
+func (sduo *StockDeductionUpdateOne) Mutation() *StockDeductionMutation {
+	return sduo.mutation
+}
+
+// Where appends a list predicates to the StockDeductionUpdate builder.
+func (sduo *StockDeductionUpdateOne) Where(ps ...predicate.StockDeduction) *StockDeductionUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *StockDeductionUpdateOne) Select(field string, fields ...string) *StockDeductionUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated StockDeduction entity.
+func (sduo *StockDeductionUpdateOne) Save(ctx context.Context) (*StockDeduction, error) {
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *StockDeductionUpdateOne) SaveX(ctx context.Context) *StockDeduction {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *StockDeductionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *StockDeductionUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sduo *StockDeductionUpdateOne) sqlSave(ctx context.Context) (_node *StockDeduction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stockdeduction.Table, stockdeduction.Columns, sqlgraph.NewFieldSpec(stockdeduction.FieldID, field.TypeUUID))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockDeduction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockdeduction.FieldID)
+		for _, f := range fields {
+			if !stockdeduction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockdeduction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &StockDeduction{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockdeduction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"products/ent/stockrestock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// StockRestock is the model entity for the StockRestock schema.
+type StockRestock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Cancelled order whose items were restocked
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockRestock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockrestock.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case stockrestock.FieldID, stockrestock.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockRestock fields.
+func (sr *StockRestock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockrestock.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sr.ID = *value
+			}
+		case stockrestock.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				sr.OrderID = *value
+			}
+		case stockrestock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockRestock.
+// This includes values selected through modifiers, order, etc.
+func (sr *StockRestock) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StockRestock.
+// Note that you need to call StockRestock.Unwrap() before calling this method if this StockRestock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *StockRestock) Update() *StockRestockUpdateOne {
+	return NewStockRestockClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the StockRestock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *StockRestock) Unwrap() *StockRestock {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockRestock is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *StockRestock) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockRestock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockRestocks is a parsable slice of StockRestock.
+type StockRestocks []*StockRestock
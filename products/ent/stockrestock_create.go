@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/stockrestock"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// StockRestockCreate is the builder for creating a StockRestock entity.
+type StockRestockCreate struct {
+	config
+	mutation *StockRestockMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (src *StockRestockCreate) SetOrderID(u uuid.UUID) *StockRestockCreate {
+	src.mutation.SetOrderID(u)
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *StockRestockCreate) SetCreatedAt(t time.Time) *StockRestockCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *StockRestockCreate) SetNillableCreatedAt(t *time.Time) *StockRestockCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *StockRestockCreate) SetID(u uuid.UUID) *StockRestockCreate {
+	src.mutation.SetID(u)
+	return src
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (src *StockRestockCreate) SetNillableID(u *uuid.UUID) *StockRestockCreate {
+	if u != nil {
+		src.SetID(*u)
+	}
+	return src
+}
+
+// Mutation returns the StockRestockMutation object of the builder.
+func (src *StockRestockCreate) Mutation() *StockRestockMutation {
+	return src.mutation
+}
+
+// Save creates the StockRestock in the database.
+func (src *StockRestockCreate) Save(ctx context.Context) (*StockRestock, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *StockRestockCreate) SaveX(ctx context.Context) *StockRestock {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *StockRestockCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *StockRestockCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *StockRestockCreate) defaults() {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := stockrestock.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+	if _, ok := src.mutation.ID(); !ok {
+		v := stockrestock.DefaultID()
+		src.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *StockRestockCreate) check() error {
+	if _, ok := src.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "StockRestock.order_id"`)}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockRestock.created_at"`)}
+	}
+	return nil
+}
+
+func (src *StockRestockCreate) sqlSave(ctx context.Context) (*StockRestock, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *StockRestockCreate) createSpec() (*StockRestock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockRestock{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(stockrestock.Table, sqlgraph.NewFieldSpec(stockrestock.FieldID, field.TypeUUID))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := src.mutation.OrderID(); ok {
+		_spec.SetField(stockrestock.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(stockrestock.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// StockRestockCreateBulk is the builder for creating many StockRestock entities in bulk.
+type StockRestockCreateBulk struct {
+	config
+	err      error
+	builders []*StockRestockCreate
+}
+
+// Save creates the StockRestock entities in the database.
+func (srcb *StockRestockCreateBulk) Save(ctx context.Context) ([]*StockRestock, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*StockRestock, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockRestockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *StockRestockCreateBulk) SaveX(ctx context.Context) []*StockRestock {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *StockRestockCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *StockRestockCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"products/ent/predicate"
+	"products/ent/stockrestock"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockRestockUpdate is the builder for updating StockRestock entities.
+type StockRestockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockRestockMutation
+}
+
+// Where appends a list predicates to the StockRestockUpdate builder.
+func (sru *StockRestockUpdate) Where(ps ...predicate.StockRestock) *StockRestockUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// Mutation returns the StockRestockMutation object of the builder.
+func (sru *StockRestockUpdate) Mutation() *StockRestockMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *StockRestockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *StockRestockUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *StockRestockUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *StockRestockUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sru *StockRestockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stockrestock.Table, stockrestock.Columns, sqlgraph.NewFieldSpec(stockrestock.FieldID, field.TypeUUID))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockrestock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// StockRestockUpdateOne is the builder for updating a single StockRestock entity.
+type StockRestockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockRestockMutation
+}
+
+// Mutation returns the StockRestockMutation object of the builder.
+func (sruo *StockRestockUpdateOne) Mutation() *StockRestockMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the StockRestockUpdate builder.
+func (sruo *StockRestockUpdateOne) Where(ps ...predicate.StockRestock) *StockRestockUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *StockRestockUpdateOne) Select(field string, fields ...string) *StockRestockUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated StockRestock entity.
+func (sruo *StockRestockUpdateOne) Save(ctx context.Context) (*StockRestock, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *StockRestockUpdateOne) SaveX(ctx context.Context) *StockRestock {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *StockRestockUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *StockRestockUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sruo *StockRestockUpdateOne) sqlSave(ctx context.Context) (_node *StockRestock, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stockrestock.Table, stockrestock.Columns, sqlgraph.NewFieldSpec(stockrestock.FieldID, field.TypeUUID))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockRestock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockrestock.FieldID)
+		for _, f := range fields {
+			if !stockrestock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockrestock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &StockRestock{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockrestock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
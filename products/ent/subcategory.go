@@ -31,7 +31,6 @@ type SubCategory struct {
 	// The values are being populated by the SubCategoryQuery when eager-loading is set.
 	Edges                  SubCategoryEdges `json:"edges"`
 	category_subcategories *uuid.UUID
-	sub_category_category  *uuid.UUID
 	selectValues           sql.SelectValues
 }
 
@@ -79,8 +78,6 @@ func (*SubCategory) scanValues(columns []string) ([]any, error) {
 			values[i] = new(uuid.UUID)
 		case subcategory.ForeignKeys[0]: // category_subcategories
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case subcategory.ForeignKeys[1]: // sub_category_category
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -134,13 +131,6 @@ func (sc *SubCategory) assignValues(columns []string, values []any) error {
 				sc.category_subcategories = new(uuid.UUID)
 				*sc.category_subcategories = *value.S.(*uuid.UUID)
 			}
-		case subcategory.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field sub_category_category", values[i])
-			} else if value.Valid {
-				sc.sub_category_category = new(uuid.UUID)
-				*sc.sub_category_category = *value.S.(*uuid.UUID)
-			}
 		default:
 			sc.selectValues.Set(columns[i], values[i])
 		}
@@ -35,7 +35,7 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "category" package.
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
-	CategoryColumn = "sub_category_category"
+	CategoryColumn = "category_subcategories"
 	// ProductsTable is the table that holds the products relation/edge.
 	ProductsTable = "products"
 	// ProductsInverseTable is the table name for the Product entity.
@@ -58,7 +58,6 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"category_subcategories",
-	"sub_category_category",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -141,7 +140,7 @@ func newCategoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(CategoryInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
 	)
 }
 func newProductsStep() *sqlgraph.Step {
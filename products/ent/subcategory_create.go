@@ -233,7 +233,7 @@ func (scc *SubCategoryCreate) createSpec() (*SubCategory, *sqlgraph.CreateSpec)
 	if nodes := scc.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   subcategory.CategoryTable,
 			Columns: []string{subcategory.CategoryColumn},
 			Bidi:    false,
@@ -244,7 +244,7 @@ func (scc *SubCategoryCreate) createSpec() (*SubCategory, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.sub_category_category = &nodes[0]
+		_node.category_subcategories = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := scc.mutation.ProductsIDs(); len(nodes) > 0 {
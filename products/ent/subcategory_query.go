@@ -79,7 +79,7 @@ func (scq *SubCategoryQuery) QueryCategory() *CategoryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(subcategory.Table, subcategory.FieldID, selector),
 			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, subcategory.CategoryTable, subcategory.CategoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, subcategory.CategoryTable, subcategory.CategoryColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
 		return fromU, nil
@@ -459,10 +459,10 @@ func (scq *SubCategoryQuery) loadCategory(ctx context.Context, query *CategoryQu
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*SubCategory)
 	for i := range nodes {
-		if nodes[i].sub_category_category == nil {
+		if nodes[i].category_subcategories == nil {
 			continue
 		}
-		fk := *nodes[i].sub_category_category
+		fk := *nodes[i].category_subcategories
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -479,7 +479,7 @@ func (scq *SubCategoryQuery) loadCategory(ctx context.Context, query *CategoryQu
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "sub_category_category" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "category_subcategories" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -205,7 +205,7 @@ func (scu *SubCategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if scu.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   subcategory.CategoryTable,
 			Columns: []string{subcategory.CategoryColumn},
 			Bidi:    false,
@@ -218,7 +218,7 @@ func (scu *SubCategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := scu.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   subcategory.CategoryTable,
 			Columns: []string{subcategory.CategoryColumn},
 			Bidi:    false,
@@ -500,7 +500,7 @@ func (scuo *SubCategoryUpdateOne) sqlSave(ctx context.Context) (_node *SubCatego
 	if scuo.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   subcategory.CategoryTable,
 			Columns: []string{subcategory.CategoryColumn},
 			Bidi:    false,
@@ -513,7 +513,7 @@ func (scuo *SubCategoryUpdateOne) sqlSave(ctx context.Context) (_node *SubCatego
 	if nodes := scuo.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
+			Inverse: true,
 			Table:   subcategory.CategoryTable,
 			Columns: []string{subcategory.CategoryColumn},
 			Bidi:    false,
@@ -14,8 +14,18 @@ type Tx struct {
 	config
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// FailedStockAdjustment is the client for interacting with the FailedStockAdjustment builders.
+	FailedStockAdjustment *FailedStockAdjustmentClient
+	// PriceTier is the client for interacting with the PriceTier builders.
+	PriceTier *PriceTierClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// Review is the client for interacting with the Review builders.
+	Review *ReviewClient
+	// StockDeduction is the client for interacting with the StockDeduction builders.
+	StockDeduction *StockDeductionClient
+	// StockRestock is the client for interacting with the StockRestock builders.
+	StockRestock *StockRestockClient
 	// SubCategory is the client for interacting with the SubCategory builders.
 	SubCategory *SubCategoryClient
 
@@ -150,7 +160,12 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Category = NewCategoryClient(tx.config)
+	tx.FailedStockAdjustment = NewFailedStockAdjustmentClient(tx.config)
+	tx.PriceTier = NewPriceTierClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.Review = NewReviewClient(tx.config)
+	tx.StockDeduction = NewStockDeductionClient(tx.config)
+	tx.StockRestock = NewStockRestockClient(tx.config)
 	tx.SubCategory = NewSubCategoryClient(tx.config)
 }
 
@@ -3,12 +3,18 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
 	"go-micro.dev/v5/logger"
 
 	"products/ent"
+	"products/ent/category"
+	"products/ent/failedstockadjustment"
+	"products/ent/predicate"
 	"products/ent/product"
+	"products/ent/subcategory"
 	pb "products/proto"
 )
 
@@ -19,29 +25,288 @@ type AdminService struct {
 
 // ForceDeleteProduct handles the forced deletion of a product (admin privilege)
 func (h *AdminService) ForceDeleteProduct(ctx context.Context, req *pb.ForceDeleteProductRequest, rsp *pb.ForceDeleteProductResponse) error {
-	logger.Infof("Received ForceDeleteProduct request for ID: %s (Admin operation)", req.Id)
+	logger.Extract(ctx).Infof("Received ForceDeleteProduct request for ID: %s (Admin operation)", req.Id)
 
 	err := h.EntClient.Product.DeleteOneID(uuid.MustParse(req.Id)).Exec(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Product not found for deletion: %s", req.Id)
+		logger.Extract(ctx).Infof("Product not found for deletion: %s", req.Id)
 		rsp.Success = false
 		return fmt.Errorf("product not found for deletion: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to force delete product: %v", err)
+		logger.Extract(ctx).Errorf("Failed to force delete product: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to force delete product: %w", err)
 	}
 
 	rsp.Id = req.Id
 	rsp.Success = true
-	logger.Infof("Product force deleted successfully: %s", req.Id)
+	logger.Extract(ctx).Infof("Product force deleted successfully: %s", req.Id)
+	return nil
+}
+
+// ActivateProduct makes a deactivated product visible to customers again (admin privilege)
+func (h *AdminService) ActivateProduct(ctx context.Context, req *pb.ActivateProductRequest, rsp *pb.ActivateProductResponse) error {
+	logger.Extract(ctx).Infof("Received ActivateProduct request for ID: %s (Admin operation)", req.Id)
+
+	p, err := h.setProductActive(ctx, "products.ActivateProduct", req.Id, true)
+	if err != nil {
+		return err
+	}
+
+	rsp.Product = toProtoProduct(p)
+	logger.Extract(ctx).Infof("Product activated successfully: %s", p.ID)
+	return nil
+}
+
+// DeactivateProduct hides a product from customers without deleting it (admin privilege)
+func (h *AdminService) DeactivateProduct(ctx context.Context, req *pb.DeactivateProductRequest, rsp *pb.DeactivateProductResponse) error {
+	logger.Extract(ctx).Infof("Received DeactivateProduct request for ID: %s (Admin operation)", req.Id)
+
+	p, err := h.setProductActive(ctx, "products.DeactivateProduct", req.Id, false)
+	if err != nil {
+		return err
+	}
+
+	rsp.Product = toProtoProduct(p)
+	logger.Extract(ctx).Infof("Product deactivated successfully: %s", p.ID)
+	return nil
+}
+
+// setProductActive sets a product's is_active flag, bumping its version so edits based on
+// the old state are rejected, and returns it with its subcategory and category
+func (h *AdminService) setProductActive(ctx context.Context, endpoint, id string, active bool) (*ent.Product, error) {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product id: %s", id)
+	}
+
+	err = h.EntClient.Product.UpdateOneID(productID).
+		SetIsActive(active).
+		AddVersion(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Product not found: %s", id)
+		return nil, errors.NotFound(endpoint, "product not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to set is_active of product %s: %v", id, err)
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	p, err := h.EntClient.Product.Query().
+		Where(product.ID(productID)).
+		WithSubcategory(func(q *ent.SubCategoryQuery) {
+			q.WithCategory()
+		}).
+		Only(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to fetch product with subcategory: %v", err)
+		return nil, fmt.Errorf("failed to fetch product: %w", err)
+	}
+	return p, nil
+}
+
+// GetProduct fetches a product by ID, including an inactive one when asked (admin privilege)
+func (h *AdminService) GetProduct(ctx context.Context, req *pb.GetProductRequest, rsp *pb.GetProductResponse) error {
+	logger.Extract(ctx).Infof("Received GetProduct request for ID: %s, include_inactive: %v (Admin operation)", req.Id, req.IncludeInactive)
+
+	return getProduct(ctx, h.EntClient, req.Id, req.IncludeInactive, rsp)
+}
+
+// ListProducts lists products, including inactive ones when asked (admin privilege)
+func (h *AdminService) ListProducts(ctx context.Context, req *pb.ListProductsRequest, rsp *pb.ListProductsResponse) error {
+	logger.Extract(ctx).Infof("Received ListProducts request (limit: %d, offset: %d, include_inactive: %v) (Admin operation)", req.Limit, req.Offset, req.IncludeInactive)
+
+	return listProducts(ctx, h.EntClient, req, req.IncludeInactive, rsp)
+}
+
+// SearchProducts searches products, including inactive ones when asked (admin privilege).
+// Admin searches bypass the search cache.
+func (h *AdminService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest, rsp *pb.SearchProductsResponse) error {
+	logger.Extract(ctx).Infof("Received SearchProducts request (query: %s, fuzzy: %v, include_inactive: %v) (Admin operation)", req.Query, req.Fuzzy, req.IncludeInactive)
+
+	return searchProducts(ctx, h.EntClient, req, req.IncludeInactive, rsp)
+}
+
+// DeleteCategory deletes an empty category (admin privilege). With cascade set its
+// subcategories are deleted too, but only if none of them still has products.
+func (h *AdminService) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest, rsp *pb.DeleteCategoryResponse) error {
+	logger.Extract(ctx).Infof("Received DeleteCategory request for ID: %s, cascade: %v (Admin operation)", req.Id, req.Cascade)
+
+	categoryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid category id: %s", req.Id)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for category deletion: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	subcategoryIDs, err := tx.SubCategory.Query().
+		Where(subcategory.HasCategoryWith(category.ID(categoryID))).
+		IDs(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to query subcategories of category %s: %v", req.Id, err)
+		return fmt.Errorf("failed to query subcategories: %w", err)
+	}
+
+	if len(subcategoryIDs) > 0 {
+		if !req.Cascade {
+			logger.Extract(ctx).Infof("Refusing to delete category %s with %d subcategories", req.Id, len(subcategoryIDs))
+			return fmt.Errorf("category not empty: %d subcategories", len(subcategoryIDs))
+		}
+
+		hasProducts, err := tx.Product.Query().
+			Where(product.HasSubcategoryWith(subcategory.IDIn(subcategoryIDs...))).
+			Exist(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to check products of category %s: %v", req.Id, err)
+			return fmt.Errorf("failed to check products: %w", err)
+		}
+		if hasProducts {
+			logger.Extract(ctx).Infof("Refusing to cascade delete category %s whose subcategories have products", req.Id)
+			return fmt.Errorf("category not empty: subcategories still have products")
+		}
+
+		if _, err := tx.SubCategory.Delete().Where(subcategory.IDIn(subcategoryIDs...)).Exec(ctx); err != nil {
+			logger.Extract(ctx).Errorf("Failed to delete subcategories of category %s: %v", req.Id, err)
+			return fmt.Errorf("failed to delete subcategories: %w", err)
+		}
+	}
+
+	err = tx.Category.DeleteOneID(categoryID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Category not found for deletion: %s", req.Id)
+		return fmt.Errorf("category not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to delete category: %v", err)
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit category deletion: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Id = req.Id
+	rsp.Success = true
+	rsp.DeletedSubcategories = int32(len(subcategoryIDs))
+	logger.Extract(ctx).Infof("Category deleted successfully: %s (%d subcategories)", req.Id, len(subcategoryIDs))
+	return nil
+}
+
+// DeleteSubcategory deletes a subcategory that has no products (admin privilege)
+func (h *AdminService) DeleteSubcategory(ctx context.Context, req *pb.DeleteSubcategoryRequest, rsp *pb.DeleteSubcategoryResponse) error {
+	logger.Extract(ctx).Infof("Received DeleteSubcategory request for ID: %s (Admin operation)", req.Id)
+
+	subcategoryID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid subcategory id: %s", req.Id)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for subcategory deletion: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	products, err := tx.Product.Query().
+		Where(product.HasSubcategoryWith(subcategory.ID(subcategoryID))).
+		Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count products of subcategory %s: %v", req.Id, err)
+		return fmt.Errorf("failed to count products: %w", err)
+	}
+	if products > 0 {
+		logger.Extract(ctx).Infof("Refusing to delete subcategory %s with %d products", req.Id, products)
+		return fmt.Errorf("subcategory not empty: %d products", products)
+	}
+
+	err = tx.SubCategory.DeleteOneID(subcategoryID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Subcategory not found for deletion: %s", req.Id)
+		return fmt.Errorf("subcategory not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to delete subcategory: %v", err)
+		return fmt.Errorf("failed to delete subcategory: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit subcategory deletion: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Id = req.Id
+	rsp.Success = true
+	logger.Extract(ctx).Infof("Subcategory deleted successfully: %s", req.Id)
+	return nil
+}
+
+// ReassignProductsSubcategory moves every product of one subcategory to another (admin privilege)
+func (h *AdminService) ReassignProductsSubcategory(ctx context.Context, req *pb.ReassignProductsSubcategoryRequest, rsp *pb.ReassignProductsSubcategoryResponse) error {
+	logger.Extract(ctx).Infof("Received ReassignProductsSubcategory request from %s to %s (Admin operation)", req.FromSubcategoryId, req.ToSubcategoryId)
+
+	fromID, err := uuid.Parse(req.FromSubcategoryId)
+	if err != nil {
+		return fmt.Errorf("invalid from_subcategory_id: %s", req.FromSubcategoryId)
+	}
+	toID, err := uuid.Parse(req.ToSubcategoryId)
+	if err != nil {
+		return fmt.Errorf("invalid to_subcategory_id: %s", req.ToSubcategoryId)
+	}
+	if fromID == toID {
+		return fmt.Errorf("from_subcategory_id and to_subcategory_id must differ")
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for subcategory reassignment: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range []uuid.UUID{fromID, toID} {
+		exists, err := tx.SubCategory.Query().Where(subcategory.ID(id)).Exist(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to look up subcategory %s: %v", id, err)
+			return fmt.Errorf("failed to look up subcategory: %w", err)
+		}
+		if !exists {
+			logger.Extract(ctx).Infof("Subcategory not found for reassignment: %s", id)
+			return fmt.Errorf("subcategory not found: %s", id)
+		}
+	}
+
+	n, err := tx.Product.Update().
+		Where(product.HasSubcategoryWith(subcategory.ID(fromID))).
+		SetSubcategoryID(toID).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to reassign products from subcategory %s: %v", req.FromSubcategoryId, err)
+		return fmt.Errorf("failed to reassign products: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit subcategory reassignment: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Reassigned = int32(n)
+	logger.Extract(ctx).Infof("Reassigned %d products from subcategory %s to %s", n, req.FromSubcategoryId, req.ToSubcategoryId)
 	return nil
 }
 
 // BulkCreateProducts handles streaming creation of multiple products
 func (h *AdminService) BulkCreateProducts(ctx context.Context, stream pb.AdminService_BulkCreateProductsStream) error {
-	logger.Infof("Received BulkCreateProducts stream request (Admin operation)")
+	logger.Extract(ctx).Infof("Received BulkCreateProducts stream request (Admin operation)")
 	var createdProducts []*pb.Product
 	var totalCreated int32
 
@@ -52,51 +317,70 @@ func (h *AdminService) BulkCreateProducts(ctx context.Context, stream pb.AdminSe
 			if err.Error() == "EOF" { // go-micro uses EOF for end of stream
 				break
 			}
-			logger.Errorf("Error receiving from BulkCreateProducts stream: %v", err)
+			logger.Extract(ctx).Errorf("Error receiving from BulkCreateProducts stream: %v", err)
 			return fmt.Errorf("error receiving product data: %w", err)
 		}
 
-		logger.Infof("Bulk creating product: %s", req.Name)
+		logger.Extract(ctx).Infof("Bulk creating product: %s", req.Name)
+
+		if err := Limits.check(req.Name, req.Description); err != nil {
+			logger.Extract(ctx).Infof("BulkCreateProducts: Skipping %s: %v", req.Name, err)
+			continue
+		}
+
+		currency, err := normalizeCurrency(req.Currency)
+		if err != nil {
+			logger.Extract(ctx).Infof("BulkCreateProducts: Invalid currency for product %s: %q", req.Name, req.Currency)
+			continue
+		}
+
+		sku, err := normalizeSKU(req.Sku)
+		if err != nil {
+			logger.Extract(ctx).Infof("BulkCreateProducts: Rejected product %s: %v", req.Name, err)
+			continue
+		}
 
 		// Validate subcategory exists
 		_, err = h.EntClient.SubCategory.Get(ctx, uuid.MustParse(req.SubcategoryId))
 		if ent.IsNotFound(err) {
-			logger.Infof("Subcategory not found: %s", req.SubcategoryId)
+			logger.Extract(ctx).Infof("Subcategory not found: %s", req.SubcategoryId)
 			continue
 		}
 		if err != nil {
-			logger.Errorf("Failed to validate subcategory: %v", err)
+			logger.Extract(ctx).Errorf("Failed to validate subcategory: %v", err)
 			continue
 		}
 
 		// Start a transaction for each product creation
 		tx, err := h.EntClient.Tx(ctx)
 		if err != nil {
-			logger.Errorf("BulkCreateProducts: Failed to start transaction for %s: %v", req.Name, err)
+			logger.Extract(ctx).Errorf("BulkCreateProducts: Failed to start transaction for %s: %v", req.Name, err)
 			continue
 		}
 
 		p, err := tx.Product.Create().
 			SetName(req.Name).
+			SetSku(sku).
 			SetDescription(req.Description).
-			SetPrice(req.Price).
+			SetPriceCents(requestCents(req.PriceCents, req.Price)).
+			SetCurrency(currency).
 			SetStockQuantity(int(req.StockQuantity)).
 			SetUserID(uuid.MustParse(req.UserId)).
 			SetSubcategoryID(uuid.MustParse(req.SubcategoryId)).
 			Save(ctx)
 		if ent.IsConstraintError(err) {
-			logger.Errorf("BulkCreateProducts: Constraint violation for product %s: %v", req.Name, err)
+			logger.Extract(ctx).Errorf("BulkCreateProducts: Constraint violation for product %s: %v", req.Name, err)
 			tx.Rollback()
 			continue
 		}
 		if err != nil {
-			logger.Errorf("BulkCreateProducts: Failed to create product %s: %v", req.Name, err)
+			logger.Extract(ctx).Errorf("BulkCreateProducts: Failed to create product %s: %v", req.Name, err)
 			tx.Rollback()
 			continue
 		}
 
 		if err = tx.Commit(); err != nil {
-			logger.Errorf("BulkCreateProducts: Failed to commit transaction for product %s: %v", p.ID, err)
+			logger.Extract(ctx).Errorf("BulkCreateProducts: Failed to commit transaction for product %s: %v", p.ID, err)
 			continue
 		}
 
@@ -108,7 +392,7 @@ func (h *AdminService) BulkCreateProducts(ctx context.Context, stream pb.AdminSe
 			}).
 			Only(ctx)
 		if err != nil {
-			logger.Errorf("BulkCreateProducts: Failed to fetch product with subcategory %s: %v", p.ID, err)
+			logger.Extract(ctx).Errorf("BulkCreateProducts: Failed to fetch product with subcategory %s: %v", p.ID, err)
 			continue
 		}
 
@@ -122,17 +406,17 @@ func (h *AdminService) BulkCreateProducts(ctx context.Context, stream pb.AdminSe
 		Total:    totalCreated,
 	})
 	if err != nil {
-		logger.Errorf("Error sending BulkCreateProducts response: %v", err)
+		logger.Extract(ctx).Errorf("Error sending BulkCreateProducts response: %v", err)
 		return fmt.Errorf("failed to send response: %w", err)
 	}
 
-	logger.Infof("BulkCreateProducts: Successfully created %d products.", totalCreated)
+	logger.Extract(ctx).Infof("BulkCreateProducts: Successfully created %d products.", totalCreated)
 	return nil
 }
 
 // ExportProducts streams all products, optionally filtered and paginated
 func (h *AdminService) ExportProducts(ctx context.Context, req *pb.ExportProductsRequest, stream pb.AdminService_ExportProductsStream) error {
-	logger.Infof("Received ExportProducts stream request (limit: %d, offset: %d, filter: %s)", req.Limit, req.Offset, req.Filter)
+	logger.Extract(ctx).Infof("Received ExportProducts stream request (limit: %d, offset: %d, filter: %s)", req.Limit, req.Offset, req.Filter)
 
 	query := h.EntClient.Product.Query().
 		WithSubcategory(func(q *ent.SubCategoryQuery) {
@@ -153,18 +437,144 @@ func (h *AdminService) ExportProducts(ctx context.Context, req *pb.ExportProduct
 
 	products, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to retrieve products for export: %v", err)
+		logger.Extract(ctx).Errorf("Failed to retrieve products for export: %v", err)
 		return fmt.Errorf("failed to retrieve products for export: %w", err)
 	}
 
 	for _, p := range products {
 		protoProduct := toProtoProduct(p)
 		if err := stream.Send(protoProduct); err != nil {
-			logger.Errorf("Error sending product %s during export: %v", p.ID, err)
+			logger.Extract(ctx).Errorf("Error sending product %s during export: %v", p.ID, err)
 			return fmt.Errorf("failed to stream product: %w", err)
 		}
 	}
 
-	logger.Infof("Successfully exported %d products.", len(products))
+	logger.Extract(ctx).Infof("Successfully exported %d products.", len(products))
+	return nil
+}
+
+// ListFailedStockAdjustments lists order items whose stock could not be deducted, oldest first (admin privilege)
+func (h *AdminService) ListFailedStockAdjustments(ctx context.Context, req *pb.ListFailedStockAdjustmentsRequest, rsp *pb.ListFailedStockAdjustmentsResponse) error {
+	logger.Extract(ctx).Infof("Received ListFailedStockAdjustments request (include_resolved: %v, limit: %d, offset: %d)", req.IncludeResolved, req.Limit, req.Offset)
+
+	var preds []predicate.FailedStockAdjustment
+	if !req.IncludeResolved {
+		preds = append(preds, failedstockadjustment.ResolvedAtIsNil())
+	}
+
+	query := h.EntClient.FailedStockAdjustment.Query().
+		Where(preds...).
+		Order(failedstockadjustment.ByCreatedAt())
+	if req.Limit > 0 {
+		query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query.Offset(int(req.Offset))
+	}
+
+	adjustments, err := query.All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list failed stock adjustments: %v", err)
+		return fmt.Errorf("failed to list failed stock adjustments: %w", err)
+	}
+
+	total, err := h.EntClient.FailedStockAdjustment.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count failed stock adjustments: %v", err)
+		return fmt.Errorf("failed to count failed stock adjustments: %w", err)
+	}
+
+	rsp.Adjustments = make([]*pb.FailedStockAdjustment, len(adjustments))
+	for i, a := range adjustments {
+		rsp.Adjustments[i] = toProtoFailedStockAdjustment(a)
+	}
+	rsp.Total = int32(total)
+	logger.Extract(ctx).Infof("Listed %d failed stock adjustments (total: %d)", len(adjustments), total)
+	return nil
+}
+
+// RetryStockAdjustment deducts the stock of a failed adjustment again, e.g. once its
+// product has been created, resolving it on success (admin privilege)
+func (h *AdminService) RetryStockAdjustment(ctx context.Context, req *pb.RetryStockAdjustmentRequest, rsp *pb.RetryStockAdjustmentResponse) error {
+	logger.Extract(ctx).Infof("Received RetryStockAdjustment request for ID: %s (Admin operation)", req.Id)
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid id: %s", req.Id)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for stock adjustment %s: %v", req.Id, err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	a, err := tx.FailedStockAdjustment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Failed stock adjustment not found: %s", req.Id)
+		return fmt.Errorf("failed stock adjustment not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get failed stock adjustment: %v", err)
+		return fmt.Errorf("failed to get failed stock adjustment: %w", err)
+	}
+	if a.ResolvedAt != nil {
+		logger.Extract(ctx).Infof("Stock adjustment %s already resolved", req.Id)
+		rsp.Adjustment = toProtoFailedStockAdjustment(a)
+		return nil
+	}
+
+	// Resolve it first so a concurrent retry can't deduct the stock twice
+	a, err = tx.FailedStockAdjustment.UpdateOne(a).
+		Where(failedstockadjustment.ResolvedAtIsNil()).
+		AddAttempts(1).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("stock adjustment %s was resolved concurrently", req.Id)
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to resolve stock adjustment %s: %v", req.Id, err)
+		return fmt.Errorf("failed to resolve stock adjustment: %w", err)
+	}
+
+	if deductErr := deductStock(ctx, tx, a.ProductID, a.Quantity); deductErr != nil {
+		tx.Rollback()
+		logger.Extract(ctx).Infof("Retry of stock adjustment %s failed: %v", req.Id, deductErr)
+		err := h.EntClient.FailedStockAdjustment.UpdateOneID(id).
+			AddAttempts(1).
+			SetReason(deductErr.Error()).
+			Exec(ctx)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to record retry of stock adjustment %s: %v", req.Id, err)
+		}
+		return fmt.Errorf("stock adjustment still failing: %w", deductErr)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit stock adjustment %s: %v", req.Id, err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Adjustment = toProtoFailedStockAdjustment(a)
+	logger.Extract(ctx).Infof("Stock adjustment %s resolved, deducted %d of product %s for order %s", req.Id, a.Quantity, a.ProductID, a.OrderID)
 	return nil
 }
+
+// toProtoFailedStockAdjustment converts an Entgo FailedStockAdjustment entity to a Protobuf message
+func toProtoFailedStockAdjustment(a *ent.FailedStockAdjustment) *pb.FailedStockAdjustment {
+	protoAdjustment := &pb.FailedStockAdjustment{
+		Id:        a.ID.String(),
+		OrderId:   a.OrderID.String(),
+		ProductId: a.ProductID,
+		Quantity:  int32(a.Quantity),
+		Reason:    a.Reason,
+		Attempts:  int32(a.Attempts),
+		CreatedAt: a.CreatedAt.Unix(),
+	}
+	if a.ResolvedAt != nil {
+		protoAdjustment.ResolvedAt = a.ResolvedAt.Unix()
+	}
+	return protoAdjustment
+}
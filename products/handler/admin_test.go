@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"products/ent/product"
+	"products/ent/subcategory"
+	pb "products/proto"
+)
+
+func TestReassignProductsSubcategory(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+	from, to := createTestSubcategory(t, client), createTestSubcategory(t, client)
+	for _, sku := range []string{"MUG-1", "MUG-2", "MUG-3"} {
+		p := createTestProduct(t, client, "Mug", sku, 5)
+		client.Product.UpdateOne(p).SetSubcategory(from).ExecX(ctx)
+	}
+	other := createTestProduct(t, client, "Pen", "PEN-1", 5)
+
+	rsp := &pb.ReassignProductsSubcategoryResponse{}
+	err := admin.ReassignProductsSubcategory(ctx, &pb.ReassignProductsSubcategoryRequest{
+		FromSubcategoryId: from.ID.String(),
+		ToSubcategoryId:   to.ID.String(),
+	}, rsp)
+	if err != nil {
+		t.Fatalf("ReassignProductsSubcategory: %v", err)
+	}
+	if rsp.Reassigned != 3 {
+		t.Fatalf("expected 3 products reassigned, got %d", rsp.Reassigned)
+	}
+	if n := client.Product.Query().Where(product.HasSubcategoryWith(subcategory.ID(from.ID))).CountX(ctx); n != 0 {
+		t.Fatalf("expected the source subcategory empty, found %d products", n)
+	}
+	if n := client.Product.Query().Where(product.HasSubcategoryWith(subcategory.ID(to.ID))).CountX(ctx); n != 3 {
+		t.Fatalf("expected 3 products in the target subcategory, found %d", n)
+	}
+	if sc := client.Product.QuerySubcategory(other).OnlyX(ctx); sc.ID == from.ID || sc.ID == to.ID {
+		t.Fatal("expected products of other subcategories left alone")
+	}
+
+	err = admin.ReassignProductsSubcategory(ctx, &pb.ReassignProductsSubcategoryRequest{
+		FromSubcategoryId: to.ID.String(),
+		ToSubcategoryId:   uuid.NewString(),
+	}, &pb.ReassignProductsSubcategoryResponse{})
+	if err == nil {
+		t.Fatal("expected reassigning to an unknown subcategory to fail")
+	}
+	if n := client.Product.Query().Where(product.HasSubcategoryWith(subcategory.ID(to.ID))).CountX(ctx); n != 3 {
+		t.Fatalf("expected a failed reassignment to move nothing, found %d products left", n)
+	}
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	pb "products/proto"
+)
+
+// StockThresholds decides which availability bucket a stock quantity falls into
+type StockThresholds struct {
+	// OutOfStock is the highest quantity still reported as out of stock
+	OutOfStock int
+	// LowStock is the highest quantity reported as low stock
+	LowStock int
+}
+
+// Thresholds are applied by toProtoProduct; main overrides the defaults from the environment
+var Thresholds = StockThresholds{OutOfStock: 0, LowStock: 5}
+
+// Availability maps a stock quantity to its availability bucket
+func (t StockThresholds) Availability(quantity int) pb.Availability {
+	switch {
+	case quantity <= t.OutOfStock:
+		return pb.Availability_OUT_OF_STOCK
+	case quantity <= t.LowStock:
+		return pb.Availability_LOW_STOCK
+	default:
+		return pb.Availability_IN_STOCK
+	}
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	pb "products/proto"
+)
+
+func TestAvailabilityBoundaries(t *testing.T) {
+	tests := []struct {
+		thresholds StockThresholds
+		quantity   int
+		want       pb.Availability
+	}{
+		{Thresholds, 0, pb.Availability_OUT_OF_STOCK},
+		{Thresholds, 1, pb.Availability_LOW_STOCK},
+		{Thresholds, 5, pb.Availability_LOW_STOCK},
+		{Thresholds, 6, pb.Availability_IN_STOCK},
+		{StockThresholds{OutOfStock: 2, LowStock: 10}, 2, pb.Availability_OUT_OF_STOCK},
+		{StockThresholds{OutOfStock: 2, LowStock: 10}, 3, pb.Availability_LOW_STOCK},
+		{StockThresholds{OutOfStock: 2, LowStock: 10}, 10, pb.Availability_LOW_STOCK},
+		{StockThresholds{OutOfStock: 2, LowStock: 10}, 11, pb.Availability_IN_STOCK},
+	}
+	for _, tt := range tests {
+		if got := tt.thresholds.Availability(tt.quantity); got != tt.want {
+			t.Errorf("%+v.Availability(%d) = %s, want %s", tt.thresholds, tt.quantity, got, tt.want)
+		}
+	}
+}
+
+func TestToProtoProductReportsAvailability(t *testing.T) {
+	client := newTestClient(t)
+	for quantity, want := range map[int]pb.Availability{
+		0:  pb.Availability_OUT_OF_STOCK,
+		5:  pb.Availability_LOW_STOCK,
+		50: pb.Availability_IN_STOCK,
+	} {
+		p := createTestProduct(t, client, "Mug", fmt.Sprintf("MUG-%d", quantity), quantity)
+		if got := toProtoProduct(p).Availability; got != want {
+			t.Errorf("stock %d: got %s, want %s", quantity, got, want)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"products/ent"
+	pb "products/proto"
+)
+
+// CatalogVersion counts changes to the catalog so caches can tell when their entries are stale
+type CatalogVersion struct {
+	n atomic.Uint64
+}
+
+// Current returns the version of the catalog as of now
+func (v *CatalogVersion) Current() uint64 {
+	return v.n.Load()
+}
+
+// Hook returns an ent hook that bumps the version after every successful mutation. Inside a
+// transaction it bumps again on commit, so nothing cached while the transaction was open
+// outlives it.
+func (v *CatalogVersion) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			v.n.Add(1)
+			if txm, ok := m.(interface{ Tx() (*ent.Tx, error) }); ok {
+				if tx, err := txm.Tx(); err == nil {
+					tx.OnCommit(func(next ent.Committer) ent.Committer {
+						return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+							err := next.Commit(ctx, tx)
+							v.n.Add(1)
+							return err
+						})
+					})
+				}
+			}
+			return value, nil
+		})
+	}
+}
+
+// SearchCache is an in-process LRU cache of SearchProducts responses. Entries expire after
+// a TTL and are ignored once the catalog version moves past the one they were read at.
+// A nil *SearchCache caches nothing.
+type SearchCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	version *CatalogVersion
+	entries map[string]*list.Element
+	recent  *list.List // Most recently used first
+}
+
+// searchCacheEntry is a cached response and the catalog version it was read at
+type searchCacheEntry struct {
+	key     string
+	version uint64
+	expires time.Time
+	rsp     *pb.SearchProductsResponse
+}
+
+// NewSearchCache returns a cache holding up to size responses for ttl each, or nil if size is zero
+func NewSearchCache(size int, ttl time.Duration, version *CatalogVersion) *SearchCache {
+	if size <= 0 {
+		return nil
+	}
+	return &SearchCache{
+		size:    size,
+		ttl:     ttl,
+		version: version,
+		entries: make(map[string]*list.Element, size),
+		recent:  list.New(),
+	}
+}
+
+// Version returns the current catalog version, to be passed to Put with the response read after it
+func (c *SearchCache) Version() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.version.Current()
+}
+
+// Get returns a copy of the cached response for key if it is still fresh
+func (c *SearchCache) Get(key string) (*pb.SearchProductsResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*searchCacheEntry)
+	if e.version != c.version.Current() || time.Now().After(e.expires) {
+		c.recent.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.recent.MoveToFront(el)
+	return proto.Clone(e.rsp).(*pb.SearchProductsResponse), true
+}
+
+// Put caches a copy of rsp under key, evicting the least recently used entry when full
+func (c *SearchCache) Put(key string, version uint64, rsp *pb.SearchProductsResponse) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &searchCacheEntry{
+		key:     key,
+		version: version,
+		expires: time.Now().Add(c.ttl),
+		rsp:     proto.Clone(rsp).(*pb.SearchProductsResponse),
+	}
+	if el, ok := c.entries[key]; ok {
+		el.Value = e
+		c.recent.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.recent.PushFront(e)
+	if c.recent.Len() > c.size {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(*searchCacheEntry).key)
+	}
+}
+
+// searchCacheKey identifies a search by its normalized query and pagination, so queries
+// differing only in case or punctuation share an entry
+func searchCacheKey(req *pb.SearchProductsRequest) string {
+	return fmt.Sprintf("%s|%d|%d|%v|%d|%d|%d|%v|%v", strings.Join(normalizeQuery(req.Query), " "), req.Limit, req.Offset, req.Fuzzy, req.MaxDistance, req.MinPriceCents, req.MaxPriceCents, req.InStockOnly, req.ActiveOnly)
+}
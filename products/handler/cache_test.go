@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"products/ent"
+	"products/ent/enttest"
+	pb "products/proto"
+)
+
+// newCachedProductService returns a ProductService whose SearchCache is invalidated by
+// writes through its client, and a second client on the same database whose writes
+// bypass the catalog version, so they only show once the cache misses
+func newCachedProductService(t *testing.T) (*ProductService, *ent.Client) {
+	t.Helper()
+	dsn := "file:" + uuid.NewString() + "?mode=memory&cache=shared&_fk=1"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	bypass := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { bypass.Close() })
+
+	version := &CatalogVersion{}
+	client.Use(version.Hook())
+	return &ProductService{EntClient: client, SearchCache: NewSearchCache(10, time.Minute, version)}, bypass
+}
+
+// searchNames runs SearchProducts and returns the names of the products found
+func searchNames(t *testing.T, h *ProductService, query string) []string {
+	t.Helper()
+	rsp := &pb.SearchProductsResponse{}
+	if err := h.SearchProducts(context.Background(), &pb.SearchProductsRequest{Query: query, Limit: 10}, rsp); err != nil {
+		t.Fatalf("SearchProducts(%q): %v", query, err)
+	}
+	names := make([]string, len(rsp.Products))
+	for i, p := range rsp.Products {
+		names[i] = p.Name
+	}
+	return names
+}
+
+func TestSearchCacheServesRepeatQueries(t *testing.T) {
+	h, bypass := newCachedProductService(t)
+	ctx := context.Background()
+	p := createTestProduct(t, h.EntClient, "Blue Mug", "MUG-1", 5)
+
+	if got := searchNames(t, h, "mug"); len(got) != 1 || got[0] != "Blue Mug" {
+		t.Fatalf("expected Blue Mug found, got %v", got)
+	}
+
+	// A rename the cache doesn't hear about stays hidden behind the cached response
+	bypass.Product.UpdateOneID(p.ID).SetName("Red Mug").ExecX(ctx)
+	if got := searchNames(t, h, "  MUG "); len(got) != 1 || got[0] != "Blue Mug" {
+		t.Fatalf("expected the normalized repeat query served from cache, got %v", got)
+	}
+}
+
+func TestSearchCacheInvalidatedByProductUpdate(t *testing.T) {
+	h, _ := newCachedProductService(t)
+	ctx := context.Background()
+	p := createTestProduct(t, h.EntClient, "Blue Mug", "MUG-1", 5)
+
+	if got := searchNames(t, h, "mug"); len(got) != 1 || got[0] != "Blue Mug" {
+		t.Fatalf("expected Blue Mug found, got %v", got)
+	}
+	h.EntClient.Product.UpdateOneID(p.ID).SetName("Red Mug").ExecX(ctx)
+	if got := searchNames(t, h, "mug"); len(got) != 1 || got[0] != "Red Mug" {
+		t.Fatalf("expected the update to invalidate the cached search, got %v", got)
+	}
+}
+
+func TestSearchCacheInvalidatedOnCommit(t *testing.T) {
+	h, _ := newCachedProductService(t)
+	ctx := context.Background()
+	p := createTestProduct(t, h.EntClient, "Blue Mug", "MUG-1", 5)
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		t.Fatalf("starting transaction: %v", err)
+	}
+	tx.Product.UpdateOneID(p.ID).SetName("Red Mug").ExecX(ctx)
+
+	// A response read while the transaction is open predates its commit
+	key := searchCacheKey(&pb.SearchProductsRequest{Query: "mug", Limit: 10})
+	h.SearchCache.Put(key, h.SearchCache.Version(), &pb.SearchProductsResponse{Total: 1})
+	if _, ok := h.SearchCache.Get(key); !ok {
+		t.Fatal("expected the entry cached while the transaction is open")
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("committing: %v", err)
+	}
+	if _, ok := h.SearchCache.Get(key); ok {
+		t.Fatal("expected the commit to invalidate entries cached during the transaction")
+	}
+}
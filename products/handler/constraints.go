@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"strings"
+
+	"go-micro.dev/v5/errors"
+
+	"products/ent"
+)
+
+// UniqueViolations maps a unique column, as "table.column", to the message returned when a
+// write collides with an existing row; main may add entries or reword them
+var UniqueViolations = map[string]string{
+	"categories.name":     "category name already in use",
+	"sub_categories.name": "subcategory name already in use",
+	"products.sku":        "SKU already in use",
+	"reviews.user_id":     "you have already reviewed this product",
+	// Set concurrently with another SetPriceTiers for the same product
+	"price_tiers.min_quantity": "price tiers changed concurrently, retry",
+}
+
+// uniqueViolation returns an AlreadyExists (409) error carrying the message of the column in
+// UniqueViolations that err violates, so callers never see the raw database text, or nil
+// if err is not such a violation
+func uniqueViolation(id string, err error) error {
+	if !ent.IsConstraintError(err) {
+		return nil
+	}
+	msg := err.Error()
+	for column, friendly := range UniqueViolations {
+		// SQLite reports "UNIQUE constraint failed: categories.name", PostgreSQL the
+		// "categories_name_key" constraint it created for the column
+		if strings.Contains(msg, ": "+column) || strings.Contains(msg, `"`+strings.Replace(column, ".", "_", 1)+`_key"`) {
+			return errors.Conflict(id, "%s", friendly)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-micro.dev/v5/errors"
+
+	pb "products/proto"
+)
+
+func TestDuplicateNamesAreFriendlyConflicts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &ProductService{EntClient: client}
+
+	category := &pb.CreateCategoryResponse{}
+	if err := h.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: "Kitchen"}, category); err != nil {
+		t.Fatalf("CreateCategory: %v", err)
+	}
+	err := h.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: "Kitchen"}, &pb.CreateCategoryResponse{})
+	if e := errors.FromError(err); e.Code != http.StatusConflict || e.Detail != "category name already in use" {
+		t.Errorf("expected a 409 for a duplicate category, got %v", err)
+	}
+
+	req := &pb.CreateSubcategoryRequest{Name: "Mugs", CategoryId: category.Category.Id}
+	if err := h.CreateSubcategory(ctx, req, &pb.CreateSubcategoryResponse{}); err != nil {
+		t.Fatalf("CreateSubcategory: %v", err)
+	}
+	err = h.CreateSubcategory(ctx, req, &pb.CreateSubcategoryResponse{})
+	if e := errors.FromError(err); e.Code != http.StatusConflict || e.Detail != "subcategory name already in use" {
+		t.Errorf("expected a 409 for a duplicate subcategory, got %v", err)
+	}
+}
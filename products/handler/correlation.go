@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+	"go-micro.dev/v5/metadata"
+	"go-micro.dev/v5/server"
+)
+
+// CorrelationIDKey is the metadata key carrying the correlation ID of a request across services
+const CorrelationIDKey = "Correlation-Id"
+
+// withCorrelationID returns ctx carrying the caller's correlation ID, or a new one if the
+// caller sent none, with a logger tagged with the ID and fields. Keeping the ID in the
+// context's metadata forwards it on every call and event made with that context.
+func withCorrelationID(ctx context.Context, fields map[string]interface{}) (context.Context, *logger.Helper) {
+	id, ok := metadata.Get(ctx, CorrelationIDKey)
+	if !ok || id == "" {
+		id = uuid.NewString()
+		ctx = metadata.Set(ctx, CorrelationIDKey, id)
+	}
+	fields["correlation_id"] = id
+	log := logger.NewHelper(logger.DefaultLogger.Fields(fields))
+	return log.Inject(ctx), log
+}
+
+// CorrelationWrapper tags every request with a correlation ID and logs its outcome. Handlers
+// log through logger.Extract(ctx) so each of their lines carries the ID.
+func CorrelationWrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			ctx, log := withCorrelationID(ctx, map[string]interface{}{"method": req.Endpoint()})
+
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+			if err != nil && err.Error() != streamEnd {
+				log.Errorf("Request failed after %s: %v", time.Since(start), err)
+			} else {
+				log.Infof("Request handled in %s", time.Since(start))
+			}
+			return err
+		}
+	}
+}
+
+// CorrelationSubscriberWrapper does the same for events, which carry the publisher's ID
+func CorrelationSubscriberWrapper() server.SubscriberWrapper {
+	return func(fn server.SubscriberFunc) server.SubscriberFunc {
+		return func(ctx context.Context, msg server.Message) error {
+			ctx, log := withCorrelationID(ctx, map[string]interface{}{"topic": msg.Topic()})
+
+			start := time.Now()
+			err := fn(ctx, msg)
+			if err != nil {
+				log.Errorf("Event failed after %s: %v", time.Since(start), err)
+			} else {
+				log.Infof("Event handled in %s", time.Since(start))
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// currencies are the accepted ISO 4217 codes; all have two minor digits, matching the cents representation
+var currencies = map[string]bool{
+	"AUD": true, "CAD": true, "CHF": true, "CNY": true, "EUR": true, "GBP": true,
+	"INR": true, "KES": true, "NGN": true, "TZS": true, "USD": true, "ZAR": true,
+}
+
+// normalizeCurrency validates an ISO 4217 code and returns it upper-cased
+func normalizeCurrency(code string) (string, error) {
+	c := strings.ToUpper(strings.TrimSpace(code))
+	if !currencies[c] {
+		return "", fmt.Errorf("unsupported currency: %q", code)
+	}
+	return c, nil
+}
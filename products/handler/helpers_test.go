@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"products/ent"
+	"products/ent/enttest"
+)
+
+// newTestClient opens a migrated in-memory SQLite database private to the test
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestSubcategory stores a subcategory in a new category
+func createTestSubcategory(t *testing.T, client *ent.Client) *ent.SubCategory {
+	t.Helper()
+	ctx := context.Background()
+	c, err := client.Category.Create().SetName("category " + uuid.NewString()).Save(ctx)
+	if err != nil {
+		t.Fatalf("creating category: %v", err)
+	}
+	sc, err := client.SubCategory.Create().SetName("subcategory " + uuid.NewString()).SetCategory(c).Save(ctx)
+	if err != nil {
+		t.Fatalf("creating subcategory: %v", err)
+	}
+	return sc
+}
+
+// createTestProduct stores an active, described product priced at 1000 cents in a new subcategory
+func createTestProduct(t *testing.T, client *ent.Client, name, sku string, stock int) *ent.Product {
+	t.Helper()
+	p, err := client.Product.Create().
+		SetName(name).
+		SetSku(sku).
+		SetDescription(name + " description").
+		SetPriceCents(1000).
+		SetStockQuantity(stock).
+		SetUserID(uuid.New()).
+		SetSubcategory(createTestSubcategory(t, client)).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating product: %v", err)
+	}
+	return p
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go-micro.dev/v5/server"
+)
+
+// streamEnd is the error go-micro's router returns from a streaming handler that ended
+// without error, telling the client the stream is over
+const streamEnd = "EOS"
+
+// Metrics records request counts, error counts and latencies of every RPC, labeled by
+// service and method, for Prometheus to scrape
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+}
+
+// NewMetrics creates the RPC metrics along with the Go runtime and process collectors
+func NewMetrics() *Metrics {
+	labels := []string{"service", "method"}
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_requests_total",
+			Help: "Number of RPCs handled.",
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_errors_total",
+			Help: "Number of RPCs that returned an error.",
+		}, labels),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "rpc_duration_seconds",
+			Help:    "Time spent handling RPCs, including the whole stream for streaming RPCs.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.errors,
+		m.latency,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Wrapper is the server handler wrapper recording the metrics; it covers streaming
+// handlers too, which go-micro runs through the same wrappers
+func (m *Metrics) Wrapper() server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			start := time.Now()
+			err := fn(ctx, req, rsp)
+
+			labels := prometheus.Labels{"service": req.Service(), "method": req.Endpoint()}
+			m.requests.With(labels).Inc()
+			if err != nil && err.Error() != streamEnd {
+				m.errors.With(labels).Inc()
+			}
+			m.latency.With(labels).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// Serve exposes the metrics on addr at /metrics until it fails
+func (m *Metrics) Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	return http.ListenAndServe(addr, mux)
+}
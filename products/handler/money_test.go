@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSummingCentsIsExact(t *testing.T) {
+	var cents int64
+	var legacy float64
+	for i := 0; i < 10; i++ {
+		cents += toCents(0.1)
+		legacy += 0.1
+	}
+	if legacy == 1 {
+		t.Fatal("expected summing float amounts to drift")
+	}
+	if cents != 100 || formatCents(cents) != "1.00" || fromCents(cents) != 1 {
+		t.Fatalf("expected ten 0.1 prices to sum to exactly 1.00, got %d cents", cents)
+	}
+}
+
+func TestToProtoProductExposesCentsAndDecimal(t *testing.T) {
+	client := newTestClient(t)
+	p := createTestProduct(t, client, "Mug", "MUG-1", 5)
+	p = client.Product.UpdateOne(p).SetPriceCents(1999).SaveX(context.Background())
+
+	got := toProtoProduct(p)
+	if got.PriceCents != 1999 || got.PriceDecimal != "19.99" || got.Price != 19.99 {
+		t.Fatalf("expected 1999 cents shown as 19.99, got %d, %q, %v", got.PriceCents, got.PriceDecimal, got.Price)
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	"products/ent"
+	"products/ent/pricetier"
+	"products/ent/product"
+	pb "products/proto"
+)
+
+// effectiveTier returns the tier with the highest min_quantity that quantity reaches,
+// or nil when quantity is below every tier and the product price applies
+func effectiveTier(tiers []*ent.PriceTier, quantity int) *ent.PriceTier {
+	var best *ent.PriceTier
+	for _, t := range tiers {
+		if t.MinQuantity <= quantity && (best == nil || t.MinQuantity > best.MinQuantity) {
+			best = t
+		}
+	}
+	return best
+}
+
+// checkPriceTiers rejects a tier set in which a tier is invalid or two tiers start at the
+// same quantity; tiers are open-ended, so distinct min_quantities can never overlap
+func checkPriceTiers(id string, tiers []*pb.PriceTier) error {
+	seen := make(map[int32]bool, len(tiers))
+	for i, t := range tiers {
+		if t.MinQuantity < 2 {
+			return errors.BadRequest(id, "price tier %d: min quantity must be at least 2, the product price covers a single unit", i)
+		}
+		if t.UnitPriceCents <= 0 {
+			return errors.BadRequest(id, "price tier %d: unit price must be positive", i)
+		}
+		if seen[t.MinQuantity] {
+			return errors.BadRequest(id, "price tier %d overlaps another tier starting at quantity %d", i, t.MinQuantity)
+		}
+		seen[t.MinQuantity] = true
+	}
+	return nil
+}
+
+// GetEffectivePrice handles pricing a quantity of an active product, applying the price
+// tier the quantity reaches
+func (h *ProductService) GetEffectivePrice(ctx context.Context, req *pb.GetEffectivePriceRequest, rsp *pb.GetEffectivePriceResponse) error {
+	logger.Extract(ctx).Infof("Received GetEffectivePrice request for product %s, quantity: %d", req.ProductId, req.Quantity)
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.ProductId)
+	}
+	if req.Quantity <= 0 {
+		return errors.BadRequest("products.GetEffectivePrice", "quantity must be positive")
+	}
+
+	p, err := h.EntClient.Product.Query().
+		Where(product.ID(productID), product.IsActive(true)).
+		WithPriceTiers(func(q *ent.PriceTierQuery) {
+			q.Where(pricetier.MinQuantityLTE(int(req.Quantity)))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Product not found: %s", req.ProductId)
+		return errors.NotFound("products.GetEffectivePrice", "product not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get product with price tiers: %v", err)
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+
+	unit := p.PriceCents
+	if t := effectiveTier(p.Edges.PriceTiers, int(req.Quantity)); t != nil {
+		unit = t.UnitPriceCents
+		rsp.TierMinQuantity = int32(t.MinQuantity)
+	}
+	rsp.UnitPriceCents = unit
+	rsp.UnitPriceDecimal = formatCents(unit)
+	rsp.TotalCents = unit * int64(req.Quantity)
+	rsp.TotalDecimal = formatCents(rsp.TotalCents)
+	rsp.Currency = p.Currency
+	logger.Extract(ctx).Infof("Effective price of product %s for %d units: %d cents each", p.ID, req.Quantity, unit)
+	return nil
+}
+
+// SetPriceTiers replaces all of a product's price tiers, inactive products included;
+// an empty list removes them (admin privilege)
+func (h *AdminService) SetPriceTiers(ctx context.Context, req *pb.SetPriceTiersRequest, rsp *pb.SetPriceTiersResponse) error {
+	logger.Extract(ctx).Infof("Received SetPriceTiers request for product %s with %d tiers (Admin operation)", req.ProductId, len(req.Tiers))
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.ProductId)
+	}
+	if err := checkPriceTiers("products.SetPriceTiers", req.Tiers); err != nil {
+		return err
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.Product.Query().Where(product.ID(productID)).Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to look up product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	if !exists {
+		logger.Extract(ctx).Infof("Product not found: %s", req.ProductId)
+		return errors.NotFound("products.SetPriceTiers", "product not found")
+	}
+
+	if _, err := tx.PriceTier.Delete().Where(pricetier.HasProductWith(product.ID(productID))).Exec(ctx); err != nil {
+		logger.Extract(ctx).Errorf("Failed to delete price tiers of product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to replace price tiers: %w", err)
+	}
+	if len(req.Tiers) > 0 {
+		builders := make([]*ent.PriceTierCreate, len(req.Tiers))
+		for i, t := range req.Tiers {
+			builders[i] = tx.PriceTier.Create().
+				SetProductID(productID).
+				SetMinQuantity(int(t.MinQuantity)).
+				SetUnitPriceCents(t.UnitPriceCents)
+		}
+		if _, err := tx.PriceTier.CreateBulk(builders...).Save(ctx); err != nil {
+			if conflict := uniqueViolation("products.SetPriceTiers", err); conflict != nil {
+				return conflict
+			}
+			logger.Extract(ctx).Errorf("Failed to create price tiers of product %s: %v", req.ProductId, err)
+			return fmt.Errorf("failed to replace price tiers: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit price tiers of product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to replace price tiers: %w", err)
+	}
+
+	var getRsp pb.GetProductResponse
+	if err := getProduct(ctx, h.EntClient, req.ProductId, true, &getRsp); err != nil {
+		return err
+	}
+	rsp.Product = getRsp.Product
+	logger.Extract(ctx).Infof("Price tiers of product %s replaced with %d tiers", req.ProductId, len(req.Tiers))
+	return nil
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-micro.dev/v5/errors"
+
+	pb "products/proto"
+)
+
+func TestGetEffectivePriceSelectsTierAtBoundaries(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &ProductService{EntClient: client}
+	admin := &AdminService{EntClient: client}
+	p := createTestProduct(t, client, "Widget", "WID-1", 100)
+
+	set := &pb.SetPriceTiersResponse{}
+	err := admin.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{
+		ProductId: p.ID.String(),
+		// Out of order on purpose; GetProduct lists them by min_quantity
+		Tiers: []*pb.PriceTier{{MinQuantity: 50, UnitPriceCents: 700}, {MinQuantity: 10, UnitPriceCents: 900}},
+	}, set)
+	if err != nil {
+		t.Fatalf("SetPriceTiers: %v", err)
+	}
+	if tiers := set.Product.PriceTiers; len(tiers) != 2 || tiers[0].MinQuantity != 10 || tiers[1].MinQuantity != 50 {
+		t.Fatalf("expected tiers at 10 and 50, got %v", tiers)
+	}
+
+	// quantity: unit price
+	cases := map[int32]int64{1: 1000, 9: 1000, 10: 900, 49: 900, 50: 700, 500: 700}
+	for quantity, want := range cases {
+		rsp := &pb.GetEffectivePriceResponse{}
+		if err := h.GetEffectivePrice(ctx, &pb.GetEffectivePriceRequest{ProductId: p.ID.String(), Quantity: quantity}, rsp); err != nil {
+			t.Fatalf("GetEffectivePrice(%d): %v", quantity, err)
+		}
+		if rsp.UnitPriceCents != want || rsp.TotalCents != want*int64(quantity) {
+			t.Errorf("quantity %d: expected %d each, got %d totalling %d", quantity, want, rsp.UnitPriceCents, rsp.TotalCents)
+		}
+	}
+
+	if err := h.GetEffectivePrice(ctx, &pb.GetEffectivePriceRequest{ProductId: p.ID.String()}, &pb.GetEffectivePriceResponse{}); err == nil {
+		t.Fatal("expected a zero quantity to be rejected")
+	}
+}
+
+func TestSetPriceTiersRejectsOverlappingTiers(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+	p := createTestProduct(t, client, "Widget", "WID-1", 100)
+	if err := admin.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{
+		ProductId: p.ID.String(),
+		Tiers:     []*pb.PriceTier{{MinQuantity: 10, UnitPriceCents: 900}},
+	}, &pb.SetPriceTiersResponse{}); err != nil {
+		t.Fatalf("SetPriceTiers: %v", err)
+	}
+
+	invalid := map[string][]*pb.PriceTier{
+		"overlapping": {{MinQuantity: 20, UnitPriceCents: 800}, {MinQuantity: 20, UnitPriceCents: 750}},
+		"single unit": {{MinQuantity: 1, UnitPriceCents: 800}},
+		"free":        {{MinQuantity: 20, UnitPriceCents: 0}},
+	}
+	for name, tiers := range invalid {
+		err := admin.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{ProductId: p.ID.String(), Tiers: tiers}, &pb.SetPriceTiersResponse{})
+		if errors.FromError(err).Code != http.StatusBadRequest {
+			t.Errorf("%s: expected a BadRequest, got %v", name, err)
+		}
+	}
+
+	// Rejected tier sets leave the existing tiers in place
+	if n := client.PriceTier.Query().CountX(ctx); n != 1 {
+		t.Fatalf("expected the original tier kept, found %d", n)
+	}
+}
@@ -3,12 +3,17 @@ package handler
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
 	"go-micro.dev/v5/logger"
+	"google.golang.org/protobuf/proto"
 
 	"products/ent"
 	"products/ent/category"
+	"products/ent/predicate"
+	"products/ent/pricetier"
 	"products/ent/product"
 	"products/ent/subcategory"
 	pb "products/proto"
@@ -17,38 +22,63 @@ import (
 // ProductService implements the ProductServiceServer interface
 type ProductService struct {
 	EntClient *ent.Client
+	// SearchCache caches SearchProducts responses; nil disables caching
+	SearchCache *SearchCache
 }
 
 // CreateProduct handles the creation of a new product
 func (h *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest, rsp *pb.CreateProductResponse) error {
-	logger.Infof("Received CreateProduct request for name: %s", req.Name)
+	logger.Extract(ctx).Infof("Received CreateProduct request for name: %s", req.Name)
+
+	if err := Limits.check(req.Name, req.Description); err != nil {
+		logger.Extract(ctx).Infof("Rejected CreateProduct request: %v", err)
+		return err
+	}
+
+	currency, err := normalizeCurrency(req.Currency)
+	if err != nil {
+		logger.Extract(ctx).Infof("Invalid currency for product %s: %q", req.Name, req.Currency)
+		return err
+	}
+
+	sku, err := normalizeSKU(req.Sku)
+	if err != nil {
+		logger.Extract(ctx).Infof("Rejected CreateProduct request: %v", err)
+		return err
+	}
 
 	// Validate subcategory exists
-	_, err := h.EntClient.SubCategory.Get(ctx, uuid.MustParse(req.SubcategoryId))
+	_, err = h.EntClient.SubCategory.Get(ctx, uuid.MustParse(req.SubcategoryId))
 	if ent.IsNotFound(err) {
-		logger.Infof("Subcategory not found: %s", req.SubcategoryId)
+		logger.Extract(ctx).Infof("Subcategory not found: %s", req.SubcategoryId)
 		return fmt.Errorf("subcategory not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to validate subcategory: %v", err)
+		logger.Extract(ctx).Errorf("Failed to validate subcategory: %v", err)
 		return fmt.Errorf("failed to validate subcategory: %w", err)
 	}
 
 	// Create product
 	p, err := h.EntClient.Product.Create().
 		SetName(req.Name).
+		SetSku(sku).
 		SetDescription(req.Description).
-		SetPrice(req.Price).
+		SetPriceCents(requestCents(req.PriceCents, req.Price)).
+		SetCurrency(currency).
 		SetStockQuantity(int(req.StockQuantity)).
 		SetUserID(uuid.MustParse(req.UserId)).
 		SetSubcategoryID(uuid.MustParse(req.SubcategoryId)).
 		Save(ctx)
+	if conflict := uniqueViolation("products.CreateProduct", err); conflict != nil {
+		logger.Extract(ctx).Infof("SKU already in use: %s", sku)
+		return conflict
+	}
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation: %v", err)
+		logger.Extract(ctx).Errorf("Constraint violation: %v", err)
 		return fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to create product: %v", err)
+		logger.Extract(ctx).Errorf("Failed to create product: %v", err)
 		return fmt.Errorf("failed to create product: %w", err)
 	}
 
@@ -60,44 +90,152 @@ func (h *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProduc
 		}).
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch product with subcategory: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch product with subcategory: %v", err)
 		return fmt.Errorf("failed to fetch product: %w", err)
 	}
 
 	rsp.Product = toProtoProduct(pWithSubcategory)
-	logger.Infof("Product created successfully: %s", p.ID)
+	logger.Extract(ctx).Infof("Product created successfully: %s", p.ID)
 	return nil
 }
 
-// GetProduct handles fetching a product by ID
+// GetProduct handles fetching a product by ID, hiding inactive products
 func (h *ProductService) GetProduct(ctx context.Context, req *pb.GetProductRequest, rsp *pb.GetProductResponse) error {
-	logger.Infof("Received GetProduct request for ID: %s", req.Id)
+	logger.Extract(ctx).Infof("Received GetProduct request for ID: %s", req.Id)
+
+	// include_inactive is reserved for AdminService.GetProduct
+	return getProduct(ctx, h.EntClient, req.Id, false, rsp)
+}
+
+// getProduct fetches a product with its subcategory and category, optionally including an inactive one
+func getProduct(ctx context.Context, client *ent.Client, id string, includeInactive bool, rsp *pb.GetProductResponse) error {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", id)
+	}
+
+	query := client.Product.Query().
+		Where(product.ID(productID)).
+		WithSubcategory(func(q *ent.SubCategoryQuery) {
+			q.WithCategory()
+		}).
+		WithPriceTiers(func(q *ent.PriceTierQuery) {
+			q.Order(ent.Asc(pricetier.FieldMinQuantity))
+		})
+	if !includeInactive {
+		query.Where(product.IsActive(true))
+	}
+	p, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		logger.Extract(ctx).Infof("Product not found: %s", id)
+		return errors.NotFound("products.GetProduct", "product not found")
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get product: %v", err)
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+
+	rsp.Product = toProtoProduct(p)
+	logger.Extract(ctx).Infof("Product fetched successfully: %s", p.ID)
+	return nil
+}
+
+// GetProductBySKU handles fetching a product by its SKU, hiding inactive products
+func (h *ProductService) GetProductBySKU(ctx context.Context, req *pb.GetProductBySKURequest, rsp *pb.GetProductResponse) error {
+	logger.Extract(ctx).Infof("Received GetProductBySKU request for sku: %s", req.Sku)
+
+	sku, err := normalizeSKU(req.Sku)
+	if err != nil {
+		return err
+	}
 
 	p, err := h.EntClient.Product.Query().
-		Where(product.ID(uuid.MustParse(req.Id))).
+		Where(product.Sku(sku), product.IsActive(true)).
 		WithSubcategory(func(q *ent.SubCategoryQuery) {
 			q.WithCategory()
 		}).
+		WithPriceTiers(func(q *ent.PriceTierQuery) {
+			q.Order(ent.Asc(pricetier.FieldMinQuantity))
+		}).
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Product not found: %s", req.Id)
-		return fmt.Errorf("product not found")
+		logger.Extract(ctx).Infof("Product not found for sku: %s", sku)
+		return errors.NotFound("products.GetProductBySKU", "product not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to get product: %v", err)
-		return fmt.Errorf("failed to get product: %w", err)
+		logger.Extract(ctx).Errorf("Failed to get product by sku: %v", err)
+		return fmt.Errorf("failed to get product by sku: %w", err)
 	}
 
 	rsp.Product = toProtoProduct(p)
-	logger.Infof("Product fetched successfully: %s", p.ID)
+	logger.Extract(ctx).Infof("Product fetched by sku successfully: %s", p.ID)
+	return nil
+}
+
+// GetProductsByIDs handles fetching several products at once, reporting the IDs it couldn't find.
+// Subcategories, categories and price tiers are eager-loaded with one query each for the whole batch.
+func (h *ProductService) GetProductsByIDs(ctx context.Context, req *pb.GetProductsByIDsRequest, rsp *pb.GetProductsByIDsResponse) error {
+	logger.Extract(ctx).Infof("Received GetProductsByIDs request for %d IDs", len(req.Ids))
+
+	ids := make([]uuid.UUID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		productID, err := uuid.Parse(id)
+		if err != nil {
+			rsp.MissingIds = append(rsp.MissingIds, id)
+			continue
+		}
+		ids = append(ids, productID)
+	}
+
+	products, err := h.EntClient.Product.Query().
+		Where(product.IDIn(ids...)).
+		WithSubcategory(func(q *ent.SubCategoryQuery) {
+			q.WithCategory()
+		}).
+		WithPriceTiers(func(q *ent.PriceTierQuery) {
+			q.Order(ent.Asc(pricetier.FieldMinQuantity))
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to get products: %v", err)
+		return fmt.Errorf("failed to get products: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(products))
+	rsp.Products = make([]*pb.Product, len(products))
+	for i, p := range products {
+		found[p.ID] = true
+		rsp.Products[i] = toProtoProduct(p)
+	}
+	for _, id := range ids {
+		if !found[id] {
+			found[id] = true // report repeated IDs once
+			rsp.MissingIds = append(rsp.MissingIds, id.String())
+		}
+	}
+
+	logger.Extract(ctx).Infof("Fetched %d products, %d missing", len(rsp.Products), len(rsp.MissingIds))
 	return nil
 }
 
 // UpdateProduct handles updating an existing product
 func (h *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest, rsp *pb.UpdateProductResponse) error {
-	logger.Infof("Received UpdateProduct request for ID: %s", req.Id)
+	logger.Extract(ctx).Infof("Received UpdateProduct request for ID: %s", req.Id)
 
-	updater := h.EntClient.Product.UpdateOneID(uuid.MustParse(req.Id))
+	if err := Limits.check(req.Name, req.Description); err != nil {
+		logger.Extract(ctx).Infof("Rejected UpdateProduct request: %v", err)
+		return err
+	}
+
+	productID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.Id)
+	}
+
+	// Only apply the edit if nobody else has changed the product since the client read it
+	updater := h.EntClient.Product.UpdateOneID(productID).
+		Where(product.Version(int(req.Version))).
+		AddVersion(1)
 
 	if req.Name != "" {
 		updater.SetName(req.Name)
@@ -105,8 +243,16 @@ func (h *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 	if req.Description != "" {
 		updater.SetDescription(req.Description)
 	}
-	if req.Price > 0 {
-		updater.SetPrice(req.Price)
+	if req.PriceCents > 0 || req.Price > 0 {
+		updater.SetPriceCents(requestCents(req.PriceCents, req.Price))
+	}
+	if req.Currency != "" {
+		currency, err := normalizeCurrency(req.Currency)
+		if err != nil {
+			logger.Extract(ctx).Infof("Invalid currency for product %s: %q", req.Id, req.Currency)
+			return err
+		}
+		updater.SetCurrency(currency)
 	}
 	if req.StockQuantity >= 0 {
 		updater.SetStockQuantity(int(req.StockQuantity))
@@ -115,11 +261,11 @@ func (h *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 		// Validate subcategory exists
 		_, err := h.EntClient.SubCategory.Get(ctx, uuid.MustParse(req.SubcategoryId))
 		if ent.IsNotFound(err) {
-			logger.Infof("Subcategory not found: %s", req.SubcategoryId)
+			logger.Extract(ctx).Infof("Subcategory not found: %s", req.SubcategoryId)
 			return fmt.Errorf("subcategory not found")
 		}
 		if err != nil {
-			logger.Errorf("Failed to validate subcategory: %v", err)
+			logger.Extract(ctx).Errorf("Failed to validate subcategory: %v", err)
 			return fmt.Errorf("failed to validate subcategory: %w", err)
 		}
 		updater.SetSubcategoryID(uuid.MustParse(req.SubcategoryId))
@@ -127,15 +273,24 @@ func (h *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 
 	p, err := updater.Save(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Product not found for update: %s", req.Id)
+		exists, existsErr := h.EntClient.Product.Query().Where(product.ID(productID)).Exist(ctx)
+		if existsErr != nil {
+			logger.Extract(ctx).Errorf("Failed to look up product %s: %v", req.Id, existsErr)
+			return fmt.Errorf("failed to update product: %w", existsErr)
+		}
+		if exists {
+			logger.Extract(ctx).Infof("Version mismatch updating product %s (expected version %d)", req.Id, req.Version)
+			return fmt.Errorf("version mismatch")
+		}
+		logger.Extract(ctx).Infof("Product not found for update: %s", req.Id)
 		return fmt.Errorf("product not found")
 	}
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation during update: %v", err)
+		logger.Extract(ctx).Errorf("Constraint violation during update: %v", err)
 		return fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to update product: %v", err)
+		logger.Extract(ctx).Errorf("Failed to update product: %v", err)
 		return fmt.Errorf("failed to update product: %w", err)
 	}
 
@@ -147,29 +302,87 @@ func (h *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 		}).
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch product with subcategory: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch product with subcategory: %v", err)
 		return fmt.Errorf("failed to fetch product: %w", err)
 	}
 
 	rsp.Product = toProtoProduct(pWithSubcategory)
-	logger.Infof("Product updated successfully: %s", p.ID)
+	logger.Extract(ctx).Infof("Product updated successfully: %s (version %d)", p.ID, p.Version)
 	return nil
 }
 
-// ListProducts handles listing all products with pagination
+// priceRange returns the predicates restricting products to those priced within
+// [minCents, maxCents]; a zero bound is left open
+func priceRange(minCents, maxCents int64) ([]predicate.Product, error) {
+	if minCents < 0 || maxCents < 0 {
+		return nil, fmt.Errorf("price bounds must not be negative")
+	}
+	if minCents > 0 && maxCents > 0 && minCents > maxCents {
+		return nil, fmt.Errorf("min_price_cents must not exceed max_price_cents")
+	}
+	var preds []predicate.Product
+	if minCents > 0 {
+		preds = append(preds, product.PriceCentsGTE(minCents))
+	}
+	if maxCents > 0 {
+		preds = append(preds, product.PriceCentsLTE(maxCents))
+	}
+	return preds, nil
+}
+
+// availabilityFilters returns the predicates hiding out-of-stock and inactive products
+func availabilityFilters(inStockOnly, activeOnly bool) []predicate.Product {
+	var preds []predicate.Product
+	if inStockOnly {
+		preds = append(preds, product.StockQuantityGT(0))
+	}
+	if activeOnly {
+		preds = append(preds, product.IsActive(true))
+	}
+	return preds
+}
+
+// ListProducts handles listing all products with pagination, hiding inactive products
 func (h *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsRequest, rsp *pb.ListProductsResponse) error {
-	logger.Infof("Received ListProducts request (limit: %d, offset: %d)", req.Limit, req.Offset)
+	logger.Extract(ctx).Infof("Received ListProducts request (limit: %d, offset: %d, price: %d-%d, subcategory_id: %s, category_id: %s, in_stock_only: %v)", req.Limit, req.Offset, req.MinPriceCents, req.MaxPriceCents, req.SubcategoryId, req.CategoryId, req.InStockOnly)
 
-	query := h.EntClient.Product.Query().
-		WithSubcategory(func(q *ent.SubCategoryQuery) {
-			q.WithCategory()
-		})
+	// include_inactive is reserved for AdminService.ListProducts
+	return listProducts(ctx, h.EntClient, req, false, rsp)
+}
 
+// listProducts lists products matching req, optionally including inactive ones
+func listProducts(ctx context.Context, client *ent.Client, req *pb.ListProductsRequest, includeInactive bool, rsp *pb.ListProductsResponse) error {
+	// Filters apply to both the page and the total count
+	preds, err := priceRange(req.MinPriceCents, req.MaxPriceCents)
+	if err != nil {
+		return err
+	}
+	preds = append(preds, availabilityFilters(req.InStockOnly, req.ActiveOnly || !includeInactive)...)
+	if req.SubcategoryId != "" {
+		subcategoryID, err := uuid.Parse(req.SubcategoryId)
+		if err != nil {
+			return fmt.Errorf("invalid subcategory_id: %s", req.SubcategoryId)
+		}
+		preds = append(preds, product.HasSubcategoryWith(subcategory.ID(subcategoryID)))
+	}
+	if req.CategoryId != "" {
+		categoryID, err := uuid.Parse(req.CategoryId)
+		if err != nil {
+			return fmt.Errorf("invalid category_id: %s", req.CategoryId)
+		}
+		preds = append(preds, product.HasSubcategoryWith(subcategory.HasCategoryWith(category.ID(categoryID))))
+	}
 	if req.Filter != "" {
-		filter := "%" + req.Filter + "%"
-		query.Where(product.NameContainsFold(filter))
+		// ContainsFold adds (and escapes) the LIKE wildcards itself
+		preds = append(preds, product.NameContainsFold(req.Filter))
 	}
 
+	query := client.Product.Query().
+		Where(preds...).
+		WithSubcategory(func(q *ent.SubCategoryQuery) {
+			q.WithCategory()
+		})
+
 	if req.Limit > 0 {
 		query.Limit(int(req.Limit))
 	}
@@ -179,13 +392,13 @@ func (h *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsR
 
 	products, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to list products: %v", err)
+		logger.Extract(ctx).Errorf("Failed to list products: %v", err)
 		return fmt.Errorf("failed to list products: %w", err)
 	}
 
-	total, err := h.EntClient.Product.Query().Count(ctx)
+	total, err := client.Product.Query().Where(preds...).Count(ctx)
 	if err != nil {
-		logger.Errorf("Failed to count products: %v", err)
+		logger.Extract(ctx).Errorf("Failed to count products: %v", err)
 		return fmt.Errorf("failed to count products: %w", err)
 	}
 
@@ -196,27 +409,57 @@ func (h *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsR
 
 	rsp.Products = protoProducts
 	rsp.Total = int32(total)
-	logger.Infof("Listed %d products (total: %d)", len(protoProducts), total)
+	logger.Extract(ctx).Infof("Listed %d products (total: %d)", len(protoProducts), total)
 	return nil
 }
 
-// SearchProducts searches products by query string
+// SearchProducts searches products by query string, hiding inactive products
 func (h *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest, rsp *pb.SearchProductsResponse) error {
-	logger.Infof("Received SearchProducts request (query: %s, limit: %d, offset: %d)", req.Query, req.Limit, req.Offset)
+	logger.Extract(ctx).Infof("Received SearchProducts request (query: %s, limit: %d, offset: %d, fuzzy: %v, price: %d-%d, in_stock_only: %v)", req.Query, req.Limit, req.Offset, req.Fuzzy, req.MinPriceCents, req.MaxPriceCents, req.InStockOnly)
+
+	key := searchCacheKey(req)
+	if cached, ok := h.SearchCache.Get(key); ok {
+		proto.Merge(rsp, cached)
+		logger.Extract(ctx).Infof("Served %d products matching query '%s' from cache (total: %d)", len(rsp.Products), req.Query, rsp.Total)
+		return nil
+	}
+
+	// Read the version first so a change made during the search leaves the entry stale
+	version := h.SearchCache.Version()
+	// include_inactive is reserved for AdminService.SearchProducts
+	if err := searchProducts(ctx, h.EntClient, req, false, rsp); err != nil {
+		return err
+	}
+	h.SearchCache.Put(key, version, rsp)
+	return nil
+}
+
+// searchProducts runs an exact or fuzzy search, optionally including inactive products
+func searchProducts(ctx context.Context, client *ent.Client, req *pb.SearchProductsRequest, includeInactive bool, rsp *pb.SearchProductsResponse) error {
+	if req.Fuzzy {
+		return fuzzySearchProducts(ctx, client, req, includeInactive, rsp)
+	}
+	return exactSearchProducts(ctx, client, req, includeInactive, rsp)
+}
 
-	query := h.EntClient.Product.Query().
+// exactSearchProducts finds products whose name or description contains every query term
+func exactSearchProducts(ctx context.Context, client *ent.Client, req *pb.SearchProductsRequest, includeInactive bool, rsp *pb.SearchProductsResponse) error {
+	// Filters apply to both the page and the total count
+	preds, err := priceRange(req.MinPriceCents, req.MaxPriceCents)
+	if err != nil {
+		return err
+	}
+	preds = append(preds, availabilityFilters(req.InStockOnly, req.ActiveOnly || !includeInactive)...)
+	if terms := normalizeQuery(req.Query); len(terms) > 0 {
+		preds = append(preds, searchPredicate(terms))
+	}
+
+	query := client.Product.Query().
+		Where(preds...).
 		WithSubcategory(func(q *ent.SubCategoryQuery) {
 			q.WithCategory()
 		})
 
-	if req.Query != "" {
-		searchStr := "%" + req.Query + "%"
-		query.Where(product.Or(
-			product.NameContainsFold(searchStr),
-			product.DescriptionContainsFold(searchStr),
-		))
-	}
-
 	if req.Limit > 0 {
 		query.Limit(int(req.Limit))
 	}
@@ -226,13 +469,13 @@ func (h *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProdu
 
 	products, err := query.All(ctx)
 	if err != nil {
-		logger.Errorf("Failed to search products: %v", err)
+		logger.Extract(ctx).Errorf("Failed to search products: %v", err)
 		return fmt.Errorf("failed to search products: %w", err)
 	}
 
-	total, err := h.EntClient.Product.Query().Count(ctx)
+	total, err := client.Product.Query().Where(preds...).Count(ctx)
 	if err != nil {
-		logger.Errorf("Failed to count products for search: %v", err)
+		logger.Extract(ctx).Errorf("Failed to count products for search: %v", err)
 		return fmt.Errorf("failed to count products for search: %w", err)
 	}
 
@@ -243,66 +486,228 @@ func (h *ProductService) SearchProducts(ctx context.Context, req *pb.SearchProdu
 
 	rsp.Products = protoProducts
 	rsp.Total = int32(total)
-	logger.Infof("Found %d products matching query '%s' (total: %d)", len(protoProducts), req.Query, total)
+	logger.Extract(ctx).Infof("Found %d products matching query '%s' (total: %d)", len(protoProducts), req.Query, total)
+	return nil
+}
+
+// fuzzySearchProducts ranks products whose names match every query term within the
+// tolerated edit distance. Matching runs in memory over the names of all products,
+// then only the requested page is loaded in full.
+func fuzzySearchProducts(ctx context.Context, client *ent.Client, req *pb.SearchProductsRequest, includeInactive bool, rsp *pb.SearchProductsResponse) error {
+	terms := normalizeQuery(req.Query)
+	if len(terms) == 0 {
+		return fmt.Errorf("fuzzy search requires a query")
+	}
+	maxDistance := FuzzyMaxDistance
+	if req.MaxDistance > 0 {
+		maxDistance = int(req.MaxDistance)
+	}
+
+	preds, err := priceRange(req.MinPriceCents, req.MaxPriceCents)
+	if err != nil {
+		return err
+	}
+	preds = append(preds, availabilityFilters(req.InStockOnly, req.ActiveOnly || !includeInactive)...)
+
+	candidates, err := client.Product.Query().
+		Where(preds...).
+		Select(product.FieldID, product.FieldName).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to load fuzzy search candidates: %v", err)
+		return fmt.Errorf("failed to search products: %w", err)
+	}
+
+	type match struct {
+		id    uuid.UUID
+		name  string
+		score int
+	}
+	var matches []match
+	for _, c := range candidates {
+		if score, ok := fuzzyMatch(terms, normalizeQuery(c.Name), maxDistance); ok {
+			matches = append(matches, match{id: c.ID, name: c.Name, score: score})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	total := len(matches)
+	page := matches[min(max(int(req.Offset), 0), total):]
+	if req.Limit > 0 && int(req.Limit) < len(page) {
+		page = page[:req.Limit]
+	}
+
+	ids := make([]uuid.UUID, len(page))
+	for i, m := range page {
+		ids[i] = m.id
+	}
+	products, err := client.Product.Query().
+		Where(product.IDIn(ids...)).
+		WithSubcategory(func(q *ent.SubCategoryQuery) {
+			q.WithCategory()
+		}).
+		WithPriceTiers(func(q *ent.PriceTierQuery) {
+			q.Order(ent.Asc(pricetier.FieldMinQuantity))
+		}).
+		All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to load fuzzy search results: %v", err)
+		return fmt.Errorf("failed to search products: %w", err)
+	}
+	byID := make(map[uuid.UUID]*ent.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+
+	// Keep the ranking; skip products deleted since the candidates were read
+	rsp.Products = make([]*pb.Product, 0, len(page))
+	for _, m := range page {
+		if p, ok := byID[m.id]; ok {
+			rsp.Products = append(rsp.Products, toProtoProduct(p))
+		}
+	}
+	rsp.Total = int32(total)
+	logger.Extract(ctx).Infof("Fuzzy search found %d products matching query '%s' within distance %d (total: %d)", len(rsp.Products), req.Query, maxDistance, total)
 	return nil
 }
 
 // CreateCategory handles the creation of a new category
 func (h *ProductService) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest, rsp *pb.CreateCategoryResponse) error {
-	logger.Infof("Received CreateCategory request for name: %s", req.Name)
+	logger.Extract(ctx).Infof("Received CreateCategory request for name: %s", req.Name)
+
+	if err := Limits.check(req.Name, req.Description); err != nil {
+		logger.Extract(ctx).Infof("Rejected CreateCategory request: %v", err)
+		return err
+	}
 
 	c, err := h.EntClient.Category.Create().
 		SetName(req.Name).
 		SetDescription(req.Description).
 		Save(ctx)
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation: %v", err)
+		logger.Extract(ctx).Errorf("Constraint violation: %v", err)
+		if cerr := uniqueViolation("products.CreateCategory", err); cerr != nil {
+			return cerr
+		}
 		return fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to create category: %v", err)
+		logger.Extract(ctx).Errorf("Failed to create category: %v", err)
 		return fmt.Errorf("failed to create category: %w", err)
 	}
 
 	rsp.Category = toProtoCategory(c)
-	logger.Infof("Category created successfully: %s", c.ID)
+	logger.Extract(ctx).Infof("Category created successfully: %s", c.ID)
 	return nil
 }
 
 // GetCategory handles fetching a category by ID
 func (h *ProductService) GetCategory(ctx context.Context, req *pb.GetCategoryRequest, rsp *pb.GetCategoryResponse) error {
-	logger.Infof("Received GetCategory request for ID: %s", req.Id)
+	logger.Extract(ctx).Infof("Received GetCategory request for ID: %s", req.Id)
 
 	c, err := h.EntClient.Category.Query().
 		Where(category.ID(uuid.MustParse(req.Id))).
 		WithSubcategories().
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Category not found: %s", req.Id)
+		logger.Extract(ctx).Infof("Category not found: %s", req.Id)
 		return fmt.Errorf("category not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to get category: %v", err)
+		logger.Extract(ctx).Errorf("Failed to get category: %v", err)
 		return fmt.Errorf("failed to get category: %w", err)
 	}
 
 	rsp.Category = toProtoCategory(c)
-	logger.Infof("Category fetched successfully: %s", c.ID)
+	logger.Extract(ctx).Infof("Category fetched successfully: %s", c.ID)
+	return nil
+}
+
+// ListCategories handles listing categories with their subcategory counts and pagination
+func (h *ProductService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest, rsp *pb.ListCategoriesResponse) error {
+	logger.Extract(ctx).Infof("Received ListCategories request (limit: %d, offset: %d, include_empty: %v)", req.Limit, req.Offset, req.IncludeEmpty)
+
+	query := h.EntClient.Category.Query().Order(ent.Asc(category.FieldName))
+	countQuery := h.EntClient.Category.Query()
+	if !req.IncludeEmpty {
+		query.Where(category.HasSubcategories())
+		countQuery.Where(category.HasSubcategories())
+	}
+
+	if req.Limit > 0 {
+		query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query.Offset(int(req.Offset))
+	}
+
+	categories, err := query.All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list categories: %v", err)
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	total, err := countQuery.Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count categories: %v", err)
+		return fmt.Errorf("failed to count categories: %w", err)
+	}
+
+	// Count subcategories per listed category in one grouped query
+	ids := make([]uuid.UUID, len(categories))
+	for i, c := range categories {
+		ids[i] = c.ID
+	}
+	var counts []struct {
+		CategoryID uuid.UUID `json:"category_subcategories"`
+		Count      int       `json:"count"`
+	}
+	err = h.EntClient.SubCategory.Query().
+		Where(subcategory.HasCategoryWith(category.IDIn(ids...))).
+		GroupBy(subcategory.CategoryColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count subcategories: %v", err)
+		return fmt.Errorf("failed to count subcategories: %w", err)
+	}
+	subcategoryCounts := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		subcategoryCounts[c.CategoryID] = c.Count
+	}
+
+	rsp.Categories = make([]*pb.Category, len(categories))
+	for i, c := range categories {
+		rsp.Categories[i] = toProtoCategory(c)
+		rsp.Categories[i].SubcategoryCount = int32(subcategoryCounts[c.ID])
+	}
+	rsp.Total = int32(total)
+	logger.Extract(ctx).Infof("Listed %d categories (total: %d)", len(rsp.Categories), total)
 	return nil
 }
 
 // CreateSubcategory handles the creation of a new subcategory
 func (h *ProductService) CreateSubcategory(ctx context.Context, req *pb.CreateSubcategoryRequest, rsp *pb.CreateSubcategoryResponse) error {
-	logger.Infof("Received CreateSubcategory request for name: %s", req.Name)
+	logger.Extract(ctx).Infof("Received CreateSubcategory request for name: %s", req.Name)
+
+	if err := Limits.check(req.Name, req.Description); err != nil {
+		logger.Extract(ctx).Infof("Rejected CreateSubcategory request: %v", err)
+		return err
+	}
 
 	// Validate category exists
 	_, err := h.EntClient.Category.Get(ctx, uuid.MustParse(req.CategoryId))
 	if ent.IsNotFound(err) {
-		logger.Infof("Category not found: %s", req.CategoryId)
+		logger.Extract(ctx).Infof("Category not found: %s", req.CategoryId)
 		return fmt.Errorf("category not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to validate category: %v", err)
+		logger.Extract(ctx).Errorf("Failed to validate category: %v", err)
 		return fmt.Errorf("failed to validate category: %w", err)
 	}
 
@@ -312,11 +717,14 @@ func (h *ProductService) CreateSubcategory(ctx context.Context, req *pb.CreateSu
 		SetCategoryID(uuid.MustParse(req.CategoryId)).
 		Save(ctx)
 	if ent.IsConstraintError(err) {
-		logger.Errorf("Constraint violation: %v", err)
+		logger.Extract(ctx).Errorf("Constraint violation: %v", err)
+		if cerr := uniqueViolation("products.CreateSubcategory", err); cerr != nil {
+			return cerr
+		}
 		return fmt.Errorf("constraint violation: %w", err)
 	}
 	if err != nil {
-		logger.Errorf("Failed to create subcategory: %v", err)
+		logger.Extract(ctx).Errorf("Failed to create subcategory: %v", err)
 		return fmt.Errorf("failed to create subcategory: %w", err)
 	}
 
@@ -326,34 +734,34 @@ func (h *ProductService) CreateSubcategory(ctx context.Context, req *pb.CreateSu
 		WithCategory().
 		Only(ctx)
 	if err != nil {
-		logger.Errorf("Failed to fetch subcategory with category: %v", err)
+		logger.Extract(ctx).Errorf("Failed to fetch subcategory with category: %v", err)
 		return fmt.Errorf("failed to fetch subcategory: %w", err)
 	}
 
 	rsp.Subcategory = toProtoSubcategory(scWithCategory)
-	logger.Infof("Subcategory created successfully: %s", sc.ID)
+	logger.Extract(ctx).Infof("Subcategory created successfully: %s", sc.ID)
 	return nil
 }
 
 // GetSubcategory handles fetching a subcategory by ID
 func (h *ProductService) GetSubcategory(ctx context.Context, req *pb.GetSubcategoryRequest, rsp *pb.GetSubcategoryResponse) error {
-	logger.Infof("Received GetSubcategory request for ID: %s", req.Id)
+	logger.Extract(ctx).Infof("Received GetSubcategory request for ID: %s", req.Id)
 
 	sc, err := h.EntClient.SubCategory.Query().
 		Where(subcategory.ID(uuid.MustParse(req.Id))).
 		WithCategory().
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		logger.Infof("Subcategory not found: %s", req.Id)
+		logger.Extract(ctx).Infof("Subcategory not found: %s", req.Id)
 		return fmt.Errorf("subcategory not found")
 	}
 	if err != nil {
-		logger.Errorf("Failed to get subcategory: %v", err)
+		logger.Extract(ctx).Errorf("Failed to get subcategory: %v", err)
 		return fmt.Errorf("failed to get subcategory: %w", err)
 	}
 
 	rsp.Subcategory = toProtoSubcategory(sc)
-	logger.Infof("Subcategory fetched successfully: %s", sc.ID)
+	logger.Extract(ctx).Infof("Subcategory fetched successfully: %s", sc.ID)
 	return nil
 }
 
@@ -366,15 +774,33 @@ func toProtoProduct(p *ent.Product) *pb.Product {
 		Id:            p.ID.String(),
 		Name:          p.Name,
 		Description:   *p.Description,
-		Price:         p.Price,
+		Price:         fromCents(p.PriceCents),
+		PriceCents:    p.PriceCents,
+		PriceDecimal:  formatCents(p.PriceCents),
+		Availability:  Thresholds.Availability(p.StockQuantity),
+		Currency:      p.Currency,
+		Version:       int32(p.Version),
 		StockQuantity: int32(p.StockQuantity),
 		UserId:        p.UserID.String(),
 		CreatedAt:     p.CreatedAt.Unix(),
 		UpdatedAt:     p.UpdatedAt.Unix(),
 		IsActive:      p.IsActive,
 	}
-	if p.Edges.Subcategory != nil {
-		protoProduct.Subcategory = toProtoSubcategory(p.Edges.Subcategory)
+	if p.Sku != nil {
+		protoProduct.Sku = *p.Sku
+	}
+	for _, t := range p.Edges.PriceTiers {
+		protoProduct.PriceTiers = append(protoProduct.PriceTiers, &pb.PriceTier{
+			MinQuantity:      int32(t.MinQuantity),
+			UnitPriceCents:   t.UnitPriceCents,
+			UnitPriceDecimal: formatCents(t.UnitPriceCents),
+		})
+	}
+	if sc := p.Edges.Subcategory; sc != nil {
+		protoProduct.Subcategory = toProtoSubcategory(sc)
+		if sc.Edges.Category != nil {
+			protoProduct.Breadcrumb = []string{sc.Edges.Category.Name, sc.Name}
+		}
 	}
 	return protoProduct
 }
@@ -385,11 +811,13 @@ func toProtoCategory(c *ent.Category) *pb.Category {
 		return nil
 	}
 	protoCategory := &pb.Category{
-		Id:          c.ID.String(),
-		Name:        c.Name,
-		Description: *c.Description,
-		CreatedAt:   c.CreatedAt.Unix(),
-		UpdatedAt:   c.UpdatedAt.Unix(),
+		Id:        c.ID.String(),
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt.Unix(),
+		UpdatedAt: c.UpdatedAt.Unix(),
+	}
+	if c.Description != nil {
+		protoCategory.Description = *c.Description
 	}
 	if c.Edges.Subcategories != nil {
 		protoCategory.Subcategories = make([]*pb.Subcategory, len(c.Edges.Subcategories))
@@ -406,11 +834,13 @@ func toProtoSubcategory(sc *ent.SubCategory) *pb.Subcategory {
 		return nil
 	}
 	protoSubcategory := &pb.Subcategory{
-		Id:          sc.ID.String(),
-		Name:        sc.Name,
-		Description: *sc.Description,
-		CreatedAt:   sc.CreatedAt.Unix(),
-		UpdatedAt:   sc.UpdatedAt.Unix(),
+		Id:        sc.ID.String(),
+		Name:      sc.Name,
+		CreatedAt: sc.CreatedAt.Unix(),
+		UpdatedAt: sc.UpdatedAt.Unix(),
+	}
+	if sc.Description != nil {
+		protoSubcategory.Description = *sc.Description
 	}
 	if sc.Edges.Category != nil {
 		protoSubcategory.Category = toProtoCategory(sc.Edges.Category)
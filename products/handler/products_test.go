@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"products/ent"
+	pb "products/proto"
+)
+
+func TestFilteredTotalsCountOnlyMatchingProducts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	for i, name := range []string{"Blue Mug", "Red Mug", "Travel Mug", "Teapot", "Spoon"} {
+		createTestProduct(t, client, name, "SKU-"+string(rune('A'+i)), 5)
+	}
+	h := &ProductService{EntClient: client}
+
+	list := &pb.ListProductsResponse{}
+	if err := h.ListProducts(ctx, &pb.ListProductsRequest{Filter: "mug", Limit: 2}, list); err != nil {
+		t.Fatalf("ListProducts: %v", err)
+	}
+	if list.Total != 3 || len(list.Products) != 2 {
+		t.Fatalf("expected a page of 2 of 3 listed mugs, got %d of %d", len(list.Products), list.Total)
+	}
+
+	search := &pb.SearchProductsResponse{}
+	if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: "mug", Limit: 2}, search); err != nil {
+		t.Fatalf("SearchProducts: %v", err)
+	}
+	if search.Total != 3 || len(search.Products) != 2 {
+		t.Fatalf("expected a page of 2 of 3 found mugs, got %d of %d", len(search.Products), search.Total)
+	}
+}
+
+// countingDriver counts the queries run through it
+type countingDriver struct {
+	dialect.Driver
+	queries int
+}
+
+func (d *countingDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.queries++
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func TestGetProductsByIDsLoadsBreadcrumbsInOneRound(t *testing.T) {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	counter := &countingDriver{Driver: drv}
+	client := ent.NewClient(ent.Driver(counter))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("migrating: %v", err)
+	}
+
+	ids := make([]string, 25)
+	for i := range ids {
+		ids[i] = createTestProduct(t, client, fmt.Sprintf("Product %d", i), fmt.Sprintf("SKU-%d", i), 5).ID.String()
+	}
+	h := &ProductService{EntClient: client}
+
+	counter.queries = 0
+	rsp := &pb.GetProductsByIDsResponse{}
+	if err := h.GetProductsByIDs(ctx, &pb.GetProductsByIDsRequest{Ids: ids}, rsp); err != nil {
+		t.Fatalf("GetProductsByIDs: %v", err)
+	}
+	// One query each for the products, their subcategories, their categories and their price tiers
+	if counter.queries != 4 {
+		t.Fatalf("expected 4 queries for %d products, got %d", len(ids), counter.queries)
+	}
+	if len(rsp.Products) != len(ids) {
+		t.Fatalf("expected %d products, got %d", len(ids), len(rsp.Products))
+	}
+	for _, p := range rsp.Products {
+		if len(p.Breadcrumb) != 2 || p.Breadcrumb[1] != p.Subcategory.Name {
+			t.Errorf("product %s: expected a category/subcategory breadcrumb, got %q", p.Name, p.Breadcrumb)
+		}
+	}
+}
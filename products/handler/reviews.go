@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+
+	"products/ent"
+	"products/ent/product"
+	"products/ent/review"
+	pb "products/proto"
+)
+
+// CreateReview handles a customer rating an active product; a user reviews a product once
+func (h *ProductService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest, rsp *pb.CreateReviewResponse) error {
+	logger.Extract(ctx).Infof("Received CreateReview request for product %s by user %s, rating: %d", req.ProductId, req.UserId, req.Rating)
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.ProductId)
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+	if req.Rating < 1 || req.Rating > 5 {
+		return errors.BadRequest("products.CreateReview", "rating must be between 1 and 5")
+	}
+
+	exists, err := h.EntClient.Product.Query().
+		Where(product.ID(productID), product.IsActive(true)).
+		Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to look up product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to get product: %w", err)
+	}
+	if !exists {
+		logger.Extract(ctx).Infof("Product not found: %s", req.ProductId)
+		return errors.NotFound("products.CreateReview", "product not found")
+	}
+
+	create := h.EntClient.Review.Create().
+		SetProductID(productID).
+		SetUserID(userID).
+		SetRating(int(req.Rating))
+	if comment := strings.TrimSpace(req.Comment); comment != "" {
+		create.SetComment(comment)
+	}
+	r, err := create.Save(ctx)
+	if err != nil {
+		if conflict := uniqueViolation("products.CreateReview", err); conflict != nil {
+			logger.Extract(ctx).Infof("User %s already reviewed product %s", req.UserId, req.ProductId)
+			return conflict
+		}
+		logger.Extract(ctx).Errorf("Failed to create review: %v", err)
+		return fmt.Errorf("failed to create review: %w", err)
+	}
+
+	rsp.Review = toProtoReview(r, productID)
+	logger.Extract(ctx).Infof("Review created successfully: %s", r.ID)
+	return nil
+}
+
+// ListReviewsByProduct handles listing a product's reviews, newest first
+func (h *ProductService) ListReviewsByProduct(ctx context.Context, req *pb.ListReviewsByProductRequest, rsp *pb.ListReviewsByProductResponse) error {
+	logger.Extract(ctx).Infof("Received ListReviewsByProduct request for product %s (limit: %d, offset: %d)", req.ProductId, req.Limit, req.Offset)
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.ProductId)
+	}
+
+	query := h.EntClient.Review.Query().
+		Where(review.HasProductWith(product.ID(productID))).
+		Order(ent.Desc(review.FieldCreatedAt))
+	if req.Limit > 0 {
+		query.Limit(int(req.Limit))
+	}
+	if req.Offset > 0 {
+		query.Offset(int(req.Offset))
+	}
+
+	reviews, err := query.All(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to list reviews of product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to list reviews: %w", err)
+	}
+	total, err := h.EntClient.Review.Query().
+		Where(review.HasProductWith(product.ID(productID))).
+		Count(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to count reviews of product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	rsp.Reviews = make([]*pb.Review, len(reviews))
+	for i, r := range reviews {
+		rsp.Reviews[i] = toProtoReview(r, productID)
+	}
+	rsp.Total = int32(total)
+	logger.Extract(ctx).Infof("Listed %d of %d reviews of product %s", len(reviews), total, req.ProductId)
+	return nil
+}
+
+// GetProductRating handles computing a product's average rating and review count in one query
+func (h *ProductService) GetProductRating(ctx context.Context, req *pb.GetProductRatingRequest, rsp *pb.GetProductRatingResponse) error {
+	logger.Extract(ctx).Infof("Received GetProductRating request for product %s", req.ProductId)
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return fmt.Errorf("invalid product id: %s", req.ProductId)
+	}
+
+	var ratings []struct {
+		Average float64 `json:"average"`
+		Count   int     `json:"count"`
+	}
+	err = h.EntClient.Review.Query().
+		Where(review.HasProductWith(product.ID(productID))).
+		Aggregate(
+			func(s *sql.Selector) string {
+				return sql.As("COALESCE("+sql.Avg(s.C(review.FieldRating))+", 0)", "average")
+			},
+			ent.Count(),
+		).
+		Scan(ctx, &ratings)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to aggregate ratings of product %s: %v", req.ProductId, err)
+		return fmt.Errorf("failed to get product rating: %w", err)
+	}
+
+	if len(ratings) > 0 {
+		rsp.AverageRating = ratings[0].Average
+		rsp.ReviewCount = int32(ratings[0].Count)
+	}
+	logger.Extract(ctx).Infof("Product %s has %d reviews averaging %.2f", req.ProductId, rsp.ReviewCount, rsp.AverageRating)
+	return nil
+}
+
+// toProtoReview converts an Entgo Review entity to a Protobuf Review message
+func toProtoReview(r *ent.Review, productID uuid.UUID) *pb.Review {
+	protoReview := &pb.Review{
+		Id:        r.ID.String(),
+		ProductId: productID.String(),
+		UserId:    r.UserID.String(),
+		Rating:    int32(r.Rating),
+		CreatedAt: r.CreatedAt.Unix(),
+	}
+	if r.Comment != nil {
+		protoReview.Comment = *r.Comment
+	}
+	return protoReview
+}
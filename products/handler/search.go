@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"products/ent/predicate"
+	"products/ent/product"
+)
+
+// normalizeQuery lowercases a search query, strips basic punctuation and
+// collapses whitespace, returning the individual search terms
+func normalizeQuery(q string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(q) {
+		switch {
+		case r == '\'' || r == '’':
+			// Drop apostrophes so "men's" matches "mens"
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			b.WriteRune(' ')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Fields(b.String())
+}
+
+// searchPredicate builds a predicate requiring every term to match the product name or description
+func searchPredicate(terms []string) predicate.Product {
+	preds := make([]predicate.Product, len(terms))
+	for i, term := range terms {
+		preds[i] = product.Or(
+			product.NameContainsFold(term),
+			product.DescriptionContainsFold(term),
+		)
+	}
+	return product.And(preds...)
+}
+
+// FuzzyMaxDistance is the default edit distance tolerated per search term in fuzzy
+// mode; main overrides it from the environment
+var FuzzyMaxDistance = 2
+
+// fuzzyMatch scores how well a product name matches every query term, allowing each
+// term up to maxDistance edits against one of the name's words (capped at a third of
+// the term's length so short terms stay strict). It returns false if any term misses.
+func fuzzyMatch(terms, words []string, maxDistance int) (score int, ok bool) {
+	for _, term := range terms {
+		allowed := min(maxDistance, utf8.RuneCountInString(term)/3)
+		best := -1
+		for _, word := range words {
+			if strings.Contains(word, term) {
+				best = 0
+				break
+			}
+			if d, ok := editDistance(term, word, allowed); ok && (best < 0 || d < best) {
+				best = d
+			}
+		}
+		if best < 0 {
+			return 0, false
+		}
+		score += best
+	}
+	return score, true
+}
+
+// editDistance returns the optimal string alignment distance between a and b
+// (insertions, deletions, substitutions and adjacent transpositions), giving up
+// with ok=false as soon as it must exceed max
+func editDistance(a, b string, max int) (d int, ok bool) {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > max {
+		return 0, false
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return 0, false
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	if prev[len(rb)] > max {
+		return 0, false
+	}
+	return prev[len(rb)], true
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	pb "products/proto"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := map[string][]string{
+		"iPhone":                 {"iphone"},
+		"  Apple   iPhone\t15  ": {"apple", "iphone", "15"},
+		"men's, shoes!!":         {"mens", "shoes"},
+		"usb-c / lightning":      {"usb", "c", "lightning"},
+		" ?! ":                   nil,
+	}
+	for q, want := range tests {
+		if got := normalizeQuery(q); !slices.Equal(got, want) {
+			t.Errorf("normalizeQuery(%q) = %q, want %q", q, got, want)
+		}
+	}
+}
+
+func TestSearchProductsMatchesEveryTerm(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestProduct(t, client, "iPhone 15", "PHONE-15", 5)
+	createTestProduct(t, client, "iPhone 14", "PHONE-14", 5)
+	createTestProduct(t, client, "Pixel 8", "PIXEL-8", 5)
+	h := &ProductService{EntClient: client}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"iphone", []string{"iPhone 14", "iPhone 15"}},
+		{"  IPHONE,   15!", []string{"iPhone 15"}},
+		{"iphone 8", nil},
+	}
+	for _, tt := range tests {
+		rsp := &pb.SearchProductsResponse{}
+		if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: tt.query}, rsp); err != nil {
+			t.Fatalf("SearchProducts(%q): %v", tt.query, err)
+		}
+		var got []string
+		for _, p := range rsp.Products {
+			got = append(got, p.Name)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) || int(rsp.Total) != len(tt.want) {
+			t.Errorf("SearchProducts(%q) = %q (total %d), want %q", tt.query, got, rsp.Total, tt.want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		max  int
+		want int
+		ok   bool
+	}{
+		{"iphone", "iphone", 2, 0, true},
+		{"ipohne", "iphone", 2, 1, true}, // adjacent transposition
+		{"iphne", "iphone", 2, 1, true},
+		{"ipxone", "iphone", 2, 1, true},
+		{"android", "iphone", 2, 0, false},
+		{"iphone", "iphones15", 2, 0, false},
+	}
+	for _, tt := range tests {
+		d, ok := editDistance(tt.a, tt.b, tt.max)
+		if ok != tt.ok || (ok && d != tt.want) {
+			t.Errorf("editDistance(%q, %q, %d) = %d, %v, want %d, %v", tt.a, tt.b, tt.max, d, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFuzzySearchToleratesTypos(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestProduct(t, client, "iPhone 15", "PHONE-15", 5)
+	createTestProduct(t, client, "Pixel 8", "PIXEL-8", 5)
+	h := &ProductService{EntClient: client}
+
+	rsp := &pb.SearchProductsResponse{}
+	if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: "ipohne"}, rsp); err != nil {
+		t.Fatalf("SearchProducts: %v", err)
+	}
+	if rsp.Total != 0 {
+		t.Fatalf("expected exact mode to miss a typo, got %d results", rsp.Total)
+	}
+
+	for _, query := range []string{"ipohne", "iphnoe 15", "pixle"} {
+		rsp := &pb.SearchProductsResponse{}
+		if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: query, Fuzzy: true}, rsp); err != nil {
+			t.Fatalf("SearchProducts(%q): %v", query, err)
+		}
+		if rsp.Total != 1 {
+			t.Errorf("expected fuzzy %q to match one product, got %d", query, rsp.Total)
+		}
+	}
+
+	// Short terms stay strict so they don't match everything
+	rsp = &pb.SearchProductsResponse{}
+	if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: "16", Fuzzy: true}, rsp); err != nil {
+		t.Fatalf("SearchProducts: %v", err)
+	}
+	if rsp.Total != 0 {
+		t.Fatalf("expected a two-character term to tolerate no typos, got %d results", rsp.Total)
+	}
+}
+
+func TestFuzzySearchRanksCloserMatchesFirst(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestProduct(t, client, "Blender", "BLEND-1", 5)
+	createTestProduct(t, client, "Blander", "BLAND-1", 5)
+	h := &ProductService{EntClient: client}
+
+	rsp := &pb.SearchProductsResponse{}
+	if err := h.SearchProducts(ctx, &pb.SearchProductsRequest{Query: "blandre", Fuzzy: true}, rsp); err != nil {
+		t.Fatalf("SearchProducts: %v", err)
+	}
+	if len(rsp.Products) != 2 || rsp.Products[0].Name != "Blander" {
+		t.Fatalf("expected Blander ranked ahead of Blender, got %v", rsp.Products)
+	}
+}
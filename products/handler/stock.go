@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/logger"
+
+	"products/ent"
+	"products/ent/failedstockadjustment"
+	"products/ent/product"
+	"products/ent/stockdeduction"
+	"products/ent/stockrestock"
+	pb "products/proto"
+)
+
+// OrderCreatedTopic is the topic the orders service publishes new orders on
+const OrderCreatedTopic = "orders.created"
+
+// StockSubscriber decrements product stock when orders are created
+type StockSubscriber struct {
+	EntClient *ent.Client
+}
+
+// OrderCreated takes an order's items out of stock. Each order is applied at most
+// once: a StockDeduction keyed by order id is written in the same transaction, so
+// a redelivered event finds it and is acknowledged without touching stock again.
+func (s *StockSubscriber) OrderCreated(ctx context.Context, ev *pb.OrderCreatedEvent) error {
+	logger.Extract(ctx).Infof("Received %s event for order %s (%d items)", OrderCreatedTopic, ev.OrderId, len(ev.Items))
+
+	orderID, err := uuid.Parse(ev.OrderId)
+	if err != nil {
+		// Redelivering a malformed event can't succeed, so drop it
+		logger.Extract(ctx).Errorf("Dropping %s event with invalid order_id %q", OrderCreatedTopic, ev.OrderId)
+		return nil
+	}
+
+	tx, err := s.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for order %s: %v", ev.OrderId, err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.StockDeduction.Create().SetOrderID(orderID).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		logger.Extract(ctx).Infof("Stock already deducted for order %s, ignoring duplicate event", ev.OrderId)
+		return nil
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to record stock deduction for order %s: %v", ev.OrderId, err)
+		return fmt.Errorf("failed to record stock deduction: %w", err)
+	}
+
+	// An order cancelled before its event arrived was never deducted, so there is nothing to take
+	cancelled, err := tx.StockRestock.Query().Where(stockrestock.OrderID(orderID)).Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to check restock of order %s: %v", ev.OrderId, err)
+		return fmt.Errorf("failed to check restock: %w", err)
+	}
+	if cancelled {
+		if err := tx.Commit(); err != nil {
+			logger.Extract(ctx).Errorf("Failed to commit stock deduction for order %s: %v", ev.OrderId, err)
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		logger.Extract(ctx).Infof("Order %s was cancelled before its stock was deducted, skipping", ev.OrderId)
+		return nil
+	}
+
+	for _, item := range ev.Items {
+		err := deductStock(ctx, tx, item.ProductId, int(item.Quantity))
+		if errors.Is(err, errUnknownProduct) {
+			// Redelivery can't fix a missing product, so dead-letter the item for ops to reconcile
+			logger.Extract(ctx).Warnf("Failed to deduct stock for order %s, recording it for retry: %v", ev.OrderId, err)
+			err = tx.FailedStockAdjustment.Create().
+				SetOrderID(orderID).
+				SetProductID(item.ProductId).
+				SetQuantity(int(item.Quantity)).
+				SetReason(err.Error()).
+				Exec(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Failed to record failed stock adjustment for order %s: %v", ev.OrderId, err)
+				return fmt.Errorf("failed to record failed stock adjustment: %w", err)
+			}
+			continue
+		}
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to deduct stock for order %s: %v", ev.OrderId, err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit stock deduction for order %s: %v", ev.OrderId, err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Extract(ctx).Infof("Stock deducted for order %s", ev.OrderId)
+	return nil
+}
+
+// errUnknownProduct is returned by deductStock for a product that doesn't exist
+var errUnknownProduct = errors.New("unknown product")
+
+// deductStock removes quantity of a product from stock. The order has already been
+// placed, so an oversold product is clamped to zero.
+func deductStock(ctx context.Context, tx *ent.Tx, id string, quantity int) error {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: invalid product_id %q", errUnknownProduct, id)
+	}
+
+	n, err := tx.Product.Update().
+		Where(product.ID(productID), product.StockQuantityGTE(quantity)).
+		AddStockQuantity(-quantity).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to deduct stock for product %s: %w", id, err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	n, err = tx.Product.Update().
+		Where(product.ID(productID)).
+		SetStockQuantity(0).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to deduct stock for product %s: %w", id, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", errUnknownProduct, id)
+	}
+	logger.Extract(ctx).Warnf("Product %s oversold by order item of %d, stock clamped to zero", id, quantity)
+	return nil
+}
+
+// IncrementStock returns a cancelled order's items to stock. Like OrderCreated it is
+// applied at most once per order, so callers may retry it and compensate safely; an
+// order whose stock was never deducted is only recorded, so its late event is skipped.
+func (h *ProductService) IncrementStock(ctx context.Context, req *pb.IncrementStockRequest, rsp *pb.IncrementStockResponse) error {
+	logger.Extract(ctx).Infof("Received IncrementStock request for order %s (%d items)", req.OrderId, len(req.Items))
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return fmt.Errorf("invalid order_id: %s", req.OrderId)
+	}
+	for _, item := range req.Items {
+		if _, err := uuid.Parse(item.ProductId); err != nil {
+			return fmt.Errorf("invalid product_id: %s", item.ProductId)
+		}
+		if item.Quantity <= 0 {
+			return fmt.Errorf("quantity must be positive for product %s", item.ProductId)
+		}
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to start transaction for order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.StockRestock.Create().SetOrderID(orderID).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		logger.Extract(ctx).Infof("Stock already restocked for order %s, ignoring retry", req.OrderId)
+		return nil
+	}
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to record restock for order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to record restock: %w", err)
+	}
+
+	deducted, err := tx.StockDeduction.Query().Where(stockdeduction.OrderID(orderID)).Exist(ctx)
+	if err != nil {
+		logger.Extract(ctx).Errorf("Failed to check stock deduction of order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to check stock deduction: %w", err)
+	}
+	if deducted {
+		// Items dead-lettered when the order was placed never left stock, so settle them instead
+		owed, err := settleFailedAdjustments(ctx, tx, orderID)
+		if err != nil {
+			logger.Extract(ctx).Errorf("Failed to settle failed stock adjustments of order %s: %v", req.OrderId, err)
+			return err
+		}
+		for _, item := range req.Items {
+			quantity := int(item.Quantity)
+			skip := min(owed[item.ProductId], quantity)
+			owed[item.ProductId] -= skip
+			if quantity -= skip; quantity == 0 {
+				continue
+			}
+
+			n, err := tx.Product.Update().
+				Where(product.ID(uuid.MustParse(item.ProductId))).
+				AddStockQuantity(quantity).
+				AddVersion(1).
+				Save(ctx)
+			if err != nil {
+				logger.Extract(ctx).Errorf("Failed to restock product %s for order %s: %v", item.ProductId, req.OrderId, err)
+				return fmt.Errorf("failed to restock product %s: %w", item.ProductId, err)
+			}
+			if n == 0 {
+				logger.Extract(ctx).Warnf("Skipping restock of unknown product %s", item.ProductId)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Extract(ctx).Errorf("Failed to commit restock for order %s: %v", req.OrderId, err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Restocked = deducted
+	if deducted {
+		logger.Extract(ctx).Infof("Stock restocked for order %s", req.OrderId)
+	} else {
+		logger.Extract(ctx).Infof("Stock of order %s was never deducted, recorded restock only", req.OrderId)
+	}
+	return nil
+}
+
+// settleFailedAdjustments resolves the unresolved failed stock adjustments of a cancelled
+// order, which no longer owe stock, and returns the quantities they held back by product id
+func settleFailedAdjustments(ctx context.Context, tx *ent.Tx, orderID uuid.UUID) (map[string]int, error) {
+	pending, err := tx.FailedStockAdjustment.Query().
+		Where(failedstockadjustment.OrderID(orderID), failedstockadjustment.ResolvedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query failed stock adjustments: %w", err)
+	}
+	owed := make(map[string]int, len(pending))
+	for _, a := range pending {
+		owed[a.ProductID] += a.Quantity
+	}
+	if len(pending) > 0 {
+		err = tx.FailedStockAdjustment.Update().
+			Where(failedstockadjustment.OrderID(orderID), failedstockadjustment.ResolvedAtIsNil()).
+			SetResolvedAt(time.Now()).
+			SetReason("order cancelled before its stock was deducted").
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve failed stock adjustments: %w", err)
+		}
+	}
+	return owed, nil
+}
+
+// errUnknownSKU is returned by importStock for a SKU no product has
+var errUnknownSKU = errors.New("unknown sku")
+
+// ImportStockBySKU applies a stream of warehouse stock counts keyed by SKU. Each count
+// replaces the product's stock, or changes it by its quantity when delta is set; counts
+// are applied one by one, so one that fails is reported and the rest still go in.
+func (h *AdminService) ImportStockBySKU(ctx context.Context, stream pb.AdminService_ImportStockBySKUStream) error {
+	logger.Extract(ctx).Infof("Received ImportStockBySKU stream request (Admin operation)")
+	rsp := &pb.ImportStockBySKUResponse{}
+
+	for index := int32(0); ; index++ {
+		req := &pb.ImportStockBySKURequest{}
+		err := stream.RecvMsg(req)
+		if err != nil {
+			if err.Error() == "EOF" { // go-micro uses EOF for end of stream
+				break
+			}
+			logger.Extract(ctx).Errorf("Error receiving from ImportStockBySKU stream: %v", err)
+			return fmt.Errorf("error receiving stock data: %w", err)
+		}
+
+		err = importStock(ctx, h.EntClient, req)
+		if errors.Is(err, errUnknownSKU) {
+			logger.Extract(ctx).Infof("ImportStockBySKU: Unknown sku %s", req.Sku)
+			rsp.UnknownSkus = append(rsp.UnknownSkus, req.Sku)
+			continue
+		}
+		if err != nil {
+			logger.Extract(ctx).Infof("ImportStockBySKU: Skipping count %d for sku %s: %v", index, req.Sku, err)
+			rsp.Failures = append(rsp.Failures, &pb.ImportStockFailure{
+				Index:  index,
+				Sku:    req.Sku,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		rsp.Updated++
+	}
+
+	if err := stream.SendMsg(rsp); err != nil {
+		logger.Extract(ctx).Errorf("Error sending ImportStockBySKU response: %v", err)
+		return fmt.Errorf("failed to send response: %w", err)
+	}
+
+	logger.Extract(ctx).Infof("ImportStockBySKU: Updated stock of %d products, %d unknown skus, %d failed.", rsp.Updated, len(rsp.UnknownSkus), len(rsp.Failures))
+	return nil
+}
+
+// importStock applies one stock count of ImportStockBySKU. A delta that would take
+// stock below zero is rejected rather than clamped, as the count is likely stale.
+func importStock(ctx context.Context, client *ent.Client, req *pb.ImportStockBySKURequest) error {
+	sku, err := normalizeSKU(req.Sku)
+	if err != nil {
+		return err
+	}
+	quantity := int(req.Quantity)
+
+	update := client.Product.Update().Where(product.Sku(sku))
+	if req.Delta {
+		update.Where(product.StockQuantityGTE(-quantity)).AddStockQuantity(quantity)
+	} else {
+		if quantity < 0 {
+			return fmt.Errorf("stock quantity must not be negative, got %d", quantity)
+		}
+		update.SetStockQuantity(quantity)
+	}
+	n, err := update.AddVersion(1).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update stock: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	exists, err := client.Product.Query().Where(product.Sku(sku)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to look up sku: %w", err)
+	}
+	if !exists {
+		return errUnknownSKU
+	}
+	return fmt.Errorf("delta %d would take stock below zero", quantity)
+}
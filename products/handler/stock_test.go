@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/broker"
+	"google.golang.org/protobuf/proto"
+
+	pb "products/proto"
+)
+
+// subscribeStock delivers OrderCreatedTopic events published on an in-memory broker to sub
+func subscribeStock(t *testing.T, sub *StockSubscriber) broker.Broker {
+	t.Helper()
+	b := broker.NewMemoryBroker()
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connecting broker: %v", err)
+	}
+	t.Cleanup(func() { b.Disconnect() })
+	_, err := b.Subscribe(OrderCreatedTopic, func(e broker.Event) error {
+		ev := &pb.OrderCreatedEvent{}
+		if err := proto.Unmarshal(e.Message().Body, ev); err != nil {
+			return err
+		}
+		return sub.OrderCreated(context.Background(), ev)
+	})
+	if err != nil {
+		t.Fatalf("subscribing: %v", err)
+	}
+	return b
+}
+
+// publishOrderCreated publishes ev on b
+func publishOrderCreated(t *testing.T, b broker.Broker, ev *pb.OrderCreatedEvent) {
+	t.Helper()
+	body, err := proto.Marshal(ev)
+	if err != nil {
+		t.Fatalf("marshalling event: %v", err)
+	}
+	if err := b.Publish(OrderCreatedTopic, &broker.Message{Body: body}); err != nil {
+		t.Fatalf("publishing event: %v", err)
+	}
+}
+
+func TestOrderCreatedDecrementsStockOnce(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	mug := createTestProduct(t, client, "Mug", "MUG-1", 10)
+	pen := createTestProduct(t, client, "Pen", "PEN-1", 1)
+	b := subscribeStock(t, &StockSubscriber{EntClient: client})
+
+	ev := &pb.OrderCreatedEvent{
+		OrderId: uuid.NewString(),
+		Items: []*pb.OrderCreatedEventItem{
+			{ProductId: mug.ID.String(), Quantity: 3},
+			{ProductId: pen.ID.String(), Quantity: 2},
+		},
+	}
+	publishOrderCreated(t, b, ev)
+	publishOrderCreated(t, b, ev) // redelivery
+
+	if got := client.Product.GetX(ctx, mug.ID).StockQuantity; got != 7 {
+		t.Errorf("expected mug stock decremented once to 7, got %d", got)
+	}
+	if got := client.Product.GetX(ctx, pen.ID).StockQuantity; got != 0 {
+		t.Errorf("expected oversold pen stock clamped to 0, got %d", got)
+	}
+
+	publishOrderCreated(t, b, &pb.OrderCreatedEvent{
+		OrderId: uuid.NewString(),
+		Items:   []*pb.OrderCreatedEventItem{{ProductId: mug.ID.String(), Quantity: 2}},
+	})
+	if got := client.Product.GetX(ctx, mug.ID).StockQuantity; got != 5 {
+		t.Errorf("expected another order to decrement mug stock to 5, got %d", got)
+	}
+}
+
+// fakeImportStream feeds ImportStockBySKU its counts and keeps the response
+type fakeImportStream struct {
+	pb.AdminService_ImportStockBySKUStream
+	reqs []*pb.ImportStockBySKURequest
+	rsp  *pb.ImportStockBySKUResponse
+}
+
+func (s *fakeImportStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(*pb.ImportStockBySKURequest), s.reqs[0])
+	s.reqs = s.reqs[1:]
+	return nil
+}
+
+func (s *fakeImportStream) SendMsg(m interface{}) error {
+	s.rsp = m.(*pb.ImportStockBySKUResponse)
+	return nil
+}
+
+func TestImportStockBySKU(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	mug := createTestProduct(t, client, "Mug", "MUG-1", 5)
+	hat := createTestProduct(t, client, "Cap", "CAP-1", 10)
+	pen := createTestProduct(t, client, "Pen", "PEN-1", 2)
+
+	stream := &fakeImportStream{reqs: []*pb.ImportStockBySKURequest{
+		{Sku: "mug-1", Quantity: 40},
+		{Sku: "NOPE-1", Quantity: 3},
+		{Sku: "CAP-1", Quantity: -4, Delta: true},
+		{Sku: "PEN-1", Quantity: -3, Delta: true},
+		{Sku: "GONE-2", Quantity: 1, Delta: true},
+		{Sku: "MUG-1", Quantity: -1},
+	}}
+	if err := (&AdminService{EntClient: client}).ImportStockBySKU(ctx, stream); err != nil {
+		t.Fatalf("ImportStockBySKU: %v", err)
+	}
+
+	rsp := stream.rsp
+	if rsp.Updated != 2 {
+		t.Fatalf("expected 2 counts applied, got %d", rsp.Updated)
+	}
+	if !slices.Equal(rsp.UnknownSkus, []string{"NOPE-1", "GONE-2"}) {
+		t.Fatalf("expected NOPE-1 and GONE-2 reported unknown, got %v", rsp.UnknownSkus)
+	}
+	if len(rsp.Failures) != 2 ||
+		rsp.Failures[0].Index != 3 || !strings.Contains(rsp.Failures[0].Reason, "below zero") ||
+		rsp.Failures[1].Index != 5 || !strings.Contains(rsp.Failures[1].Reason, "must not be negative") {
+		t.Fatalf("unexpected failures %v", rsp.Failures)
+	}
+
+	if got := client.Product.GetX(ctx, mug.ID).StockQuantity; got != 40 {
+		t.Errorf("expected mug stock set to 40, got %d", got)
+	}
+	if got := client.Product.GetX(ctx, hat.ID).StockQuantity; got != 6 {
+		t.Errorf("expected cap stock reduced to 6, got %d", got)
+	}
+	if got := client.Product.GetX(ctx, pen.ID).StockQuantity; got != 2 {
+		t.Errorf("expected pen stock left at 2, got %d", got)
+	}
+}
+
+func TestFailedStockDeductionIsRecordedAndRetryable(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	admin := &AdminService{EntClient: client}
+	mug := createTestProduct(t, client, "Mug", "MUG-1", 10)
+	missing := uuid.New()
+
+	err := (&StockSubscriber{EntClient: client}).OrderCreated(ctx, &pb.OrderCreatedEvent{
+		OrderId: uuid.NewString(),
+		Items: []*pb.OrderCreatedEventItem{
+			{ProductId: mug.ID.String(), Quantity: 2},
+			{ProductId: missing.String(), Quantity: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("OrderCreated: %v", err)
+	}
+	if got := client.Product.GetX(ctx, mug.ID).StockQuantity; got != 8 {
+		t.Fatalf("expected the known product deducted to 8, got %d", got)
+	}
+
+	list := &pb.ListFailedStockAdjustmentsResponse{}
+	if err := admin.ListFailedStockAdjustments(ctx, &pb.ListFailedStockAdjustmentsRequest{}, list); err != nil {
+		t.Fatalf("ListFailedStockAdjustments: %v", err)
+	}
+	if list.Total != 1 || list.Adjustments[0].ProductId != missing.String() || list.Adjustments[0].Quantity != 3 {
+		t.Fatalf("expected the missing product's item recorded, got %v", list.Adjustments)
+	}
+	id := list.Adjustments[0].Id
+
+	// Retrying while the product is still missing keeps the record open
+	if err := admin.RetryStockAdjustment(ctx, &pb.RetryStockAdjustmentRequest{Id: id}, &pb.RetryStockAdjustmentResponse{}); err == nil {
+		t.Fatal("expected a retry to fail while the product is missing")
+	}
+	list = &pb.ListFailedStockAdjustmentsResponse{}
+	if err := admin.ListFailedStockAdjustments(ctx, &pb.ListFailedStockAdjustmentsRequest{}, list); err != nil {
+		t.Fatalf("ListFailedStockAdjustments: %v", err)
+	}
+	if list.Total != 1 || list.Adjustments[0].Attempts != 2 {
+		t.Fatalf("expected the adjustment still open after 2 attempts, got %v", list.Adjustments)
+	}
+
+	client.Product.Create().
+		SetID(missing).
+		SetName("Pen").
+		SetSku("PEN-1").
+		SetDescription("Pen description").
+		SetPriceCents(100).
+		SetStockQuantity(5).
+		SetUserID(uuid.New()).
+		SetSubcategory(createTestSubcategory(t, client)).
+		ExecX(ctx)
+	rsp := &pb.RetryStockAdjustmentResponse{}
+	if err := admin.RetryStockAdjustment(ctx, &pb.RetryStockAdjustmentRequest{Id: id}, rsp); err != nil {
+		t.Fatalf("RetryStockAdjustment: %v", err)
+	}
+	if rsp.Adjustment.ResolvedAt == 0 || rsp.Adjustment.Attempts != 3 {
+		t.Fatalf("expected the adjustment resolved on the third attempt, got %v", rsp.Adjustment)
+	}
+	if got := client.Product.GetX(ctx, missing).StockQuantity; got != 2 {
+		t.Fatalf("expected the retried deduction to leave 2 in stock, got %d", got)
+	}
+
+	// Retrying a resolved adjustment is a no-op
+	if err := admin.RetryStockAdjustment(ctx, &pb.RetryStockAdjustmentRequest{Id: id}, &pb.RetryStockAdjustmentResponse{}); err != nil {
+		t.Fatalf("RetryStockAdjustment: %v", err)
+	}
+	if got := client.Product.GetX(ctx, missing).StockQuantity; got != 2 {
+		t.Fatalf("expected a repeat retry not to deduct again, got %d", got)
+	}
+
+	list = &pb.ListFailedStockAdjustmentsResponse{}
+	if err := admin.ListFailedStockAdjustments(ctx, &pb.ListFailedStockAdjustmentsRequest{IncludeResolved: true}, list); err != nil {
+		t.Fatalf("ListFailedStockAdjustments: %v", err)
+	}
+	if list.Total != 1 {
+		t.Fatalf("expected the resolved adjustment listed with include_resolved, got %d", list.Total)
+	}
+}
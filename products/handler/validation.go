@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldLimits caps the length, in characters, of free-form catalog input; zero disables a limit
+type FieldLimits struct {
+	Name        int // Product, category and subcategory names
+	Description int
+}
+
+// Limits are enforced by the handlers; main overrides the defaults from the environment
+var Limits = FieldLimits{Name: 200, Description: 5000}
+
+// check validates the name and description of a create or update request
+func (l FieldLimits) check(name, description string) error {
+	if err := checkLength("name", name, l.Name); err != nil {
+		return err
+	}
+	return checkLength("description", description, l.Description)
+}
+
+// maxSKULength caps a SKU so it fits the labels and feeds of inventory systems
+const maxSKULength = 64
+
+// skuPattern matches letters and digits, optionally separated by single dashes
+var skuPattern = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
+// normalizeSKU validates a SKU and returns it upper-cased, so SKUs differing only in case collide
+func normalizeSKU(sku string) (string, error) {
+	s := strings.TrimSpace(sku)
+	if s == "" {
+		return "", fmt.Errorf("sku is required")
+	}
+	if utf8.RuneCountInString(s) > maxSKULength {
+		return "", fmt.Errorf("sku exceeds the maximum length of %d characters", maxSKULength)
+	}
+	if !skuPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid sku %q: use letters and digits, optionally separated by single dashes", sku)
+	}
+	return strings.ToUpper(s), nil
+}
+
+// checkLength rejects a value longer than max characters, naming the offending field
+func checkLength(field, value string, max int) error {
+	if max > 0 && utf8.RuneCountInString(value) > max {
+		return fmt.Errorf("%s exceeds the maximum length of %d characters", field, max)
+	}
+	return nil
+}
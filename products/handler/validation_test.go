@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "products/proto"
+)
+
+func TestCreateProductRejectsOverLengthFields(t *testing.T) {
+	client := newTestClient(t)
+	h := &ProductService{EntClient: client}
+	subcategoryID := createTestSubcategory(t, client).ID.String()
+	valid := func() *pb.CreateProductRequest {
+		return &pb.CreateProductRequest{
+			Name:          "Mug",
+			Sku:           "MUG-1",
+			Description:   "A mug",
+			PriceCents:    1000,
+			Currency:      "USD",
+			StockQuantity: 5,
+			UserId:        uuid.NewString(),
+			SubcategoryId: subcategoryID,
+		}
+	}
+
+	tests := map[string]func(*pb.CreateProductRequest){
+		"name":        func(r *pb.CreateProductRequest) { r.Name = strings.Repeat("a", Limits.Name+1) },
+		"description": func(r *pb.CreateProductRequest) { r.Description = strings.Repeat("a", Limits.Description+1) },
+	}
+	for field, modify := range tests {
+		req := valid()
+		modify(req)
+		err := h.CreateProduct(context.Background(), req, &pb.CreateProductResponse{})
+		if err == nil || !strings.HasPrefix(err.Error(), field+" exceeds the maximum length") {
+			t.Errorf("%s: expected a length error naming the field, got %v", field, err)
+		}
+	}
+	if n := client.Product.Query().CountX(context.Background()); n != 0 {
+		t.Fatalf("expected no products stored, found %d", n)
+	}
+
+	req := valid()
+	req.Description = strings.Repeat("a", Limits.Description)
+	if err := h.CreateProduct(context.Background(), req, &pb.CreateProductResponse{}); err != nil {
+		t.Fatalf("expected a description at the limit accepted, got %v", err)
+	}
+}
@@ -3,14 +3,12 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
-	"products/ent"
 	"products/handler"
+	"products/seed"
 
-	_ "github.com/mattn/go-sqlite3" // Import for SQLite driver
-
-	"entgo.io/ent/dialect"
 	"go-micro.dev/v5"
 	"go-micro.dev/v5/logger"
 
@@ -18,30 +16,88 @@ import (
 )
 
 func main() {
-	// Initialize EntgoClient
-	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	// Initialize EntgoClient from DB_DRIVER and DB_DSN
+	client, db, driver, err := openDB()
 	if err != nil {
-		logger.Fatalf("Failed opening connection to sqlite: %v", err)
+		logger.Fatalf("Failed opening connection to %s: %v", driver, err)
 	}
 	defer client.Close()
 
+	// Report not ready until migrations and seeding are done; HEALTH_ADDR enables HTTP probes
+	health := &handler.Health{DB: db}
+	if addr := os.Getenv("HEALTH_ADDR"); addr != "" {
+		go func() {
+			if err := health.ServeProbes(addr); err != nil {
+				logger.Errorf("Health probe server stopped: %v", err)
+			}
+		}()
+	}
+
+	// Record RPC count, error and latency metrics; METRICS_ADDR exposes them at /metrics
+	metrics := handler.NewMetrics()
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		go func() {
+			if err := metrics.Serve(addr); err != nil {
+				logger.Errorf("Metrics server stopped: %v", err)
+			}
+		}()
+	}
+
 	// Run the auto migration tool
 	ctx := context.Background()
 	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("Failed creating schema resources: %v", err)
 	}
 
+	// Load sample fixtures for local development when SEED names a fixture set
+	if set := os.Getenv("SEED"); set != "" {
+		if err := seed.Load(ctx, client, seed.FixtureSet(set)); err != nil {
+			logger.Fatalf("Failed seeding database with %q fixtures: %v", set, err)
+		}
+		logger.Infof("Seeded database with %q fixtures", set)
+	}
+
+	// Configure the stock levels reported as out of stock and low stock
+	handler.Thresholds = handler.StockThresholds{
+		OutOfStock: envInt("STOCK_OUT_THRESHOLD", handler.Thresholds.OutOfStock),
+		LowStock:   envInt("STOCK_LOW_THRESHOLD", handler.Thresholds.LowStock),
+	}
+	if handler.Thresholds.LowStock < handler.Thresholds.OutOfStock {
+		logger.Warnf("STOCK_LOW_THRESHOLD (%d) is below STOCK_OUT_THRESHOLD (%d), no product will report low stock", handler.Thresholds.LowStock, handler.Thresholds.OutOfStock)
+	}
+
+	// Configure input length limits; 0 disables a limit
+	handler.Limits = handler.FieldLimits{
+		Name:        envInt("MAX_NAME_LENGTH", handler.Limits.Name),
+		Description: envInt("MAX_DESCRIPTION_LENGTH", handler.Limits.Description),
+	}
+
+	// Configure the edits tolerated per search term in fuzzy search
+	handler.FuzzyMaxDistance = envInt("FUZZY_MAX_DISTANCE", handler.FuzzyMaxDistance)
+
+	// Advertise the deployment's region, if any
+	metadata := serviceMetadata(os.Getenv("REGION"))
+
 	// Create a new service
 	service := micro.NewService(
 		micro.Name("products"),
 		micro.Version("latest"),
-		micro.Metadata(map[string]string{
-			"StartTime": time.Now().String(),
-		}),
+		micro.Metadata(metadata),
+		micro.WrapHandler(metrics.Wrapper()),
+		micro.WrapHandler(handler.CorrelationWrapper()),
+		micro.WrapSubscriber(handler.CorrelationSubscriberWrapper()),
 		micro.BeforeStart(func() error {
 			logger.Info("Product service starting...")
 			return nil
 		}),
+		micro.AfterStart(func() error {
+			health.SetReady(true)
+			return nil
+		}),
+		micro.BeforeStop(func() error {
+			health.SetReady(false)
+			return nil
+		}),
 		micro.AfterStop(func() error {
 			logger.Info("Product service stopped")
 			return nil
@@ -51,8 +107,22 @@ func main() {
 	// Initialize service
 	service.Init()
 
+	// Register Health.Check and Debug.Health for orchestrator probes
+	if err := health.Register(service.Server()); err != nil {
+		logger.Fatalf("Failed to register health handler: %v", err)
+	}
+
+	// Cache search results until the catalog changes; SEARCH_CACHE_SIZE=0 disables the cache
+	version := &handler.CatalogVersion{}
+	client.Use(version.Hook())
+	searchCache := handler.NewSearchCache(
+		envInt("SEARCH_CACHE_SIZE", 1000),
+		envDuration("SEARCH_CACHE_TTL", time.Minute),
+		version,
+	)
+
 	// Register ProductService handler
-	if err := pb.RegisterProductServiceHandler(service.Server(), &handler.ProductService{EntClient: client}); err != nil {
+	if err := pb.RegisterProductServiceHandler(service.Server(), &handler.ProductService{EntClient: client, SearchCache: searchCache}); err != nil {
 		logger.Fatalf("Failed to register product service handler: %v", err)
 	}
 
@@ -61,6 +131,11 @@ func main() {
 		logger.Fatalf("Failed to register admin service handler: %v", err)
 	}
 
+	// Subscribe to new orders to take their items out of stock
+	if err := micro.RegisterSubscriber(handler.OrderCreatedTopic, service.Server(), &handler.StockSubscriber{EntClient: client}); err != nil {
+		logger.Fatalf("Failed to register stock subscriber: %v", err)
+	}
+
 	// Run the service
 	if err := service.Run(); err != nil {
 		logger.Fatalf("Failed to run service: %v", err)
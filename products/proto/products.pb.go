@@ -21,20 +21,82 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Availability buckets a product's stock_quantity against the configured thresholds
+type Availability int32
+
+const (
+	Availability_AVAILABILITY_UNSPECIFIED Availability = 0
+	Availability_IN_STOCK                 Availability = 1
+	Availability_LOW_STOCK                Availability = 2
+	Availability_OUT_OF_STOCK             Availability = 3
+)
+
+// Enum value maps for Availability.
+var (
+	Availability_name = map[int32]string{
+		0: "AVAILABILITY_UNSPECIFIED",
+		1: "IN_STOCK",
+		2: "LOW_STOCK",
+		3: "OUT_OF_STOCK",
+	}
+	Availability_value = map[string]int32{
+		"AVAILABILITY_UNSPECIFIED": 0,
+		"IN_STOCK":                 1,
+		"LOW_STOCK":                2,
+		"OUT_OF_STOCK":             3,
+	}
+)
+
+func (x Availability) Enum() *Availability {
+	p := new(Availability)
+	*p = x
+	return p
+}
+
+func (x Availability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Availability) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_products_proto_enumTypes[0].Descriptor()
+}
+
+func (Availability) Type() protoreflect.EnumType {
+	return &file_proto_products_proto_enumTypes[0]
+}
+
+func (x Availability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Availability.Descriptor instead.
+func (Availability) EnumDescriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{0}
+}
+
 // Product represents a product in the system
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SubcategoryId string                 `protobuf:"bytes,7,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
-	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
-	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Subcategory   *Subcategory           `protobuf:"bytes,11,opt,name=subcategory,proto3" json:"subcategory,omitempty"` // Embedded subcategory
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in proto/products.proto.
+	Price         float64      `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // Use price_cents
+	StockQuantity int32        `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	UserId        string       `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SubcategoryId string       `protobuf:"bytes,7,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
+	CreatedAt     int64        `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt     int64        `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	IsActive      bool         `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Subcategory   *Subcategory `protobuf:"bytes,11,opt,name=subcategory,proto3" json:"subcategory,omitempty"`                               // Embedded subcategory
+	PriceCents    int64        `protobuf:"varint,12,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`              // Price in minor units (cents)
+	PriceDecimal  string       `protobuf:"bytes,13,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"`         // price_cents rendered as a decimal string, e.g. "19.99"
+	Availability  Availability `protobuf:"varint,14,opt,name=availability,proto3,enum=products.Availability" json:"availability,omitempty"` // Derived from stock_quantity
+	Currency      string       `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`                                     // ISO 4217 code, e.g. "USD"
+	Version       int32        `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`                                      // Optimistic lock version, pass it back on UpdateProduct
+	Breadcrumb    []string     `protobuf:"bytes,17,rep,name=breadcrumb,proto3" json:"breadcrumb,omitempty"`                                 // Category then subcategory name, set when both are loaded
+	PriceTiers    []*PriceTier `protobuf:"bytes,18,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`               // Quantity breaks by ascending min_quantity, set by GetProduct, GetProductBySKU and GetProductsByIDs
+	Sku           string       `protobuf:"bytes,19,opt,name=sku,proto3" json:"sku,omitempty"`                                               // Stock keeping unit, upper-case; empty only for products that predate SKUs
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +152,7 @@ func (x *Product) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/products.proto.
 func (x *Product) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -146,22 +209,140 @@ func (x *Product) GetSubcategory() *Subcategory {
 	return nil
 }
 
+func (x *Product) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *Product) GetPriceDecimal() string {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return ""
+}
+
+func (x *Product) GetAvailability() Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return Availability_AVAILABILITY_UNSPECIFIED
+}
+
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Product) GetBreadcrumb() []string {
+	if x != nil {
+		return x.Breadcrumb
+	}
+	return nil
+}
+
+func (x *Product) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// PriceTier is a quantity break: buying at least min_quantity units costs unit_price_cents each
+type PriceTier struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity      int32                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	UnitPriceCents   int64                  `protobuf:"varint,2,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`      // In the product's currency
+	UnitPriceDecimal string                 `protobuf:"bytes,3,opt,name=unit_price_decimal,json=unitPriceDecimal,proto3" json:"unit_price_decimal,omitempty"` // unit_price_cents rendered as a decimal string
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_proto_products_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceTier) GetMinQuantity() int32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *PriceTier) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *PriceTier) GetUnitPriceDecimal() string {
+	if x != nil {
+		return x.UnitPriceDecimal
+	}
+	return ""
+}
+
 // Category represents a product category
 type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Subcategories []*Subcategory         `protobuf:"bytes,6,rep,name=subcategories,proto3" json:"subcategories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Subcategories    []*Subcategory         `protobuf:"bytes,6,rep,name=subcategories,proto3" json:"subcategories,omitempty"`
+	SubcategoryCount int32                  `protobuf:"varint,7,opt,name=subcategory_count,json=subcategoryCount,proto3" json:"subcategory_count,omitempty"` // Set by ListCategories
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_proto_products_proto_msgTypes[1]
+	mi := &file_proto_products_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +354,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[1]
+	mi := &file_proto_products_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +367,7 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Category.ProtoReflect.Descriptor instead.
 func (*Category) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{1}
+	return file_proto_products_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Category) GetId() string {
@@ -231,6 +412,13 @@ func (x *Category) GetSubcategories() []*Subcategory {
 	return nil
 }
 
+func (x *Category) GetSubcategoryCount() int32 {
+	if x != nil {
+		return x.SubcategoryCount
+	}
+	return 0
+}
+
 // Subcategory represents a product subcategory
 type Subcategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -247,7 +435,7 @@ type Subcategory struct {
 
 func (x *Subcategory) Reset() {
 	*x = Subcategory{}
-	mi := &file_proto_products_proto_msgTypes[2]
+	mi := &file_proto_products_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +447,7 @@ func (x *Subcategory) String() string {
 func (*Subcategory) ProtoMessage() {}
 
 func (x *Subcategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[2]
+	mi := &file_proto_products_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +460,7 @@ func (x *Subcategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subcategory.ProtoReflect.Descriptor instead.
 func (*Subcategory) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{2}
+	return file_proto_products_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Subcategory) GetId() string {
@@ -326,20 +514,24 @@ func (x *Subcategory) GetCategory() *Category {
 
 // Request message for creating a product
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SubcategoryId string                 `protobuf:"bytes,6,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in proto/products.proto.
+	Price         float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // Used only when price_cents is unset
+	StockQuantity int32   `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	UserId        string  `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SubcategoryId string  `protobuf:"bytes,6,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
+	PriceCents    int64   `protobuf:"varint,7,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency      string  `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code, e.g. "USD"
+	Sku           string  `protobuf:"bytes,9,opt,name=sku,proto3" json:"sku,omitempty"`           // Required and unique; letters and digits, optionally separated by single dashes, stored upper-case
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_proto_products_proto_msgTypes[3]
+	mi := &file_proto_products_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +543,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[3]
+	mi := &file_proto_products_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +556,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{3}
+	return file_proto_products_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateProductRequest) GetName() string {
@@ -381,6 +573,7 @@ func (x *CreateProductRequest) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/products.proto.
 func (x *CreateProductRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -409,6 +602,27 @@ func (x *CreateProductRequest) GetSubcategoryId() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
 // Response message for creating a product
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -419,7 +633,7 @@ type CreateProductResponse struct {
 
 func (x *CreateProductResponse) Reset() {
 	*x = CreateProductResponse{}
-	mi := &file_proto_products_proto_msgTypes[4]
+	mi := &file_proto_products_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +645,7 @@ func (x *CreateProductResponse) String() string {
 func (*CreateProductResponse) ProtoMessage() {}
 
 func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[4]
+	mi := &file_proto_products_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +658,7 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
 func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{4}
+	return file_proto_products_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateProductResponse) GetProduct() *Product {
@@ -456,15 +670,16 @@ func (x *CreateProductResponse) GetProduct() *Product {
 
 // Request message for getting a product by ID
 type GetProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Include an inactive product; honored only by AdminService.GetProduct
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_proto_products_proto_msgTypes[5]
+	mi := &file_proto_products_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +691,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[5]
+	mi := &file_proto_products_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +704,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{5}
+	return file_proto_products_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProductRequest) GetId() string {
@@ -499,6 +714,13 @@ func (x *GetProductRequest) GetId() string {
 	return ""
 }
 
+func (x *GetProductRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
 // Response message for getting a product
 type GetProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -509,7 +731,7 @@ type GetProductResponse struct {
 
 func (x *GetProductResponse) Reset() {
 	*x = GetProductResponse{}
-	mi := &file_proto_products_proto_msgTypes[6]
+	mi := &file_proto_products_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -521,7 +743,7 @@ func (x *GetProductResponse) String() string {
 func (*GetProductResponse) ProtoMessage() {}
 
 func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[6]
+	mi := &file_proto_products_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +756,7 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
 func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{6}
+	return file_proto_products_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetProductResponse) GetProduct() *Product {
@@ -544,34 +766,29 @@ func (x *GetProductResponse) GetProduct() *Product {
 	return nil
 }
 
-// Request message for updating a product
-type UpdateProductRequest struct {
+// Request message for getting a product by its SKU
+type GetProductBySKURequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	SubcategoryId string                 `protobuf:"bytes,6,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"` // Matched case-insensitively
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_proto_products_proto_msgTypes[7]
+func (x *GetProductBySKURequest) Reset() {
+	*x = GetProductBySKURequest{}
+	mi := &file_proto_products_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *GetProductBySKURequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*GetProductBySKURequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[7]
+func (x *GetProductBySKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,76 +799,87 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetProductBySKURequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySKURequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *GetProductBySKURequest) GetSku() string {
 	if x != nil {
-		return x.Id
+		return x.Sku
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Request message for getting several products in one call
+type GetProductsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *GetProductsByIDsRequest) Reset() {
+	*x = GetProductsByIDsRequest{}
+	mi := &file_proto_products_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x *GetProductsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateProductRequest) GetStockQuantity() int32 {
+func (*GetProductsByIDsRequest) ProtoMessage() {}
+
+func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[9]
 	if x != nil {
-		return x.StockQuantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateProductRequest) GetSubcategoryId() string {
+// Deprecated: Use GetProductsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetProductsByIDsRequest) GetIds() []string {
 	if x != nil {
-		return x.SubcategoryId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-// Response message for updating a product
-type UpdateProductResponse struct {
+// Response message for getting several products
+type GetProductsByIDsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                       // Found products, in no particular order
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // Requested IDs that are malformed or don't exist
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
-	mi := &file_proto_products_proto_msgTypes[8]
+func (x *GetProductsByIDsResponse) Reset() {
+	*x = GetProductsByIDsResponse{}
+	mi := &file_proto_products_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *GetProductsByIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*GetProductsByIDsResponse) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[8]
+func (x *GetProductsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,43 +890,2197 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetProductsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetProductsByIDsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetProductsByIDsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// Request message for pricing a quantity of a product
+type GetEffectivePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePriceRequest) Reset() {
+	*x = GetEffectivePriceRequest{}
+	mi := &file_proto_products_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePriceRequest) ProtoMessage() {}
+
+func (x *GetEffectivePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePriceRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEffectivePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Response message for pricing a quantity of a product
+type GetEffectivePriceResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UnitPriceCents   int64                  `protobuf:"varint,1,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"` // Price of the applicable tier, or the product price below every tier
+	UnitPriceDecimal string                 `protobuf:"bytes,2,opt,name=unit_price_decimal,json=unitPriceDecimal,proto3" json:"unit_price_decimal,omitempty"`
+	TotalCents       int64                  `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"` // unit_price_cents times quantity
+	TotalDecimal     string                 `protobuf:"bytes,4,opt,name=total_decimal,json=totalDecimal,proto3" json:"total_decimal,omitempty"`
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TierMinQuantity  int32                  `protobuf:"varint,6,opt,name=tier_min_quantity,json=tierMinQuantity,proto3" json:"tier_min_quantity,omitempty"` // min_quantity of the applied tier, 0 when the product price applies
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetEffectivePriceResponse) Reset() {
+	*x = GetEffectivePriceResponse{}
+	mi := &file_proto_products_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePriceResponse) ProtoMessage() {}
+
+func (x *GetEffectivePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePriceResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectivePriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEffectivePriceResponse) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *GetEffectivePriceResponse) GetUnitPriceDecimal() string {
+	if x != nil {
+		return x.UnitPriceDecimal
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceResponse) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *GetEffectivePriceResponse) GetTotalDecimal() string {
+	if x != nil {
+		return x.TotalDecimal
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceResponse) GetTierMinQuantity() int32 {
+	if x != nil {
+		return x.TierMinQuantity
+	}
+	return 0
+}
+
+// Review is a customer's rating of a product
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_proto_products_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Request message for reviewing a product, once per user
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`  // 1 to 5
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_proto_products_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Response message for reviewing a product
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_proto_products_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Request message for listing a product's reviews, newest first
+type ListReviewsByProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsByProductRequest) Reset() {
+	*x = ListReviewsByProductRequest{}
+	mi := &file_proto_products_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsByProductRequest) ProtoMessage() {}
+
+func (x *ListReviewsByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsByProductRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsByProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReviewsByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReviewsByProductRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response message for listing a product's reviews
+type ListReviewsByProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Reviews of the product, ignoring limit and offset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsByProductResponse) Reset() {
+	*x = ListReviewsByProductResponse{}
+	mi := &file_proto_products_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsByProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsByProductResponse) ProtoMessage() {}
+
+func (x *ListReviewsByProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsByProductResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsByProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListReviewsByProductResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsByProductResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for a product's average rating
+type GetProductRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRatingRequest) Reset() {
+	*x = GetProductRatingRequest{}
+	mi := &file_proto_products_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRatingRequest) ProtoMessage() {}
+
+func (x *GetProductRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetProductRatingRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Response message for a product's average rating
+type GetProductRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AverageRating float64                `protobuf:"fixed64,1,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // 0 when the product has no reviews
+	ReviewCount   int32                  `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRatingResponse) Reset() {
+	*x = GetProductRatingResponse{}
+	mi := &file_proto_products_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRatingResponse) ProtoMessage() {}
+
+func (x *GetProductRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetProductRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetProductRatingResponse) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GetProductRatingResponse) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+// Request message for updating a product
+type UpdateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in proto/products.proto.
+	Price         float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // Used only when price_cents is unset
+	StockQuantity int32   `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	SubcategoryId string  `protobuf:"bytes,6,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`
+	PriceCents    int64   `protobuf:"varint,7,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency      string  `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code, e.g. "USD"
+	Version       int32   `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`  // Product version for optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_products_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/products.proto.
+func (x *UpdateProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSubcategoryId() string {
+	if x != nil {
+		return x.SubcategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message for updating a product
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_proto_products_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request message for listing products
+type ListProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter          string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`                                            // Optional filter string (e.g., name or description)
+	MinPriceCents   int64                  `protobuf:"varint,4,opt,name=min_price_cents,json=minPriceCents,proto3" json:"min_price_cents,omitempty"`      // Optional inclusive lower bound on price_cents; 0 leaves it open
+	MaxPriceCents   int64                  `protobuf:"varint,5,opt,name=max_price_cents,json=maxPriceCents,proto3" json:"max_price_cents,omitempty"`      // Optional inclusive upper bound on price_cents; 0 leaves it open
+	SubcategoryId   string                 `protobuf:"bytes,6,opt,name=subcategory_id,json=subcategoryId,proto3" json:"subcategory_id,omitempty"`         // Optional filter by subcategory
+	CategoryId      string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                  // Optional filter by category, matching products in any of its subcategories
+	InStockOnly     bool                   `protobuf:"varint,8,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`            // Hide products with no stock
+	ActiveOnly      bool                   `protobuf:"varint,9,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`                 // Hide inactive products even when include_inactive is set
+	IncludeInactive bool                   `protobuf:"varint,10,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Include inactive products; honored only by AdminService
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_products_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMinPriceCents() int64 {
+	if x != nil {
+		return x.MinPriceCents
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetMaxPriceCents() int64 {
+	if x != nil {
+		return x.MaxPriceCents
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSubcategoryId() string {
+	if x != nil {
+		return x.SubcategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Response message for listing products
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_proto_products_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for creating a category
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Response message for creating a category
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request message for getting a category by ID
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for getting a category
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request message for listing categories
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeEmpty  bool                   `protobuf:"varint,3,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"` // Include categories that have no subcategories
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_products_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCategoriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+// Response message for listing categories
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_products_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for creating a subcategory
+type CreateSubcategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubcategoryRequest) Reset() {
+	*x = CreateSubcategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubcategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubcategoryRequest) ProtoMessage() {}
+
+func (x *CreateSubcategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubcategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubcategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateSubcategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSubcategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSubcategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+// Response message for creating a subcategory
+type CreateSubcategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subcategory   *Subcategory           `protobuf:"bytes,1,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubcategoryResponse) Reset() {
+	*x = CreateSubcategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubcategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubcategoryResponse) ProtoMessage() {}
+
+func (x *CreateSubcategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubcategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubcategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateSubcategoryResponse) GetSubcategory() *Subcategory {
+	if x != nil {
+		return x.Subcategory
+	}
+	return nil
+}
+
+// Request message for getting a subcategory by ID
+type GetSubcategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubcategoryRequest) Reset() {
+	*x = GetSubcategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubcategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubcategoryRequest) ProtoMessage() {}
+
+func (x *GetSubcategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubcategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSubcategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSubcategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for getting a subcategory
+type GetSubcategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subcategory   *Subcategory           `protobuf:"bytes,1,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubcategoryResponse) Reset() {
+	*x = GetSubcategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubcategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubcategoryResponse) ProtoMessage() {}
+
+func (x *GetSubcategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubcategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSubcategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSubcategoryResponse) GetSubcategory() *Subcategory {
+	if x != nil {
+		return x.Subcategory
+	}
+	return nil
+}
+
+// Request message for searching products
+type SearchProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Fuzzy           bool                   `protobuf:"varint,4,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`                                             // Match product names tolerating typos, ranked by closeness
+	MaxDistance     int32                  `protobuf:"varint,5,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`              // Edits tolerated per term in fuzzy mode; 0 uses the server default
+	MinPriceCents   int64                  `protobuf:"varint,6,opt,name=min_price_cents,json=minPriceCents,proto3" json:"min_price_cents,omitempty"`      // Optional inclusive lower bound on price_cents; 0 leaves it open
+	MaxPriceCents   int64                  `protobuf:"varint,7,opt,name=max_price_cents,json=maxPriceCents,proto3" json:"max_price_cents,omitempty"`      // Optional inclusive upper bound on price_cents; 0 leaves it open
+	InStockOnly     bool                   `protobuf:"varint,8,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`            // Hide products with no stock
+	ActiveOnly      bool                   `protobuf:"varint,9,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`                 // Hide inactive products even when include_inactive is set
+	IncludeInactive bool                   `protobuf:"varint,10,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Include inactive products; honored only by AdminService
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_proto_products_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetMinPriceCents() int64 {
+	if x != nil {
+		return x.MinPriceCents
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetMaxPriceCents() int64 {
+	if x != nil {
+		return x.MaxPriceCents
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Response message for searching products
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_proto_products_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for force deleting a product (Admin operation)
+type ForceDeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteProductRequest) Reset() {
+	*x = ForceDeleteProductRequest{}
+	mi := &file_proto_products_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteProductRequest) ProtoMessage() {}
+
+func (x *ForceDeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ForceDeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for force deleting a product
+type ForceDeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteProductResponse) Reset() {
+	*x = ForceDeleteProductResponse{}
+	mi := &file_proto_products_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteProductResponse) ProtoMessage() {}
+
+func (x *ForceDeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ForceDeleteProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceDeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for replacing a product's price tiers (Admin operation)
+type SetPriceTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Tiers         []*PriceTier           `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"` // Replaces every existing tier; empty clears them. unit_price_decimal is ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersRequest) Reset() {
+	*x = SetPriceTiersRequest{}
+	mi := &file_proto_products_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersRequest) ProtoMessage() {}
+
+func (x *SetPriceTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetPriceTiersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceTiersRequest) GetTiers() []*PriceTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// Response message for replacing a product's price tiers
+type SetPriceTiersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersResponse) Reset() {
+	*x = SetPriceTiersResponse{}
+	mi := &file_proto_products_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersResponse) ProtoMessage() {}
+
+func (x *SetPriceTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersResponse.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetPriceTiersResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Request message for deleting a category (Admin operation)
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"` // Also delete its subcategories, provided none of them has products
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+// Response message for deleting a category
+type DeleteCategoryResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success              bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	DeletedSubcategories int32                  `protobuf:"varint,3,opt,name=deleted_subcategories,json=deletedSubcategories,proto3" json:"deleted_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteCategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCategoryResponse) GetDeletedSubcategories() int32 {
+	if x != nil {
+		return x.DeletedSubcategories
+	}
+	return 0
+}
+
+// Request message for deleting a subcategory (Admin operation)
+type DeleteSubcategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubcategoryRequest) Reset() {
+	*x = DeleteSubcategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubcategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubcategoryRequest) ProtoMessage() {}
+
+func (x *DeleteSubcategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubcategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubcategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteSubcategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message for deleting a subcategory
+type DeleteSubcategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubcategoryResponse) Reset() {
+	*x = DeleteSubcategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubcategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubcategoryResponse) ProtoMessage() {}
+
+func (x *DeleteSubcategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubcategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubcategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteSubcategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSubcategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for moving every product of one subcategory to another (Admin operation)
+type ReassignProductsSubcategoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FromSubcategoryId string                 `protobuf:"bytes,1,opt,name=from_subcategory_id,json=fromSubcategoryId,proto3" json:"from_subcategory_id,omitempty"`
+	ToSubcategoryId   string                 `protobuf:"bytes,2,opt,name=to_subcategory_id,json=toSubcategoryId,proto3" json:"to_subcategory_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReassignProductsSubcategoryRequest) Reset() {
+	*x = ReassignProductsSubcategoryRequest{}
+	mi := &file_proto_products_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignProductsSubcategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignProductsSubcategoryRequest) ProtoMessage() {}
+
+func (x *ReassignProductsSubcategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignProductsSubcategoryRequest.ProtoReflect.Descriptor instead.
+func (*ReassignProductsSubcategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReassignProductsSubcategoryRequest) GetFromSubcategoryId() string {
+	if x != nil {
+		return x.FromSubcategoryId
+	}
+	return ""
+}
+
+func (x *ReassignProductsSubcategoryRequest) GetToSubcategoryId() string {
+	if x != nil {
+		return x.ToSubcategoryId
+	}
+	return ""
+}
+
+// Response message for reassigning products between subcategories
+type ReassignProductsSubcategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reassigned    int32                  `protobuf:"varint,1,opt,name=reassigned,proto3" json:"reassigned,omitempty"` // Number of products moved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignProductsSubcategoryResponse) Reset() {
+	*x = ReassignProductsSubcategoryResponse{}
+	mi := &file_proto_products_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignProductsSubcategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignProductsSubcategoryResponse) ProtoMessage() {}
+
+func (x *ReassignProductsSubcategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignProductsSubcategoryResponse.ProtoReflect.Descriptor instead.
+func (*ReassignProductsSubcategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReassignProductsSubcategoryResponse) GetReassigned() int32 {
+	if x != nil {
+		return x.Reassigned
+	}
+	return 0
+}
+
+// Request message for bulk creating products (Admin operation)
+type BulkCreateProductsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Products      []*CreateProductRequest `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateProductsRequest) Reset() {
+	*x = BulkCreateProductsRequest{}
+	mi := &file_proto_products_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateProductsRequest) ProtoMessage() {}
+
+func (x *BulkCreateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BulkCreateProductsRequest) GetProducts() []*CreateProductRequest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Response message for bulk creating products
+type BulkCreateProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateProductsResponse) Reset() {
+	*x = BulkCreateProductsResponse{}
+	mi := &file_proto_products_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateProductsResponse) ProtoMessage() {}
+
+func (x *BulkCreateProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateProductsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BulkCreateProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
 }
 
-func (x *UpdateProductResponse) GetProduct() *Product {
+func (x *BulkCreateProductsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Product
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-// Request message for listing products
-type ListProductsRequest struct {
+// ImportStockBySKURequest is one warehouse stock count streamed to ImportStockBySKU
+type ImportStockBySKURequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Optional filter string (e.g., name or description)
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // New stock quantity, or the change to it when delta is set
+	Delta         bool                   `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`       // Add quantity to the current stock instead of replacing it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_proto_products_proto_msgTypes[9]
+func (x *ImportStockBySKURequest) Reset() {
+	*x = ImportStockBySKURequest{}
+	mi := &file_proto_products_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ImportStockBySKURequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ImportStockBySKURequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[9]
+func (x *ImportStockBySKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,56 +3091,57 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportStockBySKURequest.ProtoReflect.Descriptor instead.
+func (*ImportStockBySKURequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListProductsRequest) GetLimit() int32 {
+func (x *ImportStockBySKURequest) GetSku() string {
 	if x != nil {
-		return x.Limit
+		return x.Sku
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetOffset() int32 {
+func (x *ImportStockBySKURequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetFilter() string {
+func (x *ImportStockBySKURequest) GetDelta() bool {
 	if x != nil {
-		return x.Filter
+		return x.Delta
 	}
-	return ""
+	return false
 }
 
-// Response message for listing products
-type ListProductsResponse struct {
+// ImportStockBySKUResponse reports the outcome of an ImportStockBySKU stream
+type ImportStockBySKUResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`                           // Counts applied to a product's stock
+	UnknownSkus   []string               `protobuf:"bytes,2,rep,name=unknown_skus,json=unknownSkus,proto3" json:"unknown_skus,omitempty"` // SKUs matching no product, in stream order
+	Failures      []*ImportStockFailure  `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`                          // Counts for known SKUs that were not applied, in stream order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_proto_products_proto_msgTypes[10]
+func (x *ImportStockBySKUResponse) Reset() {
+	*x = ImportStockBySKUResponse{}
+	mi := &file_proto_products_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *ImportStockBySKUResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*ImportStockBySKUResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[10]
+func (x *ImportStockBySKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,49 +3152,57 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportStockBySKUResponse.ProtoReflect.Descriptor instead.
+func (*ImportStockBySKUResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *ImportStockBySKUResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.Products
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportStockBySKUResponse) GetUnknownSkus() []string {
+	if x != nil {
+		return x.UnknownSkus
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetTotal() int32 {
+func (x *ImportStockBySKUResponse) GetFailures() []*ImportStockFailure {
 	if x != nil {
-		return x.Total
+		return x.Failures
 	}
-	return 0
+	return nil
 }
 
-// Request message for creating a category
-type CreateCategoryRequest struct {
+// ImportStockFailure is one count of ImportStockBySKU that could not be applied
+type ImportStockFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Zero-based position of the count in the stream
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`      // As submitted
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_proto_products_proto_msgTypes[11]
+func (x *ImportStockFailure) Reset() {
+	*x = ImportStockFailure{}
+	mi := &file_proto_products_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *ImportStockFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*ImportStockFailure) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[11]
+func (x *ImportStockFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,48 +3213,57 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ImportStockFailure.ProtoReflect.Descriptor instead.
+func (*ImportStockFailure) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *ImportStockFailure) GetIndex() int32 {
 	if x != nil {
-		return x.Name
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportStockFailure) GetSku() string {
+	if x != nil {
+		return x.Sku
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetDescription() string {
+func (x *ImportStockFailure) GetReason() string {
 	if x != nil {
-		return x.Description
+		return x.Reason
 	}
 	return ""
 }
 
-// Response message for creating a category
-type CreateCategoryResponse struct {
+// Request message for exporting products (Admin operation)
+type ExportProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
-	mi := &file_proto_products_proto_msgTypes[12]
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_proto_products_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *ExportProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
+func (*ExportProductsRequest) ProtoMessage() {}
 
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[12]
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,41 +3274,59 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreateCategoryResponse) GetCategory() *Category {
+func (x *ExportProductsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Category
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Request message for getting a category by ID
-type GetCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ExportProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// OrderCreatedEvent mirrors orders.OrderCreatedEvent so products can consume the
+// "orders.created" topic without depending on the orders module
+type OrderCreatedEvent struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	OrderId       string                   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*OrderCreatedEventItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     int64                    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryRequest) Reset() {
-	*x = GetCategoryRequest{}
-	mi := &file_proto_products_proto_msgTypes[13]
+func (x *OrderCreatedEvent) Reset() {
+	*x = OrderCreatedEvent{}
+	mi := &file_proto_products_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryRequest) String() string {
+func (x *OrderCreatedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest) ProtoMessage() {}
+func (*OrderCreatedEvent) ProtoMessage() {}
 
-func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[13]
+func (x *OrderCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,41 +3337,63 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use OrderCreatedEvent.ProtoReflect.Descriptor instead.
+func (*OrderCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetCategoryRequest) GetId() string {
+func (x *OrderCreatedEvent) GetOrderId() string {
 	if x != nil {
-		return x.Id
+		return x.OrderId
 	}
 	return ""
 }
 
-// Response message for getting a category
-type GetCategoryResponse struct {
+func (x *OrderCreatedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetItems() []*OrderCreatedEventItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderCreatedEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// OrderCreatedEventItem mirrors orders.OrderCreatedEventItem
+type OrderCreatedEventItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryResponse) Reset() {
-	*x = GetCategoryResponse{}
-	mi := &file_proto_products_proto_msgTypes[14]
+func (x *OrderCreatedEventItem) Reset() {
+	*x = OrderCreatedEventItem{}
+	mi := &file_proto_products_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryResponse) String() string {
+func (x *OrderCreatedEventItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryResponse) ProtoMessage() {}
+func (*OrderCreatedEventItem) ProtoMessage() {}
 
-func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[14]
+func (x *OrderCreatedEventItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,43 +3404,49 @@ func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use OrderCreatedEventItem.ProtoReflect.Descriptor instead.
+func (*OrderCreatedEventItem) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetCategoryResponse) GetCategory() *Category {
+func (x *OrderCreatedEventItem) GetProductId() string {
 	if x != nil {
-		return x.Category
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-// Request message for creating a subcategory
-type CreateSubcategoryRequest struct {
+func (x *OrderCreatedEventItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// StockItem is a quantity of one product
+type StockItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSubcategoryRequest) Reset() {
-	*x = CreateSubcategoryRequest{}
-	mi := &file_proto_products_proto_msgTypes[15]
+func (x *StockItem) Reset() {
+	*x = StockItem{}
+	mi := &file_proto_products_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSubcategoryRequest) String() string {
+func (x *StockItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubcategoryRequest) ProtoMessage() {}
+func (*StockItem) ProtoMessage() {}
 
-func (x *CreateSubcategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[15]
+func (x *StockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,55 +3457,101 @@ func (x *CreateSubcategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubcategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubcategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
+func (*StockItem) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateSubcategoryRequest) GetName() string {
+func (x *StockItem) GetProductId() string {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateSubcategoryRequest) GetDescription() string {
+func (x *StockItem) GetQuantity() int32 {
 	if x != nil {
-		return x.Description
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSubcategoryRequest) GetCategoryId() string {
+// Request message for returning a cancelled order's items to stock
+type IncrementStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // Each order is restocked at most once
+	Items         []*StockItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementStockRequest) Reset() {
+	*x = IncrementStockRequest{}
+	mi := &file_proto_products_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementStockRequest) ProtoMessage() {}
+
+func (x *IncrementStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[55]
 	if x != nil {
-		return x.CategoryId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementStockRequest.ProtoReflect.Descriptor instead.
+func (*IncrementStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *IncrementStockRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
 	}
 	return ""
 }
 
-// Response message for creating a subcategory
-type CreateSubcategoryResponse struct {
+func (x *IncrementStockRequest) GetItems() []*StockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Response message for returning items to stock
+type IncrementStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subcategory   *Subcategory           `protobuf:"bytes,1,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	Restocked     bool                   `protobuf:"varint,1,opt,name=restocked,proto3" json:"restocked,omitempty"` // False when the order was already restocked or its stock was never deducted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSubcategoryResponse) Reset() {
-	*x = CreateSubcategoryResponse{}
-	mi := &file_proto_products_proto_msgTypes[16]
+func (x *IncrementStockResponse) Reset() {
+	*x = IncrementStockResponse{}
+	mi := &file_proto_products_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSubcategoryResponse) String() string {
+func (x *IncrementStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubcategoryResponse) ProtoMessage() {}
+func (*IncrementStockResponse) ProtoMessage() {}
 
-func (x *CreateSubcategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[16]
+func (x *IncrementStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,41 +3562,48 @@ func (x *CreateSubcategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubcategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateSubcategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use IncrementStockResponse.ProtoReflect.Descriptor instead.
+func (*IncrementStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateSubcategoryResponse) GetSubcategory() *Subcategory {
+func (x *IncrementStockResponse) GetRestocked() bool {
 	if x != nil {
-		return x.Subcategory
+		return x.Restocked
 	}
-	return nil
+	return false
 }
 
-// Request message for getting a subcategory by ID
-type GetSubcategoryRequest struct {
+// FailedStockAdjustment is an order item whose stock could not be deducted
+type FailedStockAdjustment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                            // Why the last attempt failed
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`                       // Deduction attempts, including the original event
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Unix timestamp
+	ResolvedAt    int64                  `protobuf:"varint,8,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unix timestamp, 0 while unresolved
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubcategoryRequest) Reset() {
-	*x = GetSubcategoryRequest{}
-	mi := &file_proto_products_proto_msgTypes[17]
+func (x *FailedStockAdjustment) Reset() {
+	*x = FailedStockAdjustment{}
+	mi := &file_proto_products_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubcategoryRequest) String() string {
+func (x *FailedStockAdjustment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubcategoryRequest) ProtoMessage() {}
+func (*FailedStockAdjustment) ProtoMessage() {}
 
-func (x *GetSubcategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[17]
+func (x *FailedStockAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,88 +3614,92 @@ func (x *GetSubcategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubcategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetSubcategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use FailedStockAdjustment.ProtoReflect.Descriptor instead.
+func (*FailedStockAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetSubcategoryRequest) GetId() string {
+func (x *FailedStockAdjustment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Response message for getting a subcategory
-type GetSubcategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subcategory   *Subcategory           `protobuf:"bytes,1,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FailedStockAdjustment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (x *GetSubcategoryResponse) Reset() {
-	*x = GetSubcategoryResponse{}
-	mi := &file_proto_products_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FailedStockAdjustment) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
 }
 
-func (x *GetSubcategoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FailedStockAdjustment) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (*GetSubcategoryResponse) ProtoMessage() {}
+func (x *FailedStockAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-func (x *GetSubcategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[18]
+func (x *FailedStockAdjustment) GetAttempts() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attempts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetSubcategoryResponse.ProtoReflect.Descriptor instead.
-func (*GetSubcategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{18}
+func (x *FailedStockAdjustment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *GetSubcategoryResponse) GetSubcategory() *Subcategory {
+func (x *FailedStockAdjustment) GetResolvedAt() int64 {
 	if x != nil {
-		return x.Subcategory
+		return x.ResolvedAt
 	}
-	return nil
+	return 0
 }
 
-// Request message for searching products
-type SearchProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request message for listing failed stock adjustments (Admin operation)
+type ListFailedStockAdjustmentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeResolved bool                   `protobuf:"varint,1,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"` // Also list adjustments already reconciled
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SearchProductsRequest) Reset() {
-	*x = SearchProductsRequest{}
-	mi := &file_proto_products_proto_msgTypes[19]
+func (x *ListFailedStockAdjustmentsRequest) Reset() {
+	*x = ListFailedStockAdjustmentsRequest{}
+	mi := &file_proto_products_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsRequest) String() string {
+func (x *ListFailedStockAdjustmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsRequest) ProtoMessage() {}
+func (*ListFailedStockAdjustmentsRequest) ProtoMessage() {}
 
-func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[19]
+func (x *ListFailedStockAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,56 +3710,56 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
-func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListFailedStockAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedStockAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SearchProductsRequest) GetQuery() string {
+func (x *ListFailedStockAdjustmentsRequest) GetIncludeResolved() bool {
 	if x != nil {
-		return x.Query
+		return x.IncludeResolved
 	}
-	return ""
+	return false
 }
 
-func (x *SearchProductsRequest) GetLimit() int32 {
+func (x *ListFailedStockAdjustmentsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *SearchProductsRequest) GetOffset() int32 {
+func (x *ListFailedStockAdjustmentsRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-// Response message for searching products
-type SearchProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+// Response message for listing failed stock adjustments
+type ListFailedStockAdjustmentsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Adjustments   []*FailedStockAdjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"` // Oldest first
+	Total         int32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsResponse) Reset() {
-	*x = SearchProductsResponse{}
-	mi := &file_proto_products_proto_msgTypes[20]
+func (x *ListFailedStockAdjustmentsResponse) Reset() {
+	*x = ListFailedStockAdjustmentsResponse{}
+	mi := &file_proto_products_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsResponse) String() string {
+func (x *ListFailedStockAdjustmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsResponse) ProtoMessage() {}
+func (*ListFailedStockAdjustmentsResponse) ProtoMessage() {}
 
-func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[20]
+func (x *ListFailedStockAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,48 +3770,48 @@ func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
-func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListFailedStockAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListFailedStockAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SearchProductsResponse) GetProducts() []*Product {
+func (x *ListFailedStockAdjustmentsResponse) GetAdjustments() []*FailedStockAdjustment {
 	if x != nil {
-		return x.Products
+		return x.Adjustments
 	}
 	return nil
 }
 
-func (x *SearchProductsResponse) GetTotal() int32 {
+func (x *ListFailedStockAdjustmentsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// Request message for force deleting a product (Admin operation)
-type ForceDeleteProductRequest struct {
+// Request message for retrying a failed stock adjustment (Admin operation)
+type RetryStockAdjustmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteProductRequest) Reset() {
-	*x = ForceDeleteProductRequest{}
-	mi := &file_proto_products_proto_msgTypes[21]
+func (x *RetryStockAdjustmentRequest) Reset() {
+	*x = RetryStockAdjustmentRequest{}
+	mi := &file_proto_products_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteProductRequest) String() string {
+func (x *RetryStockAdjustmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteProductRequest) ProtoMessage() {}
+func (*RetryStockAdjustmentRequest) ProtoMessage() {}
 
-func (x *ForceDeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[21]
+func (x *RetryStockAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,42 +3822,41 @@ func (x *ForceDeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*ForceDeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use RetryStockAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*RetryStockAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ForceDeleteProductRequest) GetId() string {
+func (x *RetryStockAdjustmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Response message for force deleting a product
-type ForceDeleteProductResponse struct {
+// Response message for retrying a failed stock adjustment
+type RetryStockAdjustmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Adjustment    *FailedStockAdjustment `protobuf:"bytes,1,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteProductResponse) Reset() {
-	*x = ForceDeleteProductResponse{}
-	mi := &file_proto_products_proto_msgTypes[22]
+func (x *RetryStockAdjustmentResponse) Reset() {
+	*x = RetryStockAdjustmentResponse{}
+	mi := &file_proto_products_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteProductResponse) String() string {
+func (x *RetryStockAdjustmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteProductResponse) ProtoMessage() {}
+func (*RetryStockAdjustmentResponse) ProtoMessage() {}
 
-func (x *ForceDeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[22]
+func (x *RetryStockAdjustmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,48 +3867,41 @@ func (x *ForceDeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*ForceDeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ForceDeleteProductResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RetryStockAdjustmentResponse.ProtoReflect.Descriptor instead.
+func (*RetryStockAdjustmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ForceDeleteProductResponse) GetSuccess() bool {
+func (x *RetryStockAdjustmentResponse) GetAdjustment() *FailedStockAdjustment {
 	if x != nil {
-		return x.Success
+		return x.Adjustment
 	}
-	return false
+	return nil
 }
 
-// Request message for bulk creating products (Admin operation)
-type BulkCreateProductsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Products      []*CreateProductRequest `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+// Request message for activating a product (Admin operation)
+type ActivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateProductsRequest) Reset() {
-	*x = BulkCreateProductsRequest{}
-	mi := &file_proto_products_proto_msgTypes[23]
+func (x *ActivateProductRequest) Reset() {
+	*x = ActivateProductRequest{}
+	mi := &file_proto_products_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateProductsRequest) String() string {
+func (x *ActivateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateProductsRequest) ProtoMessage() {}
+func (*ActivateProductRequest) ProtoMessage() {}
 
-func (x *BulkCreateProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[23]
+func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,42 +3912,41 @@ func (x *BulkCreateProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateProductsRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *BulkCreateProductsRequest) GetProducts() []*CreateProductRequest {
+func (x *ActivateProductRequest) GetId() string {
 	if x != nil {
-		return x.Products
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Response message for bulk creating products
-type BulkCreateProductsResponse struct {
+// Response message for activating a product
+type ActivateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateProductsResponse) Reset() {
-	*x = BulkCreateProductsResponse{}
-	mi := &file_proto_products_proto_msgTypes[24]
+func (x *ActivateProductResponse) Reset() {
+	*x = ActivateProductResponse{}
+	mi := &file_proto_products_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateProductsResponse) String() string {
+func (x *ActivateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateProductsResponse) ProtoMessage() {}
+func (*ActivateProductResponse) ProtoMessage() {}
 
-func (x *BulkCreateProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[24]
+func (x *ActivateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,50 +3957,41 @@ func (x *BulkCreateProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateProductsResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ActivateProductResponse.ProtoReflect.Descriptor instead.
+func (*ActivateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BulkCreateProductsResponse) GetProducts() []*Product {
+func (x *ActivateProductResponse) GetProduct() *Product {
 	if x != nil {
-		return x.Products
+		return x.Product
 	}
 	return nil
 }
 
-func (x *BulkCreateProductsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Request message for exporting products (Admin operation)
-type ExportProductsRequest struct {
+// Request message for deactivating a product (Admin operation)
+type DeactivateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportProductsRequest) Reset() {
-	*x = ExportProductsRequest{}
-	mi := &file_proto_products_proto_msgTypes[25]
+func (x *DeactivateProductRequest) Reset() {
+	*x = DeactivateProductRequest{}
+	mi := &file_proto_products_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportProductsRequest) String() string {
+func (x *DeactivateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProductsRequest) ProtoMessage() {}
+func (*DeactivateProductRequest) ProtoMessage() {}
 
-func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_products_proto_msgTypes[25]
+func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,42 +4002,73 @@ func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
-func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_products_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ExportProductsRequest) GetLimit() int32 {
+func (x *DeactivateProductRequest) GetId() string {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ExportProductsRequest) GetOffset() int32 {
+// Response message for deactivating a product
+type DeactivateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductResponse) Reset() {
+	*x = DeactivateProductResponse{}
+	mi := &file_proto_products_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductResponse) ProtoMessage() {}
+
+func (x *DeactivateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_products_proto_msgTypes[65]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExportProductsRequest) GetFilter() string {
+// Deprecated: Use DeactivateProductResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_products_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeactivateProductResponse) GetProduct() *Product {
 	if x != nil {
-		return x.Filter
+		return x.Product
 	}
-	return ""
+	return nil
 }
 
 var File_proto_products_proto protoreflect.FileDescriptor
 
 const file_proto_products_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/products.proto\x12\bproducts\"\xe0\x02\n" +
+	"\x14proto/products.proto\x12\bproducts\"\x84\x05\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12\x17\n" +
 	"\auser_id\x18\x06 \x01(\tR\x06userId\x12%\n" +
 	"\x0esubcategory_id\x18\a \x01(\tR\rsubcategoryId\x12\x1d\n" +
@@ -1562,7 +4078,23 @@ const file_proto_products_proto_rawDesc = "" +
 	"updated_at\x18\t \x01(\x03R\tupdatedAt\x12\x1b\n" +
 	"\tis_active\x18\n" +
 	" \x01(\bR\bisActive\x127\n" +
-	"\vsubcategory\x18\v \x01(\v2\x15.products.SubcategoryR\vsubcategory\"\xcb\x01\n" +
+	"\vsubcategory\x18\v \x01(\v2\x15.products.SubcategoryR\vsubcategory\x12\x1f\n" +
+	"\vprice_cents\x18\f \x01(\x03R\n" +
+	"priceCents\x12#\n" +
+	"\rprice_decimal\x18\r \x01(\tR\fpriceDecimal\x12:\n" +
+	"\favailability\x18\x0e \x01(\x0e2\x16.products.AvailabilityR\favailability\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x05R\aversion\x12\x1e\n" +
+	"\n" +
+	"breadcrumb\x18\x11 \x03(\tR\n" +
+	"breadcrumb\x124\n" +
+	"\vprice_tiers\x18\x12 \x03(\v2\x13.products.PriceTierR\n" +
+	"priceTiers\x12\x10\n" +
+	"\x03sku\x18\x13 \x01(\tR\x03sku\"\x86\x01\n" +
+	"\tPriceTier\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x05R\vminQuantity\x12(\n" +
+	"\x10unit_price_cents\x18\x02 \x01(\x03R\x0eunitPriceCents\x12,\n" +
+	"\x12unit_price_decimal\x18\x03 \x01(\tR\x10unitPriceDecimal\"\xf8\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1571,7 +4103,8 @@ const file_proto_products_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12;\n" +
-	"\rsubcategories\x18\x06 \x03(\v2\x15.products.SubcategoryR\rsubcategories\"\xe2\x01\n" +
+	"\rsubcategories\x18\x06 \x03(\v2\x15.products.SubcategoryR\rsubcategories\x12+\n" +
+	"\x11subcategory_count\x18\a \x01(\x05R\x10subcategoryCount\"\xe2\x01\n" +
 	"\vSubcategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1582,33 +4115,103 @@ const file_proto_products_proto_rawDesc = "" +
 	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\tR\n" +
 	"categoryId\x12.\n" +
-	"\bcategory\x18\a \x01(\v2\x12.products.CategoryR\bcategory\"\xc9\x01\n" +
+	"\bcategory\x18\a \x01(\v2\x12.products.CategoryR\bcategory\"\x9c\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12%\n" +
 	"\x0estock_quantity\x18\x04 \x01(\x05R\rstockQuantity\x12\x17\n" +
 	"\auser_id\x18\x05 \x01(\tR\x06userId\x12%\n" +
-	"\x0esubcategory_id\x18\x06 \x01(\tR\rsubcategoryId\"D\n" +
+	"\x0esubcategory_id\x18\x06 \x01(\tR\rsubcategoryId\x12\x1f\n" +
+	"\vprice_cents\x18\a \x01(\x03R\n" +
+	"priceCents\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03sku\x18\t \x01(\tR\x03sku\"D\n" +
 	"\x15CreateProductResponse\x12+\n" +
-	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"#\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"N\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"A\n" +
 	"\x12GetProductResponse\x12+\n" +
-	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"\xc0\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"*\n" +
+	"\x16GetProductBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"+\n" +
+	"\x17GetProductsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"j\n" +
+	"\x18GetProductsByIDsResponse\x12-\n" +
+	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"U\n" +
+	"\x18GetEffectivePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x81\x02\n" +
+	"\x19GetEffectivePriceResponse\x12(\n" +
+	"\x10unit_price_cents\x18\x01 \x01(\x03R\x0eunitPriceCents\x12,\n" +
+	"\x12unit_price_decimal\x18\x02 \x01(\tR\x10unitPriceDecimal\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\x12#\n" +
+	"\rtotal_decimal\x18\x04 \x01(\tR\ftotalDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12*\n" +
+	"\x11tier_min_quantity\x18\x06 \x01(\x05R\x0ftierMinQuantity\"\xa1\x01\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\x7f\n" +
+	"\x13CreateReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"@\n" +
+	"\x14CreateReviewResponse\x12(\n" +
+	"\x06review\x18\x01 \x01(\v2\x10.products.ReviewR\x06review\"j\n" +
+	"\x1bListReviewsByProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"`\n" +
+	"\x1cListReviewsByProductResponse\x12*\n" +
+	"\areviews\x18\x01 \x03(\v2\x10.products.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"8\n" +
+	"\x17GetProductRatingRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"d\n" +
+	"\x18GetProductRatingResponse\x12%\n" +
+	"\x0eaverage_rating\x18\x01 \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x02 \x01(\x05R\vreviewCount\"\x9b\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12%\n" +
-	"\x0esubcategory_id\x18\x06 \x01(\tR\rsubcategoryId\"D\n" +
+	"\x0esubcategory_id\x18\x06 \x01(\tR\rsubcategoryId\x12\x1f\n" +
+	"\vprice_cents\x18\a \x01(\x03R\n" +
+	"priceCents\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\"D\n" +
 	"\x15UpdateProductResponse\x12+\n" +
-	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"[\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"\xe3\x02\n" +
 	"\x13ListProductsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"[\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12&\n" +
+	"\x0fmin_price_cents\x18\x04 \x01(\x03R\rminPriceCents\x12&\n" +
+	"\x0fmax_price_cents\x18\x05 \x01(\x03R\rmaxPriceCents\x12%\n" +
+	"\x0esubcategory_id\x18\x06 \x01(\tR\rsubcategoryId\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\tR\n" +
+	"categoryId\x12\"\n" +
+	"\rin_stock_only\x18\b \x01(\bR\vinStockOnly\x12\x1f\n" +
+	"\vactive_only\x18\t \x01(\bR\n" +
+	"activeOnly\x12)\n" +
+	"\x10include_inactive\x18\n" +
+	" \x01(\bR\x0fincludeInactive\"[\n" +
 	"\x14ListProductsResponse\x12-\n" +
 	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
@@ -1620,7 +4223,16 @@ const file_proto_products_proto_rawDesc = "" +
 	"\x12GetCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
 	"\x13GetCategoryResponse\x12.\n" +
-	"\bcategory\x18\x01 \x01(\v2\x12.products.CategoryR\bcategory\"q\n" +
+	"\bcategory\x18\x01 \x01(\v2\x12.products.CategoryR\bcategory\"j\n" +
+	"\x15ListCategoriesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12#\n" +
+	"\rinclude_empty\x18\x03 \x01(\bR\fincludeEmpty\"b\n" +
+	"\x16ListCategoriesResponse\x122\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x12.products.CategoryR\n" +
+	"categories\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"q\n" +
 	"\x18CreateSubcategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
@@ -1631,11 +4243,20 @@ const file_proto_products_proto_rawDesc = "" +
 	"\x15GetSubcategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
 	"\x16GetSubcategoryResponse\x127\n" +
-	"\vsubcategory\x18\x01 \x01(\v2\x15.products.SubcategoryR\vsubcategory\"[\n" +
+	"\vsubcategory\x18\x01 \x01(\v2\x15.products.SubcategoryR\vsubcategory\"\xd4\x02\n" +
 	"\x15SearchProductsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"]\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05fuzzy\x18\x04 \x01(\bR\x05fuzzy\x12!\n" +
+	"\fmax_distance\x18\x05 \x01(\x05R\vmaxDistance\x12&\n" +
+	"\x0fmin_price_cents\x18\x06 \x01(\x03R\rminPriceCents\x12&\n" +
+	"\x0fmax_price_cents\x18\a \x01(\x03R\rmaxPriceCents\x12\"\n" +
+	"\rin_stock_only\x18\b \x01(\bR\vinStockOnly\x12\x1f\n" +
+	"\vactive_only\x18\t \x01(\bR\n" +
+	"activeOnly\x12)\n" +
+	"\x10include_inactive\x18\n" +
+	" \x01(\bR\x0fincludeInactive\"]\n" +
 	"\x16SearchProductsResponse\x12-\n" +
 	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"+\n" +
@@ -1643,31 +4264,146 @@ const file_proto_products_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
 	"\x1aForceDeleteProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"W\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"`\n" +
+	"\x14SetPriceTiersRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x05tiers\x18\x02 \x03(\v2\x13.products.PriceTierR\x05tiers\"D\n" +
+	"\x15SetPriceTiersResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"A\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"w\n" +
+	"\x16DeleteCategoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x123\n" +
+	"\x15deleted_subcategories\x18\x03 \x01(\x05R\x14deletedSubcategories\"*\n" +
+	"\x18DeleteSubcategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x19DeleteSubcategoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\"ReassignProductsSubcategoryRequest\x12.\n" +
+	"\x13from_subcategory_id\x18\x01 \x01(\tR\x11fromSubcategoryId\x12*\n" +
+	"\x11to_subcategory_id\x18\x02 \x01(\tR\x0ftoSubcategoryId\"E\n" +
+	"#ReassignProductsSubcategoryResponse\x12\x1e\n" +
+	"\n" +
+	"reassigned\x18\x01 \x01(\x05R\n" +
+	"reassigned\"W\n" +
 	"\x19BulkCreateProductsRequest\x12:\n" +
 	"\bproducts\x18\x01 \x03(\v2\x1e.products.CreateProductRequestR\bproducts\"a\n" +
 	"\x1aBulkCreateProductsResponse\x12-\n" +
 	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"]\n" +
+	"\x17ImportStockBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\bR\x05delta\"\x91\x01\n" +
+	"\x18ImportStockBySKUResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12!\n" +
+	"\funknown_skus\x18\x02 \x03(\tR\vunknownSkus\x128\n" +
+	"\bfailures\x18\x03 \x03(\v2\x1c.products.ImportStockFailureR\bfailures\"T\n" +
+	"\x12ImportStockFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"]\n" +
 	"\x15ExportProductsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter2\x87\x06\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9d\x01\n" +
+	"\x11OrderCreatedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x125\n" +
+	"\x05items\x18\x03 \x03(\v2\x1f.products.OrderCreatedEventItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"R\n" +
+	"\x15OrderCreatedEventItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"F\n" +
+	"\tStockItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"]\n" +
+	"\x15IncrementStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.products.StockItemR\x05items\"6\n" +
+	"\x16IncrementStockResponse\x12\x1c\n" +
+	"\trestocked\x18\x01 \x01(\bR\trestocked\"\xf1\x01\n" +
+	"\x15FailedStockAdjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\b \x01(\x03R\n" +
+	"resolvedAt\"|\n" +
+	"!ListFailedStockAdjustmentsRequest\x12)\n" +
+	"\x10include_resolved\x18\x01 \x01(\bR\x0fincludeResolved\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"}\n" +
+	"\"ListFailedStockAdjustmentsResponse\x12A\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x1f.products.FailedStockAdjustmentR\vadjustments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x1bRetryStockAdjustmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x1cRetryStockAdjustmentResponse\x12?\n" +
+	"\n" +
+	"adjustment\x18\x01 \x01(\v2\x1f.products.FailedStockAdjustmentR\n" +
+	"adjustment\"(\n" +
+	"\x16ActivateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x17ActivateProductResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\"*\n" +
+	"\x18DeactivateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x19DeactivateProductResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct*[\n" +
+	"\fAvailability\x12\x1c\n" +
+	"\x18AVAILABILITY_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bIN_STOCK\x10\x01\x12\r\n" +
+	"\tLOW_STOCK\x10\x02\x12\x10\n" +
+	"\fOUT_OF_STOCK\x10\x032\xde\v\n" +
 	"\x0eProductService\x12R\n" +
 	"\rCreateProduct\x12\x1e.products.CreateProductRequest\x1a\x1f.products.CreateProductResponse\"\x00\x12I\n" +
 	"\n" +
-	"GetProduct\x12\x1b.products.GetProductRequest\x1a\x1c.products.GetProductResponse\"\x00\x12R\n" +
+	"GetProduct\x12\x1b.products.GetProductRequest\x1a\x1c.products.GetProductResponse\"\x00\x12S\n" +
+	"\x0fGetProductBySKU\x12 .products.GetProductBySKURequest\x1a\x1c.products.GetProductResponse\"\x00\x12[\n" +
+	"\x10GetProductsByIDs\x12!.products.GetProductsByIDsRequest\x1a\".products.GetProductsByIDsResponse\"\x00\x12R\n" +
 	"\rUpdateProduct\x12\x1e.products.UpdateProductRequest\x1a\x1f.products.UpdateProductResponse\"\x00\x12O\n" +
 	"\fListProducts\x12\x1d.products.ListProductsRequest\x1a\x1e.products.ListProductsResponse\"\x00\x12U\n" +
 	"\x0eSearchProducts\x12\x1f.products.SearchProductsRequest\x1a .products.SearchProductsResponse\"\x00\x12U\n" +
+	"\x0eIncrementStock\x12\x1f.products.IncrementStockRequest\x1a .products.IncrementStockResponse\"\x00\x12^\n" +
+	"\x11GetEffectivePrice\x12\".products.GetEffectivePriceRequest\x1a#.products.GetEffectivePriceResponse\"\x00\x12O\n" +
+	"\fCreateReview\x12\x1d.products.CreateReviewRequest\x1a\x1e.products.CreateReviewResponse\"\x00\x12g\n" +
+	"\x14ListReviewsByProduct\x12%.products.ListReviewsByProductRequest\x1a&.products.ListReviewsByProductResponse\"\x00\x12[\n" +
+	"\x10GetProductRating\x12!.products.GetProductRatingRequest\x1a\".products.GetProductRatingResponse\"\x00\x12U\n" +
 	"\x0eCreateCategory\x12\x1f.products.CreateCategoryRequest\x1a .products.CreateCategoryResponse\"\x00\x12L\n" +
-	"\vGetCategory\x12\x1c.products.GetCategoryRequest\x1a\x1d.products.GetCategoryResponse\"\x00\x12^\n" +
+	"\vGetCategory\x12\x1c.products.GetCategoryRequest\x1a\x1d.products.GetCategoryResponse\"\x00\x12U\n" +
+	"\x0eListCategories\x12\x1f.products.ListCategoriesRequest\x1a .products.ListCategoriesResponse\"\x00\x12^\n" +
 	"\x11CreateSubcategory\x12\".products.CreateSubcategoryRequest\x1a#.products.CreateSubcategoryResponse\"\x00\x12U\n" +
-	"\x0eGetSubcategory\x12\x1f.products.GetSubcategoryRequest\x1a .products.GetSubcategoryResponse\"\x002\x9b\x02\n" +
+	"\x0eGetSubcategory\x12\x1f.products.GetSubcategoryRequest\x1a .products.GetSubcategoryResponse\"\x002\x94\v\n" +
 	"\fAdminService\x12a\n" +
-	"\x12ForceDeleteProduct\x12#.products.ForceDeleteProductRequest\x1a$.products.ForceDeleteProductResponse\"\x00\x12^\n" +
-	"\x12BulkCreateProducts\x12\x1e.products.CreateProductRequest\x1a$.products.BulkCreateProductsResponse\"\x00(\x01\x12H\n" +
-	"\x0eExportProducts\x12\x1f.products.ExportProductsRequest\x1a\x11.products.Product\"\x000\x01B\x12Z\x10./proto;productsb\x06proto3"
+	"\x12ForceDeleteProduct\x12#.products.ForceDeleteProductRequest\x1a$.products.ForceDeleteProductResponse\"\x00\x12U\n" +
+	"\x0eDeleteCategory\x12\x1f.products.DeleteCategoryRequest\x1a .products.DeleteCategoryResponse\"\x00\x12^\n" +
+	"\x11DeleteSubcategory\x12\".products.DeleteSubcategoryRequest\x1a#.products.DeleteSubcategoryResponse\"\x00\x12|\n" +
+	"\x1bReassignProductsSubcategory\x12,.products.ReassignProductsSubcategoryRequest\x1a-.products.ReassignProductsSubcategoryResponse\"\x00\x12^\n" +
+	"\x12BulkCreateProducts\x12\x1e.products.CreateProductRequest\x1a$.products.BulkCreateProductsResponse\"\x00(\x01\x12]\n" +
+	"\x10ImportStockBySKU\x12!.products.ImportStockBySKURequest\x1a\".products.ImportStockBySKUResponse\"\x00(\x01\x12H\n" +
+	"\x0eExportProducts\x12\x1f.products.ExportProductsRequest\x1a\x11.products.Product\"\x000\x01\x12y\n" +
+	"\x1aListFailedStockAdjustments\x12+.products.ListFailedStockAdjustmentsRequest\x1a,.products.ListFailedStockAdjustmentsResponse\"\x00\x12g\n" +
+	"\x14RetryStockAdjustment\x12%.products.RetryStockAdjustmentRequest\x1a&.products.RetryStockAdjustmentResponse\"\x00\x12X\n" +
+	"\x0fActivateProduct\x12 .products.ActivateProductRequest\x1a!.products.ActivateProductResponse\"\x00\x12^\n" +
+	"\x11DeactivateProduct\x12\".products.DeactivateProductRequest\x1a#.products.DeactivateProductResponse\"\x00\x12R\n" +
+	"\rSetPriceTiers\x12\x1e.products.SetPriceTiersRequest\x1a\x1f.products.SetPriceTiersResponse\"\x00\x12I\n" +
+	"\n" +
+	"GetProduct\x12\x1b.products.GetProductRequest\x1a\x1c.products.GetProductResponse\"\x00\x12O\n" +
+	"\fListProducts\x12\x1d.products.ListProductsRequest\x1a\x1e.products.ListProductsResponse\"\x00\x12U\n" +
+	"\x0eSearchProducts\x12\x1f.products.SearchProductsRequest\x1a .products.SearchProductsResponse\"\x00B\x12Z\x10./proto;productsb\x06proto3"
 
 var (
 	file_proto_products_proto_rawDescOnce sync.Once
@@ -1681,79 +4417,176 @@ func file_proto_products_proto_rawDescGZIP() []byte {
 	return file_proto_products_proto_rawDescData
 }
 
-var file_proto_products_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_proto_products_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_products_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_proto_products_proto_goTypes = []any{
-	(*Product)(nil),                    // 0: products.Product
-	(*Category)(nil),                   // 1: products.Category
-	(*Subcategory)(nil),                // 2: products.Subcategory
-	(*CreateProductRequest)(nil),       // 3: products.CreateProductRequest
-	(*CreateProductResponse)(nil),      // 4: products.CreateProductResponse
-	(*GetProductRequest)(nil),          // 5: products.GetProductRequest
-	(*GetProductResponse)(nil),         // 6: products.GetProductResponse
-	(*UpdateProductRequest)(nil),       // 7: products.UpdateProductRequest
-	(*UpdateProductResponse)(nil),      // 8: products.UpdateProductResponse
-	(*ListProductsRequest)(nil),        // 9: products.ListProductsRequest
-	(*ListProductsResponse)(nil),       // 10: products.ListProductsResponse
-	(*CreateCategoryRequest)(nil),      // 11: products.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),     // 12: products.CreateCategoryResponse
-	(*GetCategoryRequest)(nil),         // 13: products.GetCategoryRequest
-	(*GetCategoryResponse)(nil),        // 14: products.GetCategoryResponse
-	(*CreateSubcategoryRequest)(nil),   // 15: products.CreateSubcategoryRequest
-	(*CreateSubcategoryResponse)(nil),  // 16: products.CreateSubcategoryResponse
-	(*GetSubcategoryRequest)(nil),      // 17: products.GetSubcategoryRequest
-	(*GetSubcategoryResponse)(nil),     // 18: products.GetSubcategoryResponse
-	(*SearchProductsRequest)(nil),      // 19: products.SearchProductsRequest
-	(*SearchProductsResponse)(nil),     // 20: products.SearchProductsResponse
-	(*ForceDeleteProductRequest)(nil),  // 21: products.ForceDeleteProductRequest
-	(*ForceDeleteProductResponse)(nil), // 22: products.ForceDeleteProductResponse
-	(*BulkCreateProductsRequest)(nil),  // 23: products.BulkCreateProductsRequest
-	(*BulkCreateProductsResponse)(nil), // 24: products.BulkCreateProductsResponse
-	(*ExportProductsRequest)(nil),      // 25: products.ExportProductsRequest
+	(Availability)(0),                           // 0: products.Availability
+	(*Product)(nil),                             // 1: products.Product
+	(*PriceTier)(nil),                           // 2: products.PriceTier
+	(*Category)(nil),                            // 3: products.Category
+	(*Subcategory)(nil),                         // 4: products.Subcategory
+	(*CreateProductRequest)(nil),                // 5: products.CreateProductRequest
+	(*CreateProductResponse)(nil),               // 6: products.CreateProductResponse
+	(*GetProductRequest)(nil),                   // 7: products.GetProductRequest
+	(*GetProductResponse)(nil),                  // 8: products.GetProductResponse
+	(*GetProductBySKURequest)(nil),              // 9: products.GetProductBySKURequest
+	(*GetProductsByIDsRequest)(nil),             // 10: products.GetProductsByIDsRequest
+	(*GetProductsByIDsResponse)(nil),            // 11: products.GetProductsByIDsResponse
+	(*GetEffectivePriceRequest)(nil),            // 12: products.GetEffectivePriceRequest
+	(*GetEffectivePriceResponse)(nil),           // 13: products.GetEffectivePriceResponse
+	(*Review)(nil),                              // 14: products.Review
+	(*CreateReviewRequest)(nil),                 // 15: products.CreateReviewRequest
+	(*CreateReviewResponse)(nil),                // 16: products.CreateReviewResponse
+	(*ListReviewsByProductRequest)(nil),         // 17: products.ListReviewsByProductRequest
+	(*ListReviewsByProductResponse)(nil),        // 18: products.ListReviewsByProductResponse
+	(*GetProductRatingRequest)(nil),             // 19: products.GetProductRatingRequest
+	(*GetProductRatingResponse)(nil),            // 20: products.GetProductRatingResponse
+	(*UpdateProductRequest)(nil),                // 21: products.UpdateProductRequest
+	(*UpdateProductResponse)(nil),               // 22: products.UpdateProductResponse
+	(*ListProductsRequest)(nil),                 // 23: products.ListProductsRequest
+	(*ListProductsResponse)(nil),                // 24: products.ListProductsResponse
+	(*CreateCategoryRequest)(nil),               // 25: products.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),              // 26: products.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),                  // 27: products.GetCategoryRequest
+	(*GetCategoryResponse)(nil),                 // 28: products.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),               // 29: products.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),              // 30: products.ListCategoriesResponse
+	(*CreateSubcategoryRequest)(nil),            // 31: products.CreateSubcategoryRequest
+	(*CreateSubcategoryResponse)(nil),           // 32: products.CreateSubcategoryResponse
+	(*GetSubcategoryRequest)(nil),               // 33: products.GetSubcategoryRequest
+	(*GetSubcategoryResponse)(nil),              // 34: products.GetSubcategoryResponse
+	(*SearchProductsRequest)(nil),               // 35: products.SearchProductsRequest
+	(*SearchProductsResponse)(nil),              // 36: products.SearchProductsResponse
+	(*ForceDeleteProductRequest)(nil),           // 37: products.ForceDeleteProductRequest
+	(*ForceDeleteProductResponse)(nil),          // 38: products.ForceDeleteProductResponse
+	(*SetPriceTiersRequest)(nil),                // 39: products.SetPriceTiersRequest
+	(*SetPriceTiersResponse)(nil),               // 40: products.SetPriceTiersResponse
+	(*DeleteCategoryRequest)(nil),               // 41: products.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),              // 42: products.DeleteCategoryResponse
+	(*DeleteSubcategoryRequest)(nil),            // 43: products.DeleteSubcategoryRequest
+	(*DeleteSubcategoryResponse)(nil),           // 44: products.DeleteSubcategoryResponse
+	(*ReassignProductsSubcategoryRequest)(nil),  // 45: products.ReassignProductsSubcategoryRequest
+	(*ReassignProductsSubcategoryResponse)(nil), // 46: products.ReassignProductsSubcategoryResponse
+	(*BulkCreateProductsRequest)(nil),           // 47: products.BulkCreateProductsRequest
+	(*BulkCreateProductsResponse)(nil),          // 48: products.BulkCreateProductsResponse
+	(*ImportStockBySKURequest)(nil),             // 49: products.ImportStockBySKURequest
+	(*ImportStockBySKUResponse)(nil),            // 50: products.ImportStockBySKUResponse
+	(*ImportStockFailure)(nil),                  // 51: products.ImportStockFailure
+	(*ExportProductsRequest)(nil),               // 52: products.ExportProductsRequest
+	(*OrderCreatedEvent)(nil),                   // 53: products.OrderCreatedEvent
+	(*OrderCreatedEventItem)(nil),               // 54: products.OrderCreatedEventItem
+	(*StockItem)(nil),                           // 55: products.StockItem
+	(*IncrementStockRequest)(nil),               // 56: products.IncrementStockRequest
+	(*IncrementStockResponse)(nil),              // 57: products.IncrementStockResponse
+	(*FailedStockAdjustment)(nil),               // 58: products.FailedStockAdjustment
+	(*ListFailedStockAdjustmentsRequest)(nil),   // 59: products.ListFailedStockAdjustmentsRequest
+	(*ListFailedStockAdjustmentsResponse)(nil),  // 60: products.ListFailedStockAdjustmentsResponse
+	(*RetryStockAdjustmentRequest)(nil),         // 61: products.RetryStockAdjustmentRequest
+	(*RetryStockAdjustmentResponse)(nil),        // 62: products.RetryStockAdjustmentResponse
+	(*ActivateProductRequest)(nil),              // 63: products.ActivateProductRequest
+	(*ActivateProductResponse)(nil),             // 64: products.ActivateProductResponse
+	(*DeactivateProductRequest)(nil),            // 65: products.DeactivateProductRequest
+	(*DeactivateProductResponse)(nil),           // 66: products.DeactivateProductResponse
 }
 var file_proto_products_proto_depIdxs = []int32{
-	2,  // 0: products.Product.subcategory:type_name -> products.Subcategory
-	2,  // 1: products.Category.subcategories:type_name -> products.Subcategory
-	1,  // 2: products.Subcategory.category:type_name -> products.Category
-	0,  // 3: products.CreateProductResponse.product:type_name -> products.Product
-	0,  // 4: products.GetProductResponse.product:type_name -> products.Product
-	0,  // 5: products.UpdateProductResponse.product:type_name -> products.Product
-	0,  // 6: products.ListProductsResponse.products:type_name -> products.Product
-	1,  // 7: products.CreateCategoryResponse.category:type_name -> products.Category
-	1,  // 8: products.GetCategoryResponse.category:type_name -> products.Category
-	2,  // 9: products.CreateSubcategoryResponse.subcategory:type_name -> products.Subcategory
-	2,  // 10: products.GetSubcategoryResponse.subcategory:type_name -> products.Subcategory
-	0,  // 11: products.SearchProductsResponse.products:type_name -> products.Product
-	3,  // 12: products.BulkCreateProductsRequest.products:type_name -> products.CreateProductRequest
-	0,  // 13: products.BulkCreateProductsResponse.products:type_name -> products.Product
-	3,  // 14: products.ProductService.CreateProduct:input_type -> products.CreateProductRequest
-	5,  // 15: products.ProductService.GetProduct:input_type -> products.GetProductRequest
-	7,  // 16: products.ProductService.UpdateProduct:input_type -> products.UpdateProductRequest
-	9,  // 17: products.ProductService.ListProducts:input_type -> products.ListProductsRequest
-	19, // 18: products.ProductService.SearchProducts:input_type -> products.SearchProductsRequest
-	11, // 19: products.ProductService.CreateCategory:input_type -> products.CreateCategoryRequest
-	13, // 20: products.ProductService.GetCategory:input_type -> products.GetCategoryRequest
-	15, // 21: products.ProductService.CreateSubcategory:input_type -> products.CreateSubcategoryRequest
-	17, // 22: products.ProductService.GetSubcategory:input_type -> products.GetSubcategoryRequest
-	21, // 23: products.AdminService.ForceDeleteProduct:input_type -> products.ForceDeleteProductRequest
-	3,  // 24: products.AdminService.BulkCreateProducts:input_type -> products.CreateProductRequest
-	25, // 25: products.AdminService.ExportProducts:input_type -> products.ExportProductsRequest
-	4,  // 26: products.ProductService.CreateProduct:output_type -> products.CreateProductResponse
-	6,  // 27: products.ProductService.GetProduct:output_type -> products.GetProductResponse
-	8,  // 28: products.ProductService.UpdateProduct:output_type -> products.UpdateProductResponse
-	10, // 29: products.ProductService.ListProducts:output_type -> products.ListProductsResponse
-	20, // 30: products.ProductService.SearchProducts:output_type -> products.SearchProductsResponse
-	12, // 31: products.ProductService.CreateCategory:output_type -> products.CreateCategoryResponse
-	14, // 32: products.ProductService.GetCategory:output_type -> products.GetCategoryResponse
-	16, // 33: products.ProductService.CreateSubcategory:output_type -> products.CreateSubcategoryResponse
-	18, // 34: products.ProductService.GetSubcategory:output_type -> products.GetSubcategoryResponse
-	22, // 35: products.AdminService.ForceDeleteProduct:output_type -> products.ForceDeleteProductResponse
-	24, // 36: products.AdminService.BulkCreateProducts:output_type -> products.BulkCreateProductsResponse
-	0,  // 37: products.AdminService.ExportProducts:output_type -> products.Product
-	26, // [26:38] is the sub-list for method output_type
-	14, // [14:26] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,  // 0: products.Product.subcategory:type_name -> products.Subcategory
+	0,  // 1: products.Product.availability:type_name -> products.Availability
+	2,  // 2: products.Product.price_tiers:type_name -> products.PriceTier
+	4,  // 3: products.Category.subcategories:type_name -> products.Subcategory
+	3,  // 4: products.Subcategory.category:type_name -> products.Category
+	1,  // 5: products.CreateProductResponse.product:type_name -> products.Product
+	1,  // 6: products.GetProductResponse.product:type_name -> products.Product
+	1,  // 7: products.GetProductsByIDsResponse.products:type_name -> products.Product
+	14, // 8: products.CreateReviewResponse.review:type_name -> products.Review
+	14, // 9: products.ListReviewsByProductResponse.reviews:type_name -> products.Review
+	1,  // 10: products.UpdateProductResponse.product:type_name -> products.Product
+	1,  // 11: products.ListProductsResponse.products:type_name -> products.Product
+	3,  // 12: products.CreateCategoryResponse.category:type_name -> products.Category
+	3,  // 13: products.GetCategoryResponse.category:type_name -> products.Category
+	3,  // 14: products.ListCategoriesResponse.categories:type_name -> products.Category
+	4,  // 15: products.CreateSubcategoryResponse.subcategory:type_name -> products.Subcategory
+	4,  // 16: products.GetSubcategoryResponse.subcategory:type_name -> products.Subcategory
+	1,  // 17: products.SearchProductsResponse.products:type_name -> products.Product
+	2,  // 18: products.SetPriceTiersRequest.tiers:type_name -> products.PriceTier
+	1,  // 19: products.SetPriceTiersResponse.product:type_name -> products.Product
+	5,  // 20: products.BulkCreateProductsRequest.products:type_name -> products.CreateProductRequest
+	1,  // 21: products.BulkCreateProductsResponse.products:type_name -> products.Product
+	51, // 22: products.ImportStockBySKUResponse.failures:type_name -> products.ImportStockFailure
+	54, // 23: products.OrderCreatedEvent.items:type_name -> products.OrderCreatedEventItem
+	55, // 24: products.IncrementStockRequest.items:type_name -> products.StockItem
+	58, // 25: products.ListFailedStockAdjustmentsResponse.adjustments:type_name -> products.FailedStockAdjustment
+	58, // 26: products.RetryStockAdjustmentResponse.adjustment:type_name -> products.FailedStockAdjustment
+	1,  // 27: products.ActivateProductResponse.product:type_name -> products.Product
+	1,  // 28: products.DeactivateProductResponse.product:type_name -> products.Product
+	5,  // 29: products.ProductService.CreateProduct:input_type -> products.CreateProductRequest
+	7,  // 30: products.ProductService.GetProduct:input_type -> products.GetProductRequest
+	9,  // 31: products.ProductService.GetProductBySKU:input_type -> products.GetProductBySKURequest
+	10, // 32: products.ProductService.GetProductsByIDs:input_type -> products.GetProductsByIDsRequest
+	21, // 33: products.ProductService.UpdateProduct:input_type -> products.UpdateProductRequest
+	23, // 34: products.ProductService.ListProducts:input_type -> products.ListProductsRequest
+	35, // 35: products.ProductService.SearchProducts:input_type -> products.SearchProductsRequest
+	56, // 36: products.ProductService.IncrementStock:input_type -> products.IncrementStockRequest
+	12, // 37: products.ProductService.GetEffectivePrice:input_type -> products.GetEffectivePriceRequest
+	15, // 38: products.ProductService.CreateReview:input_type -> products.CreateReviewRequest
+	17, // 39: products.ProductService.ListReviewsByProduct:input_type -> products.ListReviewsByProductRequest
+	19, // 40: products.ProductService.GetProductRating:input_type -> products.GetProductRatingRequest
+	25, // 41: products.ProductService.CreateCategory:input_type -> products.CreateCategoryRequest
+	27, // 42: products.ProductService.GetCategory:input_type -> products.GetCategoryRequest
+	29, // 43: products.ProductService.ListCategories:input_type -> products.ListCategoriesRequest
+	31, // 44: products.ProductService.CreateSubcategory:input_type -> products.CreateSubcategoryRequest
+	33, // 45: products.ProductService.GetSubcategory:input_type -> products.GetSubcategoryRequest
+	37, // 46: products.AdminService.ForceDeleteProduct:input_type -> products.ForceDeleteProductRequest
+	41, // 47: products.AdminService.DeleteCategory:input_type -> products.DeleteCategoryRequest
+	43, // 48: products.AdminService.DeleteSubcategory:input_type -> products.DeleteSubcategoryRequest
+	45, // 49: products.AdminService.ReassignProductsSubcategory:input_type -> products.ReassignProductsSubcategoryRequest
+	5,  // 50: products.AdminService.BulkCreateProducts:input_type -> products.CreateProductRequest
+	49, // 51: products.AdminService.ImportStockBySKU:input_type -> products.ImportStockBySKURequest
+	52, // 52: products.AdminService.ExportProducts:input_type -> products.ExportProductsRequest
+	59, // 53: products.AdminService.ListFailedStockAdjustments:input_type -> products.ListFailedStockAdjustmentsRequest
+	61, // 54: products.AdminService.RetryStockAdjustment:input_type -> products.RetryStockAdjustmentRequest
+	63, // 55: products.AdminService.ActivateProduct:input_type -> products.ActivateProductRequest
+	65, // 56: products.AdminService.DeactivateProduct:input_type -> products.DeactivateProductRequest
+	39, // 57: products.AdminService.SetPriceTiers:input_type -> products.SetPriceTiersRequest
+	7,  // 58: products.AdminService.GetProduct:input_type -> products.GetProductRequest
+	23, // 59: products.AdminService.ListProducts:input_type -> products.ListProductsRequest
+	35, // 60: products.AdminService.SearchProducts:input_type -> products.SearchProductsRequest
+	6,  // 61: products.ProductService.CreateProduct:output_type -> products.CreateProductResponse
+	8,  // 62: products.ProductService.GetProduct:output_type -> products.GetProductResponse
+	8,  // 63: products.ProductService.GetProductBySKU:output_type -> products.GetProductResponse
+	11, // 64: products.ProductService.GetProductsByIDs:output_type -> products.GetProductsByIDsResponse
+	22, // 65: products.ProductService.UpdateProduct:output_type -> products.UpdateProductResponse
+	24, // 66: products.ProductService.ListProducts:output_type -> products.ListProductsResponse
+	36, // 67: products.ProductService.SearchProducts:output_type -> products.SearchProductsResponse
+	57, // 68: products.ProductService.IncrementStock:output_type -> products.IncrementStockResponse
+	13, // 69: products.ProductService.GetEffectivePrice:output_type -> products.GetEffectivePriceResponse
+	16, // 70: products.ProductService.CreateReview:output_type -> products.CreateReviewResponse
+	18, // 71: products.ProductService.ListReviewsByProduct:output_type -> products.ListReviewsByProductResponse
+	20, // 72: products.ProductService.GetProductRating:output_type -> products.GetProductRatingResponse
+	26, // 73: products.ProductService.CreateCategory:output_type -> products.CreateCategoryResponse
+	28, // 74: products.ProductService.GetCategory:output_type -> products.GetCategoryResponse
+	30, // 75: products.ProductService.ListCategories:output_type -> products.ListCategoriesResponse
+	32, // 76: products.ProductService.CreateSubcategory:output_type -> products.CreateSubcategoryResponse
+	34, // 77: products.ProductService.GetSubcategory:output_type -> products.GetSubcategoryResponse
+	38, // 78: products.AdminService.ForceDeleteProduct:output_type -> products.ForceDeleteProductResponse
+	42, // 79: products.AdminService.DeleteCategory:output_type -> products.DeleteCategoryResponse
+	44, // 80: products.AdminService.DeleteSubcategory:output_type -> products.DeleteSubcategoryResponse
+	46, // 81: products.AdminService.ReassignProductsSubcategory:output_type -> products.ReassignProductsSubcategoryResponse
+	48, // 82: products.AdminService.BulkCreateProducts:output_type -> products.BulkCreateProductsResponse
+	50, // 83: products.AdminService.ImportStockBySKU:output_type -> products.ImportStockBySKUResponse
+	1,  // 84: products.AdminService.ExportProducts:output_type -> products.Product
+	60, // 85: products.AdminService.ListFailedStockAdjustments:output_type -> products.ListFailedStockAdjustmentsResponse
+	62, // 86: products.AdminService.RetryStockAdjustment:output_type -> products.RetryStockAdjustmentResponse
+	64, // 87: products.AdminService.ActivateProduct:output_type -> products.ActivateProductResponse
+	66, // 88: products.AdminService.DeactivateProduct:output_type -> products.DeactivateProductResponse
+	40, // 89: products.AdminService.SetPriceTiers:output_type -> products.SetPriceTiersResponse
+	8,  // 90: products.AdminService.GetProduct:output_type -> products.GetProductResponse
+	24, // 91: products.AdminService.ListProducts:output_type -> products.ListProductsResponse
+	36, // 92: products.AdminService.SearchProducts:output_type -> products.SearchProductsResponse
+	61, // [61:93] is the sub-list for method output_type
+	29, // [29:61] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_proto_products_proto_init() }
@@ -1766,13 +4599,14 @@ func file_proto_products_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_products_proto_rawDesc), len(file_proto_products_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   26,
+			NumEnums:      1,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_proto_products_proto_goTypes,
 		DependencyIndexes: file_proto_products_proto_depIdxs,
+		EnumInfos:         file_proto_products_proto_enumTypes,
 		MessageInfos:      file_proto_products_proto_msgTypes,
 	}.Build()
 	File_proto_products_proto = out.File
@@ -31,12 +31,21 @@ type ProductService interface {
 	// Product CRUD operations
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...client.CallOption) (*CreateProductResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...client.CallOption) (*GetProductResponse, error)
+	GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, opts ...client.CallOption) (*GetProductResponse, error)
+	GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...client.CallOption) (*GetProductsByIDsResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...client.CallOption) (*UpdateProductResponse, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error)
 	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...client.CallOption) (*SearchProductsResponse, error)
+	IncrementStock(ctx context.Context, in *IncrementStockRequest, opts ...client.CallOption) (*IncrementStockResponse, error)
+	GetEffectivePrice(ctx context.Context, in *GetEffectivePriceRequest, opts ...client.CallOption) (*GetEffectivePriceResponse, error)
+	// Reviews
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...client.CallOption) (*CreateReviewResponse, error)
+	ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest, opts ...client.CallOption) (*ListReviewsByProductResponse, error)
+	GetProductRating(ctx context.Context, in *GetProductRatingRequest, opts ...client.CallOption) (*GetProductRatingResponse, error)
 	// Category CRUD operations
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...client.CallOption) (*CreateCategoryResponse, error)
 	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...client.CallOption) (*GetCategoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...client.CallOption) (*ListCategoriesResponse, error)
 	// Subcategory CRUD operations
 	CreateSubcategory(ctx context.Context, in *CreateSubcategoryRequest, opts ...client.CallOption) (*CreateSubcategoryResponse, error)
 	GetSubcategory(ctx context.Context, in *GetSubcategoryRequest, opts ...client.CallOption) (*GetSubcategoryResponse, error)
@@ -74,6 +83,26 @@ func (c *productService) GetProduct(ctx context.Context, in *GetProductRequest,
 	return out, nil
 }
 
+func (c *productService) GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, opts ...client.CallOption) (*GetProductResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.GetProductBySKU", in)
+	out := new(GetProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...client.CallOption) (*GetProductsByIDsResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.GetProductsByIDs", in)
+	out := new(GetProductsByIDsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productService) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...client.CallOption) (*UpdateProductResponse, error) {
 	req := c.c.NewRequest(c.name, "ProductService.UpdateProduct", in)
 	out := new(UpdateProductResponse)
@@ -104,6 +133,56 @@ func (c *productService) SearchProducts(ctx context.Context, in *SearchProductsR
 	return out, nil
 }
 
+func (c *productService) IncrementStock(ctx context.Context, in *IncrementStockRequest, opts ...client.CallOption) (*IncrementStockResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.IncrementStock", in)
+	out := new(IncrementStockResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetEffectivePrice(ctx context.Context, in *GetEffectivePriceRequest, opts ...client.CallOption) (*GetEffectivePriceResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.GetEffectivePrice", in)
+	out := new(GetEffectivePriceResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...client.CallOption) (*CreateReviewResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.CreateReview", in)
+	out := new(CreateReviewResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest, opts ...client.CallOption) (*ListReviewsByProductResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.ListReviewsByProduct", in)
+	out := new(ListReviewsByProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetProductRating(ctx context.Context, in *GetProductRatingRequest, opts ...client.CallOption) (*GetProductRatingResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.GetProductRating", in)
+	out := new(GetProductRatingResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productService) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...client.CallOption) (*CreateCategoryResponse, error) {
 	req := c.c.NewRequest(c.name, "ProductService.CreateCategory", in)
 	out := new(CreateCategoryResponse)
@@ -124,6 +203,16 @@ func (c *productService) GetCategory(ctx context.Context, in *GetCategoryRequest
 	return out, nil
 }
 
+func (c *productService) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...client.CallOption) (*ListCategoriesResponse, error) {
+	req := c.c.NewRequest(c.name, "ProductService.ListCategories", in)
+	out := new(ListCategoriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productService) CreateSubcategory(ctx context.Context, in *CreateSubcategoryRequest, opts ...client.CallOption) (*CreateSubcategoryResponse, error) {
 	req := c.c.NewRequest(c.name, "ProductService.CreateSubcategory", in)
 	out := new(CreateSubcategoryResponse)
@@ -150,12 +239,21 @@ type ProductServiceHandler interface {
 	// Product CRUD operations
 	CreateProduct(context.Context, *CreateProductRequest, *CreateProductResponse) error
 	GetProduct(context.Context, *GetProductRequest, *GetProductResponse) error
+	GetProductBySKU(context.Context, *GetProductBySKURequest, *GetProductResponse) error
+	GetProductsByIDs(context.Context, *GetProductsByIDsRequest, *GetProductsByIDsResponse) error
 	UpdateProduct(context.Context, *UpdateProductRequest, *UpdateProductResponse) error
 	ListProducts(context.Context, *ListProductsRequest, *ListProductsResponse) error
 	SearchProducts(context.Context, *SearchProductsRequest, *SearchProductsResponse) error
+	IncrementStock(context.Context, *IncrementStockRequest, *IncrementStockResponse) error
+	GetEffectivePrice(context.Context, *GetEffectivePriceRequest, *GetEffectivePriceResponse) error
+	// Reviews
+	CreateReview(context.Context, *CreateReviewRequest, *CreateReviewResponse) error
+	ListReviewsByProduct(context.Context, *ListReviewsByProductRequest, *ListReviewsByProductResponse) error
+	GetProductRating(context.Context, *GetProductRatingRequest, *GetProductRatingResponse) error
 	// Category CRUD operations
 	CreateCategory(context.Context, *CreateCategoryRequest, *CreateCategoryResponse) error
 	GetCategory(context.Context, *GetCategoryRequest, *GetCategoryResponse) error
+	ListCategories(context.Context, *ListCategoriesRequest, *ListCategoriesResponse) error
 	// Subcategory CRUD operations
 	CreateSubcategory(context.Context, *CreateSubcategoryRequest, *CreateSubcategoryResponse) error
 	GetSubcategory(context.Context, *GetSubcategoryRequest, *GetSubcategoryResponse) error
@@ -165,11 +263,19 @@ func RegisterProductServiceHandler(s server.Server, hdlr ProductServiceHandler,
 	type productService interface {
 		CreateProduct(ctx context.Context, in *CreateProductRequest, out *CreateProductResponse) error
 		GetProduct(ctx context.Context, in *GetProductRequest, out *GetProductResponse) error
+		GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, out *GetProductResponse) error
+		GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, out *GetProductsByIDsResponse) error
 		UpdateProduct(ctx context.Context, in *UpdateProductRequest, out *UpdateProductResponse) error
 		ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error
 		SearchProducts(ctx context.Context, in *SearchProductsRequest, out *SearchProductsResponse) error
+		IncrementStock(ctx context.Context, in *IncrementStockRequest, out *IncrementStockResponse) error
+		GetEffectivePrice(ctx context.Context, in *GetEffectivePriceRequest, out *GetEffectivePriceResponse) error
+		CreateReview(ctx context.Context, in *CreateReviewRequest, out *CreateReviewResponse) error
+		ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest, out *ListReviewsByProductResponse) error
+		GetProductRating(ctx context.Context, in *GetProductRatingRequest, out *GetProductRatingResponse) error
 		CreateCategory(ctx context.Context, in *CreateCategoryRequest, out *CreateCategoryResponse) error
 		GetCategory(ctx context.Context, in *GetCategoryRequest, out *GetCategoryResponse) error
+		ListCategories(ctx context.Context, in *ListCategoriesRequest, out *ListCategoriesResponse) error
 		CreateSubcategory(ctx context.Context, in *CreateSubcategoryRequest, out *CreateSubcategoryResponse) error
 		GetSubcategory(ctx context.Context, in *GetSubcategoryRequest, out *GetSubcategoryResponse) error
 	}
@@ -192,6 +298,14 @@ func (h *productServiceHandler) GetProduct(ctx context.Context, in *GetProductRe
 	return h.ProductServiceHandler.GetProduct(ctx, in, out)
 }
 
+func (h *productServiceHandler) GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, out *GetProductResponse) error {
+	return h.ProductServiceHandler.GetProductBySKU(ctx, in, out)
+}
+
+func (h *productServiceHandler) GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, out *GetProductsByIDsResponse) error {
+	return h.ProductServiceHandler.GetProductsByIDs(ctx, in, out)
+}
+
 func (h *productServiceHandler) UpdateProduct(ctx context.Context, in *UpdateProductRequest, out *UpdateProductResponse) error {
 	return h.ProductServiceHandler.UpdateProduct(ctx, in, out)
 }
@@ -204,6 +318,26 @@ func (h *productServiceHandler) SearchProducts(ctx context.Context, in *SearchPr
 	return h.ProductServiceHandler.SearchProducts(ctx, in, out)
 }
 
+func (h *productServiceHandler) IncrementStock(ctx context.Context, in *IncrementStockRequest, out *IncrementStockResponse) error {
+	return h.ProductServiceHandler.IncrementStock(ctx, in, out)
+}
+
+func (h *productServiceHandler) GetEffectivePrice(ctx context.Context, in *GetEffectivePriceRequest, out *GetEffectivePriceResponse) error {
+	return h.ProductServiceHandler.GetEffectivePrice(ctx, in, out)
+}
+
+func (h *productServiceHandler) CreateReview(ctx context.Context, in *CreateReviewRequest, out *CreateReviewResponse) error {
+	return h.ProductServiceHandler.CreateReview(ctx, in, out)
+}
+
+func (h *productServiceHandler) ListReviewsByProduct(ctx context.Context, in *ListReviewsByProductRequest, out *ListReviewsByProductResponse) error {
+	return h.ProductServiceHandler.ListReviewsByProduct(ctx, in, out)
+}
+
+func (h *productServiceHandler) GetProductRating(ctx context.Context, in *GetProductRatingRequest, out *GetProductRatingResponse) error {
+	return h.ProductServiceHandler.GetProductRating(ctx, in, out)
+}
+
 func (h *productServiceHandler) CreateCategory(ctx context.Context, in *CreateCategoryRequest, out *CreateCategoryResponse) error {
 	return h.ProductServiceHandler.CreateCategory(ctx, in, out)
 }
@@ -212,6 +346,10 @@ func (h *productServiceHandler) GetCategory(ctx context.Context, in *GetCategory
 	return h.ProductServiceHandler.GetCategory(ctx, in, out)
 }
 
+func (h *productServiceHandler) ListCategories(ctx context.Context, in *ListCategoriesRequest, out *ListCategoriesResponse) error {
+	return h.ProductServiceHandler.ListCategories(ctx, in, out)
+}
+
 func (h *productServiceHandler) CreateSubcategory(ctx context.Context, in *CreateSubcategoryRequest, out *CreateSubcategoryResponse) error {
 	return h.ProductServiceHandler.CreateSubcategory(ctx, in, out)
 }
@@ -224,8 +362,20 @@ func (h *productServiceHandler) GetSubcategory(ctx context.Context, in *GetSubca
 
 type AdminService interface {
 	ForceDeleteProduct(ctx context.Context, in *ForceDeleteProductRequest, opts ...client.CallOption) (*ForceDeleteProductResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...client.CallOption) (*DeleteCategoryResponse, error)
+	DeleteSubcategory(ctx context.Context, in *DeleteSubcategoryRequest, opts ...client.CallOption) (*DeleteSubcategoryResponse, error)
+	ReassignProductsSubcategory(ctx context.Context, in *ReassignProductsSubcategoryRequest, opts ...client.CallOption) (*ReassignProductsSubcategoryResponse, error)
 	BulkCreateProducts(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateProductsService, error)
+	ImportStockBySKU(ctx context.Context, opts ...client.CallOption) (AdminService_ImportStockBySKUService, error)
 	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...client.CallOption) (AdminService_ExportProductsService, error)
+	ListFailedStockAdjustments(ctx context.Context, in *ListFailedStockAdjustmentsRequest, opts ...client.CallOption) (*ListFailedStockAdjustmentsResponse, error)
+	RetryStockAdjustment(ctx context.Context, in *RetryStockAdjustmentRequest, opts ...client.CallOption) (*RetryStockAdjustmentResponse, error)
+	ActivateProduct(ctx context.Context, in *ActivateProductRequest, opts ...client.CallOption) (*ActivateProductResponse, error)
+	DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...client.CallOption) (*DeactivateProductResponse, error)
+	SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...client.CallOption) (*SetPriceTiersResponse, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...client.CallOption) (*GetProductResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...client.CallOption) (*SearchProductsResponse, error)
 }
 
 type adminService struct {
@@ -250,6 +400,36 @@ func (c *adminService) ForceDeleteProduct(ctx context.Context, in *ForceDeletePr
 	return out, nil
 }
 
+func (c *adminService) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...client.CallOption) (*DeleteCategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.DeleteCategory", in)
+	out := new(DeleteCategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) DeleteSubcategory(ctx context.Context, in *DeleteSubcategoryRequest, opts ...client.CallOption) (*DeleteSubcategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.DeleteSubcategory", in)
+	out := new(DeleteSubcategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ReassignProductsSubcategory(ctx context.Context, in *ReassignProductsSubcategoryRequest, opts ...client.CallOption) (*ReassignProductsSubcategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ReassignProductsSubcategory", in)
+	out := new(ReassignProductsSubcategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminService) BulkCreateProducts(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateProductsService, error) {
 	req := c.c.NewRequest(c.name, "AdminService.BulkCreateProducts", &CreateProductRequest{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -296,6 +476,52 @@ func (x *adminServiceBulkCreateProducts) Send(m *CreateProductRequest) error {
 	return x.stream.Send(m)
 }
 
+func (c *adminService) ImportStockBySKU(ctx context.Context, opts ...client.CallOption) (AdminService_ImportStockBySKUService, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ImportStockBySKU", &ImportStockBySKURequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &adminServiceImportStockBySKU{stream}, nil
+}
+
+type AdminService_ImportStockBySKUService interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	CloseSend() error
+	Close() error
+	Send(*ImportStockBySKURequest) error
+}
+
+type adminServiceImportStockBySKU struct {
+	stream client.Stream
+}
+
+func (x *adminServiceImportStockBySKU) CloseSend() error {
+	return x.stream.CloseSend()
+}
+
+func (x *adminServiceImportStockBySKU) Close() error {
+	return x.stream.Close()
+}
+
+func (x *adminServiceImportStockBySKU) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *adminServiceImportStockBySKU) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *adminServiceImportStockBySKU) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *adminServiceImportStockBySKU) Send(m *ImportStockBySKURequest) error {
+	return x.stream.Send(m)
+}
+
 func (c *adminService) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...client.CallOption) (AdminService_ExportProductsService, error) {
 	req := c.c.NewRequest(c.name, "AdminService.ExportProducts", &ExportProductsRequest{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -350,19 +576,123 @@ func (x *adminServiceExportProducts) Recv() (*Product, error) {
 	return m, nil
 }
 
+func (c *adminService) ListFailedStockAdjustments(ctx context.Context, in *ListFailedStockAdjustmentsRequest, opts ...client.CallOption) (*ListFailedStockAdjustmentsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ListFailedStockAdjustments", in)
+	out := new(ListFailedStockAdjustmentsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) RetryStockAdjustment(ctx context.Context, in *RetryStockAdjustmentRequest, opts ...client.CallOption) (*RetryStockAdjustmentResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.RetryStockAdjustment", in)
+	out := new(RetryStockAdjustmentResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ActivateProduct(ctx context.Context, in *ActivateProductRequest, opts ...client.CallOption) (*ActivateProductResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ActivateProduct", in)
+	out := new(ActivateProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...client.CallOption) (*DeactivateProductResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.DeactivateProduct", in)
+	out := new(DeactivateProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...client.CallOption) (*SetPriceTiersResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.SetPriceTiers", in)
+	out := new(SetPriceTiersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GetProduct(ctx context.Context, in *GetProductRequest, opts ...client.CallOption) (*GetProductResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetProduct", in)
+	out := new(GetProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.ListProducts", in)
+	out := new(ListProductsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...client.CallOption) (*SearchProductsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.SearchProducts", in)
+	out := new(SearchProductsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceHandler interface {
 	ForceDeleteProduct(context.Context, *ForceDeleteProductRequest, *ForceDeleteProductResponse) error
+	DeleteCategory(context.Context, *DeleteCategoryRequest, *DeleteCategoryResponse) error
+	DeleteSubcategory(context.Context, *DeleteSubcategoryRequest, *DeleteSubcategoryResponse) error
+	ReassignProductsSubcategory(context.Context, *ReassignProductsSubcategoryRequest, *ReassignProductsSubcategoryResponse) error
 	BulkCreateProducts(context.Context, AdminService_BulkCreateProductsStream) error
+	ImportStockBySKU(context.Context, AdminService_ImportStockBySKUStream) error
 	ExportProducts(context.Context, *ExportProductsRequest, AdminService_ExportProductsStream) error
+	ListFailedStockAdjustments(context.Context, *ListFailedStockAdjustmentsRequest, *ListFailedStockAdjustmentsResponse) error
+	RetryStockAdjustment(context.Context, *RetryStockAdjustmentRequest, *RetryStockAdjustmentResponse) error
+	ActivateProduct(context.Context, *ActivateProductRequest, *ActivateProductResponse) error
+	DeactivateProduct(context.Context, *DeactivateProductRequest, *DeactivateProductResponse) error
+	SetPriceTiers(context.Context, *SetPriceTiersRequest, *SetPriceTiersResponse) error
+	GetProduct(context.Context, *GetProductRequest, *GetProductResponse) error
+	ListProducts(context.Context, *ListProductsRequest, *ListProductsResponse) error
+	SearchProducts(context.Context, *SearchProductsRequest, *SearchProductsResponse) error
 }
 
 func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts ...server.HandlerOption) error {
 	type adminService interface {
 		ForceDeleteProduct(ctx context.Context, in *ForceDeleteProductRequest, out *ForceDeleteProductResponse) error
+		DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, out *DeleteCategoryResponse) error
+		DeleteSubcategory(ctx context.Context, in *DeleteSubcategoryRequest, out *DeleteSubcategoryResponse) error
+		ReassignProductsSubcategory(ctx context.Context, in *ReassignProductsSubcategoryRequest, out *ReassignProductsSubcategoryResponse) error
 		BulkCreateProducts(ctx context.Context, stream server.Stream) error
+		ImportStockBySKU(ctx context.Context, stream server.Stream) error
 		ExportProducts(ctx context.Context, stream server.Stream) error
+		ListFailedStockAdjustments(ctx context.Context, in *ListFailedStockAdjustmentsRequest, out *ListFailedStockAdjustmentsResponse) error
+		RetryStockAdjustment(ctx context.Context, in *RetryStockAdjustmentRequest, out *RetryStockAdjustmentResponse) error
+		ActivateProduct(ctx context.Context, in *ActivateProductRequest, out *ActivateProductResponse) error
+		DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, out *DeactivateProductResponse) error
+		SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, out *SetPriceTiersResponse) error
+		GetProduct(ctx context.Context, in *GetProductRequest, out *GetProductResponse) error
+		ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error
+		SearchProducts(ctx context.Context, in *SearchProductsRequest, out *SearchProductsResponse) error
 	}
 	type AdminService struct {
 		adminService
@@ -379,6 +709,18 @@ func (h *adminServiceHandler) ForceDeleteProduct(ctx context.Context, in *ForceD
 	return h.AdminServiceHandler.ForceDeleteProduct(ctx, in, out)
 }
 
+func (h *adminServiceHandler) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, out *DeleteCategoryResponse) error {
+	return h.AdminServiceHandler.DeleteCategory(ctx, in, out)
+}
+
+func (h *adminServiceHandler) DeleteSubcategory(ctx context.Context, in *DeleteSubcategoryRequest, out *DeleteSubcategoryResponse) error {
+	return h.AdminServiceHandler.DeleteSubcategory(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ReassignProductsSubcategory(ctx context.Context, in *ReassignProductsSubcategoryRequest, out *ReassignProductsSubcategoryResponse) error {
+	return h.AdminServiceHandler.ReassignProductsSubcategory(ctx, in, out)
+}
+
 func (h *adminServiceHandler) BulkCreateProducts(ctx context.Context, stream server.Stream) error {
 	return h.AdminServiceHandler.BulkCreateProducts(ctx, &adminServiceBulkCreateProductsStream{stream})
 }
@@ -419,6 +761,46 @@ func (x *adminServiceBulkCreateProductsStream) Recv() (*CreateProductRequest, er
 	return m, nil
 }
 
+func (h *adminServiceHandler) ImportStockBySKU(ctx context.Context, stream server.Stream) error {
+	return h.AdminServiceHandler.ImportStockBySKU(ctx, &adminServiceImportStockBySKUStream{stream})
+}
+
+type AdminService_ImportStockBySKUStream interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*ImportStockBySKURequest, error)
+}
+
+type adminServiceImportStockBySKUStream struct {
+	stream server.Stream
+}
+
+func (x *adminServiceImportStockBySKUStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *adminServiceImportStockBySKUStream) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *adminServiceImportStockBySKUStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *adminServiceImportStockBySKUStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *adminServiceImportStockBySKUStream) Recv() (*ImportStockBySKURequest, error) {
+	m := new(ImportStockBySKURequest)
+	if err := x.stream.Recv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (h *adminServiceHandler) ExportProducts(ctx context.Context, stream server.Stream) error {
 	m := new(ExportProductsRequest)
 	if err := stream.Recv(m); err != nil {
@@ -458,3 +840,35 @@ func (x *adminServiceExportProductsStream) RecvMsg(m interface{}) error {
 func (x *adminServiceExportProductsStream) Send(m *Product) error {
 	return x.stream.Send(m)
 }
+
+func (h *adminServiceHandler) ListFailedStockAdjustments(ctx context.Context, in *ListFailedStockAdjustmentsRequest, out *ListFailedStockAdjustmentsResponse) error {
+	return h.AdminServiceHandler.ListFailedStockAdjustments(ctx, in, out)
+}
+
+func (h *adminServiceHandler) RetryStockAdjustment(ctx context.Context, in *RetryStockAdjustmentRequest, out *RetryStockAdjustmentResponse) error {
+	return h.AdminServiceHandler.RetryStockAdjustment(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ActivateProduct(ctx context.Context, in *ActivateProductRequest, out *ActivateProductResponse) error {
+	return h.AdminServiceHandler.ActivateProduct(ctx, in, out)
+}
+
+func (h *adminServiceHandler) DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, out *DeactivateProductResponse) error {
+	return h.AdminServiceHandler.DeactivateProduct(ctx, in, out)
+}
+
+func (h *adminServiceHandler) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, out *SetPriceTiersResponse) error {
+	return h.AdminServiceHandler.SetPriceTiers(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GetProduct(ctx context.Context, in *GetProductRequest, out *GetProductResponse) error {
+	return h.AdminServiceHandler.GetProduct(ctx, in, out)
+}
+
+func (h *adminServiceHandler) ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error {
+	return h.AdminServiceHandler.ListProducts(ctx, in, out)
+}
+
+func (h *adminServiceHandler) SearchProducts(ctx context.Context, in *SearchProductsRequest, out *SearchProductsResponse) error {
+	return h.AdminServiceHandler.SearchProducts(ctx, in, out)
+}
@@ -0,0 +1,131 @@
+// Package seed loads deterministic sample data for tests and local development.
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"products/ent"
+)
+
+// FixtureSet names a predefined collection of fixtures
+type FixtureSet string
+
+const (
+	// Minimal loads a single record of each kind, enough to exercise relationships
+	Minimal FixtureSet = "minimal"
+	// Demo loads the full sample catalog for local development
+	Demo FixtureSet = "demo"
+)
+
+// OwnerID is the seeded user (alice in the users seed) who owns every seeded product
+var OwnerID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// CategoryFixture describes a seeded category
+type CategoryFixture struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// SubCategoryFixture describes a seeded subcategory of Categories[Category]
+type SubCategoryFixture struct {
+	ID       uuid.UUID
+	Name     string
+	Category int
+}
+
+// ProductFixture describes a seeded product in SubCategories[SubCategory]
+type ProductFixture struct {
+	ID            uuid.UUID
+	Name          string
+	SKU           string
+	Description   string
+	PriceCents    int64
+	StockQuantity int
+	SubCategory   int
+}
+
+// Categories are the seeded categories
+var Categories = []CategoryFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0001-000000000001"), Name: "Electronics"},
+	{ID: uuid.MustParse("00000000-0000-0000-0001-000000000002"), Name: "Books"},
+}
+
+// SubCategories are the seeded subcategories
+var SubCategories = []SubCategoryFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0002-000000000001"), Name: "Laptops", Category: 0},
+	{ID: uuid.MustParse("00000000-0000-0000-0002-000000000002"), Name: "Phones", Category: 0},
+	{ID: uuid.MustParse("00000000-0000-0000-0002-000000000003"), Name: "Fiction", Category: 1},
+}
+
+// Products are the seeded products; their IDs are shared with the orders and carts seeds
+var Products = []ProductFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0003-000000000001"), Name: "Ultrabook 13", SKU: "LAP-UB13", Description: "Lightweight 13-inch laptop", PriceCents: 99999, StockQuantity: 25, SubCategory: 0},
+	{ID: uuid.MustParse("00000000-0000-0000-0003-000000000002"), Name: "Smartphone X", SKU: "PHN-SPX", Description: "6.1-inch smartphone", PriceCents: 59950, StockQuantity: 100, SubCategory: 1},
+	{ID: uuid.MustParse("00000000-0000-0000-0003-000000000003"), Name: "The Long Voyage", SKU: "BK-TLV-PB", Description: "Paperback novel", PriceCents: 1225, StockQuantity: 0, SubCategory: 2},
+}
+
+// Load inserts the given fixture set into an empty database in a single transaction
+func Load(ctx context.Context, client *ent.Client, set FixtureSet) error {
+	nCategories, err := set.limit(len(Categories))
+	if err != nil {
+		return err
+	}
+	nSubCategories, _ := set.limit(len(SubCategories))
+	nProducts, _ := set.limit(len(Products))
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, f := range Categories[:nCategories] {
+		if _, err := tx.Category.Create().SetID(f.ID).SetName(f.Name).Save(ctx); err != nil {
+			return fmt.Errorf("failed to seed category %s: %w", f.Name, err)
+		}
+	}
+	for _, f := range SubCategories[:nSubCategories] {
+		_, err := tx.SubCategory.Create().
+			SetID(f.ID).
+			SetName(f.Name).
+			SetCategoryID(Categories[f.Category].ID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed subcategory %s: %w", f.Name, err)
+		}
+	}
+	for _, f := range Products[:nProducts] {
+		_, err := tx.Product.Create().
+			SetID(f.ID).
+			SetName(f.Name).
+			SetSku(f.SKU).
+			SetDescription(f.Description).
+			SetPriceCents(f.PriceCents).
+			SetStockQuantity(f.StockQuantity).
+			SetUserID(OwnerID).
+			SetSubcategoryID(SubCategories[f.SubCategory].ID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed product %s: %w", f.Name, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seed data: %w", err)
+	}
+	return nil
+}
+
+// limit returns how many of n fixtures the set includes
+func (s FixtureSet) limit(n int) (int, error) {
+	switch s {
+	case Minimal:
+		return min(1, n), nil
+	case Demo:
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown fixture set: %q", s)
+}
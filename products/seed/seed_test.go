@@ -0,0 +1,100 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"products/ent"
+	"products/ent/enttest"
+	"products/ent/product"
+)
+
+// load opens a fresh in-memory database and loads set into it
+func load(t *testing.T, set FixtureSet) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := Load(context.Background(), client, set); err != nil {
+		t.Fatalf("Load(%s): %v", set, err)
+	}
+	return client
+}
+
+// snapshot describes every seeded row, ignoring timestamps
+func snapshot(client *ent.Client) []string {
+	ctx := context.Background()
+	var rows []string
+	for _, p := range client.Product.Query().WithSubcategory().Order(ent.Asc(product.FieldID)).AllX(ctx) {
+		rows = append(rows, fmt.Sprintf("product %s %s %s %d %d %s", p.ID, p.Name, *p.Sku, p.PriceCents, p.StockQuantity, p.Edges.Subcategory.ID))
+	}
+	for _, sc := range client.SubCategory.Query().WithCategory().AllX(ctx) {
+		rows = append(rows, fmt.Sprintf("subcategory %s %s %s", sc.ID, sc.Name, sc.Edges.Category.ID))
+	}
+	for _, c := range client.Category.Query().AllX(ctx) {
+		rows = append(rows, fmt.Sprintf("category %s %s", c.ID, c.Name))
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+func TestLoadSeedsCatalog(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		set                                 FixtureSet
+		categories, subcategories, products int
+	}{
+		{Minimal, 1, 1, 1},
+		{Demo, len(Categories), len(SubCategories), len(Products)},
+	}
+	for _, tt := range tests {
+		client := load(t, tt.set)
+		if n := client.Category.Query().CountX(ctx); n != tt.categories {
+			t.Errorf("%s: expected %d categories, got %d", tt.set, tt.categories, n)
+		}
+		if n := client.SubCategory.Query().CountX(ctx); n != tt.subcategories {
+			t.Errorf("%s: expected %d subcategories, got %d", tt.set, tt.subcategories, n)
+		}
+		if n := client.Product.Query().CountX(ctx); n != tt.products {
+			t.Errorf("%s: expected %d products, got %d", tt.set, tt.products, n)
+		}
+
+		// Every product sits in its fixture's subcategory and category, owned by the seeded user
+		for _, p := range client.Product.Query().WithSubcategory(func(q *ent.SubCategoryQuery) { q.WithCategory() }).AllX(ctx) {
+			i := slices.IndexFunc(Products, func(f ProductFixture) bool { return f.ID == p.ID })
+			if i < 0 {
+				t.Fatalf("%s: unexpected product %s", tt.set, p.ID)
+			}
+			sc := SubCategories[Products[i].SubCategory]
+			if p.Edges.Subcategory.ID != sc.ID || p.Edges.Subcategory.Edges.Category.ID != Categories[sc.Category].ID {
+				t.Errorf("%s: product %s in the wrong subcategory or category", tt.set, p.Name)
+			}
+			if p.UserID != OwnerID {
+				t.Errorf("%s: product %s owned by %s, want %s", tt.set, p.Name, p.UserID, OwnerID)
+			}
+		}
+	}
+}
+
+func TestLoadIsDeterministic(t *testing.T) {
+	first, second := snapshot(load(t, Demo)), snapshot(load(t, Demo))
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected identical data from two loads, got\n%v\n%v", first, second)
+	}
+
+	// Loading into a seeded database fails as a whole rather than duplicating
+	client := load(t, Minimal)
+	if err := Load(context.Background(), client, Demo); err == nil {
+		t.Fatal("expected loading into a seeded database to fail")
+	}
+	if n := client.Product.Query().CountX(context.Background()); n != 1 {
+		t.Fatalf("expected the failed load rolled back, found %d products", n)
+	}
+	if err := Load(context.Background(), client, "huge"); err == nil {
+		t.Fatal("expected an unknown fixture set to be rejected")
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"go-micro.dev/v5/logger"
+)
+
+// envInt reads a non-negative integer from the environment, falling back to def
+func envInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		logger.Warnf("Invalid integer %q for %s, using default %d", v, key, def)
+		return def
+	}
+	return n
+}
+
+// envDuration reads a duration (e.g. "720h") from the environment, falling back to def
+func envDuration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		logger.Warnf("Invalid duration %q for %s, using default %s", v, key, def)
+		return def
+	}
+	return d
+}
+
+// serviceMetadata is advertised with the service in the registry. Region, set in
+// multi-region deployments, tells the instances of each region apart.
+func serviceMetadata(region string) map[string]string {
+	md := map[string]string{
+		"StartTime": time.Now().String(),
+	}
+	if region != "" {
+		md["Region"] = region
+	}
+	return md
+}
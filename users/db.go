@@ -0,0 +1,46 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"           // Import for PostgreSQL driver
+	_ "github.com/mattn/go-sqlite3" // Import for SQLite driver
+
+	"users/ent"
+)
+
+// defaultDSN keeps all data in a shared in-memory SQLite database, lost on restart
+const defaultDSN = "file:ent?mode=memory&cache=shared&_fk=1"
+
+// openDB opens the database named by DB_DRIVER (sqlite3 or postgres) and DB_DSN,
+// defaulting to in-memory SQLite. The *sql.DB is returned for health checks.
+func openDB() (*ent.Client, *sql.DB, string, error) {
+	driver := os.Getenv("DB_DRIVER")
+	if driver == "" {
+		driver = dialect.SQLite
+	}
+	dsn := os.Getenv("DB_DSN")
+
+	switch driver {
+	case dialect.SQLite:
+		if dsn == "" {
+			dsn = defaultDSN
+		}
+	case dialect.Postgres:
+		if dsn == "" {
+			return nil, nil, driver, fmt.Errorf("DB_DSN is required when DB_DRIVER is %s", driver)
+		}
+	default:
+		return nil, nil, driver, fmt.Errorf("unsupported DB_DRIVER %q, use %s or %s", driver, dialect.SQLite, dialect.Postgres)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, nil, driver, err
+	}
+	return ent.NewClient(ent.Driver(entsql.OpenDB(driver, db))), db, driver, nil
+}
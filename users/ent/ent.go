@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"users/ent/notificationpreferences"
 	"users/ent/profile"
+	"users/ent/refreshtoken"
 	"users/ent/user"
 
 	"entgo.io/ent"
@@ -74,8 +76,10 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			profile.Table: profile.ValidColumn,
-			user.Table:    user.ValidColumn,
+			notificationpreferences.Table: notificationpreferences.ValidColumn,
+			profile.Table:                 profile.ValidColumn,
+			refreshtoken.Table:            refreshtoken.ValidColumn,
+			user.Table:                    user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"users/ent/notificationpreferences"
+	"users/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferences is the model entity for the NotificationPreferences schema.
+type NotificationPreferences struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmailMarketing holds the value of the "email_marketing" field.
+	EmailMarketing bool `json:"email_marketing,omitempty"`
+	// SmsMarketing holds the value of the "sms_marketing" field.
+	SmsMarketing bool `json:"sms_marketing,omitempty"`
+	// OrderUpdates holds the value of the "order_updates" field.
+	OrderUpdates bool `json:"order_updates,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationPreferencesQuery when eager-loading is set.
+	Edges                         NotificationPreferencesEdges `json:"edges"`
+	user_notification_preferences *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// NotificationPreferencesEdges holds the relations/edges for other nodes in the graph.
+type NotificationPreferencesEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationPreferencesEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreferences) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreferences.FieldEmailMarketing, notificationpreferences.FieldSmsMarketing, notificationpreferences.FieldOrderUpdates:
+			values[i] = new(sql.NullBool)
+		case notificationpreferences.FieldID:
+			values[i] = new(sql.NullInt64)
+		case notificationpreferences.FieldCreatedAt, notificationpreferences.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationpreferences.ForeignKeys[0]: // user_notification_preferences
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreferences fields.
+func (np *NotificationPreferences) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreferences.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			np.ID = int(value.Int64)
+		case notificationpreferences.FieldEmailMarketing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_marketing", values[i])
+			} else if value.Valid {
+				np.EmailMarketing = value.Bool
+			}
+		case notificationpreferences.FieldSmsMarketing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sms_marketing", values[i])
+			} else if value.Valid {
+				np.SmsMarketing = value.Bool
+			}
+		case notificationpreferences.FieldOrderUpdates:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field order_updates", values[i])
+			} else if value.Valid {
+				np.OrderUpdates = value.Bool
+			}
+		case notificationpreferences.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				np.CreatedAt = value.Time
+			}
+		case notificationpreferences.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				np.UpdatedAt = value.Time
+			}
+		case notificationpreferences.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notification_preferences", values[i])
+			} else if value.Valid {
+				np.user_notification_preferences = new(uuid.UUID)
+				*np.user_notification_preferences = *value.S.(*uuid.UUID)
+			}
+		default:
+			np.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationPreferences.
+// This includes values selected through modifiers, order, etc.
+func (np *NotificationPreferences) Value(name string) (ent.Value, error) {
+	return np.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the NotificationPreferences entity.
+func (np *NotificationPreferences) QueryUser() *UserQuery {
+	return NewNotificationPreferencesClient(np.config).QueryUser(np)
+}
+
+// Update returns a builder for updating this NotificationPreferences.
+// Note that you need to call NotificationPreferences.Unwrap() before calling this method if this NotificationPreferences
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NotificationPreferences) Update() *NotificationPreferencesUpdateOne {
+	return NewNotificationPreferencesClient(np.config).UpdateOne(np)
+}
+
+// Unwrap unwraps the NotificationPreferences entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NotificationPreferences) Unwrap() *NotificationPreferences {
+	_tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreferences is not a transactional entity")
+	}
+	np.config.driver = _tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NotificationPreferences) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreferences(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", np.ID))
+	builder.WriteString("email_marketing=")
+	builder.WriteString(fmt.Sprintf("%v", np.EmailMarketing))
+	builder.WriteString(", ")
+	builder.WriteString("sms_marketing=")
+	builder.WriteString(fmt.Sprintf("%v", np.SmsMarketing))
+	builder.WriteString(", ")
+	builder.WriteString("order_updates=")
+	builder.WriteString(fmt.Sprintf("%v", np.OrderUpdates))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(np.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(np.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferencesSlice is a parsable slice of NotificationPreferences.
+type NotificationPreferencesSlice []*NotificationPreferences
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreferences
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreferences type in the database.
+	Label = "notification_preferences"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmailMarketing holds the string denoting the email_marketing field in the database.
+	FieldEmailMarketing = "email_marketing"
+	// FieldSmsMarketing holds the string denoting the sms_marketing field in the database.
+	FieldSmsMarketing = "sms_marketing"
+	// FieldOrderUpdates holds the string denoting the order_updates field in the database.
+	FieldOrderUpdates = "order_updates"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the notificationpreferences in the database.
+	Table = "notification_preferences"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "notification_preferences"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_notification_preferences"
+)
+
+// Columns holds all SQL columns for notificationpreferences fields.
+var Columns = []string{
+	FieldID,
+	FieldEmailMarketing,
+	FieldSmsMarketing,
+	FieldOrderUpdates,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "notification_preferences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_notification_preferences",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEmailMarketing holds the default value on creation for the "email_marketing" field.
+	DefaultEmailMarketing bool
+	// DefaultSmsMarketing holds the default value on creation for the "sms_marketing" field.
+	DefaultSmsMarketing bool
+	// DefaultOrderUpdates holds the default value on creation for the "order_updates" field.
+	DefaultOrderUpdates bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the NotificationPreferences queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmailMarketing orders the results by the email_marketing field.
+func ByEmailMarketing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailMarketing, opts...).ToFunc()
+}
+
+// BySmsMarketing orders the results by the sms_marketing field.
+func BySmsMarketing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSmsMarketing, opts...).ToFunc()
+}
+
+// ByOrderUpdates orders the results by the order_updates field.
+func ByOrderUpdates(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderUpdates, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
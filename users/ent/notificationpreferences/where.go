@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreferences
+
+import (
+	"time"
+	"users/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLTE(FieldID, id))
+}
+
+// EmailMarketing applies equality check predicate on the "email_marketing" field. It's identical to EmailMarketingEQ.
+func EmailMarketing(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldEmailMarketing, v))
+}
+
+// SmsMarketing applies equality check predicate on the "sms_marketing" field. It's identical to SmsMarketingEQ.
+func SmsMarketing(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldSmsMarketing, v))
+}
+
+// OrderUpdates applies equality check predicate on the "order_updates" field. It's identical to OrderUpdatesEQ.
+func OrderUpdates(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldOrderUpdates, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailMarketingEQ applies the EQ predicate on the "email_marketing" field.
+func EmailMarketingEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldEmailMarketing, v))
+}
+
+// EmailMarketingNEQ applies the NEQ predicate on the "email_marketing" field.
+func EmailMarketingNEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldEmailMarketing, v))
+}
+
+// SmsMarketingEQ applies the EQ predicate on the "sms_marketing" field.
+func SmsMarketingEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldSmsMarketing, v))
+}
+
+// SmsMarketingNEQ applies the NEQ predicate on the "sms_marketing" field.
+func SmsMarketingNEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldSmsMarketing, v))
+}
+
+// OrderUpdatesEQ applies the EQ predicate on the "order_updates" field.
+func OrderUpdatesEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldOrderUpdates, v))
+}
+
+// OrderUpdatesNEQ applies the NEQ predicate on the "order_updates" field.
+func OrderUpdatesNEQ(v bool) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldOrderUpdates, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreferences) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreferences) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreferences) predicate.NotificationPreferences {
+	return predicate.NotificationPreferences(sql.NotPredicates(p))
+}
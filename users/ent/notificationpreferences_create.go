@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"users/ent/notificationpreferences"
+	"users/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferencesCreate is the builder for creating a NotificationPreferences entity.
+type NotificationPreferencesCreate struct {
+	config
+	mutation *NotificationPreferencesMutation
+	hooks    []Hook
+}
+
+// SetEmailMarketing sets the "email_marketing" field.
+func (npc *NotificationPreferencesCreate) SetEmailMarketing(b bool) *NotificationPreferencesCreate {
+	npc.mutation.SetEmailMarketing(b)
+	return npc
+}
+
+// SetNillableEmailMarketing sets the "email_marketing" field if the given value is not nil.
+func (npc *NotificationPreferencesCreate) SetNillableEmailMarketing(b *bool) *NotificationPreferencesCreate {
+	if b != nil {
+		npc.SetEmailMarketing(*b)
+	}
+	return npc
+}
+
+// SetSmsMarketing sets the "sms_marketing" field.
+func (npc *NotificationPreferencesCreate) SetSmsMarketing(b bool) *NotificationPreferencesCreate {
+	npc.mutation.SetSmsMarketing(b)
+	return npc
+}
+
+// SetNillableSmsMarketing sets the "sms_marketing" field if the given value is not nil.
+func (npc *NotificationPreferencesCreate) SetNillableSmsMarketing(b *bool) *NotificationPreferencesCreate {
+	if b != nil {
+		npc.SetSmsMarketing(*b)
+	}
+	return npc
+}
+
+// SetOrderUpdates sets the "order_updates" field.
+func (npc *NotificationPreferencesCreate) SetOrderUpdates(b bool) *NotificationPreferencesCreate {
+	npc.mutation.SetOrderUpdates(b)
+	return npc
+}
+
+// SetNillableOrderUpdates sets the "order_updates" field if the given value is not nil.
+func (npc *NotificationPreferencesCreate) SetNillableOrderUpdates(b *bool) *NotificationPreferencesCreate {
+	if b != nil {
+		npc.SetOrderUpdates(*b)
+	}
+	return npc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (npc *NotificationPreferencesCreate) SetCreatedAt(t time.Time) *NotificationPreferencesCreate {
+	npc.mutation.SetCreatedAt(t)
+	return npc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (npc *NotificationPreferencesCreate) SetNillableCreatedAt(t *time.Time) *NotificationPreferencesCreate {
+	if t != nil {
+		npc.SetCreatedAt(*t)
+	}
+	return npc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npc *NotificationPreferencesCreate) SetUpdatedAt(t time.Time) *NotificationPreferencesCreate {
+	npc.mutation.SetUpdatedAt(t)
+	return npc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (npc *NotificationPreferencesCreate) SetNillableUpdatedAt(t *time.Time) *NotificationPreferencesCreate {
+	if t != nil {
+		npc.SetUpdatedAt(*t)
+	}
+	return npc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npc *NotificationPreferencesCreate) SetUserID(id uuid.UUID) *NotificationPreferencesCreate {
+	npc.mutation.SetUserID(id)
+	return npc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npc *NotificationPreferencesCreate) SetUser(u *User) *NotificationPreferencesCreate {
+	return npc.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferencesMutation object of the builder.
+func (npc *NotificationPreferencesCreate) Mutation() *NotificationPreferencesMutation {
+	return npc.mutation
+}
+
+// Save creates the NotificationPreferences in the database.
+func (npc *NotificationPreferencesCreate) Save(ctx context.Context) (*NotificationPreferences, error) {
+	npc.defaults()
+	return withHooks(ctx, npc.sqlSave, npc.mutation, npc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NotificationPreferencesCreate) SaveX(ctx context.Context) *NotificationPreferences {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NotificationPreferencesCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NotificationPreferencesCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npc *NotificationPreferencesCreate) defaults() {
+	if _, ok := npc.mutation.EmailMarketing(); !ok {
+		v := notificationpreferences.DefaultEmailMarketing
+		npc.mutation.SetEmailMarketing(v)
+	}
+	if _, ok := npc.mutation.SmsMarketing(); !ok {
+		v := notificationpreferences.DefaultSmsMarketing
+		npc.mutation.SetSmsMarketing(v)
+	}
+	if _, ok := npc.mutation.OrderUpdates(); !ok {
+		v := notificationpreferences.DefaultOrderUpdates
+		npc.mutation.SetOrderUpdates(v)
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		v := notificationpreferences.DefaultCreatedAt()
+		npc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		v := notificationpreferences.DefaultUpdatedAt()
+		npc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NotificationPreferencesCreate) check() error {
+	if _, ok := npc.mutation.EmailMarketing(); !ok {
+		return &ValidationError{Name: "email_marketing", err: errors.New(`ent: missing required field "NotificationPreferences.email_marketing"`)}
+	}
+	if _, ok := npc.mutation.SmsMarketing(); !ok {
+		return &ValidationError{Name: "sms_marketing", err: errors.New(`ent: missing required field "NotificationPreferences.sms_marketing"`)}
+	}
+	if _, ok := npc.mutation.OrderUpdates(); !ok {
+		return &ValidationError{Name: "order_updates", err: errors.New(`ent: missing required field "NotificationPreferences.order_updates"`)}
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationPreferences.created_at"`)}
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationPreferences.updated_at"`)}
+	}
+	if len(npc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationPreferences.user"`)}
+	}
+	return nil
+}
+
+func (npc *NotificationPreferencesCreate) sqlSave(ctx context.Context) (*NotificationPreferences, error) {
+	if err := npc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	npc.mutation.id = &_node.ID
+	npc.mutation.done = true
+	return _node, nil
+}
+
+func (npc *NotificationPreferencesCreate) createSpec() (*NotificationPreferences, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreferences{config: npc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreferences.Table, sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt))
+	)
+	if value, ok := npc.mutation.EmailMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldEmailMarketing, field.TypeBool, value)
+		_node.EmailMarketing = value
+	}
+	if value, ok := npc.mutation.SmsMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldSmsMarketing, field.TypeBool, value)
+		_node.SmsMarketing = value
+	}
+	if value, ok := npc.mutation.OrderUpdates(); ok {
+		_spec.SetField(notificationpreferences.FieldOrderUpdates, field.TypeBool, value)
+		_node.OrderUpdates = value
+	}
+	if value, ok := npc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationpreferences.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := npc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreferences.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := npc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreferences.UserTable,
+			Columns: []string{notificationpreferences.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notification_preferences = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationPreferencesCreateBulk is the builder for creating many NotificationPreferences entities in bulk.
+type NotificationPreferencesCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationPreferencesCreate
+}
+
+// Save creates the NotificationPreferences entities in the database.
+func (npcb *NotificationPreferencesCreateBulk) Save(ctx context.Context) ([]*NotificationPreferences, error) {
+	if npcb.err != nil {
+		return nil, npcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NotificationPreferences, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferencesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NotificationPreferencesCreateBulk) SaveX(ctx context.Context) []*NotificationPreferences {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NotificationPreferencesCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NotificationPreferencesCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
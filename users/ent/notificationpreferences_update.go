@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"users/ent/notificationpreferences"
+	"users/ent/predicate"
+	"users/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferencesUpdate is the builder for updating NotificationPreferences entities.
+type NotificationPreferencesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferencesMutation
+}
+
+// Where appends a list predicates to the NotificationPreferencesUpdate builder.
+func (npu *NotificationPreferencesUpdate) Where(ps ...predicate.NotificationPreferences) *NotificationPreferencesUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetEmailMarketing sets the "email_marketing" field.
+func (npu *NotificationPreferencesUpdate) SetEmailMarketing(b bool) *NotificationPreferencesUpdate {
+	npu.mutation.SetEmailMarketing(b)
+	return npu
+}
+
+// SetNillableEmailMarketing sets the "email_marketing" field if the given value is not nil.
+func (npu *NotificationPreferencesUpdate) SetNillableEmailMarketing(b *bool) *NotificationPreferencesUpdate {
+	if b != nil {
+		npu.SetEmailMarketing(*b)
+	}
+	return npu
+}
+
+// SetSmsMarketing sets the "sms_marketing" field.
+func (npu *NotificationPreferencesUpdate) SetSmsMarketing(b bool) *NotificationPreferencesUpdate {
+	npu.mutation.SetSmsMarketing(b)
+	return npu
+}
+
+// SetNillableSmsMarketing sets the "sms_marketing" field if the given value is not nil.
+func (npu *NotificationPreferencesUpdate) SetNillableSmsMarketing(b *bool) *NotificationPreferencesUpdate {
+	if b != nil {
+		npu.SetSmsMarketing(*b)
+	}
+	return npu
+}
+
+// SetOrderUpdates sets the "order_updates" field.
+func (npu *NotificationPreferencesUpdate) SetOrderUpdates(b bool) *NotificationPreferencesUpdate {
+	npu.mutation.SetOrderUpdates(b)
+	return npu
+}
+
+// SetNillableOrderUpdates sets the "order_updates" field if the given value is not nil.
+func (npu *NotificationPreferencesUpdate) SetNillableOrderUpdates(b *bool) *NotificationPreferencesUpdate {
+	if b != nil {
+		npu.SetOrderUpdates(*b)
+	}
+	return npu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npu *NotificationPreferencesUpdate) SetUpdatedAt(t time.Time) *NotificationPreferencesUpdate {
+	npu.mutation.SetUpdatedAt(t)
+	return npu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npu *NotificationPreferencesUpdate) SetUserID(id uuid.UUID) *NotificationPreferencesUpdate {
+	npu.mutation.SetUserID(id)
+	return npu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npu *NotificationPreferencesUpdate) SetUser(u *User) *NotificationPreferencesUpdate {
+	return npu.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferencesMutation object of the builder.
+func (npu *NotificationPreferencesUpdate) Mutation() *NotificationPreferencesMutation {
+	return npu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npu *NotificationPreferencesUpdate) ClearUser() *NotificationPreferencesUpdate {
+	npu.mutation.ClearUser()
+	return npu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NotificationPreferencesUpdate) Save(ctx context.Context) (int, error) {
+	npu.defaults()
+	return withHooks(ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NotificationPreferencesUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NotificationPreferencesUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NotificationPreferencesUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npu *NotificationPreferencesUpdate) defaults() {
+	if _, ok := npu.mutation.UpdatedAt(); !ok {
+		v := notificationpreferences.UpdateDefaultUpdatedAt()
+		npu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npu *NotificationPreferencesUpdate) check() error {
+	if npu.mutation.UserCleared() && len(npu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreferences.user"`)
+	}
+	return nil
+}
+
+func (npu *NotificationPreferencesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := npu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreferences.Table, notificationpreferences.Columns, sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.EmailMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldEmailMarketing, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.SmsMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldSmsMarketing, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.OrderUpdates(); ok {
+		_spec.SetField(notificationpreferences.FieldOrderUpdates, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreferences.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if npu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreferences.UserTable,
+			Columns: []string{notificationpreferences.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreferences.UserTable,
+			Columns: []string{notificationpreferences.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreferences.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NotificationPreferencesUpdateOne is the builder for updating a single NotificationPreferences entity.
+type NotificationPreferencesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferencesMutation
+}
+
+// SetEmailMarketing sets the "email_marketing" field.
+func (npuo *NotificationPreferencesUpdateOne) SetEmailMarketing(b bool) *NotificationPreferencesUpdateOne {
+	npuo.mutation.SetEmailMarketing(b)
+	return npuo
+}
+
+// SetNillableEmailMarketing sets the "email_marketing" field if the given value is not nil.
+func (npuo *NotificationPreferencesUpdateOne) SetNillableEmailMarketing(b *bool) *NotificationPreferencesUpdateOne {
+	if b != nil {
+		npuo.SetEmailMarketing(*b)
+	}
+	return npuo
+}
+
+// SetSmsMarketing sets the "sms_marketing" field.
+func (npuo *NotificationPreferencesUpdateOne) SetSmsMarketing(b bool) *NotificationPreferencesUpdateOne {
+	npuo.mutation.SetSmsMarketing(b)
+	return npuo
+}
+
+// SetNillableSmsMarketing sets the "sms_marketing" field if the given value is not nil.
+func (npuo *NotificationPreferencesUpdateOne) SetNillableSmsMarketing(b *bool) *NotificationPreferencesUpdateOne {
+	if b != nil {
+		npuo.SetSmsMarketing(*b)
+	}
+	return npuo
+}
+
+// SetOrderUpdates sets the "order_updates" field.
+func (npuo *NotificationPreferencesUpdateOne) SetOrderUpdates(b bool) *NotificationPreferencesUpdateOne {
+	npuo.mutation.SetOrderUpdates(b)
+	return npuo
+}
+
+// SetNillableOrderUpdates sets the "order_updates" field if the given value is not nil.
+func (npuo *NotificationPreferencesUpdateOne) SetNillableOrderUpdates(b *bool) *NotificationPreferencesUpdateOne {
+	if b != nil {
+		npuo.SetOrderUpdates(*b)
+	}
+	return npuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npuo *NotificationPreferencesUpdateOne) SetUpdatedAt(t time.Time) *NotificationPreferencesUpdateOne {
+	npuo.mutation.SetUpdatedAt(t)
+	return npuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npuo *NotificationPreferencesUpdateOne) SetUserID(id uuid.UUID) *NotificationPreferencesUpdateOne {
+	npuo.mutation.SetUserID(id)
+	return npuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npuo *NotificationPreferencesUpdateOne) SetUser(u *User) *NotificationPreferencesUpdateOne {
+	return npuo.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferencesMutation object of the builder.
+func (npuo *NotificationPreferencesUpdateOne) Mutation() *NotificationPreferencesMutation {
+	return npuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npuo *NotificationPreferencesUpdateOne) ClearUser() *NotificationPreferencesUpdateOne {
+	npuo.mutation.ClearUser()
+	return npuo
+}
+
+// Where appends a list predicates to the NotificationPreferencesUpdate builder.
+func (npuo *NotificationPreferencesUpdateOne) Where(ps ...predicate.NotificationPreferences) *NotificationPreferencesUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NotificationPreferencesUpdateOne) Select(field string, fields ...string) *NotificationPreferencesUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NotificationPreferences entity.
+func (npuo *NotificationPreferencesUpdateOne) Save(ctx context.Context) (*NotificationPreferences, error) {
+	npuo.defaults()
+	return withHooks(ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NotificationPreferencesUpdateOne) SaveX(ctx context.Context) *NotificationPreferences {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NotificationPreferencesUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NotificationPreferencesUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npuo *NotificationPreferencesUpdateOne) defaults() {
+	if _, ok := npuo.mutation.UpdatedAt(); !ok {
+		v := notificationpreferences.UpdateDefaultUpdatedAt()
+		npuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npuo *NotificationPreferencesUpdateOne) check() error {
+	if npuo.mutation.UserCleared() && len(npuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreferences.user"`)
+	}
+	return nil
+}
+
+func (npuo *NotificationPreferencesUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreferences, err error) {
+	if err := npuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreferences.Table, notificationpreferences.Columns, sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreferences.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreferences.FieldID)
+		for _, f := range fields {
+			if !notificationpreferences.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreferences.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.EmailMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldEmailMarketing, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.SmsMarketing(); ok {
+		_spec.SetField(notificationpreferences.FieldSmsMarketing, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.OrderUpdates(); ok {
+		_spec.SetField(notificationpreferences.FieldOrderUpdates, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreferences.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if npuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreferences.UserTable,
+			Columns: []string{notificationpreferences.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreferences.UserTable,
+			Columns: []string{notificationpreferences.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationPreferences{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreferences.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
@@ -6,8 +6,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// NotificationPreferences is the predicate function for notificationpreferences builders.
+type NotificationPreferences func(*sql.Selector)
+
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -4,7 +4,9 @@ package ent
 
 import (
 	"time"
+	"users/ent/notificationpreferences"
 	"users/ent/profile"
+	"users/ent/refreshtoken"
 	"users/ent/schema"
 	"users/ent/user"
 
@@ -15,6 +17,30 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	notificationpreferencesFields := schema.NotificationPreferences{}.Fields()
+	_ = notificationpreferencesFields
+	// notificationpreferencesDescEmailMarketing is the schema descriptor for email_marketing field.
+	notificationpreferencesDescEmailMarketing := notificationpreferencesFields[0].Descriptor()
+	// notificationpreferences.DefaultEmailMarketing holds the default value on creation for the email_marketing field.
+	notificationpreferences.DefaultEmailMarketing = notificationpreferencesDescEmailMarketing.Default.(bool)
+	// notificationpreferencesDescSmsMarketing is the schema descriptor for sms_marketing field.
+	notificationpreferencesDescSmsMarketing := notificationpreferencesFields[1].Descriptor()
+	// notificationpreferences.DefaultSmsMarketing holds the default value on creation for the sms_marketing field.
+	notificationpreferences.DefaultSmsMarketing = notificationpreferencesDescSmsMarketing.Default.(bool)
+	// notificationpreferencesDescOrderUpdates is the schema descriptor for order_updates field.
+	notificationpreferencesDescOrderUpdates := notificationpreferencesFields[2].Descriptor()
+	// notificationpreferences.DefaultOrderUpdates holds the default value on creation for the order_updates field.
+	notificationpreferences.DefaultOrderUpdates = notificationpreferencesDescOrderUpdates.Default.(bool)
+	// notificationpreferencesDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferencesDescCreatedAt := notificationpreferencesFields[3].Descriptor()
+	// notificationpreferences.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreferences.DefaultCreatedAt = notificationpreferencesDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferencesDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferencesDescUpdatedAt := notificationpreferencesFields[4].Descriptor()
+	// notificationpreferences.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreferences.DefaultUpdatedAt = notificationpreferencesDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreferences.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreferences.UpdateDefaultUpdatedAt = notificationpreferencesDescUpdatedAt.UpdateDefault.(func() time.Time)
 	profileFields := schema.Profile{}.Fields()
 	_ = profileFields
 	// profileDescCreatedAt is the schema descriptor for created_at field.
@@ -27,6 +53,24 @@ func init() {
 	profile.DefaultUpdatedAt = profileDescUpdatedAt.Default.(func() time.Time)
 	// profile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	profile.UpdateDefaultUpdatedAt = profileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[1].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescRevoked is the schema descriptor for revoked field.
+	refreshtokenDescRevoked := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
+	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[5].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescID is the schema descriptor for id field.
+	refreshtokenDescID := refreshtokenFields[0].Descriptor()
+	// refreshtoken.DefaultID holds the default value on creation for the id field.
+	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -37,24 +81,34 @@ func init() {
 	userDescPasswordHash := userFields[3].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescPasswordChangedAt is the schema descriptor for password_changed_at field.
+	userDescPasswordChangedAt := userFields[4].Descriptor()
+	// user.DefaultPasswordChangedAt holds the default value on creation for the password_changed_at field.
+	user.DefaultPasswordChangedAt = userDescPasswordChangedAt.Default.(func() time.Time)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[5].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[6].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescIsActive is the schema descriptor for is_active field.
-	userDescIsActive := userFields[6].Descriptor()
+	userDescIsActive := userFields[7].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
 	// userDescEmailVerified is the schema descriptor for email_verified field.
-	userDescEmailVerified := userFields[7].Descriptor()
+	userDescEmailVerified := userFields[9].Descriptor()
 	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescFailedLoginCount is the schema descriptor for failed_login_count field.
+	userDescFailedLoginCount := userFields[17].Descriptor()
+	// user.DefaultFailedLoginCount holds the default value on creation for the failed_login_count field.
+	user.DefaultFailedLoginCount = userDescFailedLoginCount.Default.(int)
+	// user.FailedLoginCountValidator is a validator for the "failed_login_count" field. It is called by the builders before save.
+	user.FailedLoginCountValidator = userDescFailedLoginCount.Validators[0].(func(int) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
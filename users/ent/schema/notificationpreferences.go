@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreferences holds the schema definition for the NotificationPreferences entity.
+type NotificationPreferences struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreferences.
+func (NotificationPreferences) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bool("email_marketing").Default(false),
+		field.Bool("sms_marketing").Default(false),
+		field.Bool("order_updates").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NotificationPreferences.
+func (NotificationPreferences) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Preferences belong to exactly one user (one-to-one relationship)
+		edge.From("user", User.Type).
+			Ref("notification_preferences").Unique().Required(),
+	}
+}
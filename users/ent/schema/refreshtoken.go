@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("token_hash").Unique().NotEmpty().Immutable().Sensitive().Comment("SHA-256 of the token; the token itself is only ever given to the client"),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Time("expires_at").Immutable(),
+		field.Bool("revoked").Default(false).Comment("Set once the token is rotated by RefreshToken or revoked on logout"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// A refresh token belongs to exactly one user (many-to-one relationship)
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// Revoking every session of a user looks its tokens up by user
+		index.Fields("user_id", "revoked"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -18,14 +19,25 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
-		field.String("email").Unique().Immutable().Comment("Email should be unique and not change after creation"),
+		field.String("email").Unique().Comment("Email should be unique; it changes only through a confirmed email change"),
 		field.String("username").Unique().NotEmpty(),
 		field.String("password_hash").NotEmpty(),
+		field.Time("password_changed_at").Optional().Nillable().Default(time.Now).Comment("When the password was last set; unset for accounts predating the column, which fall back to created_at"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Bool("is_active").Default(true),
+		field.Enum("role").Values("user", "admin").Default("user").Comment("Admins may call AdminService; changed only by SetUserRole"),
 		field.Bool("email_verified").Default(false),
 		field.String("verification_token").Optional().Nillable(),
+		field.Time("verification_token_expires_at").Optional().Nillable().Comment("When verification_token stops being accepted; unset for tokens issued before expiry was tracked"),
+		field.String("reset_token").Optional().Nillable().Comment("Issued by ResetPassword, accepted only by ConfirmPasswordReset"),
+		field.Time("reset_token_expires_at").Optional().Nillable(),
+		field.String("pending_email").Optional().Nillable().Comment("Address requested by RequestEmailChange; email stays in use until it is confirmed"),
+		field.String("email_change_token").Optional().Nillable(),
+		field.Time("email_change_expires_at").Optional().Nillable(),
+		field.Int("failed_login_count").Default(0).NonNegative().Comment("Wrong passwords given in a row since the last successful login or lock"),
+		field.Time("locked_until").Optional().Nillable().Comment("Authenticate refuses the account until then; cleared by UnlockUser"),
+		field.Time("deleted_at").Optional().Nillable().Comment("Soft delete timestamp; the email and username stay reserved while deleted"),
 	}
 }
 
@@ -34,5 +46,9 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		// A user has one profile (one-to-one relationship)
 		edge.To("profile", Profile.Type).Unique(),
+		// A user has one set of notification preferences (one-to-one relationship)
+		edge.To("notification_preferences", NotificationPreferences.Type).Unique(),
+		// A user has a refresh token per session, deleted along with the user
+		edge.To("refresh_tokens", RefreshToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
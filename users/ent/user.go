@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"users/ent/notificationpreferences"
 	"users/ent/profile"
 	"users/ent/user"
 
@@ -19,22 +20,44 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Email should be unique and not change after creation
+	// Email should be unique; it changes only through a confirmed email change
 	Email string `json:"email,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
 	PasswordHash string `json:"password_hash,omitempty"`
+	// When the password was last set; unset for accounts predating the column, which fall back to created_at
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Admins may call AdminService; changed only by SetUserRole
+	Role user.Role `json:"role,omitempty"`
 	// EmailVerified holds the value of the "email_verified" field.
 	EmailVerified bool `json:"email_verified,omitempty"`
 	// VerificationToken holds the value of the "verification_token" field.
 	VerificationToken *string `json:"verification_token,omitempty"`
+	// When verification_token stops being accepted; unset for tokens issued before expiry was tracked
+	VerificationTokenExpiresAt *time.Time `json:"verification_token_expires_at,omitempty"`
+	// Issued by ResetPassword, accepted only by ConfirmPasswordReset
+	ResetToken *string `json:"reset_token,omitempty"`
+	// ResetTokenExpiresAt holds the value of the "reset_token_expires_at" field.
+	ResetTokenExpiresAt *time.Time `json:"reset_token_expires_at,omitempty"`
+	// Address requested by RequestEmailChange; email stays in use until it is confirmed
+	PendingEmail *string `json:"pending_email,omitempty"`
+	// EmailChangeToken holds the value of the "email_change_token" field.
+	EmailChangeToken *string `json:"email_change_token,omitempty"`
+	// EmailChangeExpiresAt holds the value of the "email_change_expires_at" field.
+	EmailChangeExpiresAt *time.Time `json:"email_change_expires_at,omitempty"`
+	// Wrong passwords given in a row since the last successful login or lock
+	FailedLoginCount int `json:"failed_login_count,omitempty"`
+	// Authenticate refuses the account until then; cleared by UnlockUser
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// Soft delete timestamp; the email and username stay reserved while deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -45,9 +68,13 @@ type User struct {
 type UserEdges struct {
 	// Profile holds the value of the profile edge.
 	Profile *Profile `json:"profile,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences *NotificationPreferences `json:"notification_preferences,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ProfileOrErr returns the Profile value or an error if the edge
@@ -61,6 +88,26 @@ func (e UserEdges) ProfileOrErr() (*Profile, error) {
 	return nil, &NotLoadedError{edge: "profile"}
 }
 
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) NotificationPreferencesOrErr() (*NotificationPreferences, error) {
+	if e.NotificationPreferences != nil {
+		return e.NotificationPreferences, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: notificationpreferences.Label}
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[2] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -68,9 +115,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsActive, user.FieldEmailVerified:
 			values[i] = new(sql.NullBool)
-		case user.FieldEmail, user.FieldUsername, user.FieldPasswordHash, user.FieldVerificationToken:
+		case user.FieldFailedLoginCount:
+			values[i] = new(sql.NullInt64)
+		case user.FieldEmail, user.FieldUsername, user.FieldPasswordHash, user.FieldRole, user.FieldVerificationToken, user.FieldResetToken, user.FieldPendingEmail, user.FieldEmailChangeToken:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldPasswordChangedAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldVerificationTokenExpiresAt, user.FieldResetTokenExpiresAt, user.FieldEmailChangeExpiresAt, user.FieldLockedUntil, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -113,6 +162,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.PasswordHash = value.String
 			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				u.PasswordChangedAt = new(time.Time)
+				*u.PasswordChangedAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -131,6 +187,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IsActive = value.Bool
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
 		case user.FieldEmailVerified:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
@@ -144,6 +206,68 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.VerificationToken = new(string)
 				*u.VerificationToken = value.String
 			}
+		case user.FieldVerificationTokenExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_token_expires_at", values[i])
+			} else if value.Valid {
+				u.VerificationTokenExpiresAt = new(time.Time)
+				*u.VerificationTokenExpiresAt = value.Time
+			}
+		case user.FieldResetToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_token", values[i])
+			} else if value.Valid {
+				u.ResetToken = new(string)
+				*u.ResetToken = value.String
+			}
+		case user.FieldResetTokenExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_token_expires_at", values[i])
+			} else if value.Valid {
+				u.ResetTokenExpiresAt = new(time.Time)
+				*u.ResetTokenExpiresAt = value.Time
+			}
+		case user.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				u.PendingEmail = new(string)
+				*u.PendingEmail = value.String
+			}
+		case user.FieldEmailChangeToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_change_token", values[i])
+			} else if value.Valid {
+				u.EmailChangeToken = new(string)
+				*u.EmailChangeToken = value.String
+			}
+		case user.FieldEmailChangeExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_change_expires_at", values[i])
+			} else if value.Valid {
+				u.EmailChangeExpiresAt = new(time.Time)
+				*u.EmailChangeExpiresAt = value.Time
+			}
+		case user.FieldFailedLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_count", values[i])
+			} else if value.Valid {
+				u.FailedLoginCount = int(value.Int64)
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				u.LockedUntil = new(time.Time)
+				*u.LockedUntil = value.Time
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -162,6 +286,16 @@ func (u *User) QueryProfile() *ProfileQuery {
 	return NewUserClient(u.config).QueryProfile(u)
 }
 
+// QueryNotificationPreferences queries the "notification_preferences" edge of the User entity.
+func (u *User) QueryNotificationPreferences() *NotificationPreferencesQuery {
+	return NewUserClient(u.config).QueryNotificationPreferences(u)
+}
+
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (u *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(u.config).QueryRefreshTokens(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -194,6 +328,11 @@ func (u *User) String() string {
 	builder.WriteString("password_hash=")
 	builder.WriteString(u.PasswordHash)
 	builder.WriteString(", ")
+	if v := u.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -203,6 +342,9 @@ func (u *User) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", u.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
 	builder.WriteString("email_verified=")
 	builder.WriteString(fmt.Sprintf("%v", u.EmailVerified))
 	builder.WriteString(", ")
@@ -210,6 +352,49 @@ func (u *User) String() string {
 		builder.WriteString("verification_token=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := u.VerificationTokenExpiresAt; v != nil {
+		builder.WriteString("verification_token_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.ResetToken; v != nil {
+		builder.WriteString("reset_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.ResetTokenExpiresAt; v != nil {
+		builder.WriteString("reset_token_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.PendingEmail; v != nil {
+		builder.WriteString("pending_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.EmailChangeToken; v != nil {
+		builder.WriteString("email_change_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.EmailChangeExpiresAt; v != nil {
+		builder.WriteString("email_change_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FailedLoginCount))
+	builder.WriteString(", ")
+	if v := u.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
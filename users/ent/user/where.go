@@ -71,6 +71,11 @@ func PasswordHash(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
 }
 
+// PasswordChangedAt applies equality check predicate on the "password_changed_at" field. It's identical to PasswordChangedAtEQ.
+func PasswordChangedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -96,6 +101,51 @@ func VerificationToken(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldVerificationToken, v))
 }
 
+// VerificationTokenExpiresAt applies equality check predicate on the "verification_token_expires_at" field. It's identical to VerificationTokenExpiresAtEQ.
+func VerificationTokenExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVerificationTokenExpiresAt, v))
+}
+
+// ResetToken applies equality check predicate on the "reset_token" field. It's identical to ResetTokenEQ.
+func ResetToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResetToken, v))
+}
+
+// ResetTokenExpiresAt applies equality check predicate on the "reset_token_expires_at" field. It's identical to ResetTokenExpiresAtEQ.
+func ResetTokenExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResetTokenExpiresAt, v))
+}
+
+// PendingEmail applies equality check predicate on the "pending_email" field. It's identical to PendingEmailEQ.
+func PendingEmail(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// EmailChangeToken applies equality check predicate on the "email_change_token" field. It's identical to EmailChangeTokenEQ.
+func EmailChangeToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeToken, v))
+}
+
+// EmailChangeExpiresAt applies equality check predicate on the "email_change_expires_at" field. It's identical to EmailChangeExpiresAtEQ.
+func EmailChangeExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// FailedLoginCount applies equality check predicate on the "failed_login_count" field. It's identical to FailedLoginCountEQ.
+func FailedLoginCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFailedLoginCount, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -291,6 +341,56 @@ func PasswordHashContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPasswordHash, v))
 }
 
+// PasswordChangedAtEQ applies the EQ predicate on the "password_changed_at" field.
+func PasswordChangedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtNEQ applies the NEQ predicate on the "password_changed_at" field.
+func PasswordChangedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIn applies the In predicate on the "password_changed_at" field.
+func PasswordChangedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtNotIn applies the NotIn predicate on the "password_changed_at" field.
+func PasswordChangedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtGT applies the GT predicate on the "password_changed_at" field.
+func PasswordChangedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtGTE applies the GTE predicate on the "password_changed_at" field.
+func PasswordChangedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLT applies the LT predicate on the "password_changed_at" field.
+func PasswordChangedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLTE applies the LTE predicate on the "password_changed_at" field.
+func PasswordChangedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIsNil applies the IsNil predicate on the "password_changed_at" field.
+func PasswordChangedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPasswordChangedAt))
+}
+
+// PasswordChangedAtNotNil applies the NotNil predicate on the "password_changed_at" field.
+func PasswordChangedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPasswordChangedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -381,6 +481,26 @@ func IsActiveNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRole, vs...))
+}
+
 // EmailVerifiedEQ applies the EQ predicate on the "email_verified" field.
 func EmailVerifiedEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmailVerified, v))
@@ -466,6 +586,521 @@ func VerificationTokenContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldVerificationToken, v))
 }
 
+// VerificationTokenExpiresAtEQ applies the EQ predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtNEQ applies the NEQ predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtIn applies the In predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldVerificationTokenExpiresAt, vs...))
+}
+
+// VerificationTokenExpiresAtNotIn applies the NotIn predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldVerificationTokenExpiresAt, vs...))
+}
+
+// VerificationTokenExpiresAtGT applies the GT predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtGTE applies the GTE predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtLT applies the LT predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtLTE applies the LTE predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldVerificationTokenExpiresAt, v))
+}
+
+// VerificationTokenExpiresAtIsNil applies the IsNil predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldVerificationTokenExpiresAt))
+}
+
+// VerificationTokenExpiresAtNotNil applies the NotNil predicate on the "verification_token_expires_at" field.
+func VerificationTokenExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldVerificationTokenExpiresAt))
+}
+
+// ResetTokenEQ applies the EQ predicate on the "reset_token" field.
+func ResetTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResetToken, v))
+}
+
+// ResetTokenNEQ applies the NEQ predicate on the "reset_token" field.
+func ResetTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldResetToken, v))
+}
+
+// ResetTokenIn applies the In predicate on the "reset_token" field.
+func ResetTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldResetToken, vs...))
+}
+
+// ResetTokenNotIn applies the NotIn predicate on the "reset_token" field.
+func ResetTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldResetToken, vs...))
+}
+
+// ResetTokenGT applies the GT predicate on the "reset_token" field.
+func ResetTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldResetToken, v))
+}
+
+// ResetTokenGTE applies the GTE predicate on the "reset_token" field.
+func ResetTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldResetToken, v))
+}
+
+// ResetTokenLT applies the LT predicate on the "reset_token" field.
+func ResetTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldResetToken, v))
+}
+
+// ResetTokenLTE applies the LTE predicate on the "reset_token" field.
+func ResetTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldResetToken, v))
+}
+
+// ResetTokenContains applies the Contains predicate on the "reset_token" field.
+func ResetTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldResetToken, v))
+}
+
+// ResetTokenHasPrefix applies the HasPrefix predicate on the "reset_token" field.
+func ResetTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldResetToken, v))
+}
+
+// ResetTokenHasSuffix applies the HasSuffix predicate on the "reset_token" field.
+func ResetTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldResetToken, v))
+}
+
+// ResetTokenIsNil applies the IsNil predicate on the "reset_token" field.
+func ResetTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldResetToken))
+}
+
+// ResetTokenNotNil applies the NotNil predicate on the "reset_token" field.
+func ResetTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldResetToken))
+}
+
+// ResetTokenEqualFold applies the EqualFold predicate on the "reset_token" field.
+func ResetTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldResetToken, v))
+}
+
+// ResetTokenContainsFold applies the ContainsFold predicate on the "reset_token" field.
+func ResetTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldResetToken, v))
+}
+
+// ResetTokenExpiresAtEQ applies the EQ predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtNEQ applies the NEQ predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtIn applies the In predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldResetTokenExpiresAt, vs...))
+}
+
+// ResetTokenExpiresAtNotIn applies the NotIn predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldResetTokenExpiresAt, vs...))
+}
+
+// ResetTokenExpiresAtGT applies the GT predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtGTE applies the GTE predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtLT applies the LT predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtLTE applies the LTE predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldResetTokenExpiresAt, v))
+}
+
+// ResetTokenExpiresAtIsNil applies the IsNil predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldResetTokenExpiresAt))
+}
+
+// ResetTokenExpiresAtNotNil applies the NotNil predicate on the "reset_token_expires_at" field.
+func ResetTokenExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldResetTokenExpiresAt))
+}
+
+// PendingEmailEQ applies the EQ predicate on the "pending_email" field.
+func PendingEmailEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailNEQ applies the NEQ predicate on the "pending_email" field.
+func PendingEmailNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailIn applies the In predicate on the "pending_email" field.
+func PendingEmailIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailNotIn applies the NotIn predicate on the "pending_email" field.
+func PendingEmailNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailGT applies the GT predicate on the "pending_email" field.
+func PendingEmailGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPendingEmail, v))
+}
+
+// PendingEmailGTE applies the GTE predicate on the "pending_email" field.
+func PendingEmailGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPendingEmail, v))
+}
+
+// PendingEmailLT applies the LT predicate on the "pending_email" field.
+func PendingEmailLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPendingEmail, v))
+}
+
+// PendingEmailLTE applies the LTE predicate on the "pending_email" field.
+func PendingEmailLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPendingEmail, v))
+}
+
+// PendingEmailContains applies the Contains predicate on the "pending_email" field.
+func PendingEmailContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPendingEmail, v))
+}
+
+// PendingEmailHasPrefix applies the HasPrefix predicate on the "pending_email" field.
+func PendingEmailHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPendingEmail, v))
+}
+
+// PendingEmailHasSuffix applies the HasSuffix predicate on the "pending_email" field.
+func PendingEmailHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPendingEmail, v))
+}
+
+// PendingEmailIsNil applies the IsNil predicate on the "pending_email" field.
+func PendingEmailIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPendingEmail))
+}
+
+// PendingEmailNotNil applies the NotNil predicate on the "pending_email" field.
+func PendingEmailNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPendingEmail))
+}
+
+// PendingEmailEqualFold applies the EqualFold predicate on the "pending_email" field.
+func PendingEmailEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPendingEmail, v))
+}
+
+// PendingEmailContainsFold applies the ContainsFold predicate on the "pending_email" field.
+func PendingEmailContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPendingEmail, v))
+}
+
+// EmailChangeTokenEQ applies the EQ predicate on the "email_change_token" field.
+func EmailChangeTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenNEQ applies the NEQ predicate on the "email_change_token" field.
+func EmailChangeTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenIn applies the In predicate on the "email_change_token" field.
+func EmailChangeTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailChangeToken, vs...))
+}
+
+// EmailChangeTokenNotIn applies the NotIn predicate on the "email_change_token" field.
+func EmailChangeTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailChangeToken, vs...))
+}
+
+// EmailChangeTokenGT applies the GT predicate on the "email_change_token" field.
+func EmailChangeTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenGTE applies the GTE predicate on the "email_change_token" field.
+func EmailChangeTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenLT applies the LT predicate on the "email_change_token" field.
+func EmailChangeTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenLTE applies the LTE predicate on the "email_change_token" field.
+func EmailChangeTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenContains applies the Contains predicate on the "email_change_token" field.
+func EmailChangeTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenHasPrefix applies the HasPrefix predicate on the "email_change_token" field.
+func EmailChangeTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenHasSuffix applies the HasSuffix predicate on the "email_change_token" field.
+func EmailChangeTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenIsNil applies the IsNil predicate on the "email_change_token" field.
+func EmailChangeTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailChangeToken))
+}
+
+// EmailChangeTokenNotNil applies the NotNil predicate on the "email_change_token" field.
+func EmailChangeTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailChangeToken))
+}
+
+// EmailChangeTokenEqualFold applies the EqualFold predicate on the "email_change_token" field.
+func EmailChangeTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldEmailChangeToken, v))
+}
+
+// EmailChangeTokenContainsFold applies the ContainsFold predicate on the "email_change_token" field.
+func EmailChangeTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldEmailChangeToken, v))
+}
+
+// EmailChangeExpiresAtEQ applies the EQ predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtNEQ applies the NEQ predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtIn applies the In predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailChangeExpiresAt, vs...))
+}
+
+// EmailChangeExpiresAtNotIn applies the NotIn predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailChangeExpiresAt, vs...))
+}
+
+// EmailChangeExpiresAtGT applies the GT predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtGTE applies the GTE predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtLT applies the LT predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtLTE applies the LTE predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtIsNil applies the IsNil predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailChangeExpiresAt))
+}
+
+// EmailChangeExpiresAtNotNil applies the NotNil predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailChangeExpiresAt))
+}
+
+// FailedLoginCountEQ applies the EQ predicate on the "failed_login_count" field.
+func FailedLoginCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountNEQ applies the NEQ predicate on the "failed_login_count" field.
+func FailedLoginCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountIn applies the In predicate on the "failed_login_count" field.
+func FailedLoginCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFailedLoginCount, vs...))
+}
+
+// FailedLoginCountNotIn applies the NotIn predicate on the "failed_login_count" field.
+func FailedLoginCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFailedLoginCount, vs...))
+}
+
+// FailedLoginCountGT applies the GT predicate on the "failed_login_count" field.
+func FailedLoginCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountGTE applies the GTE predicate on the "failed_login_count" field.
+func FailedLoginCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountLT applies the LT predicate on the "failed_login_count" field.
+func FailedLoginCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFailedLoginCount, v))
+}
+
+// FailedLoginCountLTE applies the LTE predicate on the "failed_login_count" field.
+func FailedLoginCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFailedLoginCount, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasProfile applies the HasEdge predicate on the "profile" edge.
 func HasProfile() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -489,6 +1124,52 @@ func HasProfileWith(preds ...predicate.Profile) predicate.User {
 	})
 }
 
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.NotificationPreferences) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRefreshTokens applies the HasEdge predicate on the "refresh_tokens" edge.
+func HasRefreshTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefreshTokensWith applies the HasEdge predicate on the "refresh_tokens" edge with a given conditions (other predicates).
+func HasRefreshTokensWith(preds ...predicate.RefreshToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRefreshTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"users/ent/notificationpreferences"
 	"users/ent/profile"
+	"users/ent/refreshtoken"
 	"users/ent/user"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -40,6 +42,20 @@ func (uc *UserCreate) SetPasswordHash(s string) *UserCreate {
 	return uc
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uc *UserCreate) SetPasswordChangedAt(t time.Time) *UserCreate {
+	uc.mutation.SetPasswordChangedAt(t)
+	return uc
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePasswordChangedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPasswordChangedAt(*t)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -82,6 +98,20 @@ func (uc *UserCreate) SetNillableIsActive(b *bool) *UserCreate {
 	return uc
 }
 
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
 // SetEmailVerified sets the "email_verified" field.
 func (uc *UserCreate) SetEmailVerified(b bool) *UserCreate {
 	uc.mutation.SetEmailVerified(b)
@@ -110,6 +140,132 @@ func (uc *UserCreate) SetNillableVerificationToken(s *string) *UserCreate {
 	return uc
 }
 
+// SetVerificationTokenExpiresAt sets the "verification_token_expires_at" field.
+func (uc *UserCreate) SetVerificationTokenExpiresAt(t time.Time) *UserCreate {
+	uc.mutation.SetVerificationTokenExpiresAt(t)
+	return uc
+}
+
+// SetNillableVerificationTokenExpiresAt sets the "verification_token_expires_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVerificationTokenExpiresAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetVerificationTokenExpiresAt(*t)
+	}
+	return uc
+}
+
+// SetResetToken sets the "reset_token" field.
+func (uc *UserCreate) SetResetToken(s string) *UserCreate {
+	uc.mutation.SetResetToken(s)
+	return uc
+}
+
+// SetNillableResetToken sets the "reset_token" field if the given value is not nil.
+func (uc *UserCreate) SetNillableResetToken(s *string) *UserCreate {
+	if s != nil {
+		uc.SetResetToken(*s)
+	}
+	return uc
+}
+
+// SetResetTokenExpiresAt sets the "reset_token_expires_at" field.
+func (uc *UserCreate) SetResetTokenExpiresAt(t time.Time) *UserCreate {
+	uc.mutation.SetResetTokenExpiresAt(t)
+	return uc
+}
+
+// SetNillableResetTokenExpiresAt sets the "reset_token_expires_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableResetTokenExpiresAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetResetTokenExpiresAt(*t)
+	}
+	return uc
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (uc *UserCreate) SetPendingEmail(s string) *UserCreate {
+	uc.mutation.SetPendingEmail(s)
+	return uc
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePendingEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPendingEmail(*s)
+	}
+	return uc
+}
+
+// SetEmailChangeToken sets the "email_change_token" field.
+func (uc *UserCreate) SetEmailChangeToken(s string) *UserCreate {
+	uc.mutation.SetEmailChangeToken(s)
+	return uc
+}
+
+// SetNillableEmailChangeToken sets the "email_change_token" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailChangeToken(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmailChangeToken(*s)
+	}
+	return uc
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (uc *UserCreate) SetEmailChangeExpiresAt(t time.Time) *UserCreate {
+	uc.mutation.SetEmailChangeExpiresAt(t)
+	return uc
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailChangeExpiresAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetEmailChangeExpiresAt(*t)
+	}
+	return uc
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (uc *UserCreate) SetFailedLoginCount(i int) *UserCreate {
+	uc.mutation.SetFailedLoginCount(i)
+	return uc
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFailedLoginCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFailedLoginCount(*i)
+	}
+	return uc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uc *UserCreate) SetLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLockedUntil(t)
+	return uc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLockedUntil(*t)
+	}
+	return uc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UserCreate) SetDeletedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -143,6 +299,40 @@ func (uc *UserCreate) SetProfile(p *Profile) *UserCreate {
 	return uc.SetProfileID(p.ID)
 }
 
+// SetNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID.
+func (uc *UserCreate) SetNotificationPreferencesID(id int) *UserCreate {
+	uc.mutation.SetNotificationPreferencesID(id)
+	return uc
+}
+
+// SetNillableNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableNotificationPreferencesID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetNotificationPreferencesID(*id)
+	}
+	return uc
+}
+
+// SetNotificationPreferences sets the "notification_preferences" edge to the NotificationPreferences entity.
+func (uc *UserCreate) SetNotificationPreferences(n *NotificationPreferences) *UserCreate {
+	return uc.SetNotificationPreferencesID(n.ID)
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (uc *UserCreate) AddRefreshTokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddRefreshTokenIDs(ids...)
+	return uc
+}
+
+// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
+func (uc *UserCreate) AddRefreshTokens(r ...*RefreshToken) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRefreshTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -178,6 +368,10 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.PasswordChangedAt(); !ok {
+		v := user.DefaultPasswordChangedAt()
+		uc.mutation.SetPasswordChangedAt(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -190,10 +384,18 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsActive
 		uc.mutation.SetIsActive(v)
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
 	if _, ok := uc.mutation.EmailVerified(); !ok {
 		v := user.DefaultEmailVerified
 		uc.mutation.SetEmailVerified(v)
 	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		v := user.DefaultFailedLoginCount
+		uc.mutation.SetFailedLoginCount(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -230,9 +432,25 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "User.is_active"`)}
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.EmailVerified(); !ok {
 		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
 	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		return &ValidationError{Name: "failed_login_count", err: errors.New(`ent: missing required field "User.failed_login_count"`)}
+	}
+	if v, ok := uc.mutation.FailedLoginCount(); ok {
+		if err := user.FailedLoginCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_count", err: fmt.Errorf(`ent: validator failed for field "User.failed_login_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -280,6 +498,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 		_node.PasswordHash = value
 	}
+	if value, ok := uc.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -292,6 +514,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
 	if value, ok := uc.mutation.EmailVerified(); ok {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 		_node.EmailVerified = value
@@ -300,6 +526,42 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldVerificationToken, field.TypeString, value)
 		_node.VerificationToken = &value
 	}
+	if value, ok := uc.mutation.VerificationTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldVerificationTokenExpiresAt, field.TypeTime, value)
+		_node.VerificationTokenExpiresAt = &value
+	}
+	if value, ok := uc.mutation.ResetToken(); ok {
+		_spec.SetField(user.FieldResetToken, field.TypeString, value)
+		_node.ResetToken = &value
+	}
+	if value, ok := uc.mutation.ResetTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldResetTokenExpiresAt, field.TypeTime, value)
+		_node.ResetTokenExpiresAt = &value
+	}
+	if value, ok := uc.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+		_node.PendingEmail = &value
+	}
+	if value, ok := uc.mutation.EmailChangeToken(); ok {
+		_spec.SetField(user.FieldEmailChangeToken, field.TypeString, value)
+		_node.EmailChangeToken = &value
+	}
+	if value, ok := uc.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+		_node.EmailChangeExpiresAt = &value
+	}
+	if value, ok := uc.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+		_node.FailedLoginCount = value
+	}
+	if value, ok := uc.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := uc.mutation.ProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -316,6 +578,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
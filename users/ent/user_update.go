@@ -7,13 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"users/ent/notificationpreferences"
 	"users/ent/predicate"
 	"users/ent/profile"
+	"users/ent/refreshtoken"
 	"users/ent/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -29,6 +32,20 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// SetEmail sets the "email" field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
 // SetUsername sets the "username" field.
 func (uu *UserUpdate) SetUsername(s string) *UserUpdate {
 	uu.mutation.SetUsername(s)
@@ -57,6 +74,26 @@ func (uu *UserUpdate) SetNillablePasswordHash(s *string) *UserUpdate {
 	return uu
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uu *UserUpdate) SetPasswordChangedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPasswordChangedAt(t)
+	return uu
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePasswordChangedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPasswordChangedAt(*t)
+	}
+	return uu
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uu *UserUpdate) ClearPasswordChangedAt() *UserUpdate {
+	uu.mutation.ClearPasswordChangedAt()
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -77,6 +114,20 @@ func (uu *UserUpdate) SetNillableIsActive(b *bool) *UserUpdate {
 	return uu
 }
 
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
 // SetEmailVerified sets the "email_verified" field.
 func (uu *UserUpdate) SetEmailVerified(b bool) *UserUpdate {
 	uu.mutation.SetEmailVerified(b)
@@ -111,6 +162,187 @@ func (uu *UserUpdate) ClearVerificationToken() *UserUpdate {
 	return uu
 }
 
+// SetVerificationTokenExpiresAt sets the "verification_token_expires_at" field.
+func (uu *UserUpdate) SetVerificationTokenExpiresAt(t time.Time) *UserUpdate {
+	uu.mutation.SetVerificationTokenExpiresAt(t)
+	return uu
+}
+
+// SetNillableVerificationTokenExpiresAt sets the "verification_token_expires_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVerificationTokenExpiresAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetVerificationTokenExpiresAt(*t)
+	}
+	return uu
+}
+
+// ClearVerificationTokenExpiresAt clears the value of the "verification_token_expires_at" field.
+func (uu *UserUpdate) ClearVerificationTokenExpiresAt() *UserUpdate {
+	uu.mutation.ClearVerificationTokenExpiresAt()
+	return uu
+}
+
+// SetResetToken sets the "reset_token" field.
+func (uu *UserUpdate) SetResetToken(s string) *UserUpdate {
+	uu.mutation.SetResetToken(s)
+	return uu
+}
+
+// SetNillableResetToken sets the "reset_token" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableResetToken(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetResetToken(*s)
+	}
+	return uu
+}
+
+// ClearResetToken clears the value of the "reset_token" field.
+func (uu *UserUpdate) ClearResetToken() *UserUpdate {
+	uu.mutation.ClearResetToken()
+	return uu
+}
+
+// SetResetTokenExpiresAt sets the "reset_token_expires_at" field.
+func (uu *UserUpdate) SetResetTokenExpiresAt(t time.Time) *UserUpdate {
+	uu.mutation.SetResetTokenExpiresAt(t)
+	return uu
+}
+
+// SetNillableResetTokenExpiresAt sets the "reset_token_expires_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableResetTokenExpiresAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetResetTokenExpiresAt(*t)
+	}
+	return uu
+}
+
+// ClearResetTokenExpiresAt clears the value of the "reset_token_expires_at" field.
+func (uu *UserUpdate) ClearResetTokenExpiresAt() *UserUpdate {
+	uu.mutation.ClearResetTokenExpiresAt()
+	return uu
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (uu *UserUpdate) SetPendingEmail(s string) *UserUpdate {
+	uu.mutation.SetPendingEmail(s)
+	return uu
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePendingEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPendingEmail(*s)
+	}
+	return uu
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (uu *UserUpdate) ClearPendingEmail() *UserUpdate {
+	uu.mutation.ClearPendingEmail()
+	return uu
+}
+
+// SetEmailChangeToken sets the "email_change_token" field.
+func (uu *UserUpdate) SetEmailChangeToken(s string) *UserUpdate {
+	uu.mutation.SetEmailChangeToken(s)
+	return uu
+}
+
+// SetNillableEmailChangeToken sets the "email_change_token" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailChangeToken(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmailChangeToken(*s)
+	}
+	return uu
+}
+
+// ClearEmailChangeToken clears the value of the "email_change_token" field.
+func (uu *UserUpdate) ClearEmailChangeToken() *UserUpdate {
+	uu.mutation.ClearEmailChangeToken()
+	return uu
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (uu *UserUpdate) SetEmailChangeExpiresAt(t time.Time) *UserUpdate {
+	uu.mutation.SetEmailChangeExpiresAt(t)
+	return uu
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailChangeExpiresAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetEmailChangeExpiresAt(*t)
+	}
+	return uu
+}
+
+// ClearEmailChangeExpiresAt clears the value of the "email_change_expires_at" field.
+func (uu *UserUpdate) ClearEmailChangeExpiresAt() *UserUpdate {
+	uu.mutation.ClearEmailChangeExpiresAt()
+	return uu
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (uu *UserUpdate) SetFailedLoginCount(i int) *UserUpdate {
+	uu.mutation.ResetFailedLoginCount()
+	uu.mutation.SetFailedLoginCount(i)
+	return uu
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFailedLoginCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFailedLoginCount(*i)
+	}
+	return uu
+}
+
+// AddFailedLoginCount adds i to the "failed_login_count" field.
+func (uu *UserUpdate) AddFailedLoginCount(i int) *UserUpdate {
+	uu.mutation.AddFailedLoginCount(i)
+	return uu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uu *UserUpdate) SetLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetLockedUntil(t)
+	return uu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uu *UserUpdate) ClearLockedUntil() *UserUpdate {
+	uu.mutation.ClearLockedUntil()
+	return uu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
 // SetProfileID sets the "profile" edge to the Profile entity by ID.
 func (uu *UserUpdate) SetProfileID(id int) *UserUpdate {
 	uu.mutation.SetProfileID(id)
@@ -130,6 +362,40 @@ func (uu *UserUpdate) SetProfile(p *Profile) *UserUpdate {
 	return uu.SetProfileID(p.ID)
 }
 
+// SetNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID.
+func (uu *UserUpdate) SetNotificationPreferencesID(id int) *UserUpdate {
+	uu.mutation.SetNotificationPreferencesID(id)
+	return uu
+}
+
+// SetNillableNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableNotificationPreferencesID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetNotificationPreferencesID(*id)
+	}
+	return uu
+}
+
+// SetNotificationPreferences sets the "notification_preferences" edge to the NotificationPreferences entity.
+func (uu *UserUpdate) SetNotificationPreferences(n *NotificationPreferences) *UserUpdate {
+	return uu.SetNotificationPreferencesID(n.ID)
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (uu *UserUpdate) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddRefreshTokenIDs(ids...)
+	return uu
+}
+
+// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) AddRefreshTokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRefreshTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -141,6 +407,33 @@ func (uu *UserUpdate) ClearProfile() *UserUpdate {
 	return uu
 }
 
+// ClearNotificationPreferences clears the "notification_preferences" edge to the NotificationPreferences entity.
+func (uu *UserUpdate) ClearNotificationPreferences() *UserUpdate {
+	uu.mutation.ClearNotificationPreferences()
+	return uu
+}
+
+// ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) ClearRefreshTokens() *UserUpdate {
+	uu.mutation.ClearRefreshTokens()
+	return uu
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to RefreshToken entities by IDs.
+func (uu *UserUpdate) RemoveRefreshTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveRefreshTokenIDs(ids...)
+	return uu
+}
+
+// RemoveRefreshTokens removes "refresh_tokens" edges to RefreshToken entities.
+func (uu *UserUpdate) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRefreshTokenIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -189,6 +482,16 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.FailedLoginCount(); ok {
+		if err := user.FailedLoginCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_count", err: fmt.Errorf(`ent: validator failed for field "User.failed_login_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -204,18 +507,30 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
 	if value, ok := uu.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
 	if value, ok := uu.mutation.PasswordHash(); ok {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uu.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if value, ok := uu.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := uu.mutation.EmailVerified(); ok {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 	}
@@ -225,6 +540,60 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.VerificationTokenCleared() {
 		_spec.ClearField(user.FieldVerificationToken, field.TypeString)
 	}
+	if value, ok := uu.mutation.VerificationTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldVerificationTokenExpiresAt, field.TypeTime, value)
+	}
+	if uu.mutation.VerificationTokenExpiresAtCleared() {
+		_spec.ClearField(user.FieldVerificationTokenExpiresAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.ResetToken(); ok {
+		_spec.SetField(user.FieldResetToken, field.TypeString, value)
+	}
+	if uu.mutation.ResetTokenCleared() {
+		_spec.ClearField(user.FieldResetToken, field.TypeString)
+	}
+	if value, ok := uu.mutation.ResetTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldResetTokenExpiresAt, field.TypeTime, value)
+	}
+	if uu.mutation.ResetTokenExpiresAtCleared() {
+		_spec.ClearField(user.FieldResetTokenExpiresAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if uu.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := uu.mutation.EmailChangeToken(); ok {
+		_spec.SetField(user.FieldEmailChangeToken, field.TypeString, value)
+	}
+	if uu.mutation.EmailChangeTokenCleared() {
+		_spec.ClearField(user.FieldEmailChangeToken, field.TypeString)
+	}
+	if value, ok := uu.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+	}
+	if uu.mutation.EmailChangeExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeExpiresAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFailedLoginCount(); ok {
+		_spec.AddField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if uu.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -254,6 +623,80 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -274,6 +717,20 @@ type UserUpdateOne struct {
 	mutation *UserMutation
 }
 
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
 // SetUsername sets the "username" field.
 func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
 	uuo.mutation.SetUsername(s)
@@ -302,6 +759,26 @@ func (uuo *UserUpdateOne) SetNillablePasswordHash(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uuo *UserUpdateOne) SetPasswordChangedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPasswordChangedAt(t)
+	return uuo
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordChangedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPasswordChangedAt(*t)
+	}
+	return uuo
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uuo *UserUpdateOne) ClearPasswordChangedAt() *UserUpdateOne {
+	uuo.mutation.ClearPasswordChangedAt()
+	return uuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetUpdatedAt(t)
@@ -322,6 +799,20 @@ func (uuo *UserUpdateOne) SetNillableIsActive(b *bool) *UserUpdateOne {
 	return uuo
 }
 
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
 // SetEmailVerified sets the "email_verified" field.
 func (uuo *UserUpdateOne) SetEmailVerified(b bool) *UserUpdateOne {
 	uuo.mutation.SetEmailVerified(b)
@@ -356,6 +847,187 @@ func (uuo *UserUpdateOne) ClearVerificationToken() *UserUpdateOne {
 	return uuo
 }
 
+// SetVerificationTokenExpiresAt sets the "verification_token_expires_at" field.
+func (uuo *UserUpdateOne) SetVerificationTokenExpiresAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetVerificationTokenExpiresAt(t)
+	return uuo
+}
+
+// SetNillableVerificationTokenExpiresAt sets the "verification_token_expires_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerificationTokenExpiresAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetVerificationTokenExpiresAt(*t)
+	}
+	return uuo
+}
+
+// ClearVerificationTokenExpiresAt clears the value of the "verification_token_expires_at" field.
+func (uuo *UserUpdateOne) ClearVerificationTokenExpiresAt() *UserUpdateOne {
+	uuo.mutation.ClearVerificationTokenExpiresAt()
+	return uuo
+}
+
+// SetResetToken sets the "reset_token" field.
+func (uuo *UserUpdateOne) SetResetToken(s string) *UserUpdateOne {
+	uuo.mutation.SetResetToken(s)
+	return uuo
+}
+
+// SetNillableResetToken sets the "reset_token" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableResetToken(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetResetToken(*s)
+	}
+	return uuo
+}
+
+// ClearResetToken clears the value of the "reset_token" field.
+func (uuo *UserUpdateOne) ClearResetToken() *UserUpdateOne {
+	uuo.mutation.ClearResetToken()
+	return uuo
+}
+
+// SetResetTokenExpiresAt sets the "reset_token_expires_at" field.
+func (uuo *UserUpdateOne) SetResetTokenExpiresAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetResetTokenExpiresAt(t)
+	return uuo
+}
+
+// SetNillableResetTokenExpiresAt sets the "reset_token_expires_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableResetTokenExpiresAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetResetTokenExpiresAt(*t)
+	}
+	return uuo
+}
+
+// ClearResetTokenExpiresAt clears the value of the "reset_token_expires_at" field.
+func (uuo *UserUpdateOne) ClearResetTokenExpiresAt() *UserUpdateOne {
+	uuo.mutation.ClearResetTokenExpiresAt()
+	return uuo
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (uuo *UserUpdateOne) SetPendingEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetPendingEmail(s)
+	return uuo
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePendingEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPendingEmail(*s)
+	}
+	return uuo
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (uuo *UserUpdateOne) ClearPendingEmail() *UserUpdateOne {
+	uuo.mutation.ClearPendingEmail()
+	return uuo
+}
+
+// SetEmailChangeToken sets the "email_change_token" field.
+func (uuo *UserUpdateOne) SetEmailChangeToken(s string) *UserUpdateOne {
+	uuo.mutation.SetEmailChangeToken(s)
+	return uuo
+}
+
+// SetNillableEmailChangeToken sets the "email_change_token" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailChangeToken(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmailChangeToken(*s)
+	}
+	return uuo
+}
+
+// ClearEmailChangeToken clears the value of the "email_change_token" field.
+func (uuo *UserUpdateOne) ClearEmailChangeToken() *UserUpdateOne {
+	uuo.mutation.ClearEmailChangeToken()
+	return uuo
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (uuo *UserUpdateOne) SetEmailChangeExpiresAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetEmailChangeExpiresAt(t)
+	return uuo
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailChangeExpiresAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetEmailChangeExpiresAt(*t)
+	}
+	return uuo
+}
+
+// ClearEmailChangeExpiresAt clears the value of the "email_change_expires_at" field.
+func (uuo *UserUpdateOne) ClearEmailChangeExpiresAt() *UserUpdateOne {
+	uuo.mutation.ClearEmailChangeExpiresAt()
+	return uuo
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (uuo *UserUpdateOne) SetFailedLoginCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetFailedLoginCount()
+	uuo.mutation.SetFailedLoginCount(i)
+	return uuo
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFailedLoginCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFailedLoginCount(*i)
+	}
+	return uuo
+}
+
+// AddFailedLoginCount adds i to the "failed_login_count" field.
+func (uuo *UserUpdateOne) AddFailedLoginCount(i int) *UserUpdateOne {
+	uuo.mutation.AddFailedLoginCount(i)
+	return uuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uuo *UserUpdateOne) SetLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLockedUntil(t)
+	return uuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uuo *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearLockedUntil()
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
 // SetProfileID sets the "profile" edge to the Profile entity by ID.
 func (uuo *UserUpdateOne) SetProfileID(id int) *UserUpdateOne {
 	uuo.mutation.SetProfileID(id)
@@ -375,6 +1047,40 @@ func (uuo *UserUpdateOne) SetProfile(p *Profile) *UserUpdateOne {
 	return uuo.SetProfileID(p.ID)
 }
 
+// SetNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID.
+func (uuo *UserUpdateOne) SetNotificationPreferencesID(id int) *UserUpdateOne {
+	uuo.mutation.SetNotificationPreferencesID(id)
+	return uuo
+}
+
+// SetNillableNotificationPreferencesID sets the "notification_preferences" edge to the NotificationPreferences entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNotificationPreferencesID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetNotificationPreferencesID(*id)
+	}
+	return uuo
+}
+
+// SetNotificationPreferences sets the "notification_preferences" edge to the NotificationPreferences entity.
+func (uuo *UserUpdateOne) SetNotificationPreferences(n *NotificationPreferences) *UserUpdateOne {
+	return uuo.SetNotificationPreferencesID(n.ID)
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (uuo *UserUpdateOne) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddRefreshTokenIDs(ids...)
+	return uuo
+}
+
+// AddRefreshTokens adds the "refresh_tokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) AddRefreshTokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRefreshTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -386,6 +1092,33 @@ func (uuo *UserUpdateOne) ClearProfile() *UserUpdateOne {
 	return uuo
 }
 
+// ClearNotificationPreferences clears the "notification_preferences" edge to the NotificationPreferences entity.
+func (uuo *UserUpdateOne) ClearNotificationPreferences() *UserUpdateOne {
+	uuo.mutation.ClearNotificationPreferences()
+	return uuo
+}
+
+// ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) ClearRefreshTokens() *UserUpdateOne {
+	uuo.mutation.ClearRefreshTokens()
+	return uuo
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to RefreshToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveRefreshTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveRefreshTokens removes "refresh_tokens" edges to RefreshToken entities.
+func (uuo *UserUpdateOne) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRefreshTokenIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -447,6 +1180,16 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.FailedLoginCount(); ok {
+		if err := user.FailedLoginCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_login_count", err: fmt.Errorf(`ent: validator failed for field "User.failed_login_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -479,18 +1222,30 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
 	if value, ok := uuo.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
 	if value, ok := uuo.mutation.PasswordHash(); ok {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if value, ok := uuo.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := uuo.mutation.EmailVerified(); ok {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 	}
@@ -500,6 +1255,60 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.VerificationTokenCleared() {
 		_spec.ClearField(user.FieldVerificationToken, field.TypeString)
 	}
+	if value, ok := uuo.mutation.VerificationTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldVerificationTokenExpiresAt, field.TypeTime, value)
+	}
+	if uuo.mutation.VerificationTokenExpiresAtCleared() {
+		_spec.ClearField(user.FieldVerificationTokenExpiresAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.ResetToken(); ok {
+		_spec.SetField(user.FieldResetToken, field.TypeString, value)
+	}
+	if uuo.mutation.ResetTokenCleared() {
+		_spec.ClearField(user.FieldResetToken, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ResetTokenExpiresAt(); ok {
+		_spec.SetField(user.FieldResetTokenExpiresAt, field.TypeTime, value)
+	}
+	if uuo.mutation.ResetTokenExpiresAtCleared() {
+		_spec.ClearField(user.FieldResetTokenExpiresAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if uuo.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.EmailChangeToken(); ok {
+		_spec.SetField(user.FieldEmailChangeToken, field.TypeString, value)
+	}
+	if uuo.mutation.EmailChangeTokenCleared() {
+		_spec.ClearField(user.FieldEmailChangeToken, field.TypeString)
+	}
+	if value, ok := uuo.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+	}
+	if uuo.mutation.EmailChangeExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeExpiresAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFailedLoginCount(); ok {
+		_spec.AddField(user.FieldFailedLoginCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if uuo.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -529,6 +1338,80 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreferences.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uuo.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
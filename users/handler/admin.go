@@ -4,10 +4,10 @@ package handler
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/google/uuid"
+	log "go-micro.dev/v5/logger"
 	"golang.org/x/crypto/bcrypt"
 
 	"users/ent"
@@ -18,24 +18,26 @@ import (
 // AdminService implements the AdminServiceServer interface
 type AdminService struct {
 	EntClient *ent.Client // Entgo client instance
+	// Purger hard-deletes users past the soft-delete retention for PurgeDeletedUsers; nil disables that RPC
+	Purger *UserPurger
 }
 
 // ForceDeleteUser handles the forced deletion of a user (admin privilege)
 func (h *AdminService) ForceDeleteUser(ctx context.Context, req *pb.ForceDeleteUserRequest, rsp *pb.ForceDeleteUserResponse) error {
-	log.Printf("Received ForceDeleteUser request for ID: %s (Admin operation)", req.Id)
+	log.Extract(ctx).Infof("Received ForceDeleteUser request for ID: %s (Admin operation)", req.Id)
 
 	// Start a transaction to ensure atomicity of user and profile deletion
 	tx, err := h.EntClient.Tx(ctx)
 	if err != nil {
-		log.Printf("Failed to start transaction for force delete: %v", err)
+		log.Extract(ctx).Errorf("Failed to start transaction for force delete: %v", err)
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer tx.Rollback() // Rollback if an error occurs
 
 	// Find the user to get their profile ID (if it exists)
-	u, err := tx.User.Query().Where(user.ID(uuid.MustParse(req.Id))).WithProfile().Only(ctx)
+	u, err := tx.User.Query().Where(user.ID(uuid.MustParse(req.Id))).WithProfile().WithNotificationPreferences().Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
-		log.Printf("Failed to query user for force delete: %v", err)
+		log.Extract(ctx).Errorf("Failed to query user for force delete: %v", err)
 		return fmt.Errorf("failed to query user: %w", err)
 	}
 
@@ -43,206 +45,447 @@ func (h *AdminService) ForceDeleteUser(ctx context.Context, req *pb.ForceDeleteU
 		// Delete the associated profile first due to foreign key constraints if not CASCADE
 		err = tx.Profile.DeleteOneID(u.Edges.Profile.ID).Exec(ctx)
 		if err != nil {
-			log.Printf("Failed to delete profile for user %s: %v", req.Id, err)
+			log.Extract(ctx).Errorf("Failed to delete profile for user %s: %v", req.Id, err)
 			return fmt.Errorf("failed to delete profile: %w", err)
 		}
-		log.Printf("Profile for user %s deleted successfully.", req.Id)
+		log.Extract(ctx).Infof("Profile for user %s deleted successfully.", req.Id)
+	}
+
+	if u != nil && u.Edges.NotificationPreferences != nil {
+		err = tx.NotificationPreferences.DeleteOneID(u.Edges.NotificationPreferences.ID).Exec(ctx)
+		if err != nil {
+			log.Extract(ctx).Errorf("Failed to delete notification preferences for user %s: %v", req.Id, err)
+			return fmt.Errorf("failed to delete notification preferences: %w", err)
+		}
 	}
 
 	// Now delete the user
 	err = tx.User.DeleteOneID(uuid.MustParse(req.Id)).Exec(ctx)
 	if ent.IsNotFound(err) {
-		log.Printf("User not found for forced deletion: %s", req.Id)
+		log.Extract(ctx).Infof("User not found for forced deletion: %s", req.Id)
 		rsp.Success = false
 		return fmt.Errorf("user not found for deletion: %w", err)
 	}
 	if err != nil {
-		log.Printf("Failed to force delete user: %v", err)
+		log.Extract(ctx).Errorf("Failed to force delete user: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to force delete user: %w", err)
 	}
 
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
-		log.Printf("Failed to commit transaction for force delete: %v", err)
+		log.Extract(ctx).Errorf("Failed to commit transaction for force delete: %v", err)
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	rsp.Id = req.Id
 	rsp.Success = true
-	log.Printf("User force deleted successfully: %s", req.Id)
+	log.Extract(ctx).Infof("User force deleted successfully: %s", req.Id)
 	return nil
 }
 
 // SuspendUser handles suspending a user by setting is_active to false (admin privilege)
 func (h *AdminService) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest, rsp *pb.SuspendUserResponse) error {
-	log.Printf("Received SuspendUser request for ID: %s (Admin operation)", req.Id)
+	log.Extract(ctx).Infof("Received SuspendUser request for ID: %s (Admin operation)", req.Id)
 
 	u, err := h.EntClient.User.UpdateOneID(uuid.MustParse(req.Id)).
 		SetIsActive(false).
 		Save(ctx)
 	if ent.IsNotFound(err) {
-		log.Printf("User not found for suspension: %s", req.Id)
+		log.Extract(ctx).Infof("User not found for suspension: %s", req.Id)
 		return fmt.Errorf("user not found for suspension: %w", err)
 	}
 	if err != nil {
-		log.Printf("Failed to suspend user: %v", err)
+		log.Extract(ctx).Errorf("Failed to suspend user: %v", err)
 		return fmt.Errorf("failed to suspend user: %w", err)
 	}
 
 	// Re-query user with profile to return complete user object
 	uWithProfile, err := h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
 	if err != nil {
-		log.Printf("Failed to retrieve user with profile after suspension: %v", err)
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after suspension: %v", err)
 		return fmt.Errorf("failed to retrieve user after suspension: %w", err)
 	}
 
 	rsp.User = toProtoUser(uWithProfile)
-	log.Printf("User suspended successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User suspended successfully: %s", u.ID)
 	return nil
 }
 
 // ActivateUser handles activating a user by setting is_active to true (admin privilege)
 func (h *AdminService) ActivateUser(ctx context.Context, req *pb.ActivateUserRequest, rsp *pb.ActivateUserResponse) error {
-	log.Printf("Received ActivateUser request for ID: %s (Admin operation)", req.Id)
+	log.Extract(ctx).Infof("Received ActivateUser request for ID: %s (Admin operation)", req.Id)
 
 	u, err := h.EntClient.User.UpdateOneID(uuid.MustParse(req.Id)).
 		SetIsActive(true).
 		Save(ctx)
 	if ent.IsNotFound(err) {
-		log.Printf("User not found for activation: %s", req.Id)
+		log.Extract(ctx).Infof("User not found for activation: %s", req.Id)
 		return fmt.Errorf("user not found for activation: %w", err)
 	}
 	if err != nil {
-		log.Printf("Failed to activate user: %v", err)
+		log.Extract(ctx).Errorf("Failed to activate user: %v", err)
 		return fmt.Errorf("failed to activate user: %w", err)
 	}
 
 	// Re-query user with profile to return complete user object
 	uWithProfile, err := h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
 	if err != nil {
-		log.Printf("Failed to retrieve user with profile after activation: %v", err)
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after activation: %v", err)
 		return fmt.Errorf("failed to retrieve user after activation: %w", err)
 	}
 
 	rsp.User = toProtoUser(uWithProfile)
-	log.Printf("User activated successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User activated successfully: %s", u.ID)
 	return nil
 }
 
-// BulkCreateUsers handles streaming creation of multiple users
+// UnlockUser lifts a lock placed by failed logins and starts their count over (admin privilege)
+func (h *AdminService) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest, rsp *pb.UnlockUserResponse) error {
+	log.Extract(ctx).Infof("Received UnlockUser request for ID: %s (Admin operation)", req.Id)
+
+	userID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.Id)
+	}
+
+	err = h.EntClient.User.UpdateOneID(userID).
+		SetFailedLoginCount(0).
+		ClearLockedUntil().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for unlocking: %s", req.Id)
+		return fmt.Errorf("user not found for unlocking: %w", err)
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to unlock user: %v", err)
+		return fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ID(userID)).WithProfile().Only(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after unlocking: %v", err)
+		return fmt.Errorf("failed to retrieve user after unlocking: %w", err)
+	}
+
+	rsp.User = toProtoUser(u)
+	log.Extract(ctx).Infof("User unlocked successfully: %s", req.Id)
+	return nil
+}
+
+// SetUserRole changes a user's role; tokens already issued keep the old role until they expire (admin privilege)
+func (h *AdminService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest, rsp *pb.SetUserRoleResponse) error {
+	log.Extract(ctx).Infof("Received SetUserRole request for ID: %s, role: %s (Admin operation)", req.Id, req.Role)
+
+	userID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.Id)
+	}
+	role := user.Role(req.Role)
+	if err := user.RoleValidator(role); err != nil {
+		return fmt.Errorf("invalid role: %s", req.Role)
+	}
+
+	err = h.EntClient.User.UpdateOneID(userID).
+		Where(user.DeletedAtIsNil()).
+		SetRole(role).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for role change: %s", req.Id)
+		return fmt.Errorf("user not found for role change: %w", err)
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to change role of user: %v", err)
+		return fmt.Errorf("failed to change role of user: %w", err)
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ID(userID)).WithProfile().Only(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after role change: %v", err)
+		return fmt.Errorf("failed to retrieve user after role change: %w", err)
+	}
+
+	rsp.User = toProtoUser(u)
+	log.Extract(ctx).Infof("Role of user %s set to %s", req.Id, role)
+	return nil
+}
+
+// RestoreUser clears the soft-delete marker set by DeleteUser (admin privilege)
+func (h *AdminService) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest, rsp *pb.RestoreUserResponse) error {
+	log.Extract(ctx).Infof("Received RestoreUser request for ID: %s (Admin operation)", req.Id)
+
+	userID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.Id)
+	}
+
+	err = h.EntClient.User.UpdateOneID(userID).
+		Where(user.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("No deleted user found for restoration: %s", req.Id)
+		return fmt.Errorf("deleted user not found: %s", req.Id)
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to restore user: %v", err)
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ID(userID)).WithProfile().Only(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after restoration: %v", err)
+		return fmt.Errorf("failed to retrieve user after restoration: %w", err)
+	}
+
+	rsp.User = toProtoUser(u)
+	log.Extract(ctx).Infof("User restored successfully: %s", req.Id)
+	return nil
+}
+
+// PurgeDeletedUsers hard-deletes users soft-deleted for longer than the retention window (admin privilege)
+func (h *AdminService) PurgeDeletedUsers(ctx context.Context, req *pb.PurgeDeletedUsersRequest, rsp *pb.PurgeDeletedUsersResponse) error {
+	log.Extract(ctx).Infof("Received PurgeDeletedUsers request (Admin operation)")
+
+	if h.Purger == nil || h.Purger.Retention <= 0 {
+		return fmt.Errorf("user purging is disabled")
+	}
+
+	purged, err := h.Purger.Purge(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to purge deleted users after purging %d: %v", purged, err)
+		return fmt.Errorf("failed to purge deleted users: %w", err)
+	}
+
+	rsp.Purged = int32(purged)
+	rsp.Retention = int64(h.Purger.Retention / time.Second)
+	log.Extract(ctx).Infof("Purged %d users deleted more than %s ago", purged, h.Purger.Retention)
+	return nil
+}
+
+// BulkCreateUsers handles streaming creation of multiple users. A user that fails to
+// create is skipped and reported in the response's failures, so the rest still go in.
 func (h *AdminService) BulkCreateUsers(ctx context.Context, stream pb.AdminService_BulkCreateUsersStream) error {
-	log.Printf("Received BulkCreateUsers stream request (Admin operation)")
+	log.Extract(ctx).Infof("Received BulkCreateUsers stream request (Admin operation)")
 	var createdUsers []*pb.User
+	var failures []*pb.BulkCreateUserFailure
 	var totalCreated int32
 
-	for {
+	for index := int32(0); ; index++ {
 		req := &pb.CreateUserRequest{}
 		err := stream.RecvMsg(req)
 		if err != nil {
-			if err == fmt.Errorf("EOF") { // go-micro uses EOF for end of stream
+			if err.Error() == "EOF" { // go-micro uses EOF for end of stream
 				break
 			}
-			log.Printf("Error receiving from BulkCreateUsers stream: %v", err)
+			log.Extract(ctx).Errorf("Error receiving from BulkCreateUsers stream: %v", err)
 			return fmt.Errorf("error receiving user data: %w", err)
 		}
 
-		log.Printf("Bulk creating user: %s (email: %s)", req.Username, req.Email)
+		log.Extract(ctx).Infof("Bulk creating user: %s (email: %s)", req.Username, req.Email)
 
-		// Hash the password
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		u, err := h.bulkCreateUser(ctx, req)
 		if err != nil {
-			log.Printf("BulkCreateUsers: Error hashing password for %s: %v", req.Username, err)
-			// Continue with other users, but log the error
+			log.Extract(ctx).Infof("BulkCreateUsers: Skipping %s: %v", req.Username, err)
+			failures = append(failures, &pb.BulkCreateUserFailure{
+				Index:    index,
+				Username: req.Username,
+				Email:    req.Email,
+				Reason:   err.Error(),
+			})
 			continue
 		}
 
-		// Generate a verification token for email verification
-		verificationToken := uuid.New().String()
+		createdUsers = append(createdUsers, toProtoUser(u))
+		totalCreated++
+	}
 
-		// Start a transaction for each user creation to ensure atomicity
-		tx, err := h.EntClient.Tx(ctx)
-		if err != nil {
-			log.Printf("BulkCreateUsers: Failed to start transaction for %s: %v", req.Username, err)
-			continue
-		}
+	// Send the final response containing all created users and the ones that failed
+	err := stream.SendMsg(&pb.BulkCreateUsersResponse{
+		Users:    createdUsers,
+		Total:    totalCreated,
+		Failures: failures,
+	})
+	if err != nil {
+		log.Extract(ctx).Errorf("Error sending BulkCreateUsers response: %v", err)
+		return fmt.Errorf("failed to send response: %w", err)
+	}
 
-		u, err := tx.User.
-			Create().
-			SetEmail(req.Email).
-			SetUsername(req.Username).
-			SetPasswordHash(string(hashedPassword)).
-			SetVerificationToken(verificationToken).
-			SetEmailVerified(false).
-			Save(ctx)
-
-		if ent.IsConstraintError(err) {
-			log.Printf("BulkCreateUsers: Constraint violation for user %s: %v", req.Username, err)
-			tx.Rollback()
-			continue
-		}
-		if err != nil {
-			log.Printf("BulkCreateUsers: Failed to create user %s: %v", req.Username, err)
-			tx.Rollback()
-			continue
-		}
+	log.Extract(ctx).Infof("BulkCreateUsers: Successfully created %d users, %d failed.", totalCreated, len(failures))
+	return nil
+}
 
-		profileCreator := tx.Profile.Create().SetUser(u)
-		if req.FirstName != "" {
-			profileCreator.SetFirstName(req.FirstName)
-		}
-		if req.LastName != "" {
-			profileCreator.SetLastName(req.LastName)
-		}
-		if req.DateOfBirth > 0 {
-			profileCreator.SetDateOfBirth(time.Unix(req.DateOfBirth, 0))
-		}
-		if req.Address != "" {
-			profileCreator.SetAddress(req.Address)
+// bulkCreateUser creates one user of a BulkCreateUsers stream with its profile and
+// returns it with the profile loaded. Its errors are reported back to the caller, so they
+// say what is wrong with the input rather than wrapping database errors.
+func (h *AdminService) bulkCreateUser(ctx context.Context, req *pb.CreateUserRequest) (*ent.User, error) {
+	if err := Limits.checkUser(req.Username, req.Email); err != nil {
+		return nil, err
+	}
+	if err := Limits.checkProfile(req.FirstName, req.LastName, req.Address, req.PhoneNumber); err != nil {
+		return nil, err
+	}
+
+	// Validate and normalize the email
+	email, err := normalizeEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash the password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Extract(ctx).Infof("BulkCreateUsers: Error hashing password for %s: %v", req.Username, err)
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Generate a verification token for email verification
+	verificationToken := uuid.New().String()
+
+	// Start a transaction for each user creation to ensure atomicity
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Infof("BulkCreateUsers: Failed to start transaction for %s: %v", req.Username, err)
+		return nil, fmt.Errorf("internal server error")
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.
+		Create().
+		SetEmail(email).
+		SetUsername(req.Username).
+		SetPasswordHash(string(hashedPassword)).
+		SetVerificationToken(verificationToken).
+		SetVerificationTokenExpiresAt(time.Now().Add(VerificationTokenTTL)).
+		SetEmailVerified(false).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		log.Extract(ctx).Infof("BulkCreateUsers: Constraint violation for user %s: %v", req.Username, err)
+		if msg, ok := uniqueViolationMessage(err); ok {
+			return nil, fmt.Errorf("%s", msg)
 		}
-		if req.PhoneNumber != "" {
-			profileCreator.SetPhoneNumber(req.PhoneNumber)
+		return nil, fmt.Errorf("user violates a constraint")
+	}
+	if err != nil {
+		log.Extract(ctx).Infof("BulkCreateUsers: Failed to create user %s: %v", req.Username, err)
+		if ent.IsValidationError(err) {
+			return nil, err
 		}
+		return nil, fmt.Errorf("internal server error")
+	}
 
-		_, err = profileCreator.Save(ctx)
-		if err != nil {
-			log.Printf("BulkCreateUsers: Failed to create profile for user %s: %v", u.ID, err)
-			tx.Rollback()
-			continue
-		}
+	_, err = newProfile(tx, u, req).Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Infof("BulkCreateUsers: Failed to create profile for user %s: %v", u.ID, err)
+		return nil, fmt.Errorf("failed to create profile")
+	}
 
-		if err = tx.Commit(); err != nil {
-			log.Printf("BulkCreateUsers: Failed to commit transaction for user %s: %v", u.ID, err)
-			continue
+	if err = tx.Commit(); err != nil {
+		log.Extract(ctx).Infof("BulkCreateUsers: Failed to commit transaction for user %s: %v", u.ID, err)
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	uWithProfile, err := h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
+	if err != nil {
+		// The user exists now; a failure here only affects what is reported
+		log.Extract(ctx).Infof("BulkCreateUsers: Failed to retrieve user with profile after creation %s: %v", u.ID, err)
+		return u, nil
+	}
+	return uWithProfile, nil
+}
+
+// BulkUpdateProfiles applies a stream of profile updates like UpdateProfile, creating the
+// profiles users don't have yet. An update that fails, e.g. for a missing user, is skipped
+// and reported in the response's failures, so the rest still go in.
+func (h *AdminService) BulkUpdateProfiles(ctx context.Context, stream pb.AdminService_BulkUpdateProfilesStream) error {
+	log.Extract(ctx).Infof("Received BulkUpdateProfiles stream request (Admin operation)")
+	var profiles []*pb.Profile
+	var failures []*pb.BulkUpdateProfileFailure
+
+	for index := int32(0); ; index++ {
+		req := &pb.UpdateProfileRequest{}
+		err := stream.RecvMsg(req)
+		if err != nil {
+			if err.Error() == "EOF" { // go-micro uses EOF for end of stream
+				break
+			}
+			log.Extract(ctx).Errorf("Error receiving from BulkUpdateProfiles stream: %v", err)
+			return fmt.Errorf("error receiving profile data: %w", err)
 		}
 
-		uWithProfile, err := h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
+		p, err := h.bulkUpdateProfile(ctx, req)
 		if err != nil {
-			log.Printf("BulkCreateUsers: Failed to retrieve user with profile after creation %s: %v", u.ID, err)
+			log.Extract(ctx).Infof("BulkUpdateProfiles: Skipping profile of user %s: %v", req.UserId, err)
+			failures = append(failures, &pb.BulkUpdateProfileFailure{
+				Index:  index,
+				UserId: req.UserId,
+				Reason: err.Error(),
+			})
 			continue
 		}
-
-		createdUsers = append(createdUsers, toProtoUser(uWithProfile))
-		totalCreated++
+		profiles = append(profiles, toProtoProfile(p))
 	}
 
-	// Send the final response containing all created users
-	err := stream.SendMsg(&pb.ListUsersResponse{
-		Users: createdUsers,
-		Total: totalCreated,
+	err := stream.SendMsg(&pb.BulkUpdateProfilesResponse{
+		Profiles: profiles,
+		Total:    int32(len(profiles)),
+		Failures: failures,
 	})
 	if err != nil {
-		log.Printf("Error sending BulkCreateUsers response: %v", err)
+		log.Extract(ctx).Errorf("Error sending BulkUpdateProfiles response: %v", err)
 		return fmt.Errorf("failed to send response: %w", err)
 	}
 
-	log.Printf("BulkCreateUsers: Successfully created %d users.", totalCreated)
+	log.Extract(ctx).Infof("BulkUpdateProfiles: Successfully saved %d profiles, %d failed.", len(profiles), len(failures))
 	return nil
 }
 
+// bulkUpdateProfile saves one profile of a BulkUpdateProfiles stream in its own
+// transaction. Like bulkCreateUser, its errors are reported back to the caller.
+func (h *AdminService) bulkUpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*ent.Profile, error) {
+	if err := Limits.checkProfile(req.FirstName, req.LastName, req.Address, req.PhoneNumber); err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Infof("BulkUpdateProfiles: Failed to start transaction for user %s: %v", req.UserId, err)
+		return nil, fmt.Errorf("internal server error")
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.ID(userID)).WithProfile().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Infof("BulkUpdateProfiles: Failed to get user %s: %v", req.UserId, err)
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	p, err := saveProfile(ctx, tx, u, req)
+	if err != nil {
+		log.Extract(ctx).Infof("BulkUpdateProfiles: Failed to save profile of user %s: %v", req.UserId, err)
+		if ent.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save profile")
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Extract(ctx).Infof("BulkUpdateProfiles: Failed to commit profile of user %s: %v", req.UserId, err)
+		return nil, fmt.Errorf("internal server error")
+	}
+	return p, nil
+}
+
 // ExportUsers streams all users, optionally filtered and paginated
 func (h *AdminService) ExportUsers(ctx context.Context, req *pb.ListUsersRequest, stream pb.AdminService_ExportUsersStream) error {
-	log.Printf("Received ExportUsers stream request (Admin operation) (limit: %d, offset: %d, filter: %s)", req.Limit, req.Offset, req.Filter)
+	log.Extract(ctx).Infof("Received ExportUsers stream request (Admin operation) (limit: %d, offset: %d, filter: %s)", req.Limit, req.Offset, req.Filter)
 
 	query := h.EntClient.User.Query().WithProfile() // Eager load profiles
 
@@ -265,18 +508,148 @@ func (h *AdminService) ExportUsers(ctx context.Context, req *pb.ListUsersRequest
 
 	users, err := query.All(ctx)
 	if err != nil {
-		log.Printf("Failed to retrieve users for export: %v", err)
+		log.Extract(ctx).Errorf("Failed to retrieve users for export: %v", err)
 		return fmt.Errorf("failed to retrieve users for export: %w", err)
 	}
 
 	for _, u := range users {
 		protoUser := toProtoUser(u)
 		if err := stream.Send(protoUser); err != nil {
-			log.Printf("Error sending user %s during export: %v", u.ID, err)
+			log.Extract(ctx).Errorf("Error sending user %s during export: %v", u.ID, err)
 			return fmt.Errorf("failed to stream user: %w", err)
 		}
 	}
 
-	log.Printf("Successfully exported %d users.", len(users))
+	log.Extract(ctx).Infof("Successfully exported %d users.", len(users))
+	return nil
+}
+
+// SearchUsers searches users like UserService.SearchUsers, including soft-deleted users when requested (admin privilege)
+func (h *AdminService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest, rsp *pb.SearchUsersResponse) error {
+	log.Extract(ctx).Infof("Received SearchUsers request (query: %s, limit: %d, offset: %d, include_deleted: %v) (Admin operation)", req.Query, req.Limit, req.Offset, req.IncludeDeleted)
+	return searchUsers(ctx, h.EntClient, req, req.IncludeDeleted, rsp)
+}
+
+// verificationAgeBuckets are the account age ranges, in days, reported by GetVerificationStats
+var verificationAgeBuckets = []struct {
+	label    string
+	min, max int
+}{
+	{"<1d", 0, 1},
+	{"1-7d", 1, 7},
+	{"7-30d", 7, 30},
+	{"30-90d", 30, 90},
+	{">=90d", 90, 0},
+}
+
+// GetVerificationStats reports verified/unverified user counts and the age distribution of unverified accounts
+func (h *AdminService) GetVerificationStats(ctx context.Context, req *pb.GetVerificationStatsRequest, rsp *pb.GetVerificationStatsResponse) error {
+	log.Extract(ctx).Infof("Received GetVerificationStats request (Admin operation)")
+
+	verified, err := h.EntClient.User.Query().Where(user.EmailVerified(true)).Count(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to count verified users: %v", err)
+		return fmt.Errorf("failed to count verified users: %w", err)
+	}
+	unverified, err := h.EntClient.User.Query().Where(user.EmailVerified(false)).Count(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to count unverified users: %v", err)
+		return fmt.Errorf("failed to count unverified users: %w", err)
+	}
+
+	now := time.Now()
+	buckets := make([]*pb.AgeBucket, len(verificationAgeBuckets))
+	for i, b := range verificationAgeBuckets {
+		// An account is older than N days when it was created before now-N days
+		query := h.EntClient.User.Query().
+			Where(
+				user.EmailVerified(false),
+				user.CreatedAtLTE(now.AddDate(0, 0, -b.min)),
+			)
+		if b.max > 0 {
+			query.Where(user.CreatedAtGT(now.AddDate(0, 0, -b.max)))
+		}
+		count, err := query.Count(ctx)
+		if err != nil {
+			log.Extract(ctx).Errorf("Failed to count unverified users in bucket %s: %v", b.label, err)
+			return fmt.Errorf("failed to count unverified users: %w", err)
+		}
+		buckets[i] = &pb.AgeBucket{
+			Label:      b.label,
+			MinAgeDays: int32(b.min),
+			MaxAgeDays: int32(b.max),
+			Count:      int32(count),
+		}
+	}
+
+	rsp.Verified = int32(verified)
+	rsp.Unverified = int32(unverified)
+	rsp.UnverifiedAgeBuckets = buckets
+	log.Extract(ctx).Infof("Verification stats: %d verified, %d unverified", verified, unverified)
+	return nil
+}
+
+// InvalidateAllTokens clears every outstanding verification, password reset and email
+// change token, e.g. after a token leak, so users must request new ones (admin privilege).
+// Pending email changes are dropped with their tokens.
+func (h *AdminService) InvalidateAllTokens(ctx context.Context, req *pb.InvalidateAllTokensRequest, rsp *pb.InvalidateTokensResponse) error {
+	log.Extract(ctx).Infof("Received InvalidateAllTokens request (Admin operation)")
+
+	n, err := h.EntClient.User.Update().
+		Where(user.Or(user.VerificationTokenNotNil(), user.ResetTokenNotNil(), user.EmailChangeTokenNotNil())).
+		ClearVerificationToken().
+		ClearVerificationTokenExpiresAt().
+		ClearResetToken().
+		ClearResetTokenExpiresAt().
+		ClearPendingEmail().
+		ClearEmailChangeToken().
+		ClearEmailChangeExpiresAt().
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to invalidate tokens: %v", err)
+		return fmt.Errorf("failed to invalidate tokens: %w", err)
+	}
+
+	rsp.Invalidated = int32(n)
+	log.Extract(ctx).Infof("Invalidated outstanding tokens of %d users", n)
+	return nil
+}
+
+// InvalidateUserTokens clears a single user's outstanding verification, password reset and email change tokens (admin privilege)
+func (h *AdminService) InvalidateUserTokens(ctx context.Context, req *pb.InvalidateUserTokensRequest, rsp *pb.InvalidateTokensResponse) error {
+	log.Extract(ctx).Infof("Received InvalidateUserTokens request for ID: %s (Admin operation)", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.UserId)
+	}
+
+	exists, err := h.EntClient.User.Query().Where(user.ID(userID)).Exist(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to look up user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if !exists {
+		log.Extract(ctx).Infof("User not found for token invalidation: %s", req.UserId)
+		return fmt.Errorf("user not found")
+	}
+
+	n, err := h.EntClient.User.Update().
+		Where(user.ID(userID), user.Or(user.VerificationTokenNotNil(), user.ResetTokenNotNil(), user.EmailChangeTokenNotNil())).
+		ClearVerificationToken().
+		ClearVerificationTokenExpiresAt().
+		ClearResetToken().
+		ClearResetTokenExpiresAt().
+		ClearPendingEmail().
+		ClearEmailChangeToken().
+		ClearEmailChangeExpiresAt().
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to invalidate tokens for user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to invalidate tokens: %w", err)
+	}
+
+	rsp.Invalidated = int32(n)
+	log.Extract(ctx).Infof("Invalidated outstanding tokens of user %s (%d cleared)", req.UserId, n)
 	return nil
 }
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"users/ent/user"
+	pb "users/proto"
+)
+
+func TestGetVerificationStatsBucketsUnverifiedAccounts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	createTestUser(t, client, "verified")
+	for i, age := range []time.Duration{
+		time.Hour,                // <1d
+		3 * 24 * time.Hour,       // 1-7d
+		5 * 24 * time.Hour,       // 1-7d
+		45 * 24 * time.Hour,      // 30-90d
+		2 * 365 * 24 * time.Hour, // >=90d
+	} {
+		name := "unverified" + string(rune('a'+i))
+		client.User.Create().
+			SetEmail(name + "@example.com").
+			SetUsername(name).
+			SetPasswordHash("unused").
+			SetCreatedAt(now.Add(-age)).
+			ExecX(ctx)
+	}
+
+	rsp := &pb.GetVerificationStatsResponse{}
+	if err := (&AdminService{EntClient: client}).GetVerificationStats(ctx, &pb.GetVerificationStatsRequest{}, rsp); err != nil {
+		t.Fatalf("GetVerificationStats: %v", err)
+	}
+	if rsp.Verified != 1 || rsp.Unverified != 5 {
+		t.Fatalf("expected 1 verified and 5 unverified, got %d and %d", rsp.Verified, rsp.Unverified)
+	}
+	want := map[string]int32{"<1d": 1, "1-7d": 2, "7-30d": 0, "30-90d": 1, ">=90d": 1}
+	if len(rsp.UnverifiedAgeBuckets) != len(want) {
+		t.Fatalf("expected %d buckets, got %d", len(want), len(rsp.UnverifiedAgeBuckets))
+	}
+	for _, b := range rsp.UnverifiedAgeBuckets {
+		if b.Count != want[b.Label] {
+			t.Errorf("bucket %s: expected %d accounts, got %d", b.Label, want[b.Label], b.Count)
+		}
+	}
+}
+
+func TestInvalidateTokensStopsOutstandingTokensWorking(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	admin := &AdminService{EntClient: client}
+
+	// issue gives u a fresh verification token and b a fresh reset token
+	u := createTestUser(t, client, "unverified")
+	b := createTestUser(t, client, "resetting")
+	createTestUser(t, client, "bystander")
+	issue := func() (verifyToken, resetToken string) {
+		t.Helper()
+		client.User.UpdateOne(u).
+			SetEmailVerified(false).
+			SetVerificationToken("verify-" + time.Now().String()).
+			SetVerificationTokenExpiresAt(time.Now().Add(time.Hour)).
+			ExecX(ctx)
+		if err := h.ResetPassword(ctx, &pb.ResetPasswordRequest{Email: b.Email}, &pb.ResetPasswordResponse{}); err != nil {
+			t.Fatalf("ResetPassword: %v", err)
+		}
+		return *client.User.GetX(ctx, u.ID).VerificationToken, *client.User.GetX(ctx, b.ID).ResetToken
+	}
+
+	verifyToken, resetToken := issue()
+	rsp := &pb.InvalidateTokensResponse{}
+	if err := admin.InvalidateAllTokens(ctx, &pb.InvalidateAllTokensRequest{}, rsp); err != nil {
+		t.Fatalf("InvalidateAllTokens: %v", err)
+	}
+	if rsp.Invalidated != 2 {
+		t.Fatalf("expected 2 users' tokens invalidated, got %d", rsp.Invalidated)
+	}
+	if err := h.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: verifyToken}, &pb.VerifyEmailResponse{}); err == nil {
+		t.Fatal("expected an invalidated verification token to be rejected")
+	}
+	err := h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: resetToken, NewPassword: "newpassword1"}, &pb.ConfirmPasswordResetResponse{})
+	if err == nil {
+		t.Fatal("expected an invalidated reset token to be rejected")
+	}
+
+	// Invalidating one user's tokens leaves everyone else's working
+	verifyToken, resetToken = issue()
+	rsp = &pb.InvalidateTokensResponse{}
+	if err := admin.InvalidateUserTokens(ctx, &pb.InvalidateUserTokensRequest{UserId: u.ID.String()}, rsp); err != nil {
+		t.Fatalf("InvalidateUserTokens: %v", err)
+	}
+	if rsp.Invalidated != 1 {
+		t.Fatalf("expected 1 user's tokens invalidated, got %d", rsp.Invalidated)
+	}
+	if err := h.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: verifyToken}, &pb.VerifyEmailResponse{}); err == nil {
+		t.Fatal("expected the invalidated user's verification token to be rejected")
+	}
+	err = h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: resetToken, NewPassword: "newpassword1"}, &pb.ConfirmPasswordResetResponse{})
+	if err != nil {
+		t.Fatalf("expected another user's reset token to keep working, got %v", err)
+	}
+}
+
+func TestSearchUsersHidesDeletedUsersFromNonAdmins(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	admin := &AdminService{EntClient: client}
+
+	createTestUser(t, client, "shopper1")
+	createTestUser(t, client, "shopper2")
+	gone := createTestUser(t, client, "shopper3")
+	client.User.UpdateOne(gone).SetDeletedAt(time.Now()).ExecX(ctx)
+
+	tests := []struct {
+		name           string
+		fn             func(context.Context, *pb.SearchUsersRequest, *pb.SearchUsersResponse) error
+		includeDeleted bool
+		wantTotal      int32
+	}{
+		{"User.SearchUsers", h.SearchUsers, false, 2},
+		{"User.SearchUsers with include_deleted", h.SearchUsers, true, 2},
+		{"AdminService.SearchUsers", admin.SearchUsers, false, 2},
+		{"AdminService.SearchUsers with include_deleted", admin.SearchUsers, true, 3},
+	}
+	for _, tt := range tests {
+		rsp := &pb.SearchUsersResponse{}
+		if err := tt.fn(ctx, &pb.SearchUsersRequest{Query: "shopper", Limit: 1, IncludeDeleted: tt.includeDeleted}, rsp); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if rsp.Total != tt.wantTotal || len(rsp.Users) != 1 {
+			t.Errorf("%s: expected a page of 1 of %d users, got %d of %d", tt.name, tt.wantTotal, len(rsp.Users), rsp.Total)
+		}
+	}
+
+	rsp := &pb.SearchUsersResponse{}
+	if err := admin.SearchUsers(ctx, &pb.SearchUsersRequest{Query: "shopper3", IncludeDeleted: true}, rsp); err != nil {
+		t.Fatalf("SearchUsers: %v", err)
+	}
+	if len(rsp.Users) != 1 || rsp.Users[0].DeletedAt == 0 {
+		t.Fatalf("expected the deleted user returned with its deletion time, got %v", rsp.Users)
+	}
+}
+
+// fakeProfileStream feeds BulkUpdateProfiles its updates and keeps the response it sends
+type fakeProfileStream struct {
+	pb.AdminService_BulkUpdateProfilesStream
+	updates []*pb.UpdateProfileRequest
+	rsp     *pb.BulkUpdateProfilesResponse
+}
+
+func (s *fakeProfileStream) RecvMsg(m interface{}) error {
+	if len(s.updates) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(*pb.UpdateProfileRequest), s.updates[0])
+	s.updates = s.updates[1:]
+	return nil
+}
+
+func (s *fakeProfileStream) SendMsg(m interface{}) error {
+	s.rsp = m.(*pb.BulkUpdateProfilesResponse)
+	return nil
+}
+
+func TestBulkUpdateProfilesReportsMissingUsers(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &AdminService{EntClient: client}
+
+	withProfile := createTestUser(t, client, "withprofile")
+	client.Profile.Create().SetUser(withProfile).SetFirstName("Ada").SetLastName("Lovelace").ExecX(ctx)
+	withoutProfile := createTestUser(t, client, "withoutprofile")
+	missing := uuid.NewString()
+
+	stream := &fakeProfileStream{updates: []*pb.UpdateProfileRequest{
+		{UserId: withProfile.ID.String(), PhoneNumber: "+15550100"},
+		{UserId: missing, PhoneNumber: "+15550101"},
+		{UserId: withoutProfile.ID.String(), FirstName: "Grace", PhoneNumber: "+15550102"},
+		{UserId: "not-a-uuid", PhoneNumber: "+15550103"},
+	}}
+	if err := h.BulkUpdateProfiles(ctx, stream); err != nil {
+		t.Fatalf("BulkUpdateProfiles: %v", err)
+	}
+
+	if stream.rsp.Total != 2 || len(stream.rsp.Profiles) != 2 {
+		t.Fatalf("expected 2 profiles saved, got %d", stream.rsp.Total)
+	}
+	want := map[int32]string{1: missing, 3: "not-a-uuid"}
+	if len(stream.rsp.Failures) != len(want) {
+		t.Fatalf("expected %d failures, got %v", len(want), stream.rsp.Failures)
+	}
+	for _, f := range stream.rsp.Failures {
+		if want[f.Index] != f.UserId || f.Reason == "" {
+			t.Errorf("unexpected failure %v", f)
+		}
+	}
+	if f := stream.rsp.Failures[0]; f.Reason != "user not found" {
+		t.Errorf("expected the missing user reported not found, got %q", f.Reason)
+	}
+
+	// Updates only set the fields given, and create profiles users lack
+	p := toProtoProfile(client.User.Query().Where(user.ID(withProfile.ID)).QueryProfile().OnlyX(ctx))
+	if p.FirstName != "Ada" || p.PhoneNumber != "+15550100" {
+		t.Fatalf("expected the existing profile updated in place, got %q, %q", p.FirstName, p.PhoneNumber)
+	}
+	p = toProtoProfile(client.User.Query().Where(user.ID(withoutProfile.ID)).QueryProfile().OnlyX(ctx))
+	if p.FirstName != "Grace" || p.PhoneNumber != "+15550102" {
+		t.Fatalf("expected a profile created, got %q, %q", p.FirstName, p.PhoneNumber)
+	}
+}
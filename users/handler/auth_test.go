@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/metadata"
+	"go-micro.dev/v5/server"
+
+	"users/ent"
+	"users/ent/user"
+)
+
+// endpointRequest is a server.Request that only knows its endpoint
+type endpointRequest struct {
+	server.Request
+	endpoint string
+}
+
+func (r endpointRequest) Endpoint() string { return r.endpoint }
+
+func TestAdminAuthWrapper(t *testing.T) {
+	SigningKey = []byte("test-key")
+	now := time.Now()
+	admin := &ent.User{ID: uuid.New(), Role: user.RoleAdmin}
+	member := &ent.User{ID: uuid.New(), Role: user.RoleUser}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		token    string
+		code     int32 // 0 when the call reaches the handler
+	}{
+		{"admin token", "AdminService.SuspendUser", accessToken(admin, now), 0},
+		{"missing token", "AdminService.SuspendUser", "", http.StatusUnauthorized},
+		{"expired token", "AdminService.SuspendUser", accessToken(admin, now.Add(-AccessTokenTTL-time.Minute)), http.StatusUnauthorized},
+		{"tampered token", "AdminService.SuspendUser", accessToken(member, now)[:20] + "x", http.StatusUnauthorized},
+		{"non-admin token", "AdminService.SuspendUser", accessToken(member, now), http.StatusForbidden},
+		{"user service without token", "UserService.GetUser", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handle := AdminAuthWrapper()(func(ctx context.Context, req server.Request, rsp interface{}) error {
+				called = true
+				return nil
+			})
+
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewContext(ctx, metadata.Metadata{"Authorization": "Bearer " + tt.token})
+			}
+			err := handle(ctx, endpointRequest{endpoint: tt.endpoint}, nil)
+
+			if tt.code == 0 {
+				if err != nil || !called {
+					t.Fatalf("expected the call to reach the handler, got error %v", err)
+				}
+				return
+			}
+			if called {
+				t.Fatal("handler was called despite the rejection")
+			}
+			if merr := errors.FromError(err); merr.Code != tt.code {
+				t.Fatalf("expected code %d, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestPromoteAdmins(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+
+	n, err := PromoteAdmins(ctx, client, []string{" Alice@Example.com ", "nobody@example.com", ""})
+	if err != nil {
+		t.Fatalf("PromoteAdmins: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 user promoted, got %d", n)
+	}
+	if role := client.User.GetX(ctx, alice.ID).Role; role != user.RoleAdmin {
+		t.Fatalf("expected alice to be an admin, got %s", role)
+	}
+	if role := client.User.GetX(ctx, bob.ID).Role; role != user.RoleUser {
+		t.Fatalf("expected bob to stay a user, got %s", role)
+	}
+
+	// Applying the same list again on the next start changes nothing
+	if n, err := PromoteAdmins(ctx, client, []string{"alice@example.com"}); err != nil || n != 0 {
+		t.Fatalf("expected no further promotions, got %d, %v", n, err)
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"strings"
+
+	"go-micro.dev/v5/errors"
+
+	"users/ent"
+)
+
+// UniqueViolations maps a unique column, as "table.column", to the message returned when a
+// write collides with an existing row; main may add entries or reword them
+var UniqueViolations = map[string]string{
+	"users.email":    "email already in use",
+	"users.username": "username taken",
+}
+
+// uniqueViolation returns an AlreadyExists (409) error carrying the message of the column in
+// UniqueViolations that err violates, so callers never see the raw database text, or nil
+// if err is not such a violation
+func uniqueViolation(id string, err error) error {
+	if friendly, ok := uniqueViolationMessage(err); ok {
+		return errors.Conflict(id, "%s", friendly)
+	}
+	return nil
+}
+
+// uniqueViolationMessage returns the message in UniqueViolations of the column err violates
+func uniqueViolationMessage(err error) (string, bool) {
+	if !ent.IsConstraintError(err) {
+		return "", false
+	}
+	msg := err.Error()
+	for column, friendly := range UniqueViolations {
+		// SQLite reports "UNIQUE constraint failed: users.email", PostgreSQL the
+		// "users_email_key" constraint it created for the column
+		if strings.Contains(msg, ": "+column) || strings.Contains(msg, `"`+strings.Replace(column, ".", "_", 1)+`_key"`) {
+			return friendly, true
+		}
+	}
+	return "", false
+}
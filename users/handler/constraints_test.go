@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-micro.dev/v5/errors"
+
+	pb "users/proto"
+)
+
+func TestCreateUserDuplicatesAreFriendlyConflicts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	createTestUser(t, client, "alice")
+
+	tests := map[string]*pb.CreateUserRequest{
+		"email already in use": {Username: "alice2", Email: "Alice@Example.com", Password: "password123"},
+		"username taken":       {Username: "alice", Email: "other@example.com", Password: "password123"},
+	}
+	for want, req := range tests {
+		err := h.CreateUser(ctx, req, &pb.CreateUserResponse{})
+		if e := errors.FromError(err); e.Code != http.StatusConflict || e.Detail != want {
+			t.Errorf("expected a 409 %q, got %v", want, err)
+		}
+	}
+	if n := client.User.Query().CountX(ctx); n != 1 {
+		t.Fatalf("expected no duplicate users stored, found %d", n)
+	}
+}
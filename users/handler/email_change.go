@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v5/errors"
+	log "go-micro.dev/v5/logger"
+	"golang.org/x/crypto/bcrypt"
+
+	"users/ent"
+	"users/ent/user"
+	pb "users/proto"
+)
+
+// EmailChangeTTL is how long a requested email change can be confirmed before the user
+// must request it again. main overrides it from the environment.
+var EmailChangeTTL = 24 * time.Hour
+
+// RequestEmailChange starts changing a user's email. The new address is stored as pending
+// and a confirmation token is published to it, while the current address is sent a notice
+// without the token; the current address stays in use until ConfirmEmailChange. A new
+// request replaces any pending one.
+func (h *User) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest, rsp *pb.RequestEmailChangeResponse) error {
+	log.Extract(ctx).Infof("Received RequestEmailChange request for user ID: %s, new email: %s", req.UserId, req.NewEmail)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.UserId)
+	}
+	if err := checkLength("email", req.NewEmail, Limits.Email); err != nil {
+		log.Extract(ctx).Infof("Rejected RequestEmailChange request: %v", err)
+		return err
+	}
+	email, err := normalizeEmail(req.NewEmail)
+	if err != nil {
+		log.Extract(ctx).Infof("Invalid email format: %s", req.NewEmail)
+		return err
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ID(userID), user.DeletedAtIsNil()).Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("RequestEmailChange failed: User not found for ID %s", req.UserId)
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get user for email change: %v", err)
+		return fmt.Errorf("internal server error: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.Password)); err != nil {
+		log.Extract(ctx).Infof("RequestEmailChange failed: Incorrect password for user %s", req.UserId)
+		return fmt.Errorf("incorrect password")
+	}
+	if email == u.Email {
+		return fmt.Errorf("new email is the same as the current email")
+	}
+
+	// Soft-deleted users keep their email reserved, so they are checked too
+	taken, err := h.EntClient.User.Query().Where(user.Email(email)).Exist(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to check whether email %s is in use: %v", email, err)
+		return fmt.Errorf("internal server error: %w", err)
+	}
+	if taken {
+		log.Extract(ctx).Infof("RequestEmailChange failed: Email %s already in use", email)
+		return errors.Conflict("users.RequestEmailChange", "%s", UniqueViolations["users.email"])
+	}
+
+	expiresAt := time.Now().Add(EmailChangeTTL)
+	u, err = h.EntClient.User.UpdateOneID(u.ID).
+		SetPendingEmail(email).
+		SetEmailChangeToken(uuid.New().String()).
+		SetEmailChangeExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to save email change for user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to request email change: %w", err)
+	}
+
+	publishEmailChangeRequested(ctx, h.EmailChangeEvents, h.EmailChangeNotices, u)
+
+	rsp.Success = true
+	rsp.ExpiresAt = expiresAt.Unix()
+	log.Extract(ctx).Infof("Email change to %s requested for user: %s", email, u.ID)
+	return nil
+}
+
+// ConfirmEmailChange promotes a user's pending email to their email. The new address has
+// not been verified yet, so email_verified is reset and a verification link is published
+// to it, as for a new user.
+func (h *User) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest, rsp *pb.ConfirmEmailChangeResponse) error {
+	log.Extract(ctx).Info("Received ConfirmEmailChange request with token.")
+
+	if req.Token == "" {
+		return fmt.Errorf("invalid or expired email change token")
+	}
+
+	u, err := h.EntClient.User.Query().
+		Where(
+			user.EmailChangeToken(req.Token),
+			user.PendingEmailNotNil(),
+			user.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Info("Email change confirmation failed: Invalid token.")
+		return fmt.Errorf("invalid or expired email change token")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to query user for email change confirmation: %v", err)
+		return fmt.Errorf("internal server error during email change confirmation: %w", err)
+	}
+
+	if u.EmailChangeExpiresAt == nil || time.Now().After(*u.EmailChangeExpiresAt) {
+		// Drop the stale change so the pending email no longer shows on the user
+		err := h.EntClient.User.Update().
+			Where(user.ID(u.ID), user.EmailChangeToken(req.Token)).
+			ClearPendingEmail().
+			ClearEmailChangeToken().
+			ClearEmailChangeExpiresAt().
+			Exec(ctx)
+		if err != nil {
+			log.Extract(ctx).Errorf("Failed to clear expired email change for user %s: %v", u.ID, err)
+		}
+		log.Extract(ctx).Infof("Email change confirmation failed: Token expired for user %s", u.ID)
+		return fmt.Errorf("invalid or expired email change token")
+	}
+
+	// Only the request the token was issued for may be confirmed, not one replacing it
+	verificationToken := uuid.New().String()
+	n, err := h.EntClient.User.Update().
+		Where(user.ID(u.ID), user.EmailChangeToken(req.Token)).
+		SetEmail(*u.PendingEmail).
+		SetEmailVerified(false).
+		SetVerificationToken(verificationToken).
+		SetVerificationTokenExpiresAt(time.Now().Add(VerificationTokenTTL)).
+		ClearPendingEmail().
+		ClearEmailChangeToken().
+		ClearEmailChangeExpiresAt().
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		log.Extract(ctx).Errorf("Contraint violation: %v", err)
+		if cerr := uniqueViolation("users.ConfirmEmailChange", err); cerr != nil {
+			return cerr
+		}
+		return err
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to change email for user %s: %v", u.ID, err)
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+	if n == 0 {
+		log.Extract(ctx).Infof("Email change for user %s was replaced before it was confirmed", u.ID)
+		return fmt.Errorf("invalid or expired email change token")
+	}
+
+	u, err = h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to retrieve user after email change: %v", err)
+		return fmt.Errorf("failed to retrieve user after email change: %w", err)
+	}
+
+	publishVerificationRequested(ctx, h.Events, u, verificationToken)
+
+	rsp.User = toProtoUser(u)
+	log.Extract(ctx).Infof("Email changed successfully for user: %s", u.ID)
+	return nil
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go-micro.dev/v5/client"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	pb "users/proto"
+)
+
+func TestEmailChangeConfirmation(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	u := createTestUser(t, client, "alice")
+
+	request := func(newEmail string) {
+		t.Helper()
+		rsp := &pb.RequestEmailChangeResponse{}
+		if err := h.RequestEmailChange(ctx, &pb.RequestEmailChangeRequest{UserId: u.ID.String(), NewEmail: newEmail, Password: "password123"}, rsp); err != nil {
+			t.Fatalf("RequestEmailChange: %v", err)
+		}
+		if !rsp.Success {
+			t.Fatal("expected the email change requested")
+		}
+	}
+	confirm := func(token string) (*pb.User, error) {
+		rsp := &pb.ConfirmEmailChangeResponse{}
+		err := h.ConfirmEmailChange(ctx, &pb.ConfirmEmailChangeRequest{Token: token}, rsp)
+		return rsp.User, err
+	}
+
+	err := h.RequestEmailChange(ctx, &pb.RequestEmailChangeRequest{UserId: u.ID.String(), NewEmail: "new@example.com", Password: "wrong"}, &pb.RequestEmailChangeResponse{})
+	if err == nil {
+		t.Fatal("expected a wrong password to be rejected")
+	}
+
+	// Pending: the old address stays in use until the change is confirmed
+	request("New@Example.com")
+	pending := client.User.GetX(ctx, u.ID)
+	if pending.Email != "alice@example.com" || pending.PendingEmail == nil || *pending.PendingEmail != "new@example.com" {
+		t.Fatalf("expected alice@example.com in use with new@example.com pending, got %s, %v", pending.Email, pending.PendingEmail)
+	}
+	if err := h.Authenticate(ctx, &pb.AuthenticateRequest{EmailOrUsername: "alice@example.com", Password: "password123"}, &pb.AuthenticateResponse{}); err != nil {
+		t.Fatalf("expected the old email to authenticate while the change is pending, got %v", err)
+	}
+
+	changed, err := confirm(*pending.EmailChangeToken)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange: %v", err)
+	}
+	if changed.Email != "new@example.com" || changed.PendingEmail != "" {
+		t.Fatalf("expected the pending email promoted, got %s pending %s", changed.Email, changed.PendingEmail)
+	}
+	if client.User.GetX(ctx, u.ID).EmailVerified {
+		t.Fatal("expected the new email to need verifying")
+	}
+	if _, err := confirm(*pending.EmailChangeToken); err == nil {
+		t.Fatal("expected a used token to be rejected")
+	}
+
+	// Expired: the change is dropped and the current email kept
+	request("later@example.com")
+	token := *client.User.GetX(ctx, u.ID).EmailChangeToken
+	client.User.UpdateOneID(u.ID).SetEmailChangeExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	if _, err := confirm(token); err == nil {
+		t.Fatal("expected an expired token to be rejected")
+	}
+	expired := client.User.GetX(ctx, u.ID)
+	if expired.Email != "new@example.com" || expired.PendingEmail != nil || expired.EmailChangeToken != nil {
+		t.Fatalf("expected the expired change dropped, got %s pending %v", expired.Email, expired.PendingEmail)
+	}
+}
+
+// fakeEvent keeps the messages published through it
+type fakeEvent struct {
+	published []interface{}
+}
+
+func (e *fakeEvent) Publish(ctx context.Context, msg interface{}, opts ...client.PublishOption) error {
+	e.published = append(e.published, msg)
+	return nil
+}
+
+func TestEmailChangeTokenGoesToTheNewAddress(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	events, notices := &fakeEvent{}, &fakeEvent{}
+	h := &User{EntClient: client, EmailChangeEvents: events, EmailChangeNotices: notices}
+	u := createTestUser(t, client, "alice")
+
+	err := h.RequestEmailChange(ctx, &pb.RequestEmailChangeRequest{UserId: u.ID.String(), NewEmail: "new@example.com", Password: "password123"}, &pb.RequestEmailChangeResponse{})
+	if err != nil {
+		t.Fatalf("RequestEmailChange: %v", err)
+	}
+	token := *client.User.GetX(ctx, u.ID).EmailChangeToken
+
+	if len(events.published) != 1 {
+		t.Fatalf("expected one confirmation published, got %d", len(events.published))
+	}
+	confirmation := events.published[0].(*pb.EmailChangeRequestedEvent)
+	if confirmation.NewEmail != "new@example.com" || confirmation.Token != token {
+		t.Fatalf("expected the token sent to new@example.com, got %v", confirmation)
+	}
+
+	if len(notices.published) != 1 {
+		t.Fatalf("expected one notice published, got %d", len(notices.published))
+	}
+	notice := notices.published[0].(*pb.EmailChangeNoticeEvent)
+	if notice.Email != "alice@example.com" || notice.NewEmail != "new@example.com" {
+		t.Fatalf("expected a notice to alice@example.com of the change, got %v", notice)
+	}
+	if strings.Contains(prototext.Format(notice), token) {
+		t.Fatal("expected the notice to the current address not to carry the token")
+	}
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v5"
+	log "go-micro.dev/v5/logger"
+
+	"users/ent"
+	pb "users/proto"
+)
+
+// VerificationRequestedTopic is the topic verification links to email are published on
+const VerificationRequestedTopic = "users.verification_requested"
+
+// publishVerificationRequested asks an email service to send u the link verifying its
+// email with token. The token is already stored, so a failed publish is logged rather
+// than failing the request; the user can ask for the link again with ResendVerification.
+func publishVerificationRequested(ctx context.Context, events micro.Event, u *ent.User, token string) {
+	if events == nil {
+		return
+	}
+
+	ev := &pb.VerificationRequestedEvent{
+		UserId:      u.ID.String(),
+		Email:       u.Email,
+		Username:    u.Username,
+		Token:       token,
+		RequestedAt: time.Now().Unix(),
+	}
+	if err := events.Publish(ctx, ev); err != nil {
+		log.Extract(ctx).Errorf("Failed to publish %s event for user %s: %v", VerificationRequestedTopic, u.ID, err)
+	}
+}
+
+// EmailChangeRequestedTopic is the topic email change confirmations are published on
+const EmailChangeRequestedTopic = "users.email_change_requested"
+
+// EmailChangeNoticeTopic is the topic notices of email changes to the current address are published on
+const EmailChangeNoticeTopic = "users.email_change_notice"
+
+// publishEmailChangeRequested asks an email service to send u's pending email the token
+// confirming the change to it, and to tell u's current address, without the token, that
+// a change away from it was requested. Like publishVerificationRequested, a failed publish
+// is only logged.
+func publishEmailChangeRequested(ctx context.Context, events, notices micro.Event, u *ent.User) {
+	if u.PendingEmail == nil || u.EmailChangeToken == nil || u.EmailChangeExpiresAt == nil {
+		return
+	}
+	now := time.Now().Unix()
+
+	if events != nil {
+		ev := &pb.EmailChangeRequestedEvent{
+			UserId:      u.ID.String(),
+			Email:       u.Email,
+			NewEmail:    *u.PendingEmail,
+			Username:    u.Username,
+			Token:       *u.EmailChangeToken,
+			ExpiresAt:   u.EmailChangeExpiresAt.Unix(),
+			RequestedAt: now,
+		}
+		if err := events.Publish(ctx, ev); err != nil {
+			log.Extract(ctx).Errorf("Failed to publish %s event for user %s: %v", EmailChangeRequestedTopic, u.ID, err)
+		}
+	}
+
+	if notices != nil {
+		ev := &pb.EmailChangeNoticeEvent{
+			UserId:      u.ID.String(),
+			Email:       u.Email,
+			NewEmail:    *u.PendingEmail,
+			Username:    u.Username,
+			RequestedAt: now,
+		}
+		if err := notices.Publish(ctx, ev); err != nil {
+			log.Extract(ctx).Errorf("Failed to publish %s event for user %s: %v", EmailChangeNoticeTopic, u.ID, err)
+		}
+	}
+}
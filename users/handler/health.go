@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	debughandler "go-micro.dev/v5/debug/handler"
+	debugpb "go-micro.dev/v5/debug/proto"
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/logger"
+	"go-micro.dev/v5/server"
+)
+
+// Pinger checks that the database connection is alive, e.g. *sql.DB
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Health reports whether the service can serve requests. It is ready once SetReady(true)
+// is called after startup migrations and for as long as the database answers pings.
+type Health struct {
+	DB    Pinger
+	ready atomic.Bool
+}
+
+// SetReady marks the service ready or not, e.g. false while migrating or shutting down
+func (h *Health) SetReady(ready bool) {
+	h.ready.Store(ready)
+}
+
+// Check is the readiness check, failing with ServiceUnavailable until the service is ready
+// or once the database stops answering
+func (h *Health) Check(ctx context.Context, req *debugpb.HealthRequest, rsp *debugpb.HealthResponse) error {
+	if !h.ready.Load() {
+		return errors.New("users.Health.Check", "starting", http.StatusServiceUnavailable)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := h.DB.PingContext(ctx); err != nil {
+		logger.Errorf("Health check failed to ping database: %v", err)
+		return errors.New("users.Health.Check", "database unavailable", http.StatusServiceUnavailable)
+	}
+	rsp.Status = "ok"
+	return nil
+}
+
+// Register exposes the check as Health.Check and through go-micro's Debug.Health endpoint
+func (h *Health) Register(s server.Server) error {
+	if err := s.Handle(s.NewHandler(h)); err != nil {
+		return err
+	}
+	return debugpb.RegisterDebugHandler(s, &debugHealth{Debug: debughandler.NewHandler(nil), health: h})
+}
+
+// debugHealth is go-micro's debug handler with Health backed by the readiness check
+type debugHealth struct {
+	*debughandler.Debug
+	health *Health
+}
+
+// Health answers Debug.Health with the readiness check
+func (d *debugHealth) Health(ctx context.Context, req *debugpb.HealthRequest, rsp *debugpb.HealthResponse) error {
+	return d.health.Check(ctx, req, rsp)
+}
+
+// ServeProbes serves HTTP liveness (/healthz) and readiness (/readyz) probes on addr until it fails
+func (h *Health) ServeProbes(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := h.Check(r.Context(), &debugpb.HealthRequest{}, &debugpb.HealthResponse{}); err != nil {
+			http.Error(w, errors.FromError(err).Detail, http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+
+	"users/ent"
+	"users/ent/enttest"
+)
+
+// newTestClient opens a migrated in-memory SQLite database private to the test
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createTestUser stores a verified, active user whose password is "password123"
+func createTestUser(t *testing.T, client *ent.Client, username string) *ent.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hashing password: %v", err)
+	}
+	u, err := client.User.Create().
+		SetEmail(username + "@example.com").
+		SetUsername(username).
+		SetPasswordHash(string(hash)).
+		SetEmailVerified(true).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating user %s: %v", username, err)
+	}
+	return u
+}
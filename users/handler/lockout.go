@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v5/errors"
+	log "go-micro.dev/v5/logger"
+
+	"users/ent"
+)
+
+// LockoutPolicy locks an account for Duration once MaxFailures wrong passwords have been
+// given for it in a row; zero MaxFailures disables locking
+type LockoutPolicy struct {
+	MaxFailures int
+	Duration    time.Duration
+}
+
+// Lockout is used by Authenticate; main overrides the defaults from the environment
+var Lockout = LockoutPolicy{MaxFailures: 10, Duration: 30 * time.Minute}
+
+// accountLocked returns a Forbidden error from id if u is locked at now, naming when the lock ends
+func accountLocked(id string, u *ent.User, now time.Time) error {
+	if u.LockedUntil == nil || !now.Before(*u.LockedUntil) {
+		return nil
+	}
+	return errors.Forbidden(id, "account locked until %s", u.LockedUntil.UTC().Format(time.RFC3339))
+}
+
+// recordFailedLogin counts a wrong password for u, locking the account and starting the
+// count over once it reaches Lockout.MaxFailures. Authentication fails regardless, so
+// errors are only logged.
+func recordFailedLogin(ctx context.Context, client *ent.Client, u *ent.User, now time.Time) {
+	updated, err := client.User.UpdateOneID(u.ID).AddFailedLoginCount(1).Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to record failed login for user %s: %v", u.ID, err)
+		return
+	}
+	if Lockout.MaxFailures <= 0 || updated.FailedLoginCount < Lockout.MaxFailures {
+		return
+	}
+
+	lockedUntil := now.Add(Lockout.Duration)
+	err = client.User.UpdateOneID(u.ID).
+		SetLockedUntil(lockedUntil).
+		SetFailedLoginCount(0).
+		Exec(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to lock user %s: %v", u.ID, err)
+		return
+	}
+	log.Extract(ctx).Infof("User %s locked until %s after %d failed logins", u.ID, lockedUntil.Format(time.RFC3339), updated.FailedLoginCount)
+}
+
+// clearFailedLogins forgets u's failed logins and any expired lock after it authenticated
+func clearFailedLogins(ctx context.Context, client *ent.Client, u *ent.User) {
+	if u.FailedLoginCount == 0 && u.LockedUntil == nil {
+		return
+	}
+	err := client.User.UpdateOneID(u.ID).
+		SetFailedLoginCount(0).
+		ClearLockedUntil().
+		Exec(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to reset failed logins of user %s: %v", u.ID, err)
+		return
+	}
+	u.FailedLoginCount, u.LockedUntil = 0, nil
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go-micro.dev/v5/errors"
+
+	pb "users/proto"
+)
+
+// withLockout sets the lockout policy for the rest of the test
+func withLockout(t *testing.T, policy LockoutPolicy) {
+	t.Helper()
+	previous := Lockout
+	Lockout = policy
+	t.Cleanup(func() { Lockout = previous })
+}
+
+func TestFailedLoginsLockAccount(t *testing.T) {
+	withLockout(t, LockoutPolicy{MaxFailures: 3, Duration: time.Hour})
+	client := newTestClient(t)
+	ctx := context.Background()
+	u := createTestUser(t, client, "alice")
+	h := &User{EntClient: client}
+	authenticate := func(password string) error {
+		return h.Authenticate(ctx, &pb.AuthenticateRequest{EmailOrUsername: "alice", Password: password}, &pb.AuthenticateResponse{})
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := authenticate("wrong"); err == nil || errors.FromError(err).Code == http.StatusForbidden {
+			t.Fatalf("failure %d: expected invalid credentials, got %v", i+1, err)
+		}
+	}
+
+	// Locked, even the right password is refused with a distinct error
+	err := authenticate("password123")
+	if e := errors.FromError(err); e.Code != http.StatusForbidden || !strings.Contains(e.Detail, "account locked") {
+		t.Fatalf("expected the account locked, got %v", err)
+	}
+	locked := client.User.GetX(ctx, u.ID)
+	if locked.LockedUntil == nil || locked.FailedLoginCount != 0 {
+		t.Fatalf("expected a lock with the count started over, got %v, %d", locked.LockedUntil, locked.FailedLoginCount)
+	}
+
+	admin := &AdminService{EntClient: client}
+	rsp := &pb.UnlockUserResponse{}
+	if err := admin.UnlockUser(ctx, &pb.UnlockUserRequest{Id: u.ID.String()}, rsp); err != nil {
+		t.Fatalf("UnlockUser: %v", err)
+	}
+	if unlocked := client.User.GetX(ctx, u.ID); unlocked.LockedUntil != nil {
+		t.Fatalf("expected the lock cleared, got %v", unlocked.LockedUntil)
+	}
+	if err := authenticate("password123"); err != nil {
+		t.Fatalf("expected an unlocked account to log in, got %v", err)
+	}
+}
+
+func TestSuccessfulLoginResetsFailedLogins(t *testing.T) {
+	withLockout(t, LockoutPolicy{MaxFailures: 3, Duration: time.Hour})
+	client := newTestClient(t)
+	ctx := context.Background()
+	u := createTestUser(t, client, "alice")
+	h := &User{EntClient: client}
+	authenticate := func(password string) error {
+		return h.Authenticate(ctx, &pb.AuthenticateRequest{EmailOrUsername: "alice", Password: password}, &pb.AuthenticateResponse{})
+	}
+
+	authenticate("wrong")
+	authenticate("wrong")
+	if n := client.User.GetX(ctx, u.ID).FailedLoginCount; n != 2 {
+		t.Fatalf("expected 2 failed logins counted, got %d", n)
+	}
+	if err := authenticate("password123"); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if n := client.User.GetX(ctx, u.ID).FailedLoginCount; n != 0 {
+		t.Fatalf("expected the count reset by the login, got %d", n)
+	}
+
+	// Two more failures don't reach the limit once it started over
+	authenticate("wrong")
+	authenticate("wrong")
+	if err := authenticate("password123"); err != nil {
+		t.Fatalf("expected the account not locked, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/metadata"
+)
+
+// LoginLimiter throttles password guessing on Authenticate. Once an identifier (email or
+// username) or a caller's IP has failed MaxFailures or MaxFailuresPerIP times within
+// Window of its first failure, further attempts are refused until the window ends, even
+// with the right password. Zero values disable the respective limit, and a nil
+// *LoginLimiter allows everything.
+type LoginLimiter struct {
+	MaxFailures      int
+	MaxFailuresPerIP int
+	Window           time.Duration
+
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+}
+
+// loginFailures counts the failed attempts of one key since the first of them
+type loginFailures struct {
+	count int
+	since time.Time
+}
+
+// loginKeys returns the keys an attempt on identifier from ctx's caller is counted under:
+// the identifier, matched case-insensitively, and the caller's IP when known
+func loginKeys(ctx context.Context, identifier string) (id, ip string) {
+	id = "id:" + emailLookupKey(identifier)
+	if host := callerHost(ctx); host != "" {
+		ip = "ip:" + host
+	}
+	return id, ip
+}
+
+// allow returns a ResourceExhausted (429) error if the identifier or IP key has used up
+// its failures for the current window
+func (l *LoginLimiter) allow(id, ip string) error {
+	if l == nil || l.Window <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for key, max := range map[string]int{id: l.MaxFailures, ip: l.MaxFailuresPerIP} {
+		f, ok := l.failures[key]
+		if key == "" || max <= 0 || !ok || now.Sub(f.since) >= l.Window || f.count < max {
+			continue
+		}
+		wait := (l.Window - now.Sub(f.since)).Truncate(time.Second) + time.Second
+		return errors.New("users.Authenticate", "too many failed attempts, retry in "+wait.String(), http.StatusTooManyRequests)
+	}
+	return nil
+}
+
+// fail counts a failed attempt under the identifier and IP keys
+func (l *LoginLimiter) fail(id, ip string) {
+	if l == nil || l.Window <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.failures == nil {
+		l.failures = make(map[string]*loginFailures)
+	}
+	// Forget keys whose window has passed so the map doesn't grow without bound
+	for key, f := range l.failures {
+		if now.Sub(f.since) >= l.Window {
+			delete(l.failures, key)
+		}
+	}
+	for _, key := range []string{id, ip} {
+		if key == "" {
+			continue
+		}
+		if f, ok := l.failures[key]; ok {
+			f.count++
+		} else {
+			l.failures[key] = &loginFailures{count: 1, since: now}
+		}
+	}
+}
+
+// reset clears the failures of an identifier after it authenticated. The IP's failures
+// are kept, so one known password doesn't buy a caller fresh guesses at other accounts.
+func (l *LoginLimiter) reset(id string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, id)
+}
+
+// callerHost returns the remote host of an RPC's caller, ignoring the port, or "" if unknown
+func callerHost(ctx context.Context) string {
+	remote, ok := metadata.Get(ctx, "Remote")
+	if !ok || remote == "" {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		return host
+	}
+	return remote
+}
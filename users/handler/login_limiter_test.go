@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"go-micro.dev/v5/errors"
+	"go-micro.dev/v5/metadata"
+
+	pb "users/proto"
+)
+
+// fromIP returns a context of a call from ip
+func fromIP(ip string) context.Context {
+	return metadata.NewContext(context.Background(), metadata.Metadata{"Remote": ip + ":40000"})
+}
+
+// login authenticates identifier from ip, returning the error's code or 0 on success
+func login(t *testing.T, h *User, ip, identifier, password string) int32 {
+	t.Helper()
+	err := h.Authenticate(fromIP(ip), &pb.AuthenticateRequest{EmailOrUsername: identifier, Password: password}, &pb.AuthenticateResponse{})
+	if err == nil {
+		return 0
+	}
+	if code := errors.FromError(err).Code; code != 0 {
+		return code
+	}
+	return http.StatusUnauthorized
+}
+
+func TestLoginLimiterRefusesIdentifierAfterMaxFailures(t *testing.T) {
+	client := newTestClient(t)
+	createTestUser(t, client, "alice")
+	h := &User{EntClient: client, Logins: &LoginLimiter{MaxFailures: 3, Window: time.Minute}}
+
+	for _, identifier := range []string{"alice", "nobody@example.com"} {
+		for i := 0; i < 3; i++ {
+			if code := login(t, h, "10.0.0.1", identifier, "wrong"); code == http.StatusTooManyRequests {
+				t.Fatalf("%s: failure %d was throttled early", identifier, i+1)
+			}
+		}
+		// Even the right password is refused once the failures are used up
+		if code := login(t, h, "10.0.0.1", identifier, "password123"); code != http.StatusTooManyRequests {
+			t.Fatalf("%s: expected the 4th attempt refused with 429, got %d", identifier, code)
+		}
+	}
+
+	// Identifiers are matched case-insensitively
+	if code := login(t, h, "10.0.0.2", "ALICE", "password123"); code != http.StatusTooManyRequests {
+		t.Fatalf("expected ALICE throttled like alice, got %d", code)
+	}
+}
+
+func TestLoginLimiterPerIPLimitSpansIdentifiers(t *testing.T) {
+	client := newTestClient(t)
+	createTestUser(t, client, "alice")
+	h := &User{EntClient: client, Logins: &LoginLimiter{MaxFailures: 10, MaxFailuresPerIP: 3, Window: time.Minute}}
+
+	for _, identifier := range []string{"bob", "carol", "dave"} {
+		login(t, h, "10.0.0.1", identifier, "wrong")
+	}
+	if code := login(t, h, "10.0.0.1", "alice", "password123"); code != http.StatusTooManyRequests {
+		t.Fatalf("expected the IP throttled across identifiers, got %d", code)
+	}
+	if code := login(t, h, "10.0.0.2", "alice", "password123"); code != 0 {
+		t.Fatalf("expected another IP to log in, got %d", code)
+	}
+}
+
+func TestLoginLimiterSuccessResetsOnlyIdentifier(t *testing.T) {
+	client := newTestClient(t)
+	createTestUser(t, client, "alice")
+	limiter := &LoginLimiter{MaxFailures: 3, MaxFailuresPerIP: 10, Window: time.Minute}
+	h := &User{EntClient: client, Logins: limiter}
+
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	if code := login(t, h, "10.0.0.1", "alice", "password123"); code != 0 {
+		t.Fatalf("expected alice to log in, got %d", code)
+	}
+	// The identifier starts over, so two more failures don't throttle it
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	if code := login(t, h, "10.0.0.1", "alice", "password123"); code != 0 {
+		t.Fatalf("expected alice's failures reset by the success, got %d", code)
+	}
+
+	id, ip := loginKeys(fromIP("10.0.0.1"), "alice")
+	if _, ok := limiter.failures[id]; ok {
+		t.Fatal("expected the identifier's failures cleared")
+	}
+	if f := limiter.failures[ip]; f == nil || f.count != 4 {
+		t.Fatalf("expected the IP's 4 failures kept, got %v", f)
+	}
+}
+
+func TestLoginLimiterAllowsAttemptsOnceWindowPasses(t *testing.T) {
+	client := newTestClient(t)
+	createTestUser(t, client, "alice")
+	limiter := &LoginLimiter{MaxFailures: 2, MaxFailuresPerIP: 2, Window: time.Minute}
+	h := &User{EntClient: client, Logins: limiter}
+
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	login(t, h, "10.0.0.1", "alice", "wrong")
+	if code := login(t, h, "10.0.0.1", "alice", "password123"); code != http.StatusTooManyRequests {
+		t.Fatalf("expected alice throttled, got %d", code)
+	}
+
+	// Move the failures back past the window
+	for _, f := range limiter.failures {
+		f.since = f.since.Add(-limiter.Window)
+	}
+	if code := login(t, h, "10.0.0.1", "alice", "password123"); code != 0 {
+		t.Fatalf("expected attempts allowed once the window passed, got %d", code)
+	}
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"time"
+
+	"users/ent"
+)
+
+// PasswordMaxAge is how long a password stays valid before the user must change it; zero
+// disables the policy. main overrides it from the environment.
+var PasswordMaxAge time.Duration
+
+// passwordChangedAt returns when u's password was last set, falling back to the account
+// creation time for users created before it was tracked
+func passwordChangedAt(u *ent.User) time.Time {
+	if u.PasswordChangedAt != nil {
+		return *u.PasswordChangedAt
+	}
+	return u.CreatedAt
+}
+
+// passwordExpired reports whether u's password is older than PasswordMaxAge at now
+func passwordExpired(u *ent.User, now time.Time) bool {
+	if PasswordMaxAge <= 0 {
+		return false
+	}
+	return now.Sub(passwordChangedAt(u)) > PasswordMaxAge
+}
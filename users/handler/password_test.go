@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "users/proto"
+)
+
+func TestAuthenticateReportsPasswordExpiry(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	t.Cleanup(func() { PasswordMaxAge = 0 })
+
+	stale := createTestUser(t, client, "stale")
+	client.User.UpdateOne(stale).SetPasswordChangedAt(time.Now().Add(-40 * 24 * time.Hour)).ExecX(ctx)
+	fresh := createTestUser(t, client, "fresh")
+	client.User.UpdateOne(fresh).SetPasswordChangedAt(time.Now().Add(-24 * time.Hour)).ExecX(ctx)
+	// Users from before password changes were tracked age from their creation
+	legacy := client.User.Create().
+		SetEmail("legacy@example.com").
+		SetUsername("legacy").
+		SetPasswordHash(stale.PasswordHash).
+		SetEmailVerified(true).
+		SetCreatedAt(time.Now().Add(-40 * 24 * time.Hour)).
+		SaveX(ctx)
+	client.User.UpdateOne(legacy).ClearPasswordChangedAt().ExecX(ctx)
+
+	expired := func(username string) bool {
+		t.Helper()
+		rsp := &pb.AuthenticateResponse{}
+		if err := h.Authenticate(ctx, &pb.AuthenticateRequest{EmailOrUsername: username, Password: "password123"}, rsp); err != nil {
+			t.Fatalf("Authenticate(%s): %v", username, err)
+		}
+		return rsp.PasswordExpired
+	}
+
+	tests := []struct {
+		maxAge time.Duration
+		want   map[string]bool
+	}{
+		{0, map[string]bool{"stale": false, "fresh": false, "legacy": false}},
+		{30 * 24 * time.Hour, map[string]bool{"stale": true, "fresh": false, "legacy": true}},
+	}
+	for _, tt := range tests {
+		PasswordMaxAge = tt.maxAge
+		for username, want := range tt.want {
+			if got := expired(username); got != want {
+				t.Errorf("max age %s: expected %s's password expired=%v, got %v", tt.maxAge, username, want, got)
+			}
+		}
+	}
+
+	// Changing the password lifts the expiry
+	err := h.ChangePassword(ctx, &pb.ChangePasswordRequest{UserId: stale.ID.String(), OldPassword: "password123", NewPassword: "password456"}, &pb.ChangePasswordResponse{})
+	if err != nil {
+		t.Fatalf("ChangePassword: %v", err)
+	}
+	rsp := &pb.AuthenticateResponse{}
+	if err := h.Authenticate(ctx, &pb.AuthenticateRequest{EmailOrUsername: "stale", Password: "password456"}, rsp); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if rsp.PasswordExpired {
+		t.Fatal("expected a just-changed password not to be expired")
+	}
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	log "go-micro.dev/v5/logger"
+
+	"users/ent"
+	"users/ent/notificationpreferences"
+	"users/ent/user"
+	pb "users/proto"
+)
+
+// GetNotificationPreferences handles fetching a user's notification preferences,
+// returning the defaults if the user has never saved any
+func (h *User) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest, rsp *pb.GetNotificationPreferencesResponse) error {
+	log.Extract(ctx).Infof("Received GetNotificationPreferences request for user ID: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ID(userID)).WithNotificationPreferences().Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for notification preferences: %s", req.UserId)
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get notification preferences: %v", err)
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	if u.Edges.NotificationPreferences == nil {
+		rsp.Preferences = defaultNotificationPreferences()
+	} else {
+		rsp.Preferences = toProtoNotificationPreferences(u.Edges.NotificationPreferences)
+	}
+	return nil
+}
+
+// UpdateNotificationPreferences updates the supplied preferences, creating the
+// user's preferences from the defaults if they don't exist yet
+func (h *User) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest, rsp *pb.UpdateNotificationPreferencesResponse) error {
+	log.Extract(ctx).Infof("Received UpdateNotificationPreferences request for user ID: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.ID(userID)).WithNotificationPreferences().Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for notification preferences update: %s", req.UserId)
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get user for notification preferences update: %v", err)
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var p *ent.NotificationPreferences
+	if u.Edges.NotificationPreferences == nil {
+		creator := tx.NotificationPreferences.Create().SetUser(u)
+		if req.EmailMarketing != nil {
+			creator.SetEmailMarketing(*req.EmailMarketing)
+		}
+		if req.SmsMarketing != nil {
+			creator.SetSmsMarketing(*req.SmsMarketing)
+		}
+		if req.OrderUpdates != nil {
+			creator.SetOrderUpdates(*req.OrderUpdates)
+		}
+		p, err = creator.Save(ctx)
+	} else {
+		updater := tx.NotificationPreferences.UpdateOne(u.Edges.NotificationPreferences)
+		if req.EmailMarketing != nil {
+			updater.SetEmailMarketing(*req.EmailMarketing)
+		}
+		if req.SmsMarketing != nil {
+			updater.SetSmsMarketing(*req.SmsMarketing)
+		}
+		if req.OrderUpdates != nil {
+			updater.SetOrderUpdates(*req.OrderUpdates)
+		}
+		p, err = updater.Save(ctx)
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to save notification preferences for user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Extract(ctx).Errorf("Failed to commit notification preferences update: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Preferences = toProtoNotificationPreferences(p)
+	log.Extract(ctx).Infof("Notification preferences updated successfully for user: %s", req.UserId)
+	return nil
+}
+
+// defaultNotificationPreferences mirrors the schema defaults for users who never saved preferences
+func defaultNotificationPreferences() *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		EmailMarketing: notificationpreferences.DefaultEmailMarketing,
+		SmsMarketing:   notificationpreferences.DefaultSmsMarketing,
+		OrderUpdates:   notificationpreferences.DefaultOrderUpdates,
+	}
+}
+
+// toProtoNotificationPreferences converts an Entgo NotificationPreferences entity to its Protobuf message
+func toProtoNotificationPreferences(p *ent.NotificationPreferences) *pb.NotificationPreferences {
+	if p == nil {
+		return nil
+	}
+	return &pb.NotificationPreferences{
+		EmailMarketing: p.EmailMarketing,
+		SmsMarketing:   p.SmsMarketing,
+		OrderUpdates:   p.OrderUpdates,
+		UpdatedAt:      p.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "users/proto"
+)
+
+func TestNotificationPreferencesDefaultsAndPartialUpdates(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	userID := createTestUser(t, client, "alice").ID.String()
+
+	get := func() *pb.NotificationPreferences {
+		t.Helper()
+		rsp := &pb.GetNotificationPreferencesResponse{}
+		if err := h.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: userID}, rsp); err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		return rsp.Preferences
+	}
+	update := func(req *pb.UpdateNotificationPreferencesRequest) {
+		t.Helper()
+		req.UserId = userID
+		if err := h.UpdateNotificationPreferences(ctx, req, &pb.UpdateNotificationPreferencesResponse{}); err != nil {
+			t.Fatalf("UpdateNotificationPreferences: %v", err)
+		}
+	}
+
+	if p := get(); p.EmailMarketing || p.SmsMarketing || !p.OrderUpdates {
+		t.Fatalf("expected order updates only by default, got %v", p)
+	}
+
+	// Only the fields sent change, both when the preferences are created and afterwards
+	update(&pb.UpdateNotificationPreferencesRequest{EmailMarketing: proto.Bool(true)})
+	if p := get(); !p.EmailMarketing || p.SmsMarketing || !p.OrderUpdates {
+		t.Fatalf("expected email marketing opted in on top of the defaults, got %v", p)
+	}
+	update(&pb.UpdateNotificationPreferencesRequest{OrderUpdates: proto.Bool(false)})
+	if p := get(); !p.EmailMarketing || p.SmsMarketing || p.OrderUpdates {
+		t.Fatalf("expected order updates opted out, keeping email marketing, got %v", p)
+	}
+}
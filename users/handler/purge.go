@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"users/ent"
+	"users/ent/notificationpreferences"
+	"users/ent/profile"
+	"users/ent/user"
+)
+
+// purgeBatches repeatedly takes the next batch of purgeable ids and hard-deletes
+// them until a short batch shows nothing is left, returning how many were purged.
+// The services share no code, so carts/handler/purge.go has the same copy; change both.
+func purgeBatches[ID any](ctx context.Context, batchSize int, next func(context.Context, int) ([]ID, error), purge func(context.Context, []ID) (int, error)) (int, error) {
+	var purged int
+	for {
+		ids, err := next(ctx, batchSize)
+		if err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+
+		n, err := purge(ctx, ids)
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+
+		if len(ids) < batchSize {
+			return purged, nil
+		}
+	}
+}
+
+// UserPurger hard-deletes users once they have been soft-deleted for longer than Retention
+type UserPurger struct {
+	EntClient *ent.Client
+	// Retention is how long a soft-deleted user is kept; zero disables purging
+	Retention time.Duration
+	// BatchSize caps the users purged per transaction; zero uses defaultPurgeBatchSize
+	BatchSize int
+}
+
+// defaultPurgeBatchSize is used when UserPurger.BatchSize is unset
+const defaultPurgeBatchSize = 500
+
+// Purge hard-deletes users soft-deleted before the retention window, with their
+// profiles and notification preferences, releasing their emails and usernames
+func (p *UserPurger) Purge(ctx context.Context) (int, error) {
+	if p.Retention <= 0 {
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-p.Retention)
+
+	next := func(ctx context.Context, limit int) ([]uuid.UUID, error) {
+		ids, err := p.EntClient.User.Query().
+			Where(user.DeletedAtLT(cutoff)).
+			Limit(limit).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query purgeable users: %w", err)
+		}
+		return ids, nil
+	}
+	batchSize := p.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+	return purgeBatches(ctx, batchSize, next, p.purge)
+}
+
+// purge hard-deletes the given users and their dependent records in one transaction
+func (p *UserPurger) purge(ctx context.Context, ids []uuid.UUID) (int, error) {
+	tx, err := p.EntClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Dependent records go first due to foreign key constraints
+	if _, err := tx.Profile.Delete().Where(profile.HasUserWith(user.IDIn(ids...))).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to purge profiles: %w", err)
+	}
+	_, err = tx.NotificationPreferences.Delete().
+		Where(notificationpreferences.HasUserWith(user.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge notification preferences: %w", err)
+	}
+	n, err := tx.User.Delete().Where(user.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge users: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit purge: %w", err)
+	}
+	return n, nil
+}
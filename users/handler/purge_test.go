@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"users/ent/user"
+)
+
+func TestUserPurgerHonoursRetention(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	p := &UserPurger{EntClient: client, Retention: 24 * time.Hour}
+
+	createTestUser(t, client, "live")
+	recent := createTestUser(t, client, "recent")
+	client.User.UpdateOne(recent).SetDeletedAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+	expired := createTestUser(t, client, "expired")
+	client.Profile.Create().SetUser(expired).SaveX(ctx)
+	if _, _, err := issueRefreshToken(ctx, client, expired.ID, time.Now()); err != nil {
+		t.Fatalf("issuing refresh token: %v", err)
+	}
+	client.User.UpdateOne(expired).SetDeletedAt(time.Now().Add(-48 * time.Hour)).ExecX(ctx)
+
+	n, err := p.Purge(ctx)
+	if err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 user purged, got %d", n)
+	}
+	if client.User.Query().Where(user.ID(expired.ID)).ExistX(ctx) {
+		t.Fatal("expected the user deleted past the retention window to be purged")
+	}
+	if client.Profile.Query().CountX(ctx) != 0 || client.RefreshToken.Query().CountX(ctx) != 0 {
+		t.Fatal("expected the purged user's profile and refresh tokens to be purged")
+	}
+	if client.User.Query().CountX(ctx) != 2 {
+		t.Fatal("expected the live and recently deleted users to survive")
+	}
+
+	// The purged user's email and username can be registered again
+	createTestUser(t, client, "expired")
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	log "go-micro.dev/v5/logger"
+
+	"users/ent"
+	"users/ent/refreshtoken"
+	pb "users/proto"
+)
+
+// RefreshTokenTTL is how long a refresh token can be exchanged for a new token pair after
+// it is issued. main overrides it from the environment.
+var RefreshTokenTTL = 30 * 24 * time.Hour
+
+// hashRefreshToken returns the hex SHA-256 a refresh token is stored and looked up by
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueRefreshToken stores a new refresh token for userID, returning the token itself,
+// which only the client keeps, and its stored record
+func issueRefreshToken(ctx context.Context, client *ent.Client, userID uuid.UUID, now time.Time) (string, *ent.RefreshToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	rt, err := client.RefreshToken.Create().
+		SetTokenHash(hashRefreshToken(token)).
+		SetUserID(userID).
+		SetExpiresAt(now.Add(RefreshTokenTTL)).
+		Save(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return token, rt, nil
+}
+
+// revokeUserRefreshTokens revokes every outstanding refresh token of userID, returning how many
+func revokeUserRefreshTokens(ctx context.Context, client *ent.Client, userID uuid.UUID) (int, error) {
+	return client.RefreshToken.Update().
+		Where(refreshtoken.UserID(userID), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+}
+
+// RefreshToken exchanges a refresh token for a new access and refresh token pair. The
+// refresh token sent is revoked, so each can be used once; presenting one that was already
+// rotated suggests it was stolen, and revokes every session of its user. Locked, suspended
+// and deleted users are refused.
+func (h *User) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest, rsp *pb.RefreshTokenResponse) error {
+	log.Extract(ctx).Infof("Received RefreshToken request")
+
+	rt, err := h.EntClient.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hashRefreshToken(req.RefreshToken))).
+		WithUser(func(q *ent.UserQuery) { q.WithProfile() }).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("RefreshToken failed: unknown refresh token")
+		return fmt.Errorf("invalid refresh token")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to look up refresh token: %v", err)
+		return fmt.Errorf("failed to look up refresh token: %w", err)
+	}
+	u := rt.Edges.User
+
+	now := time.Now()
+	if rt.Revoked {
+		n, err := revokeUserRefreshTokens(ctx, h.EntClient, u.ID)
+		if err != nil {
+			log.Extract(ctx).Errorf("Failed to revoke refresh tokens of user %s after reuse: %v", u.ID, err)
+		} else {
+			log.Extract(ctx).Infof("Revoked refresh token reused for user %s, revoked %d other sessions", u.ID, n)
+		}
+		return fmt.Errorf("invalid refresh token")
+	}
+	if !now.Before(rt.ExpiresAt) {
+		log.Extract(ctx).Infof("RefreshToken failed: refresh token of user %s expired", u.ID)
+		return fmt.Errorf("refresh token expired")
+	}
+	if u.DeletedAt != nil || !u.IsActive {
+		log.Extract(ctx).Infof("RefreshToken failed: User %s is deleted or inactive", u.ID)
+		return fmt.Errorf("user account is inactive")
+	}
+	// A locked account can't authenticate, so it mustn't keep its sessions going either
+	if err := accountLocked("users.RefreshToken", u, now); err != nil {
+		log.Extract(ctx).Infof("RefreshToken failed: User %s is locked", u.ID)
+		return err
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Only revoke the token if no concurrent refresh rotated it first
+	n, err := tx.RefreshToken.Update().
+		Where(refreshtoken.ID(rt.ID), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to revoke refresh token of user %s: %v", u.ID, err)
+		return fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if n == 0 {
+		log.Extract(ctx).Infof("RefreshToken failed: refresh token of user %s was rotated concurrently", u.ID)
+		return fmt.Errorf("invalid refresh token")
+	}
+	token, next, err := issueRefreshToken(ctx, tx.Client(), u.ID, now)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to issue refresh token for user %s: %v", u.ID, err)
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		log.Extract(ctx).Errorf("Failed to commit refresh token rotation: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.User = toProtoUser(u)
+	rsp.Token = accessToken(u, now)
+	rsp.RefreshToken = token
+	rsp.RefreshTokenExpiresAt = next.ExpiresAt.Unix()
+	log.Extract(ctx).Infof("Refresh token rotated for user %s", u.ID)
+	return nil
+}
+
+// revokeRefreshToken revokes a refresh token so it can't be exchanged anymore. Unknown
+// and already revoked tokens succeed too, revealing nothing about them.
+func revokeRefreshToken(ctx context.Context, client *ent.Client, token string) error {
+	n, err := client.RefreshToken.Update().
+		Where(refreshtoken.TokenHash(hashRefreshToken(token)), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to revoke refresh token: %v", err)
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	log.Extract(ctx).Infof("Refresh token revoked (%d updated)", n)
+	return nil
+}
+
+// RevokeRefreshToken revokes a refresh token so it can't be exchanged anymore
+func (h *User) RevokeRefreshToken(ctx context.Context, req *pb.RevokeRefreshTokenRequest, rsp *pb.RevokeRefreshTokenResponse) error {
+	log.Extract(ctx).Infof("Received RevokeRefreshToken request")
+
+	if err := revokeRefreshToken(ctx, h.EntClient, req.RefreshToken); err != nil {
+		return err
+	}
+	rsp.Success = true
+	return nil
+}
+
+// Logout ends the session of a refresh token, succeeding even if it already ended
+func (h *User) Logout(ctx context.Context, req *pb.LogoutRequest, rsp *pb.LogoutResponse) error {
+	log.Extract(ctx).Infof("Received Logout request")
+
+	if err := revokeRefreshToken(ctx, h.EntClient, req.RefreshToken); err != nil {
+		return err
+	}
+	rsp.Success = true
+	return nil
+}
+
+// LogoutAll ends every session of a user, e.g. after their password changed
+func (h *User) LogoutAll(ctx context.Context, req *pb.LogoutAllRequest, rsp *pb.LogoutAllResponse) error {
+	log.Extract(ctx).Infof("Received LogoutAll request for user ID: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.UserId)
+	}
+
+	n, err := revokeUserRefreshTokens(ctx, h.EntClient, userID)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to revoke refresh tokens of user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to log out user: %w", err)
+	}
+
+	rsp.Success = true
+	rsp.RevokedCount = int32(n)
+	log.Extract(ctx).Infof("Logged user %s out of %d sessions", req.UserId, n)
+	return nil
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"users/ent"
+	pb "users/proto"
+)
+
+// authenticate logs username in with the test password and returns the response
+func authenticate(t *testing.T, h *User, username string) *pb.AuthenticateResponse {
+	t.Helper()
+	rsp := &pb.AuthenticateResponse{}
+	if err := h.Authenticate(context.Background(), &pb.AuthenticateRequest{EmailOrUsername: username, Password: "password123"}, rsp); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	return rsp
+}
+
+func TestLogoutSucceedsForRevokedTokens(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestUser(t, client, "alice")
+	h := &User{EntClient: client}
+	session := authenticate(t, h, "alice")
+
+	for i := 0; i < 2; i++ {
+		rsp := &pb.LogoutResponse{}
+		if err := h.Logout(ctx, &pb.LogoutRequest{RefreshToken: session.RefreshToken}, rsp); err != nil || !rsp.Success {
+			t.Fatalf("Logout #%d: success %v, error %v", i+1, rsp.Success, err)
+		}
+	}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: session.RefreshToken}, &pb.RefreshTokenResponse{}); err == nil {
+		t.Fatal("expected a logged out refresh token to be refused")
+	}
+}
+
+func TestConfirmPasswordResetLogsOutEverywhere(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	u := createTestUser(t, client, "alice")
+	h := &User{EntClient: client}
+	sessions := []*pb.AuthenticateResponse{authenticate(t, h, "alice"), authenticate(t, h, "alice")}
+
+	if err := h.ResetPassword(ctx, &pb.ResetPasswordRequest{Email: u.Email}, &pb.ResetPasswordResponse{}); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	token := *client.User.GetX(ctx, u.ID).ResetToken
+
+	rsp := &pb.ConfirmPasswordResetResponse{}
+	if err := h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: token, NewPassword: "new-secret"}, rsp); err != nil || !rsp.Success {
+		t.Fatalf("ConfirmPasswordReset: success %v, error %v", rsp.Success, err)
+	}
+
+	updated := client.User.GetX(ctx, u.ID)
+	if bcrypt.CompareHashAndPassword([]byte(updated.PasswordHash), []byte("new-secret")) != nil {
+		t.Fatal("expected the new password to be set")
+	}
+	if updated.ResetToken != nil {
+		t.Fatal("expected the reset token to be cleared")
+	}
+	for i, s := range sessions {
+		if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: s.RefreshToken}, &pb.RefreshTokenResponse{}); err == nil {
+			t.Fatalf("expected session %d to be logged out", i)
+		}
+	}
+
+	// The token works only once
+	if err := h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: token, NewPassword: "again"}, &pb.ConfirmPasswordResetResponse{}); err == nil {
+		t.Fatal("expected a used reset token to be refused")
+	}
+}
+
+func TestResetAndVerificationTokensAreNotInterchangeable(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+
+	rsp := &pb.CreateUserResponse{}
+	if err := h.CreateUser(ctx, &pb.CreateUserRequest{Username: "alice", Email: "alice@example.com", Password: "password123"}, rsp); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if err := h.ResetPassword(ctx, &pb.ResetPasswordRequest{Email: "alice@example.com"}, &pb.ResetPasswordResponse{}); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	u := client.User.Query().OnlyX(ctx)
+	verifyToken, resetToken := *u.VerificationToken, *u.ResetToken
+
+	err := h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: verifyToken, NewPassword: "new-secret"}, &pb.ConfirmPasswordResetResponse{})
+	if err == nil {
+		t.Fatal("expected a verification token to be refused as a reset token")
+	}
+	if err := h.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: resetToken}, &pb.VerifyEmailResponse{}); err == nil {
+		t.Fatal("expected a reset token to be refused as a verification token")
+	}
+
+	// Each still works for its own purpose
+	if err := h.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: verifyToken}, &pb.VerifyEmailResponse{}); err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	if err := h.ConfirmPasswordReset(ctx, &pb.ConfirmPasswordResetRequest{Token: resetToken, NewPassword: "new-secret"}, &pb.ConfirmPasswordResetResponse{}); err != nil {
+		t.Fatalf("ConfirmPasswordReset: %v", err)
+	}
+}
+
+func TestRefreshTokenRotatesOnce(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestUser(t, client, "alice")
+	h := &User{EntClient: client}
+	session := authenticate(t, h, "alice")
+
+	rotated := &pb.RefreshTokenResponse{}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: session.RefreshToken}, rotated); err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if rotated.Token == "" || rotated.RefreshToken == "" || rotated.RefreshToken == session.RefreshToken {
+		t.Fatalf("expected a new token pair, got access %q, refresh %q", rotated.Token, rotated.RefreshToken)
+	}
+	if _, err := parseAccessToken(rotated.Token, time.Now()); err != nil {
+		t.Fatalf("expected a valid access token, got %v", err)
+	}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: session.RefreshToken}, &pb.RefreshTokenResponse{}); err == nil {
+		t.Fatal("expected the rotated refresh token to be refused")
+	}
+}
+
+func TestRefreshTokenReuseRevokesEverySession(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createTestUser(t, client, "alice")
+	createTestUser(t, client, "bob")
+	h := &User{EntClient: client}
+	stolen, other, bystander := authenticate(t, h, "alice"), authenticate(t, h, "alice"), authenticate(t, h, "bob")
+
+	rotated := &pb.RefreshTokenResponse{}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: stolen.RefreshToken}, rotated); err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	// Presenting the rotated token again looks like theft
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: stolen.RefreshToken}, &pb.RefreshTokenResponse{}); err == nil {
+		t.Fatal("expected the reused refresh token to be refused")
+	}
+	for name, token := range map[string]string{"rotated": rotated.RefreshToken, "other": other.RefreshToken} {
+		if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: token}, &pb.RefreshTokenResponse{}); err == nil {
+			t.Errorf("expected the %s session revoked after reuse", name)
+		}
+	}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: bystander.RefreshToken}, &pb.RefreshTokenResponse{}); err != nil {
+		t.Fatalf("expected other users' sessions to survive, got %v", err)
+	}
+}
+
+func TestRefreshTokenRefusals(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+
+	expiring := createTestUser(t, client, "expiring")
+	token, _, err := issueRefreshToken(ctx, client, expiring.ID, time.Now().Add(-RefreshTokenTTL-time.Minute))
+	if err != nil {
+		t.Fatalf("issueRefreshToken: %v", err)
+	}
+	if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: token}, &pb.RefreshTokenResponse{}); err == nil {
+		t.Error("expected an expired refresh token to be refused")
+	}
+
+	// Each case leaves the user's refresh token unusable in a different way
+	cases := map[string]func(u *ent.User){
+		"deleted":   func(u *ent.User) { client.User.UpdateOne(u).SetDeletedAt(time.Now()).ExecX(ctx) },
+		"suspended": func(u *ent.User) { client.User.UpdateOne(u).SetIsActive(false).ExecX(ctx) },
+		"locked":    func(u *ent.User) { client.User.UpdateOne(u).SetLockedUntil(time.Now().Add(time.Hour)).ExecX(ctx) },
+	}
+	for name, spoil := range cases {
+		u := createTestUser(t, client, name)
+		session := authenticate(t, h, name)
+		spoil(u)
+		if err := h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: session.RefreshToken}, &pb.RefreshTokenResponse{}); err == nil {
+			t.Errorf("%s: expected the refresh token to be refused", name)
+		}
+	}
+
+	err = h.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: "unknown"}, &pb.RefreshTokenResponse{})
+	if err == nil {
+		t.Fatal("expected an unknown refresh token to be refused")
+	}
+}
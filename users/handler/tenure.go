@@ -0,0 +1,39 @@
+package handler
+
+import "time"
+
+// Tenure tiers reported on users
+const (
+	TenureNew     = "new"
+	TenureRegular = "regular"
+	TenureVeteran = "veteran"
+)
+
+// TenureThresholds are the account ages, in days, at which a user becomes regular and veteran
+type TenureThresholds struct {
+	Regular int
+	Veteran int
+}
+
+// Tenure is used by toProtoUser; main overrides the defaults from the environment
+var Tenure = TenureThresholds{Regular: 30, Veteran: 365}
+
+// accountAgeDays returns the number of whole days since createdAt, never negative
+func accountAgeDays(createdAt, now time.Time) int {
+	if now.Before(createdAt) {
+		return 0
+	}
+	return int(now.Sub(createdAt) / (24 * time.Hour))
+}
+
+// Tier returns the tenure tier of an account ageDays old; each threshold is inclusive
+func (t TenureThresholds) Tier(ageDays int) string {
+	switch {
+	case ageDays >= t.Veteran:
+		return TenureVeteran
+	case ageDays >= t.Regular:
+		return TenureRegular
+	default:
+		return TenureNew
+	}
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"users/ent"
+)
+
+func TestTenureTierBoundaries(t *testing.T) {
+	thresholds := TenureThresholds{Regular: 30, Veteran: 365}
+	tests := map[int]string{
+		0:   TenureNew,
+		29:  TenureNew,
+		30:  TenureRegular,
+		364: TenureRegular,
+		365: TenureVeteran,
+		900: TenureVeteran,
+	}
+	for age, want := range tests {
+		if got := thresholds.Tier(age); got != want {
+			t.Errorf("Tier(%d) = %s, want %s", age, got, want)
+		}
+	}
+}
+
+func TestAccountAgeDays(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		createdAt time.Time
+		want      int
+	}{
+		{now, 0},
+		{now.Add(-23 * time.Hour), 0},
+		{now.Add(-24 * time.Hour), 1},
+		{now.Add(-30*24*time.Hour - time.Hour), 30},
+		{now.Add(time.Hour), 0}, // clock skew never makes an age negative
+	}
+	for _, tt := range tests {
+		if got := accountAgeDays(tt.createdAt, now); got != tt.want {
+			t.Errorf("accountAgeDays(%s) = %d, want %d", now.Sub(tt.createdAt), got, tt.want)
+		}
+	}
+}
+
+func TestToProtoUserReportsTenure(t *testing.T) {
+	u := &ent.User{CreatedAt: time.Now().Add(-(time.Duration(Tenure.Regular)*24*time.Hour + time.Hour))}
+	p := toProtoUser(u)
+	if int(p.AccountAgeDays) != Tenure.Regular || p.TenureTier != TenureRegular {
+		t.Fatalf("expected a %d day old regular account, got %d days, %s", Tenure.Regular, p.AccountAgeDays, p.TenureTier)
+	}
+}
@@ -3,13 +3,18 @@ package handler
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"go-micro.dev/v5"
+	"go-micro.dev/v5/errors"
 	log "go-micro.dev/v5/logger"
 	"golang.org/x/crypto/bcrypt"
 
 	"users/ent"
+	"users/ent/predicate"
+	"users/ent/profile"
 	"users/ent/user"
 	pb "users/proto"
 )
@@ -17,65 +22,160 @@ import (
 // User implements the UserServer interface
 type User struct {
 	EntClient *ent.Client
+	// Events publishes the verification links new users are emailed; nil disables publishing
+	Events micro.Event
+	// EmailChangeEvents publishes the confirmations of requested email changes, sent to
+	// the new address; nil disables publishing
+	EmailChangeEvents micro.Event
+	// EmailChangeNotices publishes the notices of requested email changes, sent to the
+	// current address; nil disables publishing
+	EmailChangeNotices micro.Event
+	// Logins throttles repeated failed Authenticate attempts; nil disables throttling
+	Logins *LoginLimiter
 }
 
 // CreateUser handles the creation of a new user
 func (h *User) CreateUser(ctx context.Context, req *pb.CreateUserRequest, rsp *pb.CreateUserResponse) error {
-	log.Infof("Received CreateUser request from username: %s, email: %s", req.Username, req.Email)
+	log.Extract(ctx).Infof("Received CreateUser request from username: %s, email: %s", req.Username, req.Email)
+
+	if err := Limits.checkUser(req.Username, req.Email); err != nil {
+		log.Extract(ctx).Infof("Rejected CreateUser request: %v", err)
+		return err
+	}
+	if err := Limits.checkProfile(req.FirstName, req.LastName, req.Address, req.PhoneNumber); err != nil {
+		log.Extract(ctx).Infof("Rejected CreateUser request: %v", err)
+		return err
+	}
+
+	// Validate and normalize the email
+	email, err := normalizeEmail(req.Email)
+	if err != nil {
+		log.Extract(ctx).Infof("Invalid email format: %s", req.Email)
+		return err
+	}
 
 	// Hash the password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		log.Infof("Error hashing password: %v", err)
+		log.Extract(ctx).Errorf("Error hashing password: %v", err)
 		return err
 	}
 
-	// Create user using Entgo
-	u, err := h.EntClient.User.Create().
-		SetEmail(req.Email).
+	// Create the user and its profile together so neither exists without the other
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The token is emailed to the user, who passes it to VerifyEmail
+	verificationToken := uuid.New().String()
+	u, err := tx.User.Create().
+		SetEmail(email).
 		SetUsername(req.Username).
 		SetPasswordHash(string(hashedPassword)).
+		SetVerificationToken(verificationToken).
+		SetVerificationTokenExpiresAt(time.Now().Add(VerificationTokenTTL)).
+		SetEmailVerified(false).
 		Save(ctx)
 	if ent.IsConstraintError(err) {
-		log.Errorf("Contraint violation: %v", err)
+		log.Extract(ctx).Errorf("Contraint violation: %v", err)
+		if cerr := uniqueViolation("users.CreateUser", err); cerr != nil {
+			return cerr
+		}
 		return err
 	}
 	if err != nil {
-		log.Errorf("Failed to create user: %v", err)
+		log.Extract(ctx).Errorf("Failed to create user: %v", err)
 		return err
 	}
-	rsp.User = toProtoUser(u)
-	log.Infof("User created successfully: %s", u.ID)
+
+	if _, err := newProfile(tx, u, req).Save(ctx); err != nil {
+		log.Extract(ctx).Errorf("Failed to create profile for user %s: %v", u.ID, err)
+		return fmt.Errorf("failed to create profile: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Extract(ctx).Errorf("Failed to commit transaction: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	uWithProfile, err := h.EntClient.User.Query().Where(user.ID(u.ID)).WithProfile().Only(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to retrieve user with profile after creation: %v", err)
+		return fmt.Errorf("failed to retrieve user after creation: %w", err)
+	}
+
+	publishVerificationRequested(ctx, h.Events, u, verificationToken)
+
+	rsp.User = toProtoUser(uWithProfile)
+	log.Extract(ctx).Infof("User created successfully: %s", u.ID)
 	return nil
 }
 
-// GetUser handles fetching a user by ID
+// newProfile prepares the profile of a newly created user from the optional profile
+// fields of its create request
+func newProfile(tx *ent.Tx, u *ent.User, req *pb.CreateUserRequest) *ent.ProfileCreate {
+	create := tx.Profile.Create().SetUser(u)
+	if req.FirstName != "" {
+		create.SetFirstName(req.FirstName)
+	}
+	if req.LastName != "" {
+		create.SetLastName(req.LastName)
+	}
+	if req.DateOfBirth != nil {
+		create.SetDateOfBirth(time.Unix(*req.DateOfBirth, 0))
+	}
+	if req.Address != "" {
+		create.SetAddress(req.Address)
+	}
+	if req.PhoneNumber != "" {
+		create.SetPhoneNumber(req.PhoneNumber)
+	}
+	return create
+}
+
+// GetUser handles fetching a user by ID. Soft-deleted users are not found; AdminService
+// still reaches them.
 func (h *User) GetUser(ctx context.Context, req *pb.GetUserRequest, rsp *pb.GetUserResponse) error {
-	log.Infof("Received GetUser request for ID: %s", req.Id)
+	log.Extract(ctx).Infof("Received GetUser request for ID: %s", req.Id)
 
-	u, err := h.EntClient.User.Get(ctx, uuid.MustParse(req.Id))
+	u, err := h.EntClient.User.Query().
+		Where(user.ID(uuid.MustParse(req.Id)), user.DeletedAtIsNil()).
+		Only(ctx)
 	if ent.IsNotFound(err) {
-		log.Infof("User not found: %s", req.Id)
+		log.Extract(ctx).Infof("User not found: %s", req.Id)
 		return err
 	}
 	if err != nil {
-		log.Infof("Failed to get user: %v", err)
+		log.Extract(ctx).Errorf("Failed to get user: %v", err)
 		return err
 	}
 
 	rsp.User = toProtoUser(u)
-	log.Infof("User fetched successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User fetched successfully: %s", u.ID)
 	return nil
 }
 
 // UpdateUser handles updating an existing user
 func (h *User) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest, rsp *pb.UpdateUserResponse) error {
-	log.Infof("Received UpdateUser request for ID: %s", req.Id)
+	log.Extract(ctx).Infof("Received UpdateUser request for ID: %s", req.Id)
+
+	if err := Limits.checkUser(req.Username, req.Email); err != nil {
+		log.Extract(ctx).Infof("Rejected UpdateUser request: %v", err)
+		return err
+	}
 
 	updater := h.EntClient.User.UpdateOneID(uuid.MustParse(req.Id))
 
 	if req.Email != "" {
-		updater.Mutation().SetEmail(req.Email)
+		email, err := normalizeEmail(req.Email)
+		if err != nil {
+			log.Extract(ctx).Infof("Invalid email format: %s", req.Email)
+			return err
+		}
+		updater.Mutation().SetEmail(email)
 	}
 	if req.Username != "" {
 		updater.SetUsername(req.Username)
@@ -83,28 +183,61 @@ func (h *User) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest, rsp *p
 
 	u, err := updater.Save(ctx)
 	if ent.IsNotFound(err) {
-		log.Infof("User not found for update: %s", req.Id)
+		log.Extract(ctx).Infof("User not found for update: %s", req.Id)
 		return err
 	}
 	if ent.IsConstraintError(err) {
-		log.Infof("Constraint violation during update: %v", err)
+		log.Extract(ctx).Infof("Constraint violation during update: %v", err)
+		if cerr := uniqueViolation("users.UpdateUser", err); cerr != nil {
+			return cerr
+		}
 		return err
 	}
 	if err != nil {
-		log.Infof("Failed to update user: %v", err)
+		log.Extract(ctx).Errorf("Failed to update user: %v", err)
 		return err
 	}
 
 	rsp.User = toProtoUser(u)
-	log.Infof("User updated successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User updated successfully: %s", u.ID)
+	return nil
+}
+
+// DeleteUser soft-deletes a user. The row is kept so orders and products that
+// reference the user stay valid, and the email and username remain reserved so
+// the account can be restored; use ForceDeleteUser to release them.
+func (h *User) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest, rsp *pb.DeleteUserResponse) error {
+	log.Extract(ctx).Infof("Received DeleteUser request for ID: %s", req.Id)
+
+	userID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %s", req.Id)
+	}
+
+	err = h.EntClient.User.UpdateOneID(userID).
+		Where(user.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for deletion: %s", req.Id)
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to delete user: %v", err)
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	rsp.Id = req.Id
+	rsp.Success = true
+	log.Extract(ctx).Infof("User soft-deleted successfully: %s", req.Id)
 	return nil
 }
 
 // ListUsers handles listing all users with optional pagination
 func (h *User) ListUsers(ctx context.Context, req *pb.ListUsersRequest, rsp *pb.ListUsersResponse) error {
-	log.Infof("Received ListUsers request (limit: %d, offset: %d)", req.Limit, req.Offset)
+	log.Extract(ctx).Infof("Received ListUsers request (limit: %d, offset: %d)", req.Limit, req.Offset)
 
-	query := h.EntClient.User.Query()
+	query := h.EntClient.User.Query().Where(user.DeletedAtIsNil())
 
 	// Apply pagination
 	if req.Limit > 0 {
@@ -116,13 +249,13 @@ func (h *User) ListUsers(ctx context.Context, req *pb.ListUsersRequest, rsp *pb.
 
 	users, err := query.All(ctx)
 	if err != nil {
-		log.Infof("Failed to list users: %v", err)
+		log.Extract(ctx).Errorf("Failed to list users: %v", err)
 		return err
 	}
 
-	total, err := h.EntClient.User.Query().Count(ctx)
+	total, err := h.EntClient.User.Query().Where(user.DeletedAtIsNil()).Count(ctx)
 	if err != nil {
-		log.Infof("Failed to count users: %v", err)
+		log.Extract(ctx).Errorf("Failed to count users: %v", err)
 		return err
 	}
 
@@ -133,83 +266,112 @@ func (h *User) ListUsers(ctx context.Context, req *pb.ListUsersRequest, rsp *pb.
 
 	rsp.Users = protoUsers
 	rsp.Total = int32(total)
-	log.Infof("Listed %d users (total: %d)", len(protoUsers), total)
+	log.Extract(ctx).Infof("Listed %d users (total: %d)", len(protoUsers), total)
 	return nil
 }
 
 // Authenticate authenticates a user by email or username and password
 func (h *User) Authenticate(ctx context.Context, req *pb.AuthenticateRequest, rsp *pb.AuthenticateResponse) error {
-	log.Info("Received Authenticate request for: %s", req.EmailOrUsername)
+	log.Extract(ctx).Infof("Received Authenticate request for: %s", req.EmailOrUsername)
+
+	// Refuse attempts on an identifier, or from an IP, that has failed too often lately
+	limitID, limitIP := loginKeys(ctx, req.EmailOrUsername)
+	if err := h.Logins.allow(limitID, limitIP); err != nil {
+		log.Extract(ctx).Infof("Authentication throttled for %s: %v", req.EmailOrUsername, err)
+		return err
+	}
 
 	var u *ent.User
 	var err error
 
 	// Try to find user by email first, then by username
 	u, err = h.EntClient.User.Query().
-		Where(user.Email(req.EmailOrUsername)).
+		Where(user.Email(emailLookupKey(req.EmailOrUsername)), user.DeletedAtIsNil()).
 		WithProfile().
 		Only(ctx)
 	if ent.IsNotFound(err) {
 		u, err = h.EntClient.User.Query().
-			Where(user.Username(req.EmailOrUsername)).
+			Where(user.Username(req.EmailOrUsername), user.DeletedAtIsNil()).
 			WithProfile().
 			Only(ctx)
 	}
 
 	if ent.IsNotFound(err) {
-		log.Info("Authentication failed: User not found for %s", req.EmailOrUsername)
+		// Count unknown identifiers too, so throttling doesn't reveal which accounts exist
+		h.Logins.fail(limitID, limitIP)
+		log.Extract(ctx).Infof("Authentication failed: User not found for %s", req.EmailOrUsername)
 		return fmt.Errorf("invalid credentials: user not found")
 	}
 	if err != nil {
-		log.Info("Failed to query user for authentication: %v", err)
+		log.Extract(ctx).Errorf("Failed to query user for authentication: %v", err)
 		return fmt.Errorf("internal server error during authentication: %w", err)
 	}
 
+	// A locked account is refused before its password is checked, so guessing can't go on
+	now := time.Now()
+	if err := accountLocked("users.Authenticate", u, now); err != nil {
+		log.Extract(ctx).Infof("Authentication failed: User %s is locked until %s", u.ID, u.LockedUntil.Format(time.RFC3339))
+		return err
+	}
+
 	// Compare provided password with hashed password
 	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.Password)); err != nil {
-		log.Info("Authentication failed: Invalid password for user %s", u.ID)
+		h.Logins.fail(limitID, limitIP)
+		recordFailedLogin(ctx, h.EntClient, u, now)
+		log.Extract(ctx).Infof("Authentication failed: Invalid password for user %s", u.ID)
 		return fmt.Errorf("invalid credentials: incorrect password")
 	}
+	h.Logins.reset(limitID)
+	clearFailedLogins(ctx, h.EntClient, u)
 
 	// Check if user is active
 	if !u.IsActive {
-		log.Info("Authentication failed: User %s is inactive", u.ID)
+		log.Extract(ctx).Infof("Authentication failed: User %s is inactive", u.ID)
 		return fmt.Errorf("user account is inactive")
 	}
 
 	// Check if email is verified (optional, but good practice for production)
 	if !u.EmailVerified {
-		log.Info("Authentication failed: User %s email not verified", u.ID)
+		log.Extract(ctx).Infof("Authentication failed: User %s email not verified", u.ID)
 		return fmt.Errorf("email not verified")
 	}
 
-	// For production, generate a proper JWT or session token here
-	// For now, a dummy token
-	token := fmt.Sprintf("dummy_token_%s_%d", u.ID, time.Now().Unix())
+	token := accessToken(u, now)
+	refreshToken, rt, err := issueRefreshToken(ctx, h.EntClient, u.ID, now)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to issue refresh token for user %s: %v", u.ID, err)
+		return err
+	}
 
 	rsp.User = toProtoUser(u)
 	rsp.Token = token
-	log.Info("User %s authenticated successfully. Token: %s", u.ID, token)
+	rsp.RefreshToken = refreshToken
+	rsp.RefreshTokenExpiresAt = rt.ExpiresAt.Unix()
+	rsp.PasswordExpired = passwordExpired(u, time.Now())
+	if rsp.PasswordExpired {
+		log.Extract(ctx).Infof("User %s authenticated with an expired password", u.ID)
+	}
+	log.Extract(ctx).Infof("User %s authenticated successfully", u.ID)
 	return nil
 }
 
 // ChangePassword allows a user to change their password
 func (h *User) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest, rsp *pb.ChangePasswordResponse) error {
-	log.Info("Received ChangePassword request for user ID: %s", req.UserId)
+	log.Extract(ctx).Infof("Received ChangePassword request for user ID: %s", req.UserId)
 
-	u, err := h.EntClient.User.Query().Where(user.ID(uuid.MustParse(req.UserId))).Only(ctx)
+	u, err := h.EntClient.User.Query().Where(user.ID(uuid.MustParse(req.UserId)), user.DeletedAtIsNil()).Only(ctx)
 	if ent.IsNotFound(err) {
-		log.Info("ChangePassword failed: User not found for ID %s", req.UserId)
+		log.Extract(ctx).Infof("ChangePassword failed: User not found for ID %s", req.UserId)
 		return fmt.Errorf("user not found")
 	}
 	if err != nil {
-		log.Info("Failed to get user for password change: %v", err)
+		log.Extract(ctx).Errorf("Failed to get user for password change: %v", err)
 		return fmt.Errorf("internal server error: %w", err)
 	}
 
 	// Verify old password
 	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.OldPassword)); err != nil {
-		log.Info("ChangePassword failed: Incorrect old password for user %s", req.UserId)
+		log.Extract(ctx).Infof("ChangePassword failed: Incorrect old password for user %s", req.UserId)
 		rsp.Success = false
 		return fmt.Errorf("incorrect old password")
 	}
@@ -217,7 +379,7 @@ func (h *User) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest
 	// Hash new password
 	newHashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
 	if err != nil {
-		log.Info("Error hashing new password: %v", err)
+		log.Extract(ctx).Errorf("Error hashing new password: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("failed to hash new password: %w", err)
 	}
@@ -225,31 +387,38 @@ func (h *User) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest
 	// Update password hash
 	_, err = h.EntClient.User.UpdateOneID(uuid.MustParse(req.UserId)).
 		SetPasswordHash(string(newHashedPassword)).
+		SetPasswordChangedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
-		log.Info("Failed to update password for user %s: %v", u.ID, err)
+		log.Extract(ctx).Errorf("Failed to update password for user %s: %v", u.ID, err)
 		rsp.Success = false
 		return fmt.Errorf("failed to change password: %w", err)
 	}
 
+	// Sessions opened with the old password must authenticate again. The password has
+	// changed either way, so a failure is only logged.
+	if err := h.LogoutAll(ctx, &pb.LogoutAllRequest{UserId: req.UserId}, &pb.LogoutAllResponse{}); err != nil {
+		log.Extract(ctx).Errorf("Failed to log user %s out after password change: %v", req.UserId, err)
+	}
+
 	rsp.Success = true
-	log.Info("Password changed successfully for user: %s", req.UserId)
+	log.Extract(ctx).Infof("Password changed successfully for user: %s", req.UserId)
 	return nil
 }
 
 // ResetPassword initiates a password reset flow (in a real app, sends email)
 func (h *User) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest, rsp *pb.ResetPasswordResponse) error {
-	log.Info("Received ResetPassword request for email: %s", req.Email)
+	log.Extract(ctx).Infof("Received ResetPassword request for email: %s", req.Email)
 
-	u, err := h.EntClient.User.Query().Where(user.Email(req.Email)).Only(ctx)
+	u, err := h.EntClient.User.Query().Where(user.Email(emailLookupKey(req.Email)), user.DeletedAtIsNil()).Only(ctx)
 	if ent.IsNotFound(err) {
 		// Log but don't expose if user not found to prevent enumeration attacks
-		log.Info("ResetPassword request for non-existent email: %s", req.Email)
+		log.Extract(ctx).Infof("ResetPassword request for non-existent email: %s", req.Email)
 		rsp.Success = true // Still return success to avoid leaking user existence
 		return nil
 	}
 	if err != nil {
-		log.Info("Failed to get user for password reset: %v", err)
+		log.Extract(ctx).Errorf("Failed to get user for password reset: %v", err)
 		return fmt.Errorf("internal server error: %w", err)
 	}
 
@@ -258,115 +427,226 @@ func (h *User) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest,
 	resetToken := uuid.New().String()
 	// 2. Store this token and its expiry in the database (e.g., in a separate table or on the User schema).
 	_, err = h.EntClient.User.UpdateOneID(u.ID).
-		SetVerificationToken(resetToken). // Reusing verification_token for simplicity
+		SetResetToken(resetToken).
+		SetResetTokenExpiresAt(time.Now().Add(VerificationTokenTTL)).
 		Save(ctx)
 	if err != nil {
-		log.Info("Failed to save reset token for user %s: %v", u.ID, err)
+		log.Extract(ctx).Errorf("Failed to save reset token for user %s: %v", u.ID, err)
 		return fmt.Errorf("failed to initiate password reset: %w", err)
 	}
 	// 3. Send an email to req.Email with a link containing this resetToken.
-	log.Info("Password reset initiated for %s. Reset token: %s (in a real app, send via email)", req.Email, resetToken)
+	log.Extract(ctx).Infof("Password reset initiated for %s. Reset token: %s (in a real app, send via email)", req.Email, resetToken)
+
+	rsp.Success = true
+	return nil
+}
+
+// ConfirmPasswordReset sets a new password with the token ResetPassword issued, then logs
+// the user out everywhere
+func (h *User) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetRequest, rsp *pb.ConfirmPasswordResetResponse) error {
+	log.Extract(ctx).Info("Received ConfirmPasswordReset request with token.")
+
+	if req.Token == "" || req.NewPassword == "" {
+		return errors.BadRequest("users.ConfirmPasswordReset", "token and new_password are required")
+	}
+
+	u, err := h.EntClient.User.Query().Where(user.ResetToken(req.Token), user.DeletedAtIsNil()).Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Info("Password reset failed: Invalid or expired token.")
+		return fmt.Errorf("invalid or expired reset token")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to query user for password reset: %v", err)
+		return fmt.Errorf("internal server error during password reset: %w", err)
+	}
+	if u.ResetTokenExpiresAt == nil || time.Now().After(*u.ResetTokenExpiresAt) {
+		log.Extract(ctx).Infof("Password reset failed: Token expired for user %s", u.ID)
+		return fmt.Errorf("reset token expired, request a new one with ResetPassword")
+	}
+
+	newHashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Extract(ctx).Errorf("Error hashing new password: %v", err)
+		return fmt.Errorf("failed to hash new password: %w", err)
+	}
+
+	// Only the holder of this exact token may use it, and only once
+	n, err := h.EntClient.User.Update().
+		Where(user.ID(u.ID), user.ResetToken(req.Token)).
+		SetPasswordHash(string(newHashedPassword)).
+		SetPasswordChangedAt(time.Now()).
+		ClearResetToken().
+		ClearResetTokenExpiresAt().
+		Save(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to reset password for user %s: %v", u.ID, err)
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	if n == 0 {
+		log.Extract(ctx).Infof("Password reset failed: Token of user %s was used concurrently", u.ID)
+		return fmt.Errorf("invalid or expired reset token")
+	}
+
+	// Sessions opened with the old password must authenticate again. The password has
+	// changed either way, so a failure is only logged.
+	if err := h.LogoutAll(ctx, &pb.LogoutAllRequest{UserId: u.ID.String()}, &pb.LogoutAllResponse{}); err != nil {
+		log.Extract(ctx).Errorf("Failed to log user %s out after password reset: %v", u.ID, err)
+	}
 
 	rsp.Success = true
+	log.Extract(ctx).Infof("Password reset successfully for user: %s", u.ID)
 	return nil
 }
 
 // VerifyEmail verifies a user's email using a token
 func (h *User) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest, rsp *pb.VerifyEmailResponse) error {
-	log.Info("Received VerifyEmail request with token.")
+	log.Extract(ctx).Info("Received VerifyEmail request with token.")
 
 	u, err := h.EntClient.User.Query().Where(user.VerificationToken(req.Token)).Only(ctx)
 	if ent.IsNotFound(err) {
-		log.Info("Email verification failed: Invalid or expired token.")
+		log.Extract(ctx).Info("Email verification failed: Invalid or expired token.")
 		rsp.Success = false
 		return fmt.Errorf("invalid or expired verification token")
 	}
 	if err != nil {
-		log.Info("Failed to query user for email verification: %v", err)
+		log.Extract(ctx).Errorf("Failed to query user for email verification: %v", err)
 		rsp.Success = false
 		return fmt.Errorf("internal server error during email verification: %w", err)
 	}
 
 	if u.EmailVerified {
-		log.Info("Email for user %s is already verified.", u.ID)
+		log.Extract(ctx).Infof("Email for user %s is already verified.", u.ID)
 		rsp.Success = true
 		return nil // Already verified, idempotent
 	}
+	if time.Now().After(verificationTokenExpiresAt(u)) {
+		log.Extract(ctx).Infof("Email verification failed: Token expired for user %s", u.ID)
+		rsp.Success = false
+		return fmt.Errorf("verification token expired, request a new one with ResendVerification")
+	}
 
 	// Mark email as verified and clear the token
 	_, err = h.EntClient.User.UpdateOneID(u.ID).
 		SetEmailVerified(true).
 		ClearVerificationToken(). // Clear the token after use
+		ClearVerificationTokenExpiresAt().
 		Save(ctx)
 	if err != nil {
-		log.Info("Failed to update email verification status for user %s: %v", u.ID, err)
+		log.Extract(ctx).Errorf("Failed to update email verification status for user %s: %v", u.ID, err)
 		rsp.Success = false
 		return fmt.Errorf("failed to verify email: %w", err)
 	}
 
 	rsp.Success = true
-	log.Info("Email verified successfully for user: %s", u.ID)
+	log.Extract(ctx).Infof("Email verified successfully for user: %s", u.ID)
 	return nil
 }
 
-// GetUserByEmail gets a user by their email address
+// ResendVerification issues a new email verification token to an unverified user and
+// publishes it to be emailed, replacing the token sent before
+func (h *User) ResendVerification(ctx context.Context, req *pb.ResendVerificationRequest, rsp *pb.ResendVerificationResponse) error {
+	log.Extract(ctx).Infof("Received ResendVerification request for email: %s", req.Email)
+
+	// Succeed for unknown and already verified emails alike so callers can't probe for accounts
+	rsp.Success = true
+
+	u, err := h.EntClient.User.Query().Where(user.Email(emailLookupKey(req.Email)), user.DeletedAtIsNil()).Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("ResendVerification request for non-existent email: %s", req.Email)
+		return nil
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get user for verification resend: %v", err)
+		return fmt.Errorf("internal server error: %w", err)
+	}
+	if u.EmailVerified {
+		log.Extract(ctx).Infof("Email for user %s is already verified, not resending", u.ID)
+		return nil
+	}
+
+	verificationToken := uuid.New().String()
+	err = h.EntClient.User.UpdateOneID(u.ID).
+		SetVerificationToken(verificationToken).
+		SetVerificationTokenExpiresAt(time.Now().Add(VerificationTokenTTL)).
+		Exec(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to save verification token for user %s: %v", u.ID, err)
+		return fmt.Errorf("failed to resend verification: %w", err)
+	}
+
+	publishVerificationRequested(ctx, h.Events, u, verificationToken)
+	log.Extract(ctx).Infof("Verification resent for user: %s", u.ID)
+	return nil
+}
+
+// GetUserByEmail gets a user by their email address, hiding soft-deleted users
 func (h *User) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmailRequest, rsp *pb.GetUserResponse) error {
-	log.Info("Received GetUserByEmail request for email: %s", req.Email)
+	log.Extract(ctx).Infof("Received GetUserByEmail request for email: %s", req.Email)
 
 	u, err := h.EntClient.User.Query().
-		Where(user.Email(req.Email)).
+		Where(user.Email(emailLookupKey(req.Email)), user.DeletedAtIsNil()).
 		WithProfile().
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		log.Info("User not found for email: %s", req.Email)
-		return fmt.Errorf("user not found")
+		log.Extract(ctx).Infof("User not found for email: %s", req.Email)
+		return errors.NotFound("users.GetUserByEmail", "user not found")
 	}
 	if err != nil {
-		log.Info("Failed to get user by email: %v", err)
+		log.Extract(ctx).Errorf("Failed to get user by email: %v", err)
 		return fmt.Errorf("failed to get user by email: %w", err)
 	}
 
 	rsp.User = toProtoUser(u)
-	log.Info("User fetched by email successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User fetched by email successfully: %s", u.ID)
 	return nil
 }
 
-// GetUserByUsername gets a user by their username
+// GetUserByUsername gets a user by their username, hiding soft-deleted users
 func (h *User) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest, rsp *pb.GetUserResponse) error {
-	log.Info("Received GetUserByUsername request for username: %s", req.Username)
+	log.Extract(ctx).Infof("Received GetUserByUsername request for username: %s", req.Username)
 
 	u, err := h.EntClient.User.Query().
-		Where(user.Username(req.Username)).
+		Where(user.Username(req.Username), user.DeletedAtIsNil()).
 		WithProfile().
 		Only(ctx)
 	if ent.IsNotFound(err) {
-		log.Info("User not found for username: %s", req.Username)
+		log.Extract(ctx).Infof("User not found for username: %s", req.Username)
 		return fmt.Errorf("user not found")
 	}
 	if err != nil {
-		log.Info("Failed to get user by username: %v", err)
+		log.Extract(ctx).Errorf("Failed to get user by username: %v", err)
 		return fmt.Errorf("failed to get user by username: %w", err)
 	}
 
 	rsp.User = toProtoUser(u)
-	log.Info("User fetched by username successfully: %s", u.ID)
+	log.Extract(ctx).Infof("User fetched by username successfully: %s", u.ID)
 	return nil
 }
 
-// SearchUsers searches users by query string (username or email)
+// SearchUsers searches users by query string (username or email), hiding soft-deleted users
 func (h *User) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest, rsp *pb.SearchUsersResponse) error {
-	log.Info("Received SearchUsers request (query: %s, limit: %d, offset: %d)", req.Query, req.Limit, req.Offset)
+	log.Extract(ctx).Infof("Received SearchUsers request (query: %s, limit: %d, offset: %d)", req.Query, req.Limit, req.Offset)
 
-	queryBuilder := h.EntClient.User.Query().WithProfile()
+	// include_deleted is reserved for AdminService.SearchUsers
+	return searchUsers(ctx, h.EntClient, req, false, rsp)
+}
 
+// searchUsers matches users whose username or email contains the query, optionally
+// including soft-deleted ones. The total counts every match, ignoring limit/offset.
+func searchUsers(ctx context.Context, client *ent.Client, req *pb.SearchUsersRequest, includeDeleted bool, rsp *pb.SearchUsersResponse) error {
+	var predicates []predicate.User
+	if !includeDeleted {
+		predicates = append(predicates, user.DeletedAtIsNil())
+	}
 	if req.Query != "" {
-		searchStr := "%" + req.Query + "%"
-		queryBuilder.Where(user.Or(
-			user.UsernameContainsFold(searchStr),
-			user.EmailContainsFold(searchStr),
+		predicates = append(predicates, user.Or(
+			user.UsernameContainsFold(req.Query),
+			user.EmailContainsFold(req.Query),
 		))
 	}
 
+	queryBuilder := client.User.Query().Where(predicates...).WithProfile()
+
 	// Apply pagination
 	if req.Limit > 0 {
 		queryBuilder.Limit(int(req.Limit))
@@ -377,14 +657,14 @@ func (h *User) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest, rsp
 
 	users, err := queryBuilder.All(ctx)
 	if err != nil {
-		log.Info("Failed to search users: %v", err)
+		log.Extract(ctx).Errorf("Failed to search users: %v", err)
 		return fmt.Errorf("failed to search users: %w", err)
 
 	}
 
-	total, err := h.EntClient.User.Query().Count(ctx) // Count without limit/offset
+	total, err := client.User.Query().Where(predicates...).Count(ctx) // Count without limit/offset
 	if err != nil {
-		log.Info("Failed to count users for search: %v", err)
+		log.Extract(ctx).Errorf("Failed to count users for search: %v", err)
 		return fmt.Errorf("failed to count users for search: %w", err)
 	}
 
@@ -395,16 +675,129 @@ func (h *User) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest, rsp
 
 	rsp.Users = protoUsers
 	rsp.Total = int32(total)
-	log.Info("Found %d users matching query '%s' (total: %d)", len(protoUsers), req.Query, total)
+	log.Extract(ctx).Infof("Found %d users matching query '%s' (total: %d, include_deleted: %v)", len(protoUsers), req.Query, total, includeDeleted)
 	return nil
 }
 
+// GetProfile handles fetching the profile of a user
+func (h *User) GetProfile(ctx context.Context, req *pb.GetProfileRequest, rsp *pb.GetProfileResponse) error {
+	log.Extract(ctx).Infof("Received GetProfile request for user ID: %s", req.UserId)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+
+	p, err := h.EntClient.Profile.Query().Where(profile.HasUserWith(user.ID(userID))).Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("Profile not found for user: %s", req.UserId)
+		return fmt.Errorf("profile not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get profile: %v", err)
+		return fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	rsp.Profile = toProtoProfile(p)
+	log.Extract(ctx).Infof("Profile fetched successfully for user: %s", req.UserId)
+	return nil
+}
+
+// UpdateProfile updates the supplied fields of a user's profile, creating the profile if it doesn't exist
+func (h *User) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest, rsp *pb.UpdateProfileResponse) error {
+	log.Extract(ctx).Infof("Received UpdateProfile request for user ID: %s", req.UserId)
+
+	if err := Limits.checkProfile(req.FirstName, req.LastName, req.Address, req.PhoneNumber); err != nil {
+		log.Extract(ctx).Infof("Rejected UpdateProfile request: %v", err)
+		return err
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %s", req.UserId)
+	}
+
+	tx, err := h.EntClient.Tx(ctx)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to start transaction: %v", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.ID(userID)).WithProfile().Only(ctx)
+	if ent.IsNotFound(err) {
+		log.Extract(ctx).Infof("User not found for profile update: %s", req.UserId)
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to get user for profile update: %v", err)
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	p, err := saveProfile(ctx, tx, u, req)
+	if err != nil {
+		log.Extract(ctx).Errorf("Failed to save profile for user %s: %v", req.UserId, err)
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Extract(ctx).Errorf("Failed to commit profile update: %v", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	rsp.Profile = toProtoProfile(p)
+	log.Extract(ctx).Infof("Profile updated successfully for user: %s", req.UserId)
+	return nil
+}
+
+// saveProfile applies the non-empty fields of req, and its date of birth when set, to u's
+// profile, creating the profile if u has none yet; u must have its profile edge loaded
+func saveProfile(ctx context.Context, tx *ent.Tx, u *ent.User, req *pb.UpdateProfileRequest) (*ent.Profile, error) {
+	if u.Edges.Profile == nil {
+		creator := tx.Profile.Create().SetUser(u)
+		if req.FirstName != "" {
+			creator.SetFirstName(req.FirstName)
+		}
+		if req.LastName != "" {
+			creator.SetLastName(req.LastName)
+		}
+		if req.DateOfBirth != nil {
+			creator.SetDateOfBirth(time.Unix(*req.DateOfBirth, 0))
+		}
+		if req.Address != "" {
+			creator.SetAddress(req.Address)
+		}
+		if req.PhoneNumber != "" {
+			creator.SetPhoneNumber(req.PhoneNumber)
+		}
+		return creator.Save(ctx)
+	}
+
+	updater := tx.Profile.UpdateOne(u.Edges.Profile)
+	if req.FirstName != "" {
+		updater.SetFirstName(req.FirstName)
+	}
+	if req.LastName != "" {
+		updater.SetLastName(req.LastName)
+	}
+	if req.DateOfBirth != nil {
+		updater.SetDateOfBirth(time.Unix(*req.DateOfBirth, 0))
+	}
+	if req.Address != "" {
+		updater.SetAddress(req.Address)
+	}
+	if req.PhoneNumber != "" {
+		updater.SetPhoneNumber(req.PhoneNumber)
+	}
+	return updater.Save(ctx)
+}
+
 // toProtoUser converts an Entgo User entity to a Protobuf User message
 func toProtoUser(u *ent.User) *pb.User {
 	if u == nil {
 		return nil
 	}
-	return &pb.User{
+	protoUser := &pb.User{
 		Id:           u.ID.String(),
 		Email:        u.Email,
 		Username:     u.Username,
@@ -412,5 +805,50 @@ func toProtoUser(u *ent.User) *pb.User {
 		CreatedAt:    u.CreatedAt.Unix(),
 		UpdatedAt:    u.UpdatedAt.Unix(),
 		IsActive:     u.IsActive,
+		Role:         u.Role.String(),
+		Profile:      toProtoProfile(u.Edges.Profile), // nil unless the profile edge was loaded
+	}
+	age := accountAgeDays(u.CreatedAt, time.Now())
+	protoUser.AccountAgeDays = int32(age)
+	protoUser.TenureTier = Tenure.Tier(age)
+	if u.DeletedAt != nil {
+		protoUser.DeletedAt = u.DeletedAt.Unix()
+	}
+	protoUser.PasswordChangedAt = passwordChangedAt(u).Unix()
+	if u.PendingEmail != nil {
+		protoUser.PendingEmail = *u.PendingEmail
+	}
+	protoUser.FailedLoginCount = int32(u.FailedLoginCount)
+	if u.LockedUntil != nil {
+		protoUser.LockedUntil = u.LockedUntil.Unix()
+	}
+	return protoUser
+}
+
+// toProtoProfile converts an Entgo Profile entity to a Protobuf Profile message
+func toProtoProfile(p *ent.Profile) *pb.Profile {
+	if p == nil {
+		return nil
+	}
+	protoProfile := &pb.Profile{
+		Id:        strconv.Itoa(p.ID),
+		CreatedAt: p.CreatedAt.Unix(),
+		UpdatedAt: p.UpdatedAt.Unix(),
+	}
+	if p.FirstName != nil {
+		protoProfile.FirstName = *p.FirstName
+	}
+	if p.LastName != nil {
+		protoProfile.LastName = *p.LastName
+	}
+	if p.DateOfBirth != nil {
+		protoProfile.DateOfBirth = p.DateOfBirth.Unix()
+	}
+	if p.Address != nil {
+		protoProfile.Address = *p.Address
+	}
+	if p.PhoneNumber != nil {
+		protoProfile.PhoneNumber = *p.PhoneNumber
 	}
+	return protoProfile
 }
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"users/ent/profile"
+	"users/ent/user"
+
+	pb "users/proto"
+)
+
+func TestDateOfBirthBefore1970RoundTrips(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	born := time.Date(1958, time.March, 14, 0, 0, 0, 0, time.UTC).Unix()
+	getDOB := func(id string) int64 {
+		t.Helper()
+		p, err := client.Profile.Query().Where(profile.HasUserWith(user.ID(uuid.MustParse(id)))).Only(ctx)
+		if err != nil {
+			t.Fatalf("query profile: %v", err)
+		}
+		return toProtoProfile(p).DateOfBirth
+	}
+
+	created := &pb.CreateUserResponse{}
+	err := h.CreateUser(ctx, &pb.CreateUserRequest{
+		Username:    "alice",
+		Email:       "alice@example.com",
+		Password:    "password123",
+		DateOfBirth: proto.Int64(born),
+	}, created)
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if got := getDOB(created.User.Id); got != born {
+		t.Fatalf("expected the created date of birth %d, got %d", born, got)
+	}
+
+	// An update without a date of birth leaves it alone; one with a date sets it
+	update := func(req *pb.UpdateProfileRequest) {
+		t.Helper()
+		req.UserId = created.User.Id
+		if err := h.UpdateProfile(ctx, req, &pb.UpdateProfileResponse{}); err != nil {
+			t.Fatalf("UpdateProfile: %v", err)
+		}
+	}
+	update(&pb.UpdateProfileRequest{FirstName: "Alice"})
+	if got := getDOB(created.User.Id); got != born {
+		t.Fatalf("expected the date of birth unchanged, got %d", got)
+	}
+	earlier := time.Date(1931, time.July, 1, 0, 0, 0, 0, time.UTC).Unix()
+	update(&pb.UpdateProfileRequest{DateOfBirth: proto.Int64(earlier)})
+	if got := getDOB(created.User.Id); got != earlier {
+		t.Fatalf("expected the updated date of birth %d, got %d", earlier, got)
+	}
+
+	// Bulk updates take the same path
+	stream := &fakeProfileStream{updates: []*pb.UpdateProfileRequest{{UserId: created.User.Id, DateOfBirth: proto.Int64(born)}}}
+	if err := (&AdminService{EntClient: client}).BulkUpdateProfiles(ctx, stream); err != nil {
+		t.Fatalf("BulkUpdateProfiles: %v", err)
+	}
+	if got := getDOB(created.User.Id); got != born {
+		t.Fatalf("expected the bulk-updated date of birth %d, got %d", born, got)
+	}
+}
+
+func TestLookupsHideDeletedUsers(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	h := &User{EntClient: client}
+	u := createTestUser(t, client, "alice")
+
+	lookups := map[string]func() error{
+		"GetUser": func() error {
+			return h.GetUser(ctx, &pb.GetUserRequest{Id: u.ID.String()}, &pb.GetUserResponse{})
+		},
+		"GetUserByEmail": func() error {
+			return h.GetUserByEmail(ctx, &pb.GetUserByEmailRequest{Email: "alice@example.com"}, &pb.GetUserResponse{})
+		},
+		"GetUserByUsername": func() error {
+			return h.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{Username: "alice"}, &pb.GetUserResponse{})
+		},
+	}
+	for name, lookup := range lookups {
+		if err := lookup(); err != nil {
+			t.Fatalf("%s: expected the user found, got %v", name, err)
+		}
+	}
+
+	client.User.UpdateOneID(u.ID).SetDeletedAt(time.Now()).ExecX(ctx)
+	for name, lookup := range lookups {
+		if err := lookup(); err == nil {
+			t.Errorf("%s: expected the deleted user not found", name)
+		}
+	}
+
+	// Admins still reach them
+	rsp := &pb.SearchUsersResponse{}
+	if err := (&AdminService{EntClient: client}).SearchUsers(ctx, &pb.SearchUsersRequest{Query: "alice", Limit: 10, IncludeDeleted: true}, rsp); err != nil {
+		t.Fatalf("SearchUsers: %v", err)
+	}
+	if len(rsp.Users) != 1 || rsp.Users[0].Id != u.ID.String() {
+		t.Fatalf("expected admins to find the deleted user, got %v", rsp.Users)
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+// normalizeEmail validates a bare email address and returns it lowercased
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", fmt.Errorf("invalid email format")
+	}
+	return strings.ToLower(addr.Address), nil
+}
+
+// emailLookupKey normalizes an email the same way it is stored so lookups are case-insensitive
+func emailLookupKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// FieldLimits caps the length, in characters, of free-form user input; zero disables a limit
+type FieldLimits struct {
+	Username    int
+	Email       int
+	Name        int // First and last name
+	Address     int
+	PhoneNumber int
+}
+
+// Limits are enforced by the handlers; main overrides the defaults from the environment
+var Limits = FieldLimits{Username: 50, Email: 254, Name: 100, Address: 500, PhoneNumber: 32}
+
+// checkUser validates the account fields of a create or update request
+func (l FieldLimits) checkUser(username, email string) error {
+	if err := checkLength("username", username, l.Username); err != nil {
+		return err
+	}
+	return checkLength("email", email, l.Email)
+}
+
+// checkProfile validates the profile fields of a create or update request
+func (l FieldLimits) checkProfile(firstName, lastName, address, phoneNumber string) error {
+	if err := checkLength("first_name", firstName, l.Name); err != nil {
+		return err
+	}
+	if err := checkLength("last_name", lastName, l.Name); err != nil {
+		return err
+	}
+	if err := checkLength("address", address, l.Address); err != nil {
+		return err
+	}
+	return checkLength("phone_number", phoneNumber, l.PhoneNumber)
+}
+
+// checkLength rejects a value longer than max characters, naming the offending field
+func checkLength(field, value string, max int) error {
+	if max > 0 && utf8.RuneCountInString(value) > max {
+		return fmt.Errorf("%s exceeds the maximum length of %d characters", field, max)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "users/proto"
+)
+
+func TestCreateUserRejectsOverLengthFields(t *testing.T) {
+	client := newTestClient(t)
+	h := &User{EntClient: client}
+	valid := func() *pb.CreateUserRequest {
+		return &pb.CreateUserRequest{Username: "alice", Email: "alice@example.com", Password: "password123"}
+	}
+
+	tests := map[string]func(*pb.CreateUserRequest){
+		"username":     func(r *pb.CreateUserRequest) { r.Username = strings.Repeat("a", Limits.Username+1) },
+		"email":        func(r *pb.CreateUserRequest) { r.Email = strings.Repeat("a", Limits.Email) + "@example.com" },
+		"first_name":   func(r *pb.CreateUserRequest) { r.FirstName = strings.Repeat("é", Limits.Name+1) },
+		"address":      func(r *pb.CreateUserRequest) { r.Address = strings.Repeat("a", Limits.Address+1) },
+		"phone_number": func(r *pb.CreateUserRequest) { r.PhoneNumber = strings.Repeat("1", Limits.PhoneNumber+1) },
+	}
+	for field, modify := range tests {
+		req := valid()
+		modify(req)
+		err := h.CreateUser(context.Background(), req, &pb.CreateUserResponse{})
+		if err == nil || !strings.HasPrefix(err.Error(), field+" exceeds the maximum length") {
+			t.Errorf("%s: expected a length error naming the field, got %v", field, err)
+		}
+	}
+	if n := client.User.Query().CountX(context.Background()); n != 0 {
+		t.Fatalf("expected no users stored, found %d", n)
+	}
+
+	// Limits count characters, not bytes
+	req := valid()
+	req.FirstName = strings.Repeat("é", Limits.Name)
+	if err := h.CreateUser(context.Background(), req, &pb.CreateUserResponse{}); err != nil {
+		t.Fatalf("expected a name at the limit accepted, got %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"time"
+
+	"users/ent"
+)
+
+// VerificationTokenTTL is how long a verification token is accepted after it is issued.
+// main overrides it from the environment.
+var VerificationTokenTTL = 24 * time.Hour
+
+// verificationTokenExpiresAt returns when u's verification token expires, counting from
+// account creation for tokens issued before expiry was tracked
+func verificationTokenExpiresAt(u *ent.User) time.Time {
+	if u.VerificationTokenExpiresAt != nil {
+		return *u.VerificationTokenExpiresAt
+	}
+	return u.CreatedAt.Add(VerificationTokenTTL)
+}
@@ -2,14 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"log"
+	"os"
+	"strings"
 	"time"
-	"users/ent"
 	"users/handler"
+	"users/seed"
 
-	_ "github.com/mattn/go-sqlite3" // Import for SQLite driver
-
-	"entgo.io/ent/dialect"
 	"go-micro.dev/v5"
 	"go-micro.dev/v5/logger"
 
@@ -17,30 +17,129 @@ import (
 )
 
 func main() {
-	// Initialize EntgoClient
-	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	// Initialize EntgoClient from DB_DRIVER and DB_DSN
+	client, db, driver, err := openDB()
 	if err != nil {
-		logger.Fatalf("Failed opening connection to sqlite: %v", err)
+		logger.Fatalf("Failed opening connection to %s: %v", driver, err)
 	}
 	defer client.Close()
 
+	// Report not ready until migrations and seeding are done; HEALTH_ADDR enables HTTP probes
+	health := &handler.Health{DB: db}
+	if addr := os.Getenv("HEALTH_ADDR"); addr != "" {
+		go func() {
+			if err := health.ServeProbes(addr); err != nil {
+				logger.Errorf("Health probe server stopped: %v", err)
+			}
+		}()
+	}
+
+	// Record RPC count, error and latency metrics; METRICS_ADDR exposes them at /metrics
+	metrics := handler.NewMetrics()
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		go func() {
+			if err := metrics.Serve(addr); err != nil {
+				logger.Errorf("Metrics server stopped: %v", err)
+			}
+		}()
+	}
+
 	// Run the auto migration tool. This will create table and columns in the database
 	ctx := context.Background()
 	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("Failed creating schema resources: %v", err)
 	}
 
+	// Load sample fixtures for local development when SEED names a fixture set
+	if set := os.Getenv("SEED"); set != "" {
+		if err := seed.Load(ctx, client, seed.FixtureSet(set)); err != nil {
+			logger.Fatalf("Failed seeding database with %q fixtures: %v", set, err)
+		}
+		logger.Infof("Seeded database with %q fixtures", set)
+	}
+
+	// Grant the admin role to the comma-separated ADMIN_EMAILS, so a fresh deployment has
+	// someone who can call AdminService
+	if emails := os.Getenv("ADMIN_EMAILS"); emails != "" {
+		n, err := handler.PromoteAdmins(ctx, client, strings.Split(emails, ","))
+		if err != nil {
+			logger.Fatalf("Failed granting the admin role to ADMIN_EMAILS: %v", err)
+		}
+		logger.Infof("Granted the admin role to %d users from ADMIN_EMAILS", n)
+	}
+
+	// Configure input length limits; 0 disables a limit
+	handler.Limits = handler.FieldLimits{
+		Username:    envInt("MAX_USERNAME_LENGTH", handler.Limits.Username),
+		Email:       envInt("MAX_EMAIL_LENGTH", handler.Limits.Email),
+		Name:        envInt("MAX_NAME_LENGTH", handler.Limits.Name),
+		Address:     envInt("MAX_ADDRESS_LENGTH", handler.Limits.Address),
+		PhoneNumber: envInt("MAX_PHONE_NUMBER_LENGTH", handler.Limits.PhoneNumber),
+	}
+
+	// Configure the account ages, in days, at which users become regular and veteran
+	handler.Tenure = handler.TenureThresholds{
+		Regular: envInt("TENURE_REGULAR_DAYS", handler.Tenure.Regular),
+		Veteran: envInt("TENURE_VETERAN_DAYS", handler.Tenure.Veteran),
+	}
+	if handler.Tenure.Veteran < handler.Tenure.Regular {
+		logger.Fatalf("TENURE_VETERAN_DAYS (%d) must not be below TENURE_REGULAR_DAYS (%d)", handler.Tenure.Veteran, handler.Tenure.Regular)
+	}
+
+	// Lock accounts for a while after repeated wrong passwords; 0 failures disables locking
+	handler.Lockout = handler.LockoutPolicy{
+		MaxFailures: envInt("LOCKOUT_MAX_FAILURES", handler.Lockout.MaxFailures),
+		Duration:    envDuration("LOCKOUT_DURATION", handler.Lockout.Duration),
+	}
+
+	// Require periodic password changes when a max age is configured
+	handler.PasswordMaxAge = envDuration("PASSWORD_MAX_AGE", 0)
+
+	// How long an email change can wait for confirmation
+	handler.EmailChangeTTL = envDuration("EMAIL_CHANGE_TTL", handler.EmailChangeTTL)
+
+	// Sign access tokens with JWT_SECRET; without one, tokens stop verifying on restart
+	handler.AccessTokenTTL = envDuration("ACCESS_TOKEN_TTL", handler.AccessTokenTTL)
+	handler.SigningKey = []byte(os.Getenv("JWT_SECRET"))
+	if len(handler.SigningKey) == 0 {
+		handler.SigningKey = make([]byte, 32)
+		if _, err := rand.Read(handler.SigningKey); err != nil {
+			logger.Fatalf("Failed generating access token signing key: %v", err)
+		}
+		logger.Warn("JWT_SECRET not set, signing access tokens with a random key")
+	}
+
+	// How long a session can be refreshed without authenticating again
+	handler.RefreshTokenTTL = envDuration("REFRESH_TOKEN_TTL", handler.RefreshTokenTTL)
+
+	// How long verification links stay valid
+	handler.VerificationTokenTTL = envDuration("VERIFICATION_TOKEN_TTL", handler.VerificationTokenTTL)
+
+	// Advertise the deployment's region, if any, and the verification link lifetime
+	metadata := serviceMetadata(os.Getenv("REGION"))
+	metadata["VerificationTokenTTL"] = handler.VerificationTokenTTL.String()
+
 	// Create a new service
 	service := micro.NewService(
 		micro.Name("users"),
 		micro.Version("latest"),
-		micro.Metadata(map[string]string{
-			"StartTime": time.Now().String(),
-		}),
+		micro.Metadata(metadata),
+		micro.WrapHandler(metrics.Wrapper()),
+		micro.WrapHandler(handler.CorrelationWrapper()),
+		// Only admins may call AdminService
+		micro.WrapHandler(handler.AdminAuthWrapper()),
 		micro.BeforeStart(func() error {
 			logger.Info("Server service starting...")
 			return nil
 		}),
+		micro.AfterStart(func() error {
+			health.SetReady(true)
+			return nil
+		}),
+		micro.BeforeStop(func() error {
+			health.SetReady(false)
+			return nil
+		}),
 		micro.AfterStop(func() error {
 			logger.Info("User service stopped")
 			return nil
@@ -50,12 +149,35 @@ func main() {
 	// Initialize service
 	service.Init()
 
+	// Register Health.Check and Debug.Health for orchestrator probes
+	if err := health.Register(service.Server()); err != nil {
+		logger.Fatalf("Failed to register health handler: %v", err)
+	}
+
 	// Register UserService handler
-	if err := pb.RegisterUserServiceHandler(service.Server(), &handler.User{EntClient: client}); err != nil {
+	// Publish verification links and email change confirmations and notices so an email service can send them
+	if err := pb.RegisterUserServiceHandler(service.Server(), &handler.User{
+		EntClient:          client,
+		Events:             micro.NewEvent(handler.VerificationRequestedTopic, service.Client()),
+		EmailChangeEvents:  micro.NewEvent(handler.EmailChangeRequestedTopic, service.Client()),
+		EmailChangeNotices: micro.NewEvent(handler.EmailChangeNoticeTopic, service.Client()),
+		// Refuse logins for the rest of the window once an identifier or IP fails too often; 0 disables a limit
+		Logins: &handler.LoginLimiter{
+			MaxFailures:      envInt("LOGIN_MAX_FAILURES", 5),
+			MaxFailuresPerIP: envInt("LOGIN_MAX_FAILURES_PER_IP", 50),
+			Window:           envDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		},
+	}); err != nil {
 		logger.Fatalf("failed to register user service handler: %v", err)
 	}
 
-	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{EntClient: client}); err != nil {
+	// Users soft-deleted for longer than the retention window can be hard-purged by admins
+	purger := &handler.UserPurger{
+		EntClient: client,
+		Retention: envDuration("SOFT_DELETE_RETENTION", 30*24*time.Hour),
+		BatchSize: envInt("PURGE_BATCH_SIZE", 500),
+	}
+	if err := pb.RegisterAdminServiceHandler(service.Server(), &handler.AdminService{EntClient: client, Purger: purger}); err != nil {
 		logger.Fatalf("failed to register admin service handler: %v", err)
 	}
 
@@ -27,7 +27,7 @@ type Profile struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
 	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth   int64                  `protobuf:"varint,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"` // Unix timestamp
+	DateOfBirth   int64                  `protobuf:"varint,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"` // Unix timestamp, negative before 1970
 	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 	PhoneNumber   string                 `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
@@ -124,17 +124,25 @@ func (x *Profile) GetUpdatedAt() int64 {
 
 // User represents a user in the system
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier for the user
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	PasswordHash  string                 `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"` // Should be handled securely (e.g., never send plain password)
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // Unix timestamp
-	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Profile       *Profile               `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"` // Embed the profile message
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier for the user
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username          string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash      string                 `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"` // Should be handled securely (e.g., never send plain password)
+	CreatedAt         int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	UpdatedAt         int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // Unix timestamp
+	IsActive          bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Profile           *Profile               `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`                                                  // Embed the profile message
+	DeletedAt         int64                  `protobuf:"varint,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                            // Unix timestamp, 0 unless the user is soft-deleted
+	AccountAgeDays    int32                  `protobuf:"varint,10,opt,name=account_age_days,json=accountAgeDays,proto3" json:"account_age_days,omitempty"`          // Whole days since created_at
+	TenureTier        string                 `protobuf:"bytes,11,opt,name=tenure_tier,json=tenureTier,proto3" json:"tenure_tier,omitempty"`                         // new, regular or veteran, derived from account_age_days
+	PasswordChangedAt int64                  `protobuf:"varint,12,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"` // Unix timestamp the password was last set
+	PendingEmail      string                 `protobuf:"bytes,13,opt,name=pending_email,json=pendingEmail,proto3" json:"pending_email,omitempty"`                   // Address awaiting ConfirmEmailChange, empty if none
+	FailedLoginCount  int32                  `protobuf:"varint,14,opt,name=failed_login_count,json=failedLoginCount,proto3" json:"failed_login_count,omitempty"`    // Wrong passwords given in a row since the last successful login or lock
+	LockedUntil       int64                  `protobuf:"varint,15,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`                     // Unix timestamp logins are refused until, 0 unless the account was locked
+	Role              string                 `protobuf:"bytes,16,opt,name=role,proto3" json:"role,omitempty"`                                                       // user or admin
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -223,6 +231,62 @@ func (x *User) GetProfile() *Profile {
 	return nil
 }
 
+func (x *User) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *User) GetAccountAgeDays() int32 {
+	if x != nil {
+		return x.AccountAgeDays
+	}
+	return 0
+}
+
+func (x *User) GetTenureTier() string {
+	if x != nil {
+		return x.TenureTier
+	}
+	return ""
+}
+
+func (x *User) GetPasswordChangedAt() int64 {
+	if x != nil {
+		return x.PasswordChangedAt
+	}
+	return 0
+}
+
+func (x *User) GetPendingEmail() string {
+	if x != nil {
+		return x.PendingEmail
+	}
+	return ""
+}
+
+func (x *User) GetFailedLoginCount() int32 {
+	if x != nil {
+		return x.FailedLoginCount
+	}
+	return 0
+}
+
+func (x *User) GetLockedUntil() int64 {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return 0
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 // Request message for creating a user
 type CreateUserRequest struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
@@ -232,7 +296,7 @@ type CreateUserRequest struct {
 	// Optional profile data
 	FirstName     string `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
 	LastName      string `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth   int64  `protobuf:"varint,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	DateOfBirth   *int64 `protobuf:"varint,6,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"` // Unix timestamp, negative before 1970
 	Address       string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
 	PhoneNumber   string `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -305,8 +369,8 @@ func (x *CreateUserRequest) GetLastName() string {
 }
 
 func (x *CreateUserRequest) GetDateOfBirth() int64 {
-	if x != nil {
-		return x.DateOfBirth
+	if x != nil && x.DateOfBirth != nil {
+		return *x.DateOfBirth
 	}
 	return 0
 }
@@ -721,28 +785,31 @@ func (x *ListUsersResponse) GetTotal() int32 {
 	return 0
 }
 
-// Request message for a forced user deletion (Admin operation)
-type ForceDeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Response message after bulk creating users (Admin operation); users and total keep the
+// field numbers of ListUsersResponse, which it used to return
+type BulkCreateUsersResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Users         []*User                  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Failures      []*BulkCreateUserFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"` // Inputs that were not created, in stream order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteUserRequest) Reset() {
-	*x = ForceDeleteUserRequest{}
+func (x *BulkCreateUsersResponse) Reset() {
+	*x = BulkCreateUsersResponse{}
 	mi := &file_proto_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteUserRequest) String() string {
+func (x *BulkCreateUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteUserRequest) ProtoMessage() {}
+func (*BulkCreateUsersResponse) ProtoMessage() {}
 
-func (x *ForceDeleteUserRequest) ProtoReflect() protoreflect.Message {
+func (x *BulkCreateUsersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,41 +821,57 @@ func (x *ForceDeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*ForceDeleteUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkCreateUsersResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateUsersResponse) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ForceDeleteUserRequest) GetId() string {
+func (x *BulkCreateUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Id
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-// Response message for a forced user deletion
-type ForceDeleteUserResponse struct {
+func (x *BulkCreateUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkCreateUsersResponse) GetFailures() []*BulkCreateUserFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// BulkCreateUserFailure is one input of BulkCreateUsers that could not be created
+type BulkCreateUserFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the deleted user
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`      // Zero-based position of the input in the stream
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // As submitted
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`       // As submitted
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceDeleteUserResponse) Reset() {
-	*x = ForceDeleteUserResponse{}
+func (x *BulkCreateUserFailure) Reset() {
+	*x = BulkCreateUserFailure{}
 	mi := &file_proto_users_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceDeleteUserResponse) String() string {
+func (x *BulkCreateUserFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceDeleteUserResponse) ProtoMessage() {}
+func (*BulkCreateUserFailure) ProtoMessage() {}
 
-func (x *ForceDeleteUserResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkCreateUserFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,47 +883,63 @@ func (x *ForceDeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceDeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*ForceDeleteUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkCreateUserFailure.ProtoReflect.Descriptor instead.
+func (*BulkCreateUserFailure) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ForceDeleteUserResponse) GetId() string {
+func (x *BulkCreateUserFailure) GetIndex() int32 {
 	if x != nil {
-		return x.Id
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkCreateUserFailure) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *ForceDeleteUserResponse) GetSuccess() bool {
+func (x *BulkCreateUserFailure) GetEmail() string {
 	if x != nil {
-		return x.Success
+		return x.Email
 	}
-	return false
+	return ""
 }
 
-// Request message to suspend a user (Admin operation)
-type SuspendUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *BulkCreateUserFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response message after bulk updating profiles (Admin operation)
+type BulkUpdateProfilesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Profiles      []*Profile                  `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"` // Saved profiles, updated or newly created
+	Total         int32                       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Failures      []*BulkUpdateProfileFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"` // Updates that were not applied, in stream order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendUserRequest) Reset() {
-	*x = SuspendUserRequest{}
+func (x *BulkUpdateProfilesResponse) Reset() {
+	*x = BulkUpdateProfilesResponse{}
 	mi := &file_proto_users_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendUserRequest) String() string {
+func (x *BulkUpdateProfilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendUserRequest) ProtoMessage() {}
+func (*BulkUpdateProfilesResponse) ProtoMessage() {}
 
-func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+func (x *BulkUpdateProfilesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,40 +951,56 @@ func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
-func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkUpdateProfilesResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateProfilesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SuspendUserRequest) GetId() string {
+func (x *BulkUpdateProfilesResponse) GetProfiles() []*Profile {
 	if x != nil {
-		return x.Id
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-// Response message after suspending a user
-type SuspendUserResponse struct {
+func (x *BulkUpdateProfilesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkUpdateProfilesResponse) GetFailures() []*BulkUpdateProfileFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// BulkUpdateProfileFailure is one update of BulkUpdateProfiles that could not be applied
+type BulkUpdateProfileFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                // Zero-based position of the update in the stream
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // As submitted
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendUserResponse) Reset() {
-	*x = SuspendUserResponse{}
+func (x *BulkUpdateProfileFailure) Reset() {
+	*x = BulkUpdateProfileFailure{}
 	mi := &file_proto_users_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendUserResponse) String() string {
+func (x *BulkUpdateProfileFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendUserResponse) ProtoMessage() {}
+func (*BulkUpdateProfileFailure) ProtoMessage() {}
 
-func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkUpdateProfileFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,40 +1012,54 @@ func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
-func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkUpdateProfileFailure.ProtoReflect.Descriptor instead.
+func (*BulkUpdateProfileFailure) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SuspendUserResponse) GetUser() *User {
+func (x *BulkUpdateProfileFailure) GetIndex() int32 {
 	if x != nil {
-		return x.User
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-// Request message to activate a user (Admin operation)
-type ActivateUserRequest struct {
+func (x *BulkUpdateProfileFailure) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkUpdateProfileFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Request message for a forced user deletion (Admin operation)
+type ForceDeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateUserRequest) Reset() {
-	*x = ActivateUserRequest{}
+func (x *ForceDeleteUserRequest) Reset() {
+	*x = ForceDeleteUserRequest{}
 	mi := &file_proto_users_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateUserRequest) String() string {
+func (x *ForceDeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateUserRequest) ProtoMessage() {}
+func (*ForceDeleteUserRequest) ProtoMessage() {}
 
-func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ForceDeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,40 +1071,41 @@ func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
-func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForceDeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ActivateUserRequest) GetId() string {
+func (x *ForceDeleteUserRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Response message after activating a user
-type ActivateUserResponse struct {
+// Response message for a forced user deletion
+type ForceDeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the deleted user
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateUserResponse) Reset() {
-	*x = ActivateUserResponse{}
+func (x *ForceDeleteUserResponse) Reset() {
+	*x = ForceDeleteUserResponse{}
 	mi := &file_proto_users_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateUserResponse) String() string {
+func (x *ForceDeleteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateUserResponse) ProtoMessage() {}
+func (*ForceDeleteUserResponse) ProtoMessage() {}
 
-func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ForceDeleteUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,41 +1117,47 @@ func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
-func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForceDeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ActivateUserResponse) GetUser() *User {
+func (x *ForceDeleteUserResponse) GetId() string {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for user authentication
-type AuthenticateRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	EmailOrUsername string                 `protobuf:"bytes,1,opt,name=email_or_username,json=emailOrUsername,proto3" json:"email_or_username,omitempty"`
-	Password        string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ForceDeleteUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *AuthenticateRequest) Reset() {
-	*x = AuthenticateRequest{}
+// Request message for soft-deleting a user; the email and username stay reserved so the user can be restored
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
 	mi := &file_proto_users_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthenticateRequest) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticateRequest) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1033,48 +1169,41 @@ func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AuthenticateRequest) GetEmailOrUsername() string {
-	if x != nil {
-		return x.EmailOrUsername
-	}
-	return ""
-}
-
-func (x *AuthenticateRequest) GetPassword() string {
+func (x *DeleteUserRequest) GetId() string {
 	if x != nil {
-		return x.Password
+		return x.Id
 	}
 	return ""
 }
 
-// Response message after authentication
-type AuthenticateResponse struct {
+// Response message after soft-deleting a user
+type DeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // JWT or session token
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the deleted user
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthenticateResponse) Reset() {
-	*x = AuthenticateResponse{}
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
 	mi := &file_proto_users_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthenticateResponse) String() string {
+func (x *DeleteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticateResponse) ProtoMessage() {}
+func (*DeleteUserResponse) ProtoMessage() {}
 
-func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_users_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,50 +1215,2483 @@ func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
-func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_proto_users_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AuthenticateResponse) GetUser() *User {
+func (x *DeleteUserResponse) GetId() string {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *AuthenticateResponse) GetToken() string {
+func (x *DeleteUserResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Token
+		return x.Success
+	}
+	return false
+}
+
+// Request message to restore a soft-deleted user (Admin operation)
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_proto_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message after restoring a user
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_proto_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message for hard-deleting users past the soft-delete retention (Admin operation)
+type PurgeDeletedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedUsersRequest) Reset() {
+	*x = PurgeDeletedUsersRequest{}
+	mi := &file_proto_users_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedUsersRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedUsersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{20}
+}
+
+// Response message for purging deleted users
+type PurgeDeletedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int32                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	Retention     int64                  `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"` // Retention window applied, in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedUsersResponse) Reset() {
+	*x = PurgeDeletedUsersResponse{}
+	mi := &file_proto_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedUsersResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedUsersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PurgeDeletedUsersResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *PurgeDeletedUsersResponse) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+// Request message to suspend a user (Admin operation)
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_proto_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SuspendUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message after suspending a user
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_proto_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SuspendUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message to activate a user (Admin operation)
+type ActivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserRequest) Reset() {
+	*x = ActivateUserRequest{}
+	mi := &file_proto_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserRequest) ProtoMessage() {}
+
+func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ActivateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message after activating a user
+type ActivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserResponse) Reset() {
+	*x = ActivateUserResponse{}
+	mi := &file_proto_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserResponse) ProtoMessage() {}
+
+func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ActivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message to unlock a user locked out by failed logins (Admin operation)
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_proto_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnlockUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message after unlocking a user
+type UnlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserResponse) Reset() {
+	*x = UnlockUserResponse{}
+	mi := &file_proto_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserResponse) ProtoMessage() {}
+
+func (x *UnlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnlockUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message to change a user's role (Admin operation)
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // user or admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_proto_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetUserRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Response message after changing a user's role
+type SetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleResponse) Reset() {
+	*x = SetUserRoleResponse{}
+	mi := &file_proto_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleResponse) ProtoMessage() {}
+
+func (x *SetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Request message for user authentication
+type AuthenticateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmailOrUsername string                 `protobuf:"bytes,1,opt,name=email_or_username,json=emailOrUsername,proto3" json:"email_or_username,omitempty"`
+	Password        string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuthenticateRequest) Reset() {
+	*x = AuthenticateRequest{}
+	mi := &file_proto_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateRequest) ProtoMessage() {}
+
+func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AuthenticateRequest) GetEmailOrUsername() string {
+	if x != nil {
+		return x.EmailOrUsername
+	}
+	return ""
+}
+
+func (x *AuthenticateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Response message after authentication
+type AuthenticateResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	User                  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token                 string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                                                   // HS256 JWT carrying the user's ID and role; send as "Authorization: Bearer <token>"
+	PasswordExpired       bool                   `protobuf:"varint,3,opt,name=password_expired,json=passwordExpired,proto3" json:"password_expired,omitempty"`                       // The password is older than the configured max age and must be changed
+	RefreshToken          string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`                                 // Exchanged for a new token pair by RefreshToken; shown only once
+	RefreshTokenExpiresAt int64                  `protobuf:"varint,5,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"` // Unix timestamp
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AuthenticateResponse) Reset() {
+	*x = AuthenticateResponse{}
+	mi := &file_proto_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateResponse) ProtoMessage() {}
+
+func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AuthenticateResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AuthenticateResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthenticateResponse) GetPasswordExpired() bool {
+	if x != nil {
+		return x.PasswordExpired
+	}
+	return false
+}
+
+func (x *AuthenticateResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthenticateResponse) GetRefreshTokenExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return 0
+}
+
+// Request message for exchanging a refresh token for a new token pair
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_proto_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Response message after refreshing a token; the refresh token sent can't be used again
+type RefreshTokenResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	User                  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token                 string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt int64                  `protobuf:"varint,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"` // Unix timestamp
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_proto_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RefreshTokenResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshTokenExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return 0
+}
+
+// Request message for revoking a refresh token
+type RevokeRefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokenRequest) Reset() {
+	*x = RevokeRefreshTokenRequest{}
+	mi := &file_proto_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokenRequest) ProtoMessage() {}
+
+func (x *RevokeRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeRefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Response message after revoking a refresh token
+type RevokeRefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // True even if the token was unknown or already revoked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokenResponse) Reset() {
+	*x = RevokeRefreshTokenResponse{}
+	mi := &file_proto_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokenResponse) ProtoMessage() {}
+
+func (x *RevokeRefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevokeRefreshTokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for ending the session of a refresh token
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Response message after logging out
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // True even if the token was unknown or already revoked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_users_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for ending every session of a user
+type LogoutAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutAllRequest) Reset() {
+	*x = LogoutAllRequest{}
+	mi := &file_proto_users_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutAllRequest) ProtoMessage() {}
+
+func (x *LogoutAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutAllRequest.ProtoReflect.Descriptor instead.
+func (*LogoutAllRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LogoutAllRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Response message after logging a user out everywhere
+type LogoutAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RevokedCount  int32                  `protobuf:"varint,2,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"` // Refresh tokens that were still usable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutAllResponse) Reset() {
+	*x = LogoutAllResponse{}
+	mi := &file_proto_users_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutAllResponse) ProtoMessage() {}
+
+func (x *LogoutAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutAllResponse.ProtoReflect.Descriptor instead.
+func (*LogoutAllResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LogoutAllResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogoutAllResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// Request message for changing password
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_proto_users_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangePasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Response message after changing password; every session of the user is logged out
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_proto_users_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangePasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for resetting password
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_proto_users_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResetPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Response message after resetting password
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_proto_users_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for setting a new password with a token issued by ResetPassword
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_proto_users_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Response message after a password reset; every session of the user is logged out
+type ConfirmPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_proto_users_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ConfirmPasswordResetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for verifying email
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_proto_users_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message after verifying email
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_proto_users_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VerifyEmailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request message for resending the email verification link
+type ResendVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationRequest) Reset() {
+	*x = ResendVerificationRequest{}
+	mi := &file_proto_users_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationRequest) ProtoMessage() {}
+
+func (x *ResendVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResendVerificationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Response message after resending the email verification link
+type ResendVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // True whether or not the email belongs to an unverified user, to avoid leaking accounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationResponse) Reset() {
+	*x = ResendVerificationResponse{}
+	mi := &file_proto_users_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationResponse) ProtoMessage() {}
+
+func (x *ResendVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResendVerificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// VerificationRequestedEvent is published on the "users.verification_requested" topic when
+// a user needs an email verification link, on creation and on ResendVerification
+type VerificationRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`                                 // Pass to VerifyEmail
+	RequestedAt   int64                  `protobuf:"varint,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationRequestedEvent) Reset() {
+	*x = VerificationRequestedEvent{}
+	mi := &file_proto_users_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationRequestedEvent) ProtoMessage() {}
+
+func (x *VerificationRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationRequestedEvent.ProtoReflect.Descriptor instead.
+func (*VerificationRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerificationRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerificationRequestedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerificationRequestedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *VerificationRequestedEvent) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerificationRequestedEvent) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+// Request message for starting an email change
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewEmail      string                 `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // The user's current password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_proto_users_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RequestEmailChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Response message after starting an email change
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp the confirmation token expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_proto_users_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RequestEmailChangeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RequestEmailChangeResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Request message for confirming an email change
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_proto_users_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message after confirming an email change
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_proto_users_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// EmailChangeRequestedEvent is published on the "users.email_change_requested" topic when
+// a user asks to change their email; the token is sent to the new address, so confirming
+// proves the user receives mail there. The current address gets an EmailChangeNoticeEvent.
+type EmailChangeRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                       // Current address, still in use until the change is confirmed
+	NewEmail      string                 `protobuf:"bytes,3,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"` // Address the token is sent to
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`                                 // Pass to ConfirmEmailChange
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`       // Unix timestamp
+	RequestedAt   int64                  `protobuf:"varint,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailChangeRequestedEvent) Reset() {
+	*x = EmailChangeRequestedEvent{}
+	mi := &file_proto_users_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailChangeRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChangeRequestedEvent) ProtoMessage() {}
+
+func (x *EmailChangeRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChangeRequestedEvent.ProtoReflect.Descriptor instead.
+func (*EmailChangeRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EmailChangeRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *EmailChangeRequestedEvent) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+// EmailChangeNoticeEvent is published on the "users.email_change_notice" topic alongside
+// an EmailChangeRequestedEvent, telling the current address a change away from it was
+// requested. It carries no token, so the current address alone cannot confirm the change.
+type EmailChangeNoticeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // Current address the notice is sent to
+	NewEmail      string                 `protobuf:"bytes,3,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	RequestedAt   int64                  `protobuf:"varint,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailChangeNoticeEvent) Reset() {
+	*x = EmailChangeNoticeEvent{}
+	mi := &file_proto_users_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailChangeNoticeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChangeNoticeEvent) ProtoMessage() {}
+
+func (x *EmailChangeNoticeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChangeNoticeEvent.ProtoReflect.Descriptor instead.
+func (*EmailChangeNoticeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EmailChangeNoticeEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailChangeNoticeEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EmailChangeNoticeEvent) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *EmailChangeNoticeEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailChangeNoticeEvent) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+// Request message for searching users
+type SearchUsersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted users; honored only by AdminService.SearchUsers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_proto_users_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response message for searching users
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_proto_users_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request message for getting user by email
+type GetUserByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByEmailRequest) Reset() {
+	*x = GetUserByEmailRequest{}
+	mi := &file_proto_users_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByEmailRequest) ProtoMessage() {}
+
+func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
 	return ""
 }
 
-// Request message for changing password
-type ChangePasswordRequest struct {
+// Request message for getting user by username
+type GetUserByUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByUsernameRequest) Reset() {
+	*x = GetUserByUsernameRequest{}
+	mi := &file_proto_users_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByUsernameRequest) ProtoMessage() {}
+
+func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByUsernameRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetUserByUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Request message for getting a user's profile
+type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
-	mi := &file_proto_users_proto_msgTypes[18]
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_proto_users_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *GetProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*GetProfileRequest) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[18]
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Response message for getting a user's profile
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_proto_users_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Request message for updating a user's profile; empty fields are left unchanged
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth   *int64                 `protobuf:"varint,4,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"` // Unix timestamp, negative before 1970; unset leaves it unchanged
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_proto_users_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetDateOfBirth() int64 {
+	if x != nil && x.DateOfBirth != nil {
+		return *x.DateOfBirth
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+// Response message after updating a user's profile
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_proto_users_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// NotificationPreferences holds a user's notification opt-ins
+type NotificationPreferences struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmailMarketing bool                   `protobuf:"varint,1,opt,name=email_marketing,json=emailMarketing,proto3" json:"email_marketing,omitempty"`
+	SmsMarketing   bool                   `protobuf:"varint,2,opt,name=sms_marketing,json=smsMarketing,proto3" json:"sms_marketing,omitempty"`
+	OrderUpdates   bool                   `protobuf:"varint,3,opt,name=order_updates,json=orderUpdates,proto3" json:"order_updates,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp, 0 while the defaults have never been saved
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_proto_users_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,55 +3702,62 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ChangePasswordRequest) GetUserId() string {
+func (x *NotificationPreferences) GetEmailMarketing() bool {
 	if x != nil {
-		return x.UserId
+		return x.EmailMarketing
 	}
-	return ""
+	return false
 }
 
-func (x *ChangePasswordRequest) GetOldPassword() string {
+func (x *NotificationPreferences) GetSmsMarketing() bool {
 	if x != nil {
-		return x.OldPassword
+		return x.SmsMarketing
 	}
-	return ""
+	return false
 }
 
-func (x *ChangePasswordRequest) GetNewPassword() string {
+func (x *NotificationPreferences) GetOrderUpdates() bool {
 	if x != nil {
-		return x.NewPassword
+		return x.OrderUpdates
 	}
-	return ""
+	return false
 }
 
-// Response message after changing password
-type ChangePasswordResponse struct {
+func (x *NotificationPreferences) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Request message for getting a user's notification preferences
+type GetNotificationPreferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordResponse) Reset() {
-	*x = ChangePasswordResponse{}
-	mi := &file_proto_users_proto_msgTypes[19]
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_proto_users_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordResponse) String() string {
+func (x *GetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResponse) ProtoMessage() {}
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[19]
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,41 +3768,41 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ChangePasswordResponse) GetSuccess() bool {
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-// Request message for resetting password
-type ResetPasswordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+// Response message for getting a user's notification preferences
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
-	mi := &file_proto_users_proto_msgTypes[20]
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_proto_users_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *GetNotificationPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[20]
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,41 +3813,44 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ResetPasswordRequest) GetEmail() string {
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.Email
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-// Response message after resetting password
-type ResetPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request message for updating notification preferences; unset fields are left unchanged
+type UpdateNotificationPreferencesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EmailMarketing *bool                  `protobuf:"varint,2,opt,name=email_marketing,json=emailMarketing,proto3,oneof" json:"email_marketing,omitempty"`
+	SmsMarketing   *bool                  `protobuf:"varint,3,opt,name=sms_marketing,json=smsMarketing,proto3,oneof" json:"sms_marketing,omitempty"`
+	OrderUpdates   *bool                  `protobuf:"varint,4,opt,name=order_updates,json=orderUpdates,proto3,oneof" json:"order_updates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ResetPasswordResponse) Reset() {
-	*x = ResetPasswordResponse{}
-	mi := &file_proto_users_proto_msgTypes[21]
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_proto_users_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordResponse) String() string {
+func (x *UpdateNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResponse) ProtoMessage() {}
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[21]
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,41 +3861,62 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ResetPasswordResponse) GetSuccess() bool {
+func (x *UpdateNotificationPreferencesRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetEmailMarketing() bool {
+	if x != nil && x.EmailMarketing != nil {
+		return *x.EmailMarketing
 	}
 	return false
 }
 
-// Request message for verifying email
-type VerifyEmailRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+func (x *UpdateNotificationPreferencesRequest) GetSmsMarketing() bool {
+	if x != nil && x.SmsMarketing != nil {
+		return *x.SmsMarketing
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetOrderUpdates() bool {
+	if x != nil && x.OrderUpdates != nil {
+		return *x.OrderUpdates
+	}
+	return false
+}
+
+// Response message after updating notification preferences
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyEmailRequest) Reset() {
-	*x = VerifyEmailRequest{}
-	mi := &file_proto_users_proto_msgTypes[22]
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_proto_users_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyEmailRequest) String() string {
+func (x *UpdateNotificationPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyEmailRequest) ProtoMessage() {}
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
 
-func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[22]
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,41 +3927,40 @@ func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
-func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *VerifyEmailRequest) GetToken() string {
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.Token
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-// Response message after verifying email
-type VerifyEmailResponse struct {
+// Request message for email verification statistics (Admin operation)
+type GetVerificationStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyEmailResponse) Reset() {
-	*x = VerifyEmailResponse{}
-	mi := &file_proto_users_proto_msgTypes[23]
+func (x *GetVerificationStatsRequest) Reset() {
+	*x = GetVerificationStatsRequest{}
+	mi := &file_proto_users_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyEmailResponse) String() string {
+func (x *GetVerificationStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyEmailResponse) ProtoMessage() {}
+func (*GetVerificationStatsRequest) ProtoMessage() {}
 
-func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[23]
+func (x *GetVerificationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,43 +3971,37 @@ func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
-func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *VerifyEmailResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use GetVerificationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{70}
 }
 
-// Request message for searching users
-type SearchUsersRequest struct {
+// AgeBucket counts accounts whose age in days falls within [min_age_days, max_age_days)
+type AgeBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	MinAgeDays    int32                  `protobuf:"varint,2,opt,name=min_age_days,json=minAgeDays,proto3" json:"min_age_days,omitempty"`
+	MaxAgeDays    int32                  `protobuf:"varint,3,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"` // 0 means unbounded
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersRequest) Reset() {
-	*x = SearchUsersRequest{}
-	mi := &file_proto_users_proto_msgTypes[24]
+func (x *AgeBucket) Reset() {
+	*x = AgeBucket{}
+	mi := &file_proto_users_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersRequest) String() string {
+func (x *AgeBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersRequest) ProtoMessage() {}
+func (*AgeBucket) ProtoMessage() {}
 
-func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[24]
+func (x *AgeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,56 +4012,64 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AgeBucket.ProtoReflect.Descriptor instead.
+func (*AgeBucket) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *SearchUsersRequest) GetQuery() string {
+func (x *AgeBucket) GetLabel() string {
 	if x != nil {
-		return x.Query
+		return x.Label
 	}
 	return ""
 }
 
-func (x *SearchUsersRequest) GetLimit() int32 {
+func (x *AgeBucket) GetMinAgeDays() int32 {
 	if x != nil {
-		return x.Limit
+		return x.MinAgeDays
 	}
 	return 0
 }
 
-func (x *SearchUsersRequest) GetOffset() int32 {
+func (x *AgeBucket) GetMaxAgeDays() int32 {
 	if x != nil {
-		return x.Offset
+		return x.MaxAgeDays
 	}
 	return 0
 }
 
-// Response message for searching users
-type SearchUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AgeBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *SearchUsersResponse) Reset() {
-	*x = SearchUsersResponse{}
-	mi := &file_proto_users_proto_msgTypes[25]
+// Response message for email verification statistics
+type GetVerificationStatsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Verified             int32                  `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Unverified           int32                  `protobuf:"varint,2,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	UnverifiedAgeBuckets []*AgeBucket           `protobuf:"bytes,3,rep,name=unverified_age_buckets,json=unverifiedAgeBuckets,proto3" json:"unverified_age_buckets,omitempty"` // Ages of unverified accounts
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetVerificationStatsResponse) Reset() {
+	*x = GetVerificationStatsResponse{}
+	mi := &file_proto_users_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersResponse) String() string {
+func (x *GetVerificationStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponse) ProtoMessage() {}
+func (*GetVerificationStatsResponse) ProtoMessage() {}
 
-func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[25]
+func (x *GetVerificationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1486,48 +4080,54 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetVerificationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SearchUsersResponse) GetUsers() []*User {
+func (x *GetVerificationStatsResponse) GetVerified() int32 {
 	if x != nil {
-		return x.Users
+		return x.Verified
 	}
-	return nil
+	return 0
 }
 
-func (x *SearchUsersResponse) GetTotal() int32 {
+func (x *GetVerificationStatsResponse) GetUnverified() int32 {
 	if x != nil {
-		return x.Total
+		return x.Unverified
 	}
 	return 0
 }
 
-// Request message for getting user by email
-type GetUserByEmailRequest struct {
+func (x *GetVerificationStatsResponse) GetUnverifiedAgeBuckets() []*AgeBucket {
+	if x != nil {
+		return x.UnverifiedAgeBuckets
+	}
+	return nil
+}
+
+// Request message for invalidating every outstanding verification/reset token (Admin operation)
+type InvalidateAllTokensRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByEmailRequest) Reset() {
-	*x = GetUserByEmailRequest{}
-	mi := &file_proto_users_proto_msgTypes[26]
+func (x *InvalidateAllTokensRequest) Reset() {
+	*x = InvalidateAllTokensRequest{}
+	mi := &file_proto_users_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByEmailRequest) String() string {
+func (x *InvalidateAllTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByEmailRequest) ProtoMessage() {}
+func (*InvalidateAllTokensRequest) ProtoMessage() {}
 
-func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[26]
+func (x *InvalidateAllTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,41 +4138,79 @@ func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use InvalidateAllTokensRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateAllTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetUserByEmailRequest) GetEmail() string {
+// Request message for invalidating one user's outstanding verification/reset token (Admin operation)
+type InvalidateUserTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateUserTokensRequest) Reset() {
+	*x = InvalidateUserTokensRequest{}
+	mi := &file_proto_users_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateUserTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateUserTokensRequest) ProtoMessage() {}
+
+func (x *InvalidateUserTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[74]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateUserTokensRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateUserTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *InvalidateUserTokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-// Request message for getting user by username
-type GetUserByUsernameRequest struct {
+// Response message for token invalidation
+type InvalidateTokensResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Invalidated   int32                  `protobuf:"varint,1,opt,name=invalidated,proto3" json:"invalidated,omitempty"` // Users whose outstanding token was cleared
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByUsernameRequest) Reset() {
-	*x = GetUserByUsernameRequest{}
-	mi := &file_proto_users_proto_msgTypes[27]
+func (x *InvalidateTokensResponse) Reset() {
+	*x = InvalidateTokensResponse{}
+	mi := &file_proto_users_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByUsernameRequest) String() string {
+func (x *InvalidateTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByUsernameRequest) ProtoMessage() {}
+func (*InvalidateTokensResponse) ProtoMessage() {}
 
-func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_proto_msgTypes[27]
+func (x *InvalidateTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,16 +4221,16 @@ func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByUsernameRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use InvalidateTokensResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateTokensResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetUserByUsernameRequest) GetUsername() string {
+func (x *InvalidateTokensResponse) GetInvalidated() int32 {
 	if x != nil {
-		return x.Username
+		return x.Invalidated
 	}
-	return ""
+	return 0
 }
 
 var File_proto_users_proto protoreflect.FileDescriptor
@@ -1611,7 +4249,7 @@ const file_proto_users_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\xf2\x01\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\x96\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -1622,17 +4260,29 @@ const file_proto_users_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12\x1b\n" +
 	"\tis_active\x18\a \x01(\bR\bisActive\x12(\n" +
-	"\aprofile\x18\b \x01(\v2\x0e.users.ProfileR\aprofile\"\xfe\x01\n" +
+	"\aprofile\x18\b \x01(\v2\x0e.users.ProfileR\aprofile\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\x03R\tdeletedAt\x12(\n" +
+	"\x10account_age_days\x18\n" +
+	" \x01(\x05R\x0eaccountAgeDays\x12\x1f\n" +
+	"\vtenure_tier\x18\v \x01(\tR\n" +
+	"tenureTier\x12.\n" +
+	"\x13password_changed_at\x18\f \x01(\x03R\x11passwordChangedAt\x12#\n" +
+	"\rpending_email\x18\r \x01(\tR\fpendingEmail\x12,\n" +
+	"\x12failed_login_count\x18\x0e \x01(\x05R\x10failedLoginCount\x12!\n" +
+	"\flocked_until\x18\x0f \x01(\x03R\vlockedUntil\x12\x12\n" +
+	"\x04role\x18\x10 \x01(\tR\x04role\"\x95\x02\n" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
-	"\tlast_name\x18\x05 \x01(\tR\blastName\x12\"\n" +
-	"\rdate_of_birth\x18\x06 \x01(\x03R\vdateOfBirth\x12\x18\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12'\n" +
+	"\rdate_of_birth\x18\x06 \x01(\x03H\x00R\vdateOfBirth\x88\x01\x01\x12\x18\n" +
 	"\aaddress\x18\a \x01(\tR\aaddress\x12!\n" +
-	"\fphone_number\x18\b \x01(\tR\vphoneNumber\"5\n" +
+	"\fphone_number\x18\b \x01(\tR\vphoneNumberB\x10\n" +
+	"\x0e_date_of_birth\"5\n" +
 	"\x12CreateUserResponse\x12\x1f\n" +
 	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
@@ -1657,12 +4307,42 @@ const file_proto_users_proto_rawDesc = "" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\"L\n" +
 	"\x11ListUsersResponse\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.users.UserR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"(\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x8c\x01\n" +
+	"\x17BulkCreateUsersResponse\x12!\n" +
+	"\x05users\x18\x01 \x03(\v2\v.users.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x128\n" +
+	"\bfailures\x18\x03 \x03(\v2\x1c.users.BulkCreateUserFailureR\bfailures\"w\n" +
+	"\x15BulkCreateUserFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x9b\x01\n" +
+	"\x1aBulkUpdateProfilesResponse\x12*\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x0e.users.ProfileR\bprofiles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12;\n" +
+	"\bfailures\x18\x03 \x03(\v2\x1f.users.BulkUpdateProfileFailureR\bfailures\"a\n" +
+	"\x18BulkUpdateProfileFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"(\n" +
 	"\x16ForceDeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x17ForceDeleteUserResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x12DeleteUserResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\"$\n" +
+	"\x12RestoreUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x13RestoreUserResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"\x1a\n" +
+	"\x18PurgeDeletedUsersRequest\"Q\n" +
+	"\x19PurgeDeletedUsersResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x05R\x06purged\x12\x1c\n" +
+	"\tretention\x18\x02 \x01(\x03R\tretention\"$\n" +
 	"\x12SuspendUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x13SuspendUserResponse\x12\x1f\n" +
@@ -1670,13 +4350,45 @@ const file_proto_users_proto_rawDesc = "" +
 	"\x13ActivateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
 	"\x14ActivateUserResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"#\n" +
+	"\x11UnlockUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x12UnlockUserResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"8\n" +
+	"\x12SetUserRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"6\n" +
+	"\x13SetUserRoleResponse\x12\x1f\n" +
 	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"]\n" +
 	"\x13AuthenticateRequest\x12*\n" +
 	"\x11email_or_username\x18\x01 \x01(\tR\x0femailOrUsername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"M\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd6\x01\n" +
 	"\x14AuthenticateResponse\x12\x1f\n" +
 	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"v\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12)\n" +
+	"\x10password_expired\x18\x03 \x01(\bR\x0fpasswordExpired\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x127\n" +
+	"\x18refresh_token_expires_at\x18\x05 \x01(\x03R\x15refreshTokenExpiresAt\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xab\x01\n" +
+	"\x14RefreshTokenResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x127\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\x03R\x15refreshTokenExpiresAt\"@\n" +
+	"\x19RevokeRefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"6\n" +
+	"\x1aRevokeRefreshTokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
+	"\x10LogoutAllRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x11LogoutAllResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rrevoked_count\x18\x02 \x01(\x05R\frevokedCount\"v\n" +
 	"\x15ChangePasswordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
@@ -1686,42 +4398,164 @@ const file_proto_users_proto_rawDesc = "" +
 	"\x14ResetPasswordRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"1\n" +
 	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"8\n" +
+	"\x1cConfirmPasswordResetResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
 	"\x12VerifyEmailRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
 	"\x13VerifyEmailResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"X\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\x19ResendVerificationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"6\n" +
+	"\x1aResendVerificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa0\x01\n" +
+	"\x1aVerificationRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12!\n" +
+	"\frequested_at\x18\x05 \x01(\x03R\vrequestedAt\"m\n" +
+	"\x19RequestEmailChangeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tnew_email\x18\x02 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"U\n" +
+	"\x1aRequestEmailChangeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"1\n" +
+	"\x19ConfirmEmailChangeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"=\n" +
+	"\x1aConfirmEmailChangeResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.users.UserR\x04user\"\xdb\x01\n" +
+	"\x19EmailChangeRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tnew_email\x18\x03 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12!\n" +
+	"\frequested_at\x18\a \x01(\x03R\vrequestedAt\"\xa3\x01\n" +
+	"\x16EmailChangeNoticeEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tnew_email\x18\x03 \x01(\tR\bnewEmail\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12!\n" +
+	"\frequested_at\x18\x05 \x01(\x03R\vrequestedAt\"\x81\x01\n" +
 	"\x12SearchUsersRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"N\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"N\n" +
 	"\x13SearchUsersResponse\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.users.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
 	"\x15GetUserByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"6\n" +
 	"\x18GetUserByUsernameRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername2\xa9\x06\n" +
+	"\busername\x18\x01 \x01(\tR\busername\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\">\n" +
+	"\x12GetProfileResponse\x12(\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0e.users.ProfileR\aprofile\"\xe3\x01\n" +
+	"\x14UpdateProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12'\n" +
+	"\rdate_of_birth\x18\x04 \x01(\x03H\x00R\vdateOfBirth\x88\x01\x01\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12!\n" +
+	"\fphone_number\x18\x06 \x01(\tR\vphoneNumberB\x10\n" +
+	"\x0e_date_of_birth\"A\n" +
+	"\x15UpdateProfileResponse\x12(\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0e.users.ProfileR\aprofile\"\xab\x01\n" +
+	"\x17NotificationPreferences\x12'\n" +
+	"\x0femail_marketing\x18\x01 \x01(\bR\x0eemailMarketing\x12#\n" +
+	"\rsms_marketing\x18\x02 \x01(\bR\fsmsMarketing\x12#\n" +
+	"\rorder_updates\x18\x03 \x01(\bR\forderUpdates\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"f\n" +
+	"\"GetNotificationPreferencesResponse\x12@\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1e.users.NotificationPreferencesR\vpreferences\"\xf9\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
+	"\x0femail_marketing\x18\x02 \x01(\bH\x00R\x0eemailMarketing\x88\x01\x01\x12(\n" +
+	"\rsms_marketing\x18\x03 \x01(\bH\x01R\fsmsMarketing\x88\x01\x01\x12(\n" +
+	"\rorder_updates\x18\x04 \x01(\bH\x02R\forderUpdates\x88\x01\x01B\x12\n" +
+	"\x10_email_marketingB\x10\n" +
+	"\x0e_sms_marketingB\x10\n" +
+	"\x0e_order_updates\"i\n" +
+	"%UpdateNotificationPreferencesResponse\x12@\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1e.users.NotificationPreferencesR\vpreferences\"\x1d\n" +
+	"\x1bGetVerificationStatsRequest\"{\n" +
+	"\tAgeBucket\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12 \n" +
+	"\fmin_age_days\x18\x02 \x01(\x05R\n" +
+	"minAgeDays\x12 \n" +
+	"\fmax_age_days\x18\x03 \x01(\x05R\n" +
+	"maxAgeDays\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\xa2\x01\n" +
+	"\x1cGetVerificationStatsResponse\x12\x1a\n" +
+	"\bverified\x18\x01 \x01(\x05R\bverified\x12\x1e\n" +
+	"\n" +
+	"unverified\x18\x02 \x01(\x05R\n" +
+	"unverified\x12F\n" +
+	"\x16unverified_age_buckets\x18\x03 \x03(\v2\x10.users.AgeBucketR\x14unverifiedAgeBuckets\"\x1c\n" +
+	"\x1aInvalidateAllTokensRequest\"6\n" +
+	"\x1bInvalidateUserTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"<\n" +
+	"\x18InvalidateTokensResponse\x12 \n" +
+	"\vinvalidated\x18\x01 \x01(\x05R\vinvalidated2\x91\x0f\n" +
 	"\vUserService\x12C\n" +
 	"\n" +
 	"CreateUser\x12\x18.users.CreateUserRequest\x1a\x19.users.CreateUserResponse\"\x00\x12:\n" +
 	"\aGetUser\x12\x15.users.GetUserRequest\x1a\x16.users.GetUserResponse\"\x00\x12C\n" +
 	"\n" +
-	"UpdateUser\x12\x18.users.UpdateUserRequest\x1a\x19.users.UpdateUserResponse\"\x00\x12@\n" +
+	"UpdateUser\x12\x18.users.UpdateUserRequest\x1a\x19.users.UpdateUserResponse\"\x00\x12C\n" +
+	"\n" +
+	"DeleteUser\x12\x18.users.DeleteUserRequest\x1a\x19.users.DeleteUserResponse\"\x00\x12@\n" +
 	"\tListUsers\x12\x17.users.ListUsersRequest\x1a\x18.users.ListUsersResponse\"\x00\x12I\n" +
-	"\fAuthenticate\x12\x1a.users.AuthenticateRequest\x1a\x1b.users.AuthenticateResponse\"\x00\x12O\n" +
+	"\fAuthenticate\x12\x1a.users.AuthenticateRequest\x1a\x1b.users.AuthenticateResponse\"\x00\x12I\n" +
+	"\fRefreshToken\x12\x1a.users.RefreshTokenRequest\x1a\x1b.users.RefreshTokenResponse\"\x00\x12[\n" +
+	"\x12RevokeRefreshToken\x12 .users.RevokeRefreshTokenRequest\x1a!.users.RevokeRefreshTokenResponse\"\x00\x127\n" +
+	"\x06Logout\x12\x14.users.LogoutRequest\x1a\x15.users.LogoutResponse\"\x00\x12@\n" +
+	"\tLogoutAll\x12\x17.users.LogoutAllRequest\x1a\x18.users.LogoutAllResponse\"\x00\x12O\n" +
 	"\x0eChangePassword\x12\x1c.users.ChangePasswordRequest\x1a\x1d.users.ChangePasswordResponse\"\x00\x12L\n" +
-	"\rResetPassword\x12\x1b.users.ResetPasswordRequest\x1a\x1c.users.ResetPasswordResponse\"\x00\x12F\n" +
-	"\vVerifyEmail\x12\x19.users.VerifyEmailRequest\x1a\x1a.users.VerifyEmailResponse\"\x00\x12H\n" +
+	"\rResetPassword\x12\x1b.users.ResetPasswordRequest\x1a\x1c.users.ResetPasswordResponse\"\x00\x12a\n" +
+	"\x14ConfirmPasswordReset\x12\".users.ConfirmPasswordResetRequest\x1a#.users.ConfirmPasswordResetResponse\"\x00\x12F\n" +
+	"\vVerifyEmail\x12\x19.users.VerifyEmailRequest\x1a\x1a.users.VerifyEmailResponse\"\x00\x12[\n" +
+	"\x12ResendVerification\x12 .users.ResendVerificationRequest\x1a!.users.ResendVerificationResponse\"\x00\x12[\n" +
+	"\x12RequestEmailChange\x12 .users.RequestEmailChangeRequest\x1a!.users.RequestEmailChangeResponse\"\x00\x12[\n" +
+	"\x12ConfirmEmailChange\x12 .users.ConfirmEmailChangeRequest\x1a!.users.ConfirmEmailChangeResponse\"\x00\x12H\n" +
 	"\x0eGetUserByEmail\x12\x1c.users.GetUserByEmailRequest\x1a\x16.users.GetUserResponse\"\x00\x12N\n" +
 	"\x11GetUserByUsername\x12\x1f.users.GetUserByUsernameRequest\x1a\x16.users.GetUserResponse\"\x00\x12F\n" +
-	"\vSearchUsers\x12\x19.users.SearchUsersRequest\x1a\x1a.users.SearchUsersResponse\"\x002\xf9\x02\n" +
+	"\vSearchUsers\x12\x19.users.SearchUsersRequest\x1a\x1a.users.SearchUsersResponse\"\x00\x12C\n" +
+	"\n" +
+	"GetProfile\x12\x18.users.GetProfileRequest\x1a\x19.users.GetProfileResponse\"\x00\x12L\n" +
+	"\rUpdateProfile\x12\x1b.users.UpdateProfileRequest\x1a\x1c.users.UpdateProfileResponse\"\x00\x12s\n" +
+	"\x1aGetNotificationPreferences\x12(.users.GetNotificationPreferencesRequest\x1a).users.GetNotificationPreferencesResponse\"\x00\x12|\n" +
+	"\x1dUpdateNotificationPreferences\x12+.users.UpdateNotificationPreferencesRequest\x1a,.users.UpdateNotificationPreferencesResponse\"\x002\xef\b\n" +
 	"\fAdminService\x12R\n" +
 	"\x0fForceDeleteUser\x12\x1d.users.ForceDeleteUserRequest\x1a\x1e.users.ForceDeleteUserResponse\"\x00\x12F\n" +
 	"\vSuspendUser\x12\x19.users.SuspendUserRequest\x1a\x1a.users.SuspendUserResponse\"\x00\x12I\n" +
-	"\fActivateUser\x12\x1a.users.ActivateUserRequest\x1a\x1b.users.ActivateUserResponse\"\x00\x12I\n" +
-	"\x0fBulkCreateUsers\x12\x18.users.CreateUserRequest\x1a\x18.users.ListUsersResponse\"\x00(\x01\x127\n" +
-	"\vExportUsers\x12\x17.users.ListUsersRequest\x1a\v.users.User\"\x000\x01B\x0fZ\r./proto;usersb\x06proto3"
+	"\fActivateUser\x12\x1a.users.ActivateUserRequest\x1a\x1b.users.ActivateUserResponse\"\x00\x12C\n" +
+	"\n" +
+	"UnlockUser\x12\x18.users.UnlockUserRequest\x1a\x19.users.UnlockUserResponse\"\x00\x12F\n" +
+	"\vSetUserRole\x12\x19.users.SetUserRoleRequest\x1a\x1a.users.SetUserRoleResponse\"\x00\x12F\n" +
+	"\vRestoreUser\x12\x19.users.RestoreUserRequest\x1a\x1a.users.RestoreUserResponse\"\x00\x12X\n" +
+	"\x11PurgeDeletedUsers\x12\x1f.users.PurgeDeletedUsersRequest\x1a .users.PurgeDeletedUsersResponse\"\x00\x12O\n" +
+	"\x0fBulkCreateUsers\x12\x18.users.CreateUserRequest\x1a\x1e.users.BulkCreateUsersResponse\"\x00(\x01\x12X\n" +
+	"\x12BulkUpdateProfiles\x12\x1b.users.UpdateProfileRequest\x1a!.users.BulkUpdateProfilesResponse\"\x00(\x01\x127\n" +
+	"\vExportUsers\x12\x17.users.ListUsersRequest\x1a\v.users.User\"\x000\x01\x12F\n" +
+	"\vSearchUsers\x12\x19.users.SearchUsersRequest\x1a\x1a.users.SearchUsersResponse\"\x00\x12a\n" +
+	"\x14GetVerificationStats\x12\".users.GetVerificationStatsRequest\x1a#.users.GetVerificationStatsResponse\"\x00\x12[\n" +
+	"\x13InvalidateAllTokens\x12!.users.InvalidateAllTokensRequest\x1a\x1f.users.InvalidateTokensResponse\"\x00\x12]\n" +
+	"\x14InvalidateUserTokens\x12\".users.InvalidateUserTokensRequest\x1a\x1f.users.InvalidateTokensResponse\"\x00B\x0fZ\r./proto;usersb\x06proto3"
 
 var (
 	file_proto_users_proto_rawDescOnce sync.Once
@@ -1735,36 +4569,84 @@ func file_proto_users_proto_rawDescGZIP() []byte {
 	return file_proto_users_proto_rawDescData
 }
 
-var file_proto_users_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_users_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_proto_users_proto_goTypes = []any{
-	(*Profile)(nil),                  // 0: users.Profile
-	(*User)(nil),                     // 1: users.User
-	(*CreateUserRequest)(nil),        // 2: users.CreateUserRequest
-	(*CreateUserResponse)(nil),       // 3: users.CreateUserResponse
-	(*GetUserRequest)(nil),           // 4: users.GetUserRequest
-	(*GetUserResponse)(nil),          // 5: users.GetUserResponse
-	(*UpdateUserRequest)(nil),        // 6: users.UpdateUserRequest
-	(*UpdateUserResponse)(nil),       // 7: users.UpdateUserResponse
-	(*ListUsersRequest)(nil),         // 8: users.ListUsersRequest
-	(*ListUsersResponse)(nil),        // 9: users.ListUsersResponse
-	(*ForceDeleteUserRequest)(nil),   // 10: users.ForceDeleteUserRequest
-	(*ForceDeleteUserResponse)(nil),  // 11: users.ForceDeleteUserResponse
-	(*SuspendUserRequest)(nil),       // 12: users.SuspendUserRequest
-	(*SuspendUserResponse)(nil),      // 13: users.SuspendUserResponse
-	(*ActivateUserRequest)(nil),      // 14: users.ActivateUserRequest
-	(*ActivateUserResponse)(nil),     // 15: users.ActivateUserResponse
-	(*AuthenticateRequest)(nil),      // 16: users.AuthenticateRequest
-	(*AuthenticateResponse)(nil),     // 17: users.AuthenticateResponse
-	(*ChangePasswordRequest)(nil),    // 18: users.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),   // 19: users.ChangePasswordResponse
-	(*ResetPasswordRequest)(nil),     // 20: users.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),    // 21: users.ResetPasswordResponse
-	(*VerifyEmailRequest)(nil),       // 22: users.VerifyEmailRequest
-	(*VerifyEmailResponse)(nil),      // 23: users.VerifyEmailResponse
-	(*SearchUsersRequest)(nil),       // 24: users.SearchUsersRequest
-	(*SearchUsersResponse)(nil),      // 25: users.SearchUsersResponse
-	(*GetUserByEmailRequest)(nil),    // 26: users.GetUserByEmailRequest
-	(*GetUserByUsernameRequest)(nil), // 27: users.GetUserByUsernameRequest
+	(*Profile)(nil),                               // 0: users.Profile
+	(*User)(nil),                                  // 1: users.User
+	(*CreateUserRequest)(nil),                     // 2: users.CreateUserRequest
+	(*CreateUserResponse)(nil),                    // 3: users.CreateUserResponse
+	(*GetUserRequest)(nil),                        // 4: users.GetUserRequest
+	(*GetUserResponse)(nil),                       // 5: users.GetUserResponse
+	(*UpdateUserRequest)(nil),                     // 6: users.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                    // 7: users.UpdateUserResponse
+	(*ListUsersRequest)(nil),                      // 8: users.ListUsersRequest
+	(*ListUsersResponse)(nil),                     // 9: users.ListUsersResponse
+	(*BulkCreateUsersResponse)(nil),               // 10: users.BulkCreateUsersResponse
+	(*BulkCreateUserFailure)(nil),                 // 11: users.BulkCreateUserFailure
+	(*BulkUpdateProfilesResponse)(nil),            // 12: users.BulkUpdateProfilesResponse
+	(*BulkUpdateProfileFailure)(nil),              // 13: users.BulkUpdateProfileFailure
+	(*ForceDeleteUserRequest)(nil),                // 14: users.ForceDeleteUserRequest
+	(*ForceDeleteUserResponse)(nil),               // 15: users.ForceDeleteUserResponse
+	(*DeleteUserRequest)(nil),                     // 16: users.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                    // 17: users.DeleteUserResponse
+	(*RestoreUserRequest)(nil),                    // 18: users.RestoreUserRequest
+	(*RestoreUserResponse)(nil),                   // 19: users.RestoreUserResponse
+	(*PurgeDeletedUsersRequest)(nil),              // 20: users.PurgeDeletedUsersRequest
+	(*PurgeDeletedUsersResponse)(nil),             // 21: users.PurgeDeletedUsersResponse
+	(*SuspendUserRequest)(nil),                    // 22: users.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                   // 23: users.SuspendUserResponse
+	(*ActivateUserRequest)(nil),                   // 24: users.ActivateUserRequest
+	(*ActivateUserResponse)(nil),                  // 25: users.ActivateUserResponse
+	(*UnlockUserRequest)(nil),                     // 26: users.UnlockUserRequest
+	(*UnlockUserResponse)(nil),                    // 27: users.UnlockUserResponse
+	(*SetUserRoleRequest)(nil),                    // 28: users.SetUserRoleRequest
+	(*SetUserRoleResponse)(nil),                   // 29: users.SetUserRoleResponse
+	(*AuthenticateRequest)(nil),                   // 30: users.AuthenticateRequest
+	(*AuthenticateResponse)(nil),                  // 31: users.AuthenticateResponse
+	(*RefreshTokenRequest)(nil),                   // 32: users.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                  // 33: users.RefreshTokenResponse
+	(*RevokeRefreshTokenRequest)(nil),             // 34: users.RevokeRefreshTokenRequest
+	(*RevokeRefreshTokenResponse)(nil),            // 35: users.RevokeRefreshTokenResponse
+	(*LogoutRequest)(nil),                         // 36: users.LogoutRequest
+	(*LogoutResponse)(nil),                        // 37: users.LogoutResponse
+	(*LogoutAllRequest)(nil),                      // 38: users.LogoutAllRequest
+	(*LogoutAllResponse)(nil),                     // 39: users.LogoutAllResponse
+	(*ChangePasswordRequest)(nil),                 // 40: users.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),                // 41: users.ChangePasswordResponse
+	(*ResetPasswordRequest)(nil),                  // 42: users.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),                 // 43: users.ResetPasswordResponse
+	(*ConfirmPasswordResetRequest)(nil),           // 44: users.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),          // 45: users.ConfirmPasswordResetResponse
+	(*VerifyEmailRequest)(nil),                    // 46: users.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),                   // 47: users.VerifyEmailResponse
+	(*ResendVerificationRequest)(nil),             // 48: users.ResendVerificationRequest
+	(*ResendVerificationResponse)(nil),            // 49: users.ResendVerificationResponse
+	(*VerificationRequestedEvent)(nil),            // 50: users.VerificationRequestedEvent
+	(*RequestEmailChangeRequest)(nil),             // 51: users.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),            // 52: users.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),             // 53: users.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),            // 54: users.ConfirmEmailChangeResponse
+	(*EmailChangeRequestedEvent)(nil),             // 55: users.EmailChangeRequestedEvent
+	(*EmailChangeNoticeEvent)(nil),                // 56: users.EmailChangeNoticeEvent
+	(*SearchUsersRequest)(nil),                    // 57: users.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                   // 58: users.SearchUsersResponse
+	(*GetUserByEmailRequest)(nil),                 // 59: users.GetUserByEmailRequest
+	(*GetUserByUsernameRequest)(nil),              // 60: users.GetUserByUsernameRequest
+	(*GetProfileRequest)(nil),                     // 61: users.GetProfileRequest
+	(*GetProfileResponse)(nil),                    // 62: users.GetProfileResponse
+	(*UpdateProfileRequest)(nil),                  // 63: users.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                 // 64: users.UpdateProfileResponse
+	(*NotificationPreferences)(nil),               // 65: users.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 66: users.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 67: users.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 68: users.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 69: users.UpdateNotificationPreferencesResponse
+	(*GetVerificationStatsRequest)(nil),           // 70: users.GetVerificationStatsRequest
+	(*AgeBucket)(nil),                             // 71: users.AgeBucket
+	(*GetVerificationStatsResponse)(nil),          // 72: users.GetVerificationStatsResponse
+	(*InvalidateAllTokensRequest)(nil),            // 73: users.InvalidateAllTokensRequest
+	(*InvalidateUserTokensRequest)(nil),           // 74: users.InvalidateUserTokensRequest
+	(*InvalidateTokensResponse)(nil),              // 75: users.InvalidateTokensResponse
 }
 var file_proto_users_proto_depIdxs = []int32{
 	0,  // 0: users.User.profile:type_name -> users.Profile
@@ -1772,47 +4654,105 @@ var file_proto_users_proto_depIdxs = []int32{
 	1,  // 2: users.GetUserResponse.user:type_name -> users.User
 	1,  // 3: users.UpdateUserResponse.user:type_name -> users.User
 	1,  // 4: users.ListUsersResponse.users:type_name -> users.User
-	1,  // 5: users.SuspendUserResponse.user:type_name -> users.User
-	1,  // 6: users.ActivateUserResponse.user:type_name -> users.User
-	1,  // 7: users.AuthenticateResponse.user:type_name -> users.User
-	1,  // 8: users.SearchUsersResponse.users:type_name -> users.User
-	2,  // 9: users.UserService.CreateUser:input_type -> users.CreateUserRequest
-	4,  // 10: users.UserService.GetUser:input_type -> users.GetUserRequest
-	6,  // 11: users.UserService.UpdateUser:input_type -> users.UpdateUserRequest
-	8,  // 12: users.UserService.ListUsers:input_type -> users.ListUsersRequest
-	16, // 13: users.UserService.Authenticate:input_type -> users.AuthenticateRequest
-	18, // 14: users.UserService.ChangePassword:input_type -> users.ChangePasswordRequest
-	20, // 15: users.UserService.ResetPassword:input_type -> users.ResetPasswordRequest
-	22, // 16: users.UserService.VerifyEmail:input_type -> users.VerifyEmailRequest
-	26, // 17: users.UserService.GetUserByEmail:input_type -> users.GetUserByEmailRequest
-	27, // 18: users.UserService.GetUserByUsername:input_type -> users.GetUserByUsernameRequest
-	24, // 19: users.UserService.SearchUsers:input_type -> users.SearchUsersRequest
-	10, // 20: users.AdminService.ForceDeleteUser:input_type -> users.ForceDeleteUserRequest
-	12, // 21: users.AdminService.SuspendUser:input_type -> users.SuspendUserRequest
-	14, // 22: users.AdminService.ActivateUser:input_type -> users.ActivateUserRequest
-	2,  // 23: users.AdminService.BulkCreateUsers:input_type -> users.CreateUserRequest
-	8,  // 24: users.AdminService.ExportUsers:input_type -> users.ListUsersRequest
-	3,  // 25: users.UserService.CreateUser:output_type -> users.CreateUserResponse
-	5,  // 26: users.UserService.GetUser:output_type -> users.GetUserResponse
-	7,  // 27: users.UserService.UpdateUser:output_type -> users.UpdateUserResponse
-	9,  // 28: users.UserService.ListUsers:output_type -> users.ListUsersResponse
-	17, // 29: users.UserService.Authenticate:output_type -> users.AuthenticateResponse
-	19, // 30: users.UserService.ChangePassword:output_type -> users.ChangePasswordResponse
-	21, // 31: users.UserService.ResetPassword:output_type -> users.ResetPasswordResponse
-	23, // 32: users.UserService.VerifyEmail:output_type -> users.VerifyEmailResponse
-	5,  // 33: users.UserService.GetUserByEmail:output_type -> users.GetUserResponse
-	5,  // 34: users.UserService.GetUserByUsername:output_type -> users.GetUserResponse
-	25, // 35: users.UserService.SearchUsers:output_type -> users.SearchUsersResponse
-	11, // 36: users.AdminService.ForceDeleteUser:output_type -> users.ForceDeleteUserResponse
-	13, // 37: users.AdminService.SuspendUser:output_type -> users.SuspendUserResponse
-	15, // 38: users.AdminService.ActivateUser:output_type -> users.ActivateUserResponse
-	9,  // 39: users.AdminService.BulkCreateUsers:output_type -> users.ListUsersResponse
-	1,  // 40: users.AdminService.ExportUsers:output_type -> users.User
-	25, // [25:41] is the sub-list for method output_type
-	9,  // [9:25] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 5: users.BulkCreateUsersResponse.users:type_name -> users.User
+	11, // 6: users.BulkCreateUsersResponse.failures:type_name -> users.BulkCreateUserFailure
+	0,  // 7: users.BulkUpdateProfilesResponse.profiles:type_name -> users.Profile
+	13, // 8: users.BulkUpdateProfilesResponse.failures:type_name -> users.BulkUpdateProfileFailure
+	1,  // 9: users.RestoreUserResponse.user:type_name -> users.User
+	1,  // 10: users.SuspendUserResponse.user:type_name -> users.User
+	1,  // 11: users.ActivateUserResponse.user:type_name -> users.User
+	1,  // 12: users.UnlockUserResponse.user:type_name -> users.User
+	1,  // 13: users.SetUserRoleResponse.user:type_name -> users.User
+	1,  // 14: users.AuthenticateResponse.user:type_name -> users.User
+	1,  // 15: users.RefreshTokenResponse.user:type_name -> users.User
+	1,  // 16: users.ConfirmEmailChangeResponse.user:type_name -> users.User
+	1,  // 17: users.SearchUsersResponse.users:type_name -> users.User
+	0,  // 18: users.GetProfileResponse.profile:type_name -> users.Profile
+	0,  // 19: users.UpdateProfileResponse.profile:type_name -> users.Profile
+	65, // 20: users.GetNotificationPreferencesResponse.preferences:type_name -> users.NotificationPreferences
+	65, // 21: users.UpdateNotificationPreferencesResponse.preferences:type_name -> users.NotificationPreferences
+	71, // 22: users.GetVerificationStatsResponse.unverified_age_buckets:type_name -> users.AgeBucket
+	2,  // 23: users.UserService.CreateUser:input_type -> users.CreateUserRequest
+	4,  // 24: users.UserService.GetUser:input_type -> users.GetUserRequest
+	6,  // 25: users.UserService.UpdateUser:input_type -> users.UpdateUserRequest
+	16, // 26: users.UserService.DeleteUser:input_type -> users.DeleteUserRequest
+	8,  // 27: users.UserService.ListUsers:input_type -> users.ListUsersRequest
+	30, // 28: users.UserService.Authenticate:input_type -> users.AuthenticateRequest
+	32, // 29: users.UserService.RefreshToken:input_type -> users.RefreshTokenRequest
+	34, // 30: users.UserService.RevokeRefreshToken:input_type -> users.RevokeRefreshTokenRequest
+	36, // 31: users.UserService.Logout:input_type -> users.LogoutRequest
+	38, // 32: users.UserService.LogoutAll:input_type -> users.LogoutAllRequest
+	40, // 33: users.UserService.ChangePassword:input_type -> users.ChangePasswordRequest
+	42, // 34: users.UserService.ResetPassword:input_type -> users.ResetPasswordRequest
+	44, // 35: users.UserService.ConfirmPasswordReset:input_type -> users.ConfirmPasswordResetRequest
+	46, // 36: users.UserService.VerifyEmail:input_type -> users.VerifyEmailRequest
+	48, // 37: users.UserService.ResendVerification:input_type -> users.ResendVerificationRequest
+	51, // 38: users.UserService.RequestEmailChange:input_type -> users.RequestEmailChangeRequest
+	53, // 39: users.UserService.ConfirmEmailChange:input_type -> users.ConfirmEmailChangeRequest
+	59, // 40: users.UserService.GetUserByEmail:input_type -> users.GetUserByEmailRequest
+	60, // 41: users.UserService.GetUserByUsername:input_type -> users.GetUserByUsernameRequest
+	57, // 42: users.UserService.SearchUsers:input_type -> users.SearchUsersRequest
+	61, // 43: users.UserService.GetProfile:input_type -> users.GetProfileRequest
+	63, // 44: users.UserService.UpdateProfile:input_type -> users.UpdateProfileRequest
+	66, // 45: users.UserService.GetNotificationPreferences:input_type -> users.GetNotificationPreferencesRequest
+	68, // 46: users.UserService.UpdateNotificationPreferences:input_type -> users.UpdateNotificationPreferencesRequest
+	14, // 47: users.AdminService.ForceDeleteUser:input_type -> users.ForceDeleteUserRequest
+	22, // 48: users.AdminService.SuspendUser:input_type -> users.SuspendUserRequest
+	24, // 49: users.AdminService.ActivateUser:input_type -> users.ActivateUserRequest
+	26, // 50: users.AdminService.UnlockUser:input_type -> users.UnlockUserRequest
+	28, // 51: users.AdminService.SetUserRole:input_type -> users.SetUserRoleRequest
+	18, // 52: users.AdminService.RestoreUser:input_type -> users.RestoreUserRequest
+	20, // 53: users.AdminService.PurgeDeletedUsers:input_type -> users.PurgeDeletedUsersRequest
+	2,  // 54: users.AdminService.BulkCreateUsers:input_type -> users.CreateUserRequest
+	63, // 55: users.AdminService.BulkUpdateProfiles:input_type -> users.UpdateProfileRequest
+	8,  // 56: users.AdminService.ExportUsers:input_type -> users.ListUsersRequest
+	57, // 57: users.AdminService.SearchUsers:input_type -> users.SearchUsersRequest
+	70, // 58: users.AdminService.GetVerificationStats:input_type -> users.GetVerificationStatsRequest
+	73, // 59: users.AdminService.InvalidateAllTokens:input_type -> users.InvalidateAllTokensRequest
+	74, // 60: users.AdminService.InvalidateUserTokens:input_type -> users.InvalidateUserTokensRequest
+	3,  // 61: users.UserService.CreateUser:output_type -> users.CreateUserResponse
+	5,  // 62: users.UserService.GetUser:output_type -> users.GetUserResponse
+	7,  // 63: users.UserService.UpdateUser:output_type -> users.UpdateUserResponse
+	17, // 64: users.UserService.DeleteUser:output_type -> users.DeleteUserResponse
+	9,  // 65: users.UserService.ListUsers:output_type -> users.ListUsersResponse
+	31, // 66: users.UserService.Authenticate:output_type -> users.AuthenticateResponse
+	33, // 67: users.UserService.RefreshToken:output_type -> users.RefreshTokenResponse
+	35, // 68: users.UserService.RevokeRefreshToken:output_type -> users.RevokeRefreshTokenResponse
+	37, // 69: users.UserService.Logout:output_type -> users.LogoutResponse
+	39, // 70: users.UserService.LogoutAll:output_type -> users.LogoutAllResponse
+	41, // 71: users.UserService.ChangePassword:output_type -> users.ChangePasswordResponse
+	43, // 72: users.UserService.ResetPassword:output_type -> users.ResetPasswordResponse
+	45, // 73: users.UserService.ConfirmPasswordReset:output_type -> users.ConfirmPasswordResetResponse
+	47, // 74: users.UserService.VerifyEmail:output_type -> users.VerifyEmailResponse
+	49, // 75: users.UserService.ResendVerification:output_type -> users.ResendVerificationResponse
+	52, // 76: users.UserService.RequestEmailChange:output_type -> users.RequestEmailChangeResponse
+	54, // 77: users.UserService.ConfirmEmailChange:output_type -> users.ConfirmEmailChangeResponse
+	5,  // 78: users.UserService.GetUserByEmail:output_type -> users.GetUserResponse
+	5,  // 79: users.UserService.GetUserByUsername:output_type -> users.GetUserResponse
+	58, // 80: users.UserService.SearchUsers:output_type -> users.SearchUsersResponse
+	62, // 81: users.UserService.GetProfile:output_type -> users.GetProfileResponse
+	64, // 82: users.UserService.UpdateProfile:output_type -> users.UpdateProfileResponse
+	67, // 83: users.UserService.GetNotificationPreferences:output_type -> users.GetNotificationPreferencesResponse
+	69, // 84: users.UserService.UpdateNotificationPreferences:output_type -> users.UpdateNotificationPreferencesResponse
+	15, // 85: users.AdminService.ForceDeleteUser:output_type -> users.ForceDeleteUserResponse
+	23, // 86: users.AdminService.SuspendUser:output_type -> users.SuspendUserResponse
+	25, // 87: users.AdminService.ActivateUser:output_type -> users.ActivateUserResponse
+	27, // 88: users.AdminService.UnlockUser:output_type -> users.UnlockUserResponse
+	29, // 89: users.AdminService.SetUserRole:output_type -> users.SetUserRoleResponse
+	19, // 90: users.AdminService.RestoreUser:output_type -> users.RestoreUserResponse
+	21, // 91: users.AdminService.PurgeDeletedUsers:output_type -> users.PurgeDeletedUsersResponse
+	10, // 92: users.AdminService.BulkCreateUsers:output_type -> users.BulkCreateUsersResponse
+	12, // 93: users.AdminService.BulkUpdateProfiles:output_type -> users.BulkUpdateProfilesResponse
+	1,  // 94: users.AdminService.ExportUsers:output_type -> users.User
+	58, // 95: users.AdminService.SearchUsers:output_type -> users.SearchUsersResponse
+	72, // 96: users.AdminService.GetVerificationStats:output_type -> users.GetVerificationStatsResponse
+	75, // 97: users.AdminService.InvalidateAllTokens:output_type -> users.InvalidateTokensResponse
+	75, // 98: users.AdminService.InvalidateUserTokens:output_type -> users.InvalidateTokensResponse
+	61, // [61:99] is the sub-list for method output_type
+	23, // [23:61] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_users_proto_init() }
@@ -1820,13 +4760,16 @@ func file_proto_users_proto_init() {
 	if File_proto_users_proto != nil {
 		return
 	}
+	file_proto_users_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_users_proto_msgTypes[63].OneofWrappers = []any{}
+	file_proto_users_proto_msgTypes[68].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_users_proto_rawDesc), len(file_proto_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
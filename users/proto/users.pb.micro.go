@@ -32,16 +32,31 @@ type UserService interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...client.CallOption) (*CreateUserResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...client.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...client.CallOption) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...client.CallOption) (*DeleteUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...client.CallOption) (*ListUsersResponse, error)
 	// Authentication related
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...client.CallOption) (*AuthenticateResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...client.CallOption) (*RefreshTokenResponse, error)
+	RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...client.CallOption) (*RevokeRefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error)
+	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...client.CallOption) (*LogoutAllResponse, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*ChangePasswordResponse, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...client.CallOption) (*ResetPasswordResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...client.CallOption) (*ConfirmPasswordResetResponse, error)
 	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...client.CallOption) (*VerifyEmailResponse, error)
+	ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...client.CallOption) (*ResendVerificationResponse, error)
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...client.CallOption) (*RequestEmailChangeResponse, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...client.CallOption) (*ConfirmEmailChangeResponse, error)
 	// Query operations
 	GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...client.CallOption) (*GetUserResponse, error)
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...client.CallOption) (*GetUserResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...client.CallOption) (*SearchUsersResponse, error)
+	// Profile operations
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...client.CallOption) (*GetProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...client.CallOption) (*UpdateProfileResponse, error)
+	// Notification preference operations
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...client.CallOption) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...client.CallOption) (*UpdateNotificationPreferencesResponse, error)
 }
 
 type userService struct {
@@ -86,6 +101,16 @@ func (c *userService) UpdateUser(ctx context.Context, in *UpdateUserRequest, opt
 	return out, nil
 }
 
+func (c *userService) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...client.CallOption) (*DeleteUserResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.DeleteUser", in)
+	out := new(DeleteUserResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userService) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...client.CallOption) (*ListUsersResponse, error) {
 	req := c.c.NewRequest(c.name, "UserService.ListUsers", in)
 	out := new(ListUsersResponse)
@@ -106,6 +131,46 @@ func (c *userService) Authenticate(ctx context.Context, in *AuthenticateRequest,
 	return out, nil
 }
 
+func (c *userService) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...client.CallOption) (*RefreshTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.RefreshToken", in)
+	out := new(RefreshTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...client.CallOption) (*RevokeRefreshTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.RevokeRefreshToken", in)
+	out := new(RevokeRefreshTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.Logout", in)
+	out := new(LogoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...client.CallOption) (*LogoutAllResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.LogoutAll", in)
+	out := new(LogoutAllResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*ChangePasswordResponse, error) {
 	req := c.c.NewRequest(c.name, "UserService.ChangePassword", in)
 	out := new(ChangePasswordResponse)
@@ -126,6 +191,16 @@ func (c *userService) ResetPassword(ctx context.Context, in *ResetPasswordReques
 	return out, nil
 }
 
+func (c *userService) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...client.CallOption) (*ConfirmPasswordResetResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.ConfirmPasswordReset", in)
+	out := new(ConfirmPasswordResetResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userService) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...client.CallOption) (*VerifyEmailResponse, error) {
 	req := c.c.NewRequest(c.name, "UserService.VerifyEmail", in)
 	out := new(VerifyEmailResponse)
@@ -136,6 +211,36 @@ func (c *userService) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, o
 	return out, nil
 }
 
+func (c *userService) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...client.CallOption) (*ResendVerificationResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.ResendVerification", in)
+	out := new(ResendVerificationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...client.CallOption) (*RequestEmailChangeResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.RequestEmailChange", in)
+	out := new(RequestEmailChangeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...client.CallOption) (*ConfirmEmailChangeResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.ConfirmEmailChange", in)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userService) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...client.CallOption) (*GetUserResponse, error) {
 	req := c.c.NewRequest(c.name, "UserService.GetUserByEmail", in)
 	out := new(GetUserResponse)
@@ -166,6 +271,46 @@ func (c *userService) SearchUsers(ctx context.Context, in *SearchUsersRequest, o
 	return out, nil
 }
 
+func (c *userService) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...client.CallOption) (*GetProfileResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.GetProfile", in)
+	out := new(GetProfileResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...client.CallOption) (*UpdateProfileResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.UpdateProfile", in)
+	out := new(UpdateProfileResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...client.CallOption) (*GetNotificationPreferencesResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.GetNotificationPreferences", in)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userService) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...client.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	req := c.c.NewRequest(c.name, "UserService.UpdateNotificationPreferences", in)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for UserService service
 
 type UserServiceHandler interface {
@@ -173,16 +318,31 @@ type UserServiceHandler interface {
 	CreateUser(context.Context, *CreateUserRequest, *CreateUserResponse) error
 	GetUser(context.Context, *GetUserRequest, *GetUserResponse) error
 	UpdateUser(context.Context, *UpdateUserRequest, *UpdateUserResponse) error
+	DeleteUser(context.Context, *DeleteUserRequest, *DeleteUserResponse) error
 	ListUsers(context.Context, *ListUsersRequest, *ListUsersResponse) error
 	// Authentication related
 	Authenticate(context.Context, *AuthenticateRequest, *AuthenticateResponse) error
+	RefreshToken(context.Context, *RefreshTokenRequest, *RefreshTokenResponse) error
+	RevokeRefreshToken(context.Context, *RevokeRefreshTokenRequest, *RevokeRefreshTokenResponse) error
+	Logout(context.Context, *LogoutRequest, *LogoutResponse) error
+	LogoutAll(context.Context, *LogoutAllRequest, *LogoutAllResponse) error
 	ChangePassword(context.Context, *ChangePasswordRequest, *ChangePasswordResponse) error
 	ResetPassword(context.Context, *ResetPasswordRequest, *ResetPasswordResponse) error
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest, *ConfirmPasswordResetResponse) error
 	VerifyEmail(context.Context, *VerifyEmailRequest, *VerifyEmailResponse) error
+	ResendVerification(context.Context, *ResendVerificationRequest, *ResendVerificationResponse) error
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest, *RequestEmailChangeResponse) error
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest, *ConfirmEmailChangeResponse) error
 	// Query operations
 	GetUserByEmail(context.Context, *GetUserByEmailRequest, *GetUserResponse) error
 	GetUserByUsername(context.Context, *GetUserByUsernameRequest, *GetUserResponse) error
 	SearchUsers(context.Context, *SearchUsersRequest, *SearchUsersResponse) error
+	// Profile operations
+	GetProfile(context.Context, *GetProfileRequest, *GetProfileResponse) error
+	UpdateProfile(context.Context, *UpdateProfileRequest, *UpdateProfileResponse) error
+	// Notification preference operations
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest, *GetNotificationPreferencesResponse) error
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest, *UpdateNotificationPreferencesResponse) error
 }
 
 func RegisterUserServiceHandler(s server.Server, hdlr UserServiceHandler, opts ...server.HandlerOption) error {
@@ -190,14 +350,27 @@ func RegisterUserServiceHandler(s server.Server, hdlr UserServiceHandler, opts .
 		CreateUser(ctx context.Context, in *CreateUserRequest, out *CreateUserResponse) error
 		GetUser(ctx context.Context, in *GetUserRequest, out *GetUserResponse) error
 		UpdateUser(ctx context.Context, in *UpdateUserRequest, out *UpdateUserResponse) error
+		DeleteUser(ctx context.Context, in *DeleteUserRequest, out *DeleteUserResponse) error
 		ListUsers(ctx context.Context, in *ListUsersRequest, out *ListUsersResponse) error
 		Authenticate(ctx context.Context, in *AuthenticateRequest, out *AuthenticateResponse) error
+		RefreshToken(ctx context.Context, in *RefreshTokenRequest, out *RefreshTokenResponse) error
+		RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, out *RevokeRefreshTokenResponse) error
+		Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error
+		LogoutAll(ctx context.Context, in *LogoutAllRequest, out *LogoutAllResponse) error
 		ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *ChangePasswordResponse) error
 		ResetPassword(ctx context.Context, in *ResetPasswordRequest, out *ResetPasswordResponse) error
+		ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, out *ConfirmPasswordResetResponse) error
 		VerifyEmail(ctx context.Context, in *VerifyEmailRequest, out *VerifyEmailResponse) error
+		ResendVerification(ctx context.Context, in *ResendVerificationRequest, out *ResendVerificationResponse) error
+		RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, out *RequestEmailChangeResponse) error
+		ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, out *ConfirmEmailChangeResponse) error
 		GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, out *GetUserResponse) error
 		GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, out *GetUserResponse) error
 		SearchUsers(ctx context.Context, in *SearchUsersRequest, out *SearchUsersResponse) error
+		GetProfile(ctx context.Context, in *GetProfileRequest, out *GetProfileResponse) error
+		UpdateProfile(ctx context.Context, in *UpdateProfileRequest, out *UpdateProfileResponse) error
+		GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, out *GetNotificationPreferencesResponse) error
+		UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, out *UpdateNotificationPreferencesResponse) error
 	}
 	type UserService struct {
 		userService
@@ -222,6 +395,10 @@ func (h *userServiceHandler) UpdateUser(ctx context.Context, in *UpdateUserReque
 	return h.UserServiceHandler.UpdateUser(ctx, in, out)
 }
 
+func (h *userServiceHandler) DeleteUser(ctx context.Context, in *DeleteUserRequest, out *DeleteUserResponse) error {
+	return h.UserServiceHandler.DeleteUser(ctx, in, out)
+}
+
 func (h *userServiceHandler) ListUsers(ctx context.Context, in *ListUsersRequest, out *ListUsersResponse) error {
 	return h.UserServiceHandler.ListUsers(ctx, in, out)
 }
@@ -230,6 +407,22 @@ func (h *userServiceHandler) Authenticate(ctx context.Context, in *AuthenticateR
 	return h.UserServiceHandler.Authenticate(ctx, in, out)
 }
 
+func (h *userServiceHandler) RefreshToken(ctx context.Context, in *RefreshTokenRequest, out *RefreshTokenResponse) error {
+	return h.UserServiceHandler.RefreshToken(ctx, in, out)
+}
+
+func (h *userServiceHandler) RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, out *RevokeRefreshTokenResponse) error {
+	return h.UserServiceHandler.RevokeRefreshToken(ctx, in, out)
+}
+
+func (h *userServiceHandler) Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error {
+	return h.UserServiceHandler.Logout(ctx, in, out)
+}
+
+func (h *userServiceHandler) LogoutAll(ctx context.Context, in *LogoutAllRequest, out *LogoutAllResponse) error {
+	return h.UserServiceHandler.LogoutAll(ctx, in, out)
+}
+
 func (h *userServiceHandler) ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *ChangePasswordResponse) error {
 	return h.UserServiceHandler.ChangePassword(ctx, in, out)
 }
@@ -238,10 +431,26 @@ func (h *userServiceHandler) ResetPassword(ctx context.Context, in *ResetPasswor
 	return h.UserServiceHandler.ResetPassword(ctx, in, out)
 }
 
+func (h *userServiceHandler) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, out *ConfirmPasswordResetResponse) error {
+	return h.UserServiceHandler.ConfirmPasswordReset(ctx, in, out)
+}
+
 func (h *userServiceHandler) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, out *VerifyEmailResponse) error {
 	return h.UserServiceHandler.VerifyEmail(ctx, in, out)
 }
 
+func (h *userServiceHandler) ResendVerification(ctx context.Context, in *ResendVerificationRequest, out *ResendVerificationResponse) error {
+	return h.UserServiceHandler.ResendVerification(ctx, in, out)
+}
+
+func (h *userServiceHandler) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, out *RequestEmailChangeResponse) error {
+	return h.UserServiceHandler.RequestEmailChange(ctx, in, out)
+}
+
+func (h *userServiceHandler) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, out *ConfirmEmailChangeResponse) error {
+	return h.UserServiceHandler.ConfirmEmailChange(ctx, in, out)
+}
+
 func (h *userServiceHandler) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, out *GetUserResponse) error {
 	return h.UserServiceHandler.GetUserByEmail(ctx, in, out)
 }
@@ -254,15 +463,40 @@ func (h *userServiceHandler) SearchUsers(ctx context.Context, in *SearchUsersReq
 	return h.UserServiceHandler.SearchUsers(ctx, in, out)
 }
 
+func (h *userServiceHandler) GetProfile(ctx context.Context, in *GetProfileRequest, out *GetProfileResponse) error {
+	return h.UserServiceHandler.GetProfile(ctx, in, out)
+}
+
+func (h *userServiceHandler) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, out *UpdateProfileResponse) error {
+	return h.UserServiceHandler.UpdateProfile(ctx, in, out)
+}
+
+func (h *userServiceHandler) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, out *GetNotificationPreferencesResponse) error {
+	return h.UserServiceHandler.GetNotificationPreferences(ctx, in, out)
+}
+
+func (h *userServiceHandler) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, out *UpdateNotificationPreferencesResponse) error {
+	return h.UserServiceHandler.UpdateNotificationPreferences(ctx, in, out)
+}
+
 // Client API for AdminService service
 
 type AdminService interface {
 	ForceDeleteUser(ctx context.Context, in *ForceDeleteUserRequest, opts ...client.CallOption) (*ForceDeleteUserResponse, error)
 	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...client.CallOption) (*SuspendUserResponse, error)
 	ActivateUser(ctx context.Context, in *ActivateUserRequest, opts ...client.CallOption) (*ActivateUserResponse, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...client.CallOption) (*UnlockUserResponse, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...client.CallOption) (*SetUserRoleResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...client.CallOption) (*RestoreUserResponse, error)
+	PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersRequest, opts ...client.CallOption) (*PurgeDeletedUsersResponse, error)
 	// Additional admin operations
 	BulkCreateUsers(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateUsersService, error)
+	BulkUpdateProfiles(ctx context.Context, opts ...client.CallOption) (AdminService_BulkUpdateProfilesService, error)
 	ExportUsers(ctx context.Context, in *ListUsersRequest, opts ...client.CallOption) (AdminService_ExportUsersService, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...client.CallOption) (*SearchUsersResponse, error)
+	GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, opts ...client.CallOption) (*GetVerificationStatsResponse, error)
+	InvalidateAllTokens(ctx context.Context, in *InvalidateAllTokensRequest, opts ...client.CallOption) (*InvalidateTokensResponse, error)
+	InvalidateUserTokens(ctx context.Context, in *InvalidateUserTokensRequest, opts ...client.CallOption) (*InvalidateTokensResponse, error)
 }
 
 type adminService struct {
@@ -307,6 +541,46 @@ func (c *adminService) ActivateUser(ctx context.Context, in *ActivateUserRequest
 	return out, nil
 }
 
+func (c *adminService) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...client.CallOption) (*UnlockUserResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.UnlockUser", in)
+	out := new(UnlockUserResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...client.CallOption) (*SetUserRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.SetUserRole", in)
+	out := new(SetUserRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...client.CallOption) (*RestoreUserResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.RestoreUser", in)
+	out := new(RestoreUserResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersRequest, opts ...client.CallOption) (*PurgeDeletedUsersResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.PurgeDeletedUsers", in)
+	out := new(PurgeDeletedUsersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminService) BulkCreateUsers(ctx context.Context, opts ...client.CallOption) (AdminService_BulkCreateUsersService, error) {
 	req := c.c.NewRequest(c.name, "AdminService.BulkCreateUsers", &CreateUserRequest{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -353,6 +627,52 @@ func (x *adminServiceBulkCreateUsers) Send(m *CreateUserRequest) error {
 	return x.stream.Send(m)
 }
 
+func (c *adminService) BulkUpdateProfiles(ctx context.Context, opts ...client.CallOption) (AdminService_BulkUpdateProfilesService, error) {
+	req := c.c.NewRequest(c.name, "AdminService.BulkUpdateProfiles", &UpdateProfileRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &adminServiceBulkUpdateProfiles{stream}, nil
+}
+
+type AdminService_BulkUpdateProfilesService interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	CloseSend() error
+	Close() error
+	Send(*UpdateProfileRequest) error
+}
+
+type adminServiceBulkUpdateProfiles struct {
+	stream client.Stream
+}
+
+func (x *adminServiceBulkUpdateProfiles) CloseSend() error {
+	return x.stream.CloseSend()
+}
+
+func (x *adminServiceBulkUpdateProfiles) Close() error {
+	return x.stream.Close()
+}
+
+func (x *adminServiceBulkUpdateProfiles) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *adminServiceBulkUpdateProfiles) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *adminServiceBulkUpdateProfiles) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *adminServiceBulkUpdateProfiles) Send(m *UpdateProfileRequest) error {
+	return x.stream.Send(m)
+}
+
 func (c *adminService) ExportUsers(ctx context.Context, in *ListUsersRequest, opts ...client.CallOption) (AdminService_ExportUsersService, error) {
 	req := c.c.NewRequest(c.name, "AdminService.ExportUsers", &ListUsersRequest{})
 	stream, err := c.c.Stream(ctx, req, opts...)
@@ -407,15 +727,64 @@ func (x *adminServiceExportUsers) Recv() (*User, error) {
 	return m, nil
 }
 
+func (c *adminService) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...client.CallOption) (*SearchUsersResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.SearchUsers", in)
+	out := new(SearchUsersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, opts ...client.CallOption) (*GetVerificationStatsResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.GetVerificationStats", in)
+	out := new(GetVerificationStatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) InvalidateAllTokens(ctx context.Context, in *InvalidateAllTokensRequest, opts ...client.CallOption) (*InvalidateTokensResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.InvalidateAllTokens", in)
+	out := new(InvalidateTokensResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminService) InvalidateUserTokens(ctx context.Context, in *InvalidateUserTokensRequest, opts ...client.CallOption) (*InvalidateTokensResponse, error) {
+	req := c.c.NewRequest(c.name, "AdminService.InvalidateUserTokens", in)
+	out := new(InvalidateTokensResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceHandler interface {
 	ForceDeleteUser(context.Context, *ForceDeleteUserRequest, *ForceDeleteUserResponse) error
 	SuspendUser(context.Context, *SuspendUserRequest, *SuspendUserResponse) error
 	ActivateUser(context.Context, *ActivateUserRequest, *ActivateUserResponse) error
+	UnlockUser(context.Context, *UnlockUserRequest, *UnlockUserResponse) error
+	SetUserRole(context.Context, *SetUserRoleRequest, *SetUserRoleResponse) error
+	RestoreUser(context.Context, *RestoreUserRequest, *RestoreUserResponse) error
+	PurgeDeletedUsers(context.Context, *PurgeDeletedUsersRequest, *PurgeDeletedUsersResponse) error
 	// Additional admin operations
 	BulkCreateUsers(context.Context, AdminService_BulkCreateUsersStream) error
+	BulkUpdateProfiles(context.Context, AdminService_BulkUpdateProfilesStream) error
 	ExportUsers(context.Context, *ListUsersRequest, AdminService_ExportUsersStream) error
+	SearchUsers(context.Context, *SearchUsersRequest, *SearchUsersResponse) error
+	GetVerificationStats(context.Context, *GetVerificationStatsRequest, *GetVerificationStatsResponse) error
+	InvalidateAllTokens(context.Context, *InvalidateAllTokensRequest, *InvalidateTokensResponse) error
+	InvalidateUserTokens(context.Context, *InvalidateUserTokensRequest, *InvalidateTokensResponse) error
 }
 
 func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts ...server.HandlerOption) error {
@@ -423,8 +792,17 @@ func RegisterAdminServiceHandler(s server.Server, hdlr AdminServiceHandler, opts
 		ForceDeleteUser(ctx context.Context, in *ForceDeleteUserRequest, out *ForceDeleteUserResponse) error
 		SuspendUser(ctx context.Context, in *SuspendUserRequest, out *SuspendUserResponse) error
 		ActivateUser(ctx context.Context, in *ActivateUserRequest, out *ActivateUserResponse) error
+		UnlockUser(ctx context.Context, in *UnlockUserRequest, out *UnlockUserResponse) error
+		SetUserRole(ctx context.Context, in *SetUserRoleRequest, out *SetUserRoleResponse) error
+		RestoreUser(ctx context.Context, in *RestoreUserRequest, out *RestoreUserResponse) error
+		PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersRequest, out *PurgeDeletedUsersResponse) error
 		BulkCreateUsers(ctx context.Context, stream server.Stream) error
+		BulkUpdateProfiles(ctx context.Context, stream server.Stream) error
 		ExportUsers(ctx context.Context, stream server.Stream) error
+		SearchUsers(ctx context.Context, in *SearchUsersRequest, out *SearchUsersResponse) error
+		GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, out *GetVerificationStatsResponse) error
+		InvalidateAllTokens(ctx context.Context, in *InvalidateAllTokensRequest, out *InvalidateTokensResponse) error
+		InvalidateUserTokens(ctx context.Context, in *InvalidateUserTokensRequest, out *InvalidateTokensResponse) error
 	}
 	type AdminService struct {
 		adminService
@@ -449,6 +827,22 @@ func (h *adminServiceHandler) ActivateUser(ctx context.Context, in *ActivateUser
 	return h.AdminServiceHandler.ActivateUser(ctx, in, out)
 }
 
+func (h *adminServiceHandler) UnlockUser(ctx context.Context, in *UnlockUserRequest, out *UnlockUserResponse) error {
+	return h.AdminServiceHandler.UnlockUser(ctx, in, out)
+}
+
+func (h *adminServiceHandler) SetUserRole(ctx context.Context, in *SetUserRoleRequest, out *SetUserRoleResponse) error {
+	return h.AdminServiceHandler.SetUserRole(ctx, in, out)
+}
+
+func (h *adminServiceHandler) RestoreUser(ctx context.Context, in *RestoreUserRequest, out *RestoreUserResponse) error {
+	return h.AdminServiceHandler.RestoreUser(ctx, in, out)
+}
+
+func (h *adminServiceHandler) PurgeDeletedUsers(ctx context.Context, in *PurgeDeletedUsersRequest, out *PurgeDeletedUsersResponse) error {
+	return h.AdminServiceHandler.PurgeDeletedUsers(ctx, in, out)
+}
+
 func (h *adminServiceHandler) BulkCreateUsers(ctx context.Context, stream server.Stream) error {
 	return h.AdminServiceHandler.BulkCreateUsers(ctx, &adminServiceBulkCreateUsersStream{stream})
 }
@@ -489,6 +883,46 @@ func (x *adminServiceBulkCreateUsersStream) Recv() (*CreateUserRequest, error) {
 	return m, nil
 }
 
+func (h *adminServiceHandler) BulkUpdateProfiles(ctx context.Context, stream server.Stream) error {
+	return h.AdminServiceHandler.BulkUpdateProfiles(ctx, &adminServiceBulkUpdateProfilesStream{stream})
+}
+
+type AdminService_BulkUpdateProfilesStream interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*UpdateProfileRequest, error)
+}
+
+type adminServiceBulkUpdateProfilesStream struct {
+	stream server.Stream
+}
+
+func (x *adminServiceBulkUpdateProfilesStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *adminServiceBulkUpdateProfilesStream) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *adminServiceBulkUpdateProfilesStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *adminServiceBulkUpdateProfilesStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *adminServiceBulkUpdateProfilesStream) Recv() (*UpdateProfileRequest, error) {
+	m := new(UpdateProfileRequest)
+	if err := x.stream.Recv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (h *adminServiceHandler) ExportUsers(ctx context.Context, stream server.Stream) error {
 	m := new(ListUsersRequest)
 	if err := stream.Recv(m); err != nil {
@@ -528,3 +962,19 @@ func (x *adminServiceExportUsersStream) RecvMsg(m interface{}) error {
 func (x *adminServiceExportUsersStream) Send(m *User) error {
 	return x.stream.Send(m)
 }
+
+func (h *adminServiceHandler) SearchUsers(ctx context.Context, in *SearchUsersRequest, out *SearchUsersResponse) error {
+	return h.AdminServiceHandler.SearchUsers(ctx, in, out)
+}
+
+func (h *adminServiceHandler) GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, out *GetVerificationStatsResponse) error {
+	return h.AdminServiceHandler.GetVerificationStats(ctx, in, out)
+}
+
+func (h *adminServiceHandler) InvalidateAllTokens(ctx context.Context, in *InvalidateAllTokensRequest, out *InvalidateTokensResponse) error {
+	return h.AdminServiceHandler.InvalidateAllTokens(ctx, in, out)
+}
+
+func (h *adminServiceHandler) InvalidateUserTokens(ctx context.Context, in *InvalidateUserTokensRequest, out *InvalidateTokensResponse) error {
+	return h.AdminServiceHandler.InvalidateUserTokens(ctx, in, out)
+}
@@ -0,0 +1,106 @@
+// Package seed loads deterministic sample data for tests and local development.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"users/ent"
+	"users/ent/user"
+)
+
+// FixtureSet names a predefined collection of fixtures
+type FixtureSet string
+
+const (
+	// Minimal loads a single record of each kind, enough to exercise relationships
+	Minimal FixtureSet = "minimal"
+	// Demo loads the full sample data set for local development
+	Demo FixtureSet = "demo"
+)
+
+// Password is the plain-text password of every seeded user
+const Password = "password123"
+
+// UserFixture describes a seeded user and their profile
+type UserFixture struct {
+	ID            uuid.UUID
+	Email         string
+	Username      string
+	EmailVerified bool
+	IsActive      bool
+	Role          user.Role
+	FirstName     string
+	LastName      string
+}
+
+// Users are the seeded users; their IDs are shared with the products, orders and carts seeds
+var Users = []UserFixture{
+	{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Email: "alice@example.com", Username: "alice", EmailVerified: true, IsActive: true, Role: user.RoleAdmin, FirstName: "Alice", LastName: "Anders"},
+	{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Email: "bob@example.com", Username: "bob", EmailVerified: false, IsActive: true, Role: user.RoleUser, FirstName: "Bob", LastName: "Brown"},
+	{ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"), Email: "carol@example.com", Username: "carol", EmailVerified: true, IsActive: false, Role: user.RoleUser, FirstName: "Carol", LastName: "Clark"},
+}
+
+// Load inserts the given fixture set into an empty database in a single transaction
+func Load(ctx context.Context, client *ent.Client, set FixtureSet) error {
+	n, err := set.limit(len(Users))
+	if err != nil {
+		return err
+	}
+
+	// The lowest cost keeps seeding fast; bcrypt verifies any cost
+	hash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.MinCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash seed password: %w", err)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, f := range Users[:n] {
+		u, err := tx.User.Create().
+			SetID(f.ID).
+			SetEmail(f.Email).
+			SetUsername(f.Username).
+			SetPasswordHash(string(hash)).
+			SetEmailVerified(f.EmailVerified).
+			SetIsActive(f.IsActive).
+			SetRole(f.Role).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed user %s: %w", f.Username, err)
+		}
+		_, err = tx.Profile.Create().
+			SetUser(u).
+			SetFirstName(f.FirstName).
+			SetLastName(f.LastName).
+			SetDateOfBirth(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to seed profile for %s: %w", f.Username, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seed data: %w", err)
+	}
+	return nil
+}
+
+// limit returns how many of n fixtures the set includes
+func (s FixtureSet) limit(n int) (int, error) {
+	switch s {
+	case Minimal:
+		return min(1, n), nil
+	case Demo:
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown fixture set: %q", s)
+}
@@ -0,0 +1,87 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+
+	"users/ent"
+	"users/ent/enttest"
+)
+
+// load opens a fresh in-memory database and loads set into it
+func load(t *testing.T, set FixtureSet) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := Load(context.Background(), client, set); err != nil {
+		t.Fatalf("Load(%s): %v", set, err)
+	}
+	return client
+}
+
+// snapshot describes every seeded row, ignoring timestamps and password hashes, which are salted
+func snapshot(client *ent.Client) []string {
+	var rows []string
+	for _, u := range client.User.Query().WithProfile().AllX(context.Background()) {
+		p := u.Edges.Profile
+		rows = append(rows, fmt.Sprintf("user %s %s %s %v %v %s %s %s %s", u.ID, u.Email, u.Username, u.EmailVerified, u.IsActive, u.Role, *p.FirstName, *p.LastName, p.DateOfBirth))
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+func TestLoadSeedsUsersWithProfiles(t *testing.T) {
+	ctx := context.Background()
+	for set, want := range map[FixtureSet]int{Minimal: 1, Demo: len(Users)} {
+		client := load(t, set)
+		users := client.User.Query().WithProfile().AllX(ctx)
+		if len(users) != want {
+			t.Fatalf("%s: expected %d users, got %d", set, want, len(users))
+		}
+		if n := client.Profile.Query().CountX(ctx); n != want {
+			t.Errorf("%s: expected %d profiles, got %d", set, want, n)
+		}
+
+		for _, u := range users {
+			i := slices.IndexFunc(Users, func(f UserFixture) bool { return f.ID == u.ID })
+			if i < 0 {
+				t.Fatalf("%s: unexpected user %s", set, u.ID)
+			}
+			f := Users[i]
+			if u.Email != f.Email || u.Username != f.Username || u.Role != f.Role || u.EmailVerified != f.EmailVerified || u.IsActive != f.IsActive {
+				t.Errorf("%s: user %s doesn't match its fixture", set, f.Username)
+			}
+			if p := u.Edges.Profile; p == nil || *p.FirstName != f.FirstName || *p.LastName != f.LastName {
+				t.Errorf("%s: expected %s's profile linked", set, f.Username)
+			}
+			if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(Password)) != nil {
+				t.Errorf("%s: expected %s to log in with the seed password", set, f.Username)
+			}
+		}
+	}
+}
+
+func TestLoadIsDeterministic(t *testing.T) {
+	first, second := snapshot(load(t, Demo)), snapshot(load(t, Demo))
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected identical data from two loads, got\n%v\n%v", first, second)
+	}
+
+	// Loading into a seeded database fails as a whole rather than duplicating
+	client := load(t, Minimal)
+	if err := Load(context.Background(), client, Demo); err == nil {
+		t.Fatal("expected loading into a seeded database to fail")
+	}
+	if n := client.User.Query().CountX(context.Background()); n != 1 {
+		t.Fatalf("expected the failed load rolled back, found %d users", n)
+	}
+	if err := Load(context.Background(), client, "huge"); err == nil {
+		t.Fatal("expected an unknown fixture set to be rejected")
+	}
+}